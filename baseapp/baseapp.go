@@ -17,6 +17,7 @@ import (
 	"github.com/tendermint/tendermint/crypto/tmhash"
 	dbm "github.com/tendermint/tendermint/libs/db"
 	"github.com/tendermint/tendermint/libs/log"
+	tmtypes "github.com/tendermint/tendermint/types"
 
 	"github.com/cosmos/cosmos-sdk/codec"
 	"github.com/cosmos/cosmos-sdk/store"
@@ -182,6 +183,18 @@ func (app *BaseApp) LoadLatestVersion(baseKey *sdk.KVStoreKey) error {
 	return app.initFromMainStore(baseKey)
 }
 
+// LoadLatestVersionAndUpgrade loads the latest application version while
+// applying the given store upgrades (added, renamed and deleted sub-stores).
+// The upgrades are persisted on the next commit. It will panic if called more
+// than once on a running BaseApp.
+func (app *BaseApp) LoadLatestVersionAndUpgrade(baseKey *sdk.KVStoreKey, upgrades *sdk.StoreUpgrades) error {
+	err := app.cms.LoadLatestVersionAndUpgrade(upgrades)
+	if err != nil {
+		return err
+	}
+	return app.initFromMainStore(baseKey)
+}
+
 // LoadVersion loads the BaseApp application version. It will panic if called
 // more than once on a running baseapp.
 func (app *BaseApp) LoadVersion(version int64, baseKey *sdk.KVStoreKey) error {
@@ -192,6 +205,18 @@ func (app *BaseApp) LoadVersion(version int64, baseKey *sdk.KVStoreKey) error {
 	return app.initFromMainStore(baseKey)
 }
 
+// LoadVersionAndUpgrade loads the BaseApp application version while applying
+// the given store upgrades (added, renamed and deleted sub-stores). The
+// upgrades are persisted on the next commit. It will panic if called more
+// than once on a running baseapp.
+func (app *BaseApp) LoadVersionAndUpgrade(version int64, baseKey *sdk.KVStoreKey, upgrades *sdk.StoreUpgrades) error {
+	err := app.cms.LoadVersionAndUpgrade(version, upgrades)
+	if err != nil {
+		return err
+	}
+	return app.initFromMainStore(baseKey)
+}
+
 // LastCommitID returns the last CommitID of the multistore.
 func (app *BaseApp) LastCommitID() sdk.CommitID {
 	return app.cms.LastCommitID()
@@ -303,6 +328,37 @@ func (app *BaseApp) storeConsensusParams(consensusParams *abci.ConsensusParams)
 	mainStore.Set(mainConsensusParamsKey, consensusParamsBz)
 }
 
+// updateConsensusParams applies the consensus params updates returned by the
+// EndBlocker, replacing the groups of params they set, and memoizes and stores
+// the resulting params so that they are used from the next block on. It panics
+// if the resulting params are outside of Tendermint's limits, as Tendermint
+// would fail to apply them.
+func (app *BaseApp) updateConsensusParams(updates *abci.ConsensusParams) {
+	consensusParams := &abci.ConsensusParams{}
+	if app.consensusParams != nil {
+		*consensusParams = *app.consensusParams
+	}
+
+	if updates.Block != nil {
+		consensusParams.Block = updates.Block
+	}
+	if updates.Evidence != nil {
+		consensusParams.Evidence = updates.Evidence
+	}
+	if updates.Validator != nil {
+		consensusParams.Validator = updates.Validator
+	}
+
+	// the params which were never set are taken from Tendermint's defaults
+	params := tmtypes.DefaultConsensusParams().Update(consensusParams)
+	if err := params.Validate(); err != nil {
+		panic(fmt.Sprintf("invalid consensus params update: %s", err))
+	}
+
+	app.setConsensusParams(consensusParams)
+	app.storeConsensusParams(consensusParams)
+}
+
 // getMaximumBlockGas gets the maximum gas from the consensus params. It panics
 // if maximum block gas is less than negative one and returns zero if negative
 // one.
@@ -643,7 +699,7 @@ func (app *BaseApp) CheckTx(txBytes []byte) (res abci.ResponseCheckTx) {
 		Log:       result.Log,
 		GasWanted: int64(result.GasWanted), // TODO: Should type accept unsigned ints?
 		GasUsed:   int64(result.GasUsed),   // TODO: Should type accept unsigned ints?
-		Tags:      result.Tags,
+		Tags:      result.Events.ToABCITags(),
 	}
 }
 
@@ -665,7 +721,7 @@ func (app *BaseApp) DeliverTx(txBytes []byte) (res abci.ResponseDeliverTx) {
 		Log:       result.Log,
 		GasWanted: int64(result.GasWanted), // TODO: Should type accept unsigned ints?
 		GasUsed:   int64(result.GasUsed),   // TODO: Should type accept unsigned ints?
-		Tags:      result.Tags,
+		Tags:      result.Events.ToABCITags(),
 	}
 }
 
@@ -705,8 +761,8 @@ func (app *BaseApp) getContextForTx(mode runTxMode, txBytes []byte) (ctx sdk.Con
 func (app *BaseApp) runMsgs(ctx sdk.Context, msgs []sdk.Msg, mode runTxMode) (result sdk.Result) {
 	idxLogs := make([]sdk.ABCIMessageLog, 0, len(msgs)) // a list of JSON-encoded logs with msg index
 
-	var data []byte   // NOTE: we just append them all (?!)
-	var tags sdk.Tags // also just append them all
+	var data []byte // NOTE: we just append them all (?!)
+	var events = sdk.EmptyEvents()
 	var code sdk.CodeType
 	var codespace sdk.CodespaceType
 
@@ -720,19 +776,32 @@ func (app *BaseApp) runMsgs(ctx sdk.Context, msgs []sdk.Msg, mode runTxMode) (re
 
 		var msgResult sdk.Result
 
+		// each message gets a fresh event manager so emitted events remain
+		// associated with the message that produced them
+		msgCtx := ctx.WithEventManager(sdk.NewEventManager())
+
 		// skip actual execution for CheckTx mode
 		if mode != runTxModeCheck {
-			msgResult = handler(ctx, msg)
+			msgResult = handler(msgCtx, msg)
+		}
+
+		// each result should contain an action event for the message
+		msgEvents := sdk.Events{
+			sdk.NewEvent(sdk.EventTypeMessage, sdk.NewAttribute(sdk.AttributeKeyAction, msg.Type())),
 		}
+		msgEvents = msgEvents.AppendEvents(msgResult.Events)
 
 		// NOTE: GasWanted is determined by ante handler and GasUsed by the GasMeter.
 
 		// Result.Data must be length prefixed in order to separate each result
 		data = append(data, msgResult.Data...)
-		tags = append(tags, sdk.MakeTag(sdk.TagAction, msg.Type()))
-		tags = append(tags, msgResult.Tags...)
+		events = events.AppendEvents(msgEvents)
 
-		idxLog := sdk.ABCIMessageLog{MsgIndex: uint16(msgIdx), Log: msgResult.Log}
+		idxLog := sdk.ABCIMessageLog{
+			MsgIndex: uint16(msgIdx),
+			Log:      msgResult.Log,
+			Events:   sdk.StringifyEvents(msgEvents),
+		}
 
 		// stop execution and return on first failed message
 		if !msgResult.IsOK() {
@@ -755,7 +824,7 @@ func (app *BaseApp) runMsgs(ctx sdk.Context, msgs []sdk.Msg, mode runTxMode) (re
 		Data:      data,
 		Log:       strings.TrimSpace(string(logJSON)),
 		GasUsed:   ctx.GasMeter().GasConsumed(),
-		Tags:      tags,
+		Events:    events,
 	}
 
 	return result
@@ -857,6 +926,8 @@ func (app *BaseApp) runTx(mode runTxMode, txBytes []byte, tx sdk.Tx) (result sdk
 		return err.Result()
 	}
 
+	anteEvents := sdk.EmptyEvents()
+
 	if app.anteHandler != nil {
 		var anteCtx sdk.Context
 		var msCache sdk.CacheMultiStore
@@ -869,6 +940,7 @@ func (app *BaseApp) runTx(mode runTxMode, txBytes []byte, tx sdk.Tx) (result sdk
 		// writes do not happen if aborted/failed.  This may have some
 		// performance benefits, but it'll be more difficult to get right.
 		anteCtx, msCache = app.cacheTxContext(ctx, txBytes)
+		anteCtx = anteCtx.WithEventManager(sdk.NewEventManager())
 
 		newCtx, result, abort := app.anteHandler(anteCtx, tx, mode == runTxModeSimulate)
 		if !newCtx.IsZero() {
@@ -889,9 +961,11 @@ func (app *BaseApp) runTx(mode runTxMode, txBytes []byte, tx sdk.Tx) (result sdk
 		}
 
 		msCache.Write()
+		anteEvents = anteCtx.EventManager().Events()
 	}
 
 	if mode == runTxModeCheck {
+		result.Events = anteEvents
 		return result
 	}
 
@@ -900,6 +974,7 @@ func (app *BaseApp) runTx(mode runTxMode, txBytes []byte, tx sdk.Tx) (result sdk
 	runMsgCtx, msCache := app.cacheTxContext(ctx, txBytes)
 	result = app.runMsgs(runMsgCtx, msgs, mode)
 	result.GasWanted = gasWanted
+	result.Events = anteEvents.AppendEvents(result.Events)
 
 	if mode == runTxModeSimulate {
 		return result
@@ -923,6 +998,10 @@ func (app *BaseApp) EndBlock(req abci.RequestEndBlock) (res abci.ResponseEndBloc
 		res = app.endBlocker(app.deliverState.ctx, req)
 	}
 
+	if res.ConsensusParamUpdates != nil {
+		app.updateConsensusParams(res.ConsensusParamUpdates)
+	}
+
 	return
 }
 
@@ -130,6 +130,56 @@ func TestLoadVersion(t *testing.T) {
 	testLoadVersionHelper(t, app, int64(2), commitID2)
 }
 
+func TestLoadVersionAndUpgrade(t *testing.T) {
+	logger := defaultLogger()
+	pruningOpt := SetPruning(store.PruneSyncable)
+	db := dbm.NewMemDB()
+	name := t.Name()
+	app := NewBaseApp(name, logger, db, nil, pruningOpt)
+
+	// mount the main store along with a module store
+	capKey := sdk.NewKVStoreKey(MainStoreKey)
+	oldKey := sdk.NewKVStoreKey("old")
+	app.MountStores(capKey, oldKey)
+	err := app.LoadLatestVersion(capKey)
+	require.Nil(t, err)
+
+	header := abci.Header{Height: 1}
+	app.BeginBlock(abci.RequestBeginBlock{Header: header})
+	app.deliverState.ctx.KVStore(oldKey).Set([]byte("foo"), []byte("bar"))
+	app.Commit()
+
+	// reloading without the old store fails
+	newKey := sdk.NewKVStoreKey("new")
+	app = NewBaseApp(name, logger, db, nil, pruningOpt)
+	app.MountStores(capKey, newKey)
+	err = app.LoadLatestVersion(capKey)
+	require.Error(t, err)
+
+	// reload with the old store renamed
+	upgrades := &sdk.StoreUpgrades{
+		Renamed: []sdk.StoreRename{{OldKey: "old", NewKey: "new"}},
+	}
+	app = NewBaseApp(name, logger, db, nil, pruningOpt)
+	app.MountStores(capKey, newKey)
+	err = app.LoadLatestVersionAndUpgrade(capKey, upgrades)
+	require.Nil(t, err)
+	require.Equal(t, int64(1), app.LastBlockHeight())
+
+	header = abci.Header{Height: 2}
+	app.BeginBlock(abci.RequestBeginBlock{Header: header})
+	require.Equal(t, []byte("bar"), app.deliverState.ctx.KVStore(newKey).Get([]byte("foo")))
+	res := app.Commit()
+	commitID2 := sdk.CommitID{Version: 2, Hash: res.Data}
+
+	// the upgrade is persisted
+	app = NewBaseApp(name, logger, db, nil, pruningOpt)
+	app.MountStores(capKey, newKey)
+	err = app.LoadVersion(2, capKey)
+	require.Nil(t, err)
+	testLoadVersionHelper(t, app, int64(2), commitID2)
+}
+
 func TestAppVersionSetterGetter(t *testing.T) {
 	logger := defaultLogger()
 	pruningOpt := SetPruning(store.PruneSyncable)
@@ -1276,3 +1326,43 @@ func TestGetMaximumBlockGas(t *testing.T) {
 	app.setConsensusParams(&abci.ConsensusParams{Block: &abci.BlockParams{MaxGas: -5000000}})
 	require.Panics(t, func() { app.getMaximumBlockGas() })
 }
+
+func TestEndBlockConsensusParamUpdates(t *testing.T) {
+	var updates *abci.ConsensusParams
+	endBlockerOpt := func(bapp *BaseApp) {
+		bapp.SetEndBlocker(func(ctx sdk.Context, req abci.RequestEndBlock) abci.ResponseEndBlock {
+			return abci.ResponseEndBlock{ConsensusParamUpdates: updates}
+		})
+	}
+
+	app := setupBaseApp(t, endBlockerOpt)
+	app.InitChain(abci.RequestInitChain{
+		ConsensusParams: &abci.ConsensusParams{
+			Block:    &abci.BlockParams{MaxBytes: 1000, MaxGas: 100},
+			Evidence: &abci.EvidenceParams{MaxAge: 10},
+		},
+	})
+
+	// no updates leave the consensus params untouched
+	header := abci.Header{Height: 1}
+	app.BeginBlock(abci.RequestBeginBlock{Header: header})
+	app.EndBlock(abci.RequestEndBlock{Height: 1})
+	app.Commit()
+	require.Equal(t, uint64(100), app.getMaximumBlockGas())
+
+	// only the updated groups of params are replaced
+	updates = &abci.ConsensusParams{Block: &abci.BlockParams{MaxBytes: 1000, MaxGas: 200}}
+	header = abci.Header{Height: 2}
+	app.BeginBlock(abci.RequestBeginBlock{Header: header})
+	app.EndBlock(abci.RequestEndBlock{Height: 2})
+	app.Commit()
+	require.Equal(t, uint64(200), app.getMaximumBlockGas())
+	require.Equal(t, int64(10), app.consensusParams.Evidence.MaxAge)
+
+	// updates outside of Tendermint's limits are rejected
+	updates = &abci.ConsensusParams{Block: &abci.BlockParams{MaxBytes: 0, MaxGas: 300}}
+	header = abci.Header{Height: 3}
+	app.BeginBlock(abci.RequestBeginBlock{Header: header})
+	require.Panics(t, func() { app.EndBlock(abci.RequestEndBlock{Height: 3}) })
+	require.Equal(t, uint64(200), app.getMaximumBlockGas())
+}
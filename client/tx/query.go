@@ -19,59 +19,60 @@ import (
 )
 
 const (
-	flagTags  = "tags"
-	flagPage  = "page"
-	flagLimit = "limit"
+	flagEvents = "events"
+	flagPage   = "page"
+	flagLimit  = "limit"
 )
 
 // ----------------------------------------------------------------------------
 // CLI
 // ----------------------------------------------------------------------------
 
-// SearchTxCmd returns a command to search through tagged transactions.
+// SearchTxCmd returns a command to search through transactions by events.
 func SearchTxCmd(cdc *codec.Codec) *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "txs",
-		Short: "Search for paginated transactions that match a set of tags",
+		Short: "Search for paginated transactions that match a set of events",
 		Long: strings.TrimSpace(`
-Search for transactions that match the exact given tags where results are paginated.
+Search for transactions that match the exact given events where results are paginated.
+Each event takes the form of '{eventType}.{eventAttribute}:{value}'.
 
 Example:
-$ <appcli> query txs --tags '<tag1>:<value1>&<tag2>:<value2>' --page 1 --limit 30
+$ <appcli> query txs --events 'message.sender:cosmos1...&message.action:withdraw_delegator_reward' --page 1 --limit 30
 `),
 		RunE: func(cmd *cobra.Command, args []string) error {
-			tagsStr := viper.GetString(flagTags)
-			tagsStr = strings.Trim(tagsStr, "'")
+			eventsStr := viper.GetString(flagEvents)
+			eventsStr = strings.Trim(eventsStr, "'")
 
-			var tags []string
-			if strings.Contains(tagsStr, "&") {
-				tags = strings.Split(tagsStr, "&")
+			var events []string
+			if strings.Contains(eventsStr, "&") {
+				events = strings.Split(eventsStr, "&")
 			} else {
-				tags = append(tags, tagsStr)
+				events = append(events, eventsStr)
 			}
 
-			var tmTags []string
-			for _, tag := range tags {
-				if !strings.Contains(tag, ":") {
-					return fmt.Errorf("%s should be of the format <key>:<value>", tagsStr)
-				} else if strings.Count(tag, ":") > 1 {
-					return fmt.Errorf("%s should only contain one <key>:<value> pair", tagsStr)
+			var tmEvents []string
+			for _, event := range events {
+				if !strings.Contains(event, ":") {
+					return fmt.Errorf("%s should be of the format {eventType}.{eventAttribute}:{value}", eventsStr)
+				} else if strings.Count(event, ":") > 1 {
+					return fmt.Errorf("%s should only contain one {eventType}.{eventAttribute}:{value} pair", eventsStr)
 				}
 
-				keyValue := strings.Split(tag, ":")
+				keyValue := strings.Split(event, ":")
 				if keyValue[0] == types.TxHeightKey {
-					tag = fmt.Sprintf("%s=%s", keyValue[0], keyValue[1])
+					event = fmt.Sprintf("%s=%s", keyValue[0], keyValue[1])
 				} else {
-					tag = fmt.Sprintf("%s='%s'", keyValue[0], keyValue[1])
+					event = fmt.Sprintf("%s='%s'", keyValue[0], keyValue[1])
 				}
-				tmTags = append(tmTags, tag)
+				tmEvents = append(tmEvents, event)
 			}
 
 			page := viper.GetInt(flagPage)
 			limit := viper.GetInt(flagLimit)
 
 			cliCtx := context.NewCLIContext().WithCodec(cdc)
-			txs, err := SearchTxs(cliCtx, cdc, tmTags, page, limit)
+			txs, err := SearchTxs(cliCtx, cdc, tmEvents, page, limit)
 			if err != nil {
 				return err
 			}
@@ -97,10 +98,10 @@ $ <appcli> query txs --tags '<tag1>:<value1>&<tag2>:<value2>' --page 1 --limit 3
 	cmd.Flags().Bool(flags.FlagTrustNode, false, "Trust connected full node (don't verify proofs for responses)")
 	viper.BindPFlag(flags.FlagTrustNode, cmd.Flags().Lookup(flags.FlagTrustNode))
 
-	cmd.Flags().String(flagTags, "", "tag:value list of tags that must match")
+	cmd.Flags().String(flagEvents, "", "list of transaction events in the form of {eventType}.{eventAttribute}:{value}")
 	cmd.Flags().Uint32(flagPage, rest.DefaultPage, "Query a specific page of paginated results")
 	cmd.Flags().Uint32(flagLimit, rest.DefaultLimit, "Query number of transactions results per page returned")
-	cmd.MarkFlagRequired(flagTags)
+	cmd.MarkFlagRequired(flagEvents)
 
 	return cmd
 }
@@ -102,6 +102,41 @@ func appStateFromGenesisFileFn(r *rand.Rand, accs []simulation.Account, genesisT
 }
 
 // TODO refactor out random initialization code to the modules
+// randomVestingPeriods splits the given amount of coins and the given length
+// of time into a random number of vesting periods.
+func randomVestingPeriods(r *rand.Rand, coins sdk.Coins, length int64) auth.Periods {
+	numPeriods := int64(r.Intn(4) + 1)
+	if numPeriods > length {
+		numPeriods = length
+	}
+
+	periods := make(auth.Periods, numPeriods)
+	remainingLength, remainingCoins := length, coins
+	for i := range periods {
+		if int64(i) == numPeriods-1 {
+			periods[i] = auth.Period{Length: remainingLength, Amount: remainingCoins}
+			break
+		}
+
+		// leave at least one second for each of the remaining periods
+		maxLength := remainingLength - (numPeriods - int64(i) - 1)
+		periodLength := int64(simulation.RandIntBetween(r, 1, int(maxLength)+1))
+		var periodCoins sdk.Coins
+		for _, coin := range remainingCoins {
+			amt := simulation.RandomAmount(r, coin.Amount)
+			if !amt.IsZero() {
+				periodCoins = periodCoins.Add(sdk.Coins{sdk.NewCoin(coin.Denom, amt)})
+			}
+		}
+
+		periods[i] = auth.Period{Length: periodLength, Amount: periodCoins}
+		remainingLength -= periodLength
+		remainingCoins = remainingCoins.Sub(periodCoins)
+	}
+
+	return periods
+}
+
 func appStateRandomizedFn(r *rand.Rand, accs []simulation.Account, genesisTimestamp time.Time,
 ) (json.RawMessage, []simulation.Account, string) {
 
@@ -123,7 +158,6 @@ func appStateRandomizedFn(r *rand.Rand, accs []simulation.Account, genesisTimest
 	for i, acc := range accs {
 		coins := sdk.Coins{sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(amount))}
 		bacc := auth.NewBaseAccountWithAddress(acc.Address)
-		bacc.SetCoins(coins)
 
 		var gacc genaccounts.GenesisAccount
 
@@ -149,19 +183,23 @@ func appStateRandomizedFn(r *rand.Rand, accs []simulation.Account, genesisTimest
 				endTime++
 			}
 
-			if r.Intn(100) < 50 {
-				vacc = auth.NewContinuousVestingAccount(&bacc, startTime, endTime)
-			} else {
-				vacc = auth.NewDelayedVestingAccount(&bacc, endTime)
+			switch r.Intn(3) {
+			case 0:
+				vacc = auth.NewContinuousVestingAccount(&bacc, coins, startTime, endTime)
+			case 1:
+				vacc = auth.NewDelayedVestingAccount(&bacc, coins, endTime)
+			default:
+				periods := randomVestingPeriods(r, coins, endTime-startTime)
+				vacc = auth.NewPeriodicVestingAccount(&bacc, startTime, periods)
 			}
 
 			var err error
-			gacc, err = genaccounts.NewGenesisAccountI(vacc)
+			gacc, err = genaccounts.NewGenesisAccountI(vacc, coins)
 			if err != nil {
 				panic(err)
 			}
 		} else {
-			gacc = genaccounts.NewGenesisAccount(&bacc)
+			gacc = genaccounts.NewGenesisAccount(&bacc, coins)
 		}
 
 		genesisAccounts = append(genesisAccounts, gacc)
@@ -170,7 +208,6 @@ func appStateRandomizedFn(r *rand.Rand, accs []simulation.Account, genesisTimest
 	genesisState[genaccounts.ModuleName] = cdc.MustMarshalJSON(genesisAccounts)
 
 	authGenesis := auth.NewGenesisState(
-		nil,
 		auth.NewParams(
 			simulation.ModuleParamSimulator["MaxMemoCharacters"](r).(uint64),
 			simulation.ModuleParamSimulator["TxSigLimit"](r).(uint64),
@@ -182,22 +219,40 @@ func appStateRandomizedFn(r *rand.Rand, accs []simulation.Account, genesisTimest
 	fmt.Printf("Selected randomly generated auth parameters:\n\t%+v\n", authGenesis)
 	genesisState[auth.ModuleName] = cdc.MustMarshalJSON(authGenesis)
 
-	bankGenesis := bank.NewGenesisState(r.Int63n(2) == 0)
+	bankGenesis := bank.NewGenesisState(
+		bank.NewParams(
+			simulation.ModuleParamSimulator["SendEnabled"](r).(bool),
+			[]bank.SendEnabled{
+				bank.NewSendEnabled(sdk.DefaultBondDenom, simulation.ModuleParamSimulator["SendEnabled"](r).(bool)),
+			},
+		),
+	)
 	genesisState[bank.ModuleName] = cdc.MustMarshalJSON(bankGenesis)
 	fmt.Printf("Selected randomly generated bank parameters:\n\t%+v\n", bankGenesis)
 
 	// Random genesis states
 	vp := simulation.ModuleParamSimulator["VotingParams/VotingPeriod"](r).(time.Duration)
+	evp := simulation.ModuleParamSimulator["VotingParams/ExpeditedVotingPeriod"](r).(time.Duration)
+	if evp >= vp {
+		evp = vp / 2
+	}
 	govGenesis := gov.NewGenesisState(
 		uint64(r.Intn(100)),
 		gov.NewDepositParams(
 			simulation.ModuleParamSimulator["DepositParams/MinDeposit"](r).(sdk.Coins),
+			simulation.ModuleParamSimulator["DepositParams/ExpeditedMinDeposit"](r).(sdk.Coins),
 			vp,
+			simulation.ModuleParamSimulator["DepositParams/ProposalCancelRatio"](r).(sdk.Dec),
+			simulation.ModuleParamSimulator["DepositParams/BurnProposalDepositPrevote"](r).(bool),
+			simulation.ModuleParamSimulator["DepositParams/BurnVoteQuorum"](r).(bool),
+			simulation.ModuleParamSimulator["DepositParams/BurnVoteVeto"](r).(bool),
+			simulation.ModuleParamSimulator["DepositParams/BurnToCommunityPool"](r).(bool),
 		),
-		gov.NewVotingParams(vp),
+		gov.NewVotingParams(vp, evp),
 		gov.NewTallyParams(
 			simulation.ModuleParamSimulator["TallyParams/Quorum"](r).(sdk.Dec),
 			simulation.ModuleParamSimulator["TallyParams/Threshold"](r).(sdk.Dec),
+			simulation.ModuleParamSimulator["TallyParams/ExpeditedThreshold"](r).(sdk.Dec),
 			simulation.ModuleParamSimulator["TallyParams/Veto"](r).(sdk.Dec),
 		),
 	)
@@ -205,11 +260,11 @@ func appStateRandomizedFn(r *rand.Rand, accs []simulation.Account, genesisTimest
 	fmt.Printf("Selected randomly generated governance parameters:\n\t%+v\n", govGenesis)
 
 	stakingGenesis := staking.NewGenesisState(
-		staking.InitialPool(),
 		staking.NewParams(
 			simulation.ModuleParamSimulator["UnbondingTime"](r).(time.Duration),
 			simulation.ModuleParamSimulator["MaxValidators"](r).(uint16),
 			7,
+			simulation.ModuleParamSimulator["HistoricalEntries"](r).(uint16),
 			sdk.DefaultBondDenom,
 		),
 		nil,
@@ -260,7 +315,6 @@ func appStateRandomizedFn(r *rand.Rand, accs []simulation.Account, genesisTimest
 		delegations = append(delegations, delegation)
 	}
 
-	stakingGenesis.Pool.NotBondedTokens = sdk.NewInt((amount * numAccs) + (numInitiallyBonded * amount))
 	stakingGenesis.Validators = validators
 	stakingGenesis.Delegations = delegations
 	genesisState[staking.ModuleName] = cdc.MustMarshalJSON(stakingGenesis)
@@ -295,7 +349,7 @@ func appStateFn(r *rand.Rand, accs []simulation.Account, genesisTimestamp time.T
 
 func testAndRunTxs(app *SimApp) []simulation.WeightedOperation {
 	return []simulation.WeightedOperation{
-		{5, authsim.SimulateDeductFee(app.accountKeeper, app.feeCollectionKeeper)},
+		{5, authsim.SimulateDeductFee(app.accountKeeper, app.bankKeeper, app.supplyKeeper)},
 		{100, banksim.SimulateMsgSend(app.accountKeeper, app.bankKeeper)},
 		{10, banksim.SimulateSingleInputMsgMultiSend(app.accountKeeper, app.bankKeeper)},
 		{50, distrsim.SimulateMsgSetWithdrawAddress(app.accountKeeper, app.distrKeeper)},
@@ -305,11 +359,16 @@ func testAndRunTxs(app *SimApp) []simulation.WeightedOperation {
 		{5, govsim.SimulateSubmittingVotingAndSlashingForProposal(app.govKeeper, distrsim.SimulateCommunityPoolSpendProposalContent(app.distrKeeper))},
 		{5, govsim.SimulateSubmittingVotingAndSlashingForProposal(app.govKeeper, paramsim.SimulateParamChangeProposalContent)},
 		{100, govsim.SimulateMsgDeposit(app.govKeeper)},
-		{100, stakingsim.SimulateMsgCreateValidator(app.accountKeeper, app.stakingKeeper)},
+		{20, govsim.SimulateMsgSetRepresentative(app.govKeeper)},
+		{10, govsim.SimulateMsgClearRepresentative(app.govKeeper)},
+		{100, stakingsim.SimulateMsgCreateValidator(app.bankKeeper, app.stakingKeeper)},
 		{5, stakingsim.SimulateMsgEditValidator(app.stakingKeeper)},
-		{100, stakingsim.SimulateMsgDelegate(app.accountKeeper, app.stakingKeeper)},
+		{100, stakingsim.SimulateMsgDelegate(app.bankKeeper, app.stakingKeeper)},
 		{100, stakingsim.SimulateMsgUndelegate(app.accountKeeper, app.stakingKeeper)},
-		{100, stakingsim.SimulateMsgBeginRedelegate(app.accountKeeper, app.stakingKeeper)},
+		{50, stakingsim.SimulateMsgCancelUnbondingDelegation(app.stakingKeeper)},
+		{50, stakingsim.SimulateMsgTokenizeShares(app.stakingKeeper)},
+		{50, stakingsim.SimulateMsgRedeemTokensForShares(app.bankKeeper, app.stakingKeeper)},
+		{100, stakingsim.SimulateMsgBeginRedelegate(app.bankKeeper, app.stakingKeeper)},
 		{100, slashingsim.SimulateMsgUnjail(app.slashingKeeper)},
 	}
 }
@@ -454,6 +513,10 @@ func TestAppImportExport(t *testing.T) {
 	fmt.Printf("Comparing stores...\n")
 	ctxA := app.NewContext(true, abci.Header{})
 
+	// the historical info is not exported, so it is removed from the cached
+	// store of the exporting app before the comparison
+	deleteKVStorePrefix(ctxA.KVStore(app.keyStaking), staking.HistoricalInfoKey)
+
 	type StoreKeysPrefixes struct {
 		A        sdk.StoreKey
 		B        sdk.StoreKey
@@ -463,12 +526,15 @@ func TestAppImportExport(t *testing.T) {
 	storeKeysPrefixes := []StoreKeysPrefixes{
 		{app.keyMain, newApp.keyMain, [][]byte{}},
 		{app.keyAccount, newApp.keyAccount, [][]byte{}},
+		{app.keyBank, newApp.keyBank, [][]byte{}},
 		{app.keyStaking, newApp.keyStaking, [][]byte{staking.UnbondingQueueKey,
 			staking.RedelegationQueueKey, staking.ValidatorQueueKey}}, // ordering may change but it doesn't matter
 		{app.keySlashing, newApp.keySlashing, [][]byte{}},
+		{app.keyEvidence, newApp.keyEvidence, [][]byte{}},
+		{app.keyUpgrade, newApp.keyUpgrade, [][]byte{}},
 		{app.keyMint, newApp.keyMint, [][]byte{}},
 		{app.keyDistr, newApp.keyDistr, [][]byte{}},
-		{app.keyFeeCollection, newApp.keyFeeCollection, [][]byte{}},
+		{app.keySupply, newApp.keySupply, [][]byte{}},
 		{app.keyParams, newApp.keyParams, [][]byte{}},
 		{app.keyGov, newApp.keyGov, [][]byte{}},
 	}
@@ -489,6 +555,20 @@ func TestAppImportExport(t *testing.T) {
 
 }
 
+// delete all the entries of a store under the given prefix
+func deleteKVStorePrefix(store sdk.KVStore, prefix []byte) {
+	iter := sdk.KVStorePrefixIterator(store, prefix)
+	var keys [][]byte
+	for ; iter.Valid(); iter.Next() {
+		keys = append(keys, iter.Key())
+	}
+	iter.Close()
+
+	for _, key := range keys {
+		store.Delete(key)
+	}
+}
+
 func TestAppSimulationAfterImport(t *testing.T) {
 	if !enabled {
 		t.Skip("Skipping application simulation after import")
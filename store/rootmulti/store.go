@@ -35,6 +35,9 @@ type Store struct {
 	stores       map[types.StoreKey]types.CommitStore
 	keysByName   map[string]types.StoreKey
 
+	// stores removed by an upgrade whose deletion is persisted on next commit
+	removedStores map[string]types.CommitStore
+
 	traceWriter  io.Writer
 	traceContext types.TraceContext
 }
@@ -49,6 +52,8 @@ func NewStore(db dbm.DB) *Store {
 		storesParams: make(map[types.StoreKey]storeParams),
 		stores:       make(map[types.StoreKey]types.CommitStore),
 		keysByName:   make(map[string]types.StoreKey),
+
+		removedStores: make(map[string]types.CommitStore),
 	}
 }
 
@@ -97,62 +102,149 @@ func (rs *Store) GetCommitKVStore(key types.StoreKey) types.CommitKVStore {
 // Implements CommitMultiStore.
 func (rs *Store) LoadLatestVersion() error {
 	ver := getLatestVersion(rs.db)
-	return rs.LoadVersion(ver)
+	return rs.loadVersion(ver, nil)
+}
+
+// Implements CommitMultiStore.
+func (rs *Store) LoadLatestVersionAndUpgrade(upgrades *types.StoreUpgrades) error {
+	ver := getLatestVersion(rs.db)
+	return rs.loadVersion(ver, upgrades)
 }
 
 // Implements CommitMultiStore.
 func (rs *Store) LoadVersion(ver int64) error {
-	if ver == 0 {
-		// Special logic for version 0 where there is no need to get commit
-		// information.
-		for key, storeParams := range rs.storesParams {
-			store, err := rs.loadCommitStoreFromParams(key, types.CommitID{}, storeParams)
-			if err != nil {
-				return fmt.Errorf("failed to load Store: %v", err)
-			}
+	return rs.loadVersion(ver, nil)
+}
+
+// Implements CommitMultiStore.
+func (rs *Store) LoadVersionAndUpgrade(ver int64, upgrades *types.StoreUpgrades) error {
+	return rs.loadVersion(ver, upgrades)
+}
+
+func (rs *Store) loadVersion(ver int64, upgrades *types.StoreUpgrades) error {
+	infos := make(map[string]storeInfo)
+	var lastCommitID types.CommitID
 
-			rs.stores[key] = store
+	// Special logic for version 0 where there is no need to get commit
+	// information.
+	if ver != 0 {
+		cInfo, err := getCommitInfo(rs.db, ver)
+		if err != nil {
+			return err
 		}
 
-		rs.lastCommitID = types.CommitID{}
-		return nil
-	}
+		// convert StoreInfos slice to map
+		for _, storeInfo := range cInfo.StoreInfos {
+			infos[storeInfo.Name] = storeInfo
+		}
 
-	cInfo, err := getCommitInfo(rs.db, ver)
-	if err != nil {
-		return err
+		lastCommitID = cInfo.CommitID()
 	}
 
-	// convert StoreInfos slice to map
-	infos := make(map[types.StoreKey]storeInfo)
-	for _, storeInfo := range cInfo.StoreInfos {
-		infos[rs.nameToKey(storeInfo.Name)] = storeInfo
+	if err := rs.validateUpgrades(ver, infos, upgrades); err != nil {
+		return err
 	}
 
 	// load each Store
 	var newStores = make(map[types.StoreKey]types.CommitStore)
 	for key, storeParams := range rs.storesParams {
-		var id types.CommitID
+		store, err := rs.loadCommitStoreFromParams(key, getCommitID(infos, key.Name()), storeParams)
+		if err != nil {
+			return fmt.Errorf("failed to load Store: %v", err)
+		}
+
+		// Move all data from the old store if it was renamed and the rename
+		// has not been committed yet.
+		if oldName := upgrades.RenamedFrom(key.Name()); oldName != "" {
+			if _, ok := infos[oldName]; ok {
+				oldParams := storeParams
+				oldParams.key = types.NewKVStoreKey(oldName)
+
+				oldStore, err := rs.loadCommitStoreFromParams(oldParams.key, getCommitID(infos, oldName), oldParams)
+				if err != nil {
+					return fmt.Errorf("failed to load old Store %s: %v", oldName, err)
+				}
+
+				moveKVStoreData(oldStore.(types.KVStore), store.(types.KVStore))
+				rs.removedStores[oldName] = oldStore
+			}
+		}
+
+		newStores[key] = store
+	}
 
-		info, ok := infos[key]
-		if ok {
-			id = info.Core.CommitID
+	// Remove all data from deleted stores that still exist at this version.
+	for name := range infos {
+		if !upgrades.IsDeleted(name) {
+			continue
 		}
 
-		store, err := rs.loadCommitStoreFromParams(key, id, storeParams)
+		params := storeParams{key: types.NewKVStoreKey(name), typ: types.StoreTypeIAVL}
+		store, err := rs.loadCommitStoreFromParams(params.key, getCommitID(infos, name), params)
 		if err != nil {
-			return fmt.Errorf("failed to load Store: %v", err)
+			return fmt.Errorf("failed to load deleted Store %s: %v", name, err)
 		}
 
-		newStores[key] = store
+		deleteKVStore(store.(types.KVStore))
+		rs.removedStores[name] = store
 	}
 
-	rs.lastCommitID = cInfo.CommitID()
+	rs.lastCommitID = lastCommitID
 	rs.stores = newStores
 
 	return nil
 }
 
+// validateUpgrades ensures that every store persisted at the given version is
+// either still mounted or explicitly removed by the upgrades, and that the
+// upgrades are consistent with the mounted stores. Upgrades that were already
+// committed are accepted so that loading remains idempotent.
+func (rs *Store) validateUpgrades(ver int64, infos map[string]storeInfo, upgrades *types.StoreUpgrades) error {
+	for name := range infos {
+		if _, ok := rs.keysByName[name]; ok {
+			continue
+		}
+		if upgrades.IsDeleted(name) || upgrades.IsRenamedAway(name) {
+			continue
+		}
+
+		return fmt.Errorf("store %s exists at version %d but is not mounted", name, ver)
+	}
+
+	if upgrades == nil {
+		return nil
+	}
+
+	for _, name := range upgrades.Added {
+		if _, ok := rs.keysByName[name]; !ok {
+			return fmt.Errorf("added store %s is not mounted", name)
+		}
+	}
+
+	for _, rename := range upgrades.Renamed {
+		if _, ok := rs.keysByName[rename.NewKey]; !ok {
+			return fmt.Errorf("renamed store %s is not mounted", rename.NewKey)
+		}
+		if _, ok := rs.keysByName[rename.OldKey]; ok {
+			return fmt.Errorf("store %s cannot be renamed while still mounted", rename.OldKey)
+		}
+
+		_, oldExists := infos[rename.OldKey]
+		_, newExists := infos[rename.NewKey]
+		if oldExists && newExists {
+			return fmt.Errorf("cannot rename store %s to %s: both stores exist at version %d", rename.OldKey, rename.NewKey, ver)
+		}
+	}
+
+	for _, name := range upgrades.Deleted {
+		if _, ok := rs.keysByName[name]; ok {
+			return fmt.Errorf("store %s cannot be deleted while still mounted", name)
+		}
+	}
+
+	return nil
+}
+
 // SetTracer sets the tracer for the MultiStore that the underlying
 // stores will utilize to trace operations. A MultiStore is returned.
 func (rs *Store) SetTracer(w io.Writer) types.MultiStore {
@@ -196,6 +288,13 @@ func (rs *Store) Commit() types.CommitID {
 	version := rs.lastCommitID.Version + 1
 	commitInfo := commitStores(version, rs.stores)
 
+	// Persist the removal of stores deleted or renamed by an upgrade. They are
+	// no longer part of the commit info from this version on.
+	for name, store := range rs.removedStores {
+		store.Commit()
+		delete(rs.removedStores, name)
+	}
+
 	// Need to update atomically.
 	batch := rs.db.NewBatch()
 	defer batch.Close()
@@ -407,15 +506,6 @@ func (rs *Store) loadCommitStoreFromParams(key types.StoreKey, id types.CommitID
 	}
 }
 
-func (rs *Store) nameToKey(name string) types.StoreKey {
-	for key := range rs.storesParams {
-		if key.Name() == name {
-			return key
-		}
-	}
-	panic("Unknown name " + name)
-}
-
 //----------------------------------------
 // storeParams
 
@@ -492,6 +582,45 @@ func (si storeInfo) Hash() []byte {
 //----------------------------------------
 // Misc.
 
+// getCommitID returns the CommitID of the named store in the given infos, or
+// an empty CommitID if the store was not persisted.
+func getCommitID(infos map[string]storeInfo, name string) types.CommitID {
+	info, ok := infos[name]
+	if !ok {
+		return types.CommitID{}
+	}
+
+	return info.Core.CommitID
+}
+
+// deleteKVStore removes all data from the given store.
+func deleteKVStore(kv types.KVStore) {
+	// Note that we cannot write while iterating, so load all keys here and
+	// delete them below.
+	var keys [][]byte
+	itr := kv.Iterator(nil, nil)
+	for ; itr.Valid(); itr.Next() {
+		keys = append(keys, itr.Key())
+	}
+	itr.Close()
+
+	for _, k := range keys {
+		kv.Delete(k)
+	}
+}
+
+// moveKVStoreData copies all data from oldDB to newDB and then removes it from
+// oldDB.
+func moveKVStoreData(oldDB types.KVStore, newDB types.KVStore) {
+	itr := oldDB.Iterator(nil, nil)
+	for ; itr.Valid(); itr.Next() {
+		newDB.Set(itr.Key(), itr.Value())
+	}
+	itr.Close()
+
+	deleteKVStore(oldDB)
+}
+
 func getLatestVersion(db dbm.DB) int64 {
 	var latest int64
 	latestBytes := db.Get([]byte(latestVersionKey))
@@ -140,6 +140,106 @@ func TestMultistoreCommitLoad(t *testing.T) {
 	checkStore(t, store, commitID, commitID)
 }
 
+func TestMultistoreLoadWithUpgrade(t *testing.T) {
+	var db dbm.DB = dbm.NewMemDB()
+	if useDebugDB {
+		db = dbm.NewDebugDB("CMS", db)
+	}
+	store := newMultiStoreWithMounts(db)
+	err := store.LoadLatestVersion()
+	require.Nil(t, err)
+
+	// write some data in all stores
+	k1, v1 := []byte("first"), []byte("store")
+	s1, _ := store.getStoreByName("store1").(types.KVStore)
+	require.NotNil(t, s1)
+	s1.Set(k1, v1)
+
+	k2, v2 := []byte("second"), []byte("restore")
+	s2, _ := store.getStoreByName("store2").(types.KVStore)
+	require.NotNil(t, s2)
+	s2.Set(k2, v2)
+
+	k3, v3 := []byte("third"), []byte("dropped")
+	s3, _ := store.getStoreByName("store3").(types.KVStore)
+	require.NotNil(t, s3)
+	s3.Set(k3, v3)
+
+	commitID := store.Commit()
+	require.Equal(t, int64(1), commitID.Version)
+
+	// loading with a mounted set that no longer matches the persisted stores
+	// fails unless the missing stores are explicitly upgraded
+	restore := newMultiStoreWithModifiedMounts(db)
+	require.Error(t, restore.LoadLatestVersion())
+
+	// mounting a store that should be removed fails
+	restore = newMultiStoreWithMounts(db)
+	require.Error(t, restore.LoadLatestVersionAndUpgrade(&types.StoreUpgrades{
+		Deleted: []string{"store3"},
+	}))
+
+	// now, let's load with upgrades...
+	restore = newMultiStoreWithModifiedMounts(db)
+	err = restore.LoadLatestVersionAndUpgrade(upgrades)
+	require.Nil(t, err)
+
+	// s1 was not changed
+	s1, _ = restore.getStoreByName("store1").(types.KVStore)
+	require.NotNil(t, s1)
+	require.Equal(t, v1, s1.Get(k1))
+
+	// store2 is no longer mounted
+	require.Nil(t, restore.getStoreByName("store2"))
+
+	// restore2 has the old data
+	rs2, _ := restore.getStoreByName("restore2").(types.KVStore)
+	require.NotNil(t, rs2)
+	require.Equal(t, v2, rs2.Get(k2))
+
+	// store4 is mounted and empty
+	s4, _ := restore.getStoreByName("store4").(types.KVStore)
+	require.NotNil(t, s4)
+	require.Nil(t, s4.Get(k1))
+
+	// store this data
+	k4, v4 := []byte("fourth"), []byte("created")
+	s4.Set(k4, v4)
+	migratedID := restore.Commit()
+	require.Equal(t, int64(2), migratedID.Version)
+
+	// the removed stores are no longer part of the commit info
+	cInfo, err := getCommitInfo(db, migratedID.Version)
+	require.NoError(t, err)
+	require.Len(t, cInfo.StoreInfos, 3)
+	for _, info := range cInfo.StoreInfos {
+		require.NotEqual(t, "store2", info.Name)
+		require.NotEqual(t, "store3", info.Name)
+	}
+
+	// loading again with the same upgrades is a no-op
+	reload := newMultiStoreWithModifiedMounts(db)
+	err = reload.LoadLatestVersionAndUpgrade(upgrades)
+	require.Nil(t, err)
+	require.Equal(t, migratedID, reload.LastCommitID())
+
+	// as is loading without the upgrades once they are committed
+	reload = newMultiStoreWithModifiedMounts(db)
+	err = reload.LoadLatestVersion()
+	require.Nil(t, err)
+	require.Equal(t, migratedID, reload.LastCommitID())
+
+	// query this new store
+	rl4, _ := reload.getStoreByName("store4").(types.KVStore)
+	require.NotNil(t, rl4)
+	require.Equal(t, v4, rl4.Get(k4))
+
+	// the renamed data was persisted
+	rl2, _ := reload.getStoreByName("restore2").(types.KVStore)
+	require.NotNil(t, rl2)
+	require.Equal(t, v2, rl2.Get(k2))
+}
+
 func TestParsePath(t *testing.T) {
 	_, _, err := parsePath("foo")
 	require.Error(t, err)
@@ -246,6 +346,28 @@ func newMultiStoreWithMounts(db dbm.DB) *Store {
 	return store
 }
 
+// upgrades matching newMultiStoreWithModifiedMounts
+var upgrades = &types.StoreUpgrades{
+	Added: []string{"store4"},
+	Renamed: []types.StoreRename{{
+		OldKey: "store2",
+		NewKey: "restore2",
+	}},
+	Deleted: []string{"store3"},
+}
+
+func newMultiStoreWithModifiedMounts(db dbm.DB) *Store {
+	store := NewStore(db)
+	store.pruningOpts = types.PruneSyncable
+	store.MountStoreWithDB(
+		types.NewKVStoreKey("store1"), types.StoreTypeIAVL, nil)
+	store.MountStoreWithDB(
+		types.NewKVStoreKey("restore2"), types.StoreTypeIAVL, nil)
+	store.MountStoreWithDB(
+		types.NewKVStoreKey("store4"), types.StoreTypeIAVL, nil)
+	return store
+}
+
 func checkStore(t *testing.T, store *Store, expect, got types.CommitID) {
 	require.Equal(t, expect, got)
 	require.Equal(t, expect, store.LastCommitID())
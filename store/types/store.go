@@ -99,6 +99,16 @@ type CommitMultiStore interface {
 	// must be idempotent (return the same commit id). Otherwise the behavior is
 	// undefined.
 	LoadVersion(ver int64) error
+
+	// LoadLatestVersionAndUpgrade will load the latest version, but also
+	// add/rename/delete sub-stores as described by the given upgrades. The
+	// resulting changes are persisted on the next Commit.
+	LoadLatestVersionAndUpgrade(upgrades *StoreUpgrades) error
+
+	// LoadVersionAndUpgrade will load the named version, but also
+	// add/rename/delete sub-stores as described by the given upgrades. The
+	// resulting changes are persisted on the next Commit.
+	LoadVersionAndUpgrade(ver int64, upgrades *StoreUpgrades) error
 }
 
 //---------subsp-------------------------------
@@ -0,0 +1,79 @@
+package types
+
+// StoreUpgrades defines a series of transformations to apply to the
+// multistore db upon load. It allows sub-stores to be added, renamed or
+// deleted between two versions of an application without requiring a genesis
+// export/import.
+type StoreUpgrades struct {
+	// Added lists the names of newly mounted stores, which start out as empty
+	// trees. Every added name must be mounted.
+	Added []string `json:"added"`
+
+	// Renamed lists the stores whose data must be moved to a new name. The
+	// new name must be mounted while the old one must not.
+	Renamed []StoreRename `json:"renamed"`
+
+	// Deleted lists the names of stores whose data must be removed. The
+	// deleted names must not be mounted.
+	Deleted []string `json:"deleted"`
+}
+
+// StoreRename defines a name change of a sub-store. All data previously under
+// a PrefixStore with OldKey will be moved to a PrefixStore with NewKey.
+type StoreRename struct {
+	OldKey string `json:"old_key"`
+	NewKey string `json:"new_key"`
+}
+
+// IsAdded returns true if the given key should be added.
+func (s *StoreUpgrades) IsAdded(key string) bool {
+	if s == nil {
+		return false
+	}
+	for _, added := range s.Added {
+		if key == added {
+			return true
+		}
+	}
+	return false
+}
+
+// IsDeleted returns true if the given key should be deleted.
+func (s *StoreUpgrades) IsDeleted(key string) bool {
+	if s == nil {
+		return false
+	}
+	for _, d := range s.Deleted {
+		if d == key {
+			return true
+		}
+	}
+	return false
+}
+
+// RenamedFrom returns the oldKey if it was renamed, or an empty string if it
+// was not renamed.
+func (s *StoreUpgrades) RenamedFrom(key string) string {
+	if s == nil {
+		return ""
+	}
+	for _, re := range s.Renamed {
+		if re.NewKey == key {
+			return re.OldKey
+		}
+	}
+	return ""
+}
+
+// IsRenamedAway returns true if the given key was renamed to another key.
+func (s *StoreUpgrades) IsRenamedAway(key string) bool {
+	if s == nil {
+		return false
+	}
+	for _, re := range s.Renamed {
+		if re.OldKey == key {
+			return true
+		}
+	}
+	return false
+}
@@ -21,7 +21,9 @@ func KVStoreReversePrefixIterator(kvs KVStore, prefix []byte) Iterator {
 // value comparison for a set of provided prefixes
 func DiffKVStores(a KVStore, b KVStore, prefixesToSkip [][]byte) (kvA cmn.KVPair, kvB cmn.KVPair, count int64, equal bool) {
 	iterA := a.Iterator(nil, nil)
+	defer iterA.Close()
 	iterB := b.Iterator(nil, nil)
+	defer iterB.Close()
 	count = int64(0)
 	for {
 		if !iterA.Valid() && !iterB.Valid() {
@@ -0,0 +1,123 @@
+package types_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	cmn "github.com/tendermint/tendermint/libs/common"
+	dbm "github.com/tendermint/tendermint/libs/db"
+
+	"github.com/cosmos/cosmos-sdk/store/dbadapter"
+	"github.com/cosmos/cosmos-sdk/store/types"
+)
+
+func newKVStore(pairs []cmn.KVPair) types.KVStore {
+	store := dbadapter.Store{DB: dbm.NewMemDB()}
+	for _, pair := range pairs {
+		store.Set(pair.Key, pair.Value)
+	}
+	return store
+}
+
+func TestDiffKVStores(t *testing.T) {
+	kv := func(key, value string) cmn.KVPair {
+		return cmn.KVPair{Key: []byte(key), Value: []byte(value)}
+	}
+	skip := [][]byte{[]byte("s/")}
+
+	cases := []struct {
+		name     string
+		a, b     []cmn.KVPair
+		prefixes [][]byte
+		equal    bool
+		count    int64
+		kvA, kvB cmn.KVPair
+	}{
+		{
+			name:  "empty stores",
+			equal: true,
+		},
+		{
+			name:  "equal stores",
+			a:     []cmn.KVPair{kv("a", "1"), kv("b", "2")},
+			b:     []cmn.KVPair{kv("a", "1"), kv("b", "2")},
+			equal: true,
+			count: 2,
+		},
+		{
+			name:  "value mismatch",
+			a:     []cmn.KVPair{kv("a", "1"), kv("b", "2")},
+			b:     []cmn.KVPair{kv("a", "1"), kv("b", "3")},
+			count: 1,
+			kvA:   kv("b", "2"),
+			kvB:   kv("b", "3"),
+		},
+		{
+			name:  "key mismatch",
+			a:     []cmn.KVPair{kv("a", "1"), kv("b", "2")},
+			b:     []cmn.KVPair{kv("a", "1"), kv("c", "2")},
+			count: 1,
+			kvA:   kv("b", "2"),
+			kvB:   kv("c", "2"),
+		},
+		{
+			name:  "extra entry in the first store",
+			a:     []cmn.KVPair{kv("a", "1"), kv("b", "2")},
+			b:     []cmn.KVPair{kv("a", "1")},
+			count: 1,
+			kvA:   kv("b", "2"),
+		},
+		{
+			name:  "extra entry in the second store",
+			a:     []cmn.KVPair{kv("a", "1")},
+			b:     []cmn.KVPair{kv("a", "1"), kv("b", "2")},
+			count: 1,
+			kvB:   kv("b", "2"),
+		},
+		{
+			name:     "skipped values differ",
+			a:        []cmn.KVPair{kv("a", "1"), kv("s/1", "x"), kv("z", "2")},
+			b:        []cmn.KVPair{kv("a", "1"), kv("s/1", "y"), kv("z", "2")},
+			prefixes: skip,
+			equal:    true,
+			count:    3,
+		},
+		{
+			name:     "keys under skipped prefixes are still compared",
+			a:        []cmn.KVPair{kv("a", "1"), kv("s/1", "x"), kv("z", "2")},
+			b:        []cmn.KVPair{kv("a", "1"), kv("s/3", "x"), kv("z", "2")},
+			prefixes: skip,
+			count:    1,
+			kvA:      kv("s/1", "x"),
+			kvB:      kv("s/3", "x"),
+		},
+		{
+			name:     "entries under skipped prefixes are still counted",
+			a:        []cmn.KVPair{kv("a", "1"), kv("s/1", "x")},
+			b:        []cmn.KVPair{kv("a", "1")},
+			prefixes: skip,
+			count:    1,
+			kvA:      kv("s/1", "x"),
+		},
+		{
+			name:     "mismatch after skipped values",
+			a:        []cmn.KVPair{kv("a", "1"), kv("s/1", "x"), kv("z", "2")},
+			b:        []cmn.KVPair{kv("a", "1"), kv("s/1", "y"), kv("z", "3")},
+			prefixes: skip,
+			count:    2,
+			kvA:      kv("z", "2"),
+			kvB:      kv("z", "3"),
+		},
+	}
+
+	for _, tc := range cases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			kvA, kvB, count, equal := types.DiffKVStores(newKVStore(tc.a), newKVStore(tc.b), tc.prefixes)
+			require.Equal(t, tc.equal, equal)
+			require.Equal(t, tc.count, count)
+			require.Equal(t, tc.kvA, kvA)
+			require.Equal(t, tc.kvB, kvB)
+		})
+	}
+}
@@ -592,6 +592,11 @@ func validateDenom(denom string) error {
 	return nil
 }
 
+// ValidateDenom validates a coin denomination.
+func ValidateDenom(denom string) error {
+	return validateDenom(denom)
+}
+
 func mustValidateDenom(denom string) {
 	if err := validateDenom(denom); err != nil {
 		panic(err)
@@ -52,6 +52,7 @@ func NewContext(ms MultiStore, header abci.Header, isCheckTx bool, logger log.Lo
 	c = c.WithGasMeter(stypes.NewInfiniteGasMeter())
 	c = c.WithMinGasPrices(DecCoins{})
 	c = c.WithConsensusParams(nil)
+	c = c.WithEventManager(NewEventManager())
 	return c
 }
 
@@ -146,6 +147,7 @@ const (
 	contextKeyBlockGasMeter
 	contextKeyMinGasPrices
 	contextKeyConsensusParams
+	contextKeyEventManager
 )
 
 func (c Context) MultiStore() MultiStore {
@@ -178,6 +180,10 @@ func (c Context) ConsensusParams() *abci.ConsensusParams {
 	return c.Value(contextKeyConsensusParams).(*abci.ConsensusParams)
 }
 
+func (c Context) EventManager() *EventManager {
+	return c.Value(contextKeyEventManager).(*EventManager)
+}
+
 func (c Context) WithMultiStore(ms MultiStore) Context {
 	return c.withValue(contextKeyMultiStore, ms)
 }
@@ -233,6 +239,10 @@ func (c Context) WithConsensusParams(params *abci.ConsensusParams) Context {
 	return c.withValue(contextKeyConsensusParams, params)
 }
 
+func (c Context) WithEventManager(em *EventManager) Context {
+	return c.withValue(contextKeyEventManager, em)
+}
+
 // Cache the multistore and return a new cached context. The cached context is
 // written to the context when writeCache is called.
 func (c Context) CacheContext() (cc Context, writeCache func()) {
@@ -0,0 +1,70 @@
+package types
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	cmn "github.com/tendermint/tendermint/libs/common"
+)
+
+func TestAppendEvents(t *testing.T) {
+	e1 := NewEvent("transfer", NewAttribute("sender", "foo"))
+	e2 := NewEvent("transfer", NewAttribute("sender", "bar"))
+	a := Events{e1}
+	b := Events{e2}
+	c := a.AppendEvents(b)
+	require.Equal(t, c, Events{e1, e2})
+	require.Equal(t, c, Events{e1}.AppendEvent(NewEvent("transfer", NewAttribute("sender", "bar"))))
+}
+
+func TestAppendAttributes(t *testing.T) {
+	e := NewEvent("transfer", NewAttribute("sender", "foo"))
+	e = e.AppendAttributes(NewAttribute("recipient", "bar"))
+	require.Len(t, e.Attributes, 2)
+	require.Equal(t, e.Attributes[1], cmn.KVPair{Key: []byte("recipient"), Value: []byte("bar")})
+}
+
+func TestEmptyEvents(t *testing.T) {
+	em := NewEventManager()
+	require.Equal(t, EmptyEvents(), em.Events())
+	require.Empty(t, em.ABCITags())
+}
+
+func TestEventManager(t *testing.T) {
+	em := NewEventManager()
+	event := NewEvent("reward", NewAttribute("x", "y"))
+	events := Events{NewEvent("transfer", NewAttribute("sender", "foo"))}
+
+	em.EmitEvents(events)
+	em.EmitEvent(event)
+
+	require.Len(t, em.Events(), 2)
+	require.Equal(t, em.Events(), events.AppendEvent(event))
+}
+
+func TestToABCITags(t *testing.T) {
+	events := Events{
+		NewEvent("message", NewAttribute("action", "send"), NewAttribute("sender", "foo")),
+		NewEvent("transfer", NewAttribute("recipient", "bar")),
+	}
+
+	expected := []cmn.KVPair{
+		{Key: []byte("message.action"), Value: []byte("send")},
+		{Key: []byte("message.sender"), Value: []byte("foo")},
+		{Key: []byte("transfer.recipient"), Value: []byte("bar")},
+	}
+	require.Equal(t, expected, events.ToABCITags())
+}
+
+func TestStringifyEvents(t *testing.T) {
+	events := Events{
+		NewEvent("message", NewAttribute("sender", "foo")),
+		NewEvent("message", NewAttribute("module", "bank")),
+	}
+
+	expected := StringEvents{
+		{Type: "message", Attributes: []Attribute{{"sender", "foo"}, {"module", "bank"}}},
+	}
+	require.Equal(t, expected, StringifyEvents(events))
+	require.Equal(t, expected, StringifyABCITags(events.ToABCITags()))
+}
@@ -6,3 +6,95 @@ type Handler func(ctx Context, msg Msg) Result
 // AnteHandler authenticates transactions, before their internal messages are handled.
 // If newCtx.IsZero(), ctx is used instead.
 type AnteHandler func(ctx Context, tx Tx, simulate bool) (newCtx Context, result Result, abort bool)
+
+// AnteDecorator wraps the next AnteHandler to perform custom pre- and
+// post-processing. A decorator aborts the chain by returning without calling
+// next.
+type AnteDecorator interface {
+	AnteHandle(ctx Context, tx Tx, simulate bool, next AnteHandler) (newCtx Context, result Result, abort bool)
+}
+
+// ChainAnteDecorators chains AnteDecorators together with each AnteDecorator
+// wrapping over the decorators further along the chain and returns a single
+// AnteHandler.
+//
+// NOTE: The first element is the outermost decorator, while the last element
+// is the innermost decorator. A Terminator is appended to the chain if it
+// does not already end with one, so the last decorator can safely call next.
+func ChainAnteDecorators(chain ...AnteDecorator) AnteHandler {
+	if len(chain) == 0 {
+		return nil
+	}
+
+	// handle non-terminated decorators chain
+	if (chain[len(chain)-1] != Terminator{}) {
+		chain = append(chain, Terminator{})
+	}
+
+	return func(ctx Context, tx Tx, simulate bool) (Context, Result, bool) {
+		return chain[0].AnteHandle(ctx, tx, simulate, ChainAnteDecorators(chain[1:]...))
+	}
+}
+
+// Terminator AnteDecorator will get added to the chain to simplify decorator
+// code. Don't need to check if next == nil further up the chain.
+type Terminator struct{}
+
+// AnteHandle returns the provided Context and an OK Result without calling
+// next, ending the chain.
+func (t Terminator) AnteHandle(ctx Context, _ Tx, _ bool, _ AnteHandler) (Context, Result, bool) {
+	return ctx, Result{}, false
+}
+
+// DispatchMsgs executes the messages in order through the handlers registered
+// on the router, as if they had been signed by their signers. It is meant for
+// modules executing messages on behalf of an account, such as a module or a
+// group account. The caller is responsible for discarding the state changes if
+// a message fails.
+//
+// Each message is run with a fresh event manager so that the events it emits
+// remain associated with it. The events of each message are returned after a
+// message event holding its action, and after the events returned by
+// beforeMsg. beforeMsg may be nil; it is called before running each message
+// and prevents the message, and all the following ones, from running if it
+// fails.
+//
+// The execution stops at the first failing message, whose index is returned
+// along with its result. The returned index is -1 if all the messages succeed.
+func DispatchMsgs(
+	ctx Context, router Router, msgs []Msg, beforeMsg func(ctx Context, msg Msg) (Events, Error),
+) (Result, int) {
+
+	var data []byte
+	events := EmptyEvents()
+
+	for i, msg := range msgs {
+		var msgEvents Events
+		if beforeMsg != nil {
+			var err Error
+			if msgEvents, err = beforeMsg(ctx, msg); err != nil {
+				return err.Result(), i
+			}
+		}
+
+		handler := router.Route(msg.Route())
+		if handler == nil {
+			return ErrUnknownRequest("unrecognized message type: " + msg.Route()).Result(), i
+		}
+
+		msgCtx := ctx.WithEventManager(NewEventManager())
+		res := handler(msgCtx, msg)
+		if !res.IsOK() {
+			return res, i
+		}
+
+		events = events.AppendEvent(
+			NewEvent(EventTypeMessage, NewAttribute(AttributeKeyAction, msg.Type())),
+		)
+		events = events.AppendEvents(msgEvents)
+		events = events.AppendEvents(res.Events)
+		data = append(data, res.Data...)
+	}
+
+	return Result{Data: data, Events: events}, -1
+}
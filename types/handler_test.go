@@ -0,0 +1,124 @@
+package types
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
+	"github.com/tendermint/tendermint/libs/log"
+)
+
+type orderDecorator struct {
+	id    int
+	calls *[]int
+	abort bool
+}
+
+func (od orderDecorator) AnteHandle(ctx Context, tx Tx, simulate bool, next AnteHandler) (Context, Result, bool) {
+	*od.calls = append(*od.calls, od.id)
+	if od.abort {
+		return ctx, ErrUnauthorized("aborted").Result(), true
+	}
+
+	return next(ctx, tx, simulate)
+}
+
+func TestChainAnteDecorators(t *testing.T) {
+	require.Nil(t, ChainAnteDecorators())
+
+	var calls []int
+	handler := ChainAnteDecorators(
+		orderDecorator{id: 1, calls: &calls},
+		orderDecorator{id: 2, calls: &calls},
+		orderDecorator{id: 3, calls: &calls},
+	)
+
+	_, res, abort := handler(Context{}, nil, false)
+	require.False(t, abort)
+	require.True(t, res.IsOK())
+	require.Equal(t, []int{1, 2, 3}, calls)
+
+	// an explicitly terminated chain behaves the same
+	calls = nil
+	handler = ChainAnteDecorators(orderDecorator{id: 1, calls: &calls}, Terminator{})
+
+	_, res, abort = handler(Context{}, nil, false)
+	require.False(t, abort)
+	require.True(t, res.IsOK())
+	require.Equal(t, []int{1}, calls)
+
+	// decorators past the one aborting are not called
+	calls = nil
+	handler = ChainAnteDecorators(
+		orderDecorator{id: 1, calls: &calls},
+		orderDecorator{id: 2, calls: &calls, abort: true},
+		orderDecorator{id: 3, calls: &calls},
+	)
+
+	_, res, abort = handler(Context{}, nil, false)
+	require.True(t, abort)
+	require.Equal(t, CodeUnauthorized, res.Code)
+	require.Equal(t, []int{1, 2}, calls)
+}
+
+// testRouter is a Router routing every message to the same handler.
+type testRouter struct {
+	handler Handler
+}
+
+func (tr testRouter) AddRoute(string, Handler) Router { return tr }
+func (tr testRouter) Route(string) Handler            { return tr.handler }
+
+func TestDispatchMsgs(t *testing.T) {
+	ctx := NewContext(nil, abci.Header{}, false, log.NewNopLogger())
+	msgs := []Msg{NewTestMsg(), NewTestMsg(), NewTestMsg()}
+
+	var calls int
+	router := testRouter{func(ctx Context, msg Msg) Result {
+		calls++
+		if calls == 3 {
+			return ErrUnauthorized("failed").Result()
+		}
+
+		ctx.EventManager().EmitEvent(NewEvent("handled"))
+		return Result{Data: []byte{byte(calls)}, Events: ctx.EventManager().Events()}
+	}}
+	beforeMsg := func(Context, Msg) (Events, Error) {
+		return Events{NewEvent("before")}, nil
+	}
+
+	// the events of each message follow its message event
+	res, failed := DispatchMsgs(ctx, router, msgs[:2], beforeMsg)
+	require.True(t, res.IsOK())
+	require.Equal(t, -1, failed)
+	require.Equal(t, []byte{1, 2}, res.Data)
+	require.Len(t, res.Events, 6)
+	for i := 0; i < 2; i++ {
+		require.Equal(t, EventTypeMessage, res.Events[3*i].Type)
+		require.Equal(t, "before", res.Events[3*i+1].Type)
+		require.Equal(t, "handled", res.Events[3*i+2].Type)
+	}
+
+	// the messages are not emitted on the event manager of the context
+	require.Empty(t, ctx.EventManager().Events())
+
+	// the execution stops at the first failing message
+	calls = 0
+	res, failed = DispatchMsgs(ctx, router, msgs, nil)
+	require.Equal(t, CodeUnauthorized, res.Code)
+	require.Equal(t, 2, failed)
+
+	// a failing beforeMsg prevents the message from running
+	calls = 0
+	res, failed = DispatchMsgs(ctx, router, msgs, func(Context, Msg) (Events, Error) {
+		return nil, ErrInvalidAddress("rejected")
+	})
+	require.Equal(t, CodeInvalidAddress, res.Code)
+	require.Equal(t, 0, failed)
+	require.Equal(t, 0, calls)
+
+	// messages without a route are rejected
+	res, failed = DispatchMsgs(ctx, testRouter{}, msgs, nil)
+	require.Equal(t, CodeUnknownRequest, res.Code)
+	require.Equal(t, 0, failed)
+}
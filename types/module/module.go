@@ -141,8 +141,8 @@ type AppModule interface {
 	QuerierRoute() string
 	NewQuerierHandler() sdk.Querier
 
-	BeginBlock(sdk.Context, abci.RequestBeginBlock) sdk.Tags
-	EndBlock(sdk.Context, abci.RequestEndBlock) ([]abci.ValidatorUpdate, sdk.Tags)
+	BeginBlock(sdk.Context, abci.RequestBeginBlock)
+	EndBlock(sdk.Context, abci.RequestEndBlock) []abci.ValidatorUpdate
 }
 
 //___________________________
@@ -174,13 +174,11 @@ func (GenesisOnlyAppModule) QuerierRoute() string { return "" }
 func (gam GenesisOnlyAppModule) NewQuerierHandler() sdk.Querier { return nil }
 
 // module begin-block
-func (gam GenesisOnlyAppModule) BeginBlock(ctx sdk.Context, req abci.RequestBeginBlock) sdk.Tags {
-	return sdk.EmptyTags()
-}
+func (gam GenesisOnlyAppModule) BeginBlock(ctx sdk.Context, req abci.RequestBeginBlock) {}
 
 // module end-block
-func (GenesisOnlyAppModule) EndBlock(_ sdk.Context, _ abci.RequestEndBlock) ([]abci.ValidatorUpdate, sdk.Tags) {
-	return []abci.ValidatorUpdate{}, sdk.EmptyTags()
+func (GenesisOnlyAppModule) EndBlock(_ sdk.Context, _ abci.RequestEndBlock) []abci.ValidatorUpdate {
+	return []abci.ValidatorUpdate{}
 }
 
 //____________________________________________________________________________
@@ -284,26 +282,30 @@ func (m *Manager) ExportGenesis(ctx sdk.Context) map[string]json.RawMessage {
 	return genesisData
 }
 
-// perform begin block functionality for modules
+// BeginBlock performs begin block functionality for all modules. It creates a
+// child context with an event manager to aggregate events emitted from all
+// modules.
 func (m *Manager) BeginBlock(ctx sdk.Context, req abci.RequestBeginBlock) abci.ResponseBeginBlock {
-	tags := sdk.EmptyTags()
+	ctx = ctx.WithEventManager(sdk.NewEventManager())
+
 	for _, moduleName := range m.OrderBeginBlockers {
-		moduleTags := m.Modules[moduleName].BeginBlock(ctx, req)
-		tags = tags.AppendTags(moduleTags)
+		m.Modules[moduleName].BeginBlock(ctx, req)
 	}
 
 	return abci.ResponseBeginBlock{
-		Tags: tags.ToKVPairs(),
+		Tags: ctx.EventManager().ABCITags(),
 	}
 }
 
-// perform end block functionality for modules
+// EndBlock performs end block functionality for all modules. It creates a
+// child context with an event manager to aggregate events emitted from all
+// modules.
 func (m *Manager) EndBlock(ctx sdk.Context, req abci.RequestEndBlock) abci.ResponseEndBlock {
+	ctx = ctx.WithEventManager(sdk.NewEventManager())
 	validatorUpdates := []abci.ValidatorUpdate{}
-	tags := sdk.EmptyTags()
+
 	for _, moduleName := range m.OrderEndBlockers {
-		moduleValUpdates, moduleTags := m.Modules[moduleName].EndBlock(ctx, req)
-		tags = tags.AppendTags(moduleTags)
+		moduleValUpdates := m.Modules[moduleName].EndBlock(ctx, req)
 
 		// use these validator updates if provided, the module manager assumes
 		// only one module will update the validator set
@@ -317,7 +319,7 @@ func (m *Manager) EndBlock(ctx sdk.Context, req abci.RequestEndBlock) abci.Respo
 
 	return abci.ResponseEndBlock{
 		ValidatorUpdates: validatorUpdates,
-		Tags:             tags,
+		Tags:             ctx.EventManager().ABCITags(),
 	}
 }
 
@@ -32,8 +32,9 @@ type Result struct {
 	// GasUsed is the amount of gas actually consumed. NOTE: unimplemented
 	GasUsed uint64
 
-	// Tags are used for transaction indexing and pubsub.
-	Tags Tags
+	// Events contains a slice of Event objects that were emitted during some
+	// execution.
+	Events Events
 }
 
 // TODO: In the future, more codes may be OK.
@@ -49,6 +50,10 @@ type ABCIMessageLog struct {
 	MsgIndex uint16 `json:"msg_index"`
 	Success  bool   `json:"success"`
 	Log      string `json:"log"`
+
+	// Events contains a slice of Event objects that were emitted during some
+	// execution.
+	Events StringEvents `json:"events,omitempty"`
 }
 
 // String implements the fmt.Stringer interface for the ABCIMessageLogs type.
@@ -75,7 +80,7 @@ type TxResponse struct {
 	Info      string          `json:"info,omitempty"`
 	GasWanted int64           `json:"gas_wanted,omitempty"`
 	GasUsed   int64           `json:"gas_used,omitempty"`
-	Tags      StringTags      `json:"tags,omitempty"`
+	Events    StringEvents    `json:"events,omitempty"`
 	Codespace string          `json:"codespace,omitempty"`
 	Tx        Tx              `json:"tx,omitempty"`
 	Timestamp string          `json:"timestamp,omitempty"`
@@ -99,7 +104,7 @@ func NewResponseResultTx(res *ctypes.ResultTx, tx Tx, timestamp string) TxRespon
 		Info:      res.TxResult.Info,
 		GasWanted: res.TxResult.GasWanted,
 		GasUsed:   res.TxResult.GasUsed,
-		Tags:      TagsToStringTags(res.TxResult.Tags),
+		Events:    StringifyABCITags(res.TxResult.Tags),
 		Tx:        tx,
 		Timestamp: timestamp,
 	}
@@ -141,7 +146,7 @@ func newTxResponseCheckTx(res *ctypes.ResultBroadcastTxCommit) TxResponse {
 		Info:      res.CheckTx.Info,
 		GasWanted: res.CheckTx.GasWanted,
 		GasUsed:   res.CheckTx.GasUsed,
-		Tags:      TagsToStringTags(res.CheckTx.Tags),
+		Events:    StringifyABCITags(res.CheckTx.Tags),
 		Codespace: res.CheckTx.Codespace,
 	}
 }
@@ -168,7 +173,7 @@ func newTxResponseDeliverTx(res *ctypes.ResultBroadcastTxCommit) TxResponse {
 		Info:      res.DeliverTx.Info,
 		GasWanted: res.DeliverTx.GasWanted,
 		GasUsed:   res.DeliverTx.GasUsed,
-		Tags:      TagsToStringTags(res.DeliverTx.Tags),
+		Events:    StringifyABCITags(res.DeliverTx.Tags),
 		Codespace: res.DeliverTx.Codespace,
 	}
 }
@@ -238,8 +243,8 @@ func (r TxResponse) String() string {
 		sb.WriteString(fmt.Sprintf("  Timestamp: %s\n", r.Timestamp))
 	}
 
-	if len(r.Tags) > 0 {
-		sb.WriteString(fmt.Sprintf("  Tags: \n%s\n", r.Tags.String()))
+	if len(r.Events) > 0 {
+		sb.WriteString(fmt.Sprintf("  Events: \n%s\n", r.Events.String()))
 	}
 
 	return strings.TrimSpace(sb.String())
@@ -48,6 +48,8 @@ type (
 	CacheWrap     = types.CacheWrap
 	CacheWrapper  = types.CacheWrapper
 	CommitID      = types.CommitID
+	StoreUpgrades = types.StoreUpgrades
+	StoreRename   = types.StoreRename
 )
 
 // nolint - reexport
@@ -28,6 +28,30 @@ type Msg interface {
 	GetSigners() []AccAddress
 }
 
+// GetSingleSigner returns the signer of a message that must be signed by a
+// single account. It returns false if the message has no or several signers.
+func GetSingleSigner(msg Msg) (AccAddress, bool) {
+	signers := msg.GetSigners()
+	if len(signers) != 1 {
+		return nil, false
+	}
+
+	return signers[0], true
+}
+
+// MsgsSignBytes returns the sign bytes of each of the messages as raw JSON, to
+// be included in the sign bytes of a message or proposal embedding them. The
+// concrete types of embedded messages don't need to be registered on the codec
+// of the embedding message this way.
+func MsgsSignBytes(msgs []Msg) []json.RawMessage {
+	msgsBytes := make([]json.RawMessage, len(msgs))
+	for i, msg := range msgs {
+		msgsBytes[i] = json.RawMessage(msg.GetSignBytes())
+	}
+
+	return msgsBytes
+}
+
 //__________________________________________________________
 
 // Transactions objects must fulfill the Tx
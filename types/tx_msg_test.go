@@ -0,0 +1,33 @@
+package types
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestGetSingleSigner(t *testing.T) {
+	addr1, addr2 := AccAddress("addr1"), AccAddress("addr2")
+
+	signer, ok := GetSingleSigner(NewTestMsg(addr1))
+	require.True(t, ok)
+	require.Equal(t, addr1, signer)
+
+	_, ok = GetSingleSigner(NewTestMsg())
+	require.False(t, ok)
+	_, ok = GetSingleSigner(NewTestMsg(addr1, addr2))
+	require.False(t, ok)
+}
+
+func TestMsgsSignBytes(t *testing.T) {
+	msgs := []Msg{NewTestMsg(AccAddress("addr1")), NewTestMsg(AccAddress("addr2"))}
+
+	msgsBytes := MsgsSignBytes(msgs)
+	require.Len(t, msgsBytes, 2)
+	for i, msg := range msgs {
+		require.Equal(t, json.RawMessage(msg.GetSignBytes()), msgsBytes[i])
+	}
+
+	require.Empty(t, MsgsSignBytes(nil))
+}
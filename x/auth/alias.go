@@ -11,7 +11,7 @@ import (
 const (
 	ModuleName                    = types.ModuleName
 	StoreKey                      = types.StoreKey
-	FeeStoreKey                   = types.FeeStoreKey
+	FeeCollectorName              = types.FeeCollectorName
 	QuerierRoute                  = types.QuerierRoute
 	DefaultParamspace             = types.DefaultParamspace
 	DefaultMaxMemoCharacters      = types.DefaultMaxMemoCharacters
@@ -20,6 +20,9 @@ const (
 	DefaultSigVerifyCostED25519   = types.DefaultSigVerifyCostED25519
 	DefaultSigVerifyCostSecp256k1 = types.DefaultSigVerifyCostSecp256k1
 	QueryAccount                  = types.QueryAccount
+	Minter                        = types.Minter
+	Burner                        = types.Burner
+	Staking                       = types.Staking
 )
 
 var (
@@ -32,9 +35,14 @@ var (
 	NewContinuousVestingAccount    = types.NewContinuousVestingAccount
 	NewDelayedVestingAccountRaw    = types.NewDelayedVestingAccountRaw
 	NewDelayedVestingAccount       = types.NewDelayedVestingAccount
+	NewPeriodicVestingAccountRaw   = types.NewPeriodicVestingAccountRaw
+	NewPeriodicVestingAccount      = types.NewPeriodicVestingAccount
 	RegisterCodec                  = types.RegisterCodec
 	RegisterBaseAccount            = types.RegisterBaseAccount
-	NewFeeCollectionKeeper         = types.NewFeeCollectionKeeper
+	NewModuleAddress               = types.NewModuleAddress
+	NewEmptyModuleAccount          = types.NewEmptyModuleAccount
+	NewModuleAccount               = types.NewModuleAccount
+	ValidatePermissions            = types.ValidatePermissions
 	NewGenesisState                = types.NewGenesisState
 	DefaultGenesisState            = types.DefaultGenesisState
 	ValidateGenesis                = types.ValidateGenesis
@@ -79,7 +87,11 @@ type (
 	BaseVestingAccount       = types.BaseVestingAccount
 	ContinuousVestingAccount = types.ContinuousVestingAccount
 	DelayedVestingAccount    = types.DelayedVestingAccount
-	FeeCollectionKeeper      = types.FeeCollectionKeeper
+	PeriodicVestingAccount   = types.PeriodicVestingAccount
+	Period                   = types.Period
+	Periods                  = types.Periods
+	ModuleAccountI           = types.ModuleAccountI
+	ModuleAccount            = types.ModuleAccount
 	GenesisState             = types.GenesisState
 	Params                   = types.Params
 	QueryAccountParams       = types.QueryAccountParams
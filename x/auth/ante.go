@@ -4,7 +4,6 @@ import (
 	"bytes"
 	"encoding/hex"
 	"fmt"
-	"time"
 
 	"github.com/tendermint/tendermint/crypto/ed25519"
 
@@ -14,6 +13,7 @@ import (
 
 	"github.com/cosmos/cosmos-sdk/codec"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/auth/types"
 )
 
 var (
@@ -34,119 +34,29 @@ type SignatureVerificationGasConsumer = func(meter sdk.GasMeter, sig []byte, pub
 
 // NewAnteHandler returns an AnteHandler that checks and increments sequence
 // numbers, checks signatures & account numbers, and deducts fees from the first
-// signer.
-func NewAnteHandler(ak AccountKeeper, fck FeeCollectionKeeper, sigGasConsumer SignatureVerificationGasConsumer) sdk.AnteHandler {
-	return func(
-		ctx sdk.Context, tx sdk.Tx, simulate bool,
-	) (newCtx sdk.Context, res sdk.Result, abort bool) {
-
-		// all transactions must be of type auth.StdTx
-		stdTx, ok := tx.(StdTx)
-		if !ok {
-			// Set a gas meter with limit 0 as to prevent an infinite gas meter attack
-			// during runTx.
-			newCtx = SetGasMeter(simulate, ctx, 0)
-			return newCtx, sdk.ErrInternal("tx must be StdTx").Result(), true
-		}
-
-		params := ak.GetParams(ctx)
-
-		// Ensure that the provided fees meet a minimum threshold for the validator,
-		// if this is a CheckTx. This is only for local mempool purposes, and thus
-		// is only ran on check tx.
-		if ctx.IsCheckTx() && !simulate {
-			res := EnsureSufficientMempoolFees(ctx, stdTx.Fee)
-			if !res.IsOK() {
-				return newCtx, res, true
-			}
-		}
-
-		newCtx = SetGasMeter(simulate, ctx, stdTx.Fee.Gas)
-
-		// AnteHandlers must have their own defer/recover in order for the BaseApp
-		// to know how much gas was used! This is because the GasMeter is created in
-		// the AnteHandler, but if it panics the context won't be set properly in
-		// runTx's recover call.
-		defer func() {
-			if r := recover(); r != nil {
-				switch rType := r.(type) {
-				case sdk.ErrorOutOfGas:
-					log := fmt.Sprintf(
-						"out of gas in location: %v; gasWanted: %d, gasUsed: %d",
-						rType.Descriptor, stdTx.Fee.Gas, newCtx.GasMeter().GasConsumed(),
-					)
-					res = sdk.ErrOutOfGas(log).Result()
-
-					res.GasWanted = stdTx.Fee.Gas
-					res.GasUsed = newCtx.GasMeter().GasConsumed()
-					abort = true
-				default:
-					panic(r)
-				}
-			}
-		}()
-
-		if res := ValidateSigCount(stdTx, params); !res.IsOK() {
-			return newCtx, res, true
-		}
-
-		if err := tx.ValidateBasic(); err != nil {
-			return newCtx, err.Result(), true
-		}
-
-		newCtx.GasMeter().ConsumeGas(params.TxSizeCostPerByte*sdk.Gas(len(newCtx.TxBytes())), "txSize")
-
-		if res := ValidateMemo(stdTx, params); !res.IsOK() {
-			return newCtx, res, true
-		}
-
-		// stdSigs contains the sequence number, account number, and signatures.
-		// When simulating, this would just be a 0-length slice.
-		signerAddrs := stdTx.GetSigners()
-		signerAccs := make([]Account, len(signerAddrs))
-		isGenesis := ctx.BlockHeight() == 0
-
-		// fetch first signer, who's going to pay the fees
-		signerAccs[0], res = GetSignerAcc(newCtx, ak, signerAddrs[0])
-		if !res.IsOK() {
-			return newCtx, res, true
-		}
-
-		if !stdTx.Fee.Amount.IsZero() {
-			signerAccs[0], res = DeductFees(ctx.BlockHeader().Time, signerAccs[0], stdTx.Fee)
-			if !res.IsOK() {
-				return newCtx, res, true
-			}
-
-			fck.AddCollectedFees(newCtx, stdTx.Fee.Amount)
-		}
-
-		// stdSigs contains the sequence number, account number, and signatures.
-		// When simulating, this would just be a 0-length slice.
-		stdSigs := stdTx.GetSignatures()
-
-		for i := 0; i < len(stdSigs); i++ {
-			// skip the fee payer, account is cached and fees were deducted already
-			if i != 0 {
-				signerAccs[i], res = GetSignerAcc(newCtx, ak, signerAddrs[i])
-				if !res.IsOK() {
-					return newCtx, res, true
-				}
-			}
-
-			// check signature, return account with incremented nonce
-			signBytes := GetSignBytes(newCtx.ChainID(), stdTx, signerAccs[i], isGenesis)
-			signerAccs[i], res = processSig(newCtx, signerAccs[i], stdSigs[i], signBytes, simulate, params, sigGasConsumer)
-			if !res.IsOK() {
-				return newCtx, res, true
-			}
-
-			ak.SetAccount(newCtx, signerAccs[i])
-		}
-
-		// TODO: tx tags (?)
-		return newCtx, sdk.Result{GasWanted: stdTx.Fee.Gas}, false // continue...
-	}
+// signer, or from the fee granter if the transaction sets one. The fee grant
+// keeper may be nil if fee grants are not supported.
+//
+// The AnteHandler is built from the auth decorators. Applications requiring
+// additional steps may chain those decorators together with their own ones
+// through sdk.ChainAnteDecorators.
+func NewAnteHandler(
+	ak AccountKeeper, supplyKeeper types.SupplyKeeper, feeGrantKeeper types.FeeGrantKeeper,
+	sigGasConsumer SignatureVerificationGasConsumer,
+) sdk.AnteHandler {
+
+	return sdk.ChainAnteDecorators(
+		NewMempoolFeeDecorator(),     // checked before setting up the gas meter
+		NewSetUpContextDecorator(ak), // SetUpContext must be called before the other decorators
+		NewValidateSigCountDecorator(ak),
+		NewValidateBasicDecorator(),
+		NewConsumeTxSizeGasDecorator(ak),
+		NewValidateMemoDecorator(ak),
+		NewDeductFeeDecorator(ak, supplyKeeper, feeGrantKeeper),
+		NewSetPubKeyDecorator(ak), // SetPubKeyDecorator must be called before all signature verification decorators
+		NewSigVerificationDecorator(ak, sigGasConsumer),
+		NewIncrementSequenceDecorator(ak), // innermost decorator
+	)
 }
 
 // GetSignerAcc returns an account for a given address that is expected to sign
@@ -191,46 +101,6 @@ func ValidateMemo(stdTx StdTx, params Params) sdk.Result {
 	return sdk.Result{}
 }
 
-// verify the signature and increment the sequence. If the account doesn't have
-// a pubkey, set it.
-func processSig(
-	ctx sdk.Context, acc Account, sig StdSignature, signBytes []byte, simulate bool, params Params,
-	sigGasConsumer SignatureVerificationGasConsumer,
-) (updatedAcc Account, res sdk.Result) {
-
-	pubKey, res := ProcessPubKey(acc, sig, simulate)
-	if !res.IsOK() {
-		return nil, res
-	}
-
-	err := acc.SetPubKey(pubKey)
-	if err != nil {
-		return nil, sdk.ErrInternal("setting PubKey on signer's account").Result()
-	}
-
-	if simulate {
-		// Simulated txs should not contain a signature and are not required to
-		// contain a pubkey, so we must account for tx size of including a
-		// StdSignature (Amino encoding) and simulate gas consumption
-		// (assuming a SECP256k1 simulation key).
-		consumeSimSigGas(ctx.GasMeter(), pubKey, sig, params)
-	}
-
-	if res := sigGasConsumer(ctx.GasMeter(), sig.Signature, pubKey, params); !res.IsOK() {
-		return nil, res
-	}
-
-	if !simulate && !pubKey.VerifyBytes(signBytes, sig.Signature) {
-		return nil, sdk.ErrUnauthorized("signature verification failed; verify correct account sequence and chain-id").Result()
-	}
-
-	if err := acc.SetSequence(acc.GetSequence() + 1); err != nil {
-		panic(err)
-	}
-
-	return acc, res
-}
-
 func consumeSimSigGas(gasmeter sdk.GasMeter, pubkey crypto.PubKey, sig StdSignature, params Params) {
 	simSig := StdSignature{PubKey: pubkey}
 	if len(sig.Signature) == 0 {
@@ -323,40 +193,23 @@ func consumeMultisignatureVerificationGas(meter sdk.GasMeter,
 	}
 }
 
-// DeductFees deducts fees from the given account.
-//
-// NOTE: We could use the CoinKeeper (in addition to the AccountKeeper, because
-// the CoinKeeper doesn't give us accounts), but it seems easier to do this.
-func DeductFees(blockTime time.Time, acc Account, fee StdFee) (Account, sdk.Result) {
-	coins := acc.GetCoins()
-	feeAmount := fee.Amount
-
-	if !feeAmount.IsValid() {
-		return nil, sdk.ErrInsufficientFee(fmt.Sprintf("invalid fee amount: %s", feeAmount)).Result()
-	}
-
-	// get the resulting coins deducting the fees
-	newCoins, ok := coins.SafeSub(feeAmount)
-	if ok {
-		return nil, sdk.ErrInsufficientFunds(
-			fmt.Sprintf("insufficient funds to pay for fees; %s < %s", coins, feeAmount),
-		).Result()
+// DeductFees deducts fees from the given account and sends them to the fee
+// collector module account. The supply keeper only lets the account pay the
+// fees with its spendable coins, which covers cases such as vesting accounts.
+func DeductFees(supplyKeeper types.SupplyKeeper, ctx sdk.Context, acc Account, fees sdk.Coins) sdk.Result {
+	if !fees.IsValid() {
+		return sdk.ErrInsufficientFee(fmt.Sprintf("invalid fee amount: %s", fees)).Result()
 	}
 
-	// Validate the account has enough "spendable" coins as this will cover cases
-	// such as vesting accounts.
-	spendableCoins := acc.SpendableCoins(blockTime)
-	if _, hasNeg := spendableCoins.SafeSub(feeAmount); hasNeg {
-		return nil, sdk.ErrInsufficientFunds(
-			fmt.Sprintf("insufficient funds to pay for fees; %s < %s", spendableCoins, feeAmount),
+	// verify the account has enough funds to pay for fees
+	err := supplyKeeper.SendCoinsFromAccountToModule(ctx, acc.GetAddress(), types.FeeCollectorName, fees)
+	if err != nil {
+		return sdk.ErrInsufficientFunds(
+			fmt.Sprintf("insufficient funds to pay for fees; %s", fees),
 		).Result()
 	}
 
-	if err := acc.SetCoins(newCoins); err != nil {
-		return nil, sdk.ErrInternal(err.Error()).Result()
-	}
-
-	return acc, sdk.Result{}
+	return sdk.Result{}
 }
 
 // EnsureSufficientMempoolFees verifies that the given transaction has supplied
@@ -48,7 +48,7 @@ func TestAnteHandlerSigErrors(t *testing.T) {
 	// setup
 	input := setupTestInput()
 	ctx := input.ctx
-	anteHandler := NewAnteHandler(input.ak, input.fck, DefaultSigVerificationGasConsumer)
+	anteHandler := NewAnteHandler(input.ak, input.sk, nil, DefaultSigVerificationGasConsumer)
 
 	// keys and addresses
 	priv1, _, addr1 := KeyTestPubAddr()
@@ -87,7 +87,7 @@ func TestAnteHandlerSigErrors(t *testing.T) {
 
 	// save the first account, but second is still unrecognized
 	acc1 := input.ak.NewAccountWithAddress(ctx, addr1)
-	acc1.SetCoins(fee.Amount)
+	input.sk.SetCoins(ctx, addr1, fee.Amount)
 	input.ak.SetAccount(ctx, acc1)
 	checkInvalidTx(t, anteHandler, ctx, tx, false, sdk.CodeUnknownAddress)
 }
@@ -96,7 +96,7 @@ func TestAnteHandlerSigErrors(t *testing.T) {
 func TestAnteHandlerAccountNumbers(t *testing.T) {
 	// setup
 	input := setupTestInput()
-	anteHandler := NewAnteHandler(input.ak, input.fck, DefaultSigVerificationGasConsumer)
+	anteHandler := NewAnteHandler(input.ak, input.sk, nil, DefaultSigVerificationGasConsumer)
 	ctx := input.ctx.WithBlockHeight(1)
 
 	// keys and addresses
@@ -105,10 +105,10 @@ func TestAnteHandlerAccountNumbers(t *testing.T) {
 
 	// set the accounts
 	acc1 := input.ak.NewAccountWithAddress(ctx, addr1)
-	acc1.SetCoins(NewTestCoins())
+	input.sk.SetCoins(ctx, addr1, NewTestCoins())
 	input.ak.SetAccount(ctx, acc1)
 	acc2 := input.ak.NewAccountWithAddress(ctx, addr2)
-	acc2.SetCoins(NewTestCoins())
+	input.sk.SetCoins(ctx, addr2, NewTestCoins())
 	input.ak.SetAccount(ctx, acc2)
 
 	// msg and signatures
@@ -151,7 +151,7 @@ func TestAnteHandlerAccountNumbers(t *testing.T) {
 func TestAnteHandlerAccountNumbersAtBlockHeightZero(t *testing.T) {
 	// setup
 	input := setupTestInput()
-	anteHandler := NewAnteHandler(input.ak, input.fck, DefaultSigVerificationGasConsumer)
+	anteHandler := NewAnteHandler(input.ak, input.sk, nil, DefaultSigVerificationGasConsumer)
 	ctx := input.ctx.WithBlockHeight(0)
 
 	// keys and addresses
@@ -160,10 +160,10 @@ func TestAnteHandlerAccountNumbersAtBlockHeightZero(t *testing.T) {
 
 	// set the accounts
 	acc1 := input.ak.NewAccountWithAddress(ctx, addr1)
-	acc1.SetCoins(NewTestCoins())
+	input.sk.SetCoins(ctx, addr1, NewTestCoins())
 	input.ak.SetAccount(ctx, acc1)
 	acc2 := input.ak.NewAccountWithAddress(ctx, addr2)
-	acc2.SetCoins(NewTestCoins())
+	input.sk.SetCoins(ctx, addr2, NewTestCoins())
 	input.ak.SetAccount(ctx, acc2)
 
 	// msg and signatures
@@ -206,7 +206,7 @@ func TestAnteHandlerAccountNumbersAtBlockHeightZero(t *testing.T) {
 func TestAnteHandlerSequences(t *testing.T) {
 	// setup
 	input := setupTestInput()
-	anteHandler := NewAnteHandler(input.ak, input.fck, DefaultSigVerificationGasConsumer)
+	anteHandler := NewAnteHandler(input.ak, input.sk, nil, DefaultSigVerificationGasConsumer)
 	ctx := input.ctx.WithBlockHeight(1)
 
 	// keys and addresses
@@ -216,13 +216,13 @@ func TestAnteHandlerSequences(t *testing.T) {
 
 	// set the accounts
 	acc1 := input.ak.NewAccountWithAddress(ctx, addr1)
-	acc1.SetCoins(NewTestCoins())
+	input.sk.SetCoins(ctx, addr1, NewTestCoins())
 	input.ak.SetAccount(ctx, acc1)
 	acc2 := input.ak.NewAccountWithAddress(ctx, addr2)
-	acc2.SetCoins(NewTestCoins())
+	input.sk.SetCoins(ctx, addr2, NewTestCoins())
 	input.ak.SetAccount(ctx, acc2)
 	acc3 := input.ak.NewAccountWithAddress(ctx, addr3)
-	acc3.SetCoins(NewTestCoins())
+	input.sk.SetCoins(ctx, addr3, NewTestCoins())
 	input.ak.SetAccount(ctx, acc3)
 
 	// msg and signatures
@@ -281,7 +281,7 @@ func TestAnteHandlerFees(t *testing.T) {
 	// setup
 	input := setupTestInput()
 	ctx := input.ctx
-	anteHandler := NewAnteHandler(input.ak, input.fck, DefaultSigVerificationGasConsumer)
+	anteHandler := NewAnteHandler(input.ak, input.sk, nil, DefaultSigVerificationGasConsumer)
 
 	// keys and addresses
 	priv1, _, addr1 := KeyTestPubAddr()
@@ -301,27 +301,27 @@ func TestAnteHandlerFees(t *testing.T) {
 	tx = NewTestTx(ctx, msgs, privs, accnums, seqs, fee)
 	checkInvalidTx(t, anteHandler, ctx, tx, false, sdk.CodeInsufficientFunds)
 
-	acc1.SetCoins(sdk.NewCoins(sdk.NewInt64Coin("atom", 149)))
+	input.sk.SetCoins(ctx, addr1, sdk.NewCoins(sdk.NewInt64Coin("atom", 149)))
 	input.ak.SetAccount(ctx, acc1)
 	checkInvalidTx(t, anteHandler, ctx, tx, false, sdk.CodeInsufficientFunds)
 
 	emptyCoins := sdk.NewCoins()
-	require.True(t, input.fck.GetCollectedFees(ctx).IsEqual(emptyCoins))
-	require.True(t, input.ak.GetAccount(ctx, addr1).GetCoins().AmountOf("atom").Equal(sdk.NewInt(149)))
+	require.True(t, input.sk.GetCoins(ctx, input.sk.GetModuleAddress(types.FeeCollectorName)).IsEqual(emptyCoins))
+	require.True(t, input.sk.GetCoins(ctx, addr1).AmountOf("atom").Equal(sdk.NewInt(149)))
 
-	acc1.SetCoins(sdk.NewCoins(sdk.NewInt64Coin("atom", 150)))
+	input.sk.SetCoins(ctx, addr1, sdk.NewCoins(sdk.NewInt64Coin("atom", 150)))
 	input.ak.SetAccount(ctx, acc1)
 	checkValidTx(t, anteHandler, ctx, tx, false)
 
-	require.True(t, input.fck.GetCollectedFees(ctx).IsEqual(sdk.NewCoins(sdk.NewInt64Coin("atom", 150))))
-	require.True(t, input.ak.GetAccount(ctx, addr1).GetCoins().AmountOf("atom").Equal(sdk.NewInt(0)))
+	require.True(t, input.sk.GetCoins(ctx, input.sk.GetModuleAddress(types.FeeCollectorName)).IsEqual(sdk.NewCoins(sdk.NewInt64Coin("atom", 150))))
+	require.True(t, input.sk.GetCoins(ctx, addr1).AmountOf("atom").Equal(sdk.NewInt(0)))
 }
 
 // Test logic around memo gas consumption.
 func TestAnteHandlerMemoGas(t *testing.T) {
 	// setup
 	input := setupTestInput()
-	anteHandler := NewAnteHandler(input.ak, input.fck, DefaultSigVerificationGasConsumer)
+	anteHandler := NewAnteHandler(input.ak, input.sk, nil, DefaultSigVerificationGasConsumer)
 	ctx := input.ctx.WithBlockHeight(1)
 
 	// keys and addresses
@@ -360,7 +360,7 @@ func TestAnteHandlerMemoGas(t *testing.T) {
 func TestAnteHandlerMultiSigner(t *testing.T) {
 	// setup
 	input := setupTestInput()
-	anteHandler := NewAnteHandler(input.ak, input.fck, DefaultSigVerificationGasConsumer)
+	anteHandler := NewAnteHandler(input.ak, input.sk, nil, DefaultSigVerificationGasConsumer)
 	ctx := input.ctx.WithBlockHeight(1)
 
 	// keys and addresses
@@ -370,13 +370,13 @@ func TestAnteHandlerMultiSigner(t *testing.T) {
 
 	// set the accounts
 	acc1 := input.ak.NewAccountWithAddress(ctx, addr1)
-	acc1.SetCoins(NewTestCoins())
+	input.sk.SetCoins(ctx, addr1, NewTestCoins())
 	input.ak.SetAccount(ctx, acc1)
 	acc2 := input.ak.NewAccountWithAddress(ctx, addr2)
-	acc2.SetCoins(NewTestCoins())
+	input.sk.SetCoins(ctx, addr2, NewTestCoins())
 	input.ak.SetAccount(ctx, acc2)
 	acc3 := input.ak.NewAccountWithAddress(ctx, addr3)
-	acc3.SetCoins(NewTestCoins())
+	input.sk.SetCoins(ctx, addr3, NewTestCoins())
 	input.ak.SetAccount(ctx, acc3)
 
 	// set up msgs and fee
@@ -407,7 +407,7 @@ func TestAnteHandlerMultiSigner(t *testing.T) {
 func TestAnteHandlerBadSignBytes(t *testing.T) {
 	// setup
 	input := setupTestInput()
-	anteHandler := NewAnteHandler(input.ak, input.fck, DefaultSigVerificationGasConsumer)
+	anteHandler := NewAnteHandler(input.ak, input.sk, nil, DefaultSigVerificationGasConsumer)
 	ctx := input.ctx.WithBlockHeight(1)
 
 	// keys and addresses
@@ -416,10 +416,10 @@ func TestAnteHandlerBadSignBytes(t *testing.T) {
 
 	// set the accounts
 	acc1 := input.ak.NewAccountWithAddress(ctx, addr1)
-	acc1.SetCoins(NewTestCoins())
+	input.sk.SetCoins(ctx, addr1, NewTestCoins())
 	input.ak.SetAccount(ctx, acc1)
 	acc2 := input.ak.NewAccountWithAddress(ctx, addr2)
-	acc2.SetCoins(NewTestCoins())
+	input.sk.SetCoins(ctx, addr2, NewTestCoins())
 	input.ak.SetAccount(ctx, acc2)
 
 	var tx sdk.Tx
@@ -482,7 +482,7 @@ func TestAnteHandlerBadSignBytes(t *testing.T) {
 func TestAnteHandlerSetPubKey(t *testing.T) {
 	// setup
 	input := setupTestInput()
-	anteHandler := NewAnteHandler(input.ak, input.fck, DefaultSigVerificationGasConsumer)
+	anteHandler := NewAnteHandler(input.ak, input.sk, nil, DefaultSigVerificationGasConsumer)
 	ctx := input.ctx.WithBlockHeight(1)
 
 	// keys and addresses
@@ -491,10 +491,10 @@ func TestAnteHandlerSetPubKey(t *testing.T) {
 
 	// set the accounts
 	acc1 := input.ak.NewAccountWithAddress(ctx, addr1)
-	acc1.SetCoins(NewTestCoins())
+	input.sk.SetCoins(ctx, addr1, NewTestCoins())
 	input.ak.SetAccount(ctx, acc1)
 	acc2 := input.ak.NewAccountWithAddress(ctx, addr2)
-	acc2.SetCoins(NewTestCoins())
+	input.sk.SetCoins(ctx, addr2, NewTestCoins())
 	input.ak.SetAccount(ctx, acc2)
 
 	var tx sdk.Tx
@@ -676,7 +676,7 @@ func TestCountSubkeys(t *testing.T) {
 func TestAnteHandlerSigLimitExceeded(t *testing.T) {
 	// setup
 	input := setupTestInput()
-	anteHandler := NewAnteHandler(input.ak, input.fck, DefaultSigVerificationGasConsumer)
+	anteHandler := NewAnteHandler(input.ak, input.sk, nil, DefaultSigVerificationGasConsumer)
 	ctx := input.ctx.WithBlockHeight(1)
 
 	// keys and addresses
@@ -691,10 +691,10 @@ func TestAnteHandlerSigLimitExceeded(t *testing.T) {
 
 	// set the accounts
 	acc1 := input.ak.NewAccountWithAddress(ctx, addr1)
-	acc1.SetCoins(NewTestCoins())
+	input.sk.SetCoins(ctx, addr1, NewTestCoins())
 	input.ak.SetAccount(ctx, acc1)
 	acc2 := input.ak.NewAccountWithAddress(ctx, addr2)
-	acc2.SetCoins(NewTestCoins())
+	input.sk.SetCoins(ctx, addr2, NewTestCoins())
 	input.ak.SetAccount(ctx, acc2)
 
 	var tx sdk.Tx
@@ -764,7 +764,7 @@ func TestCustomSignatureVerificationGasConsumer(t *testing.T) {
 	// setup
 	input := setupTestInput()
 	// setup an ante handler that only accepts PubKeyEd25519
-	anteHandler := NewAnteHandler(input.ak, input.fck, func(meter sdk.GasMeter, sig []byte, pubkey crypto.PubKey, params Params) sdk.Result {
+	anteHandler := NewAnteHandler(input.ak, input.sk, nil, func(meter sdk.GasMeter, sig []byte, pubkey crypto.PubKey, params Params) sdk.Result {
 		switch pubkey := pubkey.(type) {
 		case ed25519.PubKeyEd25519:
 			meter.ConsumeGas(params.SigVerifyCostED25519, "ante verify: ed25519")
@@ -778,7 +778,7 @@ func TestCustomSignatureVerificationGasConsumer(t *testing.T) {
 	// verify that an secp256k1 account gets rejected
 	priv1, _, addr1 := KeyTestPubAddr()
 	acc1 := input.ak.NewAccountWithAddress(ctx, addr1)
-	_ = acc1.SetCoins(sdk.NewCoins(sdk.NewInt64Coin("atom", 150)))
+	input.sk.SetCoins(ctx, addr1, sdk.NewCoins(sdk.NewInt64Coin("atom", 150)))
 	input.ak.SetAccount(ctx, acc1)
 	var tx sdk.Tx
 	msg := NewTestMsg(addr1)
@@ -793,10 +793,12 @@ func TestCustomSignatureVerificationGasConsumer(t *testing.T) {
 	pub2 := priv2.PubKey()
 	addr2 := sdk.AccAddress(pub2.Address())
 	acc2 := input.ak.NewAccountWithAddress(ctx, addr2)
-	_ = acc2.SetCoins(sdk.NewCoins(sdk.NewInt64Coin("atom", 150)))
+	input.sk.SetCoins(ctx, addr2, sdk.NewCoins(sdk.NewInt64Coin("atom", 150)))
 	input.ak.SetAccount(ctx, acc2)
 	msg = NewTestMsg(addr2)
-	privs, accnums, seqs = []crypto.PrivKey{priv2}, []uint64{1}, []uint64{0}
+	// account number 1 was taken by the fee collector module account when the
+	// fee of the rejected tx above was deducted
+	privs, accnums, seqs = []crypto.PrivKey{priv2}, []uint64{2}, []uint64{0}
 	fee = NewTestStdFee()
 	msgs = []sdk.Msg{msg}
 	tx = NewTestTx(ctx, msgs, privs, accnums, seqs, fee)
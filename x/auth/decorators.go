@@ -0,0 +1,499 @@
+package auth
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/auth/types"
+)
+
+// The decorators below each implement a single step of the default auth
+// AnteHandler. They are chained in NewAnteHandler, but applications may chain
+// them (or a subset of them) together with their own decorators through
+// sdk.ChainAnteDecorators.
+//
+// The decorators share the signer accounts of the transaction through the
+// context set up by SetUpContextDecorator, so that every signer account is read
+// from the store only once. SetUpContextDecorator persists the signer accounts,
+// along with the changes the decorators made to them, once the rest of the
+// chain succeeds. A decorator used without it persists its changes itself.
+//
+// NOTE: All decorators other than SetUpContextDecorator and MempoolFeeDecorator
+// expect to be wrapped by SetUpContextDecorator, as it sets the transaction gas
+// meter and recovers from out of gas panics.
+
+var (
+	_ sdk.AnteDecorator = SetUpContextDecorator{}
+	_ sdk.AnteDecorator = MempoolFeeDecorator{}
+	_ sdk.AnteDecorator = ValidateSigCountDecorator{}
+	_ sdk.AnteDecorator = ValidateBasicDecorator{}
+	_ sdk.AnteDecorator = ConsumeTxSizeGasDecorator{}
+	_ sdk.AnteDecorator = ValidateMemoDecorator{}
+	_ sdk.AnteDecorator = DeductFeeDecorator{}
+	_ sdk.AnteDecorator = SetPubKeyDecorator{}
+	_ sdk.AnteDecorator = SigVerificationDecorator{}
+	_ sdk.AnteDecorator = IncrementSequenceDecorator{}
+)
+
+// SetUpContextDecorator sets the transaction gas meter on the context and
+// recovers from any out of gas panic raised further along the chain. It also
+// sets up the signer accounts shared by the decorators, and persists them once
+// the rest of the chain succeeds. It must be the first decorator in the chain,
+// apart from MempoolFeeDecorator.
+type SetUpContextDecorator struct {
+	ak AccountKeeper
+}
+
+// NewSetUpContextDecorator returns a new SetUpContextDecorator.
+func NewSetUpContextDecorator(ak AccountKeeper) SetUpContextDecorator {
+	return SetUpContextDecorator{ak: ak}
+}
+
+// AnteHandle implements the sdk.AnteDecorator interface.
+func (sud SetUpContextDecorator) AnteHandle(
+	ctx sdk.Context, tx sdk.Tx, simulate bool, next sdk.AnteHandler,
+) (newCtx sdk.Context, res sdk.Result, abort bool) {
+
+	// all transactions must be of type auth.StdTx
+	stdTx, ok := tx.(StdTx)
+	if !ok {
+		// Set a gas meter with limit 0 as to prevent an infinite gas meter attack
+		// during runTx.
+		newCtx = SetGasMeter(simulate, ctx, 0)
+		return newCtx, sdk.ErrInternal("tx must be StdTx").Result(), true
+	}
+
+	newCtx = SetGasMeter(simulate, ctx, stdTx.Fee.Gas)
+
+	// AnteHandlers must have their own defer/recover in order for the BaseApp
+	// to know how much gas was used! This is because the GasMeter is created in
+	// the AnteHandler, but if it panics the context won't be set properly in
+	// runTx's recover call.
+	defer func() {
+		if r := recover(); r != nil {
+			switch rType := r.(type) {
+			case sdk.ErrorOutOfGas:
+				log := fmt.Sprintf(
+					"out of gas in location: %v; gasWanted: %d, gasUsed: %d",
+					rType.Descriptor, stdTx.Fee.Gas, newCtx.GasMeter().GasConsumed(),
+				)
+				res = sdk.ErrOutOfGas(log).Result()
+
+				res.GasWanted = stdTx.Fee.Gas
+				res.GasUsed = newCtx.GasMeter().GasConsumed()
+				abort = true
+			default:
+				panic(r)
+			}
+		}
+	}()
+
+	signerAccs := make([]Account, len(stdTx.GetSigners()))
+	newCtx, res, abort = next(newCtx.WithValue(signerAccsKey{}, signerAccs), tx, simulate)
+	if abort {
+		return newCtx, res, abort
+	}
+
+	for _, acc := range signerAccs {
+		if acc != nil {
+			sud.ak.SetAccount(newCtx, acc)
+		}
+	}
+
+	// the signer accounts are persisted, they must not leak into the execution
+	// of the messages
+	newCtx = newCtx.WithValue(signerAccsKey{}, nil)
+
+	res.GasWanted = stdTx.Fee.Gas
+	return newCtx, res, abort
+}
+
+// MempoolFeeDecorator ensures that the provided fees meet a minimum threshold
+// for the validator. This is only for local mempool purposes, and thus is only
+// ran on CheckTx. It does not consume any gas, and comes before
+// SetUpContextDecorator in the default chain.
+type MempoolFeeDecorator struct{}
+
+// NewMempoolFeeDecorator returns a new MempoolFeeDecorator.
+func NewMempoolFeeDecorator() MempoolFeeDecorator {
+	return MempoolFeeDecorator{}
+}
+
+// AnteHandle implements the sdk.AnteDecorator interface.
+func (mfd MempoolFeeDecorator) AnteHandle(
+	ctx sdk.Context, tx sdk.Tx, simulate bool, next sdk.AnteHandler,
+) (sdk.Context, sdk.Result, bool) {
+
+	// other transactions are rejected by SetUpContextDecorator
+	stdTx, ok := tx.(StdTx)
+	if !ok {
+		return next(ctx, tx, simulate)
+	}
+
+	if ctx.IsCheckTx() && !simulate {
+		if res := EnsureSufficientMempoolFees(ctx, stdTx.Fee); !res.IsOK() {
+			return ctx, res, true
+		}
+	}
+
+	return next(ctx, tx, simulate)
+}
+
+// ValidateSigCountDecorator rejects transactions whose cumulative amount of
+// signatures, counting every key of a multisig, exceeds the TxSigLimit param.
+type ValidateSigCountDecorator struct {
+	ak AccountKeeper
+}
+
+// NewValidateSigCountDecorator returns a new ValidateSigCountDecorator.
+func NewValidateSigCountDecorator(ak AccountKeeper) ValidateSigCountDecorator {
+	return ValidateSigCountDecorator{ak: ak}
+}
+
+// AnteHandle implements the sdk.AnteDecorator interface.
+func (vsd ValidateSigCountDecorator) AnteHandle(
+	ctx sdk.Context, tx sdk.Tx, simulate bool, next sdk.AnteHandler,
+) (sdk.Context, sdk.Result, bool) {
+
+	stdTx, ok := tx.(StdTx)
+	if !ok {
+		return ctx, sdk.ErrInternal("tx must be StdTx").Result(), true
+	}
+
+	if res := ValidateSigCount(stdTx, getParams(ctx, vsd.ak)); !res.IsOK() {
+		return ctx, res, true
+	}
+
+	return next(ctx, tx, simulate)
+}
+
+// ValidateBasicDecorator runs the stateless ValidateBasic checks of the
+// transaction.
+type ValidateBasicDecorator struct{}
+
+// NewValidateBasicDecorator returns a new ValidateBasicDecorator.
+func NewValidateBasicDecorator() ValidateBasicDecorator {
+	return ValidateBasicDecorator{}
+}
+
+// AnteHandle implements the sdk.AnteDecorator interface.
+func (vbd ValidateBasicDecorator) AnteHandle(
+	ctx sdk.Context, tx sdk.Tx, simulate bool, next sdk.AnteHandler,
+) (sdk.Context, sdk.Result, bool) {
+
+	if err := tx.ValidateBasic(); err != nil {
+		return ctx, err.Result(), true
+	}
+
+	return next(ctx, tx, simulate)
+}
+
+// ConsumeTxSizeGasDecorator consumes gas proportional to the size of the
+// transaction bytes, as per the TxSizeCostPerByte param.
+type ConsumeTxSizeGasDecorator struct {
+	ak AccountKeeper
+}
+
+// NewConsumeTxSizeGasDecorator returns a new ConsumeTxSizeGasDecorator.
+func NewConsumeTxSizeGasDecorator(ak AccountKeeper) ConsumeTxSizeGasDecorator {
+	return ConsumeTxSizeGasDecorator{ak: ak}
+}
+
+// AnteHandle implements the sdk.AnteDecorator interface.
+func (cgd ConsumeTxSizeGasDecorator) AnteHandle(
+	ctx sdk.Context, tx sdk.Tx, simulate bool, next sdk.AnteHandler,
+) (sdk.Context, sdk.Result, bool) {
+
+	params := getParams(ctx, cgd.ak)
+	ctx.GasMeter().ConsumeGas(params.TxSizeCostPerByte*sdk.Gas(len(ctx.TxBytes())), "txSize")
+
+	return next(ctx, tx, simulate)
+}
+
+// ValidateMemoDecorator rejects transactions whose memo is longer than the
+// MaxMemoCharacters param.
+type ValidateMemoDecorator struct {
+	ak AccountKeeper
+}
+
+// NewValidateMemoDecorator returns a new ValidateMemoDecorator.
+func NewValidateMemoDecorator(ak AccountKeeper) ValidateMemoDecorator {
+	return ValidateMemoDecorator{ak: ak}
+}
+
+// AnteHandle implements the sdk.AnteDecorator interface.
+func (vmd ValidateMemoDecorator) AnteHandle(
+	ctx sdk.Context, tx sdk.Tx, simulate bool, next sdk.AnteHandler,
+) (sdk.Context, sdk.Result, bool) {
+
+	stdTx, ok := tx.(StdTx)
+	if !ok {
+		return ctx, sdk.ErrInternal("tx must be StdTx").Result(), true
+	}
+
+	if res := ValidateMemo(stdTx, getParams(ctx, vmd.ak)); !res.IsOK() {
+		return ctx, res, true
+	}
+
+	return next(ctx, tx, simulate)
+}
+
+// DeductFeeDecorator deducts the transaction fees from the first signer, who
+// pays the fees, and sends them to the fee collector module account.
+//
+// If the fee of the transaction sets a granter, the fees are instead deducted
+// from the granter and charged against the fee allowance it granted to the
+// first signer. Transactions with a granter are rejected when no fee grant
+// keeper is set.
+type DeductFeeDecorator struct {
+	ak             AccountKeeper
+	supplyKeeper   types.SupplyKeeper
+	feeGrantKeeper types.FeeGrantKeeper
+}
+
+// NewDeductFeeDecorator returns a new DeductFeeDecorator. The fee grant keeper
+// may be nil if fee grants are not supported.
+func NewDeductFeeDecorator(ak AccountKeeper, supplyKeeper types.SupplyKeeper, feeGrantKeeper types.FeeGrantKeeper) DeductFeeDecorator {
+	return DeductFeeDecorator{ak: ak, supplyKeeper: supplyKeeper, feeGrantKeeper: feeGrantKeeper}
+}
+
+// AnteHandle implements the sdk.AnteDecorator interface.
+func (dfd DeductFeeDecorator) AnteHandle(
+	ctx sdk.Context, tx sdk.Tx, simulate bool, next sdk.AnteHandler,
+) (sdk.Context, sdk.Result, bool) {
+
+	stdTx, ok := tx.(StdTx)
+	if !ok {
+		return ctx, sdk.ErrInternal("tx must be StdTx").Result(), true
+	}
+
+	// fetch first signer, who's going to pay the fees
+	feePayer, res := loadSignerAcc(ctx, dfd.ak, stdTx, 0)
+	if !res.IsOK() {
+		return ctx, res, true
+	}
+
+	// the fees are paid by the granter on behalf of the first signer
+	if granter := stdTx.FeeGranter(); !granter.Empty() && !granter.Equals(feePayer.GetAddress()) {
+		if dfd.feeGrantKeeper == nil {
+			return ctx, sdk.ErrUnauthorized("fee grants are not supported").Result(), true
+		}
+
+		if !stdTx.Fee.Amount.IsZero() {
+			err := dfd.feeGrantKeeper.UseGrantedFees(ctx, granter, feePayer.GetAddress(), stdTx.Fee.Amount)
+			if err != nil {
+				return ctx, err.Result(), true
+			}
+		}
+
+		feePayer, res = GetSignerAcc(ctx, dfd.ak, granter)
+		if !res.IsOK() {
+			return ctx, res, true
+		}
+	}
+
+	if !stdTx.Fee.Amount.IsZero() {
+		res = DeductFees(dfd.supplyKeeper, ctx, feePayer, stdTx.Fee.Amount)
+		if !res.IsOK() {
+			return ctx, res, true
+		}
+	}
+
+	return next(ctx, tx, simulate)
+}
+
+// SetPubKeyDecorator sets the public key of every signer account that does
+// not have one yet from the transaction signatures. When simulating, accounts
+// without a public key are given a simulation secp256k1 key so that the gas
+// consumed matches the one of a signed transaction.
+type SetPubKeyDecorator struct {
+	ak AccountKeeper
+}
+
+// NewSetPubKeyDecorator returns a new SetPubKeyDecorator.
+func NewSetPubKeyDecorator(ak AccountKeeper) SetPubKeyDecorator {
+	return SetPubKeyDecorator{ak: ak}
+}
+
+// AnteHandle implements the sdk.AnteDecorator interface.
+func (spd SetPubKeyDecorator) AnteHandle(
+	ctx sdk.Context, tx sdk.Tx, simulate bool, next sdk.AnteHandler,
+) (sdk.Context, sdk.Result, bool) {
+
+	stdTx, ok := tx.(StdTx)
+	if !ok {
+		return ctx, sdk.ErrInternal("tx must be StdTx").Result(), true
+	}
+
+	stdSigs := stdTx.GetSignatures()
+
+	for i := 0; i < len(stdSigs); i++ {
+		acc, res := loadSignerAcc(ctx, spd.ak, stdTx, i)
+		if !res.IsOK() {
+			return ctx, res, true
+		}
+
+		if acc.GetPubKey() != nil {
+			continue
+		}
+
+		pubKey, res := ProcessPubKey(acc, stdSigs[i], simulate)
+		if !res.IsOK() {
+			return ctx, res, true
+		}
+
+		if err := acc.SetPubKey(pubKey); err != nil {
+			return ctx, sdk.ErrInternal("setting PubKey on signer's account").Result(), true
+		}
+
+		storeSignerAcc(ctx, spd.ak, acc)
+	}
+
+	return next(ctx, tx, simulate)
+}
+
+// SigVerificationDecorator consumes the gas required to verify each signature
+// of the transaction through the given SignatureVerificationGasConsumer, which
+// may also reject unsupported public key types, and then verifies it against
+// the sign bytes of the corresponding signer account, one signer after the
+// other. Verification is skipped when simulating.
+type SigVerificationDecorator struct {
+	ak             AccountKeeper
+	sigGasConsumer SignatureVerificationGasConsumer
+}
+
+// NewSigVerificationDecorator returns a new SigVerificationDecorator.
+func NewSigVerificationDecorator(ak AccountKeeper, sigGasConsumer SignatureVerificationGasConsumer) SigVerificationDecorator {
+	return SigVerificationDecorator{ak: ak, sigGasConsumer: sigGasConsumer}
+}
+
+// AnteHandle implements the sdk.AnteDecorator interface.
+func (svd SigVerificationDecorator) AnteHandle(
+	ctx sdk.Context, tx sdk.Tx, simulate bool, next sdk.AnteHandler,
+) (sdk.Context, sdk.Result, bool) {
+
+	stdTx, ok := tx.(StdTx)
+	if !ok {
+		return ctx, sdk.ErrInternal("tx must be StdTx").Result(), true
+	}
+
+	params := getParams(ctx, svd.ak)
+	stdSigs := stdTx.GetSignatures()
+	isGenesis := ctx.BlockHeight() == 0
+
+	for i := 0; i < len(stdSigs); i++ {
+		acc, res := loadSignerAcc(ctx, svd.ak, stdTx, i)
+		if !res.IsOK() {
+			return ctx, res, true
+		}
+
+		pubKey := acc.GetPubKey()
+		if pubKey == nil {
+			return ctx, sdk.ErrInvalidPubKey("PubKey not found").Result(), true
+		}
+
+		if simulate {
+			// Simulated txs should not contain a signature and are not required to
+			// contain a pubkey, so we must account for tx size of including a
+			// StdSignature (Amino encoding) and simulate gas consumption
+			// (assuming a SECP256k1 simulation key).
+			consumeSimSigGas(ctx.GasMeter(), pubKey, stdSigs[i], params)
+		}
+
+		if res := svd.sigGasConsumer(ctx.GasMeter(), stdSigs[i].Signature, pubKey, params); !res.IsOK() {
+			return ctx, res, true
+		}
+
+		if simulate {
+			continue
+		}
+
+		signBytes := GetSignBytes(ctx.ChainID(), stdTx, acc, isGenesis)
+		if !pubKey.VerifyBytes(signBytes, stdSigs[i].Signature) {
+			return ctx, sdk.ErrUnauthorized("signature verification failed; verify correct account sequence and chain-id").Result(), true
+		}
+	}
+
+	return next(ctx, tx, simulate)
+}
+
+// IncrementSequenceDecorator increments the sequence of every signer account.
+// It must come after SigVerificationDecorator, as the sign bytes include the
+// sequence of the signer accounts.
+type IncrementSequenceDecorator struct {
+	ak AccountKeeper
+}
+
+// NewIncrementSequenceDecorator returns a new IncrementSequenceDecorator.
+func NewIncrementSequenceDecorator(ak AccountKeeper) IncrementSequenceDecorator {
+	return IncrementSequenceDecorator{ak: ak}
+}
+
+// AnteHandle implements the sdk.AnteDecorator interface.
+func (isd IncrementSequenceDecorator) AnteHandle(
+	ctx sdk.Context, tx sdk.Tx, simulate bool, next sdk.AnteHandler,
+) (sdk.Context, sdk.Result, bool) {
+
+	stdTx, ok := tx.(StdTx)
+	if !ok {
+		return ctx, sdk.ErrInternal("tx must be StdTx").Result(), true
+	}
+
+	for i := range stdTx.GetSigners() {
+		acc, res := loadSignerAcc(ctx, isd.ak, stdTx, i)
+		if !res.IsOK() {
+			return ctx, res, true
+		}
+
+		if err := acc.SetSequence(acc.GetSequence() + 1); err != nil {
+			panic(err)
+		}
+
+		storeSignerAcc(ctx, isd.ak, acc)
+	}
+
+	return next(ctx, tx, simulate)
+}
+
+// ----------------------------------------------------------------------------
+// Misc.
+
+// signerAccsKey is the context key under which the decorators share the signer
+// accounts of the transaction being processed, so that every account is read
+// from and written to the store only once.
+type signerAccsKey struct{}
+
+// loadSignerAcc returns the i-th signer account, from the signer accounts
+// shared in the context if SetUpContextDecorator set them up. The account is
+// fetched from the store and shared if it was not loaded yet.
+func loadSignerAcc(ctx sdk.Context, ak AccountKeeper, stdTx StdTx, i int) (Account, sdk.Result) {
+	signerAccs, _ := ctx.Value(signerAccsKey{}).([]Account)
+	if signerAccs != nil && signerAccs[i] != nil {
+		return signerAccs[i], sdk.Result{}
+	}
+
+	acc, res := GetSignerAcc(ctx, ak, stdTx.GetSigners()[i])
+	if !res.IsOK() {
+		return nil, res
+	}
+
+	if signerAccs != nil {
+		signerAccs[i] = acc
+	}
+	return acc, sdk.Result{}
+}
+
+// storeSignerAcc persists a signer account modified by a decorator, unless the
+// signer accounts are shared in the context, in which case
+// SetUpContextDecorator persists them at the end of the chain.
+func storeSignerAcc(ctx sdk.Context, ak AccountKeeper, acc Account) {
+	if signerAccs, _ := ctx.Value(signerAccsKey{}).([]Account); signerAccs == nil {
+		ak.SetAccount(ctx, acc)
+	}
+}
+
+// getParams returns the auth params without charging the transaction gas
+// meter, as the params have always been read before setting it up.
+func getParams(ctx sdk.Context, ak AccountKeeper) Params {
+	return ak.GetParams(ctx.WithGasMeter(sdk.NewInfiniteGasMeter()))
+}
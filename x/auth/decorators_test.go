@@ -0,0 +1,211 @@
+package auth
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/tendermint/tendermint/crypto"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// txSizeLimitDecorator is a custom decorator rejecting transactions larger
+// than a given amount of bytes.
+type txSizeLimitDecorator struct {
+	limit int
+}
+
+func (tsd txSizeLimitDecorator) AnteHandle(
+	ctx sdk.Context, tx sdk.Tx, simulate bool, next sdk.AnteHandler,
+) (sdk.Context, sdk.Result, bool) {
+
+	if len(ctx.TxBytes()) > tsd.limit {
+		return ctx, sdk.ErrTxDecode("tx too large").Result(), true
+	}
+
+	return next(ctx, tx, simulate)
+}
+
+// Test that a custom decorator can be inserted into the auth decorators chain.
+func TestCustomAnteDecorator(t *testing.T) {
+	input := setupTestInput()
+	ctx := input.ctx.WithBlockHeight(1)
+
+	priv1, _, addr1 := KeyTestPubAddr()
+	acc1 := input.ak.NewAccountWithAddress(ctx, addr1)
+	input.sk.SetCoins(ctx, addr1, NewTestCoins())
+	input.ak.SetAccount(ctx, acc1)
+
+	anteHandler := sdk.ChainAnteDecorators(
+		NewSetUpContextDecorator(input.ak),
+		txSizeLimitDecorator{limit: 10},
+		NewValidateBasicDecorator(),
+		NewDeductFeeDecorator(input.ak, input.sk, nil),
+		NewSetPubKeyDecorator(input.ak),
+		NewSigVerificationDecorator(input.ak, DefaultSigVerificationGasConsumer),
+		NewIncrementSequenceDecorator(input.ak),
+	)
+
+	msgs := []sdk.Msg{NewTestMsg(addr1)}
+	privs, accNums, seqs := []crypto.PrivKey{priv1}, []uint64{0}, []uint64{0}
+	tx := NewTestTx(ctx, msgs, privs, accNums, seqs, NewTestStdFee())
+
+	// the custom decorator rejects the tx before any fee is deducted
+	checkInvalidTx(t, anteHandler, ctx.WithTxBytes(make([]byte, 11)), tx, false, sdk.CodeTxDecode)
+	require.Equal(t, NewTestCoins(), input.sk.GetCoins(ctx, addr1))
+	require.Equal(t, uint64(0), input.ak.GetAccount(ctx, addr1).GetSequence())
+
+	checkValidTx(t, anteHandler, ctx.WithTxBytes(make([]byte, 10)), tx, false)
+	require.Equal(t, uint64(1), input.ak.GetAccount(ctx, addr1).GetSequence())
+	require.Equal(t, NewTestStdFee().Amount, input.sk.GetCoins(ctx, input.sk.GetModuleAddress(FeeCollectorName)))
+}
+
+// Test that the changes made to the signer accounts are persisted without
+// relying on any other decorator, whether the decorator is wrapped by
+// SetUpContextDecorator or not.
+func TestSetPubKeyDecoratorPersistsAccounts(t *testing.T) {
+	input := setupTestInput()
+	ctx := input.ctx.WithBlockHeight(1)
+
+	priv1, _, addr1 := KeyTestPubAddr()
+	priv2, _, addr2 := KeyTestPubAddr()
+	input.ak.SetAccount(ctx, input.ak.NewAccountWithAddress(ctx, addr1))
+	input.ak.SetAccount(ctx, input.ak.NewAccountWithAddress(ctx, addr2))
+
+	anteHandler := sdk.ChainAnteDecorators(
+		NewSetUpContextDecorator(input.ak),
+		NewSetPubKeyDecorator(input.ak),
+	)
+	msgs := []sdk.Msg{NewTestMsg(addr1)}
+	privs, accNums, seqs := []crypto.PrivKey{priv1}, []uint64{0}, []uint64{0}
+	tx := NewTestTx(ctx, msgs, privs, accNums, seqs, NewTestStdFee())
+
+	checkValidTx(t, anteHandler, ctx, tx, false)
+	require.Equal(t, priv1.PubKey(), input.ak.GetAccount(ctx, addr1).GetPubKey())
+	require.Equal(t, uint64(0), input.ak.GetAccount(ctx, addr1).GetSequence())
+
+	anteHandler = sdk.ChainAnteDecorators(NewSetPubKeyDecorator(input.ak))
+	msgs = []sdk.Msg{NewTestMsg(addr2)}
+	privs, accNums, seqs = []crypto.PrivKey{priv2}, []uint64{1}, []uint64{0}
+	tx = NewTestTx(ctx, msgs, privs, accNums, seqs, NewTestStdFee())
+
+	_, res, abort := anteHandler(ctx, tx, false)
+	require.False(t, abort, res.Log)
+	require.Equal(t, priv2.PubKey(), input.ak.GetAccount(ctx, addr2).GetPubKey())
+}
+
+// Test that the gas of each signature is consumed right before it is
+// verified, so that no gas is consumed for the signatures following an invalid
+// one.
+func TestSigGasConsumedPerSignature(t *testing.T) {
+	input := setupTestInput()
+	ctx := input.ctx.WithBlockHeight(1)
+
+	var charged int
+	sigGasConsumer := func(meter sdk.GasMeter, sig []byte, pubkey crypto.PubKey, params Params) sdk.Result {
+		charged++
+		return DefaultSigVerificationGasConsumer(meter, sig, pubkey, params)
+	}
+	anteHandler := sdk.ChainAnteDecorators(
+		NewSetUpContextDecorator(input.ak),
+		NewSetPubKeyDecorator(input.ak),
+		NewSigVerificationDecorator(input.ak, sigGasConsumer),
+	)
+
+	priv1, _, addr1 := KeyTestPubAddr()
+	priv2, _, addr2 := KeyTestPubAddr()
+	input.ak.SetAccount(ctx, input.ak.NewAccountWithAddress(ctx, addr1))
+	input.ak.SetAccount(ctx, input.ak.NewAccountWithAddress(ctx, addr2))
+
+	// the signature of the first signer is invalid as its sequence is wrong
+	msgs := []sdk.Msg{NewTestMsg(addr1, addr2)}
+	privs, accNums, seqs := []crypto.PrivKey{priv1, priv2}, []uint64{0, 1}, []uint64{1, 0}
+	tx := NewTestTx(ctx, msgs, privs, accNums, seqs, NewTestStdFee())
+
+	checkInvalidTx(t, anteHandler, ctx, tx, false, sdk.CodeUnauthorized)
+	require.Equal(t, 1, charged)
+
+	// both signatures are charged once the first one is valid
+	charged = 0
+	seqs = []uint64{0, 0}
+	tx = NewTestTx(ctx, msgs, privs, accNums, seqs, NewTestStdFee())
+	checkValidTx(t, anteHandler, ctx, tx, false)
+	require.Equal(t, 2, charged)
+}
+
+// feeGrantKeeper is a fee grant keeper granting each grantee a fixed fee
+// allowance from a single granter.
+type feeGrantKeeper struct {
+	granter    sdk.AccAddress
+	allowances map[string]sdk.Coins
+}
+
+func (fgk feeGrantKeeper) UseGrantedFees(ctx sdk.Context, granter, grantee sdk.AccAddress, fee sdk.Coins) sdk.Error {
+	allowance, ok := fgk.allowances[grantee.String()]
+	if !ok || !granter.Equals(fgk.granter) {
+		return sdk.ErrUnauthorized("no allowance")
+	}
+
+	left, hasNeg := allowance.SafeSub(fee)
+	if hasNeg {
+		return sdk.ErrInsufficientFee("allowance exceeded")
+	}
+
+	fgk.allowances[grantee.String()] = left
+	return nil
+}
+
+// Test that the fees are deducted from the granter when the transaction sets
+// one.
+func TestDeductFeesFromGranter(t *testing.T) {
+	input := setupTestInput()
+	ctx := input.ctx.WithBlockHeight(1)
+
+	priv1, _, addr1 := KeyTestPubAddr()
+	priv2, _, addr2 := KeyTestPubAddr()
+	// the grantee holds no coins
+	input.ak.SetAccount(ctx, input.ak.NewAccountWithAddress(ctx, addr1))
+
+	granter := input.ak.NewAccountWithAddress(ctx, addr2)
+	input.sk.SetCoins(ctx, addr2, NewTestCoins())
+	input.ak.SetAccount(ctx, granter)
+
+	fee := NewTestStdFee()
+	fee.Granter = addr2
+
+	msgs := []sdk.Msg{NewTestMsg(addr1)}
+	privs, accNums, seqs := []crypto.PrivKey{priv1}, []uint64{0}, []uint64{0}
+	tx := NewTestTx(ctx, msgs, privs, accNums, seqs, fee)
+
+	// fee grants are not supported without a fee grant keeper
+	anteHandler := NewAnteHandler(input.ak, input.sk, nil, DefaultSigVerificationGasConsumer)
+	checkInvalidTx(t, anteHandler, ctx, tx, false, sdk.CodeUnauthorized)
+
+	fgk := feeGrantKeeper{granter: addr2, allowances: map[string]sdk.Coins{
+		addr1.String(): fee.Amount,
+	}}
+	anteHandler = NewAnteHandler(input.ak, input.sk, fgk, DefaultSigVerificationGasConsumer)
+
+	// the fees are paid by the granter
+	checkValidTx(t, anteHandler, ctx, tx, false)
+	require.True(t, input.sk.GetCoins(ctx, addr1).Empty())
+	require.Equal(t, uint64(1), input.ak.GetAccount(ctx, addr1).GetSequence())
+	require.Equal(t, NewTestCoins().Sub(fee.Amount), input.sk.GetCoins(ctx, addr2))
+	require.Equal(t, fee.Amount, input.sk.GetCoins(ctx, input.sk.GetModuleAddress(FeeCollectorName)))
+
+	// the allowance is used up
+	seqs = []uint64{1}
+	tx = NewTestTx(ctx, msgs, privs, accNums, seqs, fee)
+	checkInvalidTx(t, anteHandler, ctx, tx, false, sdk.CodeInsufficientFee)
+
+	// the granter may also sign the transaction, in which case the fees
+	// deducted from it must not be overwritten when persisting the signers
+	msgs = []sdk.Msg{NewTestMsg(addr1, addr2)}
+	fgk.allowances[addr1.String()] = fee.Amount
+	privs, accNums, seqs = []crypto.PrivKey{priv1, priv2}, []uint64{0, 1}, []uint64{1, 0}
+	tx = NewTestTx(ctx, msgs, privs, accNums, seqs, fee)
+
+	checkValidTx(t, anteHandler, ctx, tx, false)
+	require.Equal(t, NewTestCoins().Sub(fee.Amount).Sub(fee.Amount), input.sk.GetCoins(ctx, addr2))
+	require.Equal(t, uint64(1), input.ak.GetAccount(ctx, addr2).GetSequence())
+}
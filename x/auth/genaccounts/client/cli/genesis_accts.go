@@ -2,6 +2,7 @@ package cli
 
 import (
 	"fmt"
+	"io/ioutil"
 
 	"github.com/spf13/cobra"
 	"github.com/spf13/viper"
@@ -11,15 +12,17 @@ import (
 	"github.com/cosmos/cosmos-sdk/codec"
 	"github.com/cosmos/cosmos-sdk/server"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/auth"
 	"github.com/cosmos/cosmos-sdk/x/auth/genaccounts"
 	"github.com/cosmos/cosmos-sdk/x/genutil"
 )
 
 const (
-	flagClientHome   = "home-client"
-	flagVestingStart = "vesting-start-time"
-	flagVestingEnd   = "vesting-end-time"
-	flagVestingAmt   = "vesting-amount"
+	flagClientHome     = "home-client"
+	flagVestingStart   = "vesting-start-time"
+	flagVestingEnd     = "vesting-end-time"
+	flagVestingAmt     = "vesting-amount"
+	flagVestingPeriods = "vesting-periods"
 )
 
 // AddGenesisAccountCmd returns add-genesis-account cobra Command.
@@ -60,7 +63,29 @@ func AddGenesisAccountCmd(ctx *server.Context, cdc *codec.Codec,
 				return err
 			}
 
-			genAcc := genaccounts.NewGenesisAccountRaw(addr, coins, vestingAmt, vestingStart, vestingEnd)
+			// a periodic vesting schedule defaults the vesting amount and end time
+			// to the ones implied by its periods
+			var vestingPeriods auth.Periods
+			if periodsFile := viper.GetString(flagVestingPeriods); periodsFile != "" {
+				bz, err := ioutil.ReadFile(periodsFile)
+				if err != nil {
+					return err
+				}
+
+				if err := cdc.UnmarshalJSON(bz, &vestingPeriods); err != nil {
+					return err
+				}
+
+				if vestingAmt.IsZero() {
+					vestingAmt = vestingPeriods.TotalAmount()
+				}
+				if vestingEnd == 0 {
+					vestingEnd = vestingStart + vestingPeriods.TotalLength()
+				}
+			}
+
+			genAcc := genaccounts.NewGenesisAccountRaw(addr, coins, vestingAmt, vestingStart, vestingEnd, "")
+			genAcc.VestingPeriods = vestingPeriods
 			if err := genAcc.Validate(); err != nil {
 				return err
 			}
@@ -103,5 +128,6 @@ func AddGenesisAccountCmd(ctx *server.Context, cdc *codec.Codec,
 	cmd.Flags().String(flagVestingAmt, "", "amount of coins for vesting accounts")
 	cmd.Flags().Uint64(flagVestingStart, 0, "schedule start time (unix epoch) for vesting accounts")
 	cmd.Flags().Uint64(flagVestingEnd, 0, "schedule end time (unix epoch) for vesting accounts")
+	cmd.Flags().String(flagVestingPeriods, "", "JSON file with the vesting periods (length in seconds and amount) of periodic vesting accounts, starting at the schedule start time")
 	return cmd
 }
@@ -6,13 +6,13 @@ import (
 )
 
 // export genesis for all accounts
-func ExportGenesis(ctx sdk.Context, accountKeeper AccountKeeper) GenesisState {
+func ExportGenesis(ctx sdk.Context, accountKeeper AccountKeeper, bankKeeper BankKeeper) GenesisState {
 
 	// iterate to get the accounts
 	accounts := []GenesisAccount{}
 	accountKeeper.IterateAccounts(ctx,
 		func(acc auth.Account) (stop bool) {
-			account, err := NewGenesisAccountI(acc)
+			account, err := NewGenesisAccountI(acc, bankKeeper.GetCoins(ctx, acc.GetAddress()))
 			if err != nil {
 				panic(err)
 			}
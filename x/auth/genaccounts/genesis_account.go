@@ -3,6 +3,7 @@ package genaccounts
 import (
 	"errors"
 	"fmt"
+	"strings"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/x/auth"
@@ -21,9 +22,16 @@ type GenesisAccount struct {
 	DelegatedVesting sdk.Coins `json:"delegated_vesting"` // delegated vesting coins at time of delegation
 	StartTime        int64     `json:"start_time"`        // vesting start time (UNIX Epoch time)
 	EndTime          int64     `json:"end_time"`          // vesting end time (UNIX Epoch time)
+
+	// periodic vesting account fields
+	VestingPeriods auth.Periods `json:"vesting_periods,omitempty"` // vesting schedule of a periodic vesting account
+
+	// module account fields
+	ModuleName        string   `json:"module_name"`        // name of the module account
+	ModulePermissions []string `json:"module_permissions"` // permissions of module account
 }
 
-// validate the the VestingAccount parameters are possible
+// validate the the VestingAccount and ModuleAccount parameters are possible
 func (ga GenesisAccount) Validate() error {
 	if !ga.OriginalVesting.IsZero() {
 		if ga.OriginalVesting.IsAnyGT(ga.Coins) {
@@ -33,39 +41,69 @@ func (ga GenesisAccount) Validate() error {
 			return errors.New("vesting start-time cannot be before end-time")
 		}
 	}
+
+	if len(ga.VestingPeriods) > 0 {
+		if err := ga.VestingPeriods.Validate(); err != nil {
+			return err
+		}
+		if ga.StartTime+ga.VestingPeriods.TotalLength() != ga.EndTime {
+			return errors.New("vesting periods must span from the vesting start-time to the end-time")
+		}
+		total := ga.VestingPeriods.TotalAmount()
+		if !total.IsAllGTE(ga.OriginalVesting) || !ga.OriginalVesting.IsAllGTE(total) {
+			return errors.New("vesting periods must add up to the vesting amount")
+		}
+	}
+
+	// don't allow blank (i.e just whitespaces) on the module name
+	if ga.ModuleName != "" && strings.TrimSpace(ga.ModuleName) == "" {
+		return errors.New("module account name cannot be blank")
+	}
+
+	if ga.ModuleName != "" && !ga.Address.Equals(auth.NewModuleAddress(ga.ModuleName)) {
+		return fmt.Errorf("address %s cannot be derived from the module name '%s'", ga.Address, ga.ModuleName)
+	}
+
 	return nil
 }
 
 // NewGenesisAccount creates a new GenesisAccount object
 func NewGenesisAccountRaw(address sdk.AccAddress, coins,
-	vestingAmount sdk.Coins, vestingStartTime, vestingEndTime int64) GenesisAccount {
+	vestingAmount sdk.Coins, vestingStartTime, vestingEndTime int64,
+	module string, permissions ...string) GenesisAccount {
 
 	return GenesisAccount{
-		Address:          address,
-		Coins:            coins,
-		Sequence:         0,
-		AccountNumber:    0, // ignored set by the account keeper during InitGenesis
-		OriginalVesting:  vestingAmount,
-		DelegatedFree:    sdk.Coins{}, // ignored
-		DelegatedVesting: sdk.Coins{}, // ignored
-		StartTime:        vestingStartTime,
-		EndTime:          vestingEndTime,
+		Address:           address,
+		Coins:             coins,
+		Sequence:          0,
+		AccountNumber:     0, // ignored set by the account keeper during InitGenesis
+		OriginalVesting:   vestingAmount,
+		DelegatedFree:     sdk.Coins{}, // ignored
+		DelegatedVesting:  sdk.Coins{}, // ignored
+		StartTime:         vestingStartTime,
+		EndTime:           vestingEndTime,
+		ModuleName:        module,
+		ModulePermissions: permissions,
 	}
 }
 
-func NewGenesisAccount(acc *auth.BaseAccount) GenesisAccount {
+// NewGenesisAccount creates a new GenesisAccount from a base account and the
+// coins it holds.
+func NewGenesisAccount(acc *auth.BaseAccount, coins sdk.Coins) GenesisAccount {
 	return GenesisAccount{
 		Address:       acc.Address,
-		Coins:         acc.Coins,
+		Coins:         coins,
 		AccountNumber: acc.AccountNumber,
 		Sequence:      acc.Sequence,
 	}
 }
 
-func NewGenesisAccountI(acc auth.Account) (GenesisAccount, error) {
+// NewGenesisAccountI creates a new GenesisAccount from an account and the coins
+// it holds.
+func NewGenesisAccountI(acc auth.Account, coins sdk.Coins) (GenesisAccount, error) {
 	gacc := GenesisAccount{
 		Address:       acc.GetAddress(),
-		Coins:         acc.GetCoins(),
+		Coins:         coins,
 		AccountNumber: acc.GetAccountNumber(),
 		Sequence:      acc.GetSequence(),
 	}
@@ -74,22 +112,31 @@ func NewGenesisAccountI(acc auth.Account) (GenesisAccount, error) {
 		return gacc, err
 	}
 
-	vacc, ok := acc.(auth.VestingAccount)
-	if ok {
-		gacc.OriginalVesting = vacc.GetOriginalVesting()
-		gacc.DelegatedFree = vacc.GetDelegatedFree()
-		gacc.DelegatedVesting = vacc.GetDelegatedVesting()
-		gacc.StartTime = vacc.GetStartTime()
-		gacc.EndTime = vacc.GetEndTime()
+	switch acc := acc.(type) {
+	case auth.VestingAccount:
+		gacc.OriginalVesting = acc.GetOriginalVesting()
+		gacc.DelegatedFree = acc.GetDelegatedFree()
+		gacc.DelegatedVesting = acc.GetDelegatedVesting()
+		gacc.StartTime = acc.GetStartTime()
+		gacc.EndTime = acc.GetEndTime()
+
+		if pva, ok := acc.(*auth.PeriodicVestingAccount); ok {
+			gacc.VestingPeriods = pva.GetVestingPeriods()
+		}
+	case auth.ModuleAccountI:
+		gacc.ModuleName = acc.GetName()
+		gacc.ModulePermissions = acc.GetPermissions()
 	}
 
 	return gacc, nil
 }
 
-// convert GenesisAccount to auth.Account
+// convert GenesisAccount to auth.Account. The coins aren't part of the account
+// and are set in the bank module instead.
 func (ga *GenesisAccount) ToAccount() auth.Account {
-	bacc := auth.NewBaseAccount(ga.Address, ga.Coins.Sort(), nil, ga.AccountNumber, ga.Sequence)
+	bacc := auth.NewBaseAccount(ga.Address, nil, ga.AccountNumber, ga.Sequence)
 
+	// vesting accounts
 	if !ga.OriginalVesting.IsZero() {
 		baseVestingAcc := auth.NewBaseVestingAccount(
 			bacc, ga.OriginalVesting, ga.DelegatedFree,
@@ -97,6 +144,8 @@ func (ga *GenesisAccount) ToAccount() auth.Account {
 		)
 
 		switch {
+		case len(ga.VestingPeriods) > 0:
+			return auth.NewPeriodicVestingAccountRaw(baseVestingAcc, ga.StartTime, ga.VestingPeriods)
 		case ga.StartTime != 0 && ga.EndTime != 0:
 			return auth.NewContinuousVestingAccountRaw(baseVestingAcc, ga.StartTime)
 		case ga.EndTime != 0:
@@ -106,6 +155,11 @@ func (ga *GenesisAccount) ToAccount() auth.Account {
 		}
 	}
 
+	// module accounts
+	if ga.ModuleName != "" {
+		return auth.NewModuleAccount(bacc, ga.ModuleName, ga.ModulePermissions...)
+	}
+
 	return bacc
 }
 
@@ -2,6 +2,7 @@ package genaccounts
 
 import (
 	"errors"
+	"fmt"
 	"testing"
 	"time"
 
@@ -15,6 +16,17 @@ import (
 
 func TestGenesisAccountValidate(t *testing.T) {
 	addr := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+	periods := auth.Periods{
+		{Length: 100, Amount: sdk.NewCoins(sdk.NewInt64Coin("stake", 20))},
+		{Length: 200, Amount: sdk.NewCoins(sdk.NewInt64Coin("stake", 30))},
+	}
+	periodicAcc := func(vestingAmt sdk.Coins, endTime int64, periods auth.Periods) GenesisAccount {
+		acc := NewGenesisAccountRaw(addr, sdk.NewCoins(sdk.NewInt64Coin("stake", 50)),
+			vestingAmt, 1554668078, endTime, "")
+		acc.VestingPeriods = periods
+		return acc
+	}
+
 	tests := []struct {
 		name   string
 		acc    GenesisAccount
@@ -22,13 +34,13 @@ func TestGenesisAccountValidate(t *testing.T) {
 	}{
 		{
 			"valid account",
-			NewGenesisAccountRaw(addr, sdk.NewCoins(), sdk.NewCoins(), 0, 0),
+			NewGenesisAccountRaw(addr, sdk.NewCoins(), sdk.NewCoins(), 0, 0, ""),
 			nil,
 		},
 		{
 			"invalid vesting amount",
 			NewGenesisAccountRaw(addr, sdk.NewCoins(sdk.NewInt64Coin("stake", 50)),
-				sdk.NewCoins(sdk.NewInt64Coin("stake", 100)), 0, 0),
+				sdk.NewCoins(sdk.NewInt64Coin("stake", 100)), 0, 0, ""),
 			errors.New("vesting amount cannot be greater than total amount"),
 		},
 		{
@@ -36,16 +48,56 @@ func TestGenesisAccountValidate(t *testing.T) {
 			NewGenesisAccountRaw(addr,
 				sdk.NewCoins(sdk.NewInt64Coin("uatom", 50), sdk.NewInt64Coin("eth", 50)),
 				sdk.NewCoins(sdk.NewInt64Coin("uatom", 100), sdk.NewInt64Coin("eth", 20)),
-				0, 0),
+				0, 0, ""),
 			errors.New("vesting amount cannot be greater than total amount"),
 		},
 		{
 			"invalid vesting times",
 			NewGenesisAccountRaw(addr, sdk.NewCoins(sdk.NewInt64Coin("stake", 50)),
-				sdk.NewCoins(sdk.NewInt64Coin("stake", 50)), 1654668078, 1554668078),
+				sdk.NewCoins(sdk.NewInt64Coin("stake", 50)), 1654668078, 1554668078, ""),
 			errors.New("vesting start-time cannot be before end-time"),
 		},
+		{
+			"valid module account",
+			NewGenesisAccountRaw(auth.NewModuleAddress("testmodule"), sdk.NewCoins(),
+				sdk.NewCoins(), 0, 0, "testmodule", auth.Burner),
+			nil,
+		},
+		{
+			"invalid module account name",
+			NewGenesisAccountRaw(auth.NewModuleAddress("   "), sdk.NewCoins(),
+				sdk.NewCoins(), 0, 0, "   "),
+			errors.New("module account name cannot be blank"),
+		},
+		{
+			"invalid module account address",
+			NewGenesisAccountRaw(addr, sdk.NewCoins(),
+				sdk.NewCoins(), 0, 0, "testmodule"),
+			fmt.Errorf("address %s cannot be derived from the module name 'testmodule'", addr),
+		},
+		{
+			"valid periodic vesting account",
+			periodicAcc(sdk.NewCoins(sdk.NewInt64Coin("stake", 50)), 1554668378, periods),
+			nil,
+		},
+		{
+			"invalid vesting period length",
+			periodicAcc(sdk.NewCoins(sdk.NewInt64Coin("stake", 50)), 1554668378,
+				auth.Periods{{Length: 0, Amount: sdk.NewCoins(sdk.NewInt64Coin("stake", 50))}}),
+			errors.New("vesting period 0 must have a positive length"),
+		},
+		{
+			"invalid vesting periods end time",
+			periodicAcc(sdk.NewCoins(sdk.NewInt64Coin("stake", 50)), 1554668379, periods),
+			errors.New("vesting periods must span from the vesting start-time to the end-time"),
+		},
+		{
+			"invalid vesting periods amount",
+			periodicAcc(sdk.NewCoins(sdk.NewInt64Coin("stake", 40)), 1554668378, periods),
+			errors.New("vesting periods must add up to the vesting amount"),
+		},
 	}
+
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
 			err := tt.acc.Validate()
@@ -58,18 +110,38 @@ func TestToAccount(t *testing.T) {
 	priv := ed25519.GenPrivKey()
 	addr := sdk.AccAddress(priv.PubKey().Address())
 	authAcc := auth.NewBaseAccountWithAddress(addr)
-	authAcc.SetCoins(sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 150)))
-	genAcc := NewGenesisAccount(&authAcc)
+	coins := sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 150))
+	genAcc := NewGenesisAccount(&authAcc, coins)
 	acc := genAcc.ToAccount()
 	require.IsType(t, &auth.BaseAccount{}, acc)
 	require.Equal(t, &authAcc, acc.(*auth.BaseAccount))
 
 	vacc := auth.NewContinuousVestingAccount(
-		&authAcc, time.Now().Unix(), time.Now().Add(24*time.Hour).Unix(),
+		&authAcc, coins, time.Now().Unix(), time.Now().Add(24*time.Hour).Unix(),
 	)
-	genAcc, err := NewGenesisAccountI(vacc)
+	genAcc, err := NewGenesisAccountI(vacc, coins)
 	require.NoError(t, err)
 	acc = genAcc.ToAccount()
 	require.IsType(t, &auth.ContinuousVestingAccount{}, acc)
 	require.Equal(t, vacc, acc.(*auth.ContinuousVestingAccount))
+
+	pvacc := auth.NewPeriodicVestingAccount(
+		&authAcc, time.Now().Unix(), auth.Periods{
+			{Length: 3600, Amount: sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 50))},
+			{Length: 7200, Amount: sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 100))},
+		},
+	)
+	genAcc, err = NewGenesisAccountI(pvacc, coins)
+	require.NoError(t, err)
+	require.NoError(t, genAcc.Validate())
+	acc = genAcc.ToAccount()
+	require.IsType(t, &auth.PeriodicVestingAccount{}, acc)
+	require.Equal(t, pvacc, acc.(*auth.PeriodicVestingAccount))
+
+	macc := auth.NewEmptyModuleAccount("testmodule", auth.Burner)
+	genAcc, err = NewGenesisAccountI(macc, sdk.NewCoins())
+	require.NoError(t, err)
+	acc = genAcc.ToAccount()
+	require.IsType(t, &auth.ModuleAccount{}, acc)
+	require.Equal(t, macc, acc.(*auth.ModuleAccount))
 }
@@ -13,20 +13,18 @@ func TestSanitize(t *testing.T) {
 
 	addr1 := sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address())
 	authAcc1 := auth.NewBaseAccountWithAddress(addr1)
-	authAcc1.SetCoins(sdk.Coins{
+	authAcc1.SetAccountNumber(1)
+	genAcc1 := NewGenesisAccount(&authAcc1, sdk.Coins{
 		sdk.NewInt64Coin("bcoin", 150),
 		sdk.NewInt64Coin("acoin", 150),
 	})
-	authAcc1.SetAccountNumber(1)
-	genAcc1 := NewGenesisAccount(&authAcc1)
 
 	addr2 := sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address())
 	authAcc2 := auth.NewBaseAccountWithAddress(addr2)
-	authAcc2.SetCoins(sdk.Coins{
+	genAcc2 := NewGenesisAccount(&authAcc2, sdk.Coins{
 		sdk.NewInt64Coin("acoin", 150),
 		sdk.NewInt64Coin("bcoin", 150),
 	})
-	genAcc2 := NewGenesisAccount(&authAcc2)
 
 	genesisState := GenesisState([]GenesisAccount{genAcc1, genAcc2})
 	require.NoError(t, ValidateGenesis(genesisState))
@@ -51,11 +49,12 @@ var (
 // require duplicate accounts fails validation
 func TestValidateGenesisDuplicateAccounts(t *testing.T) {
 	acc1 := auth.NewBaseAccountWithAddress(sdk.AccAddress(addr1))
-	acc1.Coins = sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 150))
+
+	coins := sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 150))
 
 	genAccs := make([]GenesisAccount, 2)
-	genAccs[0] = NewGenesisAccount(&acc1)
-	genAccs[1] = NewGenesisAccount(&acc1)
+	genAccs[0] = NewGenesisAccount(&acc1, coins)
+	genAccs[1] = NewGenesisAccount(&acc1, coins)
 
 	genesisState := GenesisState(genAccs)
 	err := ValidateGenesis(genesisState)
@@ -65,13 +64,13 @@ func TestValidateGenesisDuplicateAccounts(t *testing.T) {
 // require invalid vesting account fails validation (invalid end time)
 func TestValidateGenesisInvalidAccounts(t *testing.T) {
 	acc1 := auth.NewBaseAccountWithAddress(sdk.AccAddress(addr1))
-	acc1.Coins = sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 150))
 	acc2 := auth.NewBaseAccountWithAddress(sdk.AccAddress(addr2))
-	acc2.Coins = sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 150))
+
+	coins := sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 150))
 
 	genAccs := make([]GenesisAccount, 2)
-	genAccs[0] = NewGenesisAccount(&acc1)
-	genAccs[1] = NewGenesisAccount(&acc2)
+	genAccs[0] = NewGenesisAccount(&acc1, coins)
+	genAccs[1] = NewGenesisAccount(&acc2, coins)
 
 	genesisState := GenesisState(genAccs)
 	genesisState[0].OriginalVesting = genesisState[0].Coins
@@ -6,7 +6,7 @@ import (
 )
 
 // initialize accounts and deliver genesis transactions
-func InitGenesis(ctx sdk.Context, _ *codec.Codec, accountKeeper AccountKeeper, genesisState GenesisState) {
+func InitGenesis(ctx sdk.Context, _ *codec.Codec, accountKeeper AccountKeeper, bankKeeper BankKeeper, genesisState GenesisState) {
 	genesisState.Sanitize()
 
 	// load the accounts
@@ -14,5 +14,10 @@ func InitGenesis(ctx sdk.Context, _ *codec.Codec, accountKeeper AccountKeeper, g
 		acc := gacc.ToAccount()
 		acc = accountKeeper.NewAccount(ctx, acc) // set account number
 		accountKeeper.SetAccount(ctx, acc)
+
+		// the balances are owned by the bank module
+		if err := bankKeeper.SetCoins(ctx, acc.GetAddress(), gacc.Coins.Sort()); err != nil {
+			panic(err)
+		}
 	}
 }
@@ -62,12 +62,12 @@ func (AppModuleBasic) GetQueryCmd(_ *codec.Codec) *cobra.Command { return nil }
 // iterate the genesis accounts and perform an operation at each of them
 // - to used by other modules
 func (AppModuleBasic) IterateGenesisAccounts(cdc *codec.Codec, appGenesis map[string]json.RawMessage,
-	iterateFn func(auth.Account) (stop bool)) {
+	iterateFn func(acc auth.Account, coins sdk.Coins) (stop bool)) {
 
 	genesisState := GetGenesisStateFromAppState(cdc, appGenesis)
 	for _, genAcc := range genesisState {
 		acc := genAcc.ToAccount()
-		if iterateFn(acc) {
+		if iterateFn(acc, genAcc.Coins) {
 			break
 		}
 	}
@@ -78,14 +78,16 @@ func (AppModuleBasic) IterateGenesisAccounts(cdc *codec.Codec, appGenesis map[st
 type AppModule struct {
 	AppModuleBasic
 	accountKeeper AccountKeeper
+	bankKeeper    BankKeeper
 }
 
 // NewAppModule creates a new AppModule object
-func NewAppModule(accountKeeper AccountKeeper) module.AppModule {
+func NewAppModule(accountKeeper AccountKeeper, bankKeeper BankKeeper) module.AppModule {
 
 	return module.NewGenesisOnlyAppModule(AppModule{
 		AppModuleBasic: AppModuleBasic{},
 		accountKeeper:  accountKeeper,
+		bankKeeper:     bankKeeper,
 	})
 }
 
@@ -93,12 +95,12 @@ func NewAppModule(accountKeeper AccountKeeper) module.AppModule {
 func (am AppModule) InitGenesis(ctx sdk.Context, data json.RawMessage) []abci.ValidatorUpdate {
 	var genesisState GenesisState
 	moduleCdc.MustUnmarshalJSON(data, &genesisState)
-	InitGenesis(ctx, moduleCdc, am.accountKeeper, genesisState)
+	InitGenesis(ctx, moduleCdc, am.accountKeeper, am.bankKeeper, genesisState)
 	return []abci.ValidatorUpdate{}
 }
 
 // module export genesis
 func (am AppModule) ExportGenesis(ctx sdk.Context) json.RawMessage {
-	gs := ExportGenesis(ctx, am.accountKeeper)
+	gs := ExportGenesis(ctx, am.accountKeeper, am.bankKeeper)
 	return moduleCdc.MustMarshalJSON(gs)
 }
@@ -6,15 +6,13 @@ import (
 )
 
 // InitGenesis - Init store state from genesis data
-func InitGenesis(ctx sdk.Context, ak AccountKeeper, fck types.FeeCollectionKeeper, data types.GenesisState) {
+func InitGenesis(ctx sdk.Context, ak AccountKeeper, data types.GenesisState) {
 	ak.SetParams(ctx, data.Params)
-	fck.AddCollectedFees(ctx, data.CollectedFees)
 }
 
 // ExportGenesis returns a GenesisState for a given context and keeper
-func ExportGenesis(ctx sdk.Context, ak AccountKeeper, fck types.FeeCollectionKeeper) types.GenesisState {
-	collectedFees := fck.GetCollectedFees(ctx)
+func ExportGenesis(ctx sdk.Context, ak AccountKeeper) types.GenesisState {
 	params := ak.GetParams(ctx)
 
-	return types.NewGenesisState(collectedFees, params)
+	return types.NewGenesisState(params)
 }
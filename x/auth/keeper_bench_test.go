@@ -24,33 +24,6 @@ func BenchmarkAccountMapperGetAccountFound(b *testing.B) {
 	}
 }
 
-func BenchmarkAccountMapperGetAccountFoundWithCoins(b *testing.B) {
-	input := setupTestInput()
-	coins := sdk.Coins{
-		sdk.NewCoin("LTC", sdk.NewInt(1000)),
-		sdk.NewCoin("BTC", sdk.NewInt(1000)),
-		sdk.NewCoin("ETH", sdk.NewInt(1000)),
-		sdk.NewCoin("XRP", sdk.NewInt(1000)),
-		sdk.NewCoin("BCH", sdk.NewInt(1000)),
-		sdk.NewCoin("EOS", sdk.NewInt(1000)),
-	}
-
-	// assumes b.N < 2**24
-	for i := 0; i < b.N; i++ {
-		arr := []byte{byte((i & 0xFF0000) >> 16), byte((i & 0xFF00) >> 8), byte(i & 0xFF)}
-		addr := sdk.AccAddress(arr)
-		acc := input.ak.NewAccountWithAddress(input.ctx, addr)
-		acc.SetCoins(coins)
-		input.ak.SetAccount(input.ctx, acc)
-	}
-
-	b.ResetTimer()
-	for i := 0; i < b.N; i++ {
-		arr := []byte{byte((i & 0xFF0000) >> 16), byte((i & 0xFF00) >> 8), byte(i & 0xFF)}
-		input.ak.GetAccount(input.ctx, sdk.AccAddress(arr))
-	}
-}
-
 func BenchmarkAccountMapperSetAccount(b *testing.B) {
 	input := setupTestInput()
 
@@ -64,26 +37,3 @@ func BenchmarkAccountMapperSetAccount(b *testing.B) {
 		input.ak.SetAccount(input.ctx, acc)
 	}
 }
-
-func BenchmarkAccountMapperSetAccountWithCoins(b *testing.B) {
-	input := setupTestInput()
-	coins := sdk.Coins{
-		sdk.NewCoin("LTC", sdk.NewInt(1000)),
-		sdk.NewCoin("BTC", sdk.NewInt(1000)),
-		sdk.NewCoin("ETH", sdk.NewInt(1000)),
-		sdk.NewCoin("XRP", sdk.NewInt(1000)),
-		sdk.NewCoin("BCH", sdk.NewInt(1000)),
-		sdk.NewCoin("EOS", sdk.NewInt(1000)),
-	}
-
-	b.ResetTimer()
-
-	// assumes b.N < 2**24
-	for i := 0; i < b.N; i++ {
-		arr := []byte{byte((i & 0xFF0000) >> 16), byte((i & 0xFF00) >> 8), byte(i & 0xFF)}
-		addr := sdk.AccAddress(arr)
-		acc := input.ak.NewAccountWithAddress(input.ctx, addr)
-		acc.SetCoins(coins)
-		input.ak.SetAccount(input.ctx, acc)
-	}
-}
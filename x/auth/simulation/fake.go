@@ -8,18 +8,19 @@ import (
 	"github.com/cosmos/cosmos-sdk/baseapp"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/x/auth"
+	"github.com/cosmos/cosmos-sdk/x/auth/types"
 	"github.com/cosmos/cosmos-sdk/x/simulation"
 )
 
 // SimulateDeductFee
-func SimulateDeductFee(m auth.AccountKeeper, f auth.FeeCollectionKeeper) simulation.Operation {
+func SimulateDeductFee(ak auth.AccountKeeper, bk types.BankKeeper, supplyKeeper types.SupplyKeeper) simulation.Operation {
 	return func(r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context,
 		accs []simulation.Account) (
 		opMsg simulation.OperationMsg, fOps []simulation.FutureOperation, err error) {
 
 		account := simulation.RandomAcc(r, accs)
-		stored := m.GetAccount(ctx, account.Address)
-		initCoins := stored.GetCoins()
+		stored := ak.GetAccount(ctx, account.Address)
+		initCoins := bk.GetCoins(ctx, stored.GetAddress())
 		opMsg = simulation.NewOperationMsgBasic(auth.ModuleName, "deduct_fee", "", false, nil)
 
 		if len(initCoins) == 0 {
@@ -37,24 +38,16 @@ func SimulateDeductFee(m auth.AccountKeeper, f auth.FeeCollectionKeeper) simulat
 		// Create a random fee and verify the fees are within the account's spendable
 		// balance.
 		fees := sdk.Coins{sdk.NewCoin(randCoin.Denom, amt)}
-		spendableCoins := stored.SpendableCoins(ctx.BlockHeader().Time)
+		spendableCoins := bk.SpendableCoins(ctx, stored.GetAddress())
 		if _, hasNeg := spendableCoins.SafeSub(fees); hasNeg {
 			return opMsg, nil, nil
 		}
 
-		// get the new account balance
-		newCoins, hasNeg := initCoins.SafeSub(fees)
-		if hasNeg {
-			return opMsg, nil, nil
-		}
-
-		if err := stored.SetCoins(newCoins); err != nil {
+		err = supplyKeeper.SendCoinsFromAccountToModule(ctx, stored.GetAddress(), auth.FeeCollectorName, fees)
+		if err != nil {
 			panic(err)
 		}
 
-		m.SetAccount(ctx, stored)
-		f.AddCollectedFees(ctx, fees)
-
 		opMsg.OK = true
 		return opMsg, nil, nil
 	}
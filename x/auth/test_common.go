@@ -17,7 +17,7 @@ type testInput struct {
 	cdc *codec.Codec
 	ctx sdk.Context
 	ak  AccountKeeper
-	fck types.FeeCollectionKeeper
+	sk  DummySupplyKeeper
 }
 
 func setupTestInput() testInput {
@@ -27,23 +27,96 @@ func setupTestInput() testInput {
 	types.RegisterBaseAccount(cdc)
 
 	authCapKey := sdk.NewKVStoreKey("authCapKey")
-	fckCapKey := sdk.NewKVStoreKey("fckCapKey")
+	keyBalances := sdk.NewKVStoreKey("balances")
 	keyParams := sdk.NewKVStoreKey("subspace")
 	tkeyParams := sdk.NewTransientStoreKey("transient_subspace")
 
 	ms := store.NewCommitMultiStore(db)
 	ms.MountStoreWithDB(authCapKey, sdk.StoreTypeIAVL, db)
-	ms.MountStoreWithDB(fckCapKey, sdk.StoreTypeIAVL, db)
+	ms.MountStoreWithDB(keyBalances, sdk.StoreTypeIAVL, db)
 	ms.MountStoreWithDB(keyParams, sdk.StoreTypeIAVL, db)
 	ms.MountStoreWithDB(tkeyParams, sdk.StoreTypeTransient, db)
 	ms.LoadLatestVersion()
 
 	ps := subspace.NewSubspace(cdc, keyParams, tkeyParams, types.DefaultParamspace)
 	ak := NewAccountKeeper(cdc, authCapKey, ps, types.ProtoBaseAccount)
-	fck := types.NewFeeCollectionKeeper(cdc, fckCapKey)
+	sk := NewDummySupplyKeeper(ak, keyBalances)
 	ctx := sdk.NewContext(ms, abci.Header{ChainID: "test-chain-id"}, false, log.NewNopLogger())
 
 	ak.SetParams(ctx, types.DefaultParams())
 
-	return testInput{cdc: cdc, ctx: ctx, ak: ak, fck: fck}
+	return testInput{cdc: cdc, ctx: ctx, ak: ak, sk: sk}
+}
+
+// DummySupplyKeeper defines a supply keeper used only for testing to avoid
+// circle dependencies. It keeps the account balances in its own store.
+type DummySupplyKeeper struct {
+	ak  AccountKeeper
+	key sdk.StoreKey
+}
+
+// NewDummySupplyKeeper creates a DummySupplyKeeper instance
+func NewDummySupplyKeeper(ak AccountKeeper, key sdk.StoreKey) DummySupplyKeeper {
+	return DummySupplyKeeper{ak, key}
+}
+
+// GetCoins returns the balance of an account for the dummy supply keeper
+func (sk DummySupplyKeeper) GetCoins(ctx sdk.Context, addr sdk.AccAddress) sdk.Coins {
+	var coins sdk.Coins
+	bz := ctx.KVStore(sk.key).Get(addr)
+	if bz != nil {
+		types.ModuleCdc.MustUnmarshalBinaryBare(bz, &coins)
+	}
+	return coins
+}
+
+// SetCoins sets the balance of an account for the dummy supply keeper
+func (sk DummySupplyKeeper) SetCoins(ctx sdk.Context, addr sdk.AccAddress, coins sdk.Coins) {
+	if coins.Empty() {
+		ctx.KVStore(sk.key).Delete(addr)
+		return
+	}
+	ctx.KVStore(sk.key).Set(addr, types.ModuleCdc.MustMarshalBinaryBare(coins))
+}
+
+// SendCoinsFromAccountToModule for the dummy supply keeper
+func (sk DummySupplyKeeper) SendCoinsFromAccountToModule(ctx sdk.Context, fromAddr sdk.AccAddress, recipientModule string, amt sdk.Coins) sdk.Error {
+	moduleAcc := sk.GetModuleAccount(ctx, recipientModule)
+
+	fromCoins := sk.GetCoins(ctx, fromAddr)
+	newFromCoins, hasNeg := fromCoins.SafeSub(amt)
+	if hasNeg {
+		return sdk.ErrInsufficientCoins(fromCoins.String())
+	}
+
+	sk.SetCoins(ctx, fromAddr, newFromCoins)
+	sk.SetCoins(ctx, moduleAcc.GetAddress(), sk.GetCoins(ctx, moduleAcc.GetAddress()).Add(amt))
+
+	return nil
+}
+
+// GetModuleAccount for dummy supply keeper
+func (sk DummySupplyKeeper) GetModuleAccount(ctx sdk.Context, moduleName string) types.ModuleAccountI {
+	addr := sk.GetModuleAddress(moduleName)
+
+	acc := sk.ak.GetAccount(ctx, addr)
+	if acc != nil {
+		macc, ok := acc.(types.ModuleAccountI)
+		if ok {
+			return macc
+		}
+	}
+
+	moduleAccount := types.NewEmptyModuleAccount(moduleName, types.Minter)
+
+	// set the account number for the new account
+	maccI := (sk.ak.NewAccount(ctx, moduleAccount)).(types.ModuleAccountI)
+	sk.ak.SetAccount(ctx, maccI)
+
+	return maccI
+}
+
+// GetModuleAddress for dummy supply keeper
+func (sk DummySupplyKeeper) GetModuleAddress(moduleName string) sdk.AccAddress {
+	return types.NewModuleAddress(moduleName)
 }
@@ -3,6 +3,7 @@ package types
 import (
 	"errors"
 	"fmt"
+	"strings"
 	"time"
 
 	"github.com/tendermint/tendermint/crypto"
@@ -10,8 +11,9 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
-// Account is an interface used to store coins at a given address within state.
-// It presumes a notion of sequence numbers for replay protection,
+// Account is an interface used to store an account at a given address within
+// state. The coins held by an account are owned by the bank module and are not
+// part of it. It presumes a notion of sequence numbers for replay protection,
 // a notion of account numbers for replay protection for previously pruned accounts,
 // and a pubkey for authentication purposes.
 //
@@ -29,13 +31,6 @@ type Account interface {
 	GetSequence() uint64
 	SetSequence(uint64) error
 
-	GetCoins() sdk.Coins
-	SetCoins(sdk.Coins) error
-
-	// Calculates the amount of coins that can be sent to other accounts given
-	// the current time.
-	SpendableCoins(blockTime time.Time) sdk.Coins
-
 	// Ensure that account implements stringer
 	String() string
 }
@@ -44,9 +39,13 @@ type Account interface {
 type VestingAccount interface {
 	Account
 
-	// Delegation and undelegation accounting that returns the resulting base
-	// coins amount.
-	TrackDelegation(blockTime time.Time, amount sdk.Coins)
+	// LockedCoins returns the set of coins that are not spendable (i.e. locked)
+	// given the current time.
+	LockedCoins(blockTime time.Time) sdk.Coins
+
+	// Delegation and undelegation accounting. The balance is the amount of
+	// coins held by the account before the delegation.
+	TrackDelegation(blockTime time.Time, balance, amount sdk.Coins)
 	TrackUndelegation(amount sdk.Coins)
 
 	GetVestedCoins(blockTime time.Time) sdk.Coins
@@ -75,19 +74,17 @@ var _ Account = (*BaseAccount)(nil)
 // implements Account.
 type BaseAccount struct {
 	Address       sdk.AccAddress `json:"address"`
-	Coins         sdk.Coins      `json:"coins"`
 	PubKey        crypto.PubKey  `json:"public_key"`
 	AccountNumber uint64         `json:"account_number"`
 	Sequence      uint64         `json:"sequence"`
 }
 
 // NewBaseAccount creates a new BaseAccount object
-func NewBaseAccount(address sdk.AccAddress, coins sdk.Coins,
+func NewBaseAccount(address sdk.AccAddress,
 	pubKey crypto.PubKey, accountNumber uint64, sequence uint64) *BaseAccount {
 
 	return &BaseAccount{
 		Address:       address,
-		Coins:         coins,
 		PubKey:        pubKey,
 		AccountNumber: accountNumber,
 		Sequence:      sequence,
@@ -105,10 +102,9 @@ func (acc BaseAccount) String() string {
 	return fmt.Sprintf(`Account:
   Address:       %s
   Pubkey:        %s
-  Coins:         %s
   AccountNumber: %d
   Sequence:      %d`,
-		acc.Address, pubkey, acc.Coins, acc.AccountNumber, acc.Sequence,
+		acc.Address, pubkey, acc.AccountNumber, acc.Sequence,
 	)
 }
 
@@ -149,17 +145,6 @@ func (acc *BaseAccount) SetPubKey(pubKey crypto.PubKey) error {
 	return nil
 }
 
-// GetCoins - Implements sdk.Account.
-func (acc *BaseAccount) GetCoins() sdk.Coins {
-	return acc.Coins
-}
-
-// SetCoins - Implements sdk.Account.
-func (acc *BaseAccount) SetCoins(coins sdk.Coins) error {
-	acc.Coins = coins
-	return nil
-}
-
 // GetAccountNumber - Implements Account
 func (acc *BaseAccount) GetAccountNumber() uint64 {
 	return acc.AccountNumber
@@ -182,12 +167,6 @@ func (acc *BaseAccount) SetSequence(seq uint64) error {
 	return nil
 }
 
-// SpendableCoins returns the total set of spendable coins. For a base account,
-// this is simply the base coins.
-func (acc *BaseAccount) SpendableCoins(_ time.Time) sdk.Coins {
-	return acc.GetCoins()
-}
-
 //-----------------------------------------------------------------------------
 // Base Vesting Account
 
@@ -196,7 +175,7 @@ func (acc *BaseAccount) SpendableCoins(_ time.Time) sdk.Coins {
 type BaseVestingAccount struct {
 	*BaseAccount
 
-	OriginalVesting  sdk.Coins `json:"original_vesting"`  // coins in account upon initialization
+	OriginalVesting  sdk.Coins `json:"original_vesting"`  // coins vesting upon initialization
 	DelegatedFree    sdk.Coins `json:"delegated_free"`    // coins that are vested and delegated
 	DelegatedVesting sdk.Coins `json:"delegated_vesting"` // coins that vesting and delegated
 
@@ -227,58 +206,53 @@ func (bva BaseVestingAccount) String() string {
 	return fmt.Sprintf(`Vesting Account:
   Address:          %s
   Pubkey:           %s
-  Coins:            %s
   AccountNumber:    %d
   Sequence:         %d
   OriginalVesting:  %s
   DelegatedFree:    %s
   DelegatedVesting: %s
   EndTime:          %d `,
-		bva.Address, pubkey, bva.Coins, bva.AccountNumber, bva.Sequence,
+		bva.Address, pubkey, bva.AccountNumber, bva.Sequence,
 		bva.OriginalVesting, bva.DelegatedFree, bva.DelegatedVesting, bva.EndTime,
 	)
 }
 
-// spendableCoins returns all the spendable coins for a vesting account given a
-// set of vesting coins.
+// lockedCoins returns all the coins of a vesting account that are not
+// spendable given a set of vesting coins. Vesting coins that have been
+// delegated are not locked anymore as they are not held by the account.
 //
-// CONTRACT: The account's coins, delegated vesting coins, vestingCoins must be
-// sorted.
-func (bva BaseVestingAccount) spendableCoins(vestingCoins sdk.Coins) sdk.Coins {
-	var spendableCoins sdk.Coins
-	bc := bva.GetCoins()
+// CONTRACT: The delegated vesting coins and vestingCoins must be sorted.
+func (bva BaseVestingAccount) lockedCoins(vestingCoins sdk.Coins) sdk.Coins {
+	var lockedCoins sdk.Coins
 
-	for _, coin := range bc {
+	for _, coin := range vestingCoins {
 		// zip/lineup all coins by their denomination to provide O(n) time
-		baseAmt := coin.Amount
-		vestingAmt := vestingCoins.AmountOf(coin.Denom)
+		vestingAmt := coin.Amount
 		delVestingAmt := bva.DelegatedVesting.AmountOf(coin.Denom)
 
-		// compute min((BC + DV) - V, BC) per the specification
-		min := sdk.MinInt(baseAmt.Add(delVestingAmt).Sub(vestingAmt), baseAmt)
-		spendableCoin := sdk.NewCoin(coin.Denom, min)
+		// compute max(V - DV, 0) per the specification
+		max := sdk.MaxInt(vestingAmt.Sub(delVestingAmt), sdk.ZeroInt())
+		lockedCoin := sdk.NewCoin(coin.Denom, max)
 
-		if !spendableCoin.IsZero() {
-			spendableCoins = spendableCoins.Add(sdk.Coins{spendableCoin})
+		if !lockedCoin.IsZero() {
+			lockedCoins = lockedCoins.Add(sdk.Coins{lockedCoin})
 		}
 	}
 
-	return spendableCoins
+	return lockedCoins
 }
 
 // trackDelegation tracks a delegation amount for any given vesting account type
-// given the amount of coins currently vesting. It returns the resulting base
-// coins.
+// given the amount of coins currently vesting and the balance of the account
+// before the delegation. The balance itself is updated by the bank module.
 //
-// CONTRACT: The account's coins, delegation coins, vesting coins, and delegated
-// vesting coins must be sorted.
-func (bva *BaseVestingAccount) trackDelegation(vestingCoins, amount sdk.Coins) {
-	bc := bva.GetCoins()
-
+// CONTRACT: The account's balance, delegation coins, vesting coins, and
+// delegated vesting coins must be sorted.
+func (bva *BaseVestingAccount) trackDelegation(balance, vestingCoins, amount sdk.Coins) {
 	for _, coin := range amount {
 		// zip/lineup all coins by their denomination to provide O(n) time
 
-		baseAmt := bc.AmountOf(coin.Denom)
+		baseAmt := balance.AmountOf(coin.Denom)
 		vestingAmt := vestingCoins.AmountOf(coin.Denom)
 		delVestingAmt := bva.DelegatedVesting.AmountOf(coin.Denom)
 
@@ -303,22 +277,19 @@ func (bva *BaseVestingAccount) trackDelegation(vestingCoins, amount sdk.Coins) {
 			yCoin := sdk.NewCoin(coin.Denom, y)
 			bva.DelegatedFree = bva.DelegatedFree.Add(sdk.Coins{yCoin})
 		}
-
-		bva.Coins = bva.Coins.Sub(sdk.Coins{coin})
 	}
 }
 
 // TrackUndelegation tracks an undelegation amount by setting the necessary
-// values by which delegated vesting and delegated vesting need to decrease and
-// by which amount the base coins need to increase. The resulting base coins are
-// returned.
+// values by which delegated vesting and delegated vesting need to decrease. The
+// undelegated coins are credited to the account's balance by the bank module.
 //
 // NOTE: The undelegation (bond refund) amount may exceed the delegated
 // vesting (bond) amount due to the way undelegation truncates the bond refund,
 // which can increase the validator's exchange rate (tokens/shares) slightly if
 // the undelegated tokens are non-integral.
 //
-// CONTRACT: The account's coins and undelegation coins must be sorted.
+// CONTRACT: The undelegation coins must be sorted.
 func (bva *BaseVestingAccount) TrackUndelegation(amount sdk.Coins) {
 	for _, coin := range amount {
 		// panic if the undelegation amount is zero
@@ -343,8 +314,6 @@ func (bva *BaseVestingAccount) TrackUndelegation(amount sdk.Coins) {
 			yCoin := sdk.NewCoin(coin.Denom, y)
 			bva.DelegatedVesting = bva.DelegatedVesting.Sub(sdk.Coins{yCoin})
 		}
-
-		bva.Coins = bva.Coins.Add(sdk.Coins{coin})
 	}
 }
 
@@ -390,12 +359,12 @@ func NewContinuousVestingAccountRaw(bva *BaseVestingAccount,
 
 // NewContinuousVestingAccount returns a new ContinuousVestingAccount
 func NewContinuousVestingAccount(
-	baseAcc *BaseAccount, StartTime, EndTime int64,
+	baseAcc *BaseAccount, originalVesting sdk.Coins, StartTime, EndTime int64,
 ) *ContinuousVestingAccount {
 
 	baseVestingAcc := &BaseVestingAccount{
 		BaseAccount:     baseAcc,
-		OriginalVesting: baseAcc.Coins,
+		OriginalVesting: originalVesting,
 		EndTime:         EndTime,
 	}
 
@@ -415,7 +384,6 @@ func (cva ContinuousVestingAccount) String() string {
 	return fmt.Sprintf(`Continuous Vesting Account:
   Address:          %s
   Pubkey:           %s
-  Coins:            %s
   AccountNumber:    %d
   Sequence:         %d
   OriginalVesting:  %s
@@ -423,7 +391,7 @@ func (cva ContinuousVestingAccount) String() string {
   DelegatedVesting: %s
   StartTime:        %d
   EndTime:          %d `,
-		cva.Address, pubkey, cva.Coins, cva.AccountNumber, cva.Sequence,
+		cva.Address, pubkey, cva.AccountNumber, cva.Sequence,
 		cva.OriginalVesting, cva.DelegatedFree, cva.DelegatedVesting,
 		cva.StartTime, cva.EndTime,
 	)
@@ -462,17 +430,16 @@ func (cva ContinuousVestingAccount) GetVestingCoins(blockTime time.Time) sdk.Coi
 	return cva.OriginalVesting.Sub(cva.GetVestedCoins(blockTime))
 }
 
-// SpendableCoins returns the total number of spendable coins per denom for a
-// continuous vesting account.
-func (cva ContinuousVestingAccount) SpendableCoins(blockTime time.Time) sdk.Coins {
-	return cva.spendableCoins(cva.GetVestingCoins(blockTime))
+// LockedCoins returns the set of coins that are not spendable for a continuous
+// vesting account.
+func (cva ContinuousVestingAccount) LockedCoins(blockTime time.Time) sdk.Coins {
+	return cva.lockedCoins(cva.GetVestingCoins(blockTime))
 }
 
 // TrackDelegation tracks a desired delegation amount by setting the appropriate
-// values for the amount of delegated vesting, delegated free, and reducing the
-// overall amount of base coins.
-func (cva *ContinuousVestingAccount) TrackDelegation(blockTime time.Time, amount sdk.Coins) {
-	cva.trackDelegation(cva.GetVestingCoins(blockTime), amount)
+// values for the amount of delegated vesting and delegated free coins.
+func (cva *ContinuousVestingAccount) TrackDelegation(blockTime time.Time, balance, amount sdk.Coins) {
+	cva.trackDelegation(balance, cva.GetVestingCoins(blockTime), amount)
 }
 
 // GetStartTime returns the time when vesting starts for a continuous vesting
@@ -506,10 +473,10 @@ func NewDelayedVestingAccountRaw(bva *BaseVestingAccount) *DelayedVestingAccount
 }
 
 // NewDelayedVestingAccount returns a DelayedVestingAccount
-func NewDelayedVestingAccount(baseAcc *BaseAccount, EndTime int64) *DelayedVestingAccount {
+func NewDelayedVestingAccount(baseAcc *BaseAccount, originalVesting sdk.Coins, EndTime int64) *DelayedVestingAccount {
 	baseVestingAcc := &BaseVestingAccount{
 		BaseAccount:     baseAcc,
-		OriginalVesting: baseAcc.Coins,
+		OriginalVesting: originalVesting,
 		EndTime:         EndTime,
 	}
 
@@ -532,17 +499,16 @@ func (dva DelayedVestingAccount) GetVestingCoins(blockTime time.Time) sdk.Coins
 	return dva.OriginalVesting.Sub(dva.GetVestedCoins(blockTime))
 }
 
-// SpendableCoins returns the total number of spendable coins for a delayed
+// LockedCoins returns the set of coins that are not spendable for a delayed
 // vesting account.
-func (dva DelayedVestingAccount) SpendableCoins(blockTime time.Time) sdk.Coins {
-	return dva.spendableCoins(dva.GetVestingCoins(blockTime))
+func (dva DelayedVestingAccount) LockedCoins(blockTime time.Time) sdk.Coins {
+	return dva.lockedCoins(dva.GetVestingCoins(blockTime))
 }
 
 // TrackDelegation tracks a desired delegation amount by setting the appropriate
-// values for the amount of delegated vesting, delegated free, and reducing the
-// overall amount of base coins.
-func (dva *DelayedVestingAccount) TrackDelegation(blockTime time.Time, amount sdk.Coins) {
-	dva.trackDelegation(dva.GetVestingCoins(blockTime), amount)
+// values for the amount of delegated vesting and delegated free coins.
+func (dva *DelayedVestingAccount) TrackDelegation(blockTime time.Time, balance, amount sdk.Coins) {
+	dva.trackDelegation(balance, dva.GetVestingCoins(blockTime), amount)
 }
 
 // GetStartTime returns zero since a delayed vesting account has no start time.
@@ -554,3 +520,196 @@ func (dva *DelayedVestingAccount) GetStartTime() int64 {
 func (dva *DelayedVestingAccount) GetEndTime() int64 {
 	return dva.EndTime
 }
+
+//-----------------------------------------------------------------------------
+// Periodic Vesting Account
+
+var _ VestingAccount = (*PeriodicVestingAccount)(nil)
+
+// Period defines a length of time and an amount of coins that will vest once
+// that length of time has elapsed.
+type Period struct {
+	Length int64     `json:"length"` // length of the period, in seconds
+	Amount sdk.Coins `json:"amount"` // amount of coins vesting during this period
+}
+
+// String implements fmt.Stringer
+func (p Period) String() string {
+	return fmt.Sprintf(`Length: %d
+  Amount: %s`, p.Length, p.Amount)
+}
+
+// Periods stores all vesting periods passed as part of a PeriodicVestingAccount
+type Periods []Period
+
+// TotalLength returns the total length of all the periods in seconds.
+func (p Periods) TotalLength() int64 {
+	var total int64
+	for _, period := range p {
+		total += period.Length
+	}
+	return total
+}
+
+// TotalAmount returns the sum of the coins vesting in all the periods.
+func (p Periods) TotalAmount() sdk.Coins {
+	total := sdk.Coins{}
+	for _, period := range p {
+		total = total.Add(period.Amount)
+	}
+	return total
+}
+
+// Validate returns an error if any of the periods has a non-positive length or
+// an invalid amount.
+func (p Periods) Validate() error {
+	for i, period := range p {
+		if period.Length <= 0 {
+			return fmt.Errorf("vesting period %d must have a positive length", i)
+		}
+		if !period.Amount.IsValid() {
+			return fmt.Errorf("vesting period %d has an invalid amount: %s", i, period.Amount)
+		}
+	}
+	return nil
+}
+
+// String implements fmt.Stringer
+func (p Periods) String() string {
+	periodsListString := make([]string, len(p))
+	for i, period := range p {
+		periodsListString[i] = period.String()
+	}
+
+	return strings.TrimSpace(fmt.Sprintf(`Vesting Periods:
+  %s`, strings.Join(periodsListString, ",\n  ")))
+}
+
+// PeriodicVestingAccount implements the VestingAccount interface. It vests
+// coins according to a schedule of consecutive periods, each unlocking its
+// amount of coins once it has elapsed.
+type PeriodicVestingAccount struct {
+	*BaseVestingAccount
+
+	StartTime      int64   `json:"start_time"`      // when the coins start to vest
+	VestingPeriods Periods `json:"vesting_periods"` // unlocking schedule relative to the start time
+}
+
+// NewPeriodicVestingAccountRaw creates a new PeriodicVestingAccount object from BaseVestingAccount
+func NewPeriodicVestingAccountRaw(bva *BaseVestingAccount,
+	startTime int64, periods Periods) *PeriodicVestingAccount {
+
+	return &PeriodicVestingAccount{
+		BaseVestingAccount: bva,
+		StartTime:          startTime,
+		VestingPeriods:     periods,
+	}
+}
+
+// NewPeriodicVestingAccount returns a new PeriodicVestingAccount. The original
+// vesting amount is the sum of the amounts of all the periods and the end time
+// is the start time plus the total length of the periods.
+func NewPeriodicVestingAccount(
+	baseAcc *BaseAccount, StartTime int64, periods Periods,
+) *PeriodicVestingAccount {
+
+	baseVestingAcc := &BaseVestingAccount{
+		BaseAccount:     baseAcc,
+		OriginalVesting: periods.TotalAmount(),
+		EndTime:         StartTime + periods.TotalLength(),
+	}
+
+	return &PeriodicVestingAccount{
+		BaseVestingAccount: baseVestingAcc,
+		StartTime:          StartTime,
+		VestingPeriods:     periods,
+	}
+}
+
+func (pva PeriodicVestingAccount) String() string {
+	var pubkey string
+
+	if pva.PubKey != nil {
+		pubkey = sdk.MustBech32ifyAccPub(pva.PubKey)
+	}
+
+	return fmt.Sprintf(`Periodic Vesting Account:
+  Address:          %s
+  Pubkey:           %s
+  AccountNumber:    %d
+  Sequence:         %d
+  OriginalVesting:  %s
+  DelegatedFree:    %s
+  DelegatedVesting: %s
+  StartTime:        %d
+  EndTime:          %d
+  VestingPeriods:   %d `,
+		pva.Address, pubkey, pva.AccountNumber, pva.Sequence,
+		pva.OriginalVesting, pva.DelegatedFree, pva.DelegatedVesting,
+		pva.StartTime, pva.EndTime, len(pva.VestingPeriods),
+	)
+}
+
+// GetVestedCoins returns the total number of vested coins, i.e. the sum of the
+// amounts of all the periods that have fully elapsed. If no coins are vested,
+// nil is returned.
+func (pva PeriodicVestingAccount) GetVestedCoins(blockTime time.Time) sdk.Coins {
+	var vestedCoins sdk.Coins
+
+	// We must handle the case where the start time for a vesting account has
+	// been set into the future or when the start of the chain is not exactly
+	// known.
+	if blockTime.Unix() <= pva.StartTime {
+		return vestedCoins
+	} else if blockTime.Unix() >= pva.EndTime {
+		return pva.OriginalVesting
+	}
+
+	// track the start time of the next period
+	currentPeriodStartTime := pva.StartTime
+	for _, period := range pva.VestingPeriods {
+		x := blockTime.Unix() - currentPeriodStartTime
+		if x < period.Length {
+			break
+		}
+
+		vestedCoins = vestedCoins.Add(period.Amount)
+		currentPeriodStartTime += period.Length
+	}
+
+	return vestedCoins
+}
+
+// GetVestingCoins returns the total number of vesting coins. If no coins are
+// vesting, nil is returned.
+func (pva PeriodicVestingAccount) GetVestingCoins(blockTime time.Time) sdk.Coins {
+	return pva.OriginalVesting.Sub(pva.GetVestedCoins(blockTime))
+}
+
+// LockedCoins returns the set of coins that are not spendable for a periodic
+// vesting account.
+func (pva PeriodicVestingAccount) LockedCoins(blockTime time.Time) sdk.Coins {
+	return pva.lockedCoins(pva.GetVestingCoins(blockTime))
+}
+
+// TrackDelegation tracks a desired delegation amount by setting the appropriate
+// values for the amount of delegated vesting and delegated free coins.
+func (pva *PeriodicVestingAccount) TrackDelegation(blockTime time.Time, balance, amount sdk.Coins) {
+	pva.trackDelegation(balance, pva.GetVestingCoins(blockTime), amount)
+}
+
+// GetStartTime returns the time when vesting starts for a periodic vesting
+// account.
+func (pva *PeriodicVestingAccount) GetStartTime() int64 {
+	return pva.StartTime
+}
+
+// GetEndTime returns the time when vesting ends for a periodic vesting account.
+func (pva *PeriodicVestingAccount) GetEndTime() int64 {
+	return pva.EndTime
+}
+
+// GetVestingPeriods returns the vesting periods of a periodic vesting account.
+func (pva *PeriodicVestingAccount) GetVestingPeriods() Periods {
+	return pva.VestingPeriods
+}
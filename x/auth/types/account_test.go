@@ -50,17 +50,6 @@ func TestBaseAddressPubKey(t *testing.T) {
 	require.EqualValues(t, addr2, acc2.GetAddress())
 }
 
-func TestBaseAccountCoins(t *testing.T) {
-	_, _, addr := KeyTestPubAddr()
-	acc := NewBaseAccountWithAddress(addr)
-
-	someCoins := sdk.Coins{sdk.NewInt64Coin("atom", 123), sdk.NewInt64Coin("eth", 246)}
-
-	err := acc.SetCoins(someCoins)
-	require.Nil(t, err)
-	require.Equal(t, someCoins, acc.GetCoins())
-}
-
 func TestBaseAccountSequence(t *testing.T) {
 	_, _, addr := KeyTestPubAddr()
 	acc := NewBaseAccountWithAddress(addr)
@@ -76,7 +65,6 @@ func TestBaseAccountMarshal(t *testing.T) {
 	_, pub, addr := KeyTestPubAddr()
 	acc := NewBaseAccountWithAddress(addr)
 
-	someCoins := sdk.Coins{sdk.NewInt64Coin("atom", 123), sdk.NewInt64Coin("eth", 246)}
 	seq := uint64(7)
 
 	// set everything on the account
@@ -84,8 +72,6 @@ func TestBaseAccountMarshal(t *testing.T) {
 	require.Nil(t, err)
 	err = acc.SetSequence(seq)
 	require.Nil(t, err)
-	err = acc.SetCoins(someCoins)
-	require.Nil(t, err)
 
 	// need a codec for marshaling
 	cdc := codec.New()
@@ -112,8 +98,7 @@ func TestGetVestedCoinsContVestingAcc(t *testing.T) {
 	_, _, addr := KeyTestPubAddr()
 	origCoins := sdk.Coins{sdk.NewInt64Coin(feeDenom, 1000), sdk.NewInt64Coin(stakeDenom, 100)}
 	bacc := NewBaseAccountWithAddress(addr)
-	bacc.SetCoins(origCoins)
-	cva := NewContinuousVestingAccount(&bacc, now.Unix(), endTime.Unix())
+	cva := NewContinuousVestingAccount(&bacc, origCoins, now.Unix(), endTime.Unix())
 
 	// require no coins vested in the very beginning of the vesting schedule
 	vestedCoins := cva.GetVestedCoins(now)
@@ -139,8 +124,7 @@ func TestGetVestingCoinsContVestingAcc(t *testing.T) {
 	_, _, addr := KeyTestPubAddr()
 	origCoins := sdk.Coins{sdk.NewInt64Coin(feeDenom, 1000), sdk.NewInt64Coin(stakeDenom, 100)}
 	bacc := NewBaseAccountWithAddress(addr)
-	bacc.SetCoins(origCoins)
-	cva := NewContinuousVestingAccount(&bacc, now.Unix(), endTime.Unix())
+	cva := NewContinuousVestingAccount(&bacc, origCoins, now.Unix(), endTime.Unix())
 
 	// require all coins vesting in the beginning of the vesting schedule
 	vestingCoins := cva.GetVestingCoins(now)
@@ -155,44 +139,34 @@ func TestGetVestingCoinsContVestingAcc(t *testing.T) {
 	require.Equal(t, sdk.Coins{sdk.NewInt64Coin(feeDenom, 500), sdk.NewInt64Coin(stakeDenom, 50)}, vestingCoins)
 }
 
-func TestSpendableCoinsContVestingAcc(t *testing.T) {
+func TestLockedCoinsContVestingAcc(t *testing.T) {
 	now := tmtime.Now()
 	endTime := now.Add(24 * time.Hour)
 
 	_, _, addr := KeyTestPubAddr()
 	origCoins := sdk.Coins{sdk.NewInt64Coin(feeDenom, 1000), sdk.NewInt64Coin(stakeDenom, 100)}
 	bacc := NewBaseAccountWithAddress(addr)
-	bacc.SetCoins(origCoins)
-	cva := NewContinuousVestingAccount(&bacc, now.Unix(), endTime.Unix())
+	cva := NewContinuousVestingAccount(&bacc, origCoins, now.Unix(), endTime.Unix())
 
-	// require that there exist no spendable coins in the beginning of the
+	// require that all original coins are locked in the beginning of the
 	// vesting schedule
-	spendableCoins := cva.SpendableCoins(now)
-	require.Nil(t, spendableCoins)
-
-	// require that all original coins are spendable at the end of the vesting
-	// schedule
-	spendableCoins = cva.SpendableCoins(endTime)
-	require.Equal(t, origCoins, spendableCoins)
+	lockedCoins := cva.LockedCoins(now)
+	require.Equal(t, origCoins, lockedCoins)
 
-	// require that all vested coins (50%) are spendable
-	spendableCoins = cva.SpendableCoins(now.Add(12 * time.Hour))
-	require.Equal(t, sdk.Coins{sdk.NewInt64Coin(feeDenom, 500), sdk.NewInt64Coin(stakeDenom, 50)}, spendableCoins)
+	// require that no coins are locked at the end of the vesting schedule
+	lockedCoins = cva.LockedCoins(endTime)
+	require.Nil(t, lockedCoins)
 
-	// receive some coins
-	recvAmt := sdk.Coins{sdk.NewInt64Coin(stakeDenom, 50)}
-	cva.SetCoins(cva.GetCoins().Add(recvAmt))
+	// require that all vesting coins (50%) are locked
+	lockedCoins = cva.LockedCoins(now.Add(12 * time.Hour))
+	require.Equal(t, sdk.Coins{sdk.NewInt64Coin(feeDenom, 500), sdk.NewInt64Coin(stakeDenom, 50)}, lockedCoins)
 
-	// require that all vested coins (50%) are spendable plus any received
-	spendableCoins = cva.SpendableCoins(now.Add(12 * time.Hour))
-	require.Equal(t, sdk.Coins{sdk.NewInt64Coin(feeDenom, 500), sdk.NewInt64Coin(stakeDenom, 100)}, spendableCoins)
+	// delegate some vesting coins
+	cva.TrackDelegation(now.Add(12*time.Hour), origCoins, sdk.Coins{sdk.NewInt64Coin(stakeDenom, 50)})
 
-	// spend all spendable coins
-	cva.SetCoins(cva.GetCoins().Sub(spendableCoins))
-
-	// require that no more coins are spendable
-	spendableCoins = cva.SpendableCoins(now.Add(12 * time.Hour))
-	require.Nil(t, spendableCoins)
+	// require that delegated vesting coins are no longer locked
+	lockedCoins = cva.LockedCoins(now.Add(12 * time.Hour))
+	require.Equal(t, sdk.Coins{sdk.NewInt64Coin(feeDenom, 500)}, lockedCoins)
 }
 
 func TestTrackDelegationContVestingAcc(t *testing.T) {
@@ -202,44 +176,36 @@ func TestTrackDelegationContVestingAcc(t *testing.T) {
 	_, _, addr := KeyTestPubAddr()
 	origCoins := sdk.Coins{sdk.NewInt64Coin(feeDenom, 1000), sdk.NewInt64Coin(stakeDenom, 100)}
 	bacc := NewBaseAccountWithAddress(addr)
-	bacc.SetCoins(origCoins)
 
 	// require the ability to delegate all vesting coins
-	cva := NewContinuousVestingAccount(&bacc, now.Unix(), endTime.Unix())
-	cva.TrackDelegation(now, origCoins)
+	cva := NewContinuousVestingAccount(&bacc, origCoins, now.Unix(), endTime.Unix())
+	cva.TrackDelegation(now, origCoins, origCoins)
 	require.Equal(t, origCoins, cva.DelegatedVesting)
 	require.Nil(t, cva.DelegatedFree)
-	require.Nil(t, cva.GetCoins())
 
 	// require the ability to delegate all vested coins
-	bacc.SetCoins(origCoins)
-	cva = NewContinuousVestingAccount(&bacc, now.Unix(), endTime.Unix())
-	cva.TrackDelegation(endTime, origCoins)
+	cva = NewContinuousVestingAccount(&bacc, origCoins, now.Unix(), endTime.Unix())
+	cva.TrackDelegation(endTime, origCoins, origCoins)
 	require.Nil(t, cva.DelegatedVesting)
 	require.Equal(t, origCoins, cva.DelegatedFree)
-	require.Nil(t, cva.GetCoins())
 
 	// require the ability to delegate all vesting coins (50%) and all vested coins (50%)
-	bacc.SetCoins(origCoins)
-	cva = NewContinuousVestingAccount(&bacc, now.Unix(), endTime.Unix())
-	cva.TrackDelegation(now.Add(12*time.Hour), sdk.Coins{sdk.NewInt64Coin(stakeDenom, 50)})
+	cva = NewContinuousVestingAccount(&bacc, origCoins, now.Unix(), endTime.Unix())
+	cva.TrackDelegation(now.Add(12*time.Hour), origCoins, sdk.Coins{sdk.NewInt64Coin(stakeDenom, 50)})
 	require.Equal(t, sdk.Coins{sdk.NewInt64Coin(stakeDenom, 50)}, cva.DelegatedVesting)
 	require.Nil(t, cva.DelegatedFree)
 
-	cva.TrackDelegation(now.Add(12*time.Hour), sdk.Coins{sdk.NewInt64Coin(stakeDenom, 50)})
+	cva.TrackDelegation(now.Add(12*time.Hour), origCoins, sdk.Coins{sdk.NewInt64Coin(stakeDenom, 50)})
 	require.Equal(t, sdk.Coins{sdk.NewInt64Coin(stakeDenom, 50)}, cva.DelegatedVesting)
 	require.Equal(t, sdk.Coins{sdk.NewInt64Coin(stakeDenom, 50)}, cva.DelegatedFree)
-	require.Equal(t, sdk.Coins{sdk.NewInt64Coin(feeDenom, 1000)}, cva.GetCoins())
 
 	// require no modifications when delegation amount is zero or not enough funds
-	bacc.SetCoins(origCoins)
-	cva = NewContinuousVestingAccount(&bacc, now.Unix(), endTime.Unix())
+	cva = NewContinuousVestingAccount(&bacc, origCoins, now.Unix(), endTime.Unix())
 	require.Panics(t, func() {
-		cva.TrackDelegation(endTime, sdk.Coins{sdk.NewInt64Coin(stakeDenom, 1000000)})
+		cva.TrackDelegation(endTime, origCoins, sdk.Coins{sdk.NewInt64Coin(stakeDenom, 1000000)})
 	})
 	require.Nil(t, cva.DelegatedVesting)
 	require.Nil(t, cva.DelegatedFree)
-	require.Equal(t, origCoins, cva.GetCoins())
 }
 
 func TestTrackUndelegationContVestingAcc(t *testing.T) {
@@ -249,53 +215,45 @@ func TestTrackUndelegationContVestingAcc(t *testing.T) {
 	_, _, addr := KeyTestPubAddr()
 	origCoins := sdk.Coins{sdk.NewInt64Coin(feeDenom, 1000), sdk.NewInt64Coin(stakeDenom, 100)}
 	bacc := NewBaseAccountWithAddress(addr)
-	bacc.SetCoins(origCoins)
 
 	// require the ability to undelegate all vesting coins
-	cva := NewContinuousVestingAccount(&bacc, now.Unix(), endTime.Unix())
-	cva.TrackDelegation(now, origCoins)
+	cva := NewContinuousVestingAccount(&bacc, origCoins, now.Unix(), endTime.Unix())
+	cva.TrackDelegation(now, origCoins, origCoins)
 	cva.TrackUndelegation(origCoins)
 	require.Nil(t, cva.DelegatedFree)
 	require.Nil(t, cva.DelegatedVesting)
-	require.Equal(t, origCoins, cva.GetCoins())
 
 	// require the ability to undelegate all vested coins
-	bacc.SetCoins(origCoins)
-	cva = NewContinuousVestingAccount(&bacc, now.Unix(), endTime.Unix())
+	cva = NewContinuousVestingAccount(&bacc, origCoins, now.Unix(), endTime.Unix())
 
-	cva.TrackDelegation(endTime, origCoins)
+	cva.TrackDelegation(endTime, origCoins, origCoins)
 	cva.TrackUndelegation(origCoins)
 	require.Nil(t, cva.DelegatedFree)
 	require.Nil(t, cva.DelegatedVesting)
-	require.Equal(t, origCoins, cva.GetCoins())
 
 	// require no modifications when the undelegation amount is zero
-	bacc.SetCoins(origCoins)
-	cva = NewContinuousVestingAccount(&bacc, now.Unix(), endTime.Unix())
+	cva = NewContinuousVestingAccount(&bacc, origCoins, now.Unix(), endTime.Unix())
 
 	require.Panics(t, func() {
 		cva.TrackUndelegation(sdk.Coins{sdk.NewInt64Coin(stakeDenom, 0)})
 	})
 	require.Nil(t, cva.DelegatedFree)
 	require.Nil(t, cva.DelegatedVesting)
-	require.Equal(t, origCoins, cva.GetCoins())
 
 	// vest 50% and delegate to two validators
-	cva = NewContinuousVestingAccount(&bacc, now.Unix(), endTime.Unix())
-	cva.TrackDelegation(now.Add(12*time.Hour), sdk.Coins{sdk.NewInt64Coin(stakeDenom, 50)})
-	cva.TrackDelegation(now.Add(12*time.Hour), sdk.Coins{sdk.NewInt64Coin(stakeDenom, 50)})
+	cva = NewContinuousVestingAccount(&bacc, origCoins, now.Unix(), endTime.Unix())
+	cva.TrackDelegation(now.Add(12*time.Hour), origCoins, sdk.Coins{sdk.NewInt64Coin(stakeDenom, 50)})
+	cva.TrackDelegation(now.Add(12*time.Hour), origCoins, sdk.Coins{sdk.NewInt64Coin(stakeDenom, 50)})
 
 	// undelegate from one validator that got slashed 50%
 	cva.TrackUndelegation(sdk.Coins{sdk.NewInt64Coin(stakeDenom, 25)})
 	require.Equal(t, sdk.Coins{sdk.NewInt64Coin(stakeDenom, 25)}, cva.DelegatedFree)
 	require.Equal(t, sdk.Coins{sdk.NewInt64Coin(stakeDenom, 50)}, cva.DelegatedVesting)
-	require.Equal(t, sdk.Coins{sdk.NewInt64Coin(feeDenom, 1000), sdk.NewInt64Coin(stakeDenom, 25)}, cva.GetCoins())
 
 	// undelegate from the other validator that did not get slashed
 	cva.TrackUndelegation(sdk.Coins{sdk.NewInt64Coin(stakeDenom, 50)})
 	require.Nil(t, cva.DelegatedFree)
 	require.Equal(t, sdk.Coins{sdk.NewInt64Coin(stakeDenom, 25)}, cva.DelegatedVesting)
-	require.Equal(t, sdk.Coins{sdk.NewInt64Coin(feeDenom, 1000), sdk.NewInt64Coin(stakeDenom, 75)}, cva.GetCoins())
 }
 
 func TestGetVestedCoinsDelVestingAcc(t *testing.T) {
@@ -305,10 +263,9 @@ func TestGetVestedCoinsDelVestingAcc(t *testing.T) {
 	_, _, addr := KeyTestPubAddr()
 	origCoins := sdk.Coins{sdk.NewInt64Coin(feeDenom, 1000), sdk.NewInt64Coin(stakeDenom, 100)}
 	bacc := NewBaseAccountWithAddress(addr)
-	bacc.SetCoins(origCoins)
 
 	// require no coins are vested until schedule maturation
-	dva := NewDelayedVestingAccount(&bacc, endTime.Unix())
+	dva := NewDelayedVestingAccount(&bacc, origCoins, endTime.Unix())
 	vestedCoins := dva.GetVestedCoins(now)
 	require.Nil(t, vestedCoins)
 
@@ -324,10 +281,9 @@ func TestGetVestingCoinsDelVestingAcc(t *testing.T) {
 	_, _, addr := KeyTestPubAddr()
 	origCoins := sdk.Coins{sdk.NewInt64Coin(feeDenom, 1000), sdk.NewInt64Coin(stakeDenom, 100)}
 	bacc := NewBaseAccountWithAddress(addr)
-	bacc.SetCoins(origCoins)
 
 	// require all coins vesting at the beginning of the schedule
-	dva := NewDelayedVestingAccount(&bacc, endTime.Unix())
+	dva := NewDelayedVestingAccount(&bacc, origCoins, endTime.Unix())
 	vestingCoins := dva.GetVestingCoins(now)
 	require.Equal(t, origCoins, vestingCoins)
 
@@ -336,45 +292,35 @@ func TestGetVestingCoinsDelVestingAcc(t *testing.T) {
 	require.Nil(t, vestingCoins)
 }
 
-func TestSpendableCoinsDelVestingAcc(t *testing.T) {
+func TestLockedCoinsDelVestingAcc(t *testing.T) {
 	now := tmtime.Now()
 	endTime := now.Add(24 * time.Hour)
 
 	_, _, addr := KeyTestPubAddr()
 	origCoins := sdk.Coins{sdk.NewInt64Coin(feeDenom, 1000), sdk.NewInt64Coin(stakeDenom, 100)}
 	bacc := NewBaseAccountWithAddress(addr)
-	bacc.SetCoins(origCoins)
 
-	// require that no coins are spendable in the beginning of the vesting
+	// require that all coins are locked in the beginning of the vesting
 	// schedule
-	dva := NewDelayedVestingAccount(&bacc, endTime.Unix())
-	spendableCoins := dva.SpendableCoins(now)
-	require.Nil(t, spendableCoins)
+	dva := NewDelayedVestingAccount(&bacc, origCoins, endTime.Unix())
+	lockedCoins := dva.LockedCoins(now)
+	require.Equal(t, origCoins, lockedCoins)
 
-	// require that all coins are spendable after the maturation of the vesting
+	// require that no coins are locked after the maturation of the vesting
 	// schedule
-	spendableCoins = dva.SpendableCoins(endTime)
-	require.Equal(t, origCoins, spendableCoins)
-
-	// require that all coins are still vesting after some time
-	spendableCoins = dva.SpendableCoins(now.Add(12 * time.Hour))
-	require.Nil(t, spendableCoins)
-
-	// receive some coins
-	recvAmt := sdk.Coins{sdk.NewInt64Coin(stakeDenom, 50)}
-	dva.SetCoins(dva.GetCoins().Add(recvAmt))
+	lockedCoins = dva.LockedCoins(endTime)
+	require.Nil(t, lockedCoins)
 
-	// require that only received coins are spendable since the account is still
-	// vesting
-	spendableCoins = dva.SpendableCoins(now.Add(12 * time.Hour))
-	require.Equal(t, recvAmt, spendableCoins)
+	// require that all coins are still locked after some time
+	lockedCoins = dva.LockedCoins(now.Add(12 * time.Hour))
+	require.Equal(t, origCoins, lockedCoins)
 
-	// spend all spendable coins
-	dva.SetCoins(dva.GetCoins().Sub(spendableCoins))
+	// delegate some locked coins
+	dva.TrackDelegation(now.Add(12*time.Hour), origCoins, sdk.Coins{sdk.NewInt64Coin(stakeDenom, 50)})
 
-	// require that no more coins are spendable
-	spendableCoins = dva.SpendableCoins(now.Add(12 * time.Hour))
-	require.Nil(t, spendableCoins)
+	// require that delegated vesting coins are no longer locked
+	lockedCoins = dva.LockedCoins(now.Add(12 * time.Hour))
+	require.Equal(t, sdk.Coins{sdk.NewInt64Coin(feeDenom, 1000), sdk.NewInt64Coin(stakeDenom, 50)}, lockedCoins)
 }
 
 func TestTrackDelegationDelVestingAcc(t *testing.T) {
@@ -384,43 +330,34 @@ func TestTrackDelegationDelVestingAcc(t *testing.T) {
 	_, _, addr := KeyTestPubAddr()
 	origCoins := sdk.Coins{sdk.NewInt64Coin(feeDenom, 1000), sdk.NewInt64Coin(stakeDenom, 100)}
 	bacc := NewBaseAccountWithAddress(addr)
-	bacc.SetCoins(origCoins)
 
 	// require the ability to delegate all vesting coins
-	bacc.SetCoins(origCoins)
-	dva := NewDelayedVestingAccount(&bacc, endTime.Unix())
-	dva.TrackDelegation(now, origCoins)
+	dva := NewDelayedVestingAccount(&bacc, origCoins, endTime.Unix())
+	dva.TrackDelegation(now, origCoins, origCoins)
 	require.Equal(t, origCoins, dva.DelegatedVesting)
 	require.Nil(t, dva.DelegatedFree)
-	require.Nil(t, dva.GetCoins())
 
 	// require the ability to delegate all vested coins
-	bacc.SetCoins(origCoins)
-	dva = NewDelayedVestingAccount(&bacc, endTime.Unix())
-	dva.TrackDelegation(endTime, origCoins)
+	dva = NewDelayedVestingAccount(&bacc, origCoins, endTime.Unix())
+	dva.TrackDelegation(endTime, origCoins, origCoins)
 	require.Nil(t, dva.DelegatedVesting)
 	require.Equal(t, origCoins, dva.DelegatedFree)
-	require.Nil(t, dva.GetCoins())
 
 	// require the ability to delegate all coins half way through the vesting
 	// schedule
-	bacc.SetCoins(origCoins)
-	dva = NewDelayedVestingAccount(&bacc, endTime.Unix())
-	dva.TrackDelegation(now.Add(12*time.Hour), origCoins)
+	dva = NewDelayedVestingAccount(&bacc, origCoins, endTime.Unix())
+	dva.TrackDelegation(now.Add(12*time.Hour), origCoins, origCoins)
 	require.Equal(t, origCoins, dva.DelegatedVesting)
 	require.Nil(t, dva.DelegatedFree)
-	require.Nil(t, dva.GetCoins())
 
 	// require no modifications when delegation amount is zero or not enough funds
-	bacc.SetCoins(origCoins)
-	dva = NewDelayedVestingAccount(&bacc, endTime.Unix())
+	dva = NewDelayedVestingAccount(&bacc, origCoins, endTime.Unix())
 
 	require.Panics(t, func() {
-		dva.TrackDelegation(endTime, sdk.Coins{sdk.NewInt64Coin(stakeDenom, 1000000)})
+		dva.TrackDelegation(endTime, origCoins, sdk.Coins{sdk.NewInt64Coin(stakeDenom, 1000000)})
 	})
 	require.Nil(t, dva.DelegatedVesting)
 	require.Nil(t, dva.DelegatedFree)
-	require.Equal(t, origCoins, dva.GetCoins())
 }
 
 func TestTrackUndelegationDelVestingAcc(t *testing.T) {
@@ -430,53 +367,260 @@ func TestTrackUndelegationDelVestingAcc(t *testing.T) {
 	_, _, addr := KeyTestPubAddr()
 	origCoins := sdk.Coins{sdk.NewInt64Coin(feeDenom, 1000), sdk.NewInt64Coin(stakeDenom, 100)}
 	bacc := NewBaseAccountWithAddress(addr)
-	bacc.SetCoins(origCoins)
 
 	// require the ability to undelegate all vesting coins
-	bacc.SetCoins(origCoins)
-	dva := NewDelayedVestingAccount(&bacc, endTime.Unix())
-	dva.TrackDelegation(now, origCoins)
+	dva := NewDelayedVestingAccount(&bacc, origCoins, endTime.Unix())
+	dva.TrackDelegation(now, origCoins, origCoins)
 	dva.TrackUndelegation(origCoins)
 	require.Nil(t, dva.DelegatedFree)
 	require.Nil(t, dva.DelegatedVesting)
-	require.Equal(t, origCoins, dva.GetCoins())
 
 	// require the ability to undelegate all vested coins
-	bacc.SetCoins(origCoins)
-	dva = NewDelayedVestingAccount(&bacc, endTime.Unix())
-	dva.TrackDelegation(endTime, origCoins)
+	dva = NewDelayedVestingAccount(&bacc, origCoins, endTime.Unix())
+	dva.TrackDelegation(endTime, origCoins, origCoins)
 	dva.TrackUndelegation(origCoins)
 	require.Nil(t, dva.DelegatedFree)
 	require.Nil(t, dva.DelegatedVesting)
-	require.Equal(t, origCoins, dva.GetCoins())
 
 	// require no modifications when the undelegation amount is zero
-	bacc.SetCoins(origCoins)
-	dva = NewDelayedVestingAccount(&bacc, endTime.Unix())
+	dva = NewDelayedVestingAccount(&bacc, origCoins, endTime.Unix())
 
 	require.Panics(t, func() {
 		dva.TrackUndelegation(sdk.Coins{sdk.NewInt64Coin(stakeDenom, 0)})
 	})
 	require.Nil(t, dva.DelegatedFree)
 	require.Nil(t, dva.DelegatedVesting)
-	require.Equal(t, origCoins, dva.GetCoins())
 
 	// vest 50% and delegate to two validators
-	bacc.SetCoins(origCoins)
-	dva = NewDelayedVestingAccount(&bacc, endTime.Unix())
-	dva.TrackDelegation(now.Add(12*time.Hour), sdk.Coins{sdk.NewInt64Coin(stakeDenom, 50)})
-	dva.TrackDelegation(now.Add(12*time.Hour), sdk.Coins{sdk.NewInt64Coin(stakeDenom, 50)})
+	dva = NewDelayedVestingAccount(&bacc, origCoins, endTime.Unix())
+	dva.TrackDelegation(now.Add(12*time.Hour), origCoins, sdk.Coins{sdk.NewInt64Coin(stakeDenom, 50)})
+	dva.TrackDelegation(now.Add(12*time.Hour), origCoins, sdk.Coins{sdk.NewInt64Coin(stakeDenom, 50)})
 
 	// undelegate from one validator that got slashed 50%
 	dva.TrackUndelegation(sdk.Coins{sdk.NewInt64Coin(stakeDenom, 25)})
 
 	require.Nil(t, dva.DelegatedFree)
 	require.Equal(t, sdk.Coins{sdk.NewInt64Coin(stakeDenom, 75)}, dva.DelegatedVesting)
-	require.Equal(t, sdk.Coins{sdk.NewInt64Coin(feeDenom, 1000), sdk.NewInt64Coin(stakeDenom, 25)}, dva.GetCoins())
 
 	// undelegate from the other validator that did not get slashed
 	dva.TrackUndelegation(sdk.Coins{sdk.NewInt64Coin(stakeDenom, 50)})
 	require.Nil(t, dva.DelegatedFree)
 	require.Equal(t, sdk.Coins{sdk.NewInt64Coin(stakeDenom, 25)}, dva.DelegatedVesting)
-	require.Equal(t, sdk.Coins{sdk.NewInt64Coin(feeDenom, 1000), sdk.NewInt64Coin(stakeDenom, 75)}, dva.GetCoins())
+}
+
+func TestGetVestedCoinsPeriodicVestingAcc(t *testing.T) {
+	now := tmtime.Now()
+	endTime := now.Add(24 * time.Hour)
+	periods := Periods{
+		Period{Length: int64(12 * 60 * 60), Amount: sdk.Coins{sdk.NewInt64Coin(feeDenom, 500), sdk.NewInt64Coin(stakeDenom, 50)}},
+		Period{Length: int64(6 * 60 * 60), Amount: sdk.Coins{sdk.NewInt64Coin(feeDenom, 250), sdk.NewInt64Coin(stakeDenom, 25)}},
+		Period{Length: int64(6 * 60 * 60), Amount: sdk.Coins{sdk.NewInt64Coin(feeDenom, 250), sdk.NewInt64Coin(stakeDenom, 25)}},
+	}
+
+	_, _, addr := KeyTestPubAddr()
+	origCoins := sdk.Coins{sdk.NewInt64Coin(feeDenom, 1000), sdk.NewInt64Coin(stakeDenom, 100)}
+	bacc := NewBaseAccountWithAddress(addr)
+	pva := NewPeriodicVestingAccount(&bacc, now.Unix(), periods)
+	require.Equal(t, origCoins, pva.GetOriginalVesting())
+	require.Equal(t, endTime.Unix(), pva.GetEndTime())
+
+	// require no coins vested at the beginning of the vesting schedule
+	vestedCoins := pva.GetVestedCoins(now)
+	require.Nil(t, vestedCoins)
+
+	// require all coins vested at the end of the vesting schedule
+	vestedCoins = pva.GetVestedCoins(endTime)
+	require.Equal(t, origCoins, vestedCoins)
+
+	// require no coins vested during the first vesting period
+	vestedCoins = pva.GetVestedCoins(now.Add(6 * time.Hour))
+	require.Nil(t, vestedCoins)
+
+	// require 50% of coins vested after the first vesting period
+	vestedCoins = pva.GetVestedCoins(now.Add(12 * time.Hour))
+	require.Equal(t, sdk.Coins{sdk.NewInt64Coin(feeDenom, 500), sdk.NewInt64Coin(stakeDenom, 50)}, vestedCoins)
+
+	// require 50% of coins vested during the second vesting period
+	vestedCoins = pva.GetVestedCoins(now.Add(15 * time.Hour))
+	require.Equal(t, sdk.Coins{sdk.NewInt64Coin(feeDenom, 500), sdk.NewInt64Coin(stakeDenom, 50)}, vestedCoins)
+
+	// require 75% of coins vested after the second vesting period
+	vestedCoins = pva.GetVestedCoins(now.Add(18 * time.Hour))
+	require.Equal(t, sdk.Coins{sdk.NewInt64Coin(feeDenom, 750), sdk.NewInt64Coin(stakeDenom, 75)}, vestedCoins)
+
+	// require 100% of coins vested past the end of the vesting schedule
+	vestedCoins = pva.GetVestedCoins(now.Add(48 * time.Hour))
+	require.Equal(t, origCoins, vestedCoins)
+}
+
+func TestGetVestingCoinsPeriodicVestingAcc(t *testing.T) {
+	now := tmtime.Now()
+	endTime := now.Add(24 * time.Hour)
+	periods := Periods{
+		Period{Length: int64(12 * 60 * 60), Amount: sdk.Coins{sdk.NewInt64Coin(feeDenom, 500), sdk.NewInt64Coin(stakeDenom, 50)}},
+		Period{Length: int64(6 * 60 * 60), Amount: sdk.Coins{sdk.NewInt64Coin(feeDenom, 250), sdk.NewInt64Coin(stakeDenom, 25)}},
+		Period{Length: int64(6 * 60 * 60), Amount: sdk.Coins{sdk.NewInt64Coin(feeDenom, 250), sdk.NewInt64Coin(stakeDenom, 25)}},
+	}
+
+	_, _, addr := KeyTestPubAddr()
+	origCoins := sdk.Coins{sdk.NewInt64Coin(feeDenom, 1000), sdk.NewInt64Coin(stakeDenom, 100)}
+	bacc := NewBaseAccountWithAddress(addr)
+	pva := NewPeriodicVestingAccount(&bacc, now.Unix(), periods)
+
+	// require all coins vesting at the beginning of the vesting schedule
+	vestingCoins := pva.GetVestingCoins(now)
+	require.Equal(t, origCoins, vestingCoins)
+
+	// require no coins vesting at the end of the vesting schedule
+	vestingCoins = pva.GetVestingCoins(endTime)
+	require.Nil(t, vestingCoins)
+
+	// require 50% of coins vesting after the first vesting period
+	vestingCoins = pva.GetVestingCoins(now.Add(12 * time.Hour))
+	require.Equal(t, sdk.Coins{sdk.NewInt64Coin(feeDenom, 500), sdk.NewInt64Coin(stakeDenom, 50)}, vestingCoins)
+
+	// require 25% of coins vesting after the second vesting period
+	vestingCoins = pva.GetVestingCoins(now.Add(18 * time.Hour))
+	require.Equal(t, sdk.Coins{sdk.NewInt64Coin(feeDenom, 250), sdk.NewInt64Coin(stakeDenom, 25)}, vestingCoins)
+}
+
+func TestLockedCoinsPeriodicVestingAcc(t *testing.T) {
+	now := tmtime.Now()
+	endTime := now.Add(24 * time.Hour)
+	periods := Periods{
+		Period{Length: int64(12 * 60 * 60), Amount: sdk.Coins{sdk.NewInt64Coin(feeDenom, 500), sdk.NewInt64Coin(stakeDenom, 50)}},
+		Period{Length: int64(6 * 60 * 60), Amount: sdk.Coins{sdk.NewInt64Coin(feeDenom, 250), sdk.NewInt64Coin(stakeDenom, 25)}},
+		Period{Length: int64(6 * 60 * 60), Amount: sdk.Coins{sdk.NewInt64Coin(feeDenom, 250), sdk.NewInt64Coin(stakeDenom, 25)}},
+	}
+
+	_, _, addr := KeyTestPubAddr()
+	origCoins := sdk.Coins{sdk.NewInt64Coin(feeDenom, 1000), sdk.NewInt64Coin(stakeDenom, 100)}
+	bacc := NewBaseAccountWithAddress(addr)
+	pva := NewPeriodicVestingAccount(&bacc, now.Unix(), periods)
+
+	// require that all original coins are locked at the beginning of the
+	// vesting schedule
+	lockedCoins := pva.LockedCoins(now)
+	require.Equal(t, origCoins, lockedCoins)
+
+	// require that no coins are locked at the end of the vesting schedule
+	lockedCoins = pva.LockedCoins(endTime)
+	require.Nil(t, lockedCoins)
+
+	// require that all vesting coins (50%) are locked
+	lockedCoins = pva.LockedCoins(now.Add(12 * time.Hour))
+	require.Equal(t, sdk.Coins{sdk.NewInt64Coin(feeDenom, 500), sdk.NewInt64Coin(stakeDenom, 50)}, lockedCoins)
+
+	// delegate some vesting coins
+	pva.TrackDelegation(now.Add(12*time.Hour), origCoins, sdk.Coins{sdk.NewInt64Coin(stakeDenom, 50)})
+
+	// require that delegated vesting coins are no longer locked
+	lockedCoins = pva.LockedCoins(now.Add(12 * time.Hour))
+	require.Equal(t, sdk.Coins{sdk.NewInt64Coin(feeDenom, 500)}, lockedCoins)
+}
+
+func TestTrackDelegationPeriodicVestingAcc(t *testing.T) {
+	now := tmtime.Now()
+	endTime := now.Add(24 * time.Hour)
+	periods := Periods{
+		Period{Length: int64(12 * 60 * 60), Amount: sdk.Coins{sdk.NewInt64Coin(feeDenom, 500), sdk.NewInt64Coin(stakeDenom, 50)}},
+		Period{Length: int64(6 * 60 * 60), Amount: sdk.Coins{sdk.NewInt64Coin(feeDenom, 250), sdk.NewInt64Coin(stakeDenom, 25)}},
+		Period{Length: int64(6 * 60 * 60), Amount: sdk.Coins{sdk.NewInt64Coin(feeDenom, 250), sdk.NewInt64Coin(stakeDenom, 25)}},
+	}
+
+	_, _, addr := KeyTestPubAddr()
+	origCoins := sdk.Coins{sdk.NewInt64Coin(feeDenom, 1000), sdk.NewInt64Coin(stakeDenom, 100)}
+	bacc := NewBaseAccountWithAddress(addr)
+
+	// require the ability to delegate all vesting coins
+	pva := NewPeriodicVestingAccount(&bacc, now.Unix(), periods)
+	pva.TrackDelegation(now, origCoins, origCoins)
+	require.Equal(t, origCoins, pva.DelegatedVesting)
+	require.Nil(t, pva.DelegatedFree)
+
+	// require the ability to delegate all vested coins
+	pva = NewPeriodicVestingAccount(&bacc, now.Unix(), periods)
+	pva.TrackDelegation(endTime, origCoins, origCoins)
+	require.Nil(t, pva.DelegatedVesting)
+	require.Equal(t, origCoins, pva.DelegatedFree)
+
+	// delegate half of vesting coins
+	pva = NewPeriodicVestingAccount(&bacc, now.Unix(), periods)
+	pva.TrackDelegation(now, origCoins, periods[0].Amount)
+	// require that all delegated coins are delegated vesting
+	require.Equal(t, pva.DelegatedVesting, periods[0].Amount)
+	require.Nil(t, pva.DelegatedFree)
+
+	// delegate 75% of coins, split between vested and vesting
+	pva = NewPeriodicVestingAccount(&bacc, now.Unix(), periods)
+	pva.TrackDelegation(now.Add(12*time.Hour), origCoins, periods[0].Amount.Add(periods[1].Amount))
+	// require that the maximum possible amount of vesting coins are chosen for delegation.
+	require.Equal(t, pva.DelegatedFree, periods[1].Amount)
+	require.Equal(t, pva.DelegatedVesting, periods[0].Amount)
+
+	// require no modifications when delegation amount is zero or not enough funds
+	pva = NewPeriodicVestingAccount(&bacc, now.Unix(), periods)
+	require.Panics(t, func() {
+		pva.TrackDelegation(endTime, origCoins, sdk.Coins{sdk.NewInt64Coin(stakeDenom, 1000000)})
+	})
+	require.Nil(t, pva.DelegatedVesting)
+	require.Nil(t, pva.DelegatedFree)
+}
+
+func TestTrackUndelegationPeriodicVestingAcc(t *testing.T) {
+	now := tmtime.Now()
+	endTime := now.Add(24 * time.Hour)
+	periods := Periods{
+		Period{Length: int64(12 * 60 * 60), Amount: sdk.Coins{sdk.NewInt64Coin(feeDenom, 500), sdk.NewInt64Coin(stakeDenom, 50)}},
+		Period{Length: int64(6 * 60 * 60), Amount: sdk.Coins{sdk.NewInt64Coin(feeDenom, 250), sdk.NewInt64Coin(stakeDenom, 25)}},
+		Period{Length: int64(6 * 60 * 60), Amount: sdk.Coins{sdk.NewInt64Coin(feeDenom, 250), sdk.NewInt64Coin(stakeDenom, 25)}},
+	}
+
+	_, _, addr := KeyTestPubAddr()
+	origCoins := sdk.Coins{sdk.NewInt64Coin(feeDenom, 1000), sdk.NewInt64Coin(stakeDenom, 100)}
+	bacc := NewBaseAccountWithAddress(addr)
+
+	// require the ability to undelegate all vesting coins at the beginning of vesting
+	pva := NewPeriodicVestingAccount(&bacc, now.Unix(), periods)
+	pva.TrackDelegation(now, origCoins, origCoins)
+	pva.TrackUndelegation(origCoins)
+	require.Nil(t, pva.DelegatedFree)
+	require.Nil(t, pva.DelegatedVesting)
+
+	// require the ability to undelegate all vested coins at the end of vesting
+	pva = NewPeriodicVestingAccount(&bacc, now.Unix(), periods)
+	pva.TrackDelegation(endTime, origCoins, origCoins)
+	pva.TrackUndelegation(origCoins)
+	require.Nil(t, pva.DelegatedFree)
+	require.Nil(t, pva.DelegatedVesting)
+
+	// require the ability to undelegate half of coins
+	pva = NewPeriodicVestingAccount(&bacc, now.Unix(), periods)
+	pva.TrackDelegation(endTime, origCoins, periods[0].Amount)
+	pva.TrackUndelegation(periods[0].Amount)
+	require.Nil(t, pva.DelegatedFree)
+	require.Nil(t, pva.DelegatedVesting)
+
+	// require no modifications when the undelegation amount is zero
+	pva = NewPeriodicVestingAccount(&bacc, now.Unix(), periods)
+	require.Panics(t, func() {
+		pva.TrackUndelegation(sdk.Coins{sdk.NewInt64Coin(stakeDenom, 0)})
+	})
+	require.Nil(t, pva.DelegatedFree)
+	require.Nil(t, pva.DelegatedVesting)
+
+	// vest 50% and delegate to two validators
+	pva = NewPeriodicVestingAccount(&bacc, now.Unix(), periods)
+	pva.TrackDelegation(now.Add(12*time.Hour), origCoins, sdk.Coins{sdk.NewInt64Coin(stakeDenom, 50)})
+	pva.TrackDelegation(now.Add(12*time.Hour), origCoins, sdk.Coins{sdk.NewInt64Coin(stakeDenom, 50)})
+
+	// undelegate from one validator that got slashed 50%
+	pva.TrackUndelegation(sdk.Coins{sdk.NewInt64Coin(stakeDenom, 25)})
+	require.Equal(t, sdk.Coins{sdk.NewInt64Coin(stakeDenom, 25)}, pva.DelegatedFree)
+	require.Equal(t, sdk.Coins{sdk.NewInt64Coin(stakeDenom, 50)}, pva.DelegatedVesting)
+
+	// undelegate from the other validator that did not get slashed
+	pva.TrackUndelegation(sdk.Coins{sdk.NewInt64Coin(stakeDenom, 50)})
+	require.Nil(t, pva.DelegatedFree)
+	require.Equal(t, sdk.Coins{sdk.NewInt64Coin(stakeDenom, 25)}, pva.DelegatedVesting)
 }
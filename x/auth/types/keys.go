@@ -9,8 +9,8 @@ const (
 	// StoreKey is string representation of the store key for auth
 	StoreKey = "acc"
 
-	// FeeStoreKey is a string representation of the store key for fees
-	FeeStoreKey = "fee"
+	// FeeCollectorName the root string for the fee collector account address
+	FeeCollectorName = "fee_collector"
 
 	// QuerierRoute is the querier route for acc
 	QuerierRoute = StoreKey
@@ -0,0 +1,125 @@
+package types
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/tendermint/tendermint/crypto"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// permissions that can be granted to a module account
+const (
+	Minter  = "minter"
+	Burner  = "burner"
+	Staking = "staking"
+)
+
+// ModuleAccountI defines an account interface for modules that hold tokens in
+// an escrow.
+type ModuleAccountI interface {
+	Account
+
+	GetName() string
+	GetPermissions() []string
+	HasPermission(string) bool
+}
+
+//-----------------------------------------------------------------------------
+// Module Account
+
+var _ ModuleAccountI = (*ModuleAccount)(nil)
+
+// ModuleAccount defines an account for modules that holds coins on a pool. A
+// module account has no public key and can't sign transactions; its coins can
+// only be moved by the module that owns it.
+type ModuleAccount struct {
+	*BaseAccount
+
+	Name        string   `json:"name"`        // name of the module
+	Permissions []string `json:"permissions"` // permissions of module account
+}
+
+// NewModuleAddress creates an AccAddress from the hash of the module's name.
+func NewModuleAddress(name string) sdk.AccAddress {
+	return sdk.AccAddress(crypto.AddressHash([]byte(name)))
+}
+
+// NewEmptyModuleAccount creates a new ModuleAccount without coins and with the
+// given permissions. It panics if any of the permissions is invalid.
+func NewEmptyModuleAccount(name string, permissions ...string) *ModuleAccount {
+	baseAcc := NewBaseAccountWithAddress(NewModuleAddress(name))
+	return NewModuleAccount(&baseAcc, name, permissions...)
+}
+
+// NewModuleAccount creates a new ModuleAccount instance from a BaseAccount. It
+// panics if any of the permissions is invalid.
+func NewModuleAccount(ba *BaseAccount, name string, permissions ...string) *ModuleAccount {
+	if err := ValidatePermissions(permissions...); err != nil {
+		panic(err)
+	}
+
+	return &ModuleAccount{
+		BaseAccount: ba,
+		Name:        name,
+		Permissions: permissions,
+	}
+}
+
+// HasPermission returns whether or not the module account has permission.
+func (ma ModuleAccount) HasPermission(permission string) bool {
+	for _, perm := range ma.Permissions {
+		if perm == permission {
+			return true
+		}
+	}
+	return false
+}
+
+// GetName returns the the name of the holder's module
+func (ma ModuleAccount) GetName() string {
+	return ma.Name
+}
+
+// GetPermissions returns permissions granted to the module account
+func (ma ModuleAccount) GetPermissions() []string {
+	return ma.Permissions
+}
+
+// SetPubKey - Implements Account
+func (ma ModuleAccount) SetPubKey(pubKey crypto.PubKey) error {
+	return fmt.Errorf("not supported for module accounts")
+}
+
+// SetSequence - Implements Account
+func (ma ModuleAccount) SetSequence(seq uint64) error {
+	return fmt.Errorf("not supported for module accounts")
+}
+
+// String follows stringer interface
+func (ma ModuleAccount) String() string {
+	return fmt.Sprintf(`Module Account:
+  Address:       %s
+  AccountNumber: %d
+  Name:          %s
+  Permissions:   %v`,
+		ma.Address, ma.AccountNumber, ma.Name, ma.Permissions,
+	)
+}
+
+// ValidatePermissions validates that all the given permissions are known
+// module account permissions.
+func ValidatePermissions(permissions ...string) error {
+	for _, perm := range permissions {
+		switch perm {
+		case Minter, Burner, Staking:
+			continue
+		case "":
+			return errors.New("module permission cannot be blank")
+		default:
+			return fmt.Errorf("invalid module permission %s", perm)
+		}
+	}
+	return nil
+}
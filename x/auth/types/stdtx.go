@@ -18,7 +18,8 @@ var (
 )
 
 // StdTx is a standard way to wrap a Msg with Fee and Signatures.
-// NOTE: the first signature is the fee payer (Signatures must not be nil).
+// NOTE: the first signature is the fee payer (Signatures must not be nil),
+// unless the fees are paid by a granter through a fee allowance.
 type StdTx struct {
 	Msgs       []sdk.Msg      `json:"msg"`
 	Fee        StdFee         `json:"fee"`
@@ -93,6 +94,19 @@ func (tx StdTx) GetSigners() []sdk.AccAddress {
 	return signers
 }
 
+// FeePayer returns the address of the signer the fees are charged for, which
+// is the first signer of the transaction.
+func (tx StdTx) FeePayer() sdk.AccAddress {
+	if signers := tx.GetSigners(); len(signers) > 0 {
+		return signers[0]
+	}
+	return nil
+}
+
+// FeeGranter returns the address of the account that pays the fees on behalf
+// of the fee payer, if any.
+func (tx StdTx) FeeGranter() sdk.AccAddress { return tx.Fee.Granter }
+
 // GetMemo returns the memo
 func (tx StdTx) GetMemo() string { return tx.Memo }
 
@@ -111,9 +125,14 @@ func (tx StdTx) GetSignatures() []StdSignature { return tx.Signatures }
 // StdFee includes the amount of coins paid in fees and the maximum
 // gas to be used by the transaction. The ratio yields an effective "gasprice",
 // which must be above some miminum to be accepted into the mempool.
+//
+// The fees are paid by the first signer, unless a granter is set. In that case
+// they are paid by the granter and charged against the fee allowance it
+// granted to the first signer.
 type StdFee struct {
-	Amount sdk.Coins `json:"amount"`
-	Gas    uint64    `json:"gas"`
+	Amount  sdk.Coins      `json:"amount"`
+	Gas     uint64         `json:"gas"`
+	Granter sdk.AccAddress `json:"granter,omitempty"`
 }
 
 // NewStdFee returns a new instance of StdFee
@@ -26,6 +26,7 @@ type TxBuilder struct {
 	memo               string
 	fees               sdk.Coins
 	gasPrices          sdk.DecCoins
+	feeGranter         sdk.AccAddress
 }
 
 // NewTxBuilder returns a new initialized TxBuilder.
@@ -70,6 +71,14 @@ func NewTxBuilderFromCLI() TxBuilder {
 	txbldr = txbldr.WithFees(viper.GetString(flags.FlagFees))
 	txbldr = txbldr.WithGasPrices(viper.GetString(flags.FlagGasPrices))
 
+	if granter := viper.GetString(flags.FlagFeeGranter); granter != "" {
+		addr, err := sdk.AccAddressFromBech32(granter)
+		if err != nil {
+			panic(err)
+		}
+		txbldr = txbldr.WithFeeGranter(addr)
+	}
+
 	return txbldr
 }
 
@@ -107,6 +116,9 @@ func (bldr TxBuilder) Fees() sdk.Coins { return bldr.fees }
 // GasPrices returns the gas prices set for the transaction, if any.
 func (bldr TxBuilder) GasPrices() sdk.DecCoins { return bldr.gasPrices }
 
+// FeeGranter returns the account paying the fees of the transaction, if any.
+func (bldr TxBuilder) FeeGranter() sdk.AccAddress { return bldr.feeGranter }
+
 // WithTxEncoder returns a copy of the context with an updated codec.
 func (bldr TxBuilder) WithTxEncoder(txEncoder sdk.TxEncoder) TxBuilder {
 	bldr.txEncoder = txEncoder
@@ -147,6 +159,12 @@ func (bldr TxBuilder) WithGasPrices(gasPrices string) TxBuilder {
 	return bldr
 }
 
+// WithFeeGranter returns a copy of the context with an updated fee granter.
+func (bldr TxBuilder) WithFeeGranter(granter sdk.AccAddress) TxBuilder {
+	bldr.feeGranter = granter
+	return bldr
+}
+
 // WithKeybase returns a copy of the context with updated keybase.
 func (bldr TxBuilder) WithKeybase(keybase crkeys.Keybase) TxBuilder {
 	bldr.keybase = keybase
@@ -196,13 +214,16 @@ func (bldr TxBuilder) BuildSignMsg(msgs []sdk.Msg) (StdSignMsg, error) {
 		}
 	}
 
+	fee := NewStdFee(bldr.gas, fees)
+	fee.Granter = bldr.feeGranter
+
 	return StdSignMsg{
 		ChainID:       bldr.chainID,
 		AccountNumber: bldr.accountNumber,
 		Sequence:      bldr.sequence,
 		Memo:          bldr.memo,
 		Msgs:          msgs,
-		Fee:           NewStdFee(bldr.gas, fees),
+		Fee:           fee,
 	}, nil
 }
 
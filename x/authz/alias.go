@@ -0,0 +1,72 @@
+// nolint
+// autogenerated code using github.com/rigelrozanski/multitool
+// aliases generated for the following subdirectories:
+// ALIASGEN: github.com/cosmos/cosmos-sdk/x/authz/keeper
+// ALIASGEN: github.com/cosmos/cosmos-sdk/x/authz/types
+package authz
+
+import (
+	"github.com/cosmos/cosmos-sdk/x/authz/keeper"
+	"github.com/cosmos/cosmos-sdk/x/authz/types"
+)
+
+const (
+	DefaultCodespace             = types.DefaultCodespace
+	CodeNoAuthorization          = types.CodeNoAuthorization
+	CodeUnauthorized             = types.CodeUnauthorized
+	CodeInvalidAuthorization     = types.CodeInvalidAuthorization
+	CodeInvalidExpiration        = types.CodeInvalidExpiration
+	EventTypeGrantAuthorization  = types.EventTypeGrantAuthorization
+	EventTypeRevokeAuthorization = types.EventTypeRevokeAuthorization
+	EventTypeExecAuthorized      = types.EventTypeExecAuthorized
+	AttributeKeyGranter          = types.AttributeKeyGranter
+	AttributeKeyGrantee          = types.AttributeKeyGrantee
+	AttributeKeyMsgType          = types.AttributeKeyMsgType
+	AttributeValueCategory       = types.AttributeValueCategory
+	ModuleName                   = types.ModuleName
+	StoreKey                     = types.StoreKey
+	RouterKey                    = types.RouterKey
+	QuerierRoute                 = types.QuerierRoute
+	QueryAuthorizations          = types.QueryAuthorizations
+)
+
+var (
+	// functions aliases
+	NewKeeper                    = keeper.NewKeeper
+	NewQuerier                   = keeper.NewQuerier
+	MsgType                      = types.MsgType
+	NewGenericAuthorization      = types.NewGenericAuthorization
+	NewSendAuthorization         = types.NewSendAuthorization
+	RegisterCodec                = types.RegisterCodec
+	ErrNoAuthorization           = types.ErrNoAuthorization
+	ErrUnauthorized              = types.ErrUnauthorized
+	ErrInvalidAuthorization      = types.ErrInvalidAuthorization
+	ErrInvalidExpiration         = types.ErrInvalidExpiration
+	NewGenesisState              = types.NewGenesisState
+	DefaultGenesisState          = types.DefaultGenesisState
+	NewAuthorizationGrant        = types.NewAuthorizationGrant
+	GetAuthorizationKey          = types.GetAuthorizationKey
+	GetAuthorizationsKey         = types.GetAuthorizationsKey
+	NewMsgGrant                  = types.NewMsgGrant
+	NewMsgRevoke                 = types.NewMsgRevoke
+	NewMsgExec                   = types.NewMsgExec
+	NewQueryAuthorizationsParams = types.NewQueryAuthorizationsParams
+
+	// variable aliases
+	ModuleCdc              = types.ModuleCdc
+	AuthorizationKeyPrefix = types.AuthorizationKeyPrefix
+)
+
+type (
+	Keeper                    = keeper.Keeper
+	Authorization             = types.Authorization
+	GenericAuthorization      = types.GenericAuthorization
+	SendAuthorization         = types.SendAuthorization
+	GenesisState              = types.GenesisState
+	AuthorizationGrant        = types.AuthorizationGrant
+	AuthorizationGrants       = types.AuthorizationGrants
+	MsgGrant                  = types.MsgGrant
+	MsgRevoke                 = types.MsgRevoke
+	MsgExec                   = types.MsgExec
+	QueryAuthorizationsParams = types.QueryAuthorizationsParams
+)
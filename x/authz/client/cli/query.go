@@ -0,0 +1,83 @@
+package cli
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/context"
+	"github.com/cosmos/cosmos-sdk/client/utils"
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/version"
+	"github.com/cosmos/cosmos-sdk/x/authz/types"
+)
+
+// GetQueryCmd returns the cli query commands for this module
+func GetQueryCmd(cdc *codec.Codec) *cobra.Command {
+	// Group authz queries under a subcommand
+	authzQueryCmd := &cobra.Command{
+		Use:                        types.ModuleName,
+		Short:                      "Querying commands for the authz module",
+		DisableFlagParsing:         true,
+		SuggestionsMinimumDistance: 2,
+		RunE:                       utils.ValidateCmd,
+	}
+
+	authzQueryCmd.AddCommand(client.GetCommands(
+		GetCmdQueryAuthorizations(cdc),
+	)...)
+
+	return authzQueryCmd
+}
+
+// GetCmdQueryAuthorizations implements the query authorizations command.
+func GetCmdQueryAuthorizations(cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "authorizations [granter] [grantee]",
+		Args:  cobra.ExactArgs(2),
+		Short: "Query the authorizations granted by an address to another",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query all the unexpired authorizations granted by an address to another.
+
+Example:
+$ %s query %s authorizations cosmos1skjw.. cosmos1gghj..
+`,
+				version.ClientName, types.ModuleName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			granter, err := sdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+
+			grantee, err := sdk.AccAddressFromBech32(args[1])
+			if err != nil {
+				return err
+			}
+
+			bz, err := cdc.MarshalJSON(types.NewQueryAuthorizationsParams(granter, grantee))
+			if err != nil {
+				return err
+			}
+
+			route := fmt.Sprintf("custom/%s/%s", types.QuerierRoute, types.QueryAuthorizations)
+			res, err := cliCtx.QueryWithData(route, bz)
+			if err != nil {
+				return err
+			}
+
+			var grants types.AuthorizationGrants
+			if err := cdc.UnmarshalJSON(res, &grants); err != nil {
+				return err
+			}
+
+			return cliCtx.PrintOutput(grants)
+		},
+	}
+}
@@ -0,0 +1,175 @@
+package cli
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/context"
+	"github.com/cosmos/cosmos-sdk/client/utils"
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/version"
+	"github.com/cosmos/cosmos-sdk/x/auth"
+	"github.com/cosmos/cosmos-sdk/x/authz/types"
+)
+
+// authz flags
+const (
+	FlagExpiration = "expiration"
+	FlagSpendLimit = "spend-limit"
+)
+
+// GetTxCmd returns the transaction commands for this module
+func GetTxCmd(cdc *codec.Codec) *cobra.Command {
+	authzTxCmd := &cobra.Command{
+		Use:                        types.ModuleName,
+		Short:                      "Authorization transactions subcommands",
+		DisableFlagParsing:         true,
+		SuggestionsMinimumDistance: 2,
+		RunE:                       utils.ValidateCmd,
+	}
+
+	authzTxCmd.AddCommand(client.PostCommands(
+		GetCmdGrantAuthorization(cdc),
+		GetCmdRevokeAuthorization(cdc),
+		GetCmdExecAuthorized(cdc),
+	)...)
+
+	return authzTxCmd
+}
+
+// GetCmdGrantAuthorization implements the grant authorization command.
+func GetCmdGrantAuthorization(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "grant [grantee] [msg-type]",
+		Args:  cobra.ExactArgs(2),
+		Short: "Grant authorization to an address to execute messages on your behalf",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Grant authorization to an address to execute messages of a given type
+on your behalf, until the expiration time. The message type is made of the route
+and type of the message. A spend limit may be set for bank/send messages.
+
+Example:
+$ %s tx %s grant cosmos1skjw.. gov/vote --expiration 2020-01-01T00:00:00Z --from mykey
+$ %s tx %s grant cosmos1skjw.. bank/send --spend-limit 1000stake --expiration 2020-01-01T00:00:00Z --from mykey
+`,
+				version.ClientName, types.ModuleName, version.ClientName, types.ModuleName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			txBldr := auth.NewTxBuilderFromCLI().WithTxEncoder(utils.GetTxEncoder(cdc))
+			cliCtx := context.NewCLIContext().WithCodec(cdc).WithAccountDecoder(cdc)
+
+			grantee, err := sdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+
+			expiration, err := time.Parse(time.RFC3339, viper.GetString(FlagExpiration))
+			if err != nil {
+				return err
+			}
+
+			var authorization types.Authorization = types.NewGenericAuthorization(args[1])
+			if limit := viper.GetString(FlagSpendLimit); limit != "" {
+				spendLimit, err := sdk.ParseCoins(limit)
+				if err != nil {
+					return err
+				}
+
+				authorization = types.NewSendAuthorization(spendLimit)
+				if args[1] != authorization.MsgType() {
+					return fmt.Errorf("a spend limit can only be set for %s messages", authorization.MsgType())
+				}
+			}
+
+			msg := types.NewMsgGrant(cliCtx.GetFromAddress(), grantee, authorization, expiration)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return utils.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
+		},
+	}
+
+	cmd.Flags().String(FlagExpiration, "", "The time at which the authorization expires (RFC3339 format)")
+	cmd.Flags().String(FlagSpendLimit, "", "The maximum amount of coins that can be sent, for bank/send messages")
+	cmd.MarkFlagRequired(FlagExpiration)
+
+	return cmd
+}
+
+// GetCmdRevokeAuthorization implements the revoke authorization command.
+func GetCmdRevokeAuthorization(cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "revoke [grantee] [msg-type]",
+		Args:  cobra.ExactArgs(2),
+		Short: "Revoke the authorization granted to an address for a message type",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Revoke the authorization granted to an address to execute messages of a
+given type on your behalf.
+
+Example:
+$ %s tx %s revoke cosmos1skjw.. gov/vote --from mykey
+`,
+				version.ClientName, types.ModuleName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			txBldr := auth.NewTxBuilderFromCLI().WithTxEncoder(utils.GetTxEncoder(cdc))
+			cliCtx := context.NewCLIContext().WithCodec(cdc).WithAccountDecoder(cdc)
+
+			grantee, err := sdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgRevoke(cliCtx.GetFromAddress(), grantee, args[1])
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return utils.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
+		},
+	}
+}
+
+// GetCmdExecAuthorized implements the execute authorized messages command.
+func GetCmdExecAuthorized(cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "exec [tx-json-file]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Execute the messages of a transaction on behalf of their signer",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Execute the messages of a transaction, generated with --generate-only, on
+behalf of their signer, who must have granted you the authorization to do so.
+
+Example:
+$ %s tx %s exec tx.json --from mykey
+`,
+				version.ClientName, types.ModuleName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			txBldr := auth.NewTxBuilderFromCLI().WithTxEncoder(utils.GetTxEncoder(cdc))
+			cliCtx := context.NewCLIContext().WithCodec(cdc).WithAccountDecoder(cdc)
+
+			stdTx, err := utils.ReadStdTxFromFile(cdc, args[0])
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgExec(cliCtx.GetFromAddress(), stdTx.GetMsgs())
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return utils.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
+		},
+	}
+}
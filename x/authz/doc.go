@@ -0,0 +1,19 @@
+/*
+Package authz provides functionality for an account (the granter) to authorize
+another account (the grantee) to execute messages on its behalf, e.g. to let a
+hot key withdraw distribution rewards or vote on governance proposals for a cold
+key without sharing it.
+
+The granter grants an Authorization for a message type, made of the route and
+type of the message (e.g. "gov/vote"), until an expiration time through
+MsgGrant, and may take it back at any time through MsgRevoke. Two
+authorizations are provided:
+
+  - GenericAuthorization allows any message of the given type.
+  - SendAuthorization allows bank/send messages up to a spend limit.
+
+The grantee executes the messages through MsgExec. Each message is dispatched
+through the application router as if its signer had signed it, once the
+authorization granted by the signer accepts it.
+*/
+package authz
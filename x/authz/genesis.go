@@ -0,0 +1,34 @@
+package authz
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// InitGenesis stores the authorizations granted at genesis.
+func InitGenesis(ctx sdk.Context, k Keeper, data GenesisState) {
+	for _, grant := range data.Authorizations {
+		k.Grant(ctx, grant.Granter, grant.Grantee, grant.Authorization, grant.Expiration)
+	}
+}
+
+// ExportGenesis returns a GenesisState for a given context and keeper. Expired
+// authorizations are not exported.
+func ExportGenesis(ctx sdk.Context, k Keeper) GenesisState {
+	blockTime := ctx.BlockHeader().Time
+
+	var grants []AuthorizationGrant
+	k.IterateAllAuthorizations(ctx, func(grant AuthorizationGrant) bool {
+		if !grant.IsExpired(blockTime) {
+			grants = append(grants, grant)
+		}
+		return false
+	})
+
+	return NewGenesisState(grants)
+}
+
+// ValidateGenesis performs basic validation of authz genesis data returning an
+// error for any failed validation criteria.
+func ValidateGenesis(data GenesisState) error {
+	return data.ValidateBasic()
+}
@@ -0,0 +1,78 @@
+package authz
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// NewHandler returns a handler for authz messages
+func NewHandler(k Keeper) sdk.Handler {
+	return func(ctx sdk.Context, msg sdk.Msg) sdk.Result {
+		switch msg := msg.(type) {
+		case MsgGrant:
+			return handleMsgGrant(ctx, k, msg)
+
+		case MsgRevoke:
+			return handleMsgRevoke(ctx, k, msg)
+
+		case MsgExec:
+			return handleMsgExec(ctx, k, msg)
+
+		default:
+			errMsg := fmt.Sprintf("unrecognized authz message type: %T", msg)
+			return sdk.ErrUnknownRequest(errMsg).Result()
+		}
+	}
+}
+
+func handleMsgGrant(ctx sdk.Context, k Keeper, msg MsgGrant) sdk.Result {
+	if !msg.Expiration.After(ctx.BlockHeader().Time) {
+		return ErrInvalidExpiration(DefaultCodespace).Result()
+	}
+
+	k.Grant(ctx, msg.Granter, msg.Grantee, msg.Authorization, msg.Expiration)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.Granter.String()),
+		),
+	)
+
+	return sdk.Result{Events: ctx.EventManager().Events()}
+}
+
+func handleMsgRevoke(ctx sdk.Context, k Keeper, msg MsgRevoke) sdk.Result {
+	if err := k.Revoke(ctx, msg.Granter, msg.Grantee, msg.MsgType); err != nil {
+		return err.Result()
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.Granter.String()),
+		),
+	)
+
+	return sdk.Result{Events: ctx.EventManager().Events()}
+}
+
+func handleMsgExec(ctx sdk.Context, k Keeper, msg MsgExec) sdk.Result {
+	res := k.DispatchActions(ctx, msg.Grantee, msg.Msgs)
+	if !res.IsOK() {
+		return res
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.Grantee.String()),
+		),
+	)
+
+	return sdk.Result{Data: res.Data, Events: ctx.EventManager().Events().AppendEvents(res.Events)}
+}
@@ -0,0 +1,198 @@
+package keeper
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/tendermint/tendermint/libs/log"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/authz/types"
+)
+
+// Keeper manages the authorizations granted between accounts, and executes
+// messages on behalf of their granters.
+type Keeper struct {
+	cdc      *codec.Codec
+	storeKey sdk.StoreKey
+	router   sdk.Router
+}
+
+// NewKeeper creates a new authz Keeper instance. The router is used to
+// dispatch the messages executed on behalf of a granter to their handlers.
+func NewKeeper(cdc *codec.Codec, storeKey sdk.StoreKey, router sdk.Router) Keeper {
+	return Keeper{
+		cdc:      cdc,
+		storeKey: storeKey,
+		router:   router,
+	}
+}
+
+// Logger returns a module-specific logger.
+func (k Keeper) Logger(ctx sdk.Context) log.Logger {
+	return ctx.Logger().With("module", fmt.Sprintf("x/%s", types.ModuleName))
+}
+
+// Grant grants the authorization to the grantee on behalf of the granter until
+// the expiration time, replacing any authorization previously granted for the
+// same message type.
+func (k Keeper) Grant(ctx sdk.Context, granter, grantee sdk.AccAddress, authorization types.Authorization,
+	expiration time.Time) {
+
+	k.setAuthorizationGrant(ctx, types.NewAuthorizationGrant(granter, grantee, authorization, expiration))
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeGrantAuthorization,
+			sdk.NewAttribute(types.AttributeKeyGranter, granter.String()),
+			sdk.NewAttribute(types.AttributeKeyGrantee, grantee.String()),
+			sdk.NewAttribute(types.AttributeKeyMsgType, authorization.MsgType()),
+		),
+	)
+}
+
+// Revoke removes the authorization granted by the granter to the grantee for
+// the given message type. It returns an error if there is no such
+// authorization.
+func (k Keeper) Revoke(ctx sdk.Context, granter, grantee sdk.AccAddress, msgType string) sdk.Error {
+	store := ctx.KVStore(k.storeKey)
+	key := types.GetAuthorizationKey(granter, grantee, msgType)
+	if !store.Has(key) {
+		return types.ErrNoAuthorization(types.DefaultCodespace, msgType)
+	}
+
+	store.Delete(key)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeRevokeAuthorization,
+			sdk.NewAttribute(types.AttributeKeyGranter, granter.String()),
+			sdk.NewAttribute(types.AttributeKeyGrantee, grantee.String()),
+			sdk.NewAttribute(types.AttributeKeyMsgType, msgType),
+		),
+	)
+
+	return nil
+}
+
+// GetAuthorization returns the authorization granted by the granter to the
+// grantee for the given message type, along with its expiration time. It
+// returns a nil authorization if there is none or if it is expired.
+func (k Keeper) GetAuthorization(ctx sdk.Context, granter, grantee sdk.AccAddress,
+	msgType string) (types.Authorization, time.Time) {
+
+	grant, found := k.getAuthorizationGrant(ctx, granter, grantee, msgType)
+	if !found || grant.IsExpired(ctx.BlockHeader().Time) {
+		return nil, time.Time{}
+	}
+	return grant.Authorization, grant.Expiration
+}
+
+// IterateAuthorizations iterates over all the authorizations granted by the
+// granter to the grantee and calls cb with each of them. The iteration stops
+// if cb returns true.
+func (k Keeper) IterateAuthorizations(ctx sdk.Context, granter, grantee sdk.AccAddress,
+	cb func(grant types.AuthorizationGrant) (stop bool)) {
+
+	k.iterateAuthorizationGrants(ctx, types.GetAuthorizationsKey(granter, grantee), cb)
+}
+
+// IterateAllAuthorizations iterates over all the authorizations in the store
+// and calls cb with each of them. The iteration stops if cb returns true.
+func (k Keeper) IterateAllAuthorizations(ctx sdk.Context, cb func(grant types.AuthorizationGrant) (stop bool)) {
+	k.iterateAuthorizationGrants(ctx, types.AuthorizationKeyPrefix, cb)
+}
+
+// DispatchActions executes the messages on behalf of their signer through
+// their handlers, as if the signer had signed them. The grantee must have been
+// authorized by the signer of every message, unless it is the signer itself.
+func (k Keeper) DispatchActions(ctx sdk.Context, grantee sdk.AccAddress, msgs []sdk.Msg) sdk.Result {
+	res, _ := sdk.DispatchMsgs(ctx, k.router, msgs, func(ctx sdk.Context, msg sdk.Msg) (sdk.Events, sdk.Error) {
+		granter, ok := sdk.GetSingleSigner(msg)
+		if !ok {
+			return nil, types.ErrUnauthorized(types.DefaultCodespace,
+				"authorization can be given to msg with only one signer")
+		}
+
+		if granter.Equals(grantee) {
+			return nil, nil
+		}
+
+		if err := k.useAuthorization(ctx, granter, grantee, msg); err != nil {
+			return nil, err
+		}
+
+		return sdk.Events{
+			sdk.NewEvent(
+				types.EventTypeExecAuthorized,
+				sdk.NewAttribute(types.AttributeKeyGranter, granter.String()),
+				sdk.NewAttribute(types.AttributeKeyGrantee, grantee.String()),
+				sdk.NewAttribute(types.AttributeKeyMsgType, types.MsgType(msg)),
+			),
+		}, nil
+	})
+
+	return res
+}
+
+// useAuthorization checks that the message is accepted by the authorization
+// granted by the granter to the grantee, and updates or deletes the
+// authorization accordingly.
+func (k Keeper) useAuthorization(ctx sdk.Context, granter, grantee sdk.AccAddress, msg sdk.Msg) sdk.Error {
+	msgType := types.MsgType(msg)
+
+	grant, found := k.getAuthorizationGrant(ctx, granter, grantee, msgType)
+	if !found || grant.IsExpired(ctx.BlockHeader().Time) {
+		return types.ErrNoAuthorization(types.DefaultCodespace, msgType)
+	}
+
+	allow, updated, del := grant.Authorization.Accept(msg, ctx.BlockHeader())
+	if !allow {
+		return types.ErrUnauthorized(types.DefaultCodespace, "message rejected by the authorization")
+	}
+
+	if del {
+		ctx.KVStore(k.storeKey).Delete(types.GetAuthorizationKey(granter, grantee, msgType))
+	} else if updated != nil {
+		grant.Authorization = updated
+		k.setAuthorizationGrant(ctx, grant)
+	}
+
+	return nil
+}
+
+func (k Keeper) getAuthorizationGrant(ctx sdk.Context, granter, grantee sdk.AccAddress,
+	msgType string) (grant types.AuthorizationGrant, found bool) {
+
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.GetAuthorizationKey(granter, grantee, msgType))
+	if bz == nil {
+		return grant, false
+	}
+
+	k.cdc.MustUnmarshalBinaryLengthPrefixed(bz, &grant)
+	return grant, true
+}
+
+func (k Keeper) setAuthorizationGrant(ctx sdk.Context, grant types.AuthorizationGrant) {
+	store := ctx.KVStore(k.storeKey)
+	bz := k.cdc.MustMarshalBinaryLengthPrefixed(grant)
+	store.Set(types.GetAuthorizationKey(grant.Granter, grant.Grantee, grant.Authorization.MsgType()), bz)
+}
+
+func (k Keeper) iterateAuthorizationGrants(ctx sdk.Context, prefix []byte,
+	cb func(grant types.AuthorizationGrant) (stop bool)) {
+
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, prefix)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var grant types.AuthorizationGrant
+		k.cdc.MustUnmarshalBinaryLengthPrefixed(iterator.Value(), &grant)
+		if cb(grant) {
+			break
+		}
+	}
+}
@@ -0,0 +1,154 @@
+package keeper
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	abci "github.com/tendermint/tendermint/abci/types"
+	"github.com/tendermint/tendermint/crypto/ed25519"
+	dbm "github.com/tendermint/tendermint/libs/db"
+	"github.com/tendermint/tendermint/libs/log"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/store"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/auth"
+	"github.com/cosmos/cosmos-sdk/x/authz/types"
+	"github.com/cosmos/cosmos-sdk/x/bank"
+	"github.com/cosmos/cosmos-sdk/x/params"
+)
+
+var (
+	granterAddr   = sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address())
+	granteeAddr   = sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address())
+	recipientAddr = sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address())
+
+	initCoins = sdk.NewCoins(sdk.NewInt64Coin("stake", 1000))
+)
+
+func createTestInput(t *testing.T) (sdk.Context, bank.Keeper, Keeper) {
+	keyAcc := sdk.NewKVStoreKey(auth.StoreKey)
+	keyParams := sdk.NewKVStoreKey(params.StoreKey)
+	tkeyParams := sdk.NewTransientStoreKey(params.TStoreKey)
+	keyBank := sdk.NewKVStoreKey(bank.StoreKey)
+	keyAuthz := sdk.NewKVStoreKey(types.StoreKey)
+
+	db := dbm.NewMemDB()
+	ms := store.NewCommitMultiStore(db)
+	ms.MountStoreWithDB(keyAcc, sdk.StoreTypeIAVL, db)
+	ms.MountStoreWithDB(keyBank, sdk.StoreTypeIAVL, db)
+	ms.MountStoreWithDB(keyParams, sdk.StoreTypeIAVL, db)
+	ms.MountStoreWithDB(tkeyParams, sdk.StoreTypeTransient, db)
+	ms.MountStoreWithDB(keyAuthz, sdk.StoreTypeIAVL, db)
+	require.NoError(t, ms.LoadLatestVersion())
+
+	cdc := codec.New()
+	auth.RegisterCodec(cdc)
+	bank.RegisterCodec(cdc)
+	types.RegisterCodec(cdc)
+	codec.RegisterCrypto(cdc)
+
+	ctx := sdk.NewContext(ms, abci.Header{ChainID: "authz-chain", Height: 1, Time: time.Now()}, false, log.NewNopLogger())
+	pk := params.NewKeeper(cdc, keyParams, tkeyParams, params.DefaultCodespace)
+	ak := auth.NewAccountKeeper(cdc, keyAcc, pk.Subspace(auth.DefaultParamspace), auth.ProtoBaseAccount)
+	bk := bank.NewBaseKeeper(cdc, keyBank, ak, pk.Subspace(bank.DefaultParamspace), bank.DefaultCodespace, make(map[string]bool))
+	bk.SetParams(ctx, bank.DefaultParams())
+
+	require.NoError(t, bk.SetCoins(ctx, granterAddr, initCoins))
+
+	router := baseapp.NewRouter()
+	router.AddRoute(bank.RouterKey, bank.NewHandler(bk))
+
+	return ctx, bk, NewKeeper(cdc, keyAuthz, router)
+}
+
+func TestKeeperGrantRevoke(t *testing.T) {
+	ctx, _, k := createTestInput(t)
+	now := ctx.BlockHeader().Time
+
+	authorization := types.NewSendAuthorization(sdk.NewCoins(sdk.NewInt64Coin("stake", 100)))
+	msgType := authorization.MsgType()
+
+	// no authorization yet
+	auth, _ := k.GetAuthorization(ctx, granterAddr, granteeAddr, msgType)
+	require.Nil(t, auth)
+
+	k.Grant(ctx, granterAddr, granteeAddr, authorization, now.Add(time.Hour))
+	auth, expiration := k.GetAuthorization(ctx, granterAddr, granteeAddr, msgType)
+	require.Equal(t, authorization, auth)
+	require.Equal(t, now.Add(time.Hour).UTC(), expiration.UTC())
+
+	// the authorization is only granted in one direction
+	auth, _ = k.GetAuthorization(ctx, granteeAddr, granterAddr, msgType)
+	require.Nil(t, auth)
+
+	// expired authorizations are ignored
+	auth, _ = k.GetAuthorization(ctx.WithBlockTime(now.Add(2*time.Hour)), granterAddr, granteeAddr, msgType)
+	require.Nil(t, auth)
+
+	generic := types.NewGenericAuthorization("gov/vote")
+	k.Grant(ctx, granterAddr, granteeAddr, generic, now.Add(time.Hour))
+
+	var grants []types.AuthorizationGrant
+	k.IterateAuthorizations(ctx, granterAddr, granteeAddr, func(grant types.AuthorizationGrant) bool {
+		grants = append(grants, grant)
+		return false
+	})
+	require.Len(t, grants, 2)
+
+	require.NoError(t, k.Revoke(ctx, granterAddr, granteeAddr, msgType))
+	require.Error(t, k.Revoke(ctx, granterAddr, granteeAddr, msgType))
+	auth, _ = k.GetAuthorization(ctx, granterAddr, granteeAddr, msgType)
+	require.Nil(t, auth)
+
+	auth, _ = k.GetAuthorization(ctx, granterAddr, granteeAddr, generic.MsgType())
+	require.Equal(t, generic, auth)
+}
+
+func TestKeeperDispatchActions(t *testing.T) {
+	ctx, bk, k := createTestInput(t)
+	now := ctx.BlockHeader().Time
+
+	sendCoins := sdk.NewCoins(sdk.NewInt64Coin("stake", 60))
+	msgs := []sdk.Msg{bank.NewMsgSend(granterAddr, recipientAddr, sendCoins)}
+
+	// no authorization granted
+	res := k.DispatchActions(ctx, granteeAddr, msgs)
+	require.Equal(t, types.CodeNoAuthorization, res.Code)
+
+	authorization := types.NewSendAuthorization(sdk.NewCoins(sdk.NewInt64Coin("stake", 100)))
+	k.Grant(ctx, granterAddr, granteeAddr, authorization, now.Add(time.Hour))
+
+	// the authorization is expired
+	res = k.DispatchActions(ctx.WithBlockTime(now.Add(2*time.Hour)), granteeAddr, msgs)
+	require.Equal(t, types.CodeNoAuthorization, res.Code)
+
+	// the coins are sent on behalf of the granter and the spend limit is updated
+	res = k.DispatchActions(ctx, granteeAddr, msgs)
+	require.True(t, res.IsOK(), res.Log)
+	require.Equal(t, initCoins.Sub(sendCoins), bk.GetCoins(ctx, granterAddr))
+	require.Equal(t, sendCoins, bk.GetCoins(ctx, recipientAddr))
+
+	auth, _ := k.GetAuthorization(ctx, granterAddr, granteeAddr, authorization.MsgType())
+	require.Equal(t, types.NewSendAuthorization(sdk.NewCoins(sdk.NewInt64Coin("stake", 40))), auth)
+
+	// the spend limit is exceeded
+	res = k.DispatchActions(ctx, granteeAddr, msgs)
+	require.Equal(t, types.CodeUnauthorized, res.Code)
+
+	// the authorization is deleted once the spend limit is used up
+	msgs = []sdk.Msg{bank.NewMsgSend(granterAddr, recipientAddr, sdk.NewCoins(sdk.NewInt64Coin("stake", 40)))}
+	res = k.DispatchActions(ctx, granteeAddr, msgs)
+	require.True(t, res.IsOK(), res.Log)
+	auth, _ = k.GetAuthorization(ctx, granterAddr, granteeAddr, authorization.MsgType())
+	require.Nil(t, auth)
+
+	// the grantee does not need any authorization for its own messages
+	msgs = []sdk.Msg{bank.NewMsgSend(recipientAddr, granteeAddr, sendCoins)}
+	res = k.DispatchActions(ctx, recipientAddr, msgs)
+	require.True(t, res.IsOK(), res.Log)
+	require.Equal(t, sendCoins, bk.GetCoins(ctx, granteeAddr))
+}
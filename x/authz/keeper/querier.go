@@ -0,0 +1,47 @@
+package keeper
+
+import (
+	abci "github.com/tendermint/tendermint/abci/types"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/authz/types"
+)
+
+// NewQuerier creates a querier for authz REST endpoints
+func NewQuerier(k Keeper) sdk.Querier {
+	return func(ctx sdk.Context, path []string, req abci.RequestQuery) ([]byte, sdk.Error) {
+		switch path[0] {
+		case types.QueryAuthorizations:
+			return queryAuthorizations(ctx, req, k)
+
+		default:
+			return nil, sdk.ErrUnknownRequest("unknown authz query endpoint")
+		}
+	}
+}
+
+func queryAuthorizations(ctx sdk.Context, req abci.RequestQuery, k Keeper) ([]byte, sdk.Error) {
+	var params types.QueryAuthorizationsParams
+
+	err := k.cdc.UnmarshalJSON(req.Data, &params)
+	if err != nil {
+		return nil, sdk.ErrUnknownRequest(sdk.AppendMsgToErr("incorrectly formatted request data", err.Error()))
+	}
+
+	blockTime := ctx.BlockHeader().Time
+	grants := types.AuthorizationGrants{}
+	k.IterateAuthorizations(ctx, params.Granter, params.Grantee, func(grant types.AuthorizationGrant) bool {
+		if !grant.IsExpired(blockTime) {
+			grants = append(grants, grant)
+		}
+		return false
+	})
+
+	res, err := codec.MarshalJSONIndent(k.cdc, grants)
+	if err != nil {
+		return nil, sdk.ErrInternal(sdk.AppendMsgToErr("failed to JSON marshal result: %s", err.Error()))
+	}
+
+	return res, nil
+}
@@ -0,0 +1,119 @@
+package types
+
+import (
+	"fmt"
+
+	abci "github.com/tendermint/tendermint/abci/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	bank "github.com/cosmos/cosmos-sdk/x/bank/types"
+)
+
+// Authorization defines the interface of the authorizations a granter may
+// grant to a grantee, allowing the grantee to execute messages on behalf of
+// the granter.
+type Authorization interface {
+	// MsgType returns the type of the messages the authorization applies to,
+	// as returned by the MsgType function.
+	MsgType() string
+
+	// Accept determines whether the authorization permits the message to be
+	// executed at the given block. If so, it returns the updated authorization,
+	// if any, or whether the authorization is used up and should be deleted.
+	Accept(msg sdk.Msg, block abci.Header) (allow bool, updated Authorization, delete bool)
+
+	// ValidateBasic performs a stateless validation of the authorization.
+	ValidateBasic() sdk.Error
+}
+
+// MsgType returns the type of a message authorizations are granted for, made
+// of the route and type of the message, e.g. "bank/send".
+func MsgType(msg sdk.Msg) string {
+	return fmt.Sprintf("%s/%s", msg.Route(), msg.Type())
+}
+
+//-----------------------------------------------------------------------------
+// Generic Authorization
+
+var _ Authorization = GenericAuthorization{}
+
+// GenericAuthorization grants the permission to execute any message of a given
+// type, without any restriction.
+type GenericAuthorization struct {
+	Msg string `json:"msg"`
+}
+
+// NewGenericAuthorization creates a new GenericAuthorization for the given
+// message type.
+func NewGenericAuthorization(msgType string) GenericAuthorization {
+	return GenericAuthorization{Msg: msgType}
+}
+
+// MsgType implements Authorization.
+func (a GenericAuthorization) MsgType() string {
+	return a.Msg
+}
+
+// Accept implements Authorization.
+func (a GenericAuthorization) Accept(msg sdk.Msg, block abci.Header) (bool, Authorization, bool) {
+	return true, a, false
+}
+
+// ValidateBasic implements Authorization.
+func (a GenericAuthorization) ValidateBasic() sdk.Error {
+	if a.Msg == "" {
+		return ErrInvalidAuthorization(DefaultCodespace, "missing message type")
+	}
+	return nil
+}
+
+//-----------------------------------------------------------------------------
+// Send Authorization
+
+var _ Authorization = SendAuthorization{}
+
+// SendAuthorization grants the permission to send coins from the account of
+// the granter, up to a spend limit.
+type SendAuthorization struct {
+	// SpendLimit is the maximum amount of coins that can be sent. It is updated
+	// as coins are sent.
+	SpendLimit sdk.Coins `json:"spend_limit"`
+}
+
+// NewSendAuthorization creates a new SendAuthorization.
+func NewSendAuthorization(spendLimit sdk.Coins) SendAuthorization {
+	return SendAuthorization{SpendLimit: spendLimit}
+}
+
+// MsgType implements Authorization.
+func (a SendAuthorization) MsgType() string {
+	return MsgType(bank.MsgSend{})
+}
+
+// Accept implements Authorization. The authorization is deleted once its spend
+// limit is used up.
+func (a SendAuthorization) Accept(msg sdk.Msg, block abci.Header) (bool, Authorization, bool) {
+	switch msg := msg.(type) {
+	case bank.MsgSend:
+		limitLeft, isNegative := a.SpendLimit.SafeSub(msg.Amount)
+		if isNegative {
+			return false, nil, false
+		}
+		if limitLeft.IsZero() {
+			return true, nil, true
+		}
+
+		return true, SendAuthorization{SpendLimit: limitLeft}, false
+
+	default:
+		return false, nil, false
+	}
+}
+
+// ValidateBasic implements Authorization.
+func (a SendAuthorization) ValidateBasic() sdk.Error {
+	if !a.SpendLimit.IsValid() || a.SpendLimit.Empty() {
+		return sdk.ErrInvalidCoins("invalid spend limit: " + a.SpendLimit.String())
+	}
+	return nil
+}
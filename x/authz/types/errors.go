@@ -0,0 +1,37 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// Authz module codespace constants
+const (
+	DefaultCodespace sdk.CodespaceType = ModuleName
+
+	CodeNoAuthorization      sdk.CodeType = 1
+	CodeUnauthorized         sdk.CodeType = 2
+	CodeInvalidAuthorization sdk.CodeType = 3
+	CodeInvalidExpiration    sdk.CodeType = 4
+)
+
+// ErrNoAuthorization returns an error for when no authorization was granted
+// by the granter to the grantee for a message type.
+func ErrNoAuthorization(codespace sdk.CodespaceType, msgType string) sdk.Error {
+	return sdk.NewError(codespace, CodeNoAuthorization, "no authorization found for "+msgType)
+}
+
+// ErrUnauthorized returns an error for when a message is rejected by the
+// authorization granted for its type.
+func ErrUnauthorized(codespace sdk.CodespaceType, msg string) sdk.Error {
+	return sdk.NewError(codespace, CodeUnauthorized, "unauthorized: "+msg)
+}
+
+// ErrInvalidAuthorization returns an error for an invalid authorization.
+func ErrInvalidAuthorization(codespace sdk.CodespaceType, msg string) sdk.Error {
+	return sdk.NewError(codespace, CodeInvalidAuthorization, "invalid authorization: "+msg)
+}
+
+// ErrInvalidExpiration returns an error for an expiration time in the past.
+func ErrInvalidExpiration(codespace sdk.CodespaceType) sdk.Error {
+	return sdk.NewError(codespace, CodeInvalidExpiration, "expiration time of authorization is in the past")
+}
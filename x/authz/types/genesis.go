@@ -0,0 +1,26 @@
+package types
+
+// GenesisState contains the authorizations granted at genesis.
+type GenesisState struct {
+	Authorizations []AuthorizationGrant `json:"authorizations"`
+}
+
+// NewGenesisState creates a new genesis state.
+func NewGenesisState(authorizations []AuthorizationGrant) GenesisState {
+	return GenesisState{Authorizations: authorizations}
+}
+
+// DefaultGenesisState returns a default genesis state
+func DefaultGenesisState() GenesisState {
+	return NewGenesisState([]AuthorizationGrant{})
+}
+
+// ValidateBasic ensures all the grants in the genesis state are valid.
+func (data GenesisState) ValidateBasic() error {
+	for _, grant := range data.Authorizations {
+		if err := grant.ValidateBasic(); err != nil {
+			return err
+		}
+	}
+	return nil
+}
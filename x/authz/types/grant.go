@@ -0,0 +1,76 @@
+package types
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// AuthorizationGrant is stored in the KVStore to record an authorization
+// granted by a granter to a grantee, with full context.
+type AuthorizationGrant struct {
+	Granter       sdk.AccAddress `json:"granter"`
+	Grantee       sdk.AccAddress `json:"grantee"`
+	Authorization Authorization  `json:"authorization"`
+	Expiration    time.Time      `json:"expiration"`
+}
+
+// NewAuthorizationGrant creates a new AuthorizationGrant.
+func NewAuthorizationGrant(granter, grantee sdk.AccAddress, authorization Authorization,
+	expiration time.Time) AuthorizationGrant {
+
+	return AuthorizationGrant{
+		Granter:       granter,
+		Grantee:       grantee,
+		Authorization: authorization,
+		Expiration:    expiration,
+	}
+}
+
+// IsExpired returns whether the grant is expired at the given block time.
+func (g AuthorizationGrant) IsExpired(blockTime time.Time) bool {
+	return !blockTime.Before(g.Expiration)
+}
+
+// ValidateBasic performs basic validation on the grant.
+func (g AuthorizationGrant) ValidateBasic() sdk.Error {
+	if g.Granter.Empty() {
+		return sdk.ErrInvalidAddress("missing granter address")
+	}
+	if g.Grantee.Empty() {
+		return sdk.ErrInvalidAddress("missing grantee address")
+	}
+	if g.Granter.Equals(g.Grantee) {
+		return sdk.ErrInvalidAddress("granter and grantee cannot be the same")
+	}
+	if g.Authorization == nil {
+		return ErrInvalidAuthorization(DefaultCodespace, "missing authorization")
+	}
+	if g.Expiration.IsZero() {
+		return ErrInvalidAuthorization(DefaultCodespace, "missing expiration")
+	}
+	return g.Authorization.ValidateBasic()
+}
+
+// String implements the Stringer interface.
+func (g AuthorizationGrant) String() string {
+	return fmt.Sprintf(`Authorization Grant:
+  Granter:       %s
+  Grantee:       %s
+  Authorization: %v
+  Expiration:    %s`, g.Granter, g.Grantee, g.Authorization, g.Expiration)
+}
+
+// AuthorizationGrants is a collection of AuthorizationGrant
+type AuthorizationGrants []AuthorizationGrant
+
+// String implements the Stringer interface.
+func (gs AuthorizationGrants) String() string {
+	out := make([]string, len(gs))
+	for i, g := range gs {
+		out[i] = g.String()
+	}
+	return strings.Join(out, "\n")
+}
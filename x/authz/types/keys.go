@@ -0,0 +1,43 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+const (
+	// ModuleName is the name of this module
+	ModuleName = "authz"
+
+	// StoreKey is the store key string for the authz module
+	StoreKey = ModuleName
+
+	// RouterKey is the message route for the authz module
+	RouterKey = ModuleName
+
+	// QuerierRoute is the querier route for the authz module
+	QuerierRoute = ModuleName
+
+	// QueryAuthorizations is the query endpoint for the authorizations granted
+	// by a granter to a grantee
+	QueryAuthorizations = "authorizations"
+)
+
+// Keys for authz store
+// Items are stored with the following key: values
+//
+// - 0x00<grantee_Bytes><granter_Bytes><msgType_Bytes>: AuthorizationGrant
+var (
+	AuthorizationKeyPrefix = []byte{0x00}
+)
+
+// GetAuthorizationKey returns the key under which the authorization granted by
+// the granter to the grantee for the given message type is stored.
+func GetAuthorizationKey(granter, grantee sdk.AccAddress, msgType string) []byte {
+	return append(GetAuthorizationsKey(granter, grantee), []byte(msgType)...)
+}
+
+// GetAuthorizationsKey returns the prefix of the keys of all the authorizations
+// granted by the granter to the grantee.
+func GetAuthorizationsKey(granter, grantee sdk.AccAddress) []byte {
+	return append(append(AuthorizationKeyPrefix, grantee.Bytes()...), granter.Bytes()...)
+}
@@ -0,0 +1,161 @@
+package types
+
+import (
+	"encoding/json"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// ensure Msg interface compliance at compile time
+var (
+	_ sdk.Msg = MsgGrant{}
+	_ sdk.Msg = MsgRevoke{}
+	_ sdk.Msg = MsgExec{}
+)
+
+// MsgGrant grants to Grantee the Authorization to execute messages on behalf
+// of Granter until Expiration. Any authorization previously granted for the
+// same message type is replaced.
+type MsgGrant struct {
+	Granter       sdk.AccAddress `json:"granter"`
+	Grantee       sdk.AccAddress `json:"grantee"`
+	Authorization Authorization  `json:"authorization"`
+	Expiration    time.Time      `json:"expiration"`
+}
+
+// NewMsgGrant creates a new MsgGrant.
+func NewMsgGrant(granter, grantee sdk.AccAddress, authorization Authorization, expiration time.Time) MsgGrant {
+	return MsgGrant{
+		Granter:       granter,
+		Grantee:       grantee,
+		Authorization: authorization,
+		Expiration:    expiration,
+	}
+}
+
+// Route implements sdk.Msg
+func (msg MsgGrant) Route() string { return RouterKey }
+
+// Type implements sdk.Msg
+func (msg MsgGrant) Type() string { return "grant" }
+
+// ValidateBasic implements sdk.Msg
+func (msg MsgGrant) ValidateBasic() sdk.Error {
+	return NewAuthorizationGrant(msg.Granter, msg.Grantee, msg.Authorization, msg.Expiration).ValidateBasic()
+}
+
+// GetSignBytes implements sdk.Msg
+func (msg MsgGrant) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(msg))
+}
+
+// GetSigners implements sdk.Msg
+func (msg MsgGrant) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.Granter}
+}
+
+// MsgRevoke revokes the authorization granted by Granter to Grantee for the
+// given message type.
+type MsgRevoke struct {
+	Granter sdk.AccAddress `json:"granter"`
+	Grantee sdk.AccAddress `json:"grantee"`
+	MsgType string         `json:"msg_type"`
+}
+
+// NewMsgRevoke creates a new MsgRevoke.
+func NewMsgRevoke(granter, grantee sdk.AccAddress, msgType string) MsgRevoke {
+	return MsgRevoke{
+		Granter: granter,
+		Grantee: grantee,
+		MsgType: msgType,
+	}
+}
+
+// Route implements sdk.Msg
+func (msg MsgRevoke) Route() string { return RouterKey }
+
+// Type implements sdk.Msg
+func (msg MsgRevoke) Type() string { return "revoke" }
+
+// ValidateBasic implements sdk.Msg
+func (msg MsgRevoke) ValidateBasic() sdk.Error {
+	if msg.Granter.Empty() {
+		return sdk.ErrInvalidAddress("missing granter address")
+	}
+	if msg.Grantee.Empty() {
+		return sdk.ErrInvalidAddress("missing grantee address")
+	}
+	if msg.MsgType == "" {
+		return ErrInvalidAuthorization(DefaultCodespace, "missing message type")
+	}
+	return nil
+}
+
+// GetSignBytes implements sdk.Msg
+func (msg MsgRevoke) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(msg))
+}
+
+// GetSigners implements sdk.Msg
+func (msg MsgRevoke) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.Granter}
+}
+
+// MsgExec executes Msgs on behalf of their signer, provided that the signer
+// authorized Grantee to execute them.
+type MsgExec struct {
+	Grantee sdk.AccAddress `json:"grantee"`
+	Msgs    []sdk.Msg      `json:"msgs"`
+}
+
+// NewMsgExec creates a new MsgExec.
+func NewMsgExec(grantee sdk.AccAddress, msgs []sdk.Msg) MsgExec {
+	return MsgExec{
+		Grantee: grantee,
+		Msgs:    msgs,
+	}
+}
+
+// Route implements sdk.Msg
+func (msg MsgExec) Route() string { return RouterKey }
+
+// Type implements sdk.Msg
+func (msg MsgExec) Type() string { return "exec" }
+
+// ValidateBasic implements sdk.Msg
+func (msg MsgExec) ValidateBasic() sdk.Error {
+	if msg.Grantee.Empty() {
+		return sdk.ErrInvalidAddress("missing grantee address")
+	}
+	if len(msg.Msgs) == 0 {
+		return sdk.ErrUnknownRequest("no messages to execute")
+	}
+
+	for _, m := range msg.Msgs {
+		if len(m.GetSigners()) != 1 {
+			return ErrUnauthorized(DefaultCodespace, "authorization can be given to msg with only one signer")
+		}
+		if err := m.ValidateBasic(); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// GetSignBytes implements sdk.Msg. The executed messages are included through
+// their own sign bytes, as their concrete types are not registered on the
+// module codec.
+func (msg MsgExec) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(struct {
+		Grantee sdk.AccAddress    `json:"grantee"`
+		Msgs    []json.RawMessage `json:"msgs"`
+	}{msg.Grantee, sdk.MsgsSignBytes(msg.Msgs)})
+	return sdk.MustSortJSON(bz)
+}
+
+// GetSigners implements sdk.Msg
+func (msg MsgExec) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.Grantee}
+}
@@ -0,0 +1,17 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// QueryAuthorizationsParams defines the params for querying the authorizations
+// granted by a granter to a grantee.
+type QueryAuthorizationsParams struct {
+	Granter sdk.AccAddress `json:"granter"`
+	Grantee sdk.AccAddress `json:"grantee"`
+}
+
+// NewQueryAuthorizationsParams creates a new QueryAuthorizationsParams instance.
+func NewQueryAuthorizationsParams(granter, grantee sdk.AccAddress) QueryAuthorizationsParams {
+	return QueryAuthorizationsParams{Granter: granter, Grantee: grantee}
+}
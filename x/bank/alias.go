@@ -13,6 +13,9 @@ const (
 	CodeSendDisabled         = types.CodeSendDisabled
 	CodeInvalidInputsOutputs = types.CodeInvalidInputsOutputs
 	ModuleName               = types.ModuleName
+	StoreKey                 = types.StoreKey
+	QuerierRoute             = types.QuerierRoute
+	QueryBalances            = types.QueryBalances
 	RouterKey                = types.RouterKey
 	DefaultParamspace        = types.DefaultParamspace
 	DefaultSendEnabled       = types.DefaultSendEnabled
@@ -30,16 +33,29 @@ var (
 	NewInput               = types.NewInput
 	NewOutput              = types.NewOutput
 	ValidateInputsOutputs  = types.ValidateInputsOutputs
+	NewSendEnabled         = types.NewSendEnabled
 	ParamKeyTable          = types.ParamKeyTable
+	NewParams              = types.NewParams
+	DefaultParams          = types.DefaultParams
+	ValidateParams         = types.ValidateParams
+	AddressBalancesPrefix  = types.AddressBalancesPrefix
+	BalanceKey             = types.BalanceKey
+	AddressFromBalancesKey = types.AddressFromBalancesKey
+	NewQueryBalancesParams = types.NewQueryBalancesParams
 
 	// variable aliases
-	ModuleCdc                = types.ModuleCdc
-	ParamStoreKeySendEnabled = types.ParamStoreKeySendEnabled
+	ModuleCdc                       = types.ModuleCdc
+	ParamStoreKeySendEnabled        = types.ParamStoreKeySendEnabled
+	ParamStoreKeyDefaultSendEnabled = types.ParamStoreKeyDefaultSendEnabled
+	BalancesPrefix                  = types.BalancesPrefix
 )
 
 type (
-	MsgSend      = types.MsgSend
-	MsgMultiSend = types.MsgMultiSend
-	Input        = types.Input
-	Output       = types.Output
+	MsgSend             = types.MsgSend
+	MsgMultiSend        = types.MsgMultiSend
+	Input               = types.Input
+	Output              = types.Output
+	SendEnabled         = types.SendEnabled
+	Params              = types.Params
+	QueryBalancesParams = types.QueryBalancesParams
 )
@@ -109,10 +109,13 @@ func TestSendNotEnoughBalance(t *testing.T) {
 	mapp := getMockApp(t)
 	acc := &auth.BaseAccount{
 		Address: addr1,
-		Coins:   sdk.Coins{sdk.NewInt64Coin("foocoin", 67)},
 	}
 
-	mock.SetGenesis(mapp, []auth.Account{acc})
+	balances := []mock.Balance{
+		{Address: addr1, Coins: sdk.Coins{sdk.NewInt64Coin("foocoin", 67)}},
+	}
+
+	mock.SetGenesis(mapp, []auth.Account{acc}, balances)
 
 	ctxCheck := mapp.BaseApp.NewContext(true, abci.Header{})
 
@@ -140,10 +143,13 @@ func TestMsgMultiSendWithAccounts(t *testing.T) {
 	mapp := getMockApp(t)
 	acc := &auth.BaseAccount{
 		Address: addr1,
-		Coins:   sdk.Coins{sdk.NewInt64Coin("foocoin", 67)},
 	}
 
-	mock.SetGenesis(mapp, []auth.Account{acc})
+	balances := []mock.Balance{
+		{Address: addr1, Coins: sdk.Coins{sdk.NewInt64Coin("foocoin", 67)}},
+	}
+
+	mock.SetGenesis(mapp, []auth.Account{acc}, balances)
 
 	ctxCheck := mapp.BaseApp.NewContext(true, abci.Header{})
 
@@ -189,14 +195,17 @@ func TestMsgMultiSendMultipleOut(t *testing.T) {
 
 	acc1 := &auth.BaseAccount{
 		Address: addr1,
-		Coins:   sdk.Coins{sdk.NewInt64Coin("foocoin", 42)},
 	}
 	acc2 := &auth.BaseAccount{
 		Address: addr2,
-		Coins:   sdk.Coins{sdk.NewInt64Coin("foocoin", 42)},
 	}
 
-	mock.SetGenesis(mapp, []auth.Account{acc1, acc2})
+	balances := []mock.Balance{
+		{Address: addr1, Coins: sdk.Coins{sdk.NewInt64Coin("foocoin", 42)}},
+		{Address: addr2, Coins: sdk.Coins{sdk.NewInt64Coin("foocoin", 42)}},
+	}
+
+	mock.SetGenesis(mapp, []auth.Account{acc1, acc2}, balances)
 
 	testCases := []appTestCase{
 		{
@@ -229,18 +238,21 @@ func TestMsgMultiSendMultipleInOut(t *testing.T) {
 
 	acc1 := &auth.BaseAccount{
 		Address: addr1,
-		Coins:   sdk.Coins{sdk.NewInt64Coin("foocoin", 42)},
 	}
 	acc2 := &auth.BaseAccount{
 		Address: addr2,
-		Coins:   sdk.Coins{sdk.NewInt64Coin("foocoin", 42)},
 	}
 	acc4 := &auth.BaseAccount{
 		Address: addr4,
-		Coins:   sdk.Coins{sdk.NewInt64Coin("foocoin", 42)},
 	}
 
-	mock.SetGenesis(mapp, []auth.Account{acc1, acc2, acc4})
+	balances := []mock.Balance{
+		{Address: addr1, Coins: sdk.Coins{sdk.NewInt64Coin("foocoin", 42)}},
+		{Address: addr2, Coins: sdk.Coins{sdk.NewInt64Coin("foocoin", 42)}},
+		{Address: addr4, Coins: sdk.Coins{sdk.NewInt64Coin("foocoin", 42)}},
+	}
+
+	mock.SetGenesis(mapp, []auth.Account{acc1, acc2, acc4}, balances)
 
 	testCases := []appTestCase{
 		{
@@ -274,10 +286,13 @@ func TestMsgMultiSendDependent(t *testing.T) {
 
 	acc1 := &auth.BaseAccount{
 		Address: addr1,
-		Coins:   sdk.Coins{sdk.NewInt64Coin("foocoin", 42)},
 	}
 
-	mock.SetGenesis(mapp, []auth.Account{acc1})
+	balances := []mock.Balance{
+		{Address: addr1, Coins: sdk.Coins{sdk.NewInt64Coin("foocoin", 42)}},
+	}
+
+	mock.SetGenesis(mapp, []auth.Account{acc1}, balances)
 
 	testCases := []appTestCase{
 		{
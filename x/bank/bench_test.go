@@ -1,6 +1,7 @@
 package bank
 
 import (
+	"fmt"
 	"testing"
 
 	abci "github.com/tendermint/tendermint/abci/types"
@@ -18,10 +19,14 @@ func getBenchmarkMockApp() (*mock.App, error) {
 
 	types.RegisterCodec(mapp.Cdc)
 	bankKeeper := NewBaseKeeper(
+		mapp.Cdc,
+		mapp.KeyBank,
 		mapp.AccountKeeper,
 		mapp.ParamsKeeper.Subspace(types.DefaultParamspace),
 		types.DefaultCodespace,
+		make(map[string]bool),
 	)
+	mapp.BankKeeper = bankKeeper
 	mapp.Router().AddRoute(types.RouterKey, NewHandler(bankKeeper))
 	mapp.SetInitChainer(getInitChainer(mapp, bankKeeper))
 
@@ -35,13 +40,18 @@ func BenchmarkOneBankSendTxPerBlock(b *testing.B) {
 	// Add an account at genesis
 	acc := &auth.BaseAccount{
 		Address: addr1,
-		// Some value conceivably higher than the benchmarks would ever go
-		Coins: sdk.Coins{sdk.NewInt64Coin("foocoin", 100000000000)},
 	}
 	accs := []auth.Account{acc}
+	balances := []mock.Balance{
+		{
+			Address: addr1,
+			// Some value conceivably higher than the benchmarks would ever go
+			Coins: sdk.Coins{sdk.NewInt64Coin("foocoin", 100000000000)},
+		},
+	}
 
 	// Construct genesis state
-	mock.SetGenesis(benchmarkApp, accs)
+	mock.SetGenesis(benchmarkApp, accs, balances)
 	// Precompute all txs
 	txs := mock.GenSequenceOfTxs([]sdk.Msg{sendMsg1}, []uint64{0}, []uint64{uint64(0)}, b.N, priv1)
 	b.ResetTimer()
@@ -65,13 +75,18 @@ func BenchmarkOneBankMultiSendTxPerBlock(b *testing.B) {
 	// Add an account at genesis
 	acc := &auth.BaseAccount{
 		Address: addr1,
-		// Some value conceivably higher than the benchmarks would ever go
-		Coins: sdk.Coins{sdk.NewInt64Coin("foocoin", 100000000000)},
 	}
 	accs := []auth.Account{acc}
+	balances := []mock.Balance{
+		{
+			Address: addr1,
+			// Some value conceivably higher than the benchmarks would ever go
+			Coins: sdk.Coins{sdk.NewInt64Coin("foocoin", 100000000000)},
+		},
+	}
 
 	// Construct genesis state
-	mock.SetGenesis(benchmarkApp, accs)
+	mock.SetGenesis(benchmarkApp, accs, balances)
 	// Precompute all txs
 	txs := mock.GenSequenceOfTxs([]sdk.Msg{multiSendMsg1}, []uint64{0}, []uint64{uint64(0)}, b.N, priv1)
 	b.ResetTimer()
@@ -88,3 +103,65 @@ func BenchmarkOneBankMultiSendTxPerBlock(b *testing.B) {
 		benchmarkApp.Commit()
 	}
 }
+
+// benchmarkManyDenomsInput returns a bank keeper and an account holding the
+// given number of denominations.
+func benchmarkManyDenomsInput(numDenoms int) (testInput, BaseKeeper, sdk.AccAddress) {
+	input := setupTestInput()
+	bankKeeper := NewBaseKeeper(input.cdc, input.key, input.ak, input.pk.Subspace(types.DefaultParamspace), types.DefaultCodespace, make(map[string]bool))
+	bankKeeper.SetParams(input.ctx, types.DefaultParams())
+
+	coins := make(sdk.Coins, numDenoms)
+	for i := 0; i < numDenoms; i++ {
+		coins[i] = sdk.NewInt64Coin(fmt.Sprintf("coin%05d", i), 100000000000)
+	}
+
+	addr := sdk.AccAddress([]byte("addr1"))
+	input.ak.SetAccount(input.ctx, input.ak.NewAccountWithAddress(input.ctx, addr))
+	bankKeeper.SetCoins(input.ctx, addr, coins)
+
+	return input, bankKeeper, addr
+}
+
+func BenchmarkSendCoinsManyDenoms(b *testing.B) {
+	for _, numDenoms := range []int{1, 100, 1000} {
+		b.Run(fmt.Sprintf("denoms=%d", numDenoms), func(b *testing.B) {
+			input, bankKeeper, addr := benchmarkManyDenomsInput(numDenoms)
+			amt := sdk.NewCoins(sdk.NewInt64Coin("coin00000", 1))
+			addr2 := sdk.AccAddress([]byte("addr2"))
+
+			b.ResetTimer()
+			for i := 0; i < b.N; i++ {
+				if err := bankKeeper.SendCoins(input.ctx, addr, addr2, amt); err != nil {
+					b.Fatal(err)
+				}
+			}
+		})
+	}
+}
+
+func BenchmarkGetBalanceManyDenoms(b *testing.B) {
+	for _, numDenoms := range []int{1, 100, 1000} {
+		b.Run(fmt.Sprintf("denoms=%d", numDenoms), func(b *testing.B) {
+			input, bankKeeper, addr := benchmarkManyDenomsInput(numDenoms)
+
+			b.ResetTimer()
+			for i := 0; i < b.N; i++ {
+				bankKeeper.GetBalance(input.ctx, addr, "coin00000")
+			}
+		})
+	}
+}
+
+func BenchmarkGetCoinsManyDenoms(b *testing.B) {
+	for _, numDenoms := range []int{1, 100, 1000} {
+		b.Run(fmt.Sprintf("denoms=%d", numDenoms), func(b *testing.B) {
+			input, bankKeeper, addr := benchmarkManyDenomsInput(numDenoms)
+
+			b.ResetTimer()
+			for i := 0; i < b.N; i++ {
+				bankKeeper.GetCoins(input.ctx, addr)
+			}
+		})
+	}
+}
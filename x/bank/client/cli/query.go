@@ -0,0 +1,78 @@
+package cli
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/context"
+	"github.com/cosmos/cosmos-sdk/client/utils"
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/version"
+	"github.com/cosmos/cosmos-sdk/x/bank/types"
+)
+
+// GetQueryCmd returns the cli query commands for this module
+func GetQueryCmd(cdc *codec.Codec) *cobra.Command {
+	// Group bank queries under a subcommand
+	bankQueryCmd := &cobra.Command{
+		Use:                        types.ModuleName,
+		Short:                      "Querying commands for the bank module",
+		DisableFlagParsing:         true,
+		SuggestionsMinimumDistance: 2,
+		RunE:                       utils.ValidateCmd,
+	}
+
+	bankQueryCmd.AddCommand(client.GetCommands(
+		GetCmdQueryBalances(cdc),
+	)...)
+
+	return bankQueryCmd
+}
+
+// GetCmdQueryBalances implements the query balances command.
+func GetCmdQueryBalances(cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "balances [address]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Query the balances held by an account",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query all the coin balances held by an account.
+
+Example:
+$ %s query %s balances cosmos1gghjut3ccd8ay0zduzj64hwre2fxs9ld75ru9p
+`,
+				version.ClientName, types.ModuleName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			addr, err := sdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+
+			bz, err := cdc.MarshalJSON(types.NewQueryBalancesParams(addr))
+			if err != nil {
+				return err
+			}
+
+			route := fmt.Sprintf("custom/%s/%s", types.QuerierRoute, types.QueryBalances)
+			res, err := cliCtx.QueryWithData(route, bz)
+			if err != nil {
+				return err
+			}
+
+			var balances sdk.Coins
+			if err := cdc.UnmarshalJSON(res, &balances); err != nil {
+				return err
+			}
+
+			return cliCtx.PrintOutput(balances)
+		},
+	}
+}
@@ -1,32 +1,63 @@
 package bank
 
 import (
+	"encoding/json"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/bank/types"
 )
 
 // GenesisState is the bank state that must be provided at genesis.
 type GenesisState struct {
-	SendEnabled bool `json:"send_enabled"`
+	Params Params `json:"params"`
+}
+
+// legacyGenesisState is the bank genesis state prior to the per denomination
+// send enabled parameters, which only held a global send_enabled flag.
+type legacyGenesisState struct {
+	SendEnabled *bool   `json:"send_enabled"`
+	Params      *Params `json:"params"`
 }
 
 // NewGenesisState creates a new genesis state.
-func NewGenesisState(sendEnabled bool) GenesisState {
-	return GenesisState{SendEnabled: sendEnabled}
+func NewGenesisState(params Params) GenesisState {
+	return GenesisState{Params: params}
 }
 
 // DefaultGenesisState returns a default genesis state
-func DefaultGenesisState() GenesisState { return NewGenesisState(true) }
+func DefaultGenesisState() GenesisState { return NewGenesisState(DefaultParams()) }
 
 // InitGenesis sets distribution information for genesis.
 func InitGenesis(ctx sdk.Context, keeper Keeper, data GenesisState) {
-	keeper.SetSendEnabled(ctx, data.SendEnabled)
+	keeper.SetParams(ctx, data.Params)
 }
 
 // ExportGenesis returns a GenesisState for a given context and keeper.
 func ExportGenesis(ctx sdk.Context, keeper Keeper) GenesisState {
-	return NewGenesisState(keeper.GetSendEnabled(ctx))
+	return NewGenesisState(keeper.GetParams(ctx))
 }
 
 // ValidateGenesis performs basic validation of bank genesis data returning an
 // error for any failed validation criteria.
-func ValidateGenesis(data GenesisState) error { return nil }
+func ValidateGenesis(data GenesisState) error {
+	return ValidateParams(data.Params)
+}
+
+// unmarshalGenesisState decodes a bank genesis state. A genesis state in the
+// legacy format is migrated by using its send_enabled flag as the default for
+// all denominations.
+func unmarshalGenesisState(bz json.RawMessage) (GenesisState, error) {
+	var data legacyGenesisState
+	if err := types.ModuleCdc.UnmarshalJSON(bz, &data); err != nil {
+		return GenesisState{}, err
+	}
+
+	switch {
+	case data.Params != nil:
+		return NewGenesisState(*data.Params), nil
+	case data.SendEnabled != nil:
+		return NewGenesisState(NewParams(*data.SendEnabled, nil)), nil
+	default:
+		return NewGenesisState(Params{}), nil
+	}
+}
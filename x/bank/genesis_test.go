@@ -0,0 +1,46 @@
+package bank
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestUnmarshalGenesisState(t *testing.T) {
+	tests := []struct {
+		name     string
+		genesis  string
+		expected GenesisState
+	}{
+		{"legacy send enabled", `{"send_enabled":true}`, NewGenesisState(NewParams(true, nil))},
+		{"legacy send disabled", `{"send_enabled":false}`, NewGenesisState(NewParams(false, nil))},
+		{
+			"params",
+			`{"params":{"send_enabled":[{"denom":"foocoin","enabled":false}],"default_send_enabled":true}}`,
+			NewGenesisState(NewParams(true, []SendEnabled{NewSendEnabled("foocoin", false)})),
+		},
+		{"empty", `{}`, NewGenesisState(Params{})},
+	}
+
+	for _, tc := range tests {
+		data, err := unmarshalGenesisState([]byte(tc.genesis))
+		require.NoError(t, err, tc.name)
+		require.Equal(t, tc.expected, data, tc.name)
+	}
+
+	_, err := unmarshalGenesisState([]byte(`{"send_enabled":"yes"}`))
+	require.Error(t, err)
+}
+
+func TestLegacySendEnabledParam(t *testing.T) {
+	input := setupTestInput()
+	ctx := input.ctx
+	paramSpace := input.pk.Subspace(DefaultParamspace)
+	keeper := NewBaseKeeper(input.cdc, input.key, input.ak, paramSpace, DefaultCodespace, make(map[string]bool))
+	keeper.SetParams(ctx, DefaultParams())
+
+	// the former global flag keeps its key and type
+	enabled := false
+	paramSpace.Set(ctx, []byte("sendenabled"), &enabled)
+	require.Equal(t, NewParams(false, nil), keeper.GetParams(ctx))
+}
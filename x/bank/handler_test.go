@@ -16,3 +16,31 @@ func TestInvalidMsg(t *testing.T) {
 	require.False(t, res.IsOK())
 	require.True(t, strings.Contains(res.Log, "unrecognized bank message type"))
 }
+
+func TestHandleMsgSendDisabled(t *testing.T) {
+	input := setupTestInput()
+	ctx := input.ctx
+	keeper := NewBaseKeeper(input.cdc, input.key, input.ak, input.pk.Subspace(DefaultParamspace), DefaultCodespace, make(map[string]bool))
+	keeper.SetParams(ctx, NewParams(true, []SendEnabled{NewSendEnabled("foocoin", false)}))
+	h := NewHandler(keeper)
+
+	addr := sdk.AccAddress([]byte("addr1"))
+	addr2 := sdk.AccAddress([]byte("addr2"))
+	fooCoins := sdk.NewCoins(sdk.NewInt64Coin("foocoin", 10))
+	barCoins := sdk.NewCoins(sdk.NewInt64Coin("barcoin", 10))
+	keeper.SetCoins(ctx, addr, fooCoins.Add(barCoins))
+
+	res := h(ctx, NewMsgSend(addr, addr2, fooCoins))
+	require.Equal(t, CodeSendDisabled, res.Code)
+
+	res = h(ctx, NewMsgSend(addr, addr2, barCoins))
+	require.True(t, res.IsOK())
+
+	res = h(ctx, MsgMultiSend{
+		Inputs:  []Input{NewInput(addr, fooCoins)},
+		Outputs: []Output{NewOutput(addr2, fooCoins)},
+	})
+	require.Equal(t, CodeSendDisabled, res.Code)
+	require.True(t, keeper.GetCoins(ctx, addr).IsEqual(fooCoins))
+	require.True(t, keeper.GetCoins(ctx, addr2).IsEqual(barCoins))
+}
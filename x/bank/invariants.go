@@ -5,45 +5,43 @@ import (
 	"fmt"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
-	"github.com/cosmos/cosmos-sdk/x/auth"
 	"github.com/cosmos/cosmos-sdk/x/bank/types"
 )
 
 // register bank invariants
-func RegisterInvariants(ir sdk.InvariantRouter, ak auth.AccountKeeper) {
+func RegisterInvariants(ir sdk.InvariantRouter, k ViewKeeper) {
 	ir.RegisterRoute(types.ModuleName, "nonnegative-outstanding",
-		NonnegativeBalanceInvariant(ak))
+		NonnegativeBalanceInvariant(k))
 }
 
 // NonnegativeBalanceInvariant checks that all accounts in the application have non-negative balances
-func NonnegativeBalanceInvariant(ak auth.AccountKeeper) sdk.Invariant {
+func NonnegativeBalanceInvariant(k ViewKeeper) sdk.Invariant {
 	return func(ctx sdk.Context) error {
-		accts := ak.GetAllAccounts(ctx)
-		for _, acc := range accts {
-			coins := acc.GetCoins()
-			if coins.IsAnyNegative() {
-				return fmt.Errorf("%s has a negative denomination of %s",
-					acc.GetAddress().String(),
-					coins.String())
+		var err error
+		k.IterateAllBalances(ctx, func(addr sdk.AccAddress, balance sdk.Coin) bool {
+			if balance.IsNegative() {
+				err = fmt.Errorf("%s has a negative denomination of %s",
+					addr.String(),
+					balance.String())
+				return true
 			}
-		}
-		return nil
+			return false
+		})
+		return err
 	}
 }
 
 // TotalCoinsInvariant checks that the sum of the coins across all accounts
 // is what is expected
-func TotalCoinsInvariant(ak auth.AccountKeeper, totalSupplyFn func() sdk.Coins) sdk.Invariant {
+func TotalCoinsInvariant(k ViewKeeper, totalSupplyFn func() sdk.Coins) sdk.Invariant {
 	return func(ctx sdk.Context) error {
 		totalCoins := sdk.NewCoins()
 
-		chkAccount := func(acc auth.Account) bool {
-			coins := acc.GetCoins()
-			totalCoins = totalCoins.Add(coins)
+		k.IterateAllBalances(ctx, func(_ sdk.AccAddress, balance sdk.Coin) bool {
+			totalCoins = totalCoins.Add(sdk.NewCoins(balance))
 			return false
-		}
+		})
 
-		ak.IterateAccounts(ctx, chkAccount)
 		if !totalSupplyFn().IsEqual(totalCoins) {
 			return errors.New("total calculated coins doesn't equal expected coins")
 		}
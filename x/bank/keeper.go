@@ -2,11 +2,10 @@ package bank
 
 import (
 	"fmt"
-	"time"
 
+	"github.com/cosmos/cosmos-sdk/codec"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/x/auth"
-	"github.com/cosmos/cosmos-sdk/x/bank/tags"
 	"github.com/cosmos/cosmos-sdk/x/bank/types"
 	"github.com/cosmos/cosmos-sdk/x/params"
 )
@@ -21,10 +20,10 @@ type Keeper interface {
 	SetCoins(ctx sdk.Context, addr sdk.AccAddress, amt sdk.Coins) sdk.Error
 	SubtractCoins(ctx sdk.Context, addr sdk.AccAddress, amt sdk.Coins) (sdk.Coins, sdk.Error)
 	AddCoins(ctx sdk.Context, addr sdk.AccAddress, amt sdk.Coins) (sdk.Coins, sdk.Error)
-	InputOutputCoins(ctx sdk.Context, inputs []types.Input, outputs []types.Output) (sdk.Tags, sdk.Error)
+	InputOutputCoins(ctx sdk.Context, inputs []types.Input, outputs []types.Output) sdk.Error
 
-	DelegateCoins(ctx sdk.Context, addr sdk.AccAddress, amt sdk.Coins) (sdk.Tags, sdk.Error)
-	UndelegateCoins(ctx sdk.Context, addr sdk.AccAddress, amt sdk.Coins) (sdk.Tags, sdk.Error)
+	DelegateCoins(ctx sdk.Context, delegatorAddr, moduleAccAddr sdk.AccAddress, amt sdk.Coins) sdk.Error
+	UndelegateCoins(ctx sdk.Context, moduleAccAddr, delegatorAddr sdk.AccAddress, amt sdk.Coins) sdk.Error
 }
 
 // BaseKeeper manages transfers between accounts. It implements the Keeper interface.
@@ -35,14 +34,15 @@ type BaseKeeper struct {
 	paramSpace params.Subspace
 }
 
-// NewBaseKeeper returns a new BaseKeeper
-func NewBaseKeeper(ak auth.AccountKeeper,
+// NewBaseKeeper returns a new BaseKeeper. Coins can't be sent through a MsgSend
+// or MsgMultiSend to any of the blacklisted addresses.
+func NewBaseKeeper(cdc *codec.Codec, storeKey sdk.StoreKey, ak auth.AccountKeeper,
 	paramSpace params.Subspace,
-	codespace sdk.CodespaceType) BaseKeeper {
+	codespace sdk.CodespaceType, blacklistedAddrs map[string]bool) BaseKeeper {
 
 	ps := paramSpace.WithKeyTable(types.ParamKeyTable())
 	return BaseKeeper{
-		BaseSendKeeper: NewBaseSendKeeper(ak, ps, codespace),
+		BaseSendKeeper: NewBaseSendKeeper(cdc, storeKey, ak, ps, codespace, blacklistedAddrs),
 		ak:             ak,
 		paramSpace:     ps,
 	}
@@ -56,7 +56,7 @@ func (keeper BaseKeeper) SetCoins(
 	if !amt.IsValid() {
 		return sdk.ErrInvalidCoins(amt.String())
 	}
-	return setCoins(ctx, keeper.ak, addr, amt)
+	return keeper.setCoins(ctx, addr, amt)
 }
 
 // SubtractCoins subtracts amt from the coins at the addr.
@@ -67,7 +67,7 @@ func (keeper BaseKeeper) SubtractCoins(
 	if !amt.IsValid() {
 		return nil, sdk.ErrInvalidCoins(amt.String())
 	}
-	return subtractCoins(ctx, keeper.ak, addr, amt)
+	return keeper.subtractCoins(ctx, addr, amt)
 }
 
 // AddCoins adds amt to the coins at the addr.
@@ -78,42 +78,101 @@ func (keeper BaseKeeper) AddCoins(
 	if !amt.IsValid() {
 		return nil, sdk.ErrInvalidCoins(amt.String())
 	}
-	return addCoins(ctx, keeper.ak, addr, amt)
+	return keeper.addCoins(ctx, addr, amt)
 }
 
 // InputOutputCoins handles a list of inputs and outputs
 func (keeper BaseKeeper) InputOutputCoins(
 	ctx sdk.Context, inputs []types.Input, outputs []types.Output,
-) (sdk.Tags, sdk.Error) {
+) sdk.Error {
+
+	for _, in := range inputs {
+		if err := keeper.IsSendEnabledCoins(ctx, in.Coins...); err != nil {
+			return err
+		}
+	}
 
-	return inputOutputCoins(ctx, keeper.ak, inputs, outputs)
+	return keeper.inputOutputCoins(ctx, inputs, outputs)
 }
 
 // DelegateCoins performs delegation by deducting amt coins from an account with
-// address addr. For vesting accounts, delegations amounts are tracked for both
+// address delegatorAddr and crediting them to the module account at
+// moduleAccAddr. For vesting accounts, delegations amounts are tracked for both
 // vesting and vested coins.
 func (keeper BaseKeeper) DelegateCoins(
-	ctx sdk.Context, addr sdk.AccAddress, amt sdk.Coins,
-) (sdk.Tags, sdk.Error) {
+	ctx sdk.Context, delegatorAddr, moduleAccAddr sdk.AccAddress, amt sdk.Coins,
+) sdk.Error {
 
 	if !amt.IsValid() {
-		return nil, sdk.ErrInvalidCoins(amt.String())
+		return sdk.ErrInvalidCoins(amt.String())
+	}
+
+	acc := keeper.ak.GetAccount(ctx, delegatorAddr)
+	if acc == nil {
+		return sdk.ErrUnknownAddress(fmt.Sprintf("account %s does not exist", delegatorAddr))
+	}
+
+	moduleAcc := keeper.ak.GetAccount(ctx, moduleAccAddr)
+	if moduleAcc == nil {
+		return sdk.ErrUnknownAddress(fmt.Sprintf("module account %s does not exist", moduleAccAddr))
+	}
+
+	// vesting coins can be delegated, so the check is made against the whole
+	// balance rather than the spendable coins
+	balance := keeper.GetCoins(ctx, delegatorAddr)
+	if _, hasNeg := balance.SafeSub(amt); hasNeg {
+		return sdk.ErrInsufficientCoins(
+			fmt.Sprintf("insufficient account funds; %s < %s", balance, amt),
+		)
 	}
-	return delegateCoins(ctx, keeper.ak, addr, amt)
+
+	if vacc, ok := acc.(auth.VestingAccount); ok {
+		vacc.TrackDelegation(ctx.BlockHeader().Time, balance, amt)
+		keeper.ak.SetAccount(ctx, acc)
+	}
+
+	for _, coin := range amt {
+		keeper.setBalance(ctx, delegatorAddr, keeper.GetBalance(ctx, delegatorAddr, coin.Denom).Sub(coin))
+	}
+
+	_, err := keeper.addCoins(ctx, moduleAccAddr, amt)
+	return err
 }
 
-// UndelegateCoins performs undelegation by crediting amt coins to an account with
-// address addr. For vesting accounts, undelegation amounts are tracked for both
+// UndelegateCoins performs undelegation by deducting amt coins from the module
+// account at moduleAccAddr and crediting them to an account with address
+// delegatorAddr. For vesting accounts, undelegation amounts are tracked for both
 // vesting and vested coins.
 // If any of the undelegation amounts are negative, an error is returned.
 func (keeper BaseKeeper) UndelegateCoins(
-	ctx sdk.Context, addr sdk.AccAddress, amt sdk.Coins,
-) (sdk.Tags, sdk.Error) {
+	ctx sdk.Context, moduleAccAddr, delegatorAddr sdk.AccAddress, amt sdk.Coins,
+) sdk.Error {
 
 	if !amt.IsValid() {
-		return nil, sdk.ErrInvalidCoins(amt.String())
+		return sdk.ErrInvalidCoins(amt.String())
+	}
+
+	moduleAcc := keeper.ak.GetAccount(ctx, moduleAccAddr)
+	if moduleAcc == nil {
+		return sdk.ErrUnknownAddress(fmt.Sprintf("module account %s does not exist", moduleAccAddr))
+	}
+
+	if _, err := keeper.subtractCoins(ctx, moduleAccAddr, amt); err != nil {
+		return err
+	}
+
+	acc := keeper.ak.GetAccount(ctx, delegatorAddr)
+	if acc == nil {
+		return sdk.ErrUnknownAddress(fmt.Sprintf("account %s does not exist", delegatorAddr))
+	}
+
+	if vacc, ok := acc.(auth.VestingAccount); ok {
+		vacc.TrackUndelegation(amt)
+		keeper.ak.SetAccount(ctx, acc)
 	}
-	return undelegateCoins(ctx, keeper.ak, addr, amt)
+
+	_, err := keeper.addCoins(ctx, delegatorAddr, amt)
+	return err
 }
 
 // SendKeeper defines a module interface that facilitates the transfer of coins
@@ -123,8 +182,12 @@ type SendKeeper interface {
 
 	SendCoins(ctx sdk.Context, fromAddr sdk.AccAddress, toAddr sdk.AccAddress, amt sdk.Coins) sdk.Error
 
-	GetSendEnabled(ctx sdk.Context) bool
-	SetSendEnabled(ctx sdk.Context, enabled bool)
+	GetParams(ctx sdk.Context) types.Params
+	SetParams(ctx sdk.Context, params types.Params)
+	IsSendEnabledCoin(ctx sdk.Context, coin sdk.Coin) bool
+	IsSendEnabledCoins(ctx sdk.Context, coins ...sdk.Coin) sdk.Error
+
+	BlacklistedAddr(addr sdk.AccAddress) bool
 }
 
 var _ SendKeeper = (*BaseSendKeeper)(nil)
@@ -136,16 +199,20 @@ type BaseSendKeeper struct {
 
 	ak         auth.AccountKeeper
 	paramSpace params.Subspace
+
+	// list of addresses that are restricted from receiving transactions
+	blacklistedAddrs map[string]bool
 }
 
 // NewBaseSendKeeper returns a new BaseSendKeeper.
-func NewBaseSendKeeper(ak auth.AccountKeeper,
-	paramSpace params.Subspace, codespace sdk.CodespaceType) BaseSendKeeper {
+func NewBaseSendKeeper(cdc *codec.Codec, storeKey sdk.StoreKey, ak auth.AccountKeeper,
+	paramSpace params.Subspace, codespace sdk.CodespaceType, blacklistedAddrs map[string]bool) BaseSendKeeper {
 
 	return BaseSendKeeper{
-		BaseViewKeeper: NewBaseViewKeeper(ak, codespace),
-		ak:             ak,
-		paramSpace:     paramSpace,
+		BaseViewKeeper:   NewBaseViewKeeper(cdc, storeKey, ak, codespace),
+		ak:               ak,
+		paramSpace:       paramSpace,
+		blacklistedAddrs: blacklistedAddrs,
 	}
 }
 
@@ -157,283 +224,344 @@ func (keeper BaseSendKeeper) SendCoins(
 	if !amt.IsValid() {
 		return sdk.ErrInvalidCoins(amt.String())
 	}
-	return sendCoins(ctx, keeper.ak, fromAddr, toAddr, amt)
-}
-
-// GetSendEnabled returns the current SendEnabled
-// nolint: errcheck
-func (keeper BaseSendKeeper) GetSendEnabled(ctx sdk.Context) bool {
-	var enabled bool
-	keeper.paramSpace.Get(ctx, types.ParamStoreKeySendEnabled, &enabled)
-	return enabled
-}
 
-// SetSendEnabled sets the send enabled
-func (keeper BaseSendKeeper) SetSendEnabled(ctx sdk.Context, enabled bool) {
-	keeper.paramSpace.Set(ctx, types.ParamStoreKeySendEnabled, &enabled)
-}
+	_, err := keeper.subtractCoins(ctx, fromAddr, amt)
+	if err != nil {
+		return err
+	}
 
-var _ ViewKeeper = (*BaseViewKeeper)(nil)
+	_, err = keeper.addCoins(ctx, toAddr, amt)
+	if err != nil {
+		return err
+	}
 
-// ViewKeeper defines a module interface that facilitates read only access to
-// account balances.
-type ViewKeeper interface {
-	GetCoins(ctx sdk.Context, addr sdk.AccAddress) sdk.Coins
-	HasCoins(ctx sdk.Context, addr sdk.AccAddress, amt sdk.Coins) bool
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeTransfer,
+			sdk.NewAttribute(types.AttributeKeyRecipient, toAddr.String()),
+			sdk.NewAttribute(sdk.AttributeKeyAmount, amt.String()),
+		),
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(types.AttributeKeySender, fromAddr.String()),
+		),
+	})
 
-	Codespace() sdk.CodespaceType
+	return nil
 }
 
-// BaseViewKeeper implements a read only keeper implementation of ViewKeeper.
-type BaseViewKeeper struct {
-	ak        auth.AccountKeeper
-	codespace sdk.CodespaceType
+// GetParams returns the total set of bank parameters.
+func (keeper BaseSendKeeper) GetParams(ctx sdk.Context) (params types.Params) {
+	keeper.paramSpace.GetParamSet(ctx, &params)
+	return params
 }
 
-// NewBaseViewKeeper returns a new BaseViewKeeper.
-func NewBaseViewKeeper(ak auth.AccountKeeper, codespace sdk.CodespaceType) BaseViewKeeper {
-	return BaseViewKeeper{ak: ak, codespace: codespace}
+// SetParams sets the total set of bank parameters.
+func (keeper BaseSendKeeper) SetParams(ctx sdk.Context, params types.Params) {
+	keeper.paramSpace.SetParamSet(ctx, &params)
 }
 
-// GetCoins returns the coins at the addr.
-func (keeper BaseViewKeeper) GetCoins(ctx sdk.Context, addr sdk.AccAddress) sdk.Coins {
-	return getCoins(ctx, keeper.ak, addr)
+// IsSendEnabledCoin returns whether coins of the given coin's denomination can
+// be transferred.
+func (keeper BaseSendKeeper) IsSendEnabledCoin(ctx sdk.Context, coin sdk.Coin) bool {
+	return keeper.GetParams(ctx).SendEnabledDenom(coin.Denom)
 }
 
-// HasCoins returns whether or not an account has at least amt coins.
-func (keeper BaseViewKeeper) HasCoins(ctx sdk.Context, addr sdk.AccAddress, amt sdk.Coins) bool {
-	return hasCoins(ctx, keeper.ak, addr, amt)
+// IsSendEnabledCoins returns an error if any of the given coins can't be
+// transferred.
+func (keeper BaseSendKeeper) IsSendEnabledCoins(ctx sdk.Context, coins ...sdk.Coin) sdk.Error {
+	params := keeper.GetParams(ctx)
+	for _, coin := range coins {
+		if !params.SendEnabledDenom(coin.Denom) {
+			return types.ErrSendDisabled(keeper.codespace, coin.Denom)
+		}
+	}
+	return nil
 }
 
-// Codespace returns the keeper's codespace.
-func (keeper BaseViewKeeper) Codespace() sdk.CodespaceType {
-	return keeper.codespace
+// BlacklistedAddr checks if a given address is blacklisted (i.e restricted from
+// receiving funds)
+func (keeper BaseSendKeeper) BlacklistedAddr(addr sdk.AccAddress) bool {
+	return keeper.blacklistedAddrs[addr.String()]
 }
 
-func getCoins(ctx sdk.Context, am auth.AccountKeeper, addr sdk.AccAddress) sdk.Coins {
-	acc := am.GetAccount(ctx, addr)
-	if acc == nil {
-		return sdk.NewCoins()
+// setBalance sets the balance of a single denomination held by an account. Zero
+// balances are removed from the store.
+func (keeper BaseSendKeeper) setBalance(ctx sdk.Context, addr sdk.AccAddress, balance sdk.Coin) {
+	store := ctx.KVStore(keeper.storeKey)
+	key := types.BalanceKey(addr, balance.Denom)
+
+	if balance.IsZero() {
+		store.Delete(key)
+		return
 	}
-	return acc.GetCoins()
+
+	store.Set(key, keeper.cdc.MustMarshalBinaryBare(balance))
 }
 
-func setCoins(ctx sdk.Context, am auth.AccountKeeper, addr sdk.AccAddress, amt sdk.Coins) sdk.Error {
+// setCoins replaces all the balances held by an account with amt. The account
+// is created if it doesn't exist yet.
+func (keeper BaseSendKeeper) setCoins(ctx sdk.Context, addr sdk.AccAddress, amt sdk.Coins) sdk.Error {
 	if !amt.IsValid() {
 		return sdk.ErrInvalidCoins(amt.String())
 	}
-	acc := am.GetAccount(ctx, addr)
-	if acc == nil {
-		acc = am.NewAccountWithAddress(ctx, addr)
-	}
-	err := acc.SetCoins(amt)
-	if err != nil {
-		// Handle w/ #870
-		panic(err)
-	}
-	am.SetAccount(ctx, acc)
-	return nil
-}
 
-// HasCoins returns whether or not an account has at least amt coins.
-func hasCoins(ctx sdk.Context, am auth.AccountKeeper, addr sdk.AccAddress, amt sdk.Coins) bool {
-	return getCoins(ctx, am, addr).IsAllGTE(amt)
-}
+	keeper.ensureAccount(ctx, addr)
 
-func getAccount(ctx sdk.Context, ak auth.AccountKeeper, addr sdk.AccAddress) auth.Account {
-	return ak.GetAccount(ctx, addr)
-}
+	for _, coin := range keeper.GetCoins(ctx, addr) {
+		keeper.setBalance(ctx, addr, sdk.NewCoin(coin.Denom, sdk.ZeroInt()))
+	}
 
-func setAccount(ctx sdk.Context, ak auth.AccountKeeper, acc auth.Account) {
-	ak.SetAccount(ctx, acc)
+	for _, coin := range amt {
+		keeper.setBalance(ctx, addr, coin)
+	}
+
+	return nil
 }
 
-// subtractCoins subtracts amt coins from an account with the given address addr.
+// subtractCoins subtracts amt coins from an account with the given address
+// addr and returns the resulting non-zero balances of the denominations of amt.
 //
 // CONTRACT: If the account is a vesting account, the amount has to be spendable.
-func subtractCoins(ctx sdk.Context, ak auth.AccountKeeper, addr sdk.AccAddress, amt sdk.Coins) (sdk.Coins, sdk.Error) {
-
+func (keeper BaseSendKeeper) subtractCoins(ctx sdk.Context, addr sdk.AccAddress, amt sdk.Coins) (sdk.Coins, sdk.Error) {
 	if !amt.IsValid() {
 		return nil, sdk.ErrInvalidCoins(amt.String())
 	}
 
-	oldCoins, spendableCoins := sdk.NewCoins(), sdk.NewCoins()
-
-	acc := getAccount(ctx, ak, addr)
-	if acc != nil {
-		oldCoins = acc.GetCoins()
-		spendableCoins = acc.SpendableCoins(ctx.BlockHeader().Time)
-	}
-
-	// For non-vesting accounts, spendable coins will simply be the original coins.
-	// So the check here is sufficient instead of subtracting from oldCoins.
-	_, hasNeg := spendableCoins.SafeSub(amt)
-	if hasNeg {
-		return amt, sdk.ErrInsufficientCoins(
-			fmt.Sprintf("insufficient account funds; %s < %s", spendableCoins, amt),
-		)
-	}
-
-	newCoins := oldCoins.Sub(amt) // should not panic as spendable coins was already checked
-	err := setCoins(ctx, ak, addr, newCoins)
+	// the locked coins are only needed, and the account only fetched, when the
+	// balances could cover amt
+	var (
+		locked        sdk.Coins
+		lockedFetched bool
+	)
+
+	balances := make([]sdk.Coin, len(amt))
+	for i, coin := range amt {
+		balance := keeper.GetBalance(ctx, addr, coin.Denom)
+		if balance.IsLT(coin) {
+			return amt, sdk.ErrInsufficientCoins(
+				fmt.Sprintf("insufficient account funds; %s < %s", balance, coin),
+			)
+		}
 
-	return newCoins, err
-}
+		if !lockedFetched {
+			locked = keeper.lockedCoins(ctx, addr)
+			lockedFetched = true
+		}
 
-// AddCoins adds amt to the coins at the addr.
-func addCoins(ctx sdk.Context, am auth.AccountKeeper, addr sdk.AccAddress, amt sdk.Coins) (sdk.Coins, sdk.Error) {
+		// For non-vesting accounts, spendable coins will simply be the balance.
+		spendable := sdk.NewCoin(coin.Denom, sdk.MaxInt(balance.Amount.Sub(locked.AmountOf(coin.Denom)), sdk.ZeroInt()))
+		if spendable.IsLT(coin) {
+			return amt, sdk.ErrInsufficientCoins(
+				fmt.Sprintf("insufficient account funds; %s < %s", spendable, coin),
+			)
+		}
 
-	if !amt.IsValid() {
-		return nil, sdk.ErrInvalidCoins(amt.String())
+		balances[i] = balance.Sub(coin)
 	}
 
-	oldCoins := getCoins(ctx, am, addr)
-	newCoins := oldCoins.Add(amt)
-
-	if newCoins.IsAnyNegative() {
-		return amt, sdk.ErrInsufficientCoins(
-			fmt.Sprintf("insufficient account funds; %s < %s", oldCoins, amt),
-		)
+	for _, balance := range balances {
+		keeper.setBalance(ctx, addr, balance)
 	}
 
-	err := setCoins(ctx, am, addr, newCoins)
-
-	return newCoins, err
+	return sdk.NewCoins(balances...), nil
 }
 
-// SendCoins moves coins from one account to another
-// Returns ErrInvalidCoins if amt is invalid.
-func sendCoins(ctx sdk.Context, am auth.AccountKeeper, fromAddr sdk.AccAddress, toAddr sdk.AccAddress, amt sdk.Coins) sdk.Error {
-	// Safety check ensuring that when sending coins the keeper must maintain the
+// addCoins adds amt to the coins at the addr and returns the resulting
+// balances of the denominations of amt. The account is created if it doesn't
+// exist yet.
+func (keeper BaseSendKeeper) addCoins(ctx sdk.Context, addr sdk.AccAddress, amt sdk.Coins) (sdk.Coins, sdk.Error) {
 	if !amt.IsValid() {
-		return sdk.ErrInvalidCoins(amt.String())
+		return nil, sdk.ErrInvalidCoins(amt.String())
 	}
 
-	_, err := subtractCoins(ctx, am, fromAddr, amt)
-	if err != nil {
-		return err
-	}
+	keeper.ensureAccount(ctx, addr)
 
-	_, err = addCoins(ctx, am, toAddr, amt)
-	if err != nil {
-		return err
+	balances := make(sdk.Coins, len(amt))
+	for i, coin := range amt {
+		balances[i] = keeper.GetBalance(ctx, addr, coin.Denom).Add(coin)
+		keeper.setBalance(ctx, addr, balances[i])
 	}
 
-	return nil
+	return balances, nil
 }
 
 // InputOutputCoins handles a list of inputs and outputs
 // NOTE: Make sure to revert state changes from tx on error
-func inputOutputCoins(ctx sdk.Context, am auth.AccountKeeper, inputs []types.Input, outputs []types.Output) (sdk.Tags, sdk.Error) {
+func (keeper BaseSendKeeper) inputOutputCoins(ctx sdk.Context, inputs []types.Input, outputs []types.Output) sdk.Error {
 	// Safety check ensuring that when sending coins the keeper must maintain the
 	// Check supply invariant and validity of Coins.
 	if err := types.ValidateInputsOutputs(inputs, outputs); err != nil {
-		return nil, err
+		return err
 	}
 
-	allTags := sdk.EmptyTags()
-
 	for _, in := range inputs {
-		_, err := subtractCoins(ctx, am, in.Address, in.Coins)
+		_, err := keeper.subtractCoins(ctx, in.Address, in.Coins)
 		if err != nil {
-			return nil, err
+			return err
 		}
 
-		allTags = allTags.AppendTag(
-			tags.Sender, in.Address.String(),
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				sdk.EventTypeMessage,
+				sdk.NewAttribute(types.AttributeKeySender, in.Address.String()),
+			),
 		)
 	}
 
 	for _, out := range outputs {
-		_, err := addCoins(ctx, am, out.Address, out.Coins)
+		_, err := keeper.addCoins(ctx, out.Address, out.Coins)
 		if err != nil {
-			return nil, err
+			return err
 		}
-		allTags = allTags.AppendTag(
-			tags.Recipient, out.Address.String(),
+
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				types.EventTypeTransfer,
+				sdk.NewAttribute(types.AttributeKeyRecipient, out.Address.String()),
+				sdk.NewAttribute(sdk.AttributeKeyAmount, out.Coins.String()),
+			),
 		)
 	}
 
-	return allTags, nil
+	return nil
 }
 
-func delegateCoins(
-	ctx sdk.Context, ak auth.AccountKeeper, addr sdk.AccAddress, amt sdk.Coins,
-) (sdk.Tags, sdk.Error) {
-
-	if !amt.IsValid() {
-		return nil, sdk.ErrInvalidCoins(amt.String())
+// ensureAccount creates an account for addr if it doesn't exist yet.
+func (keeper BaseSendKeeper) ensureAccount(ctx sdk.Context, addr sdk.AccAddress) {
+	if keeper.ak.GetAccount(ctx, addr) == nil {
+		keeper.ak.SetAccount(ctx, keeper.ak.NewAccountWithAddress(ctx, addr))
 	}
+}
 
-	acc := getAccount(ctx, ak, addr)
-	if acc == nil {
-		return nil, sdk.ErrUnknownAddress(fmt.Sprintf("account %s does not exist", addr))
-	}
+var _ ViewKeeper = (*BaseViewKeeper)(nil)
 
-	oldCoins := acc.GetCoins()
+// ViewKeeper defines a module interface that facilitates read only access to
+// account balances.
+type ViewKeeper interface {
+	GetCoins(ctx sdk.Context, addr sdk.AccAddress) sdk.Coins
+	GetBalance(ctx sdk.Context, addr sdk.AccAddress, denom string) sdk.Coin
+	HasCoins(ctx sdk.Context, addr sdk.AccAddress, amt sdk.Coins) bool
+	SpendableCoins(ctx sdk.Context, addr sdk.AccAddress) sdk.Coins
 
-	_, hasNeg := oldCoins.SafeSub(amt)
-	if hasNeg {
-		return nil, sdk.ErrInsufficientCoins(
-			fmt.Sprintf("insufficient account funds; %s < %s", oldCoins, amt),
-		)
-	}
+	IterateAccountBalances(ctx sdk.Context, addr sdk.AccAddress, cb func(coin sdk.Coin) (stop bool))
+	IterateAllBalances(ctx sdk.Context, cb func(addr sdk.AccAddress, coin sdk.Coin) (stop bool))
 
-	if err := trackDelegation(acc, ctx.BlockHeader().Time, amt); err != nil {
-		return nil, sdk.ErrInternal(fmt.Sprintf("failed to track delegation: %v", err))
-	}
+	Codespace() sdk.CodespaceType
+}
 
-	setAccount(ctx, ak, acc)
+// BaseViewKeeper implements a read only keeper implementation of ViewKeeper.
+type BaseViewKeeper struct {
+	cdc       *codec.Codec
+	storeKey  sdk.StoreKey
+	ak        auth.AccountKeeper
+	codespace sdk.CodespaceType
+}
 
-	return sdk.NewTags(
-		sdk.TagAction, tags.ActionDelegateCoins,
-		sdk.TagDelegator, addr.String(),
-	), nil
+// NewBaseViewKeeper returns a new BaseViewKeeper.
+func NewBaseViewKeeper(cdc *codec.Codec, storeKey sdk.StoreKey, ak auth.AccountKeeper, codespace sdk.CodespaceType) BaseViewKeeper {
+	return BaseViewKeeper{cdc: cdc, storeKey: storeKey, ak: ak, codespace: codespace}
 }
 
-func undelegateCoins(
-	ctx sdk.Context, ak auth.AccountKeeper, addr sdk.AccAddress, amt sdk.Coins,
-) (sdk.Tags, sdk.Error) {
+// GetCoins returns all the coins held by the addr, sorted by denomination.
+func (keeper BaseViewKeeper) GetCoins(ctx sdk.Context, addr sdk.AccAddress) sdk.Coins {
+	coins := sdk.NewCoins()
+	keeper.IterateAccountBalances(ctx, addr, func(coin sdk.Coin) bool {
+		coins = append(coins, coin)
+		return false
+	})
 
-	if !amt.IsValid() {
-		return nil, sdk.ErrInvalidCoins(amt.String())
-	}
+	return coins
+}
 
-	acc := getAccount(ctx, ak, addr)
-	if acc == nil {
-		return nil, sdk.ErrUnknownAddress(fmt.Sprintf("account %s does not exist", addr))
+// GetBalance returns the balance of a single denomination held by the addr. A
+// zero coin is returned if the account holds none.
+func (keeper BaseViewKeeper) GetBalance(ctx sdk.Context, addr sdk.AccAddress, denom string) sdk.Coin {
+	store := ctx.KVStore(keeper.storeKey)
+	bz := store.Get(types.BalanceKey(addr, denom))
+	if bz == nil {
+		return sdk.NewCoin(denom, sdk.ZeroInt())
 	}
 
-	if err := trackUndelegation(acc, amt); err != nil {
-		return nil, sdk.ErrInternal(fmt.Sprintf("failed to track undelegation: %v", err))
+	var balance sdk.Coin
+	keeper.cdc.MustUnmarshalBinaryBare(bz, &balance)
+	return balance
+}
+
+// HasCoins returns whether or not an account has at least amt coins.
+func (keeper BaseViewKeeper) HasCoins(ctx sdk.Context, addr sdk.AccAddress, amt sdk.Coins) bool {
+	return keeper.GetCoins(ctx, addr).IsAllGTE(amt)
+}
+
+// SpendableCoins returns the coins the addr can currently spend. For vesting
+// accounts, the coins that are still locked are excluded from the balance.
+func (keeper BaseViewKeeper) SpendableCoins(ctx sdk.Context, addr sdk.AccAddress) sdk.Coins {
+	balance := keeper.GetCoins(ctx, addr)
+
+	locked := keeper.lockedCoins(ctx, addr)
+	if locked.IsZero() {
+		return balance
 	}
 
-	setAccount(ctx, ak, acc)
+	var spendable sdk.Coins
+	for _, coin := range balance {
+		// compute max(B - L, 0) per denomination
+		amt := sdk.MaxInt(coin.Amount.Sub(locked.AmountOf(coin.Denom)), sdk.ZeroInt())
+		if amt.IsPositive() {
+			spendable = append(spendable, sdk.NewCoin(coin.Denom, amt))
+		}
+	}
 
-	return sdk.NewTags(
-		sdk.TagAction, tags.ActionUndelegateCoins,
-		sdk.TagDelegator, addr.String(),
-	), nil
+	return spendable
 }
 
-// CONTRACT: assumes that amt is valid.
-func trackDelegation(acc auth.Account, blockTime time.Time, amt sdk.Coins) error {
-	vacc, ok := acc.(auth.VestingAccount)
-	if ok {
-		vacc.TrackDelegation(blockTime, amt)
+// lockedCoins returns the coins of the addr that are still locked if it is a
+// vesting account, and no coins otherwise.
+func (keeper BaseViewKeeper) lockedCoins(ctx sdk.Context, addr sdk.AccAddress) sdk.Coins {
+	vacc, ok := keeper.ak.GetAccount(ctx, addr).(auth.VestingAccount)
+	if !ok {
 		return nil
 	}
 
-	return acc.SetCoins(acc.GetCoins().Sub(amt))
+	return vacc.LockedCoins(ctx.BlockHeader().Time)
 }
 
-// CONTRACT: assumes that amt is valid.
-func trackUndelegation(acc auth.Account, amt sdk.Coins) error {
-	vacc, ok := acc.(auth.VestingAccount)
-	if ok {
-		vacc.TrackUndelegation(amt)
-		return nil
+// IterateAccountBalances iterates over all the balances held by an account, in
+// denomination order, and calls cb on each of them. The iteration stops when
+// cb returns true.
+func (keeper BaseViewKeeper) IterateAccountBalances(ctx sdk.Context, addr sdk.AccAddress, cb func(coin sdk.Coin) (stop bool)) {
+	store := ctx.KVStore(keeper.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, types.AddressBalancesPrefix(addr))
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var balance sdk.Coin
+		keeper.cdc.MustUnmarshalBinaryBare(iterator.Value(), &balance)
+
+		if cb(balance) {
+			break
+		}
 	}
+}
+
+// IterateAllBalances iterates over the balances held by all accounts and calls
+// cb on each of them. The iteration stops when cb returns true.
+func (keeper BaseViewKeeper) IterateAllBalances(ctx sdk.Context, cb func(addr sdk.AccAddress, coin sdk.Coin) (stop bool)) {
+	store := ctx.KVStore(keeper.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, types.BalancesPrefix)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var balance sdk.Coin
+		keeper.cdc.MustUnmarshalBinaryBare(iterator.Value(), &balance)
+
+		if cb(types.AddressFromBalancesKey(iterator.Key()), balance) {
+			break
+		}
+	}
+}
 
-	return acc.SetCoins(acc.GetCoins().Add(amt))
+// Codespace returns the keeper's codespace.
+func (keeper BaseViewKeeper) Codespace() sdk.CodespaceType {
+	return keeper.codespace
 }
@@ -6,6 +6,7 @@ import (
 
 	"github.com/stretchr/testify/require"
 	abci "github.com/tendermint/tendermint/abci/types"
+	"github.com/tendermint/tendermint/crypto"
 	dbm "github.com/tendermint/tendermint/libs/db"
 	"github.com/tendermint/tendermint/libs/log"
 	tmtime "github.com/tendermint/tendermint/types/time"
@@ -21,6 +22,7 @@ import (
 type testInput struct {
 	cdc *codec.Codec
 	ctx sdk.Context
+	key sdk.StoreKey
 	ak  auth.AccountKeeper
 	pk  params.Keeper
 }
@@ -32,13 +34,13 @@ func setupTestInput() testInput {
 	auth.RegisterBaseAccount(cdc)
 
 	authCapKey := sdk.NewKVStoreKey("authCapKey")
-	fckCapKey := sdk.NewKVStoreKey("fckCapKey")
+	keyBank := sdk.NewKVStoreKey(types.StoreKey)
 	keyParams := sdk.NewKVStoreKey("params")
 	tkeyParams := sdk.NewTransientStoreKey("transient_params")
 
 	ms := store.NewCommitMultiStore(db)
 	ms.MountStoreWithDB(authCapKey, sdk.StoreTypeIAVL, db)
-	ms.MountStoreWithDB(fckCapKey, sdk.StoreTypeIAVL, db)
+	ms.MountStoreWithDB(keyBank, sdk.StoreTypeIAVL, db)
 	ms.MountStoreWithDB(keyParams, sdk.StoreTypeIAVL, db)
 	ms.MountStoreWithDB(tkeyParams, sdk.StoreTypeTransient, db)
 	ms.LoadLatestVersion()
@@ -51,14 +53,14 @@ func setupTestInput() testInput {
 
 	ak.SetParams(ctx, auth.DefaultParams())
 
-	return testInput{cdc: cdc, ctx: ctx, ak: ak, pk: pk}
+	return testInput{cdc: cdc, ctx: ctx, key: keyBank, ak: ak, pk: pk}
 }
 
 func TestKeeper(t *testing.T) {
 	input := setupTestInput()
 	ctx := input.ctx
-	bankKeeper := NewBaseKeeper(input.ak, input.pk.Subspace(types.DefaultParamspace), types.DefaultCodespace)
-	bankKeeper.SetSendEnabled(ctx, true)
+	bankKeeper := NewBaseKeeper(input.cdc, input.key, input.ak, input.pk.Subspace(types.DefaultParamspace), types.DefaultCodespace, make(map[string]bool))
+	bankKeeper.SetParams(ctx, types.DefaultParams())
 
 	addr := sdk.AccAddress([]byte("addr1"))
 	addr2 := sdk.AccAddress([]byte("addr2"))
@@ -85,8 +87,11 @@ func TestKeeper(t *testing.T) {
 	bankKeeper.AddCoins(ctx, addr, sdk.NewCoins(sdk.NewInt64Coin("barcoin", 15)))
 	require.True(t, bankKeeper.GetCoins(ctx, addr).IsEqual(sdk.NewCoins(sdk.NewInt64Coin("barcoin", 15), sdk.NewInt64Coin("foocoin", 25))))
 
-	// Test SubtractCoins
-	bankKeeper.SubtractCoins(ctx, addr, sdk.NewCoins(sdk.NewInt64Coin("foocoin", 10)))
+	// Test SubtractCoins, only the balances of the subtracted denominations are
+	// returned
+	balances, err := bankKeeper.SubtractCoins(ctx, addr, sdk.NewCoins(sdk.NewInt64Coin("foocoin", 10)))
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("foocoin", 15)), balances)
 	bankKeeper.SubtractCoins(ctx, addr, sdk.NewCoins(sdk.NewInt64Coin("barcoin", 5)))
 	require.True(t, bankKeeper.GetCoins(ctx, addr).IsEqual(sdk.NewCoins(sdk.NewInt64Coin("barcoin", 10), sdk.NewInt64Coin("foocoin", 15))))
 
@@ -138,9 +143,9 @@ func TestSendKeeper(t *testing.T) {
 	input := setupTestInput()
 	ctx := input.ctx
 	paramSpace := input.pk.Subspace(types.DefaultParamspace)
-	bankKeeper := NewBaseKeeper(input.ak, paramSpace, types.DefaultCodespace)
-	sendKeeper := NewBaseSendKeeper(input.ak, paramSpace, DefaultCodespace)
-	bankKeeper.SetSendEnabled(ctx, true)
+	bankKeeper := NewBaseKeeper(input.cdc, input.key, input.ak, paramSpace, types.DefaultCodespace, make(map[string]bool))
+	sendKeeper := NewBaseSendKeeper(input.cdc, input.key, input.ak, paramSpace, DefaultCodespace, make(map[string]bool))
+	bankKeeper.SetParams(ctx, types.DefaultParams())
 
 	addr := sdk.AccAddress([]byte("addr1"))
 	addr2 := sdk.AccAddress([]byte("addr2"))
@@ -183,13 +188,58 @@ func TestSendKeeper(t *testing.T) {
 	require.Error(t, err)
 }
 
+func TestSendEnabled(t *testing.T) {
+	input := setupTestInput()
+	ctx := input.ctx
+	bankKeeper := NewBaseKeeper(input.cdc, input.key, input.ak, input.pk.Subspace(types.DefaultParamspace), types.DefaultCodespace, make(map[string]bool))
+	bankKeeper.SetParams(ctx, types.DefaultParams())
+
+	fooCoin := sdk.NewInt64Coin("foocoin", 10)
+	barCoin := sdk.NewInt64Coin("barcoin", 10)
+	require.True(t, bankKeeper.IsSendEnabledCoin(ctx, fooCoin))
+	require.Nil(t, bankKeeper.IsSendEnabledCoins(ctx, fooCoin, barCoin))
+
+	// disable transfers of a single denomination
+	bankKeeper.SetParams(ctx, bankKeeper.GetParams(ctx).SetSendEnabledParam(fooCoin.Denom, false))
+	require.False(t, bankKeeper.IsSendEnabledCoin(ctx, fooCoin))
+	require.True(t, bankKeeper.IsSendEnabledCoin(ctx, barCoin))
+
+	err := bankKeeper.IsSendEnabledCoins(ctx, barCoin, fooCoin)
+	require.NotNil(t, err)
+	require.Equal(t, types.CodeSendDisabled, err.Code())
+
+	addr := sdk.AccAddress([]byte("addr1"))
+	addr2 := sdk.AccAddress([]byte("addr2"))
+	bankKeeper.SetCoins(ctx, addr, sdk.NewCoins(fooCoin, barCoin))
+
+	err = bankKeeper.InputOutputCoins(ctx,
+		[]types.Input{types.NewInput(addr, sdk.NewCoins(fooCoin))},
+		[]types.Output{types.NewOutput(addr2, sdk.NewCoins(fooCoin))},
+	)
+	require.NotNil(t, err)
+	require.Equal(t, types.CodeSendDisabled, err.Code())
+
+	err = bankKeeper.InputOutputCoins(ctx,
+		[]types.Input{types.NewInput(addr, sdk.NewCoins(barCoin))},
+		[]types.Output{types.NewOutput(addr2, sdk.NewCoins(barCoin))},
+	)
+	require.Nil(t, err)
+	require.True(t, bankKeeper.GetCoins(ctx, addr).IsEqual(sdk.NewCoins(fooCoin)))
+	require.True(t, bankKeeper.GetCoins(ctx, addr2).IsEqual(sdk.NewCoins(barCoin)))
+
+	// disable transfers of all denominations without an entry
+	bankKeeper.SetParams(ctx, types.NewParams(false, []types.SendEnabled{types.NewSendEnabled(fooCoin.Denom, true)}))
+	require.True(t, bankKeeper.IsSendEnabledCoin(ctx, fooCoin))
+	require.False(t, bankKeeper.IsSendEnabledCoin(ctx, barCoin))
+}
+
 func TestViewKeeper(t *testing.T) {
 	input := setupTestInput()
 	ctx := input.ctx
 	paramSpace := input.pk.Subspace(DefaultParamspace)
-	bankKeeper := NewBaseKeeper(input.ak, paramSpace, DefaultCodespace)
-	bankKeeper.SetSendEnabled(ctx, true)
-	viewKeeper := NewBaseViewKeeper(input.ak, DefaultCodespace)
+	bankKeeper := NewBaseKeeper(input.cdc, input.key, input.ak, paramSpace, DefaultCodespace, make(map[string]bool))
+	bankKeeper.SetParams(ctx, types.DefaultParams())
+	viewKeeper := NewBaseViewKeeper(input.cdc, input.key, input.ak, DefaultCodespace)
 
 	addr := sdk.AccAddress([]byte("addr1"))
 	acc := input.ak.NewAccountWithAddress(ctx, addr)
@@ -206,6 +256,44 @@ func TestViewKeeper(t *testing.T) {
 	require.True(t, viewKeeper.HasCoins(ctx, addr, sdk.NewCoins(sdk.NewInt64Coin("foocoin", 5))))
 	require.False(t, viewKeeper.HasCoins(ctx, addr, sdk.NewCoins(sdk.NewInt64Coin("foocoin", 15))))
 	require.False(t, viewKeeper.HasCoins(ctx, addr, sdk.NewCoins(sdk.NewInt64Coin("barcoin", 5))))
+
+	// Test GetBalance
+	require.Equal(t, sdk.NewInt64Coin("foocoin", 10), viewKeeper.GetBalance(ctx, addr, "foocoin"))
+	require.Equal(t, sdk.NewInt64Coin("barcoin", 0), viewKeeper.GetBalance(ctx, addr, "barcoin"))
+}
+
+func TestIterateBalances(t *testing.T) {
+	input := setupTestInput()
+	ctx := input.ctx
+	bankKeeper := NewBaseKeeper(input.cdc, input.key, input.ak, input.pk.Subspace(DefaultParamspace), DefaultCodespace, make(map[string]bool))
+
+	addr1 := sdk.AccAddress(crypto.AddressHash([]byte("addr1")))
+	addr2 := sdk.AccAddress(crypto.AddressHash([]byte("addr2")))
+	coins1 := sdk.NewCoins(sdk.NewInt64Coin("barcoin", 5), sdk.NewInt64Coin("foocoin", 10))
+	coins2 := sdk.NewCoins(sdk.NewInt64Coin("foocoin", 20))
+	bankKeeper.SetCoins(ctx, addr1, coins1)
+	bankKeeper.SetCoins(ctx, addr2, coins2)
+
+	// require the balances of a single account to be iterated by denomination
+	var balances sdk.Coins
+	bankKeeper.IterateAccountBalances(ctx, addr1, func(coin sdk.Coin) bool {
+		balances = append(balances, coin)
+		return false
+	})
+	require.Equal(t, coins1, balances)
+
+	// require the balances of all accounts to be iterated
+	total := sdk.NewCoins()
+	bankKeeper.IterateAllBalances(ctx, func(addr sdk.AccAddress, coin sdk.Coin) bool {
+		total = total.Add(sdk.NewCoins(coin))
+		return false
+	})
+	require.Equal(t, coins1.Add(coins2), total)
+
+	// require zero balances to be removed from the store
+	bankKeeper.SetCoins(ctx, addr1, sdk.NewCoins(sdk.NewInt64Coin("foocoin", 10)))
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("foocoin", 10)), bankKeeper.GetCoins(ctx, addr1))
+	require.False(t, input.ctx.KVStore(input.key).Has(types.BalanceKey(addr1, "barcoin")))
 }
 
 func TestVestingAccountSend(t *testing.T) {
@@ -216,30 +304,28 @@ func TestVestingAccountSend(t *testing.T) {
 
 	origCoins := sdk.NewCoins(sdk.NewInt64Coin("stake", 100))
 	sendCoins := sdk.NewCoins(sdk.NewInt64Coin("stake", 50))
-	bankKeeper := NewBaseKeeper(input.ak, input.pk.Subspace(DefaultParamspace), DefaultCodespace)
-	bankKeeper.SetSendEnabled(ctx, true)
+	bankKeeper := NewBaseKeeper(input.cdc, input.key, input.ak, input.pk.Subspace(DefaultParamspace), DefaultCodespace, make(map[string]bool))
+	bankKeeper.SetParams(ctx, types.DefaultParams())
 
 	addr1 := sdk.AccAddress([]byte("addr1"))
 	addr2 := sdk.AccAddress([]byte("addr2"))
 	bacc := auth.NewBaseAccountWithAddress(addr1)
-	bacc.SetCoins(origCoins)
-	vacc := auth.NewContinuousVestingAccount(&bacc, ctx.BlockHeader().Time.Unix(), endTime.Unix())
+	vacc := auth.NewContinuousVestingAccount(&bacc, origCoins, ctx.BlockHeader().Time.Unix(), endTime.Unix())
 	input.ak.SetAccount(ctx, vacc)
+	bankKeeper.SetCoins(ctx, addr1, origCoins)
 
 	// require that no coins be sendable at the beginning of the vesting schedule
 	err := bankKeeper.SendCoins(ctx, addr1, addr2, sendCoins)
 	require.Error(t, err)
 
 	// receive some coins
-	vacc.SetCoins(origCoins.Add(sendCoins))
-	input.ak.SetAccount(ctx, vacc)
+	bankKeeper.SetCoins(ctx, addr1, origCoins.Add(sendCoins))
 
 	// require that all vested coins are spendable plus any received
 	ctx = ctx.WithBlockTime(now.Add(12 * time.Hour))
 	err = bankKeeper.SendCoins(ctx, addr1, addr2, sendCoins)
-	vacc = input.ak.GetAccount(ctx, addr1).(*auth.ContinuousVestingAccount)
 	require.NoError(t, err)
-	require.Equal(t, origCoins, vacc.GetCoins())
+	require.Equal(t, origCoins, bankKeeper.GetCoins(ctx, addr1))
 }
 
 func TestVestingAccountReceive(t *testing.T) {
@@ -250,30 +336,30 @@ func TestVestingAccountReceive(t *testing.T) {
 
 	origCoins := sdk.NewCoins(sdk.NewInt64Coin("stake", 100))
 	sendCoins := sdk.NewCoins(sdk.NewInt64Coin("stake", 50))
-	bankKeeper := NewBaseKeeper(input.ak, input.pk.Subspace(DefaultParamspace), DefaultCodespace)
-	bankKeeper.SetSendEnabled(ctx, true)
+	bankKeeper := NewBaseKeeper(input.cdc, input.key, input.ak, input.pk.Subspace(DefaultParamspace), DefaultCodespace, make(map[string]bool))
+	bankKeeper.SetParams(ctx, types.DefaultParams())
 
 	addr1 := sdk.AccAddress([]byte("addr1"))
 	addr2 := sdk.AccAddress([]byte("addr2"))
 
 	bacc := auth.NewBaseAccountWithAddress(addr1)
-	bacc.SetCoins(origCoins)
-	vacc := auth.NewContinuousVestingAccount(&bacc, ctx.BlockHeader().Time.Unix(), endTime.Unix())
+	vacc := auth.NewContinuousVestingAccount(&bacc, origCoins, ctx.BlockHeader().Time.Unix(), endTime.Unix())
 	acc := input.ak.NewAccountWithAddress(ctx, addr2)
 	input.ak.SetAccount(ctx, vacc)
 	input.ak.SetAccount(ctx, acc)
+	bankKeeper.SetCoins(ctx, addr1, origCoins)
 	bankKeeper.SetCoins(ctx, addr2, origCoins)
 
 	// send some coins to the vesting account
 	bankKeeper.SendCoins(ctx, addr2, addr1, sendCoins)
 
 	// require the coins are spendable
-	vacc = input.ak.GetAccount(ctx, addr1).(*auth.ContinuousVestingAccount)
-	require.Equal(t, origCoins.Add(sendCoins), vacc.GetCoins())
-	require.Equal(t, vacc.SpendableCoins(now), sendCoins)
+	require.Equal(t, origCoins.Add(sendCoins), bankKeeper.GetCoins(ctx, addr1))
+	require.Equal(t, sendCoins, bankKeeper.SpendableCoins(ctx, addr1))
 
 	// require coins are spendable plus any that have vested
-	require.Equal(t, vacc.SpendableCoins(now.Add(12*time.Hour)), origCoins)
+	ctx = ctx.WithBlockTime(now.Add(12 * time.Hour))
+	require.Equal(t, origCoins, bankKeeper.SpendableCoins(ctx, addr1))
 }
 
 func TestDelegateCoins(t *testing.T) {
@@ -284,33 +370,39 @@ func TestDelegateCoins(t *testing.T) {
 
 	origCoins := sdk.NewCoins(sdk.NewInt64Coin("stake", 100))
 	delCoins := sdk.NewCoins(sdk.NewInt64Coin("stake", 50))
-	bankKeeper := NewBaseKeeper(input.ak, input.pk.Subspace(DefaultParamspace), DefaultCodespace)
-	bankKeeper.SetSendEnabled(ctx, true)
+	bankKeeper := NewBaseKeeper(input.cdc, input.key, input.ak, input.pk.Subspace(DefaultParamspace), DefaultCodespace, make(map[string]bool))
+	bankKeeper.SetParams(ctx, types.DefaultParams())
 
 	addr1 := sdk.AccAddress([]byte("addr1"))
 	addr2 := sdk.AccAddress([]byte("addr2"))
+	addrModule := sdk.AccAddress([]byte("moduleAcc"))
 
 	bacc := auth.NewBaseAccountWithAddress(addr1)
-	bacc.SetCoins(origCoins)
-	vacc := auth.NewContinuousVestingAccount(&bacc, ctx.BlockHeader().Time.Unix(), endTime.Unix())
+	vacc := auth.NewContinuousVestingAccount(&bacc, origCoins, ctx.BlockHeader().Time.Unix(), endTime.Unix())
 	acc := input.ak.NewAccountWithAddress(ctx, addr2)
 	input.ak.SetAccount(ctx, vacc)
+	macc := input.ak.NewAccountWithAddress(ctx, addrModule) // only the address of the module account is needed
 	input.ak.SetAccount(ctx, acc)
+	input.ak.SetAccount(ctx, macc)
+	bankKeeper.SetCoins(ctx, addr1, origCoins)
 	bankKeeper.SetCoins(ctx, addr2, origCoins)
 
 	ctx = ctx.WithBlockTime(now.Add(12 * time.Hour))
 
 	// require the ability for a non-vesting account to delegate
-	_, err := bankKeeper.DelegateCoins(ctx, addr2, delCoins)
-	acc = input.ak.GetAccount(ctx, addr2)
+	err := bankKeeper.DelegateCoins(ctx, addr2, addrModule, delCoins)
 	require.NoError(t, err)
-	require.Equal(t, delCoins, acc.GetCoins())
+	require.Equal(t, delCoins, bankKeeper.GetCoins(ctx, addr2))
 
 	// require the ability for a vesting account to delegate
-	_, err = bankKeeper.DelegateCoins(ctx, addr1, delCoins)
+	err = bankKeeper.DelegateCoins(ctx, addr1, addrModule, delCoins)
 	vacc = input.ak.GetAccount(ctx, addr1).(*auth.ContinuousVestingAccount)
 	require.NoError(t, err)
-	require.Equal(t, delCoins, vacc.GetCoins())
+	require.Equal(t, delCoins, bankKeeper.GetCoins(ctx, addr1))
+	require.Equal(t, delCoins, vacc.GetDelegatedVesting())
+
+	// require the module account holds all the delegated coins
+	require.Equal(t, delCoins.Add(delCoins), bankKeeper.GetCoins(ctx, addrModule))
 }
 
 func TestUndelegateCoins(t *testing.T) {
@@ -321,41 +413,44 @@ func TestUndelegateCoins(t *testing.T) {
 
 	origCoins := sdk.NewCoins(sdk.NewInt64Coin("stake", 100))
 	delCoins := sdk.NewCoins(sdk.NewInt64Coin("stake", 50))
-	bankKeeper := NewBaseKeeper(input.ak, input.pk.Subspace(DefaultParamspace), DefaultCodespace)
-	bankKeeper.SetSendEnabled(ctx, true)
+	bankKeeper := NewBaseKeeper(input.cdc, input.key, input.ak, input.pk.Subspace(DefaultParamspace), DefaultCodespace, make(map[string]bool))
+	bankKeeper.SetParams(ctx, types.DefaultParams())
 
 	addr1 := sdk.AccAddress([]byte("addr1"))
 	addr2 := sdk.AccAddress([]byte("addr2"))
+	addrModule := sdk.AccAddress([]byte("moduleAcc"))
 
 	bacc := auth.NewBaseAccountWithAddress(addr1)
-	bacc.SetCoins(origCoins)
-	vacc := auth.NewContinuousVestingAccount(&bacc, ctx.BlockHeader().Time.Unix(), endTime.Unix())
+	vacc := auth.NewContinuousVestingAccount(&bacc, origCoins, ctx.BlockHeader().Time.Unix(), endTime.Unix())
 	acc := input.ak.NewAccountWithAddress(ctx, addr2)
 	input.ak.SetAccount(ctx, vacc)
+	macc := input.ak.NewAccountWithAddress(ctx, addrModule) // only the address of the module account is needed
 	input.ak.SetAccount(ctx, acc)
+	input.ak.SetAccount(ctx, macc)
+	bankKeeper.SetCoins(ctx, addr1, origCoins)
 	bankKeeper.SetCoins(ctx, addr2, origCoins)
 
 	ctx = ctx.WithBlockTime(now.Add(12 * time.Hour))
 
 	// require the ability for a non-vesting account to delegate
-	_, err := bankKeeper.DelegateCoins(ctx, addr2, delCoins)
+	err := bankKeeper.DelegateCoins(ctx, addr2, addrModule, delCoins)
 	require.NoError(t, err)
 
 	// require the ability for a non-vesting account to undelegate
-	_, err = bankKeeper.UndelegateCoins(ctx, addr2, delCoins)
+	err = bankKeeper.UndelegateCoins(ctx, addrModule, addr2, delCoins)
 	require.NoError(t, err)
 
-	acc = input.ak.GetAccount(ctx, addr2)
-	require.Equal(t, origCoins, acc.GetCoins())
+	require.Equal(t, origCoins, bankKeeper.GetCoins(ctx, addr2))
 
 	// require the ability for a vesting account to delegate
-	_, err = bankKeeper.DelegateCoins(ctx, addr1, delCoins)
+	err = bankKeeper.DelegateCoins(ctx, addr1, addrModule, delCoins)
 	require.NoError(t, err)
 
 	// require the ability for a vesting account to undelegate
-	_, err = bankKeeper.UndelegateCoins(ctx, addr1, delCoins)
+	err = bankKeeper.UndelegateCoins(ctx, addrModule, addr1, delCoins)
 	require.NoError(t, err)
 
 	vacc = input.ak.GetAccount(ctx, addr1).(*auth.ContinuousVestingAccount)
-	require.Equal(t, origCoins, vacc.GetCoins())
+	require.Equal(t, origCoins, bankKeeper.GetCoins(ctx, addr1))
+	require.True(t, vacc.GetDelegatedVesting().Empty())
 }
@@ -0,0 +1,42 @@
+package bank
+
+import (
+	"fmt"
+
+	abci "github.com/tendermint/tendermint/abci/types"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/bank/types"
+)
+
+// NewQuerier returns a bank Querier handler.
+func NewQuerier(k ViewKeeper) sdk.Querier {
+	return func(ctx sdk.Context, path []string, req abci.RequestQuery) ([]byte, sdk.Error) {
+		switch path[0] {
+		case types.QueryBalances:
+			return queryBalances(ctx, req, k)
+
+		default:
+			return nil, sdk.ErrUnknownRequest(fmt.Sprintf("unknown bank query endpoint: %s", path[0]))
+		}
+	}
+}
+
+func queryBalances(ctx sdk.Context, req abci.RequestQuery, k ViewKeeper) ([]byte, sdk.Error) {
+	var params types.QueryBalancesParams
+
+	err := types.ModuleCdc.UnmarshalJSON(req.Data, &params)
+	if err != nil {
+		return nil, sdk.ErrUnknownRequest(sdk.AppendMsgToErr("incorrectly formatted request data", err.Error()))
+	}
+
+	balances := k.GetCoins(ctx, params.Address)
+
+	res, err := codec.MarshalJSONIndent(types.ModuleCdc, balances)
+	if err != nil {
+		return nil, sdk.ErrInternal(sdk.AppendMsgToErr("failed to JSON marshal result: %s", err.Error()))
+	}
+
+	return res, nil
+}
@@ -0,0 +1,53 @@
+package bank
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	abci "github.com/tendermint/tendermint/abci/types"
+	"github.com/tendermint/tendermint/crypto"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/bank/types"
+)
+
+func TestQuerierBalances(t *testing.T) {
+	input := setupTestInput()
+	ctx := input.ctx
+	bankKeeper := NewBaseKeeper(input.cdc, input.key, input.ak, input.pk.Subspace(DefaultParamspace), DefaultCodespace, make(map[string]bool))
+	querier := NewQuerier(bankKeeper)
+
+	addr := sdk.AccAddress(crypto.AddressHash([]byte("addr1")))
+	query := abci.RequestQuery{
+		Path: fmt.Sprintf("custom/%s/%s", types.QuerierRoute, types.QueryBalances),
+		Data: []byte{},
+	}
+
+	bz, err := querier(ctx, []string{"other"}, query)
+	require.NotNil(t, err)
+	require.Nil(t, bz)
+
+	// invalid request data
+	query.Data = []byte("invalid")
+	_, err = querier(ctx, []string{types.QueryBalances}, query)
+	require.NotNil(t, err)
+
+	// no balances for the account
+	query.Data = types.ModuleCdc.MustMarshalJSON(types.NewQueryBalancesParams(addr))
+	bz, err = querier(ctx, []string{types.QueryBalances}, query)
+	require.Nil(t, err)
+
+	var balances sdk.Coins
+	require.NoError(t, types.ModuleCdc.UnmarshalJSON(bz, &balances))
+	require.True(t, balances.IsZero())
+
+	coins := sdk.NewCoins(sdk.NewInt64Coin("barcoin", 5), sdk.NewInt64Coin("foocoin", 10))
+	bankKeeper.SetCoins(ctx, addr, coins)
+
+	bz, err = querier(ctx, []string{types.QueryBalances}, query)
+	require.Nil(t, err)
+	require.NoError(t, types.ModuleCdc.UnmarshalJSON(bz, &balances))
+	require.Equal(t, coins, balances)
+}
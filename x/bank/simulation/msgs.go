@@ -21,12 +21,12 @@ func SimulateMsgSend(mapper auth.AccountKeeper, bk bank.Keeper) simulation.Opera
 	return func(r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simulation.Account) (
 		opMsg simulation.OperationMsg, fOps []simulation.FutureOperation, err error) {
 
-		fromAcc, comment, msg, ok := createMsgSend(r, ctx, accs, mapper)
+		fromAcc, comment, msg, ok := createMsgSend(r, ctx, accs, bk)
 		opMsg = simulation.NewOperationMsg(msg, ok, comment)
 		if !ok {
 			return opMsg, nil, nil
 		}
-		err = sendAndVerifyMsgSend(app, mapper, msg, ctx, []crypto.PrivKey{fromAcc.PrivKey}, handler)
+		err = sendAndVerifyMsgSend(app, mapper, bk, msg, ctx, []crypto.PrivKey{fromAcc.PrivKey}, handler)
 		if err != nil {
 			return opMsg, nil, err
 		}
@@ -34,7 +34,7 @@ func SimulateMsgSend(mapper auth.AccountKeeper, bk bank.Keeper) simulation.Opera
 	}
 }
 
-func createMsgSend(r *rand.Rand, ctx sdk.Context, accs []simulation.Account, mapper auth.AccountKeeper) (
+func createMsgSend(r *rand.Rand, ctx sdk.Context, accs []simulation.Account, bk bank.Keeper) (
 	fromAcc simulation.Account, comment string, msg bank.MsgSend, ok bool) {
 
 	fromAcc = simulation.RandomAcc(r, accs)
@@ -46,7 +46,7 @@ func createMsgSend(r *rand.Rand, ctx sdk.Context, accs []simulation.Account, map
 		}
 		toAcc = simulation.RandomAcc(r, accs)
 	}
-	initFromCoins := mapper.GetAccount(ctx, fromAcc.Address).SpendableCoins(ctx.BlockHeader().Time)
+	initFromCoins := bk.SpendableCoins(ctx, fromAcc.Address)
 
 	if len(initFromCoins) == 0 {
 		return fromAcc, "skipping, no coins at all", msg, false
@@ -58,27 +58,26 @@ func createMsgSend(r *rand.Rand, ctx sdk.Context, accs []simulation.Account, map
 		return fromAcc, "skipping bank send due to account having no coins of denomination " + initFromCoins[denomIndex].Denom, msg, false
 	}
 
+	if !bk.IsSendEnabledCoin(ctx, initFromCoins[denomIndex]) {
+		return fromAcc, "skipping, transfers of denomination " + initFromCoins[denomIndex].Denom + " are disabled", msg, false
+	}
+
 	coins := sdk.Coins{sdk.NewCoin(initFromCoins[denomIndex].Denom, amt)}
 	msg = bank.NewMsgSend(fromAcc.Address, toAcc.Address, coins)
 	return fromAcc, "", msg, true
 }
 
 // Sends and verifies the transition of a msg send.
-func sendAndVerifyMsgSend(app *baseapp.BaseApp, mapper auth.AccountKeeper, msg bank.MsgSend, ctx sdk.Context, privkeys []crypto.PrivKey, handler sdk.Handler) error {
+func sendAndVerifyMsgSend(app *baseapp.BaseApp, mapper auth.AccountKeeper, bk bank.Keeper, msg bank.MsgSend, ctx sdk.Context, privkeys []crypto.PrivKey, handler sdk.Handler) error {
 	fromAcc := mapper.GetAccount(ctx, msg.FromAddress)
 	AccountNumbers := []uint64{fromAcc.GetAccountNumber()}
 	SequenceNumbers := []uint64{fromAcc.GetSequence()}
-	initialFromAddrCoins := fromAcc.GetCoins()
-
-	toAcc := mapper.GetAccount(ctx, msg.ToAddress)
-	initialToAddrCoins := toAcc.GetCoins()
+	initialFromAddrCoins := bk.GetCoins(ctx, msg.FromAddress)
+	initialToAddrCoins := bk.GetCoins(ctx, msg.ToAddress)
 
 	if handler != nil {
 		res := handler(ctx, msg)
 		if !res.IsOK() {
-			if res.Code == bank.CodeSendDisabled {
-				return nil
-			}
 			// TODO: Do this in a more 'canonical' way
 			return fmt.Errorf("handling msg failed %v", res)
 		}
@@ -94,15 +93,12 @@ func sendAndVerifyMsgSend(app *baseapp.BaseApp, mapper auth.AccountKeeper, msg b
 		}
 	}
 
-	fromAcc = mapper.GetAccount(ctx, msg.FromAddress)
-	toAcc = mapper.GetAccount(ctx, msg.ToAddress)
-
-	if !initialFromAddrCoins.Sub(msg.Amount).IsEqual(fromAcc.GetCoins()) {
-		return fmt.Errorf("fromAddress %s had an incorrect amount of coins", fromAcc.GetAddress())
+	if !initialFromAddrCoins.Sub(msg.Amount).IsEqual(bk.GetCoins(ctx, msg.FromAddress)) {
+		return fmt.Errorf("fromAddress %s had an incorrect amount of coins", msg.FromAddress)
 	}
 
-	if !initialToAddrCoins.Add(msg.Amount).IsEqual(toAcc.GetCoins()) {
-		return fmt.Errorf("toAddress %s had an incorrect amount of coins", toAcc.GetAddress())
+	if !initialToAddrCoins.Add(msg.Amount).IsEqual(bk.GetCoins(ctx, msg.ToAddress)) {
+		return fmt.Errorf("toAddress %s had an incorrect amount of coins", msg.ToAddress)
 	}
 
 	return nil
@@ -115,12 +111,12 @@ func SimulateSingleInputMsgMultiSend(mapper auth.AccountKeeper, bk bank.Keeper)
 	return func(r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simulation.Account) (
 		opMsg simulation.OperationMsg, fOps []simulation.FutureOperation, err error) {
 
-		fromAcc, comment, msg, ok := createSingleInputMsgMultiSend(r, ctx, accs, mapper)
+		fromAcc, comment, msg, ok := createSingleInputMsgMultiSend(r, ctx, accs, bk)
 		opMsg = simulation.NewOperationMsg(msg, ok, comment)
 		if !ok {
 			return opMsg, nil, nil
 		}
-		err = sendAndVerifyMsgMultiSend(app, mapper, msg, ctx, []crypto.PrivKey{fromAcc.PrivKey}, handler)
+		err = sendAndVerifyMsgMultiSend(app, mapper, bk, msg, ctx, []crypto.PrivKey{fromAcc.PrivKey}, handler)
 		if err != nil {
 			return opMsg, nil, err
 		}
@@ -128,7 +124,7 @@ func SimulateSingleInputMsgMultiSend(mapper auth.AccountKeeper, bk bank.Keeper)
 	}
 }
 
-func createSingleInputMsgMultiSend(r *rand.Rand, ctx sdk.Context, accs []simulation.Account, mapper auth.AccountKeeper) (
+func createSingleInputMsgMultiSend(r *rand.Rand, ctx sdk.Context, accs []simulation.Account, bk bank.Keeper) (
 	fromAcc simulation.Account, comment string, msg bank.MsgMultiSend, ok bool) {
 
 	fromAcc = simulation.RandomAcc(r, accs)
@@ -141,7 +137,7 @@ func createSingleInputMsgMultiSend(r *rand.Rand, ctx sdk.Context, accs []simulat
 		toAcc = simulation.RandomAcc(r, accs)
 	}
 	toAddr := toAcc.Address
-	initFromCoins := mapper.GetAccount(ctx, fromAcc.Address).SpendableCoins(ctx.BlockHeader().Time)
+	initFromCoins := bk.SpendableCoins(ctx, fromAcc.Address)
 
 	if len(initFromCoins) == 0 {
 		return fromAcc, "skipping, no coins at all", msg, false
@@ -153,6 +149,10 @@ func createSingleInputMsgMultiSend(r *rand.Rand, ctx sdk.Context, accs []simulat
 		return fromAcc, "skipping bank send due to account having no coins of denomination " + initFromCoins[denomIndex].Denom, msg, false
 	}
 
+	if !bk.IsSendEnabledCoin(ctx, initFromCoins[denomIndex]) {
+		return fromAcc, "skipping, transfers of denomination " + initFromCoins[denomIndex].Denom + " are disabled", msg, false
+	}
+
 	coins := sdk.Coins{sdk.NewCoin(initFromCoins[denomIndex].Denom, amt)}
 	msg = bank.MsgMultiSend{
 		Inputs:  []bank.Input{bank.NewInput(fromAcc.Address, coins)},
@@ -163,7 +163,7 @@ func createSingleInputMsgMultiSend(r *rand.Rand, ctx sdk.Context, accs []simulat
 
 // Sends and verifies the transition of a msg multisend. This fails if there are repeated inputs or outputs
 // pass in handler as nil to handle txs, otherwise handle msgs
-func sendAndVerifyMsgMultiSend(app *baseapp.BaseApp, mapper auth.AccountKeeper, msg bank.MsgMultiSend,
+func sendAndVerifyMsgMultiSend(app *baseapp.BaseApp, mapper auth.AccountKeeper, bk bank.Keeper, msg bank.MsgMultiSend,
 	ctx sdk.Context, privkeys []crypto.PrivKey, handler sdk.Handler) error {
 
 	initialInputAddrCoins := make([]sdk.Coins, len(msg.Inputs))
@@ -175,18 +175,14 @@ func sendAndVerifyMsgMultiSend(app *baseapp.BaseApp, mapper auth.AccountKeeper,
 		acc := mapper.GetAccount(ctx, msg.Inputs[i].Address)
 		AccountNumbers[i] = acc.GetAccountNumber()
 		SequenceNumbers[i] = acc.GetSequence()
-		initialInputAddrCoins[i] = acc.GetCoins()
+		initialInputAddrCoins[i] = bk.GetCoins(ctx, msg.Inputs[i].Address)
 	}
 	for i := 0; i < len(msg.Outputs); i++ {
-		acc := mapper.GetAccount(ctx, msg.Outputs[i].Address)
-		initialOutputAddrCoins[i] = acc.GetCoins()
+		initialOutputAddrCoins[i] = bk.GetCoins(ctx, msg.Outputs[i].Address)
 	}
 	if handler != nil {
 		res := handler(ctx, msg)
 		if !res.IsOK() {
-			if res.Code == bank.CodeSendDisabled {
-				return nil
-			}
 			// TODO: Do this in a more 'canonical' way
 			return fmt.Errorf("handling msg failed %v", res)
 		}
@@ -203,13 +199,13 @@ func sendAndVerifyMsgMultiSend(app *baseapp.BaseApp, mapper auth.AccountKeeper,
 	}
 
 	for i := 0; i < len(msg.Inputs); i++ {
-		terminalInputCoins := mapper.GetAccount(ctx, msg.Inputs[i].Address).GetCoins()
+		terminalInputCoins := bk.GetCoins(ctx, msg.Inputs[i].Address)
 		if !initialInputAddrCoins[i].Sub(msg.Inputs[i].Coins).IsEqual(terminalInputCoins) {
 			return fmt.Errorf("input #%d had an incorrect amount of coins", i)
 		}
 	}
 	for i := 0; i < len(msg.Outputs); i++ {
-		terminalOutputCoins := mapper.GetAccount(ctx, msg.Outputs[i].Address).GetCoins()
+		terminalOutputCoins := bk.GetCoins(ctx, msg.Outputs[i].Address)
 		if !terminalOutputCoins.IsEqual(initialOutputAddrCoins[i].Add(msg.Outputs[i].Coins)) {
 			return fmt.Errorf("output #%d had an incorrect amount of coins", i)
 		}
@@ -1,6 +1,8 @@
 package types
 
 import (
+	"fmt"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
@@ -28,6 +30,6 @@ func ErrInputOutputMismatch(codespace sdk.CodespaceType) sdk.Error {
 }
 
 // ErrSendDisabled is an error
-func ErrSendDisabled(codespace sdk.CodespaceType) sdk.Error {
-	return sdk.NewError(codespace, CodeSendDisabled, "send transactions are currently disabled")
+func ErrSendDisabled(codespace sdk.CodespaceType, denom string) sdk.Error {
+	return sdk.NewError(codespace, CodeSendDisabled, fmt.Sprintf("%s transfers are currently disabled", denom))
 }
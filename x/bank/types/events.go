@@ -0,0 +1,11 @@
+package types
+
+// bank module event types
+const (
+	EventTypeTransfer = "transfer"
+
+	AttributeKeyRecipient = "recipient"
+	AttributeKeySender    = "sender"
+
+	AttributeValueCategory = ModuleName
+)
@@ -1,6 +1,43 @@
 package types
 
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
 const (
 	// module name
 	ModuleName = "bank"
+
+	// StoreKey is the default store key for bank
+	StoreKey = ModuleName
+
+	// QuerierRoute is the querier route for bank
+	QuerierRoute = ModuleName
 )
+
+// Keys for bank store
+// Items are stored with the following key: values
+//
+// - 0x00<accAddr_Bytes><denom_Bytes>: sdk.Coin
+var (
+	BalancesPrefix = []byte{0x00}
+)
+
+// AddressBalancesPrefix returns the key prefix of all the balances held by an
+// account.
+func AddressBalancesPrefix(addr sdk.AccAddress) []byte {
+	return append(BalancesPrefix, addr.Bytes()...)
+}
+
+// BalanceKey returns the key of the balance of a denomination held by an
+// account.
+func BalanceKey(addr sdk.AccAddress, denom string) []byte {
+	return append(AddressBalancesPrefix(addr), []byte(denom)...)
+}
+
+// AddressFromBalancesKey returns the address of the account holding the balance
+// stored under the given key. The address is expected to be sdk.AddrLen bytes
+// long.
+func AddressFromBalancesKey(key []byte) sdk.AccAddress {
+	return sdk.AccAddress(key[len(BalancesPrefix) : len(BalancesPrefix)+sdk.AddrLen])
+}
@@ -1,6 +1,10 @@
 package types
 
 import (
+	"fmt"
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/x/params"
 )
 
@@ -11,12 +15,139 @@ const (
 	DefaultSendEnabled = true
 )
 
-// ParamStoreKeySendEnabled is store's key for SendEnabled
-var ParamStoreKeySendEnabled = []byte("sendenabled")
+// Parameter store keys
+var (
+	// ParamStoreKeySendEnabled is store's key for the per denomination
+	// SendEnabled entries
+	ParamStoreKeySendEnabled = []byte("sendenableddenoms")
+	// ParamStoreKeyDefaultSendEnabled is store's key for DefaultSendEnabled. It
+	// reuses the key of the former global SendEnabled flag, which had the same
+	// type and now applies to denominations without an entry.
+	ParamStoreKeyDefaultSendEnabled = []byte("sendenabled")
+)
+
+var _ params.ParamSet = (*Params)(nil)
+
+// SendEnabled defines whether coins of a given denomination can be transferred
+type SendEnabled struct {
+	Denom   string `json:"denom"`
+	Enabled bool   `json:"enabled"`
+}
+
+// NewSendEnabled creates a new SendEnabled entry
+func NewSendEnabled(denom string, enabled bool) SendEnabled {
+	return SendEnabled{
+		Denom:   denom,
+		Enabled: enabled,
+	}
+}
+
+// String implements the Stringer interface
+func (se SendEnabled) String() string {
+	return fmt.Sprintf("%s: %t", se.Denom, se.Enabled)
+}
+
+// Params defines the parameters of the bank module
+type Params struct {
+	SendEnabled        []SendEnabled `json:"send_enabled"`         // per denomination overrides of DefaultSendEnabled
+	DefaultSendEnabled bool          `json:"default_send_enabled"` // whether denominations without an entry can be transferred
+}
 
 // ParamKeyTable type declaration for parameters
 func ParamKeyTable() params.KeyTable {
-	return params.NewKeyTable(
-		ParamStoreKeySendEnabled, false,
+	return params.NewKeyTable().RegisterParamSet(&Params{})
+}
+
+// NewParams creates a new Params object
+func NewParams(defaultSendEnabled bool, sendEnabled []SendEnabled) Params {
+	return Params{
+		SendEnabled:        sendEnabled,
+		DefaultSendEnabled: defaultSendEnabled,
+	}
+}
+
+// DefaultParams returns the default bank parameters. All denominations can be
+// transferred.
+func DefaultParams() Params {
+	return NewParams(DefaultSendEnabled, nil)
+}
+
+// SendEnabledDenom returns whether coins of the given denomination can be
+// transferred.
+func (p Params) SendEnabledDenom(denom string) bool {
+	for _, se := range p.SendEnabled {
+		if se.Denom == denom {
+			return se.Enabled
+		}
+	}
+	return p.DefaultSendEnabled
+}
+
+// SetSendEnabledParam returns a copy of the params with the SendEnabled entry
+// of the given denomination set to enabled.
+func (p Params) SetSendEnabledParam(denom string, enabled bool) Params {
+	sendEnabled := make([]SendEnabled, 0, len(p.SendEnabled)+1)
+	for _, se := range p.SendEnabled {
+		if se.Denom != denom {
+			sendEnabled = append(sendEnabled, se)
+		}
+	}
+	sendEnabled = append(sendEnabled, NewSendEnabled(denom, enabled))
+	return NewParams(p.DefaultSendEnabled, sendEnabled)
+}
+
+// ValidateParams validates the bank parameters.
+func ValidateParams(params Params) error {
+	return validateSendEnabled(params.SendEnabled)
+}
+
+// String implements the Stringer interface
+func (p Params) String() string {
+	sendEnabled := make([]string, len(p.SendEnabled))
+	for i, se := range p.SendEnabled {
+		sendEnabled[i] = se.String()
+	}
+
+	return fmt.Sprintf(`Bank Params:
+  Send Enabled:         %s
+  Default Send Enabled: %t
+`,
+		strings.Join(sendEnabled, ", "), p.DefaultSendEnabled,
 	)
 }
+
+// ParamSetPairs implements params.ParamSet
+func (p *Params) ParamSetPairs() params.ParamSetPairs {
+	return params.ParamSetPairs{
+		params.NewParamSetPair(ParamStoreKeySendEnabled, &p.SendEnabled, validateSendEnabled),
+		params.NewParamSetPair(ParamStoreKeyDefaultSendEnabled, &p.DefaultSendEnabled, validateDefaultSendEnabled),
+	}
+}
+
+func validateSendEnabled(i interface{}) error {
+	sendEnabled, ok := i.([]SendEnabled)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	seen := make(map[string]bool)
+	for _, se := range sendEnabled {
+		if err := sdk.ValidateDenom(se.Denom); err != nil {
+			return fmt.Errorf("bank parameter SendEnabled: %s", err)
+		}
+		if seen[se.Denom] {
+			return fmt.Errorf("bank parameter SendEnabled has a duplicate entry for denom %s", se.Denom)
+		}
+		seen[se.Denom] = true
+	}
+
+	return nil
+}
+
+func validateDefaultSendEnabled(i interface{}) error {
+	if _, ok := i.(bool); !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	return nil
+}
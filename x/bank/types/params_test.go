@@ -0,0 +1,41 @@
+package types
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestSendEnabledDenom(t *testing.T) {
+	params := DefaultParams()
+	require.True(t, params.SendEnabledDenom("foocoin"))
+
+	params = params.SetSendEnabledParam("foocoin", false)
+	require.False(t, params.SendEnabledDenom("foocoin"))
+	require.True(t, params.SendEnabledDenom("barcoin"))
+
+	// an existing entry is replaced
+	params = params.SetSendEnabledParam("foocoin", true)
+	require.Len(t, params.SendEnabled, 1)
+	require.True(t, params.SendEnabledDenom("foocoin"))
+
+	// entries override the default
+	params = NewParams(false, []SendEnabled{NewSendEnabled("foocoin", true)})
+	require.True(t, params.SendEnabledDenom("foocoin"))
+	require.False(t, params.SendEnabledDenom("barcoin"))
+}
+
+func TestValidateParams(t *testing.T) {
+	require.NoError(t, ValidateParams(DefaultParams()))
+	require.NoError(t, ValidateParams(NewParams(true, []SendEnabled{
+		NewSendEnabled("foocoin", false), NewSendEnabled("barcoin", true),
+	})))
+
+	// invalid denomination
+	require.Error(t, ValidateParams(NewParams(true, []SendEnabled{NewSendEnabled("F", false)})))
+
+	// duplicate entries
+	require.Error(t, ValidateParams(NewParams(true, []SendEnabled{
+		NewSendEnabled("foocoin", false), NewSendEnabled("foocoin", true),
+	})))
+}
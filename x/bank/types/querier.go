@@ -0,0 +1,23 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// query endpoints supported by the bank Querier
+const (
+	QueryBalances = "balances"
+)
+
+// QueryBalancesParams defines the params for the following queries:
+//
+// - 'custom/bank/balances'
+type QueryBalancesParams struct {
+	Address sdk.AccAddress
+}
+
+// NewQueryBalancesParams creates a new instance to query the balances held by
+// an account
+func NewQueryBalancesParams(addr sdk.AccAddress) QueryBalancesParams {
+	return QueryBalancesParams{addr}
+}
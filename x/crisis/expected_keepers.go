@@ -4,17 +4,7 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
-// expected bank keeper
-type DistrKeeper interface {
-	DistributeFeePool(ctx sdk.Context, amount sdk.Coins, receiveAddr sdk.AccAddress) sdk.Error
-}
-
-// expected fee collection keeper
-type FeeCollectionKeeper interface {
-	AddCollectedFees(ctx sdk.Context, coins sdk.Coins) sdk.Coins
-}
-
-// expected bank keeper
-type BankKeeper interface {
-	SubtractCoins(ctx sdk.Context, addr sdk.AccAddress, amt sdk.Coins) (sdk.Coins, sdk.Error)
+// SupplyKeeper defines the expected supply keeper
+type SupplyKeeper interface {
+	SendCoinsFromAccountToModule(ctx sdk.Context, senderAddr sdk.AccAddress, recipientModule string, amt sdk.Coins) sdk.Error
 }
@@ -10,6 +10,7 @@ import (
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/x/auth"
+	"github.com/cosmos/cosmos-sdk/x/bank"
 	distr "github.com/cosmos/cosmos-sdk/x/distribution"
 )
 
@@ -20,14 +21,14 @@ var (
 	addrs                 = distr.TestAddrs
 )
 
-func CreateTestInput(t *testing.T) (sdk.Context, Keeper, auth.AccountKeeper, distr.Keeper) {
+func CreateTestInput(t *testing.T) (sdk.Context, Keeper, bank.Keeper, distr.Keeper) {
 
 	communityTax := sdk.NewDecWithPrec(2, 2)
-	ctx, accKeeper, bankKeeper, distrKeeper, _, feeCollectionKeeper, paramsKeeper :=
+	ctx, _, bankKeeper, distrKeeper, _, paramsKeeper, supplyKeeper :=
 		distr.CreateTestInputAdvanced(t, false, 10, communityTax)
 
 	paramSpace := paramsKeeper.Subspace(DefaultParamspace)
-	crisisKeeper := NewKeeper(paramSpace, 1, distrKeeper, bankKeeper, feeCollectionKeeper)
+	crisisKeeper := NewKeeper(paramSpace, 1, supplyKeeper, auth.FeeCollectorName)
 	constantFee := sdk.NewInt64Coin("stake", 10000000)
 	crisisKeeper.SetConstantFee(ctx, constantFee)
 
@@ -39,15 +40,15 @@ func CreateTestInput(t *testing.T) (sdk.Context, Keeper, auth.AccountKeeper, dis
 	feePool.CommunityPool = sdk.NewDecCoins(sdk.NewCoins(constantFee))
 	distrKeeper.SetFeePool(ctx, feePool)
 
-	return ctx, crisisKeeper, accKeeper, distrKeeper
+	return ctx, crisisKeeper, bankKeeper, distrKeeper
 }
 
 //____________________________________________________________________________
 
 func TestHandleMsgVerifyInvariantWithNotEnoughSenderCoins(t *testing.T) {
-	ctx, crisisKeeper, accKeeper, _ := CreateTestInput(t)
+	ctx, crisisKeeper, bankKeeper, _ := CreateTestInput(t)
 	sender := addrs[0]
-	coin := accKeeper.GetAccount(ctx, sender).GetCoins()[0]
+	coin := bankKeeper.GetCoins(ctx, sender)[0]
 	excessCoins := sdk.NewCoin(coin.Denom, coin.Amount.AddRaw(1))
 	crisisKeeper.SetConstantFee(ctx, excessCoins)
 
@@ -17,23 +17,20 @@ type Keeper struct {
 	paramSpace     params.Subspace
 	invCheckPeriod uint
 
-	distrKeeper         DistrKeeper
-	bankKeeper          BankKeeper
-	feeCollectionKeeper FeeCollectionKeeper
+	supplyKeeper     SupplyKeeper
+	feeCollectorName string // name of the FeeCollector ModuleAccount
 }
 
 // NewKeeper creates a new Keeper object
 func NewKeeper(paramSpace params.Subspace, invCheckPeriod uint,
-	distrKeeper DistrKeeper, bankKeeper BankKeeper,
-	feeCollectionKeeper FeeCollectionKeeper) Keeper {
+	supplyKeeper SupplyKeeper, feeCollectorName string) Keeper {
 
 	return Keeper{
-		routes:              []types.InvarRoute{},
-		paramSpace:          paramSpace.WithKeyTable(types.ParamKeyTable()),
-		invCheckPeriod:      invCheckPeriod,
-		distrKeeper:         distrKeeper,
-		bankKeeper:          bankKeeper,
-		feeCollectionKeeper: feeCollectionKeeper,
+		routes:           []types.InvarRoute{},
+		paramSpace:       paramSpace.WithKeyTable(types.ParamKeyTable()),
+		invCheckPeriod:   invCheckPeriod,
+		supplyKeeper:     supplyKeeper,
+		feeCollectorName: feeCollectorName,
 	}
 }
 
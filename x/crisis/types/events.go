@@ -0,0 +1,9 @@
+package types
+
+// crisis module event types
+const (
+	EventTypeInvariant = "invariant"
+
+	AttributeKeyRoute      = "route"
+	AttributeValueCategory = ModuleName
+)
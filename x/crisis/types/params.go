@@ -1,6 +1,8 @@
 package types
 
 import (
+	"fmt"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/x/params"
 )
@@ -18,6 +20,22 @@ var (
 // type declaration for parameters
 func ParamKeyTable() params.KeyTable {
 	return params.NewKeyTable(
-		ParamStoreKeyConstantFee, sdk.Coin{},
+		params.NewParamSetPair(ParamStoreKeyConstantFee, sdk.Coin{}, validateConstantFee),
 	)
 }
+
+func validateConstantFee(i interface{}) error {
+	v, ok := i.(sdk.Coin)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if err := sdk.ValidateDenom(v.Denom); err != nil {
+		return fmt.Errorf("crisis parameter ConstantFee: %s", err)
+	}
+	if v.IsNegative() {
+		return fmt.Errorf("crisis parameter ConstantFee must be a non-negative coin, is %s", v)
+	}
+
+	return nil
+}
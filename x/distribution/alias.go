@@ -2,13 +2,11 @@
 // autogenerated code using github.com/rigelrozanski/multitool
 // aliases generated for the following subdirectories:
 // ALIASGEN: github.com/cosmos/cosmos-sdk/x/distribution/keeper
-// ALIASGEN: github.com/cosmos/cosmos-sdk/x/distribution/tags
 // ALIASGEN: github.com/cosmos/cosmos-sdk/x/distribution/types
 package distribution
 
 import (
 	"github.com/cosmos/cosmos-sdk/x/distribution/keeper"
-	"github.com/cosmos/cosmos-sdk/x/distribution/tags"
 	"github.com/cosmos/cosmos-sdk/x/distribution/types"
 )
 
@@ -19,6 +17,15 @@ const (
 	CodeNoDistributionInfo           = types.CodeNoDistributionInfo
 	CodeNoValidatorCommission        = types.CodeNoValidatorCommission
 	CodeSetWithdrawAddrDisabled      = types.CodeSetWithdrawAddrDisabled
+	EventTypeSetWithdrawAddress      = types.EventTypeSetWithdrawAddress
+	EventTypeRewards                 = types.EventTypeRewards
+	EventTypeCommission              = types.EventTypeCommission
+	EventTypeWithdrawRewards         = types.EventTypeWithdrawRewards
+	EventTypeWithdrawCommission      = types.EventTypeWithdrawCommission
+	EventTypeProposerReward          = types.EventTypeProposerReward
+	AttributeKeyWithdrawAddress      = types.AttributeKeyWithdrawAddress
+	AttributeKeyValidator            = types.AttributeKeyValidator
+	AttributeValueCategory           = types.AttributeValueCategory
 	ModuleName                       = types.ModuleName
 	StoreKey                         = types.StoreKey
 	TStoreKey                        = types.TStoreKey
@@ -47,6 +54,7 @@ var (
 	NonNegativeOutstandingInvariant            = keeper.NonNegativeOutstandingInvariant
 	CanWithdrawInvariant                       = keeper.CanWithdrawInvariant
 	ReferenceCountInvariant                    = keeper.ReferenceCountInvariant
+	ModuleAccountInvariant                     = keeper.ModuleAccountInvariant
 	NewKeeper                                  = keeper.NewKeeper
 	GetValidatorOutstandingRewardsAddress      = keeper.GetValidatorOutstandingRewardsAddress
 	GetDelegatorWithdrawInfoAddress            = keeper.GetDelegatorWithdrawInfoAddress
@@ -118,24 +126,15 @@ var (
 	ParamStoreKeyBonusProposerReward     = keeper.ParamStoreKeyBonusProposerReward
 	ParamStoreKeyWithdrawAddrEnabled     = keeper.ParamStoreKeyWithdrawAddrEnabled
 	TestAddrs                            = keeper.TestAddrs
-	Rewards                              = tags.Rewards
-	Commission                           = tags.Commission
-	TxCategory                           = tags.TxCategory
-	Validator                            = tags.Validator
-	Category                             = tags.Category
-	Sender                               = tags.Sender
 	ModuleCdc                            = types.ModuleCdc
 )
 
 type (
 	Hooks                                  = keeper.Hooks
 	Keeper                                 = keeper.Keeper
-	DummyFeeCollectionKeeper               = keeper.DummyFeeCollectionKeeper
 	DelegatorStartingInfo                  = types.DelegatorStartingInfo
 	CodeType                               = types.CodeType
 	StakingKeeper                          = types.StakingKeeper
-	BankKeeper                             = types.BankKeeper
-	FeeCollectionKeeper                    = types.FeeCollectionKeeper
 	FeePool                                = types.FeePool
 	DelegatorWithdrawInfo                  = types.DelegatorWithdrawInfo
 	ValidatorOutstandingRewardsRecord      = types.ValidatorOutstandingRewardsRecord
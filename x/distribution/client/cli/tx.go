@@ -244,7 +244,7 @@ Where proposal.json contains:
 			from := cliCtx.GetFromAddress()
 			content := types.NewCommunityPoolSpendProposal(proposal.Title, proposal.Description, proposal.Recipient, proposal.Amount)
 
-			msg := gov.NewMsgSubmitProposal(content, proposal.Deposit, from)
+			msg := gov.NewMsgSubmitProposal(content, proposal.Deposit, from, false)
 			if err := msg.ValidateBasic(); err != nil {
 				return err
 			}
@@ -43,7 +43,7 @@ func postProposalHandlerFn(cdc *codec.Codec, cliCtx context.CLIContext) http.Han
 
 		content := types.NewCommunityPoolSpendProposal(req.Title, req.Description, req.Recipient, req.Amount)
 
-		msg := gov.NewMsgSubmitProposal(content, req.Deposit, req.Proposer)
+		msg := gov.NewMsgSubmitProposal(content, req.Deposit, req.Proposer, false)
 		if err := msg.ValidateBasic(); err != nil {
 			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
 			return
@@ -38,7 +38,7 @@ func TestAllocateTokensToValidatorWithCommission(t *testing.T) {
 }
 
 func TestAllocateTokensToManyValidators(t *testing.T) {
-	ctx, _, k, sk, fck := CreateTestInputDefault(t, false, 1000)
+	ctx, _, k, sk, supplyKeeper := CreateTestInputDefault(t, false, 1000)
 	sh := staking.NewHandler(sk)
 
 	// create validator with 50% commission
@@ -75,7 +75,8 @@ func TestAllocateTokensToManyValidators(t *testing.T) {
 	fees := sdk.Coins{
 		{sdk.DefaultBondDenom, sdk.NewInt(100)},
 	}
-	fck.SetCollectedFees(fees)
+	require.NoError(t, supplyKeeper.SetModuleAccountCoins(ctx, k.feeCollectorName, fees))
+
 	votes := []abci.VoteInfo{
 		{
 			Validator:       abciValA,
@@ -105,7 +106,7 @@ func TestAllocateTokensToManyValidators(t *testing.T) {
 
 func TestAllocateTokensTruncation(t *testing.T) {
 	communityTax := sdk.NewDec(0)
-	ctx, _, _, k, sk, fck, _ := CreateTestInputAdvanced(t, false, 1000000, communityTax)
+	ctx, _, _, k, sk, _, supplyKeeper := CreateTestInputAdvanced(t, false, 1000000, communityTax)
 	sh := staking.NewHandler(sk)
 
 	// create validator with 10% commission
@@ -153,7 +154,8 @@ func TestAllocateTokensTruncation(t *testing.T) {
 	fees := sdk.Coins{
 		sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(634195840)),
 	}
-	fck.SetCollectedFees(fees)
+	require.NoError(t, supplyKeeper.SetModuleAccountCoins(ctx, k.feeCollectorName, fees))
+
 	votes := []abci.VoteInfo{
 		{
 			Validator:       abciValA,
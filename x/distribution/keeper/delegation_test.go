@@ -6,6 +6,7 @@ import (
 	"github.com/stretchr/testify/require"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/distribution/types"
 	"github.com/cosmos/cosmos-sdk/x/staking"
 )
 
@@ -263,7 +264,7 @@ func TestCalculateRewardsMultiDelegator(t *testing.T) {
 func TestWithdrawDelegationRewardsBasic(t *testing.T) {
 	balancePower := int64(1000)
 	balanceTokens := sdk.TokensFromTendermintPower(balancePower)
-	ctx, ak, k, sk, _ := CreateTestInputDefault(t, false, balancePower)
+	ctx, bk, k, sk, supplyKeeper := CreateTestInputDefault(t, false, balancePower)
 	sh := staking.NewHandler(sk)
 
 	// create validator with 50% commission
@@ -281,7 +282,7 @@ func TestWithdrawDelegationRewardsBasic(t *testing.T) {
 	expTokens := balanceTokens.Sub(valTokens)
 	require.Equal(t,
 		sdk.Coins{sdk.NewCoin(sdk.DefaultBondDenom, expTokens)},
-		ak.GetAccount(ctx, sdk.AccAddress(valOpAddr1)).GetCoins(),
+		bk.GetCoins(ctx, sdk.AccAddress(valOpAddr1)),
 	)
 
 	// end block to bond validator
@@ -297,6 +298,9 @@ func TestWithdrawDelegationRewardsBasic(t *testing.T) {
 	initial := sdk.TokensFromTendermintPower(10)
 	tokens := sdk.DecCoins{sdk.NewDecCoin(sdk.DefaultBondDenom, initial)}
 
+	// set module account coins
+	require.NoError(t, supplyKeeper.SetModuleAccountCoins(ctx, types.ModuleName, sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, initial))))
+
 	k.AllocateTokensToValidator(ctx, val, tokens)
 
 	// historical count should be 2 (initial + latest for delegation)
@@ -313,7 +317,7 @@ func TestWithdrawDelegationRewardsBasic(t *testing.T) {
 	exp := balanceTokens.Sub(valTokens).Add(initial.QuoRaw(2))
 	require.Equal(t,
 		sdk.Coins{sdk.NewCoin(sdk.DefaultBondDenom, exp)},
-		ak.GetAccount(ctx, sdk.AccAddress(valOpAddr1)).GetCoins(),
+		bk.GetCoins(ctx, sdk.AccAddress(valOpAddr1)),
 	)
 
 	// withdraw commission
@@ -324,7 +328,7 @@ func TestWithdrawDelegationRewardsBasic(t *testing.T) {
 	exp = balanceTokens.Sub(valTokens).Add(initial)
 	require.Equal(t,
 		sdk.Coins{sdk.NewCoin(sdk.DefaultBondDenom, exp)},
-		ak.GetAccount(ctx, sdk.AccAddress(valOpAddr1)).GetCoins(),
+		bk.GetCoins(ctx, sdk.AccAddress(valOpAddr1)),
 	)
 }
 
@@ -471,13 +475,16 @@ func TestCalculateRewardsMultiDelegatorMultiSlash(t *testing.T) {
 }
 
 func TestCalculateRewardsMultiDelegatorMultWithdraw(t *testing.T) {
-	ctx, _, k, sk, _ := CreateTestInputDefault(t, false, 1000)
+	ctx, _, k, sk, supplyKeeper := CreateTestInputDefault(t, false, 1000)
 	sh := staking.NewHandler(sk)
 
 	initial := int64(20)
 	totalRewards := sdk.DecCoins{sdk.NewDecCoinFromDec(sdk.DefaultBondDenom, sdk.NewDec(initial*2))}
 	tokens := sdk.DecCoins{sdk.NewDecCoinFromDec(sdk.DefaultBondDenom, sdk.NewDec(initial))}
 
+	// set module account coins for the rewards allocated below
+	require.NoError(t, supplyKeeper.SetModuleAccountCoins(ctx, types.ModuleName, sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(initial*4)))))
+
 	// create validator with 50% commission
 	commission := staking.NewCommissionRates(sdk.NewDecWithPrec(5, 1), sdk.NewDecWithPrec(5, 1), sdk.NewDec(0))
 	msg := staking.NewMsgCreateValidator(valOpAddr1, valConsPk1,
@@ -608,3 +615,69 @@ func TestCalculateRewardsMultiDelegatorMultWithdraw(t *testing.T) {
 	// commission should be zero
 	require.True(t, k.GetValidatorAccumulatedCommission(ctx, valOpAddr1).IsZero())
 }
+
+func TestTokenizedSharesRewards(t *testing.T) {
+	ctx, bk, k, sk, supplyKeeper := CreateTestInputDefault(t, false, 1000)
+	sh := staking.NewHandler(sk)
+
+	// create validator with 0% commission
+	valTokens := sdk.TokensFromTendermintPower(100)
+	commission := staking.NewCommissionRates(sdk.ZeroDec(), sdk.ZeroDec(), sdk.ZeroDec())
+	msg := staking.NewMsgCreateValidator(
+		valOpAddr1, valConsPk1,
+		sdk.NewCoin(sdk.DefaultBondDenom, valTokens),
+		staking.Description{}, commission, sdk.OneInt(),
+	)
+	require.True(t, sh(ctx, msg).IsOK())
+
+	// end block to bond validator
+	staking.EndBlocker(ctx, sk)
+
+	// next block
+	ctx = ctx.WithBlockHeight(ctx.BlockHeight() + 1)
+
+	// tokenize half of the self delegation and give the share tokens away
+	tokenizeTokens := valTokens.QuoRaw(2)
+	msgTokenize := staking.NewMsgTokenizeShares(valAccAddr1, valOpAddr1, sdk.NewCoin(sdk.DefaultBondDenom, tokenizeTokens))
+	require.True(t, sh(ctx, msgTokenize).IsOK())
+
+	shareTokens := sdk.NewCoin(staking.TokenizedSharesDenom(1), tokenizeTokens)
+	require.Equal(t, tokenizeTokens, bk.GetCoins(ctx, valAccAddr1).AmountOf(shareTokens.Denom))
+	require.NoError(t, bk.SendCoins(ctx, valAccAddr1, delAddr1, sdk.NewCoins(shareTokens)))
+
+	// next block
+	ctx = ctx.WithBlockHeight(ctx.BlockHeight() + 1)
+
+	// allocate rewards in the bond denom and in another denom
+	initial := sdk.TokensFromTendermintPower(10)
+	rewards := sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, initial), sdk.NewCoin("foocoin", initial))
+	require.NoError(t, supplyKeeper.SetModuleAccountCoins(ctx, types.ModuleName, rewards))
+	k.AllocateTokensToValidator(ctx, sk.Validator(ctx, valOpAddr1), sdk.NewDecCoins(rewards))
+
+	// redeem half of the share tokens
+	msgRedeem := staking.NewMsgRedeemTokensForShares(delAddr1, sdk.NewCoin(shareTokens.Denom, tokenizeTokens.QuoRaw(2)))
+	require.True(t, sh(ctx, msgRedeem).IsOK())
+
+	// the bond denom rewards of the tokenized shares were delegated back to
+	// the validator and the other rewards are paid on redemption
+	val := sk.Validator(ctx, valOpAddr1)
+	del := sk.Delegation(ctx, delAddr1, valOpAddr1)
+	require.Equal(t, initial.QuoRaw(4), bk.GetCoins(ctx, delAddr1).AmountOf("foocoin"))
+	require.Equal(t, tokenizeTokens.Add(initial.QuoRaw(2)).QuoRaw(2), val.TokensFromShares(del.GetShares()).TruncateInt())
+
+	// redeem the remaining share tokens
+	msgRedeem = staking.NewMsgRedeemTokensForShares(delAddr1, sdk.NewCoin(shareTokens.Denom, tokenizeTokens.QuoRaw(2)))
+	require.True(t, sh(ctx, msgRedeem).IsOK())
+
+	val = sk.Validator(ctx, valOpAddr1)
+	del = sk.Delegation(ctx, delAddr1, valOpAddr1)
+	require.Equal(t, initial.QuoRaw(2), bk.GetCoins(ctx, delAddr1).AmountOf("foocoin"))
+	require.Equal(t, tokenizeTokens.Add(initial.QuoRaw(2)), val.TokensFromShares(del.GetShares()).TruncateInt())
+	require.True(t, supplyKeeper.GetModuleAccountCoins(ctx, staking.TokenizedSharesPoolName).IsZero())
+	require.True(t, supplyKeeper.GetSupply(ctx).Total.AmountOf(shareTokens.Denom).IsZero())
+
+	// the operator keeps the rewards of the shares it did not tokenize
+	_, err := k.WithdrawDelegationRewards(ctx, valAccAddr1, valOpAddr1)
+	require.Nil(t, err)
+	require.Equal(t, initial.QuoRaw(2), bk.GetCoins(ctx, valAccAddr1).AmountOf("foocoin"))
+}
@@ -14,8 +14,8 @@ func (k Keeper) DistributeFeePool(ctx sdk.Context, amount sdk.Coins, receiveAddr
 		return types.ErrBadDistribution(k.codespace)
 	}
 
-	feePool.CommunityPool.Sub(sdk.NewDecCoins(amount))
-	_, err := k.bankKeeper.AddCoins(ctx, receiveAddr, amount)
+	feePool.CommunityPool = feePool.CommunityPool.Sub(sdk.NewDecCoins(amount))
+	err := k.supplyKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, receiveAddr, amount)
 	if err != nil {
 		return err
 	}
@@ -23,3 +23,18 @@ func (k Keeper) DistributeFeePool(ctx sdk.Context, amount sdk.Coins, receiveAddr
 	k.SetFeePool(ctx, feePool)
 	return nil
 }
+
+// FundCommunityPool transfers the given amount from the sender account to the
+// distribution module account and adds it to the community pool
+func (k Keeper) FundCommunityPool(ctx sdk.Context, amount sdk.Coins, sender sdk.AccAddress) sdk.Error {
+	err := k.supplyKeeper.SendCoinsFromAccountToModule(ctx, sender, types.ModuleName, amount)
+	if err != nil {
+		return err
+	}
+
+	feePool := k.GetFeePool(ctx)
+	feePool.CommunityPool = feePool.CommunityPool.Add(sdk.NewDecCoins(amount))
+	k.SetFeePool(ctx, feePool)
+
+	return nil
+}
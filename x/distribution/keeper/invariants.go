@@ -16,6 +16,8 @@ func RegisterInvariants(ir sdk.InvariantRouter, k Keeper) {
 		CanWithdrawInvariant(k))
 	ir.RegisterRoute(types.ModuleName, "reference-count",
 		ReferenceCountInvariant(k))
+	ir.RegisterRoute(types.ModuleName, "module-account",
+		ModuleAccountInvariant(k))
 }
 
 // AllInvariants runs all invariants of the distribution module
@@ -33,6 +35,10 @@ func AllInvariants(k Keeper) sdk.Invariant {
 		if err != nil {
 			return err
 		}
+		err = ModuleAccountInvariant(k)(ctx)
+		if err != nil {
+			return err
+		}
 		return nil
 	}
 }
@@ -135,3 +141,29 @@ func ReferenceCountInvariant(k Keeper) sdk.Invariant {
 		return nil
 	}
 }
+
+// ModuleAccountInvariant checks that the coins held by the distr ModuleAccount
+// is consistent with the sum of validator outstanding rewards
+func ModuleAccountInvariant(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) error {
+
+		var expectedCoins sdk.DecCoins
+		k.IterateValidatorOutstandingRewards(ctx, func(_ sdk.ValAddress, rewards types.ValidatorOutstandingRewards) (stop bool) {
+			expectedCoins = expectedCoins.Add(rewards)
+			return false
+		})
+
+		communityPool := k.GetFeePoolCommunityCoins(ctx)
+		expectedInt, _ := expectedCoins.Add(communityPool).TruncateDecimal()
+
+		maccCoins := k.supplyKeeper.GetModuleAccountCoins(ctx, types.ModuleName)
+
+		if !maccCoins.IsEqual(expectedInt) {
+			return fmt.Errorf("distribution ModuleAccount coins invariance:\n"+
+				"\texpected ModuleAccount coins: %s\n"+
+				"\tdistribution ModuleAccount coins : %s", expectedInt, maccCoins)
+		}
+
+		return nil
+	}
+}
@@ -1,8 +1,11 @@
 package keeper
 
 import (
+	"fmt"
+
 	"github.com/cosmos/cosmos-sdk/codec"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/auth"
 	"github.com/cosmos/cosmos-sdk/x/distribution/types"
 	"github.com/cosmos/cosmos-sdk/x/params"
 
@@ -11,28 +14,37 @@ import (
 
 // keeper of the staking store
 type Keeper struct {
-	storeKey            sdk.StoreKey
-	cdc                 *codec.Codec
-	paramSpace          params.Subspace
-	bankKeeper          types.BankKeeper
-	stakingKeeper       types.StakingKeeper
-	feeCollectionKeeper types.FeeCollectionKeeper
+	storeKey      sdk.StoreKey
+	cdc           *codec.Codec
+	paramSpace    params.Subspace
+	stakingKeeper types.StakingKeeper
+	supplyKeeper  types.SupplyKeeper
 
 	// codespace
 	codespace sdk.CodespaceType
+
+	// name of the module account the collected fees are held in
+	feeCollectorName string
 }
 
 // create a new keeper
-func NewKeeper(cdc *codec.Codec, key sdk.StoreKey, paramSpace params.Subspace, ck types.BankKeeper,
-	sk types.StakingKeeper, fck types.FeeCollectionKeeper, codespace sdk.CodespaceType) Keeper {
+func NewKeeper(cdc *codec.Codec, key sdk.StoreKey, paramSpace params.Subspace,
+	sk types.StakingKeeper, supplyKeeper types.SupplyKeeper, codespace sdk.CodespaceType,
+	feeCollectorName string) Keeper {
+
+	// ensure distribution module account is set
+	if addr := supplyKeeper.GetModuleAddress(types.ModuleName); addr == nil {
+		panic(fmt.Sprintf("%s module account has not been set", types.ModuleName))
+	}
+
 	keeper := Keeper{
-		storeKey:            key,
-		cdc:                 cdc,
-		paramSpace:          paramSpace.WithKeyTable(ParamKeyTable()),
-		bankKeeper:          ck,
-		stakingKeeper:       sk,
-		feeCollectionKeeper: fck,
-		codespace:           codespace,
+		storeKey:         key,
+		cdc:              cdc,
+		paramSpace:       paramSpace.WithKeyTable(ParamKeyTable()),
+		stakingKeeper:    sk,
+		supplyKeeper:     supplyKeeper,
+		codespace:        codespace,
+		feeCollectorName: feeCollectorName,
 	}
 	return keeper
 }
@@ -46,6 +58,13 @@ func (k Keeper) SetWithdrawAddr(ctx sdk.Context, delegatorAddr sdk.AccAddress, w
 		return types.ErrSetWithdrawAddrDisabled(k.codespace)
 	}
 
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeSetWithdrawAddress,
+			sdk.NewAttribute(types.AttributeKeyWithdrawAddress, withdrawAddr.String()),
+		),
+	)
+
 	k.SetDelegatorWithdrawAddr(ctx, delegatorAddr, withdrawAddr)
 
 	return nil
@@ -69,6 +88,14 @@ func (k Keeper) WithdrawDelegationRewards(ctx sdk.Context, delAddr sdk.AccAddres
 		return nil, err
 	}
 
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeWithdrawRewards,
+			sdk.NewAttribute(sdk.AttributeKeyAmount, rewards.String()),
+			sdk.NewAttribute(types.AttributeKeyValidator, valAddr.String()),
+		),
+	)
+
 	// reinitialize the delegation
 	k.initializeDelegation(ctx, valAddr, delAddr)
 
@@ -94,10 +121,24 @@ func (k Keeper) WithdrawValidatorCommission(ctx sdk.Context, valAddr sdk.ValAddr
 		accAddr := sdk.AccAddress(valAddr)
 		withdrawAddr := k.GetDelegatorWithdrawAddr(ctx, accAddr)
 
-		if _, err := k.bankKeeper.AddCoins(ctx, withdrawAddr, coins); err != nil {
+		err := k.supplyKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, withdrawAddr, coins)
+		if err != nil {
 			return nil, err
 		}
 	}
 
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeWithdrawCommission,
+			sdk.NewAttribute(sdk.AttributeKeyAmount, coins.String()),
+		),
+	)
+
 	return coins, nil
 }
+
+// GetDistributionAccount returns the distribution module account, which holds
+// the outstanding rewards and the community pool
+func (k Keeper) GetDistributionAccount(ctx sdk.Context) auth.ModuleAccountI {
+	return k.supplyKeeper.GetModuleAccount(ctx, types.ModuleName)
+}
@@ -6,6 +6,7 @@ import (
 	"github.com/stretchr/testify/require"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/distribution/types"
 )
 
 func TestSetWithdrawAddr(t *testing.T) {
@@ -23,7 +24,7 @@ func TestSetWithdrawAddr(t *testing.T) {
 }
 
 func TestWithdrawValidatorCommission(t *testing.T) {
-	ctx, ak, keeper, _, _ := CreateTestInputDefault(t, false, 1000)
+	ctx, bk, keeper, _, supplyKeeper := CreateTestInputDefault(t, false, 1000)
 
 	valCommission := sdk.DecCoins{
 		sdk.NewDecCoinFromDec("mytoken", sdk.NewDec(5).Quo(sdk.NewDec(4))),
@@ -31,12 +32,18 @@ func TestWithdrawValidatorCommission(t *testing.T) {
 	}
 
 	// check initial balance
-	balance := ak.GetAccount(ctx, sdk.AccAddress(valOpAddr3)).GetCoins()
+	balance := bk.GetCoins(ctx, sdk.AccAddress(valOpAddr3))
 	expTokens := sdk.TokensFromTendermintPower(1000)
 	require.Equal(t, sdk.Coins{
 		sdk.NewCoin("stake", sdk.TokensFromTendermintPower(1000)),
 	}, balance)
 
+	// set module account coins
+	require.NoError(t, supplyKeeper.SetModuleAccountCoins(ctx, types.ModuleName, sdk.NewCoins(
+		sdk.NewCoin("mytoken", sdk.NewInt(2)),
+		sdk.NewCoin("stake", sdk.NewInt(2)),
+	)))
+
 	// set outstanding rewards
 	keeper.SetValidatorOutstandingRewards(ctx, valOpAddr3, valCommission)
 
@@ -47,7 +54,7 @@ func TestWithdrawValidatorCommission(t *testing.T) {
 	keeper.WithdrawValidatorCommission(ctx, valOpAddr3)
 
 	// check balance increase
-	balance = ak.GetAccount(ctx, sdk.AccAddress(valOpAddr3)).GetCoins()
+	balance = bk.GetCoins(ctx, sdk.AccAddress(valOpAddr3))
 	require.Equal(t, sdk.Coins{
 		sdk.NewCoin("mytoken", sdk.NewInt(1)),
 		sdk.NewCoin("stake", expTokens.AddRaw(1)),
@@ -62,3 +69,20 @@ func TestWithdrawValidatorCommission(t *testing.T) {
 
 	require.True(t, true)
 }
+
+func TestFundCommunityPool(t *testing.T) {
+	ctx, bk, keeper, _, supplyKeeper := CreateTestInputDefault(t, false, 1000)
+
+	amount := sdk.NewCoins(sdk.NewCoin("stake", sdk.NewInt(100)))
+	initBalance := bk.GetCoins(ctx, delAddr1)
+	initPool := keeper.GetFeePoolCommunityCoins(ctx)
+	initModuleCoins := supplyKeeper.GetModuleAccountCoins(ctx, types.ModuleName)
+
+	require.Nil(t, keeper.FundCommunityPool(ctx, amount, delAddr1))
+	require.Equal(t, initBalance.Sub(amount), bk.GetCoins(ctx, delAddr1))
+	require.Equal(t, initPool.Add(sdk.NewDecCoins(amount)), keeper.GetFeePoolCommunityCoins(ctx))
+	require.Equal(t, initModuleCoins.Add(amount), supplyKeeper.GetModuleAccountCoins(ctx, types.ModuleName))
+
+	// funding with more than the sender's balance fails
+	require.NotNil(t, keeper.FundCommunityPool(ctx, initBalance, delAddr1))
+}
@@ -15,11 +15,13 @@ func HandleCommunityPoolSpendProposal(ctx sdk.Context, k Keeper, p types.Communi
 		return types.ErrBadDistribution(k.codespace)
 	}
 	feePool.CommunityPool = newPool
-	k.SetFeePool(ctx, feePool)
-	_, err := k.bankKeeper.AddCoins(ctx, p.Recipient, p.Amount)
+
+	err := k.supplyKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, p.Recipient, p.Amount)
 	if err != nil {
 		return err
 	}
+
+	k.SetFeePool(ctx, feePool)
 	logger := k.Logger(ctx)
 	logger.Info(fmt.Sprintf("Spent %s coins from the community pool to recipient %s", p.Amount, p.Recipient))
 	return nil
@@ -25,13 +25,16 @@ func testProposal(recipient sdk.AccAddress, amount sdk.Coins) types.CommunityPoo
 }
 
 func TestProposalHandlerPassed(t *testing.T) {
-	ctx, accountKeeper, keeper, _, _ := CreateTestInputDefault(t, false, 10)
+	ctx, bankKeeper, keeper, _, supplyKeeper := CreateTestInputDefault(t, false, 10)
 	recipient := delAddr1
 	amount := sdk.NewCoin("stake", sdk.NewInt(1))
 
-	account := accountKeeper.NewAccountWithAddress(ctx, recipient)
-	require.True(t, account.GetCoins().IsZero())
-	accountKeeper.SetAccount(ctx, account)
+	// add coins to the module account
+	macc := keeper.GetDistributionAccount(ctx)
+	err := supplyKeeper.SetModuleAccountCoins(ctx, macc.GetName(), sdk.NewCoins(amount))
+	require.NoError(t, err)
+
+	require.True(t, bankKeeper.GetCoins(ctx, recipient).IsZero())
 
 	feePool := keeper.GetFeePool(ctx)
 	feePool.CommunityPool = sdk.DecCoins{sdk.NewDecCoinFromCoin(amount)}
@@ -40,20 +43,18 @@ func TestProposalHandlerPassed(t *testing.T) {
 	tp := testProposal(recipient, sdk.NewCoins(amount))
 	hdlr := NewCommunityPoolSpendProposalHandler(keeper)
 	require.NoError(t, hdlr(ctx, tp))
-	require.Equal(t, accountKeeper.GetAccount(ctx, recipient).GetCoins(), sdk.NewCoins(amount))
+	require.Equal(t, bankKeeper.GetCoins(ctx, recipient), sdk.NewCoins(amount))
 }
 
 func TestProposalHandlerFailed(t *testing.T) {
-	ctx, accountKeeper, keeper, _, _ := CreateTestInputDefault(t, false, 10)
+	ctx, bankKeeper, keeper, _, _ := CreateTestInputDefault(t, false, 10)
 	recipient := delAddr1
 	amount := sdk.NewCoin("stake", sdk.NewInt(1))
 
-	account := accountKeeper.NewAccountWithAddress(ctx, recipient)
-	require.True(t, account.GetCoins().IsZero())
-	accountKeeper.SetAccount(ctx, account)
+	require.True(t, bankKeeper.GetCoins(ctx, recipient).IsZero())
 
 	tp := testProposal(recipient, sdk.NewCoins(amount))
 	hdlr := NewCommunityPoolSpendProposalHandler(keeper)
 	require.Error(t, hdlr(ctx, tp))
-	require.True(t, accountKeeper.GetAccount(ctx, recipient).GetCoins().IsZero())
+	require.True(t, bankKeeper.GetCoins(ctx, recipient).IsZero())
 }
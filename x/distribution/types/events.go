@@ -0,0 +1,16 @@
+package types
+
+// distribution module event types
+const (
+	EventTypeSetWithdrawAddress = "set_withdraw_address"
+	EventTypeRewards            = "rewards"
+	EventTypeCommission         = "commission"
+	EventTypeWithdrawRewards    = "withdraw_rewards"
+	EventTypeWithdrawCommission = "withdraw_commission"
+	EventTypeProposerReward     = "proposer_reward"
+
+	AttributeKeyWithdrawAddress = "withdraw_address"
+	AttributeKeyValidator       = "validator"
+
+	AttributeValueCategory = ModuleName
+)
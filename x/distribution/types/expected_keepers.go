@@ -2,6 +2,7 @@ package types
 
 import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/auth"
 	"github.com/cosmos/cosmos-sdk/x/staking"
 	"github.com/cosmos/cosmos-sdk/x/staking/exported"
 )
@@ -23,7 +24,7 @@ type StakingKeeper interface {
 	Validator(sdk.Context, sdk.ValAddress) exported.ValidatorI            // get a particular validator by operator address
 	ValidatorByConsAddr(sdk.Context, sdk.ConsAddress) exported.ValidatorI // get a particular validator by consensus address
 	TotalBondedTokens(sdk.Context) sdk.Int                                // total bonded tokens within the validator set
-	TotalTokens(sdk.Context) sdk.Int                                      // total token supply
+	StakingTokenSupply(sdk.Context) sdk.Int                               // total staking token supply
 
 	// slash the validator and delegators of the validator, specifying offence height, offence power, and slash fraction
 	Slash(sdk.Context, sdk.ConsAddress, int64, int64, sdk.Dec)
@@ -57,13 +58,17 @@ type StakingHooks interface {
 	BeforeValidatorSlashed(ctx sdk.Context, valAddr sdk.ValAddress, fraction sdk.Dec)
 }
 
-// expected coin keeper
-type BankKeeper interface {
-	AddCoins(ctx sdk.Context, addr sdk.AccAddress, amt sdk.Coins) (sdk.Coins, sdk.Error)
-}
+// SupplyKeeper defines the expected supply keeper (noalias)
+type SupplyKeeper interface {
+	GetModuleAddress(name string) sdk.AccAddress
+	GetModuleAccount(ctx sdk.Context, name string) auth.ModuleAccountI
+	GetModuleAccountCoins(ctx sdk.Context, moduleName string) sdk.Coins
+
+	// used to set the initial module account balance at genesis
+	SetModuleAccount(sdk.Context, auth.ModuleAccountI)
+	SetModuleAccountCoins(ctx sdk.Context, moduleName string, amt sdk.Coins) sdk.Error
 
-// expected fee collection keeper
-type FeeCollectionKeeper interface {
-	GetCollectedFees(ctx sdk.Context) sdk.Coins
-	ClearCollectedFees(ctx sdk.Context)
+	SendCoinsFromModuleToModule(ctx sdk.Context, senderModule string, recipientModule string, amt sdk.Coins) sdk.Error
+	SendCoinsFromModuleToAccount(ctx sdk.Context, senderModule string, recipientAddr sdk.AccAddress, amt sdk.Coins) sdk.Error
+	SendCoinsFromAccountToModule(ctx sdk.Context, senderAddr sdk.AccAddress, recipientModule string, amt sdk.Coins) sdk.Error
 }
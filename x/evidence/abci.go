@@ -0,0 +1,28 @@
+package evidence
+
+import (
+	"fmt"
+
+	abci "github.com/tendermint/tendermint/abci/types"
+	tmtypes "github.com/tendermint/tendermint/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// BeginBlocker routes the evidence of misbehaviour reported by Tendermint
+// through the evidence router, so that it is handled the same way as the
+// evidence submitted in transactions.
+func BeginBlocker(ctx sdk.Context, req abci.RequestBeginBlock, k Keeper) {
+	for _, tmEvidence := range req.ByzantineValidators {
+		switch tmEvidence.Type {
+		case tmtypes.ABCIEvidenceTypeDuplicateVote:
+			evidence := ConvertDuplicateVoteEvidence(tmEvidence)
+			if err := k.SubmitEvidence(ctx, evidence); err != nil {
+				k.Logger(ctx).Error(fmt.Sprintf("failed to handle evidence %s: %s", evidence.Hash(), err))
+			}
+
+		default:
+			k.Logger(ctx).Error(fmt.Sprintf("ignored unknown evidence type: %s", tmEvidence.Type))
+		}
+	}
+}
@@ -0,0 +1,69 @@
+// nolint
+// autogenerated code using github.com/rigelrozanski/multitool
+// aliases generated for the following subdirectories:
+// ALIASGEN: github.com/cosmos/cosmos-sdk/x/evidence/keeper
+// ALIASGEN: github.com/cosmos/cosmos-sdk/x/evidence/types
+package evidence
+
+import (
+	"github.com/cosmos/cosmos-sdk/x/evidence/keeper"
+	"github.com/cosmos/cosmos-sdk/x/evidence/types"
+)
+
+const (
+	DefaultCodespace            = types.DefaultCodespace
+	CodeNoEvidenceHandlerExists = types.CodeNoEvidenceHandlerExists
+	CodeInvalidEvidence         = types.CodeInvalidEvidence
+	CodeEvidenceExists          = types.CodeEvidenceExists
+	CodeUnknownEvidence         = types.CodeUnknownEvidence
+	RouteEquivocation           = types.RouteEquivocation
+	TypeEquivocation            = types.TypeEquivocation
+	EventTypeSubmitEvidence     = types.EventTypeSubmitEvidence
+	AttributeKeyEvidenceHash    = types.AttributeKeyEvidenceHash
+	AttributeKeyEvidenceRoute   = types.AttributeKeyEvidenceRoute
+	AttributeValueCategory      = types.AttributeValueCategory
+	ModuleName                  = types.ModuleName
+	StoreKey                    = types.StoreKey
+	RouterKey                   = types.RouterKey
+	QuerierRoute                = types.QuerierRoute
+	QueryEvidence               = types.QueryEvidence
+	QueryAllEvidence            = types.QueryAllEvidence
+	TypeMsgSubmitEvidence       = types.TypeMsgSubmitEvidence
+)
+
+var (
+	// functions aliases
+	NewKeeper                    = keeper.NewKeeper
+	NewQuerier                   = keeper.NewQuerier
+	RegisterCodec                = types.RegisterCodec
+	RegisterEvidenceTypeCodec    = types.RegisterEvidenceTypeCodec
+	NewEquivocation              = types.NewEquivocation
+	ConvertDuplicateVoteEvidence = types.ConvertDuplicateVoteEvidence
+	ErrNoEvidenceHandlerExists   = types.ErrNoEvidenceHandlerExists
+	ErrInvalidEvidence           = types.ErrInvalidEvidence
+	ErrEvidenceExists            = types.ErrEvidenceExists
+	ErrUnknownEvidence           = types.ErrUnknownEvidence
+	NewGenesisState              = types.NewGenesisState
+	DefaultGenesisState          = types.DefaultGenesisState
+	GetEvidenceKey               = types.GetEvidenceKey
+	NewMsgSubmitEvidence         = types.NewMsgSubmitEvidence
+	NewQueryEvidenceParams       = types.NewQueryEvidenceParams
+	NewRouter                    = types.NewRouter
+
+	// variable aliases
+	ModuleCdc         = types.ModuleCdc
+	EvidenceKeyPrefix = types.EvidenceKeyPrefix
+)
+
+type (
+	Keeper              = keeper.Keeper
+	Evidence            = types.Evidence
+	ValidatorEvidence   = types.ValidatorEvidence
+	EvidenceList        = types.EvidenceList
+	Handler             = types.Handler
+	Router              = types.Router
+	Equivocation        = types.Equivocation
+	GenesisState        = types.GenesisState
+	MsgSubmitEvidence   = types.MsgSubmitEvidence
+	QueryEvidenceParams = types.QueryEvidenceParams
+)
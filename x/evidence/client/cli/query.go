@@ -0,0 +1,113 @@
+package cli
+
+import (
+	"encoding/hex"
+	"fmt"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/context"
+	"github.com/cosmos/cosmos-sdk/client/utils"
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/version"
+	"github.com/cosmos/cosmos-sdk/x/evidence/types"
+)
+
+// GetQueryCmd returns the cli query commands for this module
+func GetQueryCmd(cdc *codec.Codec) *cobra.Command {
+	// Group evidence queries under a subcommand
+	evidenceQueryCmd := &cobra.Command{
+		Use:                        types.ModuleName,
+		Short:                      "Querying commands for the evidence module",
+		DisableFlagParsing:         true,
+		SuggestionsMinimumDistance: 2,
+		RunE:                       utils.ValidateCmd,
+	}
+
+	evidenceQueryCmd.AddCommand(client.GetCommands(
+		GetCmdQueryEvidence(cdc),
+		GetCmdQueryAllEvidence(cdc),
+	)...)
+
+	return evidenceQueryCmd
+}
+
+// GetCmdQueryEvidence implements the query evidence command.
+func GetCmdQueryEvidence(cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "evidence [hash]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Query a piece of evidence by hash",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query a piece of evidence which was handled by its hash.
+
+Example:
+$ %s query %s evidence DF0C23E8634E480F84B9D5674A7CDC9816466DEC28A3358F73260F68D28D7660
+`,
+				version.ClientName, types.ModuleName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			hash, err := hex.DecodeString(args[0])
+			if err != nil {
+				return fmt.Errorf("invalid evidence hash: %s", err)
+			}
+
+			bz, err := cdc.MarshalJSON(types.NewQueryEvidenceParams(hash))
+			if err != nil {
+				return err
+			}
+
+			route := fmt.Sprintf("custom/%s/%s", types.QuerierRoute, types.QueryEvidence)
+			res, err := cliCtx.QueryWithData(route, bz)
+			if err != nil {
+				return err
+			}
+
+			var evidence types.Evidence
+			if err := cdc.UnmarshalJSON(res, &evidence); err != nil {
+				return err
+			}
+
+			return cliCtx.PrintOutput(evidence)
+		},
+	}
+}
+
+// GetCmdQueryAllEvidence implements the query all evidence command.
+func GetCmdQueryAllEvidence(cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "all",
+		Args:  cobra.NoArgs,
+		Short: "Query all the evidence which was handled",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query all the evidence which was handled.
+
+Example:
+$ %s query %s all
+`,
+				version.ClientName, types.ModuleName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			route := fmt.Sprintf("custom/%s/%s", types.QuerierRoute, types.QueryAllEvidence)
+			res, err := cliCtx.QueryWithData(route, nil)
+			if err != nil {
+				return err
+			}
+
+			var evidence types.EvidenceList
+			if err := cdc.UnmarshalJSON(res, &evidence); err != nil {
+				return err
+			}
+
+			return cliCtx.PrintOutput(evidence)
+		},
+	}
+}
@@ -0,0 +1,76 @@
+package cli
+
+import (
+	"fmt"
+	"io/ioutil"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/context"
+	"github.com/cosmos/cosmos-sdk/client/utils"
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/version"
+	"github.com/cosmos/cosmos-sdk/x/auth"
+	"github.com/cosmos/cosmos-sdk/x/evidence/types"
+)
+
+// GetTxCmd returns the transaction commands for this module
+func GetTxCmd(cdc *codec.Codec) *cobra.Command {
+	evidenceTxCmd := &cobra.Command{
+		Use:                        types.ModuleName,
+		Short:                      "Evidence transactions subcommands",
+		DisableFlagParsing:         true,
+		SuggestionsMinimumDistance: 2,
+		RunE:                       utils.ValidateCmd,
+	}
+
+	evidenceTxCmd.AddCommand(client.PostCommands(
+		GetCmdSubmitEvidence(cdc),
+	)...)
+
+	return evidenceTxCmd
+}
+
+// GetCmdSubmitEvidence implements the submit evidence command.
+func GetCmdSubmitEvidence(cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "submit [evidence-file]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Submit a piece of evidence of misbehaviour",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Submit a piece of evidence of misbehaviour. The evidence must be supplied
+via a JSON file, in the Amino JSON encoding of one of the evidence types
+registered by the application.
+
+Example:
+$ %s tx %s submit <path/to/evidence.json> --from mykey
+`,
+				version.ClientName, types.ModuleName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			txBldr := auth.NewTxBuilderFromCLI().WithTxEncoder(utils.GetTxEncoder(cdc))
+			cliCtx := context.NewCLIContext().WithCodec(cdc).WithAccountDecoder(cdc)
+
+			contents, err := ioutil.ReadFile(args[0])
+			if err != nil {
+				return err
+			}
+
+			var evidence types.Evidence
+			if err := cdc.UnmarshalJSON(contents, &evidence); err != nil {
+				return err
+			}
+
+			msg := types.NewMsgSubmitEvidence(evidence, cliCtx.GetFromAddress())
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return utils.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
+		},
+	}
+}
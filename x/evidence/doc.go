@@ -0,0 +1,21 @@
+/*
+Package evidence provides a generic way to submit and handle evidence of
+misbehaviour, such as a validator double-signing.
+
+Each piece of evidence implements the Evidence interface, and is routed by its
+Route to the Handler registered for it on the evidence Router. The Handler
+verifies the evidence and punishes the misbehaviour; its state changes are
+discarded if it fails. Handled evidence is stored by hash, so that the same
+evidence cannot be handled twice.
+
+Evidence is submitted through MsgSubmitEvidence. The duplicate vote evidence
+reported by Tendermint is converted into Equivocation evidence in BeginBlock and
+routed the same way, e.g. to the slashing module which registers a Handler for
+the "equivocation" route. As the signatures of the conflicting votes are not
+part of it, Equivocation evidence cannot be submitted in a transaction.
+
+Modules defining their own evidence types must register them with
+RegisterEvidenceTypeCodec, so that MsgSubmitEvidence can be Amino encoded and
+decoded.
+*/
+package evidence
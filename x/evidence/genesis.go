@@ -0,0 +1,35 @@
+package evidence
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// InitGenesis stores the evidence submitted before genesis, so that it cannot
+// be handled again.
+func InitGenesis(ctx sdk.Context, k Keeper, data GenesisState) {
+	for _, evidence := range data.Evidence {
+		if _, found := k.GetEvidence(ctx, evidence.Hash()); found {
+			panic(fmt.Sprintf("evidence with hash %s already exists", evidence.Hash()))
+		}
+
+		k.SetEvidence(ctx, evidence)
+	}
+}
+
+// ExportGenesis returns a GenesisState for a given context and keeper.
+func ExportGenesis(ctx sdk.Context, k Keeper) GenesisState {
+	evidence := k.GetAllEvidence(ctx)
+	if evidence == nil {
+		evidence = []Evidence{}
+	}
+
+	return NewGenesisState(evidence)
+}
+
+// ValidateGenesis performs basic validation of evidence genesis data returning
+// an error for any failed validation criteria.
+func ValidateGenesis(data GenesisState) error {
+	return data.ValidateBasic()
+}
@@ -0,0 +1,40 @@
+package evidence
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// NewHandler returns a handler for evidence messages
+func NewHandler(k Keeper) sdk.Handler {
+	return func(ctx sdk.Context, msg sdk.Msg) sdk.Result {
+		switch msg := msg.(type) {
+		case MsgSubmitEvidence:
+			return handleMsgSubmitEvidence(ctx, k, msg)
+
+		default:
+			errMsg := fmt.Sprintf("unrecognized evidence message type: %T", msg)
+			return sdk.ErrUnknownRequest(errMsg).Result()
+		}
+	}
+}
+
+func handleMsgSubmitEvidence(ctx sdk.Context, k Keeper, msg MsgSubmitEvidence) sdk.Result {
+	if err := k.SubmitEvidence(ctx, msg.Evidence); err != nil {
+		return err.Result()
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.Submitter.String()),
+		),
+	)
+
+	return sdk.Result{
+		Data:   msg.Evidence.Hash(),
+		Events: ctx.EventManager().Events(),
+	}
+}
@@ -0,0 +1,114 @@
+package keeper
+
+import (
+	"fmt"
+
+	cmn "github.com/tendermint/tendermint/libs/common"
+	"github.com/tendermint/tendermint/libs/log"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/evidence/types"
+)
+
+// Keeper stores the evidence of misbehaviour which was handled, and routes
+// new evidence to the Handler registered for its route.
+type Keeper struct {
+	cdc      *codec.Codec
+	storeKey sdk.StoreKey
+	router   types.Router
+}
+
+// NewKeeper creates a new evidence Keeper instance. The router is sealed, so
+// all the evidence handlers must be registered beforehand.
+func NewKeeper(cdc *codec.Codec, storeKey sdk.StoreKey, router types.Router) Keeper {
+	// It is vital to seal the evidence router here as to not allow further
+	// handlers to be registered after the keeper is created since this
+	// could create invalid or non-deterministic behavior.
+	router.Seal()
+
+	return Keeper{
+		cdc:      cdc,
+		storeKey: storeKey,
+		router:   router,
+	}
+}
+
+// Logger returns a module-specific logger.
+func (k Keeper) Logger(ctx sdk.Context) log.Logger {
+	return ctx.Logger().With("module", fmt.Sprintf("x/%s", types.ModuleName))
+}
+
+// SubmitEvidence routes the evidence to the Handler registered for its route
+// and stores it once handled, so that the same evidence cannot be handled
+// twice. The state changes of the Handler are discarded if it fails.
+func (k Keeper) SubmitEvidence(ctx sdk.Context, evidence types.Evidence) sdk.Error {
+	if _, found := k.GetEvidence(ctx, evidence.Hash()); found {
+		return types.ErrEvidenceExists(types.DefaultCodespace, evidence.Hash())
+	}
+	if !k.router.HasRoute(evidence.Route()) {
+		return types.ErrNoEvidenceHandlerExists(types.DefaultCodespace, evidence.Route())
+	}
+
+	cacheCtx, writeCache := ctx.CacheContext()
+	handler := k.router.GetRoute(evidence.Route())
+	if err := handler(cacheCtx, evidence); err != nil {
+		return types.ErrInvalidEvidence(types.DefaultCodespace, err.Error())
+	}
+	writeCache()
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeSubmitEvidence,
+			sdk.NewAttribute(types.AttributeKeyEvidenceHash, evidence.Hash().String()),
+			sdk.NewAttribute(types.AttributeKeyEvidenceRoute, evidence.Route()),
+		),
+	)
+
+	k.SetEvidence(ctx, evidence)
+	return nil
+}
+
+// SetEvidence stores the evidence under its hash, without handling it.
+func (k Keeper) SetEvidence(ctx sdk.Context, evidence types.Evidence) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.GetEvidenceKey(evidence.Hash()), k.cdc.MustMarshalBinaryLengthPrefixed(evidence))
+}
+
+// GetEvidence returns the evidence stored under the given hash.
+func (k Keeper) GetEvidence(ctx sdk.Context, hash cmn.HexBytes) (evidence types.Evidence, found bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.GetEvidenceKey(hash))
+	if bz == nil {
+		return nil, false
+	}
+
+	k.cdc.MustUnmarshalBinaryLengthPrefixed(bz, &evidence)
+	return evidence, true
+}
+
+// IterateEvidence iterates over all the stored evidence, calling the provided
+// function until it returns true.
+func (k Keeper) IterateEvidence(ctx sdk.Context, cb func(evidence types.Evidence) (stop bool)) {
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, types.EvidenceKeyPrefix)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var evidence types.Evidence
+		k.cdc.MustUnmarshalBinaryLengthPrefixed(iterator.Value(), &evidence)
+
+		if cb(evidence) {
+			break
+		}
+	}
+}
+
+// GetAllEvidence returns all the stored evidence.
+func (k Keeper) GetAllEvidence(ctx sdk.Context) (evidence []types.Evidence) {
+	k.IterateEvidence(ctx, func(e types.Evidence) bool {
+		evidence = append(evidence, e)
+		return false
+	})
+	return evidence
+}
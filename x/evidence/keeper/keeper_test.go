@@ -0,0 +1,171 @@
+package keeper
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	abci "github.com/tendermint/tendermint/abci/types"
+	"github.com/tendermint/tendermint/crypto/ed25519"
+	"github.com/tendermint/tendermint/crypto/tmhash"
+	cmn "github.com/tendermint/tendermint/libs/common"
+	dbm "github.com/tendermint/tendermint/libs/db"
+	"github.com/tendermint/tendermint/libs/log"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/store"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/evidence/types"
+)
+
+const routeTest = "test"
+
+var (
+	keyHandled = []byte("handled")
+
+	submitterAddr = sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address())
+)
+
+// testEvidence is handled by the test handler, which fails if Invalid is set.
+type testEvidence struct {
+	Height  int64 `json:"height"`
+	Invalid bool  `json:"invalid"`
+}
+
+var _ types.Evidence = testEvidence{}
+
+func (e testEvidence) Route() string            { return routeTest }
+func (e testEvidence) Type() string             { return "test" }
+func (e testEvidence) String() string           { return fmt.Sprintf("test evidence at height %d", e.Height) }
+func (e testEvidence) ValidateBasic() sdk.Error { return nil }
+func (e testEvidence) GetHeight() int64         { return e.Height }
+func (e testEvidence) Hash() cmn.HexBytes {
+	return tmhash.Sum(types.ModuleCdc.MustMarshalBinaryBare(e))
+}
+
+func createTestInput(t *testing.T, routes map[string]types.Handler) (sdk.Context, sdk.StoreKey, Keeper) {
+	keyEvidence := sdk.NewKVStoreKey(types.StoreKey)
+	keyTest := sdk.NewKVStoreKey(routeTest)
+
+	db := dbm.NewMemDB()
+	ms := store.NewCommitMultiStore(db)
+	ms.MountStoreWithDB(keyEvidence, sdk.StoreTypeIAVL, db)
+	ms.MountStoreWithDB(keyTest, sdk.StoreTypeIAVL, db)
+	require.NoError(t, ms.LoadLatestVersion())
+
+	cdc := codec.New()
+	types.RegisterCodec(cdc)
+	cdc.RegisterConcrete(testEvidence{}, "cosmos-sdk/testEvidence", nil)
+
+	ctx := sdk.NewContext(ms, abci.Header{ChainID: "evidence-chain", Height: 10, Time: time.Now()}, false, log.NewNopLogger())
+
+	router := types.NewRouter()
+	for route, handler := range routes {
+		router.AddRoute(route, handler)
+	}
+
+	return ctx, keyTest, NewKeeper(cdc, keyEvidence, router)
+}
+
+func init() {
+	types.RegisterEvidenceTypeCodec(testEvidence{}, "cosmos-sdk/testEvidence")
+}
+
+func TestKeeperSubmitEvidence(t *testing.T) {
+	var keyTest sdk.StoreKey
+	handler := func(ctx sdk.Context, evidence types.Evidence) sdk.Error {
+		// the state changes of a failing handler must be discarded
+		ctx.KVStore(keyTest).Set(keyHandled, []byte{1})
+
+		if evidence.(testEvidence).Invalid {
+			return sdk.ErrUnknownRequest("invalid test evidence")
+		}
+		return nil
+	}
+
+	ctx, keyTest, k := createTestInput(t, map[string]types.Handler{routeTest: handler})
+
+	// failing handler
+	invalid := testEvidence{Height: 1, Invalid: true}
+	err := k.SubmitEvidence(ctx, invalid)
+	require.Error(t, err)
+	require.Equal(t, types.CodeInvalidEvidence, err.Code())
+	require.False(t, ctx.KVStore(keyTest).Has(keyHandled))
+	_, found := k.GetEvidence(ctx, invalid.Hash())
+	require.False(t, found)
+
+	// handled evidence is stored
+	evidence := testEvidence{Height: 1}
+	require.Nil(t, k.SubmitEvidence(ctx, evidence))
+	require.True(t, ctx.KVStore(keyTest).Has(keyHandled))
+
+	stored, found := k.GetEvidence(ctx, evidence.Hash())
+	require.True(t, found)
+	require.Equal(t, evidence, stored)
+
+	// the same evidence cannot be handled twice
+	err = k.SubmitEvidence(ctx, evidence)
+	require.Error(t, err)
+	require.Equal(t, types.CodeEvidenceExists, err.Code())
+
+	require.Nil(t, k.SubmitEvidence(ctx, testEvidence{Height: 2}))
+	require.Len(t, k.GetAllEvidence(ctx), 2)
+}
+
+func TestKeeperSubmitEvidenceNoHandler(t *testing.T) {
+	ctx, _, k := createTestInput(t, map[string]types.Handler{})
+
+	consAddr := sdk.ConsAddress(ed25519.GenPrivKey().PubKey().Address())
+	evidence := types.NewEquivocation(1, time.Now(), 100, consAddr)
+
+	err := k.SubmitEvidence(ctx, evidence)
+	require.Error(t, err)
+	require.Equal(t, types.CodeNoEvidenceHandlerExists, err.Code())
+	require.Empty(t, k.GetAllEvidence(ctx))
+}
+
+func TestQuerier(t *testing.T) {
+	handler := func(ctx sdk.Context, evidence types.Evidence) sdk.Error { return nil }
+	ctx, _, k := createTestInput(t, map[string]types.Handler{routeTest: handler})
+	querier := NewQuerier(k)
+
+	evidence := testEvidence{Height: 1}
+	require.Nil(t, k.SubmitEvidence(ctx, evidence))
+
+	bz, err := k.cdc.MarshalJSON(types.NewQueryEvidenceParams(evidence.Hash()))
+	require.NoError(t, err)
+
+	res, sdkErr := querier(ctx, []string{types.QueryEvidence}, abci.RequestQuery{Data: bz})
+	require.Nil(t, sdkErr)
+
+	var queried types.Evidence
+	require.NoError(t, k.cdc.UnmarshalJSON(res, &queried))
+	require.Equal(t, evidence, queried)
+
+	bz, err = k.cdc.MarshalJSON(types.NewQueryEvidenceParams(testEvidence{Height: 2}.Hash()))
+	require.NoError(t, err)
+	_, sdkErr = querier(ctx, []string{types.QueryEvidence}, abci.RequestQuery{Data: bz})
+	require.Error(t, sdkErr)
+
+	res, sdkErr = querier(ctx, []string{types.QueryAllEvidence}, abci.RequestQuery{})
+	require.Nil(t, sdkErr)
+
+	var all types.EvidenceList
+	require.NoError(t, k.cdc.UnmarshalJSON(res, &all))
+	require.Equal(t, types.EvidenceList{evidence}, all)
+}
+
+func TestMsgSubmitEvidenceValidateBasic(t *testing.T) {
+	consAddr := sdk.ConsAddress(ed25519.GenPrivKey().PubKey().Address())
+
+	require.Nil(t, types.NewMsgSubmitEvidence(testEvidence{Height: 1}, submitterAddr).ValidateBasic())
+	require.Error(t, types.NewMsgSubmitEvidence(testEvidence{Height: 1}, nil).ValidateBasic())
+	require.Error(t, types.NewMsgSubmitEvidence(nil, submitterAddr).ValidateBasic())
+
+	// equivocations are only accepted from Tendermint
+	equivocation := types.NewEquivocation(1, time.Now(), 100, consAddr)
+	require.Nil(t, equivocation.ValidateBasic())
+	require.Error(t, types.NewMsgSubmitEvidence(equivocation, submitterAddr).ValidateBasic())
+}
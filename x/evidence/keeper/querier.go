@@ -0,0 +1,60 @@
+package keeper
+
+import (
+	abci "github.com/tendermint/tendermint/abci/types"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/evidence/types"
+)
+
+// NewQuerier creates a querier for evidence REST endpoints
+func NewQuerier(k Keeper) sdk.Querier {
+	return func(ctx sdk.Context, path []string, req abci.RequestQuery) ([]byte, sdk.Error) {
+		switch path[0] {
+		case types.QueryEvidence:
+			return queryEvidence(ctx, req, k)
+
+		case types.QueryAllEvidence:
+			return queryAllEvidence(ctx, k)
+
+		default:
+			return nil, sdk.ErrUnknownRequest("unknown evidence query endpoint")
+		}
+	}
+}
+
+func queryEvidence(ctx sdk.Context, req abci.RequestQuery, k Keeper) ([]byte, sdk.Error) {
+	var params types.QueryEvidenceParams
+
+	err := k.cdc.UnmarshalJSON(req.Data, &params)
+	if err != nil {
+		return nil, sdk.ErrUnknownRequest(sdk.AppendMsgToErr("incorrectly formatted request data", err.Error()))
+	}
+
+	evidence, found := k.GetEvidence(ctx, params.EvidenceHash)
+	if !found {
+		return nil, types.ErrUnknownEvidence(types.DefaultCodespace, params.EvidenceHash)
+	}
+
+	res, err := codec.MarshalJSONIndent(k.cdc, evidence)
+	if err != nil {
+		return nil, sdk.ErrInternal(sdk.AppendMsgToErr("failed to JSON marshal result: %s", err.Error()))
+	}
+
+	return res, nil
+}
+
+func queryAllEvidence(ctx sdk.Context, k Keeper) ([]byte, sdk.Error) {
+	evidence := k.GetAllEvidence(ctx)
+	if evidence == nil {
+		evidence = []types.Evidence{}
+	}
+
+	res, err := codec.MarshalJSONIndent(k.cdc, evidence)
+	if err != nil {
+		return nil, sdk.ErrInternal(sdk.AppendMsgToErr("failed to JSON marshal result: %s", err.Error()))
+	}
+
+	return res, nil
+}
@@ -0,0 +1,29 @@
+package types
+
+import (
+	"github.com/cosmos/cosmos-sdk/codec"
+)
+
+// ModuleCdc is the generic codec used throughout the module. It is left
+// unsealed so that other modules can register their evidence types.
+var ModuleCdc = codec.New()
+
+// RegisterCodec registers the evidence module types on the given codec.
+func RegisterCodec(cdc *codec.Codec) {
+	cdc.RegisterInterface((*Evidence)(nil), nil)
+	cdc.RegisterConcrete(Equivocation{}, "cosmos-sdk/Equivocation", nil)
+
+	cdc.RegisterConcrete(MsgSubmitEvidence{}, "cosmos-sdk/MsgSubmitEvidence", nil)
+}
+
+// RegisterEvidenceTypeCodec registers an external evidence type defined in
+// another module for the internal ModuleCdc. This allows the MsgSubmitEvidence
+// to be correctly Amino encoded and decoded.
+func RegisterEvidenceTypeCodec(o interface{}, name string) {
+	ModuleCdc.RegisterConcrete(o, name, nil)
+}
+
+func init() {
+	RegisterCodec(ModuleCdc)
+	codec.RegisterCrypto(ModuleCdc)
+}
@@ -0,0 +1,97 @@
+package types
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	abci "github.com/tendermint/tendermint/abci/types"
+	"github.com/tendermint/tendermint/crypto/tmhash"
+	cmn "github.com/tendermint/tendermint/libs/common"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// Evidence route and type of Equivocation
+const (
+	RouteEquivocation = "equivocation"
+	TypeEquivocation  = "equivocation"
+)
+
+var _ ValidatorEvidence = Equivocation{}
+
+// Equivocation implements the Evidence of a validator signing two conflicting
+// votes at the same height and round (double-signing), as reported by
+// Tendermint.
+type Equivocation struct {
+	Height           int64           `json:"height"`
+	Time             time.Time       `json:"time"`
+	Power            int64           `json:"power"`
+	ConsensusAddress sdk.ConsAddress `json:"consensus_address"`
+}
+
+// NewEquivocation creates a new Equivocation instance.
+func NewEquivocation(height int64, t time.Time, power int64, consAddr sdk.ConsAddress) Equivocation {
+	return Equivocation{
+		Height:           height,
+		Time:             t,
+		Power:            power,
+		ConsensusAddress: consAddr,
+	}
+}
+
+// ConvertDuplicateVoteEvidence converts the duplicate vote evidence reported
+// by Tendermint into Equivocation evidence.
+func ConvertDuplicateVoteEvidence(evidence abci.Evidence) Equivocation {
+	return NewEquivocation(evidence.Height, evidence.Time, evidence.Validator.Power,
+		sdk.ConsAddress(evidence.Validator.Address))
+}
+
+// Route implements Evidence
+func (e Equivocation) Route() string { return RouteEquivocation }
+
+// Type implements Evidence
+func (e Equivocation) Type() string { return TypeEquivocation }
+
+// Hash implements Evidence
+func (e Equivocation) Hash() cmn.HexBytes {
+	return tmhash.Sum(ModuleCdc.MustMarshalBinaryBare(e))
+}
+
+// ValidateBasic implements Evidence
+func (e Equivocation) ValidateBasic() sdk.Error {
+	if e.Height < 1 {
+		return ErrInvalidEvidence(DefaultCodespace, fmt.Sprintf("invalid equivocation height: %d", e.Height))
+	}
+	if e.Time.IsZero() {
+		return ErrInvalidEvidence(DefaultCodespace, "equivocation time cannot be zero")
+	}
+	if e.Power < 1 {
+		return ErrInvalidEvidence(DefaultCodespace, fmt.Sprintf("invalid equivocation validator power: %d", e.Power))
+	}
+	if e.ConsensusAddress.Empty() {
+		return ErrInvalidEvidence(DefaultCodespace, "equivocation validator address cannot be empty")
+	}
+	return nil
+}
+
+// GetHeight implements Evidence
+func (e Equivocation) GetHeight() int64 { return e.Height }
+
+// GetTime returns the time at which the infraction occurred.
+func (e Equivocation) GetTime() time.Time { return e.Time }
+
+// GetConsensusAddress implements ValidatorEvidence
+func (e Equivocation) GetConsensusAddress() sdk.ConsAddress { return e.ConsensusAddress }
+
+// GetValidatorPower implements ValidatorEvidence
+func (e Equivocation) GetValidatorPower() int64 { return e.Power }
+
+// String implements Evidence
+func (e Equivocation) String() string {
+	return strings.TrimSpace(fmt.Sprintf(`Equivocation:
+  Height:            %d
+  Time:              %s
+  Power:             %d
+  Consensus Address: %s`, e.Height, e.Time, e.Power, e.ConsensusAddress))
+}
@@ -0,0 +1,40 @@
+package types
+
+import (
+	"fmt"
+
+	cmn "github.com/tendermint/tendermint/libs/common"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// Evidence module codespace constants
+const (
+	DefaultCodespace sdk.CodespaceType = ModuleName
+
+	CodeNoEvidenceHandlerExists sdk.CodeType = 1
+	CodeInvalidEvidence         sdk.CodeType = 2
+	CodeEvidenceExists          sdk.CodeType = 3
+	CodeUnknownEvidence         sdk.CodeType = 4
+)
+
+// ErrNoEvidenceHandlerExists returns an error for when no handler is routed for
+// the route of a piece of evidence.
+func ErrNoEvidenceHandlerExists(codespace sdk.CodespaceType, route string) sdk.Error {
+	return sdk.NewError(codespace, CodeNoEvidenceHandlerExists, fmt.Sprintf("route '%s' does not have a registered handler", route))
+}
+
+// ErrInvalidEvidence returns an error for invalid evidence.
+func ErrInvalidEvidence(codespace sdk.CodespaceType, msg string) sdk.Error {
+	return sdk.NewError(codespace, CodeInvalidEvidence, "invalid evidence: "+msg)
+}
+
+// ErrEvidenceExists returns an error for evidence which was already submitted.
+func ErrEvidenceExists(codespace sdk.CodespaceType, hash cmn.HexBytes) sdk.Error {
+	return sdk.NewError(codespace, CodeEvidenceExists, fmt.Sprintf("evidence %s was already submitted", hash))
+}
+
+// ErrUnknownEvidence returns an error for evidence which was never submitted.
+func ErrUnknownEvidence(codespace sdk.CodespaceType, hash cmn.HexBytes) sdk.Error {
+	return sdk.NewError(codespace, CodeUnknownEvidence, fmt.Sprintf("evidence %s does not exist", hash))
+}
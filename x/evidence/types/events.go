@@ -0,0 +1,11 @@
+package types
+
+// evidence module event types
+const (
+	EventTypeSubmitEvidence = "submit_evidence"
+
+	AttributeKeyEvidenceHash  = "evidence_hash"
+	AttributeKeyEvidenceRoute = "evidence_route"
+
+	AttributeValueCategory = ModuleName
+)
@@ -0,0 +1,49 @@
+package types
+
+import (
+	"strings"
+
+	cmn "github.com/tendermint/tendermint/libs/common"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// Evidence defines the contract which concrete evidence of misbehaviour must
+// implement. The route of a piece of evidence selects the Handler which
+// verifies it and punishes the misbehaviour.
+type Evidence interface {
+	Route() string
+	Type() string
+	String() string
+	Hash() cmn.HexBytes
+	ValidateBasic() sdk.Error
+
+	// GetHeight returns the height at which the infraction occurred.
+	GetHeight() int64
+}
+
+// ValidatorEvidence extends Evidence with the validator which committed the
+// infraction and its voting power at the time.
+type ValidatorEvidence interface {
+	Evidence
+
+	GetConsensusAddress() sdk.ConsAddress
+	GetValidatorPower() int64
+}
+
+// Handler defines a function which verifies a piece of evidence and punishes
+// the misbehaviour it proves. The state changes of a Handler returning an
+// error are discarded.
+type Handler func(ctx sdk.Context, evidence Evidence) sdk.Error
+
+// EvidenceList defines a list of evidence.
+type EvidenceList []Evidence
+
+// String implements the Stringer interface.
+func (el EvidenceList) String() string {
+	evidence := make([]string, len(el))
+	for i, e := range el {
+		evidence[i] = e.String()
+	}
+	return strings.Join(evidence, "\n")
+}
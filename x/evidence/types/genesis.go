@@ -0,0 +1,29 @@
+package types
+
+// GenesisState contains the evidence submitted before genesis.
+type GenesisState struct {
+	Evidence []Evidence `json:"evidence"`
+}
+
+// NewGenesisState creates a new genesis state.
+func NewGenesisState(evidence []Evidence) GenesisState {
+	return GenesisState{Evidence: evidence}
+}
+
+// DefaultGenesisState returns a default genesis state
+func DefaultGenesisState() GenesisState {
+	return NewGenesisState([]Evidence{})
+}
+
+// ValidateBasic ensures all the evidence in the genesis state is valid.
+func (data GenesisState) ValidateBasic() error {
+	for _, evidence := range data.Evidence {
+		if evidence == nil {
+			return ErrInvalidEvidence(DefaultCodespace, "missing evidence")
+		}
+		if err := evidence.ValidateBasic(); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,39 @@
+package types
+
+import (
+	cmn "github.com/tendermint/tendermint/libs/common"
+)
+
+const (
+	// ModuleName is the name of this module
+	ModuleName = "evidence"
+
+	// StoreKey is the store key string for the evidence module
+	StoreKey = ModuleName
+
+	// RouterKey is the message route for the evidence module
+	RouterKey = ModuleName
+
+	// QuerierRoute is the querier route for the evidence module
+	QuerierRoute = ModuleName
+
+	// QueryEvidence is the query endpoint for a piece of evidence by hash
+	QueryEvidence = "evidence"
+
+	// QueryAllEvidence is the query endpoint for all the evidence
+	QueryAllEvidence = "all_evidence"
+)
+
+// Keys for evidence store
+// Items are stored with the following key: values
+//
+// - 0x00<evidenceHash_Bytes>: Evidence
+var (
+	EvidenceKeyPrefix = []byte{0x00}
+)
+
+// GetEvidenceKey returns the key under which the evidence with the given hash
+// is stored.
+func GetEvidenceKey(hash cmn.HexBytes) []byte {
+	return append(EvidenceKeyPrefix, hash...)
+}
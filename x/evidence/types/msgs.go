@@ -0,0 +1,56 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// evidence message types
+const (
+	TypeMsgSubmitEvidence = "submit_evidence"
+)
+
+var _ sdk.Msg = MsgSubmitEvidence{}
+
+// MsgSubmitEvidence submits a piece of evidence of misbehaviour, which is
+// routed to the Handler registered for its route.
+type MsgSubmitEvidence struct {
+	Evidence  Evidence       `json:"evidence"`
+	Submitter sdk.AccAddress `json:"submitter"`
+}
+
+// NewMsgSubmitEvidence creates a new MsgSubmitEvidence instance.
+func NewMsgSubmitEvidence(evidence Evidence, submitter sdk.AccAddress) MsgSubmitEvidence {
+	return MsgSubmitEvidence{Evidence: evidence, Submitter: submitter}
+}
+
+// Route implements Msg
+func (msg MsgSubmitEvidence) Route() string { return RouterKey }
+
+// Type implements Msg
+func (msg MsgSubmitEvidence) Type() string { return TypeMsgSubmitEvidence }
+
+// ValidateBasic implements Msg
+func (msg MsgSubmitEvidence) ValidateBasic() sdk.Error {
+	if msg.Submitter.Empty() {
+		return sdk.ErrInvalidAddress(msg.Submitter.String())
+	}
+	if msg.Evidence == nil {
+		return ErrInvalidEvidence(DefaultCodespace, "missing evidence")
+	}
+	// equivocations are only trusted when reported by Tendermint, as their
+	// signatures are not part of the evidence
+	if _, ok := msg.Evidence.(Equivocation); ok {
+		return ErrInvalidEvidence(DefaultCodespace, "equivocation evidence cannot be submitted in a transaction")
+	}
+	return msg.Evidence.ValidateBasic()
+}
+
+// GetSignBytes implements Msg
+func (msg MsgSubmitEvidence) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(msg))
+}
+
+// GetSigners implements Msg
+func (msg MsgSubmitEvidence) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.Submitter}
+}
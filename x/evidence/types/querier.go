@@ -0,0 +1,16 @@
+package types
+
+import (
+	cmn "github.com/tendermint/tendermint/libs/common"
+)
+
+// QueryEvidenceParams defines the params for querying a piece of evidence by
+// hash.
+type QueryEvidenceParams struct {
+	EvidenceHash cmn.HexBytes `json:"evidence_hash"`
+}
+
+// NewQueryEvidenceParams creates a new QueryEvidenceParams instance.
+func NewQueryEvidenceParams(hash cmn.HexBytes) QueryEvidenceParams {
+	return QueryEvidenceParams{EvidenceHash: hash}
+}
@@ -0,0 +1,76 @@
+// nolint
+// autogenerated code using github.com/rigelrozanski/multitool
+// aliases generated for the following subdirectories:
+// ALIASGEN: github.com/cosmos/cosmos-sdk/x/feegrant/keeper
+// ALIASGEN: github.com/cosmos/cosmos-sdk/x/feegrant/types
+package feegrant
+
+import (
+	"github.com/cosmos/cosmos-sdk/x/feegrant/keeper"
+	"github.com/cosmos/cosmos-sdk/x/feegrant/types"
+)
+
+const (
+	DefaultCodespace            = types.DefaultCodespace
+	CodeFeeLimitExceeded        = types.CodeFeeLimitExceeded
+	CodeFeeLimitExpired         = types.CodeFeeLimitExpired
+	CodeInvalidDuration         = types.CodeInvalidDuration
+	CodeNoAllowance             = types.CodeNoAllowance
+	CodeInvalidAllowance        = types.CodeInvalidAllowance
+	EventTypeSetFeeAllowance    = types.EventTypeSetFeeAllowance
+	EventTypeRevokeFeeAllowance = types.EventTypeRevokeFeeAllowance
+	EventTypeUseFeeAllowance    = types.EventTypeUseFeeAllowance
+	AttributeKeyGranter         = types.AttributeKeyGranter
+	AttributeKeyGrantee         = types.AttributeKeyGrantee
+	AttributeValueCategory      = types.AttributeValueCategory
+	ModuleName                  = types.ModuleName
+	StoreKey                    = types.StoreKey
+	RouterKey                   = types.RouterKey
+	QuerierRoute                = types.QuerierRoute
+	QueryFeeAllowances          = types.QueryFeeAllowances
+)
+
+var (
+	// functions aliases
+	NewKeeper                    = keeper.NewKeeper
+	NewQuerier                   = keeper.NewQuerier
+	NewBasicFeeAllowance         = types.NewBasicFeeAllowance
+	RegisterCodec                = types.RegisterCodec
+	ErrFeeLimitExceeded          = types.ErrFeeLimitExceeded
+	ErrFeeLimitExpired           = types.ErrFeeLimitExpired
+	ErrInvalidDuration           = types.ErrInvalidDuration
+	ErrNoAllowance               = types.ErrNoAllowance
+	ErrInvalidAllowance          = types.ErrInvalidAllowance
+	ExpiresAtTime                = types.ExpiresAtTime
+	ExpiresAtHeight              = types.ExpiresAtHeight
+	ClockDuration                = types.ClockDuration
+	BlockDuration                = types.BlockDuration
+	NewGenesisState              = types.NewGenesisState
+	DefaultGenesisState          = types.DefaultGenesisState
+	NewFeeAllowanceGrant         = types.NewFeeAllowanceGrant
+	GetFeeAllowanceKey           = types.GetFeeAllowanceKey
+	GetFeeAllowancesByGranteeKey = types.GetFeeAllowancesByGranteeKey
+	NewMsgGrantFeeAllowance      = types.NewMsgGrantFeeAllowance
+	NewMsgRevokeFeeAllowance     = types.NewMsgRevokeFeeAllowance
+	NewPeriodicFeeAllowance      = types.NewPeriodicFeeAllowance
+	NewQueryFeeAllowancesParams  = types.NewQueryFeeAllowancesParams
+
+	// variable aliases
+	ModuleCdc             = types.ModuleCdc
+	FeeAllowanceKeyPrefix = types.FeeAllowanceKeyPrefix
+)
+
+type (
+	Keeper                   = keeper.Keeper
+	BasicFeeAllowance        = types.BasicFeeAllowance
+	ExpiresAt                = types.ExpiresAt
+	Duration                 = types.Duration
+	FeeAllowance             = types.FeeAllowance
+	GenesisState             = types.GenesisState
+	FeeAllowanceGrant        = types.FeeAllowanceGrant
+	FeeAllowanceGrants       = types.FeeAllowanceGrants
+	MsgGrantFeeAllowance     = types.MsgGrantFeeAllowance
+	MsgRevokeFeeAllowance    = types.MsgRevokeFeeAllowance
+	PeriodicFeeAllowance     = types.PeriodicFeeAllowance
+	QueryFeeAllowancesParams = types.QueryFeeAllowancesParams
+)
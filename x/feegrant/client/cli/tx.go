@@ -0,0 +1,170 @@
+package cli
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/context"
+	"github.com/cosmos/cosmos-sdk/client/utils"
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/version"
+	"github.com/cosmos/cosmos-sdk/x/auth"
+	"github.com/cosmos/cosmos-sdk/x/feegrant/types"
+)
+
+// fee grant flags
+const (
+	FlagExpiration       = "expiration"
+	FlagExpirationHeight = "expiration-height"
+	FlagPeriod           = "period"
+	FlagPeriodBlocks     = "period-blocks"
+	FlagPeriodLimit      = "period-limit"
+)
+
+// GetTxCmd returns the transaction commands for this module
+func GetTxCmd(cdc *codec.Codec) *cobra.Command {
+	feegrantTxCmd := &cobra.Command{
+		Use:                        types.ModuleName,
+		Short:                      "Feegrant transactions subcommands",
+		DisableFlagParsing:         true,
+		SuggestionsMinimumDistance: 2,
+		RunE:                       utils.ValidateCmd,
+	}
+
+	feegrantTxCmd.AddCommand(client.PostCommands(
+		GetCmdGrantFeeAllowance(cdc),
+		GetCmdRevokeFeeAllowance(cdc),
+	)...)
+
+	return feegrantTxCmd
+}
+
+// GetCmdGrantFeeAllowance implements the grant fee allowance command.
+func GetCmdGrantFeeAllowance(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "grant [grantee] [spend-limit]",
+		Args:  cobra.RangeArgs(1, 2),
+		Short: "Grant an allowance to an account to pay its fees",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Grant an allowance to an account, so that the fees of its transactions
+can be paid from the account of the granter. The fees that can be paid are
+limited to the spend limit, if any. A periodic allowance is granted if a period
+is set, limiting the fees that can be paid in each period as well.
+
+Example:
+$ %s tx %s grant cosmos1gghjut3ccd8ay0zduzj64hwre2fxs9ld75ru9p 1000stake --from mykey
+$ %s tx %s grant cosmos1gghjut3ccd8ay0zduzj64hwre2fxs9ld75ru9p 1000stake --period 24h --period-limit 10stake --from mykey
+`,
+				version.ClientName, types.ModuleName, version.ClientName, types.ModuleName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			txBldr := auth.NewTxBuilderFromCLI().WithTxEncoder(utils.GetTxEncoder(cdc))
+			cliCtx := context.NewCLIContext().WithCodec(cdc).WithAccountDecoder(cdc)
+
+			grantee, err := sdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+
+			var spendLimit sdk.Coins
+			if len(args) > 1 {
+				spendLimit, err = sdk.ParseCoins(args[1])
+				if err != nil {
+					return err
+				}
+			}
+
+			expiration, err := parseExpiration()
+			if err != nil {
+				return err
+			}
+
+			var allowance types.FeeAllowance = types.NewBasicFeeAllowance(spendLimit, expiration)
+
+			period, err := parsePeriod()
+			if err != nil {
+				return err
+			}
+
+			if period != (types.Duration{}) {
+				periodLimit, err := sdk.ParseCoins(viper.GetString(FlagPeriodLimit))
+				if err != nil {
+					return err
+				}
+
+				basic := types.BasicFeeAllowance{SpendLimit: spendLimit, Expiration: expiration}
+				allowance = types.NewPeriodicFeeAllowance(basic, period, periodLimit)
+			}
+
+			msg := types.NewMsgGrantFeeAllowance(cliCtx.GetFromAddress(), grantee, allowance)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return utils.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
+		},
+	}
+
+	cmd.Flags().String(FlagExpiration, "", "The time at which the allowance expires (RFC3339 format)")
+	cmd.Flags().Int64(FlagExpirationHeight, 0, "The block height at which the allowance expires")
+	cmd.Flags().Duration(FlagPeriod, 0, "The length of a period in time, in order to grant a periodic allowance")
+	cmd.Flags().Int64(FlagPeriodBlocks, 0, "The length of a period in blocks, in order to grant a periodic allowance")
+	cmd.Flags().String(FlagPeriodLimit, "", "The fees that can be paid in each period")
+
+	return cmd
+}
+
+// GetCmdRevokeFeeAllowance implements the revoke fee allowance command.
+func GetCmdRevokeFeeAllowance(cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "revoke [grantee]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Revoke the allowance granted to an account",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Revoke the fee allowance granted to an account.
+
+Example:
+$ %s tx %s revoke cosmos1gghjut3ccd8ay0zduzj64hwre2fxs9ld75ru9p --from mykey
+`,
+				version.ClientName, types.ModuleName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			txBldr := auth.NewTxBuilderFromCLI().WithTxEncoder(utils.GetTxEncoder(cdc))
+			cliCtx := context.NewCLIContext().WithCodec(cdc).WithAccountDecoder(cdc)
+
+			grantee, err := sdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgRevokeFeeAllowance(cliCtx.GetFromAddress(), grantee)
+			return utils.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
+		},
+	}
+}
+
+func parseExpiration() (types.ExpiresAt, error) {
+	if expiration := viper.GetString(FlagExpiration); expiration != "" {
+		t, err := time.Parse(time.RFC3339, expiration)
+		if err != nil {
+			return types.ExpiresAt{}, err
+		}
+		return types.ExpiresAtTime(t), nil
+	}
+	return types.ExpiresAtHeight(viper.GetInt64(FlagExpirationHeight)), nil
+}
+
+func parsePeriod() (types.Duration, error) {
+	if period := viper.GetDuration(FlagPeriod); period != 0 {
+		return types.ClockDuration(period), nil
+	}
+	return types.BlockDuration(viper.GetInt64(FlagPeriodBlocks)), nil
+}
@@ -0,0 +1,21 @@
+/*
+Package feegrant provides functionality for paying the transaction fees of an
+account from the account of another, for example to let a sponsor pay the gas
+of new users who do not hold any tokens yet.
+
+A granter grants a FeeAllowance to a grantee through MsgGrantFeeAllowance, and
+may take it back at any time through MsgRevokeFeeAllowance. Two allowances are
+provided:
+
+  - BasicFeeAllowance lets the grantee spend up to a given amount of fees,
+    possibly until an expiration time or height.
+  - PeriodicFeeAllowance additionally limits the fees that can be spent in
+    each period, e.g. a day or a number of blocks.
+
+To have its fees paid by a granter, the grantee sets the granter on the StdFee
+of its transaction (e.g. with the --fee-granter flag). The auth AnteHandler
+then charges the fees against the allowance through the keeper passed to
+auth.NewAnteHandler, and deducts them from the granter instead of the first
+signer. The allowance is deleted once it is used up or expired.
+*/
+package feegrant
@@ -0,0 +1,32 @@
+package feegrant
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// InitGenesis stores the fee allowances granted at genesis.
+func InitGenesis(ctx sdk.Context, k Keeper, data GenesisState) {
+	for _, grant := range data.FeeAllowances {
+		k.GrantFeeAllowance(ctx, grant)
+	}
+}
+
+// ExportGenesis returns a GenesisState for a given context and keeper. The
+// allowances are adjusted for the chain to restart from height zero.
+func ExportGenesis(ctx sdk.Context, k Keeper) GenesisState {
+	time, height := ctx.BlockHeader().Time, ctx.BlockHeight()
+
+	var grants []FeeAllowanceGrant
+	k.IterateAllFeeAllowances(ctx, func(grant FeeAllowanceGrant) bool {
+		grants = append(grants, grant.PrepareForExport(time, height))
+		return false
+	})
+
+	return NewGenesisState(grants)
+}
+
+// ValidateGenesis performs basic validation of fee grant genesis data
+// returning an error for any failed validation criteria.
+func ValidateGenesis(data GenesisState) error {
+	return data.ValidateBasic()
+}
@@ -0,0 +1,171 @@
+package keeper
+
+import (
+	"fmt"
+
+	"github.com/tendermint/tendermint/libs/log"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/feegrant/types"
+)
+
+// Keeper manages the fee allowances granted between accounts
+type Keeper struct {
+	cdc      *codec.Codec
+	storeKey sdk.StoreKey
+	ak       types.AccountKeeper
+}
+
+// NewKeeper creates a new fee grant Keeper instance
+func NewKeeper(cdc *codec.Codec, storeKey sdk.StoreKey, ak types.AccountKeeper) Keeper {
+	return Keeper{
+		cdc:      cdc,
+		storeKey: storeKey,
+		ak:       ak,
+	}
+}
+
+// Logger returns a module-specific logger.
+func (k Keeper) Logger(ctx sdk.Context) log.Logger {
+	return ctx.Logger().With("module", fmt.Sprintf("x/%s", types.ModuleName))
+}
+
+// GrantFeeAllowance creates a new grant, replacing any allowance previously
+// granted by the granter to the grantee. The account of the grantee is created
+// if it does not exist yet, so that it can sign transactions.
+func (k Keeper) GrantFeeAllowance(ctx sdk.Context, grant types.FeeAllowanceGrant) {
+	if k.ak.GetAccount(ctx, grant.Grantee) == nil {
+		k.ak.SetAccount(ctx, k.ak.NewAccountWithAddress(ctx, grant.Grantee))
+	}
+
+	k.setFeeGrant(ctx, grant)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeSetFeeAllowance,
+			sdk.NewAttribute(types.AttributeKeyGranter, grant.Granter.String()),
+			sdk.NewAttribute(types.AttributeKeyGrantee, grant.Grantee.String()),
+		),
+	)
+}
+
+// RevokeFeeAllowance removes the allowance granted by the granter to the
+// grantee. It returns an error if there is no such allowance.
+func (k Keeper) RevokeFeeAllowance(ctx sdk.Context, granter, grantee sdk.AccAddress) sdk.Error {
+	store := ctx.KVStore(k.storeKey)
+	key := types.GetFeeAllowanceKey(granter, grantee)
+	if !store.Has(key) {
+		return types.ErrNoAllowance(types.DefaultCodespace)
+	}
+
+	store.Delete(key)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeRevokeFeeAllowance,
+			sdk.NewAttribute(types.AttributeKeyGranter, granter.String()),
+			sdk.NewAttribute(types.AttributeKeyGrantee, grantee.String()),
+		),
+	)
+
+	return nil
+}
+
+// GetFeeAllowance returns the allowance granted by the granter to the grantee,
+// or nil if there is none.
+func (k Keeper) GetFeeAllowance(ctx sdk.Context, granter, grantee sdk.AccAddress) types.FeeAllowance {
+	grant, found := k.GetFeeGrant(ctx, granter, grantee)
+	if !found {
+		return nil
+	}
+	return grant.Allowance
+}
+
+// GetFeeGrant returns the full grant of the allowance granted by the granter
+// to the grantee.
+func (k Keeper) GetFeeGrant(ctx sdk.Context, granter, grantee sdk.AccAddress) (grant types.FeeAllowanceGrant, found bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.GetFeeAllowanceKey(granter, grantee))
+	if bz == nil {
+		return grant, false
+	}
+
+	k.cdc.MustUnmarshalBinaryLengthPrefixed(bz, &grant)
+	return grant, true
+}
+
+// setFeeGrant stores the grant, replacing any existing one between the same
+// accounts.
+func (k Keeper) setFeeGrant(ctx sdk.Context, grant types.FeeAllowanceGrant) {
+	store := ctx.KVStore(k.storeKey)
+	bz := k.cdc.MustMarshalBinaryLengthPrefixed(grant)
+	store.Set(types.GetFeeAllowanceKey(grant.Granter, grant.Grantee), bz)
+}
+
+// IterateAllGranteeFeeAllowances iterates over all the allowances granted to
+// the grantee and calls cb with each of them. The iteration stops if cb
+// returns true.
+func (k Keeper) IterateAllGranteeFeeAllowances(ctx sdk.Context, grantee sdk.AccAddress,
+	cb func(grant types.FeeAllowanceGrant) (stop bool)) {
+
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, types.GetFeeAllowancesByGranteeKey(grantee))
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var grant types.FeeAllowanceGrant
+		k.cdc.MustUnmarshalBinaryLengthPrefixed(iterator.Value(), &grant)
+		if cb(grant) {
+			break
+		}
+	}
+}
+
+// IterateAllFeeAllowances iterates over all the allowances in the store and
+// calls cb with each of them. The iteration stops if cb returns true.
+func (k Keeper) IterateAllFeeAllowances(ctx sdk.Context, cb func(grant types.FeeAllowanceGrant) (stop bool)) {
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, types.FeeAllowanceKeyPrefix)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var grant types.FeeAllowanceGrant
+		k.cdc.MustUnmarshalBinaryLengthPrefixed(iterator.Value(), &grant)
+		if cb(grant) {
+			break
+		}
+	}
+}
+
+// UseGrantedFees charges the fee against the allowance granted by the granter
+// to the grantee. It returns an error if there is no such allowance or if it
+// does not accept the fee. The allowance is deleted once it is used up or
+// expired.
+func (k Keeper) UseGrantedFees(ctx sdk.Context, granter, grantee sdk.AccAddress, fee sdk.Coins) sdk.Error {
+	grant, found := k.GetFeeGrant(ctx, granter, grantee)
+	if !found || grant.Allowance == nil {
+		return types.ErrNoAllowance(types.DefaultCodespace)
+	}
+
+	remove, err := grant.Allowance.Accept(fee, ctx.BlockHeader().Time, ctx.BlockHeight())
+	if err != nil {
+		return err
+	}
+
+	if remove {
+		ctx.KVStore(k.storeKey).Delete(types.GetFeeAllowanceKey(granter, grantee))
+	} else {
+		k.setFeeGrant(ctx, grant)
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeUseFeeAllowance,
+			sdk.NewAttribute(types.AttributeKeyGranter, granter.String()),
+			sdk.NewAttribute(types.AttributeKeyGrantee, grantee.String()),
+		),
+	)
+
+	return nil
+}
@@ -0,0 +1,207 @@
+package keeper
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	abci "github.com/tendermint/tendermint/abci/types"
+	"github.com/tendermint/tendermint/crypto/ed25519"
+	dbm "github.com/tendermint/tendermint/libs/db"
+	"github.com/tendermint/tendermint/libs/log"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/store"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/auth"
+	"github.com/cosmos/cosmos-sdk/x/feegrant/types"
+	"github.com/cosmos/cosmos-sdk/x/params"
+)
+
+var (
+	addr  = sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address())
+	addr2 = sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address())
+	addr3 = sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address())
+	addr4 = sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address())
+)
+
+func createTestInput(t *testing.T) (sdk.Context, auth.AccountKeeper, Keeper) {
+	keyAcc := sdk.NewKVStoreKey(auth.StoreKey)
+	keyParams := sdk.NewKVStoreKey(params.StoreKey)
+	tkeyParams := sdk.NewTransientStoreKey(params.TStoreKey)
+	keyFeeGrant := sdk.NewKVStoreKey(types.StoreKey)
+
+	db := dbm.NewMemDB()
+	ms := store.NewCommitMultiStore(db)
+	ms.MountStoreWithDB(keyAcc, sdk.StoreTypeIAVL, db)
+	ms.MountStoreWithDB(keyParams, sdk.StoreTypeIAVL, db)
+	ms.MountStoreWithDB(tkeyParams, sdk.StoreTypeTransient, db)
+	ms.MountStoreWithDB(keyFeeGrant, sdk.StoreTypeIAVL, db)
+	require.NoError(t, ms.LoadLatestVersion())
+
+	cdc := codec.New()
+	auth.RegisterCodec(cdc)
+	types.RegisterCodec(cdc)
+	codec.RegisterCrypto(cdc)
+
+	ctx := sdk.NewContext(ms, abci.Header{ChainID: "feegrant-chain", Height: 1, Time: time.Now()}, false, log.NewNopLogger())
+	pk := params.NewKeeper(cdc, keyParams, tkeyParams, params.DefaultCodespace)
+	ak := auth.NewAccountKeeper(cdc, keyAcc, pk.Subspace(auth.DefaultParamspace), auth.ProtoBaseAccount)
+
+	return ctx, ak, NewKeeper(cdc, keyFeeGrant, ak)
+}
+
+func TestKeeperCrud(t *testing.T) {
+	ctx, ak, k := createTestInput(t)
+
+	// some helpers
+	atom := sdk.NewCoins(sdk.NewInt64Coin("atom", 555))
+	eth := sdk.NewCoins(sdk.NewInt64Coin("eth", 123))
+	basic := types.NewBasicFeeAllowance(atom, types.ExpiresAtHeight(334455))
+	basic2 := types.NewBasicFeeAllowance(eth, types.ExpiresAtHeight(172436))
+
+	// let's set up some initial state here
+	k.GrantFeeAllowance(ctx, types.NewFeeAllowanceGrant(addr, addr2, basic))
+	k.GrantFeeAllowance(ctx, types.NewFeeAllowanceGrant(addr, addr3, basic2))
+	k.GrantFeeAllowance(ctx, types.NewFeeAllowanceGrant(addr2, addr3, basic))
+	k.GrantFeeAllowance(ctx, types.NewFeeAllowanceGrant(addr2, addr4, basic))
+	k.GrantFeeAllowance(ctx, types.NewFeeAllowanceGrant(addr4, addr3, basic))
+
+	// the grantee accounts are created
+	require.NotNil(t, ak.GetAccount(ctx, addr2))
+	require.NotNil(t, ak.GetAccount(ctx, addr3))
+	require.NotNil(t, ak.GetAccount(ctx, addr4))
+
+	// remove some, overwrite other
+	require.NoError(t, k.RevokeFeeAllowance(ctx, addr, addr2))
+	require.NoError(t, k.RevokeFeeAllowance(ctx, addr, addr3))
+	require.Error(t, k.RevokeFeeAllowance(ctx, addr, addr3))
+	k.GrantFeeAllowance(ctx, types.NewFeeAllowanceGrant(addr, addr3, basic))
+	k.GrantFeeAllowance(ctx, types.NewFeeAllowanceGrant(addr2, addr3, basic2))
+
+	// end state:
+	// addr -> addr3 (basic)
+	// addr2 -> addr3 (basic2), addr4(basic)
+	// addr4 -> addr3 (basic)
+
+	// then lots of queries
+	cases := map[string]struct {
+		grantee   sdk.AccAddress
+		granter   sdk.AccAddress
+		allowance types.FeeAllowance
+	}{
+		"addr revoked":           {granter: addr, grantee: addr2},
+		"addr revoked and added": {granter: addr, grantee: addr3, allowance: basic},
+		"addr never there":       {granter: addr, grantee: addr4},
+		"addr modified":          {granter: addr2, grantee: addr3, allowance: basic2},
+	}
+
+	for name, tc := range cases {
+		tc := tc
+		t.Run(name, func(t *testing.T) {
+			allow := k.GetFeeAllowance(ctx, tc.granter, tc.grantee)
+			if tc.allowance == nil {
+				require.Nil(t, allow)
+				return
+			}
+			require.NotNil(t, allow)
+			require.Equal(t, tc.allowance, allow)
+		})
+	}
+
+	grants := []types.FeeAllowanceGrant{}
+	k.IterateAllGranteeFeeAllowances(ctx, addr3, func(grant types.FeeAllowanceGrant) bool {
+		grants = append(grants, grant)
+		return false
+	})
+	require.Len(t, grants, 3)
+
+	grants = []types.FeeAllowanceGrant{}
+	k.IterateAllFeeAllowances(ctx, func(grant types.FeeAllowanceGrant) bool {
+		grants = append(grants, grant)
+		return false
+	})
+	require.Len(t, grants, 4)
+}
+
+func TestUseGrantedFee(t *testing.T) {
+	ctx, _, k := createTestInput(t)
+
+	// some helpers
+	atom := sdk.NewCoins(sdk.NewInt64Coin("atom", 555))
+	eth := sdk.NewCoins(sdk.NewInt64Coin("eth", 123))
+	future := types.NewBasicFeeAllowance(atom, types.ExpiresAtHeight(5678))
+	expired := types.NewBasicFeeAllowance(eth, types.ExpiresAtHeight(55))
+
+	// for testing limits of the contract
+	hugeAtom := sdk.NewCoins(sdk.NewInt64Coin("atom", 9999))
+	smallAtom := sdk.NewCoins(sdk.NewInt64Coin("atom", 1))
+	futureAfterSmall := types.NewBasicFeeAllowance(sdk.NewCoins(sdk.NewInt64Coin("atom", 554)), types.ExpiresAtHeight(5678))
+
+	cases := map[string]struct {
+		granter sdk.AccAddress
+		grantee sdk.AccAddress
+		fee     sdk.Coins
+		allowed bool
+		final   types.FeeAllowance
+	}{
+		"use entire pot": {
+			granter: addr,
+			grantee: addr2,
+			fee:     atom,
+			allowed: true,
+			final:   nil,
+		},
+		"expired and removed": {
+			granter: addr,
+			grantee: addr3,
+			fee:     eth,
+			allowed: false,
+			final:   expired,
+		},
+		"too high": {
+			granter: addr,
+			grantee: addr2,
+			fee:     hugeAtom,
+			allowed: false,
+			final:   future,
+		},
+		"use a little": {
+			granter: addr,
+			grantee: addr2,
+			fee:     smallAtom,
+			allowed: true,
+			final:   futureAfterSmall,
+		},
+		"no allowance": {
+			granter: addr2,
+			grantee: addr,
+			fee:     smallAtom,
+			allowed: false,
+			final:   nil,
+		},
+	}
+
+	for name, tc := range cases {
+		tc := tc
+		t.Run(name, func(t *testing.T) {
+			// let's set up some initial state here
+			// addr -> addr2 (future)
+			// addr -> addr3 (expired)
+			cacheCtx, _ := ctx.WithBlockHeight(100).CacheContext()
+			k.GrantFeeAllowance(cacheCtx, types.NewFeeAllowanceGrant(addr, addr2, future))
+			k.GrantFeeAllowance(cacheCtx, types.NewFeeAllowanceGrant(addr, addr3, expired))
+
+			err := k.UseGrantedFees(cacheCtx, tc.granter, tc.grantee, tc.fee)
+			if tc.allowed {
+				require.NoError(t, err)
+			} else {
+				require.Error(t, err)
+			}
+
+			loaded := k.GetFeeAllowance(cacheCtx, tc.granter, tc.grantee)
+			require.Equal(t, tc.final, loaded)
+		})
+	}
+}
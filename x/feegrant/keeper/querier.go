@@ -0,0 +1,44 @@
+package keeper
+
+import (
+	abci "github.com/tendermint/tendermint/abci/types"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/feegrant/types"
+)
+
+// NewQuerier creates a querier for fee grant REST endpoints
+func NewQuerier(k Keeper) sdk.Querier {
+	return func(ctx sdk.Context, path []string, req abci.RequestQuery) ([]byte, sdk.Error) {
+		switch path[0] {
+		case types.QueryFeeAllowances:
+			return queryFeeAllowances(ctx, req, k)
+
+		default:
+			return nil, sdk.ErrUnknownRequest("unknown feegrant query endpoint")
+		}
+	}
+}
+
+func queryFeeAllowances(ctx sdk.Context, req abci.RequestQuery, k Keeper) ([]byte, sdk.Error) {
+	var params types.QueryFeeAllowancesParams
+
+	err := k.cdc.UnmarshalJSON(req.Data, &params)
+	if err != nil {
+		return nil, sdk.ErrUnknownRequest(sdk.AppendMsgToErr("incorrectly formatted request data", err.Error()))
+	}
+
+	grants := types.FeeAllowanceGrants{}
+	k.IterateAllGranteeFeeAllowances(ctx, params.Grantee, func(grant types.FeeAllowanceGrant) bool {
+		grants = append(grants, grant)
+		return false
+	})
+
+	res, err := codec.MarshalJSONIndent(k.cdc, grants)
+	if err != nil {
+		return nil, sdk.ErrInternal(sdk.AppendMsgToErr("failed to JSON marshal result: %s", err.Error()))
+	}
+
+	return res, nil
+}
@@ -0,0 +1,76 @@
+package types
+
+import (
+	"fmt"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+var _ FeeAllowance = (*BasicFeeAllowance)(nil)
+
+// BasicFeeAllowance implements FeeAllowance with a one-time grant of tokens
+// that optionally expires. The grantee can use up to SpendLimit to cover fees.
+type BasicFeeAllowance struct {
+	// SpendLimit is the maximum amount of tokens that can be spent through the
+	// allowance. It is updated as fees are paid. If it is empty, the amount of
+	// tokens that can be spent is not limited.
+	SpendLimit sdk.Coins `json:"spend_limit"`
+
+	// Expiration is the point at which the allowance expires, if any.
+	Expiration ExpiresAt `json:"expiration"`
+}
+
+// NewBasicFeeAllowance creates a new BasicFeeAllowance.
+func NewBasicFeeAllowance(spendLimit sdk.Coins, expiration ExpiresAt) *BasicFeeAllowance {
+	return &BasicFeeAllowance{
+		SpendLimit: spendLimit,
+		Expiration: expiration,
+	}
+}
+
+// Accept implements FeeAllowance. The allowance is removed once it is expired
+// or its spend limit is used up.
+func (a *BasicFeeAllowance) Accept(fee sdk.Coins, blockTime time.Time, blockHeight int64) (bool, sdk.Error) {
+	if a.Expiration.IsExpired(blockTime, blockHeight) {
+		return true, ErrFeeLimitExpired(DefaultCodespace)
+	}
+
+	if a.SpendLimit.Empty() {
+		return false, nil
+	}
+
+	left, invalid := a.SpendLimit.SafeSub(fee)
+	if invalid {
+		return false, ErrFeeLimitExceeded(DefaultCodespace)
+	}
+
+	a.SpendLimit = left
+	return left.IsZero(), nil
+}
+
+// PrepareForExport implements FeeAllowance.
+func (a *BasicFeeAllowance) PrepareForExport(dumpTime time.Time, dumpHeight int64) FeeAllowance {
+	return &BasicFeeAllowance{
+		SpendLimit: a.SpendLimit,
+		Expiration: a.Expiration.PrepareForExport(dumpTime, dumpHeight),
+	}
+}
+
+// ValidateBasic implements FeeAllowance.
+func (a BasicFeeAllowance) ValidateBasic() sdk.Error {
+	if !a.SpendLimit.IsValid() {
+		return sdk.ErrInvalidCoins("send amount is invalid: " + a.SpendLimit.String())
+	}
+	if err := a.Expiration.ValidateBasic(); err != nil {
+		return ErrInvalidAllowance(DefaultCodespace, err.Error())
+	}
+	return nil
+}
+
+// String implements the Stringer interface.
+func (a BasicFeeAllowance) String() string {
+	return fmt.Sprintf(`Basic Fee Allowance:
+  Spend Limit: %s
+  Expiration:  %v`, a.SpendLimit, a.Expiration)
+}
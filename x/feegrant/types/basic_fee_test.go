@@ -0,0 +1,99 @@
+package types
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func TestBasicFeeValidAllow(t *testing.T) {
+	eth := sdk.NewCoins(sdk.NewInt64Coin("eth", 10))
+	atom := sdk.NewCoins(sdk.NewInt64Coin("atom", 555))
+	smallAtom := sdk.NewCoins(sdk.NewInt64Coin("atom", 43))
+	leftAtom := sdk.NewCoins(sdk.NewInt64Coin("atom", 512))
+
+	cases := map[string]struct {
+		allow *BasicFeeAllowance
+		valid bool
+		// all other checks are ignored if valid=false
+		fee         sdk.Coins
+		blockTime   time.Time
+		blockHeight int64
+		accept      bool
+		remove      bool
+		remains     sdk.Coins
+	}{
+		"empty": {
+			allow:  &BasicFeeAllowance{},
+			valid:  true,
+			fee:    atom,
+			accept: true,
+		},
+		"invalid spend limit": {
+			allow: &BasicFeeAllowance{SpendLimit: sdk.Coins{sdk.NewInt64Coin("atom", 0)}},
+			valid: false,
+		},
+		"small fee": {
+			allow:   NewBasicFeeAllowance(atom, ExpiresAt{}),
+			valid:   true,
+			fee:     smallAtom,
+			accept:  true,
+			remove:  false,
+			remains: leftAtom,
+		},
+		"all fee": {
+			allow:  NewBasicFeeAllowance(smallAtom, ExpiresAt{}),
+			valid:  true,
+			fee:    smallAtom,
+			accept: true,
+			remove: true,
+		},
+		"wrong fee": {
+			allow:  NewBasicFeeAllowance(smallAtom, ExpiresAt{}),
+			valid:  true,
+			fee:    eth,
+			accept: false,
+		},
+		"non-expired": {
+			allow:       NewBasicFeeAllowance(atom, ExpiresAtHeight(100)),
+			valid:       true,
+			fee:         smallAtom,
+			blockHeight: 85,
+			accept:      true,
+			remove:      false,
+			remains:     leftAtom,
+		},
+		"expired": {
+			allow:       NewBasicFeeAllowance(atom, ExpiresAtHeight(100)),
+			valid:       true,
+			fee:         smallAtom,
+			blockHeight: 121,
+			accept:      false,
+			remove:      true,
+		},
+	}
+
+	for name, tc := range cases {
+		tc := tc
+		t.Run(name, func(t *testing.T) {
+			err := tc.allow.ValidateBasic()
+			if !tc.valid {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+
+			remove, err := tc.allow.Accept(tc.fee, tc.blockTime, tc.blockHeight)
+			if !tc.accept {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, tc.remains, tc.allow.SpendLimit)
+			}
+			require.Equal(t, tc.remove, remove)
+		})
+	}
+}
@@ -0,0 +1,42 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// Fee grant module codespace constants
+const (
+	DefaultCodespace sdk.CodespaceType = ModuleName
+
+	CodeFeeLimitExceeded sdk.CodeType = 1
+	CodeFeeLimitExpired  sdk.CodeType = 2
+	CodeInvalidDuration  sdk.CodeType = 3
+	CodeNoAllowance      sdk.CodeType = 4
+	CodeInvalidAllowance sdk.CodeType = 5
+)
+
+// ErrFeeLimitExceeded returns an error for when the fees exceed the allowance.
+func ErrFeeLimitExceeded(codespace sdk.CodespaceType) sdk.Error {
+	return sdk.NewError(codespace, CodeFeeLimitExceeded, "fee limit exceeded")
+}
+
+// ErrFeeLimitExpired returns an error for when the allowance has expired.
+func ErrFeeLimitExpired(codespace sdk.CodespaceType) sdk.Error {
+	return sdk.NewError(codespace, CodeFeeLimitExpired, "fee limit expired")
+}
+
+// ErrInvalidDuration returns an error for an invalid duration.
+func ErrInvalidDuration(codespace sdk.CodespaceType, msg string) sdk.Error {
+	return sdk.NewError(codespace, CodeInvalidDuration, "invalid duration: "+msg)
+}
+
+// ErrNoAllowance returns an error for when no allowance was granted by the
+// granter to the grantee.
+func ErrNoAllowance(codespace sdk.CodespaceType) sdk.Error {
+	return sdk.NewError(codespace, CodeNoAllowance, "no fee allowance")
+}
+
+// ErrInvalidAllowance returns an error for an invalid fee allowance.
+func ErrInvalidAllowance(codespace sdk.CodespaceType, msg string) sdk.Error {
+	return sdk.NewError(codespace, CodeInvalidAllowance, "invalid fee allowance: "+msg)
+}
@@ -0,0 +1,140 @@
+package types
+
+import (
+	"errors"
+	"time"
+)
+
+// ExpiresAt is a point in time where something expires. It may be either a
+// block time or a block height, but not both.
+type ExpiresAt struct {
+	Time   time.Time `json:"time"`
+	Height int64     `json:"height"`
+}
+
+// ExpiresAtTime creates an expiration at the given time.
+func ExpiresAtTime(t time.Time) ExpiresAt {
+	return ExpiresAt{Time: t}
+}
+
+// ExpiresAtHeight creates an expiration at the given height.
+func ExpiresAtHeight(h int64) ExpiresAt {
+	return ExpiresAt{Height: h}
+}
+
+// ValidateBasic performs basic sanity checks. Note that empty expirations are
+// allowed.
+func (e ExpiresAt) ValidateBasic() error {
+	if !e.Time.IsZero() && e.Height != 0 {
+		return errors.New("both time and height are set")
+	}
+	if e.Height < 0 {
+		return errors.New("negative height")
+	}
+	return nil
+}
+
+// IsZero returns true for an uninitialized struct.
+func (e ExpiresAt) IsZero() bool {
+	return e.Time.IsZero() && e.Height == 0
+}
+
+// FastForward produces a new expiration with the time or height set to the
+// given value, depending on which is set on the existing one. It is used to
+// start a period from the current block.
+func (e ExpiresAt) FastForward(t time.Time, h int64) ExpiresAt {
+	if !e.Time.IsZero() {
+		return ExpiresAtTime(t)
+	}
+	return ExpiresAtHeight(h)
+}
+
+// IsExpired returns whether the expiration has been reached at the given block
+// time and height. An uninitialized expiration never expires.
+func (e ExpiresAt) IsExpired(t time.Time, h int64) bool {
+	if !e.Time.IsZero() && !t.Before(e.Time) {
+		return true
+	}
+	return e.Height != 0 && h >= e.Height
+}
+
+// IsCompatible returns true if the duration can be added to the expiration,
+// i.e. they are both expressed in block time or both in block height.
+func (e ExpiresAt) IsCompatible(d Duration) bool {
+	if !e.Time.IsZero() {
+		return d.Clock > 0
+	}
+	return d.Block > 0
+}
+
+// Step increments the expiration by the given duration. It returns an error
+// if the duration is incompatible with the expiration.
+func (e ExpiresAt) Step(d Duration) (ExpiresAt, error) {
+	if !e.IsCompatible(d) {
+		return ExpiresAt{}, errors.New("expiration time and provided duration have different units")
+	}
+	if !e.Time.IsZero() {
+		e.Time = e.Time.Add(d.Clock)
+	} else {
+		e.Height += d.Block
+	}
+	return e, nil
+}
+
+// MustStep is like Step, but panics on error.
+func (e ExpiresAt) MustStep(d Duration) ExpiresAt {
+	res, err := e.Step(d)
+	if err != nil {
+		panic(err)
+	}
+	return res
+}
+
+// PrepareForExport adjusts the expiration for a genesis export, where the
+// block height restarts from zero. The time is left untouched.
+func (e ExpiresAt) PrepareForExport(dumpTime time.Time, dumpHeight int64) ExpiresAt {
+	if e.Height == 0 {
+		return e
+	}
+
+	e.Height -= dumpHeight
+	if e.Height < 1 {
+		e.Height = 1
+	}
+	return e
+}
+
+// Duration is a repeating unit of either block time or block height, but not
+// both.
+type Duration struct {
+	Clock time.Duration `json:"clock"`
+	Block int64         `json:"block"`
+}
+
+// ClockDuration creates a duration in block time.
+func ClockDuration(d time.Duration) Duration {
+	return Duration{Clock: d}
+}
+
+// BlockDuration creates a duration in block height.
+func BlockDuration(h int64) Duration {
+	return Duration{Block: h}
+}
+
+// ValidateBasic performs basic sanity checks. Note that exactly one of the
+// clock and block fields must be set.
+func (d Duration) ValidateBasic() error {
+	if d.Block == 0 && d.Clock == 0 {
+		return errors.New("neither time and height are set")
+	}
+	if d.Block != 0 && d.Clock != 0 {
+		return errors.New("both time and height are set")
+	}
+	if d.Block < 0 {
+		return errors.New("negative block step")
+	}
+	if d.Clock < 0 {
+		return errors.New("negative clock step")
+	}
+	return nil
+}
@@ -0,0 +1,27 @@
+package types
+
+import (
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// FeeAllowance defines the interface of the fee allowances a granter may grant
+// to a grantee, allowing the grantee to have its transaction fees paid by the
+// granter.
+type FeeAllowance interface {
+	// Accept is called when the grantee sends a transaction whose fees are paid
+	// through the allowance. It checks whether the fee can be paid at the given
+	// block time and height, and updates the allowance if that is the case.
+	//
+	// It returns true if the allowance is spent or expired and should be
+	// deleted, along with an error if the fee cannot be paid.
+	Accept(fee sdk.Coins, blockTime time.Time, blockHeight int64) (remove bool, err sdk.Error)
+
+	// ValidateBasic performs a stateless validation of the allowance.
+	ValidateBasic() sdk.Error
+
+	// PrepareForExport adjusts the allowance for a genesis export, where the
+	// block height restarts from zero.
+	PrepareForExport(dumpTime time.Time, dumpHeight int64) FeeAllowance
+}
@@ -0,0 +1,26 @@
+package types
+
+// GenesisState contains the fee allowances granted at genesis.
+type GenesisState struct {
+	FeeAllowances []FeeAllowanceGrant `json:"fee_allowances"`
+}
+
+// NewGenesisState creates a new genesis state.
+func NewGenesisState(feeAllowances []FeeAllowanceGrant) GenesisState {
+	return GenesisState{FeeAllowances: feeAllowances}
+}
+
+// DefaultGenesisState returns a default genesis state
+func DefaultGenesisState() GenesisState {
+	return NewGenesisState([]FeeAllowanceGrant{})
+}
+
+// ValidateBasic ensures all the grants in the genesis state are valid.
+func (data GenesisState) ValidateBasic() error {
+	for _, grant := range data.FeeAllowances {
+		if err := grant.ValidateBasic(); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,69 @@
+package types
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// FeeAllowanceGrant is stored in the KVStore to record a grant with full
+// context.
+type FeeAllowanceGrant struct {
+	Granter   sdk.AccAddress `json:"granter"`
+	Grantee   sdk.AccAddress `json:"grantee"`
+	Allowance FeeAllowance   `json:"allowance"`
+}
+
+// NewFeeAllowanceGrant creates a new FeeAllowanceGrant.
+func NewFeeAllowanceGrant(granter, grantee sdk.AccAddress, allowance FeeAllowance) FeeAllowanceGrant {
+	return FeeAllowanceGrant{
+		Granter:   granter,
+		Grantee:   grantee,
+		Allowance: allowance,
+	}
+}
+
+// ValidateBasic performs basic validation on the grant.
+func (g FeeAllowanceGrant) ValidateBasic() sdk.Error {
+	if g.Granter.Empty() {
+		return sdk.ErrInvalidAddress("missing granter address")
+	}
+	if g.Grantee.Empty() {
+		return sdk.ErrInvalidAddress("missing grantee address")
+	}
+	if g.Grantee.Equals(g.Granter) {
+		return sdk.ErrInvalidAddress("cannot self-grant fee authorization")
+	}
+	if g.Allowance == nil {
+		return ErrInvalidAllowance(DefaultCodespace, "missing allowance")
+	}
+	return g.Allowance.ValidateBasic()
+}
+
+// PrepareForExport adjusts the grant for a genesis export.
+func (g FeeAllowanceGrant) PrepareForExport(dumpTime time.Time, dumpHeight int64) FeeAllowanceGrant {
+	g.Allowance = g.Allowance.PrepareForExport(dumpTime, dumpHeight)
+	return g
+}
+
+// String implements the Stringer interface.
+func (g FeeAllowanceGrant) String() string {
+	return fmt.Sprintf(`Fee Allowance Grant:
+  Granter: %s
+  Grantee: %s
+  %s`, g.Granter, g.Grantee, g.Allowance)
+}
+
+// FeeAllowanceGrants is a collection of FeeAllowanceGrant
+type FeeAllowanceGrants []FeeAllowanceGrant
+
+// String implements the Stringer interface.
+func (gs FeeAllowanceGrants) String() string {
+	out := make([]string, len(gs))
+	for i, g := range gs {
+		out[i] = g.String()
+	}
+	return strings.Join(out, "\n")
+}
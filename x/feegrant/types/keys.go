@@ -0,0 +1,44 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+const (
+	// ModuleName is the name of this module
+	ModuleName = "feegrant"
+
+	// StoreKey is the store key string for the fee grant module
+	StoreKey = ModuleName
+
+	// RouterKey is the message route for the fee grant module
+	RouterKey = ModuleName
+
+	// QuerierRoute is the querier route for the fee grant module
+	QuerierRoute = ModuleName
+
+	// QueryFeeAllowances is the query endpoint for the allowances granted to a
+	// grantee
+	QueryFeeAllowances = "fees"
+)
+
+// Keys for fee grant store
+// Items are stored with the following key: values
+//
+// - 0x00<grantee_Bytes><granter_Bytes>: FeeAllowanceGrant
+var (
+	FeeAllowanceKeyPrefix = []byte{0x00}
+)
+
+// GetFeeAllowanceKey returns the key under which the allowance granted by the
+// granter to the grantee is stored. Keys are prefixed by the grantee so that
+// all the allowances of a grantee can be iterated over.
+func GetFeeAllowanceKey(granter, grantee sdk.AccAddress) []byte {
+	return append(GetFeeAllowancesByGranteeKey(grantee), granter.Bytes()...)
+}
+
+// GetFeeAllowancesByGranteeKey returns the prefix of the keys of all the
+// allowances granted to the grantee.
+func GetFeeAllowancesByGranteeKey(grantee sdk.AccAddress) []byte {
+	return append(FeeAllowanceKeyPrefix, grantee.Bytes()...)
+}
@@ -0,0 +1,159 @@
+package types
+
+import (
+	"fmt"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+var _ FeeAllowance = (*PeriodicFeeAllowance)(nil)
+
+// PeriodicFeeAllowance extends BasicFeeAllowance to allow for both a maximum
+// cap, as well as a limit per time period.
+type PeriodicFeeAllowance struct {
+	// Basic specifies the maximum amount of tokens that can be spent through the
+	// allowance over all periods, as well as its expiration.
+	Basic BasicFeeAllowance `json:"basic"`
+
+	// Period specifies the length of a period, in block time or height.
+	Period Duration `json:"period"`
+
+	// PeriodSpendLimit specifies the maximum amount of tokens that can be spent
+	// in a period.
+	PeriodSpendLimit sdk.Coins `json:"period_spend_limit"`
+
+	// PeriodCanSpend is the amount of tokens left to be spent before the period
+	// resets.
+	PeriodCanSpend sdk.Coins `json:"period_can_spend"`
+
+	// PeriodReset is the point at which the current period ends and a new one
+	// starts. If it is empty, the first period starts when the allowance is
+	// first used.
+	PeriodReset ExpiresAt `json:"period_reset"`
+}
+
+// NewPeriodicFeeAllowance creates a new PeriodicFeeAllowance whose first period
+// starts when the allowance is first used.
+func NewPeriodicFeeAllowance(basic BasicFeeAllowance, period Duration, periodSpendLimit sdk.Coins) *PeriodicFeeAllowance {
+	return &PeriodicFeeAllowance{
+		Basic:            basic,
+		Period:           period,
+		PeriodSpendLimit: periodSpendLimit,
+		PeriodCanSpend:   periodSpendLimit,
+	}
+}
+
+// Accept implements FeeAllowance. The fee is charged against both the limit of
+// the current period and the overall limit. The allowance is removed once it
+// is expired or its overall limit is used up.
+func (a *PeriodicFeeAllowance) Accept(fee sdk.Coins, blockTime time.Time, blockHeight int64) (bool, sdk.Error) {
+	if a.Basic.Expiration.IsExpired(blockTime, blockHeight) {
+		return true, ErrFeeLimitExpired(DefaultCodespace)
+	}
+
+	a.tryResetPeriod(blockTime, blockHeight)
+
+	// deduct from both the current period and the overall limit
+	periodLeft, invalid := a.PeriodCanSpend.SafeSub(fee)
+	if invalid {
+		return false, ErrFeeLimitExceeded(DefaultCodespace)
+	}
+	a.PeriodCanSpend = periodLeft
+
+	if a.Basic.SpendLimit.Empty() {
+		return false, nil
+	}
+
+	left, invalid := a.Basic.SpendLimit.SafeSub(fee)
+	if invalid {
+		return false, ErrFeeLimitExceeded(DefaultCodespace)
+	}
+	a.Basic.SpendLimit = left
+
+	return left.IsZero(), nil
+}
+
+// tryResetPeriod starts a new period if the current one is over, resetting
+// the amount that can be spent to the lesser of PeriodSpendLimit and the
+// overall limit left. The new period starts at the end of the current one,
+// unless a whole period was skipped, in which case it starts at the current
+// block.
+func (a *PeriodicFeeAllowance) tryResetPeriod(blockTime time.Time, blockHeight int64) {
+	if !a.PeriodReset.IsZero() && !a.PeriodReset.IsExpired(blockTime, blockHeight) {
+		return
+	}
+
+	if _, isNeg := a.Basic.SpendLimit.SafeSub(a.PeriodSpendLimit); isNeg && !a.Basic.SpendLimit.Empty() {
+		a.PeriodCanSpend = a.Basic.SpendLimit
+	} else {
+		a.PeriodCanSpend = a.PeriodSpendLimit
+	}
+
+	if !a.PeriodReset.IsZero() {
+		a.PeriodReset = a.PeriodReset.MustStep(a.Period)
+		if !a.PeriodReset.IsExpired(blockTime, blockHeight) {
+			return
+		}
+	}
+
+	if a.Period.Clock > 0 {
+		a.PeriodReset = ExpiresAtTime(blockTime).MustStep(a.Period)
+	} else {
+		a.PeriodReset = ExpiresAtHeight(blockHeight).MustStep(a.Period)
+	}
+}
+
+// PrepareForExport implements FeeAllowance.
+func (a *PeriodicFeeAllowance) PrepareForExport(dumpTime time.Time, dumpHeight int64) FeeAllowance {
+	return &PeriodicFeeAllowance{
+		Basic: BasicFeeAllowance{
+			SpendLimit: a.Basic.SpendLimit,
+			Expiration: a.Basic.Expiration.PrepareForExport(dumpTime, dumpHeight),
+		},
+		Period:           a.Period,
+		PeriodSpendLimit: a.PeriodSpendLimit,
+		PeriodCanSpend:   a.PeriodCanSpend,
+		PeriodReset:      a.PeriodReset.PrepareForExport(dumpTime, dumpHeight),
+	}
+}
+
+// ValidateBasic implements FeeAllowance.
+func (a PeriodicFeeAllowance) ValidateBasic() sdk.Error {
+	if err := a.Basic.ValidateBasic(); err != nil {
+		return err
+	}
+
+	if !a.PeriodSpendLimit.IsValid() || a.PeriodSpendLimit.Empty() {
+		return sdk.ErrInvalidCoins("invalid period spend limit: " + a.PeriodSpendLimit.String())
+	}
+	if !a.PeriodCanSpend.IsValid() {
+		return sdk.ErrInvalidCoins("invalid period can spend: " + a.PeriodCanSpend.String())
+	}
+
+	if err := a.Period.ValidateBasic(); err != nil {
+		return ErrInvalidDuration(DefaultCodespace, err.Error())
+	}
+	if err := a.PeriodReset.ValidateBasic(); err != nil {
+		return ErrInvalidAllowance(DefaultCodespace, err.Error())
+	}
+	if !a.PeriodReset.IsZero() && !a.PeriodReset.IsCompatible(a.Period) {
+		return ErrInvalidDuration(DefaultCodespace, "period and period reset have different units")
+	}
+
+	return nil
+}
+
+// String implements the Stringer interface.
+func (a PeriodicFeeAllowance) String() string {
+	return fmt.Sprintf(`Periodic Fee Allowance:
+  Spend Limit:        %s
+  Expiration:         %v
+  Period:             %v
+  Period Spend Limit: %s
+  Period Can Spend:   %s
+  Period Reset:       %v`,
+		a.Basic.SpendLimit, a.Basic.Expiration, a.Period,
+		a.PeriodSpendLimit, a.PeriodCanSpend, a.PeriodReset,
+	)
+}
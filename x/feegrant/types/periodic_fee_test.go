@@ -0,0 +1,228 @@
+package types
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func TestPeriodicFeeValidAllow(t *testing.T) {
+	atom := sdk.NewCoins(sdk.NewInt64Coin("atom", 555))
+	smallAtom := sdk.NewCoins(sdk.NewInt64Coin("atom", 43))
+	leftAtom := sdk.NewCoins(sdk.NewInt64Coin("atom", 512))
+	oneAtom := sdk.NewCoins(sdk.NewInt64Coin("atom", 1))
+	eth := sdk.NewCoins(sdk.NewInt64Coin("eth", 1))
+
+	cases := map[string]struct {
+		allow PeriodicFeeAllowance
+		valid bool
+		// all other checks are ignored if valid=false
+		fee           sdk.Coins
+		blockTime     time.Time
+		blockHeight   int64
+		accept        bool
+		remove        bool
+		remains       sdk.Coins
+		remainsPeriod sdk.Coins
+		periodReset   ExpiresAt
+	}{
+		"empty": {
+			allow: PeriodicFeeAllowance{},
+			valid: false,
+		},
+		"only basic": {
+			allow: PeriodicFeeAllowance{
+				Basic: BasicFeeAllowance{
+					SpendLimit: atom,
+					Expiration: ExpiresAtHeight(100),
+				},
+			},
+			valid: false,
+		},
+		"empty basic": {
+			allow: PeriodicFeeAllowance{
+				Period:           BlockDuration(10),
+				PeriodSpendLimit: smallAtom,
+				PeriodReset:      ExpiresAtHeight(70),
+			},
+			valid:         true,
+			blockHeight:   75,
+			fee:           smallAtom,
+			accept:        true,
+			remove:        false,
+			remainsPeriod: nil,
+			periodReset:   ExpiresAtHeight(80),
+		},
+		"mismatched units": {
+			allow: PeriodicFeeAllowance{
+				Period:           BlockDuration(10),
+				PeriodSpendLimit: smallAtom,
+				PeriodReset:      ExpiresAtTime(time.Now()),
+			},
+			valid: false,
+		},
+		"first time": {
+			allow: PeriodicFeeAllowance{
+				Basic: BasicFeeAllowance{
+					SpendLimit: atom,
+					Expiration: ExpiresAtHeight(100),
+				},
+				Period:           BlockDuration(10),
+				PeriodSpendLimit: smallAtom,
+			},
+			valid:         true,
+			fee:           smallAtom,
+			blockHeight:   75,
+			accept:        true,
+			remove:        false,
+			remainsPeriod: nil,
+			remains:       leftAtom,
+			periodReset:   ExpiresAtHeight(85),
+		},
+		"same period": {
+			allow: PeriodicFeeAllowance{
+				Basic: BasicFeeAllowance{
+					SpendLimit: atom,
+					Expiration: ExpiresAtHeight(100),
+				},
+				Period:           BlockDuration(10),
+				PeriodReset:      ExpiresAtHeight(80),
+				PeriodSpendLimit: leftAtom,
+				PeriodCanSpend:   smallAtom,
+			},
+			valid:         true,
+			fee:           smallAtom,
+			blockHeight:   75,
+			accept:        true,
+			remove:        false,
+			remainsPeriod: nil,
+			remains:       leftAtom,
+			periodReset:   ExpiresAtHeight(80),
+		},
+		"step one period": {
+			allow: PeriodicFeeAllowance{
+				Basic: BasicFeeAllowance{
+					SpendLimit: atom,
+					Expiration: ExpiresAtHeight(100),
+				},
+				Period:           BlockDuration(10),
+				PeriodReset:      ExpiresAtHeight(70),
+				PeriodSpendLimit: leftAtom,
+			},
+			valid:         true,
+			fee:           leftAtom,
+			blockHeight:   75,
+			accept:        true,
+			remove:        false,
+			remainsPeriod: nil,
+			remains:       smallAtom,
+			periodReset:   ExpiresAtHeight(80), // one step from last reset, not now
+		},
+		"step limited by global allowance": {
+			allow: PeriodicFeeAllowance{
+				Basic: BasicFeeAllowance{
+					SpendLimit: smallAtom,
+					Expiration: ExpiresAtHeight(100),
+				},
+				Period:           BlockDuration(10),
+				PeriodReset:      ExpiresAtHeight(70),
+				PeriodSpendLimit: atom,
+			},
+			valid:         true,
+			fee:           oneAtom,
+			blockHeight:   75,
+			accept:        true,
+			remove:        false,
+			remainsPeriod: smallAtom.Sub(oneAtom),
+			remains:       smallAtom.Sub(oneAtom),
+			periodReset:   ExpiresAtHeight(80), // one step from last reset, not now
+		},
+		"expired": {
+			allow: PeriodicFeeAllowance{
+				Basic: BasicFeeAllowance{
+					SpendLimit: atom,
+					Expiration: ExpiresAtHeight(100),
+				},
+				Period:           BlockDuration(10),
+				PeriodSpendLimit: smallAtom,
+			},
+			valid:       true,
+			fee:         smallAtom,
+			blockHeight: 101,
+			accept:      false,
+			remove:      true,
+		},
+		"over period limit": {
+			allow: PeriodicFeeAllowance{
+				Basic: BasicFeeAllowance{
+					SpendLimit: atom,
+					Expiration: ExpiresAtHeight(100),
+				},
+				Period:           BlockDuration(10),
+				PeriodReset:      ExpiresAtHeight(80),
+				PeriodSpendLimit: leftAtom,
+				PeriodCanSpend:   smallAtom,
+			},
+			valid:       true,
+			fee:         leftAtom,
+			blockHeight: 70,
+			accept:      false,
+			remove:      false,
+		},
+		"wrong denom": {
+			allow: PeriodicFeeAllowance{
+				Basic: BasicFeeAllowance{
+					SpendLimit: atom,
+				},
+				Period:           BlockDuration(10),
+				PeriodSpendLimit: smallAtom,
+			},
+			valid:       true,
+			fee:         eth,
+			blockHeight: 70,
+			accept:      false,
+			remove:      false,
+		},
+		"skipped periods": {
+			allow: PeriodicFeeAllowance{
+				Period:           ClockDuration(time.Hour),
+				PeriodReset:      ExpiresAtTime(time.Date(2019, 1, 1, 0, 0, 0, 0, time.UTC)),
+				PeriodSpendLimit: smallAtom,
+			},
+			valid:         true,
+			fee:           oneAtom,
+			blockTime:     time.Date(2019, 1, 2, 0, 30, 0, 0, time.UTC),
+			accept:        true,
+			remove:        false,
+			remainsPeriod: smallAtom.Sub(oneAtom),
+			periodReset:   ExpiresAtTime(time.Date(2019, 1, 2, 1, 30, 0, 0, time.UTC)),
+		},
+	}
+
+	for name, tc := range cases {
+		tc := tc
+		t.Run(name, func(t *testing.T) {
+			err := tc.allow.ValidateBasic()
+			if !tc.valid {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+
+			remove, err := tc.allow.Accept(tc.fee, tc.blockTime, tc.blockHeight)
+			require.Equal(t, tc.remove, remove)
+			if !tc.accept {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+
+			require.Equal(t, tc.remains, tc.allow.Basic.SpendLimit)
+			require.Equal(t, tc.remainsPeriod, tc.allow.PeriodCanSpend)
+			require.Equal(t, tc.periodReset, tc.allow.PeriodReset)
+		})
+	}
+}
@@ -0,0 +1,16 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// QueryFeeAllowancesParams defines the params for querying the allowances
+// granted to a grantee.
+type QueryFeeAllowancesParams struct {
+	Grantee sdk.AccAddress `json:"grantee"`
+}
+
+// NewQueryFeeAllowancesParams creates a new QueryFeeAllowancesParams instance.
+func NewQueryFeeAllowancesParams(grantee sdk.AccAddress) QueryFeeAllowancesParams {
+	return QueryFeeAllowancesParams{Grantee: grantee}
+}
@@ -123,10 +123,10 @@ func CollectStdTxs(cdc *codec.Codec, moniker, genTxsDir string,
 		return appGenTxs, persistentPeers, err
 	}
 
-	addrMap := make(map[string]auth.Account)
+	addrMap := make(map[string]sdk.Coins)
 	genAccIterator.IterateGenesisAccounts(cdc, appState,
-		func(acc auth.Account) (stop bool) {
-			addrMap[acc.GetAddress().String()] = acc
+		func(acc auth.Account, coins sdk.Coins) (stop bool) {
+			addrMap[acc.GetAddress().String()] = coins
 			return false
 		},
 	)
@@ -173,7 +173,7 @@ func CollectStdTxs(cdc *codec.Codec, moniker, genTxsDir string,
 		delAddr := msg.DelegatorAddress.String()
 		valAddr := sdk.AccAddress(msg.ValidatorAddress).String()
 
-		delAcc, delOk := addrMap[delAddr]
+		delCoins, delOk := addrMap[delAddr]
 		if !delOk {
 			return appGenTxs, persistentPeers, fmt.Errorf(
 				"account %v not in genesis.json: %+v", delAddr, addrMap)
@@ -185,10 +185,10 @@ func CollectStdTxs(cdc *codec.Codec, moniker, genTxsDir string,
 				"account %v not in genesis.json: %+v", valAddr, addrMap)
 		}
 
-		if delAcc.GetCoins().AmountOf(msg.Value.Denom).LT(msg.Value.Amount) {
+		if delCoins.AmountOf(msg.Value.Denom).LT(msg.Value.Amount) {
 			return appGenTxs, persistentPeers, fmt.Errorf(
 				"insufficient fund for delegation %v: %v < %v",
-				delAcc.GetAddress(), delAcc.GetCoins().AmountOf(msg.Value.Denom), msg.Value.Amount,
+				delAddr, delCoins.AmountOf(msg.Value.Denom), msg.Value.Amount,
 			)
 		}
 
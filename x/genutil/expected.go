@@ -27,6 +27,6 @@ type GenesisAccountsIterator interface {
 	IterateGenesisAccounts(
 		cdc *codec.Codec,
 		appGenesis map[string]json.RawMessage,
-		iterateFn func(auth.Account) (stop bool),
+		iterateFn func(acc auth.Account, coins sdk.Coins) (stop bool),
 	)
 }
@@ -43,9 +43,8 @@ func ValidateAccountInGenesis(appGenesisState map[string]json.RawMessage,
 
 	var err error
 	genAccIterator.IterateGenesisAccounts(cdc, appGenesisState,
-		func(acc auth.Account) (stop bool) {
+		func(acc auth.Account, accCoins sdk.Coins) (stop bool) {
 			accAddress := acc.GetAddress()
-			accCoins := acc.GetCoins()
 
 			// Ensure that account is in genesis
 			if accAddress.Equals(key) {
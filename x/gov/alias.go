@@ -9,149 +9,215 @@ import (
 )
 
 const (
-	MaxDescriptionLength         = types.MaxDescriptionLength
-	MaxTitleLength               = types.MaxTitleLength
-	DefaultCodespace             = types.DefaultCodespace
-	CodeUnknownProposal          = types.CodeUnknownProposal
-	CodeInactiveProposal         = types.CodeInactiveProposal
-	CodeAlreadyActiveProposal    = types.CodeAlreadyActiveProposal
-	CodeAlreadyFinishedProposal  = types.CodeAlreadyFinishedProposal
-	CodeAddressNotStaked         = types.CodeAddressNotStaked
-	CodeInvalidContent           = types.CodeInvalidContent
-	CodeInvalidProposalType      = types.CodeInvalidProposalType
-	CodeInvalidVote              = types.CodeInvalidVote
-	CodeInvalidGenesis           = types.CodeInvalidGenesis
-	CodeInvalidProposalStatus    = types.CodeInvalidProposalStatus
-	CodeProposalHandlerNotExists = types.CodeProposalHandlerNotExists
-	ModuleName                   = types.ModuleName
-	StoreKey                     = types.StoreKey
-	RouterKey                    = types.RouterKey
-	QuerierRoute                 = types.QuerierRoute
-	DefaultParamspace            = types.DefaultParamspace
-	TypeMsgDeposit               = types.TypeMsgDeposit
-	TypeMsgVote                  = types.TypeMsgVote
-	TypeMsgSubmitProposal        = types.TypeMsgSubmitProposal
-	StatusNil                    = types.StatusNil
-	StatusDepositPeriod          = types.StatusDepositPeriod
-	StatusVotingPeriod           = types.StatusVotingPeriod
-	StatusPassed                 = types.StatusPassed
-	StatusRejected               = types.StatusRejected
-	StatusFailed                 = types.StatusFailed
-	ProposalTypeText             = types.ProposalTypeText
-	ProposalTypeSoftwareUpgrade  = types.ProposalTypeSoftwareUpgrade
-	QueryParams                  = types.QueryParams
-	QueryProposals               = types.QueryProposals
-	QueryProposal                = types.QueryProposal
-	QueryDeposits                = types.QueryDeposits
-	QueryDeposit                 = types.QueryDeposit
-	QueryVotes                   = types.QueryVotes
-	QueryVote                    = types.QueryVote
-	QueryTally                   = types.QueryTally
-	ParamDeposit                 = types.ParamDeposit
-	ParamVoting                  = types.ParamVoting
-	ParamTallying                = types.ParamTallying
-	OptionEmpty                  = types.OptionEmpty
-	OptionYes                    = types.OptionYes
-	OptionAbstain                = types.OptionAbstain
-	OptionNo                     = types.OptionNo
-	OptionNoWithVeto             = types.OptionNoWithVeto
+	MaxDescriptionLength                    = types.MaxDescriptionLength
+	MaxTitleLength                          = types.MaxTitleLength
+	DefaultCodespace                        = types.DefaultCodespace
+	CodeUnknownProposal                     = types.CodeUnknownProposal
+	CodeInactiveProposal                    = types.CodeInactiveProposal
+	CodeAlreadyActiveProposal               = types.CodeAlreadyActiveProposal
+	CodeAlreadyFinishedProposal             = types.CodeAlreadyFinishedProposal
+	CodeAddressNotStaked                    = types.CodeAddressNotStaked
+	CodeInvalidContent                      = types.CodeInvalidContent
+	CodeInvalidProposalType                 = types.CodeInvalidProposalType
+	CodeInvalidVote                         = types.CodeInvalidVote
+	CodeInvalidGenesis                      = types.CodeInvalidGenesis
+	CodeInvalidProposalStatus               = types.CodeInvalidProposalStatus
+	CodeProposalHandlerNotExists            = types.CodeProposalHandlerNotExists
+	CodeProposalMsgFailed                   = types.CodeProposalMsgFailed
+	CodeInvalidProposer                     = types.CodeInvalidProposer
+	CodeInvalidRepresentative               = types.CodeInvalidRepresentative
+	CodeUnknownVoteDelegation               = types.CodeUnknownVoteDelegation
+	EventTypeSubmitProposal                 = types.EventTypeSubmitProposal
+	EventTypeProposalDeposit                = types.EventTypeProposalDeposit
+	EventTypeProposalVote                   = types.EventTypeProposalVote
+	EventTypeInactiveProposal               = types.EventTypeInactiveProposal
+	EventTypeActiveProposal                 = types.EventTypeActiveProposal
+	EventTypeCancelProposal                 = types.EventTypeCancelProposal
+	EventTypeSetRepresentative              = types.EventTypeSetRepresentative
+	EventTypeClearRepresentative            = types.EventTypeClearRepresentative
+	AttributeKeyProposalResult              = types.AttributeKeyProposalResult
+	AttributeKeyOption                      = types.AttributeKeyOption
+	AttributeKeyProposalID                  = types.AttributeKeyProposalID
+	AttributeKeyVotingPeriodStart           = types.AttributeKeyVotingPeriodStart
+	AttributeKeyRepresentative              = types.AttributeKeyRepresentative
+	AttributeKeyProposalType                = types.AttributeKeyProposalType
+	AttributeValueCategory                  = types.AttributeValueCategory
+	AttributeValueProposalDropped           = types.AttributeValueProposalDropped
+	AttributeValueProposalPassed            = types.AttributeValueProposalPassed
+	AttributeValueProposalRejected          = types.AttributeValueProposalRejected
+	AttributeValueProposalFailed            = types.AttributeValueProposalFailed
+	AttributeValueExpeditedProposalRejected = types.AttributeValueExpeditedProposalRejected
+	ModuleName                              = types.ModuleName
+	StoreKey                                = types.StoreKey
+	RouterKey                               = types.RouterKey
+	QuerierRoute                            = types.QuerierRoute
+	DefaultParamspace                       = types.DefaultParamspace
+	TreasuryName                            = types.TreasuryName
+	TypeMsgDeposit                          = types.TypeMsgDeposit
+	TypeMsgVote                             = types.TypeMsgVote
+	TypeMsgVoteWeighted                     = types.TypeMsgVoteWeighted
+	TypeMsgSubmitProposal                   = types.TypeMsgSubmitProposal
+	TypeMsgCancelProposal                   = types.TypeMsgCancelProposal
+	TypeMsgSetRepresentative                = types.TypeMsgSetRepresentative
+	TypeMsgClearRepresentative              = types.TypeMsgClearRepresentative
+	StatusNil                               = types.StatusNil
+	StatusDepositPeriod                     = types.StatusDepositPeriod
+	StatusVotingPeriod                      = types.StatusVotingPeriod
+	StatusPassed                            = types.StatusPassed
+	StatusRejected                          = types.StatusRejected
+	StatusFailed                            = types.StatusFailed
+	ProposalTypeText                        = types.ProposalTypeText
+	ProposalTypeMessages                    = types.ProposalTypeMessages
+	QueryParams                             = types.QueryParams
+	QueryProposals                          = types.QueryProposals
+	QueryProposal                           = types.QueryProposal
+	QueryDeposits                           = types.QueryDeposits
+	QueryDeposit                            = types.QueryDeposit
+	QueryVotes                              = types.QueryVotes
+	QueryVote                               = types.QueryVote
+	QueryTally                              = types.QueryTally
+	QueryTallyBreakdown                     = types.QueryTallyBreakdown
+	QueryVoteDelegations                    = types.QueryVoteDelegations
+	ParamDeposit                            = types.ParamDeposit
+	ParamVoting                             = types.ParamVoting
+	ParamTallying                           = types.ParamTallying
+	OptionEmpty                             = types.OptionEmpty
+	OptionYes                               = types.OptionYes
+	OptionAbstain                           = types.OptionAbstain
+	OptionNo                                = types.OptionNo
+	OptionNoWithVeto                        = types.OptionNoWithVeto
 )
 
 var (
 	// functions aliases
-	RegisterCodec                 = types.RegisterCodec
-	RegisterProposalTypeCodec     = types.RegisterProposalTypeCodec
-	ValidateAbstract              = types.ValidateAbstract
-	NewDeposit                    = types.NewDeposit
-	ErrUnknownProposal            = types.ErrUnknownProposal
-	ErrInactiveProposal           = types.ErrInactiveProposal
-	ErrAlreadyActiveProposal      = types.ErrAlreadyActiveProposal
-	ErrAlreadyFinishedProposal    = types.ErrAlreadyFinishedProposal
-	ErrAddressNotStaked           = types.ErrAddressNotStaked
-	ErrInvalidProposalContent     = types.ErrInvalidProposalContent
-	ErrInvalidProposalType        = types.ErrInvalidProposalType
-	ErrInvalidVote                = types.ErrInvalidVote
-	ErrInvalidGenesis             = types.ErrInvalidGenesis
-	ErrNoProposalHandlerExists    = types.ErrNoProposalHandlerExists
-	ProposalKey                   = types.ProposalKey
-	ActiveProposalByTimeKey       = types.ActiveProposalByTimeKey
-	ActiveProposalQueueKey        = types.ActiveProposalQueueKey
-	InactiveProposalByTimeKey     = types.InactiveProposalByTimeKey
-	InactiveProposalQueueKey      = types.InactiveProposalQueueKey
-	DepositsKey                   = types.DepositsKey
-	DepositKey                    = types.DepositKey
-	VotesKey                      = types.VotesKey
-	VoteKey                       = types.VoteKey
-	SplitProposalKey              = types.SplitProposalKey
-	SplitActiveProposalQueueKey   = types.SplitActiveProposalQueueKey
-	SplitInactiveProposalQueueKey = types.SplitInactiveProposalQueueKey
-	SplitKeyDeposit               = types.SplitKeyDeposit
-	SplitKeyVote                  = types.SplitKeyVote
-	NewMsgSubmitProposal          = types.NewMsgSubmitProposal
-	NewMsgDeposit                 = types.NewMsgDeposit
-	NewMsgVote                    = types.NewMsgVote
-	ParamKeyTable                 = types.ParamKeyTable
-	NewDepositParams              = types.NewDepositParams
-	NewTallyParams                = types.NewTallyParams
-	NewVotingParams               = types.NewVotingParams
-	NewParams                     = types.NewParams
-	NewProposal                   = types.NewProposal
-	ProposalStatusFromString      = types.ProposalStatusFromString
-	ValidProposalStatus           = types.ValidProposalStatus
-	NewTallyResult                = types.NewTallyResult
-	NewTallyResultFromMap         = types.NewTallyResultFromMap
-	EmptyTallyResult              = types.EmptyTallyResult
-	NewTextProposal               = types.NewTextProposal
-	NewSoftwareUpgradeProposal    = types.NewSoftwareUpgradeProposal
-	RegisterProposalType          = types.RegisterProposalType
-	ContentFromProposalType       = types.ContentFromProposalType
-	IsValidProposalType           = types.IsValidProposalType
-	ProposalHandler               = types.ProposalHandler
-	NewQueryProposalParams        = types.NewQueryProposalParams
-	NewQueryDepositParams         = types.NewQueryDepositParams
-	NewQueryVoteParams            = types.NewQueryVoteParams
-	NewQueryProposalsParams       = types.NewQueryProposalsParams
-	NewVote                       = types.NewVote
-	VoteOptionFromString          = types.VoteOptionFromString
-	ValidVoteOption               = types.ValidVoteOption
+	RegisterCodec                          = types.RegisterCodec
+	RegisterProposalTypeCodec              = types.RegisterProposalTypeCodec
+	ValidateAbstract                       = types.ValidateAbstract
+	NewDeposit                             = types.NewDeposit
+	ErrUnknownProposal                     = types.ErrUnknownProposal
+	ErrInactiveProposal                    = types.ErrInactiveProposal
+	ErrAlreadyActiveProposal               = types.ErrAlreadyActiveProposal
+	ErrAlreadyFinishedProposal             = types.ErrAlreadyFinishedProposal
+	ErrAddressNotStaked                    = types.ErrAddressNotStaked
+	ErrInvalidProposalContent              = types.ErrInvalidProposalContent
+	ErrInvalidProposalType                 = types.ErrInvalidProposalType
+	ErrInvalidVote                         = types.ErrInvalidVote
+	ErrInvalidWeightedVote                 = types.ErrInvalidWeightedVote
+	ErrInvalidGenesis                      = types.ErrInvalidGenesis
+	ErrNoProposalHandlerExists             = types.ErrNoProposalHandlerExists
+	ErrProposalMsgFailed                   = types.ErrProposalMsgFailed
+	ErrInvalidProposer                     = types.ErrInvalidProposer
+	ErrInvalidRepresentative               = types.ErrInvalidRepresentative
+	ErrUnknownVoteDelegation               = types.ErrUnknownVoteDelegation
+	ProposalKey                            = types.ProposalKey
+	ActiveProposalByTimeKey                = types.ActiveProposalByTimeKey
+	ActiveProposalQueueKey                 = types.ActiveProposalQueueKey
+	InactiveProposalByTimeKey              = types.InactiveProposalByTimeKey
+	InactiveProposalQueueKey               = types.InactiveProposalQueueKey
+	DepositsKey                            = types.DepositsKey
+	DepositKey                             = types.DepositKey
+	VotesKey                               = types.VotesKey
+	VoteKey                                = types.VoteKey
+	VoteDelegationsKey                     = types.VoteDelegationsKey
+	VoteDelegationKey                      = types.VoteDelegationKey
+	VoteDelegationsByRepresentativeKey     = types.VoteDelegationsByRepresentativeKey
+	VoteDelegationByRepresentativeKey      = types.VoteDelegationByRepresentativeKey
+	SplitProposalKey                       = types.SplitProposalKey
+	SplitActiveProposalQueueKey            = types.SplitActiveProposalQueueKey
+	SplitInactiveProposalQueueKey          = types.SplitInactiveProposalQueueKey
+	SplitKeyDeposit                        = types.SplitKeyDeposit
+	SplitKeyVote                           = types.SplitKeyVote
+	SplitVoteDelegationByRepresentativeKey = types.SplitVoteDelegationByRepresentativeKey
+	NewMsgSubmitProposal                   = types.NewMsgSubmitProposal
+	NewMsgCancelProposal                   = types.NewMsgCancelProposal
+	NewMsgDeposit                          = types.NewMsgDeposit
+	NewMsgVote                             = types.NewMsgVote
+	NewMsgVoteWeighted                     = types.NewMsgVoteWeighted
+	NewMsgSetRepresentative                = types.NewMsgSetRepresentative
+	NewMsgClearRepresentative              = types.NewMsgClearRepresentative
+	ParamKeyTable                          = types.ParamKeyTable
+	NewDepositParams                       = types.NewDepositParams
+	NewTallyParams                         = types.NewTallyParams
+	NewVotingParams                        = types.NewVotingParams
+	NewParams                              = types.NewParams
+	NewProposal                            = types.NewProposal
+	ProposalStatusFromString               = types.ProposalStatusFromString
+	ValidProposalStatus                    = types.ValidProposalStatus
+	NewTallyResult                         = types.NewTallyResult
+	NewTallyResultFromMap                  = types.NewTallyResultFromMap
+	EmptyTallyResult                       = types.EmptyTallyResult
+	NewTextProposal                        = types.NewTextProposal
+	NewMessagesProposal                    = types.NewMessagesProposal
+	RegisterProposalType                   = types.RegisterProposalType
+	ContentFromProposalType                = types.ContentFromProposalType
+	IsValidProposalType                    = types.IsValidProposalType
+	ProposalHandler                        = types.ProposalHandler
+	NewQueryProposalParams                 = types.NewQueryProposalParams
+	NewQueryDepositParams                  = types.NewQueryDepositParams
+	NewQueryVoteParams                     = types.NewQueryVoteParams
+	NewQueryProposalsParams                = types.NewQueryProposalsParams
+	NewQueryVoteDelegationsParams          = types.NewQueryVoteDelegationsParams
+	NewVote                                = types.NewVote
+	VoteOptionFromString                   = types.VoteOptionFromString
+	ValidVoteOption                        = types.ValidVoteOption
+	NewWeightedVoteOption                  = types.NewWeightedVoteOption
+	NewNonSplitVoteOption                  = types.NewNonSplitVoteOption
+	WeightedVoteOptionsFromString          = types.WeightedVoteOptionsFromString
+	ValidWeightedVoteOptions               = types.ValidWeightedVoteOptions
+	NewVoteDelegation                      = types.NewVoteDelegation
+	NewVoterTally                          = types.NewVoterTally
+	NewTallyBreakdown                      = types.NewTallyBreakdown
 
 	// variable aliases
-	ModuleCdc                   = types.ModuleCdc
-	ProposalsKeyPrefix          = types.ProposalsKeyPrefix
-	ActiveProposalQueuePrefix   = types.ActiveProposalQueuePrefix
-	InactiveProposalQueuePrefix = types.InactiveProposalQueuePrefix
-	ProposalIDKey               = types.ProposalIDKey
-	DepositsKeyPrefix           = types.DepositsKeyPrefix
-	VotesKeyPrefix              = types.VotesKeyPrefix
-	ParamStoreKeyDepositParams  = types.ParamStoreKeyDepositParams
-	ParamStoreKeyVotingParams   = types.ParamStoreKeyVotingParams
-	ParamStoreKeyTallyParams    = types.ParamStoreKeyTallyParams
+	ModuleCdc                                = types.ModuleCdc
+	ProposalsKeyPrefix                       = types.ProposalsKeyPrefix
+	ActiveProposalQueuePrefix                = types.ActiveProposalQueuePrefix
+	InactiveProposalQueuePrefix              = types.InactiveProposalQueuePrefix
+	ProposalIDKey                            = types.ProposalIDKey
+	DepositsKeyPrefix                        = types.DepositsKeyPrefix
+	VotesKeyPrefix                           = types.VotesKeyPrefix
+	VoteDelegationsKeyPrefix                 = types.VoteDelegationsKeyPrefix
+	VoteDelegationsByRepresentativeKeyPrefix = types.VoteDelegationsByRepresentativeKeyPrefix
+	ParamStoreKeyDepositParams               = types.ParamStoreKeyDepositParams
+	ParamStoreKeyVotingParams                = types.ParamStoreKeyVotingParams
+	ParamStoreKeyTallyParams                 = types.ParamStoreKeyTallyParams
 )
 
 type (
-	Content                 = types.Content
-	Handler                 = types.Handler
-	Deposit                 = types.Deposit
-	Deposits                = types.Deposits
-	MsgSubmitProposal       = types.MsgSubmitProposal
-	MsgDeposit              = types.MsgDeposit
-	MsgVote                 = types.MsgVote
-	DepositParams           = types.DepositParams
-	TallyParams             = types.TallyParams
-	VotingParams            = types.VotingParams
-	Params                  = types.Params
-	Proposal                = types.Proposal
-	Proposals               = types.Proposals
-	ProposalQueue           = types.ProposalQueue
-	ProposalStatus          = types.ProposalStatus
-	TallyResult             = types.TallyResult
-	TextProposal            = types.TextProposal
-	SoftwareUpgradeProposal = types.SoftwareUpgradeProposal
-	QueryProposalParams     = types.QueryProposalParams
-	QueryDepositParams      = types.QueryDepositParams
-	QueryVoteParams         = types.QueryVoteParams
-	QueryProposalsParams    = types.QueryProposalsParams
-	Vote                    = types.Vote
-	Votes                   = types.Votes
-	VoteOption              = types.VoteOption
+	Content                    = types.Content
+	Handler                    = types.Handler
+	Deposit                    = types.Deposit
+	Deposits                   = types.Deposits
+	MsgSubmitProposal          = types.MsgSubmitProposal
+	MsgCancelProposal          = types.MsgCancelProposal
+	MsgDeposit                 = types.MsgDeposit
+	MsgVote                    = types.MsgVote
+	MsgVoteWeighted            = types.MsgVoteWeighted
+	MsgSetRepresentative       = types.MsgSetRepresentative
+	MsgClearRepresentative     = types.MsgClearRepresentative
+	DepositParams              = types.DepositParams
+	TallyParams                = types.TallyParams
+	VotingParams               = types.VotingParams
+	Params                     = types.Params
+	Proposal                   = types.Proposal
+	Proposals                  = types.Proposals
+	ProposalQueue              = types.ProposalQueue
+	ProposalStatus             = types.ProposalStatus
+	TallyResult                = types.TallyResult
+	TextProposal               = types.TextProposal
+	MessagesProposal           = types.MessagesProposal
+	QueryProposalParams        = types.QueryProposalParams
+	QueryDepositParams         = types.QueryDepositParams
+	QueryVoteParams            = types.QueryVoteParams
+	QueryProposalsParams       = types.QueryProposalsParams
+	QueryVoteDelegationsParams = types.QueryVoteDelegationsParams
+	Vote                       = types.Vote
+	Votes                      = types.Votes
+	VoteOption                 = types.VoteOption
+	WeightedVoteOption         = types.WeightedVoteOption
+	WeightedVoteOptions        = types.WeightedVoteOptions
+	VoteDelegation             = types.VoteDelegation
+	VoteDelegations            = types.VoteDelegations
+	VoterTally                 = types.VoterTally
+	TallyBreakdown             = types.TallyBreakdown
 )
@@ -7,6 +7,7 @@ import (
 
 	"github.com/spf13/viper"
 
+	"github.com/cosmos/cosmos-sdk/codec"
 	govutils "github.com/cosmos/cosmos-sdk/x/gov/client/utils"
 )
 
@@ -19,6 +20,7 @@ func parseSubmitProposalFlags() (*proposal, error) {
 		proposal.Description = viper.GetString(FlagDescription)
 		proposal.Type = govutils.NormalizeProposalType(viper.GetString(flagProposalType))
 		proposal.Deposit = viper.GetString(FlagDeposit)
+		proposal.Expedited = viper.GetBool(FlagExpedited)
 		return proposal, nil
 	}
 
@@ -27,6 +29,9 @@ func parseSubmitProposalFlags() (*proposal, error) {
 			return nil, fmt.Errorf("--%s flag provided alongside --proposal, which is a noop", flag)
 		}
 	}
+	if viper.GetBool(FlagExpedited) {
+		return nil, fmt.Errorf("--%s flag provided alongside --proposal, which is a noop", FlagExpedited)
+	}
 
 	contents, err := ioutil.ReadFile(proposalFile)
 	if err != nil {
@@ -40,3 +45,21 @@ func parseSubmitProposalFlags() (*proposal, error) {
 
 	return proposal, nil
 }
+
+// parseMessagesProposal reads and decodes a messages proposal JSON file. The
+// messages are decoded with the given codec, on which their concrete types must
+// be registered.
+func parseMessagesProposal(cdc *codec.Codec, proposalFile string) (messagesProposal, error) {
+	proposal := messagesProposal{}
+
+	contents, err := ioutil.ReadFile(proposalFile)
+	if err != nil {
+		return proposal, err
+	}
+
+	if err := cdc.UnmarshalJSON(contents, &proposal); err != nil {
+		return proposal, err
+	}
+
+	return proposal, nil
+}
@@ -6,6 +6,11 @@ import (
 
 	"github.com/spf13/viper"
 	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	"github.com/cosmos/cosmos-sdk/x/gov/types"
 )
 
 func TestParseSubmitProposalFlags(t *testing.T) {
@@ -69,3 +74,52 @@ func TestParseSubmitProposalFlags(t *testing.T) {
 	err = badJSON.Close()
 	require.Nil(t, err, "unexpected error")
 }
+
+func TestParseMessagesProposal(t *testing.T) {
+	cdc := codec.New()
+	sdk.RegisterCodec(cdc)
+	types.RegisterCodec(cdc)
+
+	okJSON, err := ioutil.TempFile("", "proposal")
+	require.Nil(t, err, "unexpected error")
+	okJSON.WriteString(`
+{
+  "title": "Test Proposal",
+  "description": "My awesome proposal",
+  "messages": [
+    {
+      "type": "cosmos-sdk/MsgVote",
+      "value": {
+        "proposal_id": "1",
+        "voter": "cosmos10d07y265gmmuvt4z0w9aw880jnsr700j6zn9kn",
+        "option": "Yes"
+      }
+    }
+  ],
+  "deposit": [{"denom": "test", "amount": "1000"}]
+}
+`)
+
+	badJSON, err := ioutil.TempFile("", "proposal")
+	require.Nil(t, err, "unexpected error")
+	badJSON.WriteString(`{"messages": [{"type": "unknown", "value": {}}]}`)
+
+	_, err = parseMessagesProposal(cdc, "fileDoesNotExist")
+	require.Error(t, err)
+
+	_, err = parseMessagesProposal(cdc, badJSON.Name())
+	require.Error(t, err)
+
+	proposal, err := parseMessagesProposal(cdc, okJSON.Name())
+	require.Nil(t, err, "unexpected error")
+	require.Equal(t, "Test Proposal", proposal.Title)
+	require.Equal(t, "My awesome proposal", proposal.Description)
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("test", 1000)), proposal.Deposit)
+	require.Len(t, proposal.Messages, 1)
+	require.Equal(t, types.NewMsgVote(authtypes.NewModuleAddress(types.ModuleName), 1, types.OptionYes), proposal.Messages[0])
+
+	err = okJSON.Close()
+	require.Nil(t, err, "unexpected error")
+	err = badJSON.Close()
+	require.Nil(t, err, "unexpected error")
+}
@@ -39,7 +39,9 @@ func GetQueryCmd(queryRoute string, cdc *codec.Codec) *cobra.Command {
 		GetCmdQueryProposer(queryRoute, cdc),
 		GetCmdQueryDeposit(queryRoute, cdc),
 		GetCmdQueryDeposits(queryRoute, cdc),
-		GetCmdQueryTally(queryRoute, cdc))...)
+		GetCmdQueryTally(queryRoute, cdc),
+		GetCmdQueryTallyBreakdown(queryRoute, cdc),
+		GetCmdQueryVoteDelegations(queryRoute, cdc))...)
 
 	return govQueryCmd
 }
@@ -589,3 +591,110 @@ $ %s query gov proposer 1
 }
 
 // DONTCOVER
+
+// GetCmdQueryTallyBreakdown implements the command to query the voting power
+// contributed by each voter to the tally of a proposal.
+func GetCmdQueryTallyBreakdown(queryRoute string, cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "tally-breakdown [proposal-id]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Get the voting power of each voter in the tally of a proposal vote",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the tally of votes on a proposal along with the voting power
+contributed by each voter, split between its own voting power and the voting
+power of the accounts it represents. The voters are only available while the
+proposal is in its voting period.
+
+Example:
+$ %s query gov tally-breakdown 1
+`,
+				version.ClientName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			// validate that the proposal id is a uint
+			proposalID, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return fmt.Errorf("proposal-id %s not a valid int, please input a valid proposal-id", args[0])
+			}
+
+			params := types.NewQueryProposalParams(proposalID)
+			bz, err := cdc.MarshalJSON(params)
+			if err != nil {
+				return err
+			}
+
+			res, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s", queryRoute, types.QueryTallyBreakdown), bz)
+			if err != nil {
+				return err
+			}
+
+			var breakdown types.TallyBreakdown
+			cdc.MustUnmarshalJSON(res, &breakdown)
+			return cliCtx.PrintOutput(breakdown)
+		},
+	}
+}
+
+// GetCmdQueryVoteDelegations implements the command to query who represents
+// whom in governance.
+func GetCmdQueryVoteDelegations(queryRoute string, cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "vote-delegations",
+		Args:  cobra.NoArgs,
+		Short: "Query the governance representatives with optional filters",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the delegations of governance voting power to representatives.
+You can filter the returns by delegator and by representative.
+
+Example:
+$ %s query gov vote-delegations --delegator cosmos1skjwj5whet0lpe65qaq4rpq03hjxlwd9nf39lk
+$ %s query gov vote-delegations --representative cosmos1skjwj5whet0lpe65qaq4rpq03hjxlwd9nf39lk
+`,
+				version.ClientName, version.ClientName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			var params types.QueryVoteDelegationsParams
+
+			if bechDelegatorAddr := viper.GetString(flagDelegator); len(bechDelegatorAddr) != 0 {
+				delegatorAddr, err := sdk.AccAddressFromBech32(bechDelegatorAddr)
+				if err != nil {
+					return err
+				}
+				params.Delegator = delegatorAddr
+			}
+
+			if bechRepresentativeAddr := viper.GetString(flagRepresentative); len(bechRepresentativeAddr) != 0 {
+				representativeAddr, err := sdk.AccAddressFromBech32(bechRepresentativeAddr)
+				if err != nil {
+					return err
+				}
+				params.Representative = representativeAddr
+			}
+
+			bz, err := cdc.MarshalJSON(params)
+			if err != nil {
+				return err
+			}
+
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			res, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s", queryRoute, types.QueryVoteDelegations), bz)
+			if err != nil {
+				return err
+			}
+
+			var delegations types.VoteDelegations
+			cdc.MustUnmarshalJSON(res, &delegations)
+			return cliCtx.PrintOutput(delegations)
+		},
+	}
+
+	cmd.Flags().String(flagDelegator, "", "(optional) filter by the delegator of the voting power")
+	cmd.Flags().String(flagRepresentative, "", "(optional) filter by the representative")
+
+	return cmd
+}
@@ -6,6 +6,7 @@ import (
 	"strings"
 
 	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
 
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/client/context"
@@ -29,6 +30,10 @@ const (
 	flagStatus       = "status"
 	flagNumLimit     = "limit"
 	FlagProposal     = "proposal"
+	FlagExpedited    = "expedited"
+
+	flagDelegator      = "delegator"
+	flagRepresentative = "representative"
 )
 
 type proposal struct {
@@ -36,6 +41,16 @@ type proposal struct {
 	Description string
 	Type        string
 	Deposit     string
+	Expedited   bool
+}
+
+// messagesProposal defines the JSON file format of a messages proposal.
+type messagesProposal struct {
+	Title       string    `json:"title"`
+	Description string    `json:"description"`
+	Messages    []sdk.Msg `json:"messages"`
+	Deposit     sdk.Coins `json:"deposit"`
+	Expedited   bool      `json:"expedited"`
 }
 
 // ProposalFlags defines the core required fields of a proposal. It is used to
@@ -63,6 +78,7 @@ func GetTxCmd(storeKey string, cdc *codec.Codec, pcmds []*cobra.Command) *cobra.
 	}
 
 	cmdSubmitProp := GetCmdSubmitProposal(cdc)
+	cmdSubmitProp.AddCommand(client.PostCommands(GetCmdSubmitMessagesProposal(cdc))[0])
 	for _, pcmd := range pcmds {
 		cmdSubmitProp.AddCommand(client.PostCommands(pcmd)[0])
 	}
@@ -70,6 +86,10 @@ func GetTxCmd(storeKey string, cdc *codec.Codec, pcmds []*cobra.Command) *cobra.
 	govTxCmd.AddCommand(client.PostCommands(
 		GetCmdDeposit(cdc),
 		GetCmdVote(cdc),
+		GetCmdWeightedVote(cdc),
+		GetCmdCancelProposal(cdc),
+		GetCmdSetRepresentative(cdc),
+		GetCmdClearRepresentative(cdc),
 		cmdSubmitProp,
 	)...)
 
@@ -94,12 +114,17 @@ Where proposal.json contains:
   "title": "Test Proposal",
   "description": "My awesome proposal",
   "type": "Text",
-  "deposit": "10test"
+  "deposit": "10test",
+  "expedited": false
 }
 
 Which is equivalent to:
 
 $ %s tx gov submit-proposal --title="Test Proposal" --description="My awesome proposal" --type="Text" --deposit="10test" --from mykey
+
+Expedited proposals require a higher deposit and a higher threshold of Yes votes,
+but have a shorter voting period. An expedited proposal that does not pass is
+converted into a regular proposal.
 `,
 				version.ClientName, version.ClientName,
 			),
@@ -122,7 +147,7 @@ $ %s tx gov submit-proposal --title="Test Proposal" --description="My awesome pr
 
 			content := types.ContentFromProposalType(proposal.Title, proposal.Description, proposal.Type)
 
-			msg := types.NewMsgSubmitProposal(content, amount, cliCtx.GetFromAddress())
+			msg := types.NewMsgSubmitProposal(content, amount, cliCtx.GetFromAddress(), proposal.Expedited)
 			if err := msg.ValidateBasic(); err != nil {
 				return err
 			}
@@ -133,13 +158,80 @@ $ %s tx gov submit-proposal --title="Test Proposal" --description="My awesome pr
 
 	cmd.Flags().String(FlagTitle, "", "title of proposal")
 	cmd.Flags().String(FlagDescription, "", "description of proposal")
-	cmd.Flags().String(flagProposalType, "", "proposalType of proposal, types: text/parameter_change/software_upgrade")
+	cmd.Flags().String(flagProposalType, "", "proposalType of proposal, types: text")
 	cmd.Flags().String(FlagDeposit, "", "deposit of proposal")
+	cmd.Flags().Bool(FlagExpedited, false, "submit an expedited proposal")
 	cmd.Flags().String(FlagProposal, "", "proposal file path (if this path is given, other proposal flags are ignored)")
 
 	return cmd
 }
 
+// GetCmdSubmitMessagesProposal implements submitting a proposal that executes
+// messages on behalf of the governance treasury account.
+func GetCmdSubmitMessagesProposal(cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "messages [proposal-file]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Submit a proposal executing messages on behalf of the governance treasury",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Submit a proposal along with an initial deposit. Once the proposal passes,
+its messages are executed atomically on behalf of the governance treasury account,
+which must be the only signer of each message. The proposal details must be
+supplied via a JSON file.
+
+Example:
+$ %s tx gov submit-proposal messages <path/to/proposal.json> --from=<key_or_address>
+
+Where proposal.json contains:
+
+{
+  "title": "Community Grant",
+  "description": "Send funds held by the governance treasury",
+  "messages": [
+    {
+      "type": "cosmos-sdk/MsgSend",
+      "value": {
+        "from_address": "cosmos187wpssafkyaudtxler5paln0rqu6ffycn3wq7e",
+        "to_address": "cosmos1...",
+        "amount": [{"denom": "stake", "amount": "10000"}]
+      }
+    }
+  ],
+  "deposit": [
+    {
+      "denom": "stake",
+      "amount": "10000"
+    }
+  ],
+  "expedited": false
+}
+`,
+				version.ClientName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			txBldr := auth.NewTxBuilderFromCLI().WithTxEncoder(utils.GetTxEncoder(cdc))
+			cliCtx := context.NewCLIContext().
+				WithCodec(cdc).
+				WithAccountDecoder(cdc)
+
+			proposal, err := parseMessagesProposal(cdc, args[0])
+			if err != nil {
+				return err
+			}
+
+			content := types.NewMessagesProposal(proposal.Title, proposal.Description, proposal.Messages)
+
+			msg := types.NewMsgSubmitProposal(content, proposal.Deposit, cliCtx.GetFromAddress(), proposal.Expedited)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return utils.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
+		},
+	}
+}
+
 // GetCmdDeposit implements depositing tokens for an active proposal.
 func GetCmdDeposit(cdc *codec.Codec) *cobra.Command {
 	return &cobra.Command{
@@ -238,4 +330,183 @@ $ %s tx gov vote 1 yes --from mykey
 	}
 }
 
+// GetCmdWeightedVote implements creating a new weighted vote command.
+func GetCmdWeightedVote(cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "weighted-vote [proposal-id] [weighted-options]",
+		Args:  cobra.ExactArgs(2),
+		Short: "Vote for an active proposal, splitting the voting power between options: yes/no/no_with_veto/abstain",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Submit a weighted vote for an active proposal. The voting power is split
+between the given options according to their weights, which must add up to 1.
+You can find the proposal-id by running "%s query gov proposals".
+
+
+Example:
+$ %s tx gov weighted-vote 1 yes=0.6,no=0.3,abstain=0.05,no_with_veto=0.05 --from mykey
+`,
+				version.ClientName, version.ClientName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			txBldr := auth.NewTxBuilderFromCLI().WithTxEncoder(utils.GetTxEncoder(cdc))
+			cliCtx := context.NewCLIContext().
+				WithCodec(cdc).
+				WithAccountDecoder(cdc)
+
+			// Get voting address
+			from := cliCtx.GetFromAddress()
+
+			// validate that the proposal id is a uint
+			proposalID, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return fmt.Errorf("proposal-id %s not a valid int, please input a valid proposal-id", args[0])
+			}
+
+			// Figure out which vote options user chose
+			options, err := types.WeightedVoteOptionsFromString(govutils.NormalizeWeightedVoteOptions(args[1]))
+			if err != nil {
+				return err
+			}
+
+			// Build vote message and run basic validation
+			msg := types.NewMsgVoteWeighted(from, proposalID, options)
+			err = msg.ValidateBasic()
+			if err != nil {
+				return err
+			}
+
+			return utils.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
+		},
+	}
+}
+
 // DONTCOVER
+
+// GetCmdCancelProposal implements cancelling a proposal by its proposer.
+func GetCmdCancelProposal(cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "cancel-proposal [proposal-id]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Cancel a governance proposal before its voting period ends",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Cancel a proposal that is still in its deposit or voting period. Only
+the original proposer can cancel a proposal; a fraction of the deposits,
+defined by the proposal_cancel_ratio parameter, is burned and the rest is
+refunded to the depositors.
+
+Example:
+$ %s tx gov cancel-proposal 1 --from mykey
+`,
+				version.ClientName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			txBldr := auth.NewTxBuilderFromCLI().WithTxEncoder(utils.GetTxEncoder(cdc))
+			cliCtx := context.NewCLIContext().
+				WithCodec(cdc).
+				WithAccountDecoder(cdc)
+
+			// validate that the proposal id is a uint
+			proposalID, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return fmt.Errorf("proposal-id %s not a valid uint, please input a valid proposal-id", args[0])
+			}
+
+			// Get proposer address
+			from := cliCtx.GetFromAddress()
+
+			msg := types.NewMsgCancelProposal(proposalID, from)
+			err = msg.ValidateBasic()
+			if err != nil {
+				return err
+			}
+
+			return utils.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
+		},
+	}
+}
+
+// GetCmdSetRepresentative implements delegating the governance voting power of
+// an account to a representative.
+func GetCmdSetRepresentative(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "set-representative [representative]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Delegate your governance voting power to a representative",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Delegate your governance voting power to a representative account.
+When you don't vote on a proposal, your voting power follows the vote of your
+representative, or of its own representative if it didn't vote either, instead
+of the votes of the validators you are delegating to. The delegation applies to
+all the proposal types, unless a proposal type is given with the --type flag.
+
+Example:
+$ %s tx gov set-representative cosmos1skjwj5whet0lpe65qaq4rpq03hjxlwd9nf39lk --from mykey
+$ %s tx gov set-representative cosmos1skjwj5whet0lpe65qaq4rpq03hjxlwd9nf39lk --type Text --from mykey
+`,
+				version.ClientName, version.ClientName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			txBldr := auth.NewTxBuilderFromCLI().WithTxEncoder(utils.GetTxEncoder(cdc))
+			cliCtx := context.NewCLIContext().
+				WithCodec(cdc).
+				WithAccountDecoder(cdc)
+
+			representative, err := sdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgSetRepresentative(cliCtx.GetFromAddress(), representative, viper.GetString(flagProposalType))
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return utils.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
+		},
+	}
+
+	cmd.Flags().String(flagProposalType, "", "(optional) type of the proposals to delegate, all of them by default")
+
+	return cmd
+}
+
+// GetCmdClearRepresentative implements removing the representative of an
+// account.
+func GetCmdClearRepresentative(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "clear-representative",
+		Args:  cobra.NoArgs,
+		Short: "Remove your governance representative",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Remove the representative you delegated your governance voting power
+to, for all the proposal types or for the proposal type given with the --type flag.
+
+Example:
+$ %s tx gov clear-representative --from mykey
+$ %s tx gov clear-representative --type Text --from mykey
+`,
+				version.ClientName, version.ClientName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			txBldr := auth.NewTxBuilderFromCLI().WithTxEncoder(utils.GetTxEncoder(cdc))
+			cliCtx := context.NewCLIContext().
+				WithCodec(cdc).
+				WithAccountDecoder(cdc)
+
+			msg := types.NewMsgClearRepresentative(cliCtx.GetFromAddress(), viper.GetString(flagProposalType))
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return utils.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
+		},
+	}
+
+	cmd.Flags().String(flagProposalType, "", "(optional) type of the proposals of the delegation, all of them by default")
+
+	return cmd
+}
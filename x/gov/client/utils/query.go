@@ -6,7 +6,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/client/context"
 	"github.com/cosmos/cosmos-sdk/client/tx"
 	"github.com/cosmos/cosmos-sdk/codec"
-	"github.com/cosmos/cosmos-sdk/x/gov/tags"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/x/gov/types"
 )
 
@@ -31,7 +31,7 @@ func (p Proposer) String() string {
 	return fmt.Sprintf("Proposal with ID %d was proposed by %s", p.ProposalID, p.Proposer)
 }
 
-// QueryDepositsByTxQuery will query for deposits via a direct txs tags query. It
+// QueryDepositsByTxQuery will query for deposits via a direct txs events query. It
 // will fetch and build deposits directly from the returned txs and return a
 // JSON marshalled result or any error that occurred.
 //
@@ -41,14 +41,14 @@ func QueryDepositsByTxQuery(
 	cdc *codec.Codec, cliCtx context.CLIContext, params types.QueryProposalParams,
 ) ([]byte, error) {
 
-	tags := []string{
-		fmt.Sprintf("%s='%s'", tags.Action, types.MsgDeposit{}.Type()),
-		fmt.Sprintf("%s='%s'", tags.ProposalID, []byte(fmt.Sprintf("%d", params.ProposalID))),
+	events := []string{
+		fmt.Sprintf("%s.%s='%s'", sdk.EventTypeMessage, sdk.AttributeKeyAction, types.TypeMsgDeposit),
+		fmt.Sprintf("%s.%s='%s'", types.EventTypeProposalDeposit, types.AttributeKeyProposalID, []byte(fmt.Sprintf("%d", params.ProposalID))),
 	}
 
 	// NOTE: SearchTxs is used to facilitate the txs query which does not currently
 	// support configurable pagination.
-	searchResult, err := tx.SearchTxs(cliCtx, cdc, tags, defaultPage, defaultLimit)
+	searchResult, err := tx.SearchTxs(cliCtx, cdc, events, defaultPage, defaultLimit)
 	if err != nil {
 		return nil, err
 	}
@@ -76,7 +76,7 @@ func QueryDepositsByTxQuery(
 	return cdc.MarshalJSON(deposits)
 }
 
-// QueryVotesByTxQuery will query for votes via a direct txs tags query. It
+// QueryVotesByTxQuery will query for votes via a direct txs events query. It
 // will fetch and build votes directly from the returned txs and return a JSON
 // marshalled result or any error that occurred.
 //
@@ -86,34 +86,11 @@ func QueryVotesByTxQuery(
 	cdc *codec.Codec, cliCtx context.CLIContext, params types.QueryProposalParams,
 ) ([]byte, error) {
 
-	tags := []string{
-		fmt.Sprintf("%s='%s'", tags.Action, types.MsgVote{}.Type()),
-		fmt.Sprintf("%s='%s'", tags.ProposalID, []byte(fmt.Sprintf("%d", params.ProposalID))),
-	}
-
-	// NOTE: SearchTxs is used to facilitate the txs query which does not currently
-	// support configurable pagination.
-	searchResult, err := tx.SearchTxs(cliCtx, cdc, tags, defaultPage, defaultLimit)
+	votes, err := searchVotes(cdc, cliCtx, params.ProposalID)
 	if err != nil {
 		return nil, err
 	}
 
-	var votes []types.Vote
-
-	for _, info := range searchResult.Txs {
-		for _, msg := range info.Tx.GetMsgs() {
-			if msg.Type() == types.TypeMsgVote {
-				voteMsg := msg.(types.MsgVote)
-
-				votes = append(votes, types.Vote{
-					Voter:      voteMsg.Voter,
-					ProposalID: params.ProposalID,
-					Option:     voteMsg.Option,
-				})
-			}
-		}
-	}
-
 	if cliCtx.Indent {
 		return cdc.MarshalJSONIndent(votes, "", "  ")
 	}
@@ -121,63 +98,92 @@ func QueryVotesByTxQuery(
 	return cdc.MarshalJSON(votes)
 }
 
-// QueryVoteByTxQuery will query for a single vote via a direct txs tags query.
+// QueryVoteByTxQuery will query for a single vote via a direct txs events query.
 func QueryVoteByTxQuery(
 	cdc *codec.Codec, cliCtx context.CLIContext, params types.QueryVoteParams,
 ) ([]byte, error) {
 
-	tags := []string{
-		fmt.Sprintf("%s='%s'", tags.Action, types.MsgVote{}.Type()),
-		fmt.Sprintf("%s='%s'", tags.ProposalID, []byte(fmt.Sprintf("%d", params.ProposalID))),
-		fmt.Sprintf("%s='%s'", tags.Sender, []byte(params.Voter.String())),
-	}
-
-	// NOTE: SearchTxs is used to facilitate the txs query which does not currently
-	// support configurable pagination.
-	searchResult, err := tx.SearchTxs(cliCtx, cdc, tags, defaultPage, defaultLimit)
+	votes, err := searchVotes(
+		cdc, cliCtx, params.ProposalID,
+		fmt.Sprintf("%s.%s='%s'", sdk.EventTypeMessage, sdk.AttributeKeySender, []byte(params.Voter.String())),
+	)
 	if err != nil {
 		return nil, err
 	}
 
-	for _, info := range searchResult.Txs {
-		for _, msg := range info.Tx.GetMsgs() {
-			// there should only be a single vote under the given conditions
-			if msg.Type() == types.TypeMsgVote {
-				voteMsg := msg.(types.MsgVote)
+	// there should only be a single vote under the given conditions
+	for _, vote := range votes {
+		if vote.Voter.Equals(params.Voter) {
+			if cliCtx.Indent {
+				return cdc.MarshalJSONIndent(vote, "", "  ")
+			}
 
-				vote := types.Vote{
-					Voter:      voteMsg.Voter,
-					ProposalID: params.ProposalID,
-					Option:     voteMsg.Option,
-				}
+			return cdc.MarshalJSON(vote)
+		}
+	}
 
-				if cliCtx.Indent {
-					return cdc.MarshalJSONIndent(vote, "", "  ")
-				}
+	return nil, fmt.Errorf("address '%s' did not vote on proposalID %d", params.Voter, params.ProposalID)
+}
 
-				return cdc.MarshalJSON(vote)
+// searchVotes builds the votes on a proposal from the txs containing either a
+// MsgVote or a MsgVoteWeighted and matching the given additional events.
+//
+// NOTE: SearchTxs is used to facilitate the txs query which does not currently
+// support configurable pagination.
+func searchVotes(
+	cdc *codec.Codec, cliCtx context.CLIContext, proposalID uint64, extraEvents ...string,
+) ([]types.Vote, error) {
+
+	var votes []types.Vote
+
+	// the events query can't match any of several message actions, so each vote
+	// message type is searched for separately
+	for _, action := range []string{types.TypeMsgVote, types.TypeMsgVoteWeighted} {
+		events := append([]string{
+			fmt.Sprintf("%s.%s='%s'", sdk.EventTypeMessage, sdk.AttributeKeyAction, action),
+			fmt.Sprintf("%s.%s='%s'", types.EventTypeProposalVote, types.AttributeKeyProposalID, []byte(fmt.Sprintf("%d", proposalID))),
+		}, extraEvents...)
+
+		searchResult, err := tx.SearchTxs(cliCtx, cdc, events, defaultPage, defaultLimit)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, info := range searchResult.Txs {
+			for _, msg := range info.Tx.GetMsgs() {
+				switch msg := msg.(type) {
+				case types.MsgVote:
+					if msg.ProposalID == proposalID {
+						votes = append(votes, types.NewVote(proposalID, msg.Voter, types.NewNonSplitVoteOption(msg.Option)))
+					}
+
+				case types.MsgVoteWeighted:
+					if msg.ProposalID == proposalID {
+						votes = append(votes, types.NewVote(proposalID, msg.Voter, msg.Options))
+					}
+				}
 			}
 		}
 	}
 
-	return nil, fmt.Errorf("address '%s' did not vote on proposalID %d", params.Voter, params.ProposalID)
+	return votes, nil
 }
 
-// QueryDepositByTxQuery will query for a single deposit via a direct txs tags
+// QueryDepositByTxQuery will query for a single deposit via a direct txs events
 // query.
 func QueryDepositByTxQuery(
 	cdc *codec.Codec, cliCtx context.CLIContext, params types.QueryDepositParams,
 ) ([]byte, error) {
 
-	tags := []string{
-		fmt.Sprintf("%s='%s'", tags.Action, types.MsgDeposit{}.Type()),
-		fmt.Sprintf("%s='%s'", tags.ProposalID, []byte(fmt.Sprintf("%d", params.ProposalID))),
-		fmt.Sprintf("%s='%s'", tags.Sender, []byte(params.Depositor.String())),
+	events := []string{
+		fmt.Sprintf("%s.%s='%s'", sdk.EventTypeMessage, sdk.AttributeKeyAction, types.TypeMsgDeposit),
+		fmt.Sprintf("%s.%s='%s'", types.EventTypeProposalDeposit, types.AttributeKeyProposalID, []byte(fmt.Sprintf("%d", params.ProposalID))),
+		fmt.Sprintf("%s.%s='%s'", sdk.EventTypeMessage, sdk.AttributeKeySender, []byte(params.Depositor.String())),
 	}
 
 	// NOTE: SearchTxs is used to facilitate the txs query which does not currently
 	// support configurable pagination.
-	searchResult, err := tx.SearchTxs(cliCtx, cdc, tags, defaultPage, defaultLimit)
+	searchResult, err := tx.SearchTxs(cliCtx, cdc, events, defaultPage, defaultLimit)
 	if err != nil {
 		return nil, err
 	}
@@ -212,14 +218,14 @@ func QueryProposerByTxQuery(
 	cdc *codec.Codec, cliCtx context.CLIContext, proposalID uint64,
 ) (Proposer, error) {
 
-	tags := []string{
-		fmt.Sprintf("%s='%s'", tags.Action, types.MsgSubmitProposal{}.Type()),
-		fmt.Sprintf("%s='%s'", tags.ProposalID, []byte(fmt.Sprintf("%d", proposalID))),
+	events := []string{
+		fmt.Sprintf("%s.%s='%s'", sdk.EventTypeMessage, sdk.AttributeKeyAction, types.TypeMsgSubmitProposal),
+		fmt.Sprintf("%s.%s='%s'", types.EventTypeSubmitProposal, types.AttributeKeyProposalID, []byte(fmt.Sprintf("%d", proposalID))),
 	}
 
 	// NOTE: SearchTxs is used to facilitate the txs query which does not currently
 	// support configurable pagination.
-	searchResult, err := tx.SearchTxs(cliCtx, cdc, tags, defaultPage, defaultLimit)
+	searchResult, err := tx.SearchTxs(cliCtx, cdc, events, defaultPage, defaultLimit)
 	if err != nil {
 		return Proposer{}, err
 	}
@@ -1,6 +1,10 @@
 package utils
 
-import "github.com/cosmos/cosmos-sdk/x/gov/types"
+import (
+	"strings"
+
+	"github.com/cosmos/cosmos-sdk/x/gov/types"
+)
 
 // NormalizeVoteOption - normalize user specified vote option
 func NormalizeVoteOption(option string) string {
@@ -22,15 +26,24 @@ func NormalizeVoteOption(option string) string {
 	}
 }
 
+// NormalizeWeightedVoteOptions - normalize user specified weighted vote options,
+// e.g. "yes=0.6,no=0.4"
+func NormalizeWeightedVoteOptions(options string) string {
+	newOptions := []string{}
+	for _, option := range strings.Split(options, ",") {
+		fields := strings.Split(option, "=")
+		fields[0] = NormalizeVoteOption(strings.TrimSpace(fields[0]))
+		newOptions = append(newOptions, strings.Join(fields, "="))
+	}
+	return strings.Join(newOptions, ",")
+}
+
 //NormalizeProposalType - normalize user specified proposal type
 func NormalizeProposalType(proposalType string) string {
 	switch proposalType {
 	case "Text", "text":
 		return types.ProposalTypeText
 
-	case "SoftwareUpgrade", "software_upgrade":
-		return types.ProposalTypeSoftwareUpgrade
-
 	default:
 		return ""
 	}
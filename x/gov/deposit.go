@@ -1,6 +1,8 @@
 package gov
 
 import (
+	"fmt"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/x/gov/types"
 )
@@ -37,9 +39,8 @@ func (keeper Keeper) AddDeposit(ctx sdk.Context, proposalID uint64, depositorAdd
 		return ErrAlreadyFinishedProposal(keeper.codespace, proposalID), false
 	}
 
-	// Send coins from depositor's account to DepositedCoinsAccAddr account
-	// TODO: Don't use an account for this purpose; it's clumsy and prone to misuse.
-	err := keeper.ck.SendCoins(ctx, depositorAddr, DepositedCoinsAccAddr, depositAmount)
+	// update the governance module's account coins pool
+	err := keeper.supplyKeeper.SendCoinsFromAccountToModule(ctx, depositorAddr, types.ModuleName, depositAmount)
 	if err != nil {
 		return err, false
 	}
@@ -50,7 +51,7 @@ func (keeper Keeper) AddDeposit(ctx sdk.Context, proposalID uint64, depositorAdd
 
 	// Check if deposit has provided sufficient total funds to transition the proposal into the voting period
 	activatedVotingPeriod := false
-	if proposal.Status == StatusDepositPeriod && proposal.TotalDeposit.IsAllGTE(keeper.GetDepositParams(ctx).MinDeposit) {
+	if proposal.Status == StatusDepositPeriod && proposal.TotalDeposit.IsAllGTE(keeper.GetDepositParams(ctx).GetMinDeposit(proposal.Expedited)) {
 		keeper.activateVotingPeriod(ctx, proposal)
 		activatedVotingPeriod = true
 	}
@@ -65,6 +66,14 @@ func (keeper Keeper) AddDeposit(ctx sdk.Context, proposalID uint64, depositorAdd
 
 	keeper.setDeposit(ctx, proposalID, depositorAddr, deposit)
 
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeProposalDeposit,
+			sdk.NewAttribute(sdk.AttributeKeyAmount, depositAmount.String()),
+			sdk.NewAttribute(types.AttributeKeyProposalID, fmt.Sprintf("%d", proposalID)),
+		),
+	)
+
 	return nil, activatedVotingPeriod
 }
 
@@ -97,7 +106,7 @@ func (keeper Keeper) RefundDeposits(ctx sdk.Context, proposalID uint64) {
 	store := ctx.KVStore(keeper.storeKey)
 
 	keeper.IterateDeposits(ctx, proposalID, func(deposit Deposit) bool {
-		err := keeper.ck.SendCoins(ctx, DepositedCoinsAccAddr, deposit.Depositor, deposit.Amount)
+		err := keeper.supplyKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, deposit.Depositor, deposit.Amount)
 		if err != nil {
 			panic(err)
 		}
@@ -106,17 +115,55 @@ func (keeper Keeper) RefundDeposits(ctx sdk.Context, proposalID uint64) {
 	})
 }
 
-// DeleteDeposits deletes all the deposits on a specific proposal without refunding them
-func (keeper Keeper) DeleteDeposits(ctx sdk.Context, proposalID uint64) {
+// chargeDeposits burns the given ratio of each deposit on a specific proposal,
+// refunds the remainder to the depositors and deletes the deposits.
+func (keeper Keeper) chargeDeposits(ctx sdk.Context, proposalID uint64, burnRatio sdk.Dec) {
 	store := ctx.KVStore(keeper.storeKey)
 
 	keeper.IterateDeposits(ctx, proposalID, func(deposit Deposit) bool {
-		err := keeper.ck.SendCoins(ctx, DepositedCoinsAccAddr, BurnedDepositCoinsAccAddr, deposit.Amount)
-		if err != nil {
-			panic(err)
+		burnAmount, _ := sdk.NewDecCoins(deposit.Amount).MulDecTruncate(burnRatio).TruncateDecimal()
+		refundAmount := deposit.Amount.Sub(burnAmount)
+
+		if !burnAmount.Empty() {
+			keeper.burnDeposit(ctx, burnAmount)
+		}
+
+		if !refundAmount.Empty() {
+			err := keeper.supplyKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, deposit.Depositor, refundAmount)
+			if err != nil {
+				panic(err)
+			}
 		}
 
 		store.Delete(DepositKey(proposalID, deposit.Depositor))
 		return false
 	})
 }
+
+// DeleteDeposits deletes and burns all the deposits on a specific proposal
+func (keeper Keeper) DeleteDeposits(ctx sdk.Context, proposalID uint64) {
+	store := ctx.KVStore(keeper.storeKey)
+
+	keeper.IterateDeposits(ctx, proposalID, func(deposit Deposit) bool {
+		keeper.burnDeposit(ctx, deposit.Amount)
+
+		store.Delete(DepositKey(proposalID, deposit.Depositor))
+		return false
+	})
+}
+
+// burnDeposit burns the given amount from the governance module account or, if
+// the BurnToCommunityPool deposit parameter is set, sends it to the community
+// pool instead.
+func (keeper Keeper) burnDeposit(ctx sdk.Context, amount sdk.Coins) {
+	var err sdk.Error
+	if keeper.GetDepositParams(ctx).BurnToCommunityPool {
+		err = keeper.distrKeeper.FundCommunityPool(ctx, amount, keeper.supplyKeeper.GetModuleAddress(types.ModuleName))
+	} else {
+		err = keeper.supplyKeeper.BurnCoins(ctx, types.ModuleName, amount)
+	}
+
+	if err != nil {
+		panic(err)
+	}
+}
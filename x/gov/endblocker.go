@@ -4,21 +4,29 @@ import (
 	"fmt"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
-	"github.com/cosmos/cosmos-sdk/x/gov/tags"
 )
 
 // EndBlocker called every block, process inflation, update validator set
-func EndBlocker(ctx sdk.Context, keeper Keeper) sdk.Tags {
+func EndBlocker(ctx sdk.Context, keeper Keeper) {
 	logger := keeper.Logger(ctx)
-	resTags := sdk.NewTags()
 
-	// delete inactive proposal from store and its deposits
+	// delete inactive proposal from store and burn or refund its deposits
 	keeper.IterateInactiveProposalsQueue(ctx, ctx.BlockHeader().Time, func(proposal Proposal) bool {
 		keeper.DeleteProposal(ctx, proposal.ProposalID)
-		keeper.DeleteDeposits(ctx, proposal.ProposalID)
 
-		resTags = resTags.AppendTag(tags.ProposalID, fmt.Sprintf("%d", proposal.ProposalID))
-		resTags = resTags.AppendTag(tags.ProposalResult, tags.ActionProposalDropped)
+		if keeper.GetDepositParams(ctx).BurnProposalDepositPrevote {
+			keeper.DeleteDeposits(ctx, proposal.ProposalID)
+		} else {
+			keeper.RefundDeposits(ctx, proposal.ProposalID)
+		}
+
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				EventTypeInactiveProposal,
+				sdk.NewAttribute(AttributeKeyProposalID, fmt.Sprintf("%d", proposal.ProposalID)),
+				sdk.NewAttribute(AttributeKeyProposalResult, AttributeValueProposalDropped),
+			),
+		)
 
 		logger.Info(
 			fmt.Sprintf("proposal %d (%s) didn't meet minimum deposit of %s (had only %s); deleted",
@@ -33,10 +41,42 @@ func EndBlocker(ctx sdk.Context, keeper Keeper) sdk.Tags {
 
 	// fetch active proposals whose voting periods have ended (are passed the block time)
 	keeper.IterateActiveProposalsQueue(ctx, ctx.BlockHeader().Time, func(proposal Proposal) bool {
-		var tagValue, logMsg string
+		var resultValue, logMsg string
 
 		passes, burnDeposits, tallyResults := tally(ctx, keeper, proposal)
 
+		// An expedited proposal that didn't pass is converted into a regular
+		// proposal and keeps its deposits and votes until the end of the
+		// regular voting period.
+		if !passes && proposal.Expedited {
+			keeper.RemoveFromActiveProposalQueue(ctx, proposal.ProposalID, proposal.VotingEndTime)
+
+			proposal.Expedited = false
+			proposal.VotingEndTime = proposal.VotingStartTime.Add(keeper.GetVotingParams(ctx).VotingPeriod)
+
+			keeper.SetProposal(ctx, proposal)
+			keeper.InsertActiveProposalQueue(ctx, proposal.ProposalID, proposal.VotingEndTime)
+
+			logger.Info(
+				fmt.Sprintf(
+					"expedited proposal %d (%s) tallied; result: converted to regular proposal",
+					proposal.ProposalID, proposal.GetTitle(),
+				),
+			)
+
+			ctx.EventManager().EmitEvent(
+				sdk.NewEvent(
+					EventTypeActiveProposal,
+					sdk.NewAttribute(AttributeKeyProposalID, fmt.Sprintf("%d", proposal.ProposalID)),
+					sdk.NewAttribute(AttributeKeyProposalResult, AttributeValueExpeditedProposalRejected),
+				),
+			)
+
+			return false
+		}
+
+		keeper.deleteVotes(ctx, proposal.ProposalID)
+
 		if burnDeposits {
 			keeper.DeleteDeposits(ctx, proposal.ProposalID)
 		} else {
@@ -46,26 +86,29 @@ func EndBlocker(ctx sdk.Context, keeper Keeper) sdk.Tags {
 		if passes {
 			handler := keeper.router.GetRoute(proposal.ProposalRoute())
 			cacheCtx, writeCache := ctx.CacheContext()
+			cacheCtx = cacheCtx.WithEventManager(sdk.NewEventManager())
 
 			// The proposal handler may execute state mutating logic depending
 			// on the proposal content. If the handler fails, no state mutation
-			// is written and the error message is logged.
+			// is written, no event is emitted and the error message is logged.
 			err := handler(cacheCtx, proposal.Content)
 			if err == nil {
 				proposal.Status = StatusPassed
-				tagValue = tags.ActionProposalPassed
+				resultValue = AttributeValueProposalPassed
 				logMsg = "passed"
 
-				// write state to the underlying multi-store
+				// write state to the underlying multi-store and emit the
+				// events of the handler
 				writeCache()
+				ctx.EventManager().EmitEvents(cacheCtx.EventManager().Events())
 			} else {
 				proposal.Status = StatusFailed
-				tagValue = tags.ActionProposalFailed
+				resultValue = AttributeValueProposalFailed
 				logMsg = fmt.Sprintf("passed, but failed on execution: %s", err.ABCILog())
 			}
 		} else {
 			proposal.Status = StatusRejected
-			tagValue = tags.ActionProposalRejected
+			resultValue = AttributeValueProposalRejected
 			logMsg = "rejected"
 		}
 
@@ -81,11 +124,14 @@ func EndBlocker(ctx sdk.Context, keeper Keeper) sdk.Tags {
 			),
 		)
 
-		resTags = resTags.AppendTag(tags.ProposalID, fmt.Sprintf("%d", proposal.ProposalID))
-		resTags = resTags.AppendTag(tags.ProposalResult, tagValue)
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				EventTypeActiveProposal,
+				sdk.NewAttribute(AttributeKeyProposalID, fmt.Sprintf("%d", proposal.ProposalID)),
+				sdk.NewAttribute(AttributeKeyProposalResult, resultValue),
+			),
+		)
 
 		return false
 	})
-
-	return resTags
 }
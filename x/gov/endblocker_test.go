@@ -1,6 +1,7 @@
 package gov
 
 import (
+	"fmt"
 	"testing"
 	"time"
 
@@ -9,18 +10,17 @@ import (
 	abci "github.com/tendermint/tendermint/abci/types"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
-	"github.com/cosmos/cosmos-sdk/x/gov/tags"
+	"github.com/cosmos/cosmos-sdk/x/bank"
 	"github.com/cosmos/cosmos-sdk/x/staking"
 )
 
 func TestTickExpiredDepositPeriod(t *testing.T) {
-	input := getMockApp(t, 10, GenesisState{}, nil)
+	input := getMockApp(t, 10, GenesisState{}, nil, nil)
 
 	header := abci.Header{Height: input.mApp.LastBlockHeight() + 1}
 	input.mApp.BeginBlock(abci.RequestBeginBlock{Header: header})
 
 	ctx := input.mApp.BaseApp.NewContext(false, abci.Header{})
-	input.keeper.ck.SetSendEnabled(ctx, true)
 	govHandler := NewHandler(input.keeper)
 
 	inactiveQueue := input.keeper.InactiveProposalQueueIterator(ctx, ctx.BlockHeader().Time)
@@ -31,6 +31,7 @@ func TestTickExpiredDepositPeriod(t *testing.T) {
 		ContentFromProposalType("test", "test", ProposalTypeText),
 		sdk.Coins{sdk.NewInt64Coin(sdk.DefaultBondDenom, 5)},
 		input.addrs[0],
+		false,
 	)
 
 	res := govHandler(ctx, newProposalMsg)
@@ -64,13 +65,12 @@ func TestTickExpiredDepositPeriod(t *testing.T) {
 }
 
 func TestTickMultipleExpiredDepositPeriod(t *testing.T) {
-	input := getMockApp(t, 10, GenesisState{}, nil)
+	input := getMockApp(t, 10, GenesisState{}, nil, nil)
 
 	header := abci.Header{Height: input.mApp.LastBlockHeight() + 1}
 	input.mApp.BeginBlock(abci.RequestBeginBlock{Header: header})
 
 	ctx := input.mApp.BaseApp.NewContext(false, abci.Header{})
-	input.keeper.ck.SetSendEnabled(ctx, true)
 	govHandler := NewHandler(input.keeper)
 
 	inactiveQueue := input.keeper.InactiveProposalQueueIterator(ctx, ctx.BlockHeader().Time)
@@ -81,6 +81,7 @@ func TestTickMultipleExpiredDepositPeriod(t *testing.T) {
 		ContentFromProposalType("test", "test", ProposalTypeText),
 		sdk.Coins{sdk.NewInt64Coin(sdk.DefaultBondDenom, 5)},
 		input.addrs[0],
+		false,
 	)
 
 	res := govHandler(ctx, newProposalMsg)
@@ -102,6 +103,7 @@ func TestTickMultipleExpiredDepositPeriod(t *testing.T) {
 		ContentFromProposalType("test2", "test2", ProposalTypeText),
 		sdk.Coins{sdk.NewInt64Coin(sdk.DefaultBondDenom, 5)},
 		input.addrs[0],
+		false,
 	)
 
 	res = govHandler(ctx, newProposalMsg2)
@@ -133,13 +135,12 @@ func TestTickMultipleExpiredDepositPeriod(t *testing.T) {
 }
 
 func TestTickPassedDepositPeriod(t *testing.T) {
-	input := getMockApp(t, 10, GenesisState{}, nil)
+	input := getMockApp(t, 10, GenesisState{}, nil, nil)
 
 	header := abci.Header{Height: input.mApp.LastBlockHeight() + 1}
 	input.mApp.BeginBlock(abci.RequestBeginBlock{Header: header})
 
 	ctx := input.mApp.BaseApp.NewContext(false, abci.Header{})
-	input.keeper.ck.SetSendEnabled(ctx, true)
 	govHandler := NewHandler(input.keeper)
 
 	inactiveQueue := input.keeper.InactiveProposalQueueIterator(ctx, ctx.BlockHeader().Time)
@@ -153,6 +154,7 @@ func TestTickPassedDepositPeriod(t *testing.T) {
 		ContentFromProposalType("test2", "test2", ProposalTypeText),
 		sdk.Coins{sdk.NewInt64Coin(sdk.DefaultBondDenom, 5)},
 		input.addrs[0],
+		false,
 	)
 
 	res := govHandler(ctx, newProposalMsg)
@@ -182,14 +184,13 @@ func TestTickPassedDepositPeriod(t *testing.T) {
 }
 
 func TestTickPassedVotingPeriod(t *testing.T) {
-	input := getMockApp(t, 10, GenesisState{}, nil)
+	input := getMockApp(t, 10, GenesisState{}, nil, nil)
 	SortAddresses(input.addrs)
 
 	header := abci.Header{Height: input.mApp.LastBlockHeight() + 1}
 	input.mApp.BeginBlock(abci.RequestBeginBlock{Header: header})
 
 	ctx := input.mApp.BaseApp.NewContext(false, abci.Header{})
-	input.keeper.ck.SetSendEnabled(ctx, true)
 	govHandler := NewHandler(input.keeper)
 
 	inactiveQueue := input.keeper.InactiveProposalQueueIterator(ctx, ctx.BlockHeader().Time)
@@ -200,7 +201,7 @@ func TestTickPassedVotingPeriod(t *testing.T) {
 	activeQueue.Close()
 
 	proposalCoins := sdk.Coins{sdk.NewCoin(sdk.DefaultBondDenom, sdk.TokensFromTendermintPower(5))}
-	newProposalMsg := NewMsgSubmitProposal(testProposal(), proposalCoins, input.addrs[0])
+	newProposalMsg := NewMsgSubmitProposal(testProposal(), proposalCoins, input.addrs[0], false)
 
 	res := govHandler(ctx, newProposalMsg)
 	require.True(t, res.IsOK())
@@ -245,7 +246,7 @@ func TestTickPassedVotingPeriod(t *testing.T) {
 }
 
 func TestProposalPassedEndblocker(t *testing.T) {
-	input := getMockApp(t, 1, GenesisState{}, nil)
+	input := getMockApp(t, 1, GenesisState{}, nil, nil)
 	SortAddresses(input.addrs)
 
 	handler := NewHandler(input.keeper)
@@ -257,11 +258,10 @@ func TestProposalPassedEndblocker(t *testing.T) {
 
 	valAddr := sdk.ValAddress(input.addrs[0])
 
-	input.keeper.ck.SetSendEnabled(ctx, true)
 	createValidators(t, stakingHandler, ctx, []sdk.ValAddress{valAddr}, []int64{10})
 	staking.EndBlocker(ctx, input.sk)
 
-	proposal, err := input.keeper.SubmitProposal(ctx, testProposal())
+	proposal, err := input.keeper.SubmitProposal(ctx, testProposal(), input.addrs[0], false)
 	require.NoError(t, err)
 
 	proposalCoins := sdk.Coins{sdk.NewCoin(sdk.DefaultBondDenom, sdk.TokensFromTendermintPower(10))}
@@ -269,19 +269,27 @@ func TestProposalPassedEndblocker(t *testing.T) {
 	res := handler(ctx, newDepositMsg)
 	require.True(t, res.IsOK())
 
-	err = input.keeper.AddVote(ctx, proposal.ProposalID, input.addrs[0], OptionYes)
+	err = input.keeper.AddVote(ctx, proposal.ProposalID, input.addrs[0], NewNonSplitVoteOption(OptionYes))
 	require.NoError(t, err)
 
 	newHeader := ctx.BlockHeader()
 	newHeader.Time = ctx.BlockHeader().Time.Add(input.keeper.GetDepositParams(ctx).MaxDepositPeriod).Add(input.keeper.GetVotingParams(ctx).VotingPeriod)
 	ctx = ctx.WithBlockHeader(newHeader)
 
-	resTags := EndBlocker(ctx, input.keeper)
-	require.Equal(t, sdk.MakeTag(tags.ProposalResult, tags.ActionProposalPassed), resTags[1])
+	ctx = ctx.WithEventManager(sdk.NewEventManager())
+	EndBlocker(ctx, input.keeper)
+
+	events := ctx.EventManager().Events()
+	expected := sdk.NewEvent(
+		EventTypeActiveProposal,
+		sdk.NewAttribute(AttributeKeyProposalID, fmt.Sprintf("%d", proposal.ProposalID)),
+		sdk.NewAttribute(AttributeKeyProposalResult, AttributeValueProposalPassed),
+	)
+	require.Equal(t, expected, events[len(events)-1])
 }
 
 func TestEndBlockerProposalHandlerFailed(t *testing.T) {
-	input := getMockApp(t, 1, GenesisState{}, nil)
+	input := getMockApp(t, 1, GenesisState{}, nil, nil)
 	SortAddresses(input.addrs)
 
 	// hijack the router to one that will fail in a proposal's handler
@@ -296,14 +304,13 @@ func TestEndBlockerProposalHandlerFailed(t *testing.T) {
 
 	valAddr := sdk.ValAddress(input.addrs[0])
 
-	input.keeper.ck.SetSendEnabled(ctx, true)
 	createValidators(t, stakingHandler, ctx, []sdk.ValAddress{valAddr}, []int64{10})
 	staking.EndBlocker(ctx, input.sk)
 
 	// Create a proposal where the handler will pass for the test proposal
 	// because the value of contextKeyBadProposal is true.
 	ctx = ctx.WithValue(contextKeyBadProposal, true)
-	proposal, err := input.keeper.SubmitProposal(ctx, testProposal())
+	proposal, err := input.keeper.SubmitProposal(ctx, testProposal(), input.addrs[0], false)
 	require.NoError(t, err)
 
 	proposalCoins := sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdk.TokensFromTendermintPower(10)))
@@ -311,7 +318,7 @@ func TestEndBlockerProposalHandlerFailed(t *testing.T) {
 	res := handler(ctx, newDepositMsg)
 	require.True(t, res.IsOK())
 
-	err = input.keeper.AddVote(ctx, proposal.ProposalID, input.addrs[0], OptionYes)
+	err = input.keeper.AddVote(ctx, proposal.ProposalID, input.addrs[0], NewNonSplitVoteOption(OptionYes))
 	require.NoError(t, err)
 
 	newHeader := ctx.BlockHeader()
@@ -323,6 +330,314 @@ func TestEndBlockerProposalHandlerFailed(t *testing.T) {
 	ctx = ctx.WithValue(contextKeyBadProposal, false)
 
 	// validate that the proposal fails/has been rejected
-	resTags := EndBlocker(ctx, input.keeper)
-	require.Equal(t, sdk.MakeTag(tags.ProposalResult, tags.ActionProposalFailed), resTags[1])
+	ctx = ctx.WithEventManager(sdk.NewEventManager())
+	EndBlocker(ctx, input.keeper)
+
+	events := ctx.EventManager().Events()
+	expected := sdk.NewEvent(
+		EventTypeActiveProposal,
+		sdk.NewAttribute(AttributeKeyProposalID, fmt.Sprintf("%d", proposal.ProposalID)),
+		sdk.NewAttribute(AttributeKeyProposalResult, AttributeValueProposalFailed),
+	)
+	require.Equal(t, expected, events[len(events)-1])
+
+	// the events of the failed handler are discarded along with its state
+	for _, event := range events {
+		require.NotEqual(t, eventTypeBadProposal, event.Type)
+	}
+}
+
+func TestEndBlockerProposalHandlerEvents(t *testing.T) {
+	input := getMockApp(t, 1, GenesisState{}, nil, nil)
+	SortAddresses(input.addrs)
+
+	input.keeper.router = NewRouter().AddRoute(RouterKey, badProposalHandler)
+
+	handler := NewHandler(input.keeper)
+	stakingHandler := staking.NewHandler(input.sk)
+
+	header := abci.Header{Height: input.mApp.LastBlockHeight() + 1}
+	input.mApp.BeginBlock(abci.RequestBeginBlock{Header: header})
+	ctx := input.mApp.BaseApp.NewContext(false, abci.Header{})
+
+	valAddr := sdk.ValAddress(input.addrs[0])
+
+	createValidators(t, stakingHandler, ctx, []sdk.ValAddress{valAddr}, []int64{10})
+	staking.EndBlocker(ctx, input.sk)
+
+	ctx = ctx.WithValue(contextKeyBadProposal, true)
+	proposal, err := input.keeper.SubmitProposal(ctx, testProposal(), input.addrs[0], false)
+	require.NoError(t, err)
+
+	proposalCoins := sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdk.TokensFromTendermintPower(10)))
+	res := handler(ctx, NewMsgDeposit(input.addrs[0], proposal.ProposalID, proposalCoins))
+	require.True(t, res.IsOK())
+
+	err = input.keeper.AddVote(ctx, proposal.ProposalID, input.addrs[0], NewNonSplitVoteOption(OptionYes))
+	require.NoError(t, err)
+
+	newHeader := ctx.BlockHeader()
+	newHeader.Time = ctx.BlockHeader().Time.Add(input.keeper.GetDepositParams(ctx).MaxDepositPeriod).Add(input.keeper.GetVotingParams(ctx).VotingPeriod)
+	ctx = ctx.WithBlockHeader(newHeader)
+
+	// the events of the successful handler are emitted before the result
+	ctx = ctx.WithEventManager(sdk.NewEventManager())
+	EndBlocker(ctx, input.keeper)
+
+	events := ctx.EventManager().Events()
+	require.True(t, len(events) >= 2)
+	require.Equal(t, eventTypeBadProposal, events[len(events)-2].Type)
+	require.Equal(t, EventTypeActiveProposal, events[len(events)-1].Type)
+}
+
+func TestEndBlockerMessagesProposal(t *testing.T) {
+	input := getMockApp(t, 2, GenesisState{}, nil, nil)
+	SortAddresses(input.addrs)
+
+	handler := NewHandler(input.keeper)
+	stakingHandler := staking.NewHandler(input.sk)
+
+	header := abci.Header{Height: input.mApp.LastBlockHeight() + 1}
+	input.mApp.BeginBlock(abci.RequestBeginBlock{Header: header})
+	ctx := input.mApp.BaseApp.NewContext(false, abci.Header{})
+
+	valAddr := sdk.ValAddress(input.addrs[0])
+
+	createValidators(t, stakingHandler, ctx, []sdk.ValAddress{valAddr}, []int64{10})
+	staking.EndBlocker(ctx, input.sk)
+
+	// fund the governance treasury
+	treasuryAddr := input.keeper.GetTreasuryAccount(ctx).GetAddress()
+	funds := sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 100))
+	require.NoError(t, input.supplyKeeper.SendCoinsFromAccountToModule(ctx, input.addrs[1], TreasuryName, funds))
+
+	// submitProposal submits the content, deposits and votes yes on it.
+	submitProposal := func(ctx sdk.Context, content Content) uint64 {
+		proposal, err := input.keeper.SubmitProposal(ctx, content, input.addrs[0], false)
+		require.NoError(t, err)
+
+		proposalCoins := sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdk.TokensFromTendermintPower(10)))
+		res := handler(ctx, NewMsgDeposit(input.addrs[0], proposal.ProposalID, proposalCoins))
+		require.True(t, res.IsOK())
+
+		err = input.keeper.AddVote(ctx, proposal.ProposalID, input.addrs[0], NewNonSplitVoteOption(OptionYes))
+		require.NoError(t, err)
+
+		return proposal.ProposalID
+	}
+
+	// endVotingPeriod moves the block time to the end of the voting period and
+	// runs the EndBlocker.
+	endVotingPeriod := func(ctx sdk.Context) sdk.Context {
+		newHeader := ctx.BlockHeader()
+		newHeader.Time = ctx.BlockHeader().Time.Add(input.keeper.GetDepositParams(ctx).MaxDepositPeriod).Add(input.keeper.GetVotingParams(ctx).VotingPeriod)
+		ctx = ctx.WithBlockHeader(newHeader)
+
+		EndBlocker(ctx, input.keeper)
+
+		// the deposits are held apart from the spent treasury funds
+		require.NoError(t, AllInvariants(input.keeper)(ctx))
+		return ctx
+	}
+
+	// a passed proposal executes its messages
+	sendAmt := sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 60))
+	balance := input.mApp.BankKeeper.GetCoins(ctx, input.addrs[1])
+
+	proposalID := submitProposal(ctx, NewMessagesProposal("Test", "description", []sdk.Msg{
+		bank.NewMsgSend(treasuryAddr, input.addrs[1], sendAmt),
+	}))
+	ctx = endVotingPeriod(ctx)
+
+	proposal, ok := input.keeper.GetProposal(ctx, proposalID)
+	require.True(t, ok)
+	require.Equal(t, StatusPassed, proposal.Status)
+	require.Equal(t, balance.Add(sendAmt), input.mApp.BankKeeper.GetCoins(ctx, input.addrs[1]))
+	require.Equal(t, funds.Sub(sendAmt), input.mApp.BankKeeper.GetCoins(ctx, treasuryAddr))
+
+	// the messages are executed atomically: the treasury is drained after
+	// the submission so the second send fails and the first one is reverted
+	remaining := funds.Sub(sendAmt)
+	refill := sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 10))
+	firstAmt := sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 5))
+
+	proposalID = submitProposal(ctx, NewMessagesProposal("Test", "description", []sdk.Msg{
+		bank.NewMsgSend(treasuryAddr, input.addrs[1], firstAmt),
+		bank.NewMsgSend(treasuryAddr, input.addrs[1], remaining.Sub(firstAmt)),
+	}))
+	require.NoError(t, input.supplyKeeper.SendCoinsFromModuleToAccount(ctx, TreasuryName, input.addrs[1], remaining))
+	require.NoError(t, input.supplyKeeper.SendCoinsFromAccountToModule(ctx, input.addrs[1], TreasuryName, refill))
+	balance = input.mApp.BankKeeper.GetCoins(ctx, input.addrs[1])
+
+	ctx = endVotingPeriod(ctx)
+
+	proposal, ok = input.keeper.GetProposal(ctx, proposalID)
+	require.True(t, ok)
+	require.Equal(t, StatusFailed, proposal.Status)
+	require.Equal(t, balance, input.mApp.BankKeeper.GetCoins(ctx, input.addrs[1]))
+	require.Equal(t, refill, input.mApp.BankKeeper.GetCoins(ctx, treasuryAddr))
+}
+
+func TestEndBlockerExpeditedProposal(t *testing.T) {
+	input := getMockApp(t, 1, GenesisState{}, nil, nil)
+	SortAddresses(input.addrs)
+
+	handler := NewHandler(input.keeper)
+	stakingHandler := staking.NewHandler(input.sk)
+
+	header := abci.Header{Height: input.mApp.LastBlockHeight() + 1}
+	input.mApp.BeginBlock(abci.RequestBeginBlock{Header: header})
+	ctx := input.mApp.BaseApp.NewContext(false, abci.Header{})
+
+	valAddr := sdk.ValAddress(input.addrs[0])
+
+	createValidators(t, stakingHandler, ctx, []sdk.ValAddress{valAddr}, []int64{10})
+	staking.EndBlocker(ctx, input.sk)
+
+	// lower the expedited minimum deposit so that it fits the depositor balance
+	depositParams := input.keeper.GetDepositParams(ctx)
+	depositParams.ExpeditedMinDeposit = sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdk.TokensFromTendermintPower(20)))
+	input.keeper.setDepositParams(ctx, depositParams)
+	votingParams := input.keeper.GetVotingParams(ctx)
+
+	// submitProposal submits an expedited proposal and votes on it with the
+	// given options.
+	submitProposal := func(ctx sdk.Context, options WeightedVoteOptions) Proposal {
+		res := handler(ctx, NewMsgSubmitProposal(testProposal(), depositParams.MinDeposit, input.addrs[0], true))
+		require.True(t, res.IsOK())
+
+		var proposalID uint64
+		input.keeper.cdc.MustUnmarshalBinaryLengthPrefixed(res.Data, &proposalID)
+
+		// the regular minimum deposit is not enough to activate an expedited proposal
+		proposal, ok := input.keeper.GetProposal(ctx, proposalID)
+		require.True(t, ok)
+		require.True(t, proposal.Expedited)
+		require.Equal(t, StatusDepositPeriod, proposal.Status)
+
+		res = handler(ctx, NewMsgDeposit(input.addrs[0], proposalID, depositParams.ExpeditedMinDeposit.Sub(depositParams.MinDeposit)))
+		require.True(t, res.IsOK())
+
+		proposal, ok = input.keeper.GetProposal(ctx, proposalID)
+		require.True(t, ok)
+		require.Equal(t, StatusVotingPeriod, proposal.Status)
+		require.Equal(t, ctx.BlockHeader().Time.Add(votingParams.ExpeditedVotingPeriod), proposal.VotingEndTime)
+
+		require.NoError(t, input.keeper.AddVote(ctx, proposalID, input.addrs[0], options))
+		return proposal
+	}
+
+	endBlock := func(ctx sdk.Context, blockTime time.Time) sdk.Context {
+		newHeader := ctx.BlockHeader()
+		newHeader.Time = blockTime
+		ctx = ctx.WithBlockHeader(newHeader)
+
+		EndBlocker(ctx, input.keeper)
+		return ctx
+	}
+
+	// an expedited proposal meeting the expedited threshold passes at the end
+	// of the expedited voting period
+	proposal := submitProposal(ctx, NewNonSplitVoteOption(OptionYes))
+	ctx = endBlock(ctx, proposal.VotingEndTime)
+
+	proposal, ok := input.keeper.GetProposal(ctx, proposal.ProposalID)
+	require.True(t, ok)
+	require.Equal(t, StatusPassed, proposal.Status)
+
+	// an expedited proposal which only meets the regular threshold is converted
+	// into a regular proposal, keeping its votes and deposits
+	options, err := WeightedVoteOptionsFromString("Yes=0.6,No=0.4")
+	require.NoError(t, err)
+
+	proposal = submitProposal(ctx, options)
+	ctx = endBlock(ctx, proposal.VotingEndTime)
+
+	converted, ok := input.keeper.GetProposal(ctx, proposal.ProposalID)
+	require.True(t, ok)
+	require.Equal(t, StatusVotingPeriod, converted.Status)
+	require.False(t, converted.Expedited)
+	require.Equal(t, proposal.VotingStartTime.Add(votingParams.VotingPeriod), converted.VotingEndTime)
+	require.Equal(t, proposal.TotalDeposit, converted.TotalDeposit)
+
+	_, found := input.keeper.GetVote(ctx, proposal.ProposalID, input.addrs[0])
+	require.True(t, found)
+
+	// the converted proposal passes at the end of the regular voting period
+	ctx = endBlock(ctx, converted.VotingEndTime)
+
+	converted, ok = input.keeper.GetProposal(ctx, proposal.ProposalID)
+	require.True(t, ok)
+	require.Equal(t, StatusPassed, converted.Status)
+
+	_, found = input.keeper.GetVote(ctx, proposal.ProposalID, input.addrs[0])
+	require.False(t, found)
+}
+
+func TestEndBlockerExpiredDepositBurning(t *testing.T) {
+	testCases := []struct {
+		name                string
+		burnPrevote         bool
+		burnToCommunityPool bool
+	}{
+		{"burn", true, false},
+		{"burn to community pool", true, true},
+		{"refund", false, false},
+		{"refund with community pool", false, true},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			input := getMockApp(t, 1, GenesisState{}, nil, nil)
+
+			header := abci.Header{Height: input.mApp.LastBlockHeight() + 1}
+			input.mApp.BeginBlock(abci.RequestBeginBlock{Header: header})
+
+			ctx := input.mApp.BaseApp.NewContext(false, abci.Header{})
+			govHandler := NewHandler(input.keeper)
+
+			depositParams := input.keeper.GetDepositParams(ctx)
+			depositParams.BurnProposalDepositPrevote = tc.burnPrevote
+			depositParams.BurnToCommunityPool = tc.burnToCommunityPool
+			input.keeper.setDepositParams(ctx, depositParams)
+
+			initBalance := input.mApp.BankKeeper.GetCoins(ctx, input.addrs[0])
+			initSupply := input.supplyKeeper.GetSupply(ctx).Total
+
+			deposit := sdk.Coins{sdk.NewInt64Coin(sdk.DefaultBondDenom, 5)}
+			res := govHandler(ctx, NewMsgSubmitProposal(testProposal(), deposit, input.addrs[0], false))
+			require.True(t, res.IsOK())
+
+			newHeader := ctx.BlockHeader()
+			newHeader.Time = ctx.BlockHeader().Time.Add(depositParams.MaxDepositPeriod)
+			ctx = ctx.WithBlockHeader(newHeader)
+
+			EndBlocker(ctx, input.keeper)
+
+			require.Empty(t, input.keeper.GetAllDeposits(ctx))
+			require.True(t, input.supplyKeeper.GetModuleAccountCoins(ctx, ModuleName).Empty())
+			require.NoError(t, AllInvariants(input.keeper)(ctx))
+
+			communityPool := input.supplyKeeper.GetModuleAccountCoins(ctx, testDistrModuleName)
+			supply := input.supplyKeeper.GetSupply(ctx).Total
+			balance := input.mApp.BankKeeper.GetCoins(ctx, input.addrs[0])
+
+			switch {
+			case !tc.burnPrevote:
+				require.Equal(t, initBalance, balance)
+				require.True(t, communityPool.Empty())
+				require.Equal(t, initSupply, supply)
+
+			case tc.burnToCommunityPool:
+				require.Equal(t, initBalance.Sub(deposit), balance)
+				require.Equal(t, deposit, communityPool)
+				require.Equal(t, initSupply, supply)
+
+			default:
+				require.Equal(t, initBalance.Sub(deposit), balance)
+				require.True(t, communityPool.Empty())
+				require.Equal(t, initSupply.Sub(deposit), supply)
+			}
+		})
+	}
 }
@@ -2,16 +2,29 @@ package gov
 
 import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/auth"
 	"github.com/cosmos/cosmos-sdk/x/staking/exported"
 )
 
-// expected bank keeper
-type BankKeeper interface {
-	GetCoins(ctx sdk.Context, addr sdk.AccAddress) sdk.Coins
+// SupplyKeeper defines the supply Keeper for module accounts
+type SupplyKeeper interface {
+	GetModuleAddress(name string) sdk.AccAddress
+	GetModuleAccount(ctx sdk.Context, name string) auth.ModuleAccountI
+	GetModuleAccountCoins(ctx sdk.Context, moduleName string) sdk.Coins
 
-	// TODO remove once governance doesn't require use of accounts
-	SendCoins(ctx sdk.Context, fromAddr sdk.AccAddress, toAddr sdk.AccAddress, amt sdk.Coins) sdk.Error
-	SetSendEnabled(ctx sdk.Context, enabled bool)
+	// used to set the initial deposits balance at genesis
+	SetModuleAccount(sdk.Context, auth.ModuleAccountI)
+	SetModuleAccountCoins(ctx sdk.Context, moduleName string, amt sdk.Coins) sdk.Error
+
+	SendCoinsFromModuleToAccount(ctx sdk.Context, senderModule string, recipientAddr sdk.AccAddress, amt sdk.Coins) sdk.Error
+	SendCoinsFromAccountToModule(ctx sdk.Context, senderAddr sdk.AccAddress, recipientModule string, amt sdk.Coins) sdk.Error
+	BurnCoins(ctx sdk.Context, name string, amt sdk.Coins) sdk.Error
+}
+
+// DistributionKeeper defines the expected distribution keeper, used to send
+// burned deposits to the community pool
+type DistributionKeeper interface {
+	FundCommunityPool(ctx sdk.Context, amount sdk.Coins, sender sdk.AccAddress) sdk.Error
 }
 
 // StakingKeeper expected staking keeper (Validator and Delegator sets)
@@ -12,17 +12,21 @@ import (
 const (
 	// Default period for deposits & voting
 	DefaultPeriod time.Duration = 86400 * 2 * time.Second // 2 days
+
+	// Default voting period for expedited proposals
+	DefaultExpeditedPeriod time.Duration = 86400 * time.Second // 1 day
 )
 
 // GenesisState - all staking state that must be provided at genesis
 type GenesisState struct {
-	StartingProposalID uint64        `json:"starting_proposal_id"`
-	Deposits           Deposits      `json:"deposits"`
-	Votes              Votes         `json:"votes"`
-	Proposals          []Proposal    `json:"proposals"`
-	DepositParams      DepositParams `json:"deposit_params"`
-	VotingParams       VotingParams  `json:"voting_params"`
-	TallyParams        TallyParams   `json:"tally_params"`
+	StartingProposalID uint64          `json:"starting_proposal_id"`
+	Deposits           Deposits        `json:"deposits"`
+	Votes              Votes           `json:"votes"`
+	Proposals          []Proposal      `json:"proposals"`
+	VoteDelegations    VoteDelegations `json:"vote_delegations"`
+	DepositParams      DepositParams   `json:"deposit_params"`
+	VotingParams       VotingParams    `json:"voting_params"`
+	TallyParams        TallyParams     `json:"tally_params"`
 }
 
 // NewGenesisState creates a new genesis state for the governance module
@@ -38,19 +42,28 @@ func NewGenesisState(startingProposalID uint64, dp DepositParams, vp VotingParam
 // get raw genesis raw message for testing
 func DefaultGenesisState() GenesisState {
 	minDepositTokens := sdk.TokensFromTendermintPower(10)
+	expeditedMinDepositTokens := sdk.TokensFromTendermintPower(50)
 	return GenesisState{
 		StartingProposalID: 1,
 		DepositParams: DepositParams{
-			MinDeposit:       sdk.Coins{sdk.NewCoin(sdk.DefaultBondDenom, minDepositTokens)},
-			MaxDepositPeriod: DefaultPeriod,
+			MinDeposit:                 sdk.Coins{sdk.NewCoin(sdk.DefaultBondDenom, minDepositTokens)},
+			ExpeditedMinDeposit:        sdk.Coins{sdk.NewCoin(sdk.DefaultBondDenom, expeditedMinDepositTokens)},
+			MaxDepositPeriod:           DefaultPeriod,
+			ProposalCancelRatio:        sdk.NewDecWithPrec(5, 1),
+			BurnProposalDepositPrevote: true,
+			BurnVoteQuorum:             true,
+			BurnVoteVeto:               true,
+			BurnToCommunityPool:        false,
 		},
 		VotingParams: VotingParams{
-			VotingPeriod: DefaultPeriod,
+			VotingPeriod:          DefaultPeriod,
+			ExpeditedVotingPeriod: DefaultExpeditedPeriod,
 		},
 		TallyParams: TallyParams{
-			Quorum:    sdk.NewDecWithPrec(334, 3),
-			Threshold: sdk.NewDecWithPrec(5, 1),
-			Veto:      sdk.NewDecWithPrec(334, 3),
+			Quorum:             sdk.NewDecWithPrec(334, 3),
+			Threshold:          sdk.NewDecWithPrec(5, 1),
+			ExpeditedThreshold: sdk.NewDecWithPrec(667, 3),
+			Veto:               sdk.NewDecWithPrec(334, 3),
 		},
 	}
 }
@@ -70,35 +83,46 @@ func (data GenesisState) IsEmpty() bool {
 
 // ValidateGenesis checks if parameters are within valid ranges
 func ValidateGenesis(data GenesisState) error {
-	threshold := data.TallyParams.Threshold
-	if threshold.IsNegative() || threshold.GT(sdk.OneDec()) {
-		return fmt.Errorf("Governance vote threshold should be positive and less or equal to one, is %s",
-			threshold.String())
+	if err := data.TallyParams.Validate(); err != nil {
+		return err
+	}
+
+	if err := data.DepositParams.Validate(); err != nil {
+		return err
 	}
 
-	veto := data.TallyParams.Veto
-	if veto.IsNegative() || veto.GT(sdk.OneDec()) {
-		return fmt.Errorf("Governance vote veto threshold should be positive and less or equal to one, is %s",
-			veto.String())
+	if err := data.VotingParams.Validate(); err != nil {
+		return err
 	}
 
-	if !data.DepositParams.MinDeposit.IsValid() {
-		return fmt.Errorf("Governance deposit amount must be a valid sdk.Coins amount, is %s",
-			data.DepositParams.MinDeposit.String())
+	for _, delegation := range data.VoteDelegations {
+		msg := NewMsgSetRepresentative(delegation.Delegator, delegation.Representative, delegation.ProposalType)
+		if err := msg.ValidateBasic(); err != nil {
+			return fmt.Errorf("invalid vote delegation %s: %s", delegation, err.Error())
+		}
 	}
 
 	return nil
 }
 
 // InitGenesis - store genesis parameters
-func InitGenesis(ctx sdk.Context, k Keeper, data GenesisState) {
+func InitGenesis(ctx sdk.Context, k Keeper, supplyKeeper SupplyKeeper, data GenesisState) {
 
 	k.setProposalID(ctx, data.StartingProposalID)
 	k.setDepositParams(ctx, data.DepositParams)
 	k.setVotingParams(ctx, data.VotingParams)
 	k.setTallyParams(ctx, data.TallyParams)
+
+	// check if the deposits pool account exists
+	moduleAcc := k.GetGovernanceAccount(ctx)
+	if moduleAcc == nil {
+		panic(fmt.Sprintf("%s module account has not been set", types.ModuleName))
+	}
+
+	var totalDeposits sdk.Coins
 	for _, deposit := range data.Deposits {
 		k.setDeposit(ctx, deposit.ProposalID, deposit.Depositor, deposit)
+		totalDeposits = totalDeposits.Add(deposit.Amount)
 	}
 	for _, vote := range data.Votes {
 		k.setVote(ctx, vote.ProposalID, vote.Voter, vote)
@@ -112,6 +136,16 @@ func InitGenesis(ctx sdk.Context, k Keeper, data GenesisState) {
 		}
 		k.SetProposal(ctx, proposal)
 	}
+	for _, delegation := range data.VoteDelegations {
+		k.setVoteDelegation(ctx, delegation)
+	}
+
+	// add coins if not provided on genesis
+	if supplyKeeper.GetModuleAccountCoins(ctx, ModuleName).IsZero() {
+		if err := supplyKeeper.SetModuleAccountCoins(ctx, ModuleName, totalDeposits); err != nil {
+			panic(err)
+		}
+	}
 }
 
 // ExportGenesis - output genesis parameters
@@ -138,6 +172,7 @@ func ExportGenesis(ctx sdk.Context, k Keeper) GenesisState {
 		Deposits:           proposalsDeposits,
 		Votes:              proposalsVotes,
 		Proposals:          proposals,
+		VoteDelegations:    k.GetAllVoteDelegations(ctx),
 		DepositParams:      depositParams,
 		VotingParams:       votingParams,
 		TallyParams:        tallyParams,
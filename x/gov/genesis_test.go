@@ -6,6 +6,8 @@ import (
 	"github.com/stretchr/testify/require"
 
 	abci "github.com/tendermint/tendermint/abci/types"
+
+	"github.com/cosmos/cosmos-sdk/x/mock"
 )
 
 func TestEqualProposalID(t *testing.T) {
@@ -25,7 +27,7 @@ func TestEqualProposalID(t *testing.T) {
 
 func TestEqualProposals(t *testing.T) {
 	// Generate mock app and keepers
-	input := getMockApp(t, 2, GenesisState{}, nil)
+	input := getMockApp(t, 2, GenesisState{}, nil, nil)
 	SortAddresses(input.addrs)
 
 	header := abci.Header{Height: input.mApp.LastBlockHeight() + 1}
@@ -35,9 +37,9 @@ func TestEqualProposals(t *testing.T) {
 
 	// Submit two proposals
 	proposal := testProposal()
-	proposal1, err := input.keeper.SubmitProposal(ctx, proposal)
+	proposal1, err := input.keeper.SubmitProposal(ctx, proposal, input.addrs[0], false)
 	require.NoError(t, err)
-	proposal2, err := input.keeper.SubmitProposal(ctx, proposal)
+	proposal2, err := input.keeper.SubmitProposal(ctx, proposal, input.addrs[0], false)
 	require.NoError(t, err)
 
 	// They are similar but their IDs should be different
@@ -67,7 +69,7 @@ func TestEqualProposals(t *testing.T) {
 
 func TestImportExportQueues(t *testing.T) {
 	// Generate mock app and keepers
-	input := getMockApp(t, 2, GenesisState{}, nil)
+	input := getMockApp(t, 2, GenesisState{}, nil, nil)
 	SortAddresses(input.addrs)
 
 	header := abci.Header{Height: input.mApp.LastBlockHeight() + 1}
@@ -77,11 +79,11 @@ func TestImportExportQueues(t *testing.T) {
 
 	// Create two proposals, put the second into the voting period
 	proposal := testProposal()
-	proposal1, err := input.keeper.SubmitProposal(ctx, proposal)
+	proposal1, err := input.keeper.SubmitProposal(ctx, proposal, input.addrs[0], false)
 	require.NoError(t, err)
 	proposalID1 := proposal1.ProposalID
 
-	proposal2, err := input.keeper.SubmitProposal(ctx, proposal)
+	proposal2, err := input.keeper.SubmitProposal(ctx, proposal, input.addrs[0], false)
 	require.NoError(t, err)
 	proposalID2 := proposal2.ProposalID
 
@@ -96,10 +98,14 @@ func TestImportExportQueues(t *testing.T) {
 	require.True(t, proposal2.Status == StatusVotingPeriod)
 
 	genAccs := input.mApp.AccountKeeper.GetAllAccounts(ctx)
+	genBalances := make([]mock.Balance, len(genAccs))
+	for i, acc := range genAccs {
+		genBalances[i] = mock.Balance{Address: acc.GetAddress(), Coins: input.mApp.BankKeeper.GetCoins(ctx, acc.GetAddress())}
+	}
 
 	// Export the state and import it into a new Mock App
 	genState := ExportGenesis(ctx, input.keeper)
-	input2 := getMockApp(t, 2, genState, genAccs)
+	input2 := getMockApp(t, 2, genState, genAccs, genBalances)
 
 	header = abci.Header{Height: input.mApp.LastBlockHeight() + 1}
 	input2.mApp.BeginBlock(abci.RequestBeginBlock{Header: header})
@@ -4,7 +4,6 @@ import (
 	"fmt"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
-	"github.com/cosmos/cosmos-sdk/x/gov/tags"
 )
 
 // Handle all "gov" type messages.
@@ -17,9 +16,21 @@ func NewHandler(keeper Keeper) sdk.Handler {
 		case MsgSubmitProposal:
 			return handleMsgSubmitProposal(ctx, keeper, msg)
 
+		case MsgCancelProposal:
+			return handleMsgCancelProposal(ctx, keeper, msg)
+
 		case MsgVote:
 			return handleMsgVote(ctx, keeper, msg)
 
+		case MsgVoteWeighted:
+			return handleMsgVoteWeighted(ctx, keeper, msg)
+
+		case MsgSetRepresentative:
+			return handleMsgSetRepresentative(ctx, keeper, msg)
+
+		case MsgClearRepresentative:
+			return handleMsgClearRepresentative(ctx, keeper, msg)
+
 		default:
 			errMsg := fmt.Sprintf("unrecognized gov message type: %T", msg)
 			return sdk.ErrUnknownRequest(errMsg).Result()
@@ -28,7 +39,7 @@ func NewHandler(keeper Keeper) sdk.Handler {
 }
 
 func handleMsgSubmitProposal(ctx sdk.Context, keeper Keeper, msg MsgSubmitProposal) sdk.Result {
-	proposal, err := keeper.SubmitProposal(ctx, msg.Content)
+	proposal, err := keeper.SubmitProposal(ctx, msg.Content, msg.Proposer, msg.Expedited)
 	if err != nil {
 		return err.Result()
 	}
@@ -38,21 +49,51 @@ func handleMsgSubmitProposal(ctx sdk.Context, keeper Keeper, msg MsgSubmitPropos
 		return err.Result()
 	}
 
-	proposalIDStr := fmt.Sprintf("%d", proposal.ProposalID)
-	resTags := sdk.NewTags(
-		tags.ProposalID, proposalIDStr,
-		tags.Category, tags.TxCategory,
-		tags.Sender, msg.Proposer.String(),
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.Proposer.String()),
+		),
 	)
 
+	submitEvent := sdk.NewEvent(
+		EventTypeSubmitProposal,
+		sdk.NewAttribute(AttributeKeyProposalID, fmt.Sprintf("%d", proposal.ProposalID)),
+	)
 	if votingStarted {
-		resTags = resTags.AppendTag(tags.VotingPeriodStart, proposalIDStr)
+		submitEvent = submitEvent.AppendAttributes(
+			sdk.NewAttribute(AttributeKeyVotingPeriodStart, fmt.Sprintf("%d", proposal.ProposalID)),
+		)
 	}
 
+	ctx.EventManager().EmitEvent(submitEvent)
+
 	return sdk.Result{
-		Data: keeper.cdc.MustMarshalBinaryLengthPrefixed(proposal.ProposalID),
-		Tags: resTags,
+		Data:   keeper.cdc.MustMarshalBinaryLengthPrefixed(proposal.ProposalID),
+		Events: ctx.EventManager().Events(),
+	}
+}
+
+func handleMsgCancelProposal(ctx sdk.Context, keeper Keeper, msg MsgCancelProposal) sdk.Result {
+	err := keeper.CancelProposal(ctx, msg.ProposalID, msg.Proposer)
+	if err != nil {
+		return err.Result()
 	}
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			EventTypeCancelProposal,
+			sdk.NewAttribute(AttributeKeyProposalID, fmt.Sprintf("%d", msg.ProposalID)),
+		),
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.Proposer.String()),
+		),
+	})
+
+	return sdk.Result{Events: ctx.EventManager().Events()}
 }
 
 func handleMsgDeposit(ctx sdk.Context, keeper Keeper, msg MsgDeposit) sdk.Result {
@@ -61,36 +102,99 @@ func handleMsgDeposit(ctx sdk.Context, keeper Keeper, msg MsgDeposit) sdk.Result
 		return err.Result()
 	}
 
-	proposalIDStr := fmt.Sprintf("%d", msg.ProposalID)
-
-	resTags := sdk.NewTags(
-		tags.ProposalID, proposalIDStr,
-		tags.Category, tags.TxCategory,
-		tags.Sender, msg.Depositor.String(),
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.Depositor.String()),
+		),
 	)
 
 	if votingStarted {
-		resTags = resTags.AppendTag(tags.VotingPeriodStart, proposalIDStr)
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				EventTypeProposalDeposit,
+				sdk.NewAttribute(AttributeKeyVotingPeriodStart, fmt.Sprintf("%d", msg.ProposalID)),
+			),
+		)
 	}
 
-	return sdk.Result{
-		Tags: resTags,
-	}
+	return sdk.Result{Events: ctx.EventManager().Events()}
 }
 
 func handleMsgVote(ctx sdk.Context, keeper Keeper, msg MsgVote) sdk.Result {
-	err := keeper.AddVote(ctx, msg.ProposalID, msg.Voter, msg.Option)
+	err := keeper.AddVote(ctx, msg.ProposalID, msg.Voter, NewNonSplitVoteOption(msg.Option))
 	if err != nil {
 		return err.Result()
 	}
 
-	proposalIDStr := fmt.Sprintf("%d", msg.ProposalID)
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.Voter.String()),
+		),
+	)
 
-	return sdk.Result{
-		Tags: sdk.NewTags(
-			tags.ProposalID, proposalIDStr,
-			tags.Category, tags.TxCategory,
-			tags.Sender, msg.Voter.String(),
+	return sdk.Result{Events: ctx.EventManager().Events()}
+}
+
+func handleMsgVoteWeighted(ctx sdk.Context, keeper Keeper, msg MsgVoteWeighted) sdk.Result {
+	err := keeper.AddVote(ctx, msg.ProposalID, msg.Voter, msg.Options)
+	if err != nil {
+		return err.Result()
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.Voter.String()),
+		),
+	)
+
+	return sdk.Result{Events: ctx.EventManager().Events()}
+}
+
+func handleMsgSetRepresentative(ctx sdk.Context, keeper Keeper, msg MsgSetRepresentative) sdk.Result {
+	err := keeper.SetRepresentative(ctx, msg.Delegator, msg.Representative, msg.ProposalType)
+	if err != nil {
+		return err.Result()
+	}
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			EventTypeSetRepresentative,
+			sdk.NewAttribute(AttributeKeyRepresentative, msg.Representative.String()),
+			sdk.NewAttribute(AttributeKeyProposalType, msg.ProposalType),
+		),
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.Delegator.String()),
 		),
+	})
+
+	return sdk.Result{Events: ctx.EventManager().Events()}
+}
+
+func handleMsgClearRepresentative(ctx sdk.Context, keeper Keeper, msg MsgClearRepresentative) sdk.Result {
+	err := keeper.ClearRepresentative(ctx, msg.Delegator, msg.ProposalType)
+	if err != nil {
+		return err.Result()
 	}
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			EventTypeClearRepresentative,
+			sdk.NewAttribute(AttributeKeyProposalType, msg.ProposalType),
+		),
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.Delegator.String()),
+		),
+	})
+
+	return sdk.Result{Events: ctx.EventManager().Events()}
 }
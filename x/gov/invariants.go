@@ -0,0 +1,101 @@
+package gov
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/gov/types"
+)
+
+// RegisterInvariants registers all governance invariants
+func RegisterInvariants(ir sdk.InvariantRouter, keeper Keeper) {
+	ir.RegisterRoute(types.ModuleName, "module-account", ModuleAccountInvariant(keeper))
+	ir.RegisterRoute(types.ModuleName, "deposits", DepositsInvariant(keeper))
+}
+
+// AllInvariants runs all invariants of the governance module
+func AllInvariants(keeper Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) error {
+		err := ModuleAccountInvariant(keeper)(ctx)
+		if err != nil {
+			return err
+		}
+
+		return DepositsInvariant(keeper)(ctx)
+	}
+}
+
+// ModuleAccountInvariant checks that the module account coins reflects the sum of
+// deposit amounts held on store
+func ModuleAccountInvariant(keeper Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) error {
+		var expectedDeposits sdk.Coins
+
+		keeper.IterateAllDeposits(ctx, func(deposit Deposit) bool {
+			expectedDeposits = expectedDeposits.Add(deposit.Amount)
+			return false
+		})
+
+		maccCoins := keeper.supplyKeeper.GetModuleAccountCoins(ctx, ModuleName)
+		if !maccCoins.IsEqual(expectedDeposits) {
+			return fmt.Errorf("deposits invariance:\n"+
+				"\tgov ModuleAccount coins: %s\n"+
+				"\tsum of deposit amounts:  %s", maccCoins, expectedDeposits)
+		}
+
+		return nil
+	}
+}
+
+// DepositsInvariant checks that deposits are only held for proposals in their
+// deposit or voting period and that they add up to the total deposit of these
+// proposals. Deposits of finalized, canceled or dropped proposals must have
+// been either refunded or burned.
+func DepositsInvariant(keeper Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) error {
+		var err error
+
+		keeper.IterateAllDeposits(ctx, func(deposit Deposit) bool {
+			proposal, ok := keeper.GetProposal(ctx, deposit.ProposalID)
+			if !ok {
+				err = fmt.Errorf("deposits invariance:\n"+
+					"\tdeposit of %s held for unknown proposal %d", deposit.Depositor, deposit.ProposalID)
+				return true
+			}
+
+			if proposal.Status != StatusDepositPeriod && proposal.Status != StatusVotingPeriod {
+				err = fmt.Errorf("deposits invariance:\n"+
+					"\tdeposit of %s held for proposal %d with status %s", deposit.Depositor, deposit.ProposalID, proposal.Status)
+				return true
+			}
+
+			return false
+		})
+		if err != nil {
+			return err
+		}
+
+		keeper.IterateProposals(ctx, func(proposal Proposal) bool {
+			if proposal.Status != StatusDepositPeriod && proposal.Status != StatusVotingPeriod {
+				return false
+			}
+
+			var deposits sdk.Coins
+			keeper.IterateDeposits(ctx, proposal.ProposalID, func(deposit Deposit) bool {
+				deposits = deposits.Add(deposit.Amount)
+				return false
+			})
+
+			if !deposits.IsEqual(proposal.TotalDeposit) {
+				err = fmt.Errorf("deposits invariance:\n"+
+					"\tproposal %d total deposit: %s\n"+
+					"\tsum of deposit amounts:    %s", proposal.ProposalID, proposal.TotalDeposit, deposits)
+				return true
+			}
+
+			return false
+		})
+
+		return err
+	}
+}
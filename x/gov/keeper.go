@@ -6,20 +6,13 @@ import (
 
 	"github.com/cosmos/cosmos-sdk/codec"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/auth"
 	"github.com/cosmos/cosmos-sdk/x/gov/types"
 	"github.com/cosmos/cosmos-sdk/x/params"
 
-	"github.com/tendermint/tendermint/crypto"
 	"github.com/tendermint/tendermint/libs/log"
 )
 
-// special governance addresses
-var (
-	// TODO: Find another way to implement this without using accounts, or find a cleaner way to implement it using accounts.
-	DepositedCoinsAccAddr     = sdk.AccAddress(crypto.AddressHash([]byte("govDepositedCoins")))
-	BurnedDepositCoinsAccAddr = sdk.AccAddress(crypto.AddressHash([]byte("govBurnedDepositCoins")))
-)
-
 // Governance Keeper
 type Keeper struct {
 	// The reference to the Param Keeper to get and set Global Params
@@ -28,8 +21,11 @@ type Keeper struct {
 	// The reference to the Paramstore to get and set gov specific params
 	paramSpace params.Subspace
 
-	// The reference to the CoinKeeper to modify balances
-	ck BankKeeper
+	// The reference to the SupplyKeeper to hold deposits in the module account
+	supplyKeeper SupplyKeeper
+
+	// The reference to the DistributionKeeper to send burned deposits to the community pool
+	distrKeeper DistributionKeeper
 
 	// The reference to the DelegationSet and ValidatorSet to get information about validators and delegators
 	sk StakingKeeper
@@ -54,9 +50,19 @@ type Keeper struct {
 // - and tallying the result of the vote.
 func NewKeeper(
 	cdc *codec.Codec, key sdk.StoreKey, paramsKeeper params.Keeper, paramSpace params.Subspace,
-	ck BankKeeper, sk StakingKeeper, codespace sdk.CodespaceType, rtr Router,
+	supplyKeeper SupplyKeeper, distrKeeper DistributionKeeper, sk StakingKeeper, codespace sdk.CodespaceType, rtr Router,
 ) Keeper {
 
+	// ensure governance module account is set
+	if addr := supplyKeeper.GetModuleAddress(types.ModuleName); addr == nil {
+		panic(fmt.Sprintf("%s module account has not been set", types.ModuleName))
+	}
+
+	// ensure governance treasury account is set
+	if addr := supplyKeeper.GetModuleAddress(types.TreasuryName); addr == nil {
+		panic(fmt.Sprintf("%s module account has not been set", types.TreasuryName))
+	}
+
 	// It is vital to seal the governance proposal router here as to not allow
 	// further handlers to be registered after the keeper is created since this
 	// could create invalid or non-deterministic behavior.
@@ -66,7 +72,8 @@ func NewKeeper(
 		storeKey:     key,
 		paramsKeeper: paramsKeeper,
 		paramSpace:   paramSpace.WithKeyTable(ParamKeyTable()),
-		ck:           ck,
+		supplyKeeper: supplyKeeper,
+		distrKeeper:  distrKeeper,
 		sk:           sk,
 		cdc:          cdc,
 		codespace:    codespace,
@@ -77,6 +84,17 @@ func NewKeeper(
 // Logger returns a module-specific logger.
 func (keeper Keeper) Logger(ctx sdk.Context) log.Logger { return ctx.Logger().With("module", "x/gov") }
 
+// GetGovernanceAccount returns the governance ModuleAccount
+func (keeper Keeper) GetGovernanceAccount(ctx sdk.Context) auth.ModuleAccountI {
+	return keeper.supplyKeeper.GetModuleAccount(ctx, types.ModuleName)
+}
+
+// GetTreasuryAccount returns the governance treasury ModuleAccount, which
+// executes the messages of the passed messages proposals
+func (keeper Keeper) GetTreasuryAccount(ctx sdk.Context) auth.ModuleAccountI {
+	return keeper.supplyKeeper.GetModuleAccount(ctx, types.TreasuryName)
+}
+
 // Params
 
 // Returns the current DepositParams from the global param store
@@ -258,6 +276,51 @@ func (keeper Keeper) IterateVotes(ctx sdk.Context, proposalID uint64, cb func(vo
 	}
 }
 
+// IterateAllVoteDelegations iterates over the all the stored vote delegations and performs a callback function
+func (keeper Keeper) IterateAllVoteDelegations(ctx sdk.Context, cb func(delegation types.VoteDelegation) (stop bool)) {
+	store := ctx.KVStore(keeper.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, types.VoteDelegationsKeyPrefix)
+
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		var delegation types.VoteDelegation
+		keeper.cdc.MustUnmarshalBinaryLengthPrefixed(iterator.Value(), &delegation)
+
+		if cb(delegation) {
+			break
+		}
+	}
+}
+
+// IterateVoteDelegations iterates over the all the vote delegations of a delegator and performs a callback function
+func (keeper Keeper) IterateVoteDelegations(ctx sdk.Context, delegator sdk.AccAddress, cb func(delegation types.VoteDelegation) (stop bool)) {
+	store := ctx.KVStore(keeper.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, types.VoteDelegationsKey(delegator))
+
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		var delegation types.VoteDelegation
+		keeper.cdc.MustUnmarshalBinaryLengthPrefixed(iterator.Value(), &delegation)
+
+		if cb(delegation) {
+			break
+		}
+	}
+}
+
+// IterateVoteDelegationsByRepresentative iterates over the all the vote delegations to a representative and performs a callback function
+func (keeper Keeper) IterateVoteDelegationsByRepresentative(ctx sdk.Context, representative sdk.AccAddress, cb func(delegation types.VoteDelegation) (stop bool)) {
+	store := ctx.KVStore(keeper.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, types.VoteDelegationsByRepresentativeKey(representative))
+
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		if cb(types.SplitVoteDelegationByRepresentativeKey(iterator.Key())) {
+			break
+		}
+	}
+}
+
 // ActiveProposalQueueIterator returns an sdk.Iterator for all the proposals in the Active Queue that expire by endTime
 func (keeper Keeper) ActiveProposalQueueIterator(ctx sdk.Context, endTime time.Time) sdk.Iterator {
 	store := ctx.KVStore(keeper.storeKey)
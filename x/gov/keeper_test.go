@@ -14,7 +14,7 @@ import (
 )
 
 func TestGetSetProposal(t *testing.T) {
-	input := getMockApp(t, 0, GenesisState{}, nil)
+	input := getMockApp(t, 1, GenesisState{}, nil, nil)
 
 	header := abci.Header{Height: input.mApp.LastBlockHeight() + 1}
 	input.mApp.BeginBlock(abci.RequestBeginBlock{Header: header})
@@ -22,7 +22,7 @@ func TestGetSetProposal(t *testing.T) {
 	ctx := input.mApp.BaseApp.NewContext(false, abci.Header{})
 
 	tp := testProposal()
-	proposal, err := input.keeper.SubmitProposal(ctx, tp)
+	proposal, err := input.keeper.SubmitProposal(ctx, tp, input.addrs[0], false)
 	require.NoError(t, err)
 	proposalID := proposal.ProposalID
 	input.keeper.SetProposal(ctx, proposal)
@@ -33,7 +33,7 @@ func TestGetSetProposal(t *testing.T) {
 }
 
 func TestIncrementProposalNumber(t *testing.T) {
-	input := getMockApp(t, 0, GenesisState{}, nil)
+	input := getMockApp(t, 1, GenesisState{}, nil, nil)
 
 	header := abci.Header{Height: input.mApp.LastBlockHeight() + 1}
 	input.mApp.BeginBlock(abci.RequestBeginBlock{Header: header})
@@ -41,19 +41,19 @@ func TestIncrementProposalNumber(t *testing.T) {
 	ctx := input.mApp.BaseApp.NewContext(false, abci.Header{})
 
 	tp := testProposal()
-	input.keeper.SubmitProposal(ctx, tp)
-	input.keeper.SubmitProposal(ctx, tp)
-	input.keeper.SubmitProposal(ctx, tp)
-	input.keeper.SubmitProposal(ctx, tp)
-	input.keeper.SubmitProposal(ctx, tp)
-	proposal6, err := input.keeper.SubmitProposal(ctx, tp)
+	input.keeper.SubmitProposal(ctx, tp, input.addrs[0], false)
+	input.keeper.SubmitProposal(ctx, tp, input.addrs[0], false)
+	input.keeper.SubmitProposal(ctx, tp, input.addrs[0], false)
+	input.keeper.SubmitProposal(ctx, tp, input.addrs[0], false)
+	input.keeper.SubmitProposal(ctx, tp, input.addrs[0], false)
+	proposal6, err := input.keeper.SubmitProposal(ctx, tp, input.addrs[0], false)
 	require.NoError(t, err)
 
 	require.Equal(t, uint64(6), proposal6.ProposalID)
 }
 
 func TestActivateVotingPeriod(t *testing.T) {
-	input := getMockApp(t, 0, GenesisState{}, nil)
+	input := getMockApp(t, 1, GenesisState{}, nil, nil)
 
 	header := abci.Header{Height: input.mApp.LastBlockHeight() + 1}
 	input.mApp.BeginBlock(abci.RequestBeginBlock{Header: header})
@@ -61,7 +61,7 @@ func TestActivateVotingPeriod(t *testing.T) {
 	ctx := input.mApp.BaseApp.NewContext(false, abci.Header{})
 
 	tp := testProposal()
-	proposal, err := input.keeper.SubmitProposal(ctx, tp)
+	proposal, err := input.keeper.SubmitProposal(ctx, tp, input.addrs[0], false)
 	require.NoError(t, err)
 
 	require.True(t, proposal.VotingStartTime.Equal(time.Time{}))
@@ -82,7 +82,7 @@ func TestActivateVotingPeriod(t *testing.T) {
 }
 
 func TestDeposits(t *testing.T) {
-	input := getMockApp(t, 2, GenesisState{}, nil)
+	input := getMockApp(t, 2, GenesisState{}, nil, nil)
 	SortAddresses(input.addrs)
 
 	header := abci.Header{Height: input.mApp.LastBlockHeight() + 1}
@@ -91,15 +91,15 @@ func TestDeposits(t *testing.T) {
 	ctx := input.mApp.BaseApp.NewContext(false, abci.Header{})
 
 	tp := testProposal()
-	proposal, err := input.keeper.SubmitProposal(ctx, tp)
+	proposal, err := input.keeper.SubmitProposal(ctx, tp, input.addrs[0], false)
 	require.NoError(t, err)
 	proposalID := proposal.ProposalID
 
 	fourStake := sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdk.TokensFromTendermintPower(4)))
 	fiveStake := sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdk.TokensFromTendermintPower(5)))
 
-	addr0Initial := input.keeper.ck.GetCoins(ctx, input.addrs[0])
-	addr1Initial := input.keeper.ck.GetCoins(ctx, input.addrs[1])
+	addr0Initial := input.mApp.BankKeeper.GetCoins(ctx, input.addrs[0])
+	addr1Initial := input.mApp.BankKeeper.GetCoins(ctx, input.addrs[1])
 
 	expTokens := sdk.TokensFromTendermintPower(42)
 	require.Equal(t, sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, expTokens)), addr0Initial)
@@ -123,7 +123,7 @@ func TestDeposits(t *testing.T) {
 	proposal, ok = input.keeper.GetProposal(ctx, proposalID)
 	require.True(t, ok)
 	require.Equal(t, fourStake, proposal.TotalDeposit)
-	require.Equal(t, addr0Initial.Sub(fourStake), input.keeper.ck.GetCoins(ctx, input.addrs[0]))
+	require.Equal(t, addr0Initial.Sub(fourStake), input.mApp.BankKeeper.GetCoins(ctx, input.addrs[0]))
 
 	// Check a second deposit from same address
 	err, votingStarted = input.keeper.AddDeposit(ctx, proposalID, input.addrs[0], fiveStake)
@@ -136,7 +136,7 @@ func TestDeposits(t *testing.T) {
 	proposal, ok = input.keeper.GetProposal(ctx, proposalID)
 	require.True(t, ok)
 	require.Equal(t, fourStake.Add(fiveStake), proposal.TotalDeposit)
-	require.Equal(t, addr0Initial.Sub(fourStake).Sub(fiveStake), input.keeper.ck.GetCoins(ctx, input.addrs[0]))
+	require.Equal(t, addr0Initial.Sub(fourStake).Sub(fiveStake), input.mApp.BankKeeper.GetCoins(ctx, input.addrs[0]))
 
 	// Check third deposit from a new address
 	err, votingStarted = input.keeper.AddDeposit(ctx, proposalID, input.addrs[1], fourStake)
@@ -149,7 +149,9 @@ func TestDeposits(t *testing.T) {
 	proposal, ok = input.keeper.GetProposal(ctx, proposalID)
 	require.True(t, ok)
 	require.Equal(t, fourStake.Add(fiveStake).Add(fourStake), proposal.TotalDeposit)
-	require.Equal(t, addr1Initial.Sub(fourStake), input.keeper.ck.GetCoins(ctx, input.addrs[1]))
+	require.Equal(t, addr1Initial.Sub(fourStake), input.mApp.BankKeeper.GetCoins(ctx, input.addrs[1]))
+	require.Equal(t, fourStake.Add(fiveStake).Add(fourStake), input.mApp.BankKeeper.GetCoins(ctx, input.keeper.GetGovernanceAccount(ctx).GetAddress()))
+	require.NoError(t, ModuleAccountInvariant(input.keeper)(ctx))
 
 	// Check that proposal moved to voting period
 	proposal, ok = input.keeper.GetProposal(ctx, proposalID)
@@ -177,13 +179,13 @@ func TestDeposits(t *testing.T) {
 	input.keeper.RefundDeposits(ctx, proposalID)
 	deposit, found = input.keeper.GetDeposit(ctx, proposalID, input.addrs[1])
 	require.False(t, found)
-	require.Equal(t, addr0Initial, input.keeper.ck.GetCoins(ctx, input.addrs[0]))
-	require.Equal(t, addr1Initial, input.keeper.ck.GetCoins(ctx, input.addrs[1]))
-
+	require.Equal(t, addr0Initial, input.mApp.BankKeeper.GetCoins(ctx, input.addrs[0]))
+	require.Equal(t, addr1Initial, input.mApp.BankKeeper.GetCoins(ctx, input.addrs[1]))
+	require.True(t, input.mApp.BankKeeper.GetCoins(ctx, input.keeper.GetGovernanceAccount(ctx).GetAddress()).IsZero())
 }
 
 func TestVotes(t *testing.T) {
-	input := getMockApp(t, 2, GenesisState{}, nil)
+	input := getMockApp(t, 2, GenesisState{}, nil, nil)
 	SortAddresses(input.addrs)
 
 	header := abci.Header{Height: input.mApp.LastBlockHeight() + 1}
@@ -192,7 +194,7 @@ func TestVotes(t *testing.T) {
 	ctx := input.mApp.BaseApp.NewContext(false, abci.Header{})
 
 	tp := testProposal()
-	proposal, err := input.keeper.SubmitProposal(ctx, tp)
+	proposal, err := input.keeper.SubmitProposal(ctx, tp, input.addrs[0], false)
 	require.NoError(t, err)
 	proposalID := proposal.ProposalID
 
@@ -200,28 +202,28 @@ func TestVotes(t *testing.T) {
 	input.keeper.SetProposal(ctx, proposal)
 
 	// Test first vote
-	input.keeper.AddVote(ctx, proposalID, input.addrs[0], OptionAbstain)
+	input.keeper.AddVote(ctx, proposalID, input.addrs[0], NewNonSplitVoteOption(OptionAbstain))
 	vote, found := input.keeper.GetVote(ctx, proposalID, input.addrs[0])
 	require.True(t, found)
 	require.Equal(t, input.addrs[0], vote.Voter)
 	require.Equal(t, proposalID, vote.ProposalID)
-	require.Equal(t, OptionAbstain, vote.Option)
+	require.True(t, vote.Options.Equals(NewNonSplitVoteOption(OptionAbstain)))
 
 	// Test change of vote
-	input.keeper.AddVote(ctx, proposalID, input.addrs[0], OptionYes)
+	input.keeper.AddVote(ctx, proposalID, input.addrs[0], NewNonSplitVoteOption(OptionYes))
 	vote, found = input.keeper.GetVote(ctx, proposalID, input.addrs[0])
 	require.True(t, found)
 	require.Equal(t, input.addrs[0], vote.Voter)
 	require.Equal(t, proposalID, vote.ProposalID)
-	require.Equal(t, OptionYes, vote.Option)
+	require.True(t, vote.Options.Equals(NewNonSplitVoteOption(OptionYes)))
 
 	// Test second vote
-	input.keeper.AddVote(ctx, proposalID, input.addrs[1], OptionNoWithVeto)
+	input.keeper.AddVote(ctx, proposalID, input.addrs[1], NewNonSplitVoteOption(OptionNoWithVeto))
 	vote, found = input.keeper.GetVote(ctx, proposalID, input.addrs[1])
 	require.True(t, found)
 	require.Equal(t, input.addrs[1], vote.Voter)
 	require.Equal(t, proposalID, vote.ProposalID)
-	require.Equal(t, OptionNoWithVeto, vote.Option)
+	require.True(t, vote.Options.Equals(NewNonSplitVoteOption(OptionNoWithVeto)))
 
 	// Test vote iterator
 	votesIterator := input.keeper.GetVotesIterator(ctx, proposalID)
@@ -230,21 +232,122 @@ func TestVotes(t *testing.T) {
 	require.True(t, votesIterator.Valid())
 	require.Equal(t, input.addrs[0], vote.Voter)
 	require.Equal(t, proposalID, vote.ProposalID)
-	require.Equal(t, OptionYes, vote.Option)
+	require.True(t, vote.Options.Equals(NewNonSplitVoteOption(OptionYes)))
 	votesIterator.Next()
 	require.True(t, votesIterator.Valid())
 	input.keeper.cdc.MustUnmarshalBinaryLengthPrefixed(votesIterator.Value(), &vote)
 	require.True(t, votesIterator.Valid())
 	require.Equal(t, input.addrs[1], vote.Voter)
 	require.Equal(t, proposalID, vote.ProposalID)
-	require.Equal(t, OptionNoWithVeto, vote.Option)
+	require.True(t, vote.Options.Equals(NewNonSplitVoteOption(OptionNoWithVeto)))
 	votesIterator.Next()
 	require.False(t, votesIterator.Valid())
 	votesIterator.Close()
 }
 
+func TestWeightedVotes(t *testing.T) {
+	input := getMockApp(t, 2, GenesisState{}, nil, nil)
+
+	header := abci.Header{Height: input.mApp.LastBlockHeight() + 1}
+	input.mApp.BeginBlock(abci.RequestBeginBlock{Header: header})
+
+	ctx := input.mApp.BaseApp.NewContext(false, abci.Header{})
+
+	tp := testProposal()
+	proposal, err := input.keeper.SubmitProposal(ctx, tp, input.addrs[0], false)
+	require.NoError(t, err)
+	proposalID := proposal.ProposalID
+
+	proposal.Status = StatusVotingPeriod
+	input.keeper.SetProposal(ctx, proposal)
+
+	// Test invalid weighted vote
+	invalidOptions := WeightedVoteOptions{
+		NewWeightedVoteOption(OptionYes, sdk.NewDecWithPrec(5, 1)),
+		NewWeightedVoteOption(OptionNo, sdk.NewDecWithPrec(4, 1)),
+	}
+	err = input.keeper.AddVote(ctx, proposalID, input.addrs[0], invalidOptions)
+	require.NotNil(t, err)
+	require.Equal(t, CodeInvalidVote, err.Code())
+	_, found := input.keeper.GetVote(ctx, proposalID, input.addrs[0])
+	require.False(t, found)
+
+	// Test weighted vote
+	options := WeightedVoteOptions{
+		NewWeightedVoteOption(OptionYes, sdk.NewDecWithPrec(6, 1)),
+		NewWeightedVoteOption(OptionNo, sdk.NewDecWithPrec(4, 1)),
+	}
+	err = input.keeper.AddVote(ctx, proposalID, input.addrs[0], options)
+	require.Nil(t, err)
+	vote, found := input.keeper.GetVote(ctx, proposalID, input.addrs[0])
+	require.True(t, found)
+	require.Equal(t, input.addrs[0], vote.Voter)
+	require.True(t, vote.Options.Equals(options))
+}
+
+func TestVoteDelegations(t *testing.T) {
+	input := getMockApp(t, 3, GenesisState{}, nil, nil)
+	SortAddresses(input.addrs)
+
+	header := abci.Header{Height: input.mApp.LastBlockHeight() + 1}
+	input.mApp.BeginBlock(abci.RequestBeginBlock{Header: header})
+
+	ctx := input.mApp.BaseApp.NewContext(false, abci.Header{})
+
+	// no representative by default
+	_, found := input.keeper.GetRepresentative(ctx, input.addrs[0], ProposalTypeText)
+	require.False(t, found)
+
+	// an account cannot represent itself
+	require.Error(t, input.keeper.SetRepresentative(ctx, input.addrs[0], input.addrs[0], ""))
+	require.Error(t, input.keeper.SetRepresentative(ctx, input.addrs[0], input.addrs[1], "Other"))
+
+	// a delegation for all the proposal types applies to text proposals
+	require.NoError(t, input.keeper.SetRepresentative(ctx, input.addrs[0], input.addrs[1], ""))
+	representative, found := input.keeper.GetRepresentative(ctx, input.addrs[0], ProposalTypeText)
+	require.True(t, found)
+	require.Equal(t, input.addrs[1], representative)
+
+	// a delegation for a proposal type takes precedence
+	require.NoError(t, input.keeper.SetRepresentative(ctx, input.addrs[0], input.addrs[2], ProposalTypeText))
+	representative, found = input.keeper.GetRepresentative(ctx, input.addrs[0], ProposalTypeText)
+	require.True(t, found)
+	require.Equal(t, input.addrs[2], representative)
+	representative, found = input.keeper.GetRepresentative(ctx, input.addrs[0], ProposalTypeMessages)
+	require.True(t, found)
+	require.Equal(t, input.addrs[1], representative)
+
+	require.NoError(t, input.keeper.SetRepresentative(ctx, input.addrs[1], input.addrs[2], ""))
+
+	require.Len(t, input.keeper.GetAllVoteDelegations(ctx), 3)
+	require.Len(t, input.keeper.GetVoteDelegationsFiltered(ctx, input.addrs[0], nil), 2)
+	require.Len(t, input.keeper.GetVoteDelegationsFiltered(ctx, nil, input.addrs[2]), 2)
+	require.Equal(t,
+		VoteDelegations{NewVoteDelegation(input.addrs[0], input.addrs[2], ProposalTypeText)},
+		input.keeper.GetVoteDelegationsFiltered(ctx, input.addrs[0], input.addrs[2]),
+	)
+
+	// clear the delegation for text proposals
+	require.NoError(t, input.keeper.ClearRepresentative(ctx, input.addrs[0], ProposalTypeText))
+	require.Error(t, input.keeper.ClearRepresentative(ctx, input.addrs[0], ProposalTypeText))
+	representative, found = input.keeper.GetRepresentative(ctx, input.addrs[0], ProposalTypeText)
+	require.True(t, found)
+	require.Equal(t, input.addrs[1], representative)
+
+	require.NoError(t, input.keeper.ClearRepresentative(ctx, input.addrs[0], ""))
+	_, found = input.keeper.GetRepresentative(ctx, input.addrs[0], ProposalTypeText)
+	require.False(t, found)
+
+	// the cleared delegations are removed from the index by representative
+	require.Empty(t, input.keeper.GetVoteDelegationsFiltered(ctx, nil, input.addrs[1]))
+	require.Equal(t,
+		VoteDelegations{NewVoteDelegation(input.addrs[1], input.addrs[2], "")},
+		input.keeper.GetVoteDelegationsFiltered(ctx, nil, input.addrs[2]),
+	)
+}
+
 func TestProposalQueues(t *testing.T) {
-	input := getMockApp(t, 0, GenesisState{}, nil)
+	input := getMockApp(t, 1, GenesisState{}, nil, nil)
 
 	header := abci.Header{Height: input.mApp.LastBlockHeight() + 1}
 	input.mApp.BeginBlock(abci.RequestBeginBlock{Header: header})
@@ -254,7 +357,7 @@ func TestProposalQueues(t *testing.T) {
 
 	// create test proposals
 	tp := testProposal()
-	proposal, err := input.keeper.SubmitProposal(ctx, tp)
+	proposal, err := input.keeper.SubmitProposal(ctx, tp, input.addrs[0], false)
 	require.NoError(t, err)
 
 	inactiveIterator := input.keeper.InactiveProposalQueueIterator(ctx, proposal.DepositEndTime)
@@ -322,7 +425,7 @@ func registerTestCodec(cdc *codec.Codec) {
 }
 
 func TestSubmitProposal(t *testing.T) {
-	input := getMockApp(t, 0, GenesisState{}, nil)
+	input := getMockApp(t, 1, GenesisState{}, nil, nil)
 
 	registerTestCodec(input.keeper.cdc)
 
@@ -349,7 +452,86 @@ func TestSubmitProposal(t *testing.T) {
 	}
 
 	for _, tc := range testCases {
-		_, err := input.keeper.SubmitProposal(ctx, tc.content)
+		_, err := input.keeper.SubmitProposal(ctx, tc.content, input.addrs[0], false)
 		require.Equal(t, tc.expectedErr, err, "unexpected type of error: %s", err)
 	}
 }
+
+func TestSubmitProposalEvents(t *testing.T) {
+	input := getMockApp(t, 1, GenesisState{}, nil, nil)
+
+	// the handler of the test proposal emits an event when it succeeds
+	input.keeper.router = NewRouter().AddRoute(RouterKey, badProposalHandler)
+
+	header := abci.Header{Height: input.mApp.LastBlockHeight() + 1}
+	input.mApp.BeginBlock(abci.RequestBeginBlock{Header: header})
+	ctx := input.mApp.BaseApp.NewContext(false, abci.Header{})
+	ctx = ctx.WithValue(contextKeyBadProposal, true).WithEventManager(sdk.NewEventManager())
+
+	_, err := input.keeper.SubmitProposal(ctx, testProposal(), input.addrs[0], false)
+	require.NoError(t, err)
+
+	// the dry-run of the handler at submission does not emit its events
+	for _, event := range ctx.EventManager().Events() {
+		require.NotEqual(t, eventTypeBadProposal, event.Type)
+	}
+}
+
+func TestCancelProposal(t *testing.T) {
+	input := getMockApp(t, 2, GenesisState{}, nil, nil)
+	SortAddresses(input.addrs)
+
+	header := abci.Header{Height: input.mApp.LastBlockHeight() + 1}
+	input.mApp.BeginBlock(abci.RequestBeginBlock{Header: header})
+
+	ctx := input.mApp.BaseApp.NewContext(false, abci.Header{})
+
+	tp := testProposal()
+	proposal, err := input.keeper.SubmitProposal(ctx, tp, input.addrs[0], false)
+	require.NoError(t, err)
+	proposalID := proposal.ProposalID
+	require.Equal(t, input.addrs[0], proposal.Proposer)
+
+	fiveStake := sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdk.TokensFromTendermintPower(5)))
+	addr0Initial := input.mApp.BankKeeper.GetCoins(ctx, input.addrs[0])
+	addr1Initial := input.mApp.BankKeeper.GetCoins(ctx, input.addrs[1])
+	supplyInitial := input.supplyKeeper.GetSupply(ctx).Total
+
+	// activate the voting period and vote on the proposal
+	err, _ = input.keeper.AddDeposit(ctx, proposalID, input.addrs[0], fiveStake)
+	require.Nil(t, err)
+	err, votingStarted := input.keeper.AddDeposit(ctx, proposalID, input.addrs[1], fiveStake)
+	require.Nil(t, err)
+	require.True(t, votingStarted)
+	require.NoError(t, input.keeper.AddVote(ctx, proposalID, input.addrs[1], NewNonSplitVoteOption(OptionYes)))
+
+	proposal, ok := input.keeper.GetProposal(ctx, proposalID)
+	require.True(t, ok)
+
+	// only the proposer can cancel the proposal
+	err = input.keeper.CancelProposal(ctx, proposalID, input.addrs[1])
+	require.Equal(t, ErrInvalidProposer(DefaultCodespace, proposalID, input.addrs[1]), err)
+
+	err = input.keeper.CancelProposal(ctx, proposalID, input.addrs[0])
+	require.Nil(t, err)
+
+	_, ok = input.keeper.GetProposal(ctx, proposalID)
+	require.False(t, ok)
+	_, found := input.keeper.GetVote(ctx, proposalID, input.addrs[1])
+	require.False(t, found)
+	require.Empty(t, input.keeper.GetDeposits(ctx, proposalID))
+
+	activeIterator := input.keeper.ActiveProposalQueueIterator(ctx, proposal.VotingEndTime)
+	require.False(t, activeIterator.Valid())
+	activeIterator.Close()
+
+	// half of the deposits are burned and the rest is refunded
+	halfStake := sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdk.TokensFromTendermintPower(5).QuoRaw(2)))
+	require.Equal(t, addr0Initial.Sub(halfStake), input.mApp.BankKeeper.GetCoins(ctx, input.addrs[0]))
+	require.Equal(t, addr1Initial.Sub(halfStake), input.mApp.BankKeeper.GetCoins(ctx, input.addrs[1]))
+	require.Equal(t, supplyInitial.Sub(halfStake.Add(halfStake)), input.supplyKeeper.GetSupply(ctx).Total)
+
+	// a canceled proposal cannot be canceled again
+	err = input.keeper.CancelProposal(ctx, proposalID, input.addrs[0])
+	require.Equal(t, ErrUnknownProposal(DefaultCodespace, proposalID), err)
+}
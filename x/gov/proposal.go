@@ -7,16 +7,19 @@ import (
 	"github.com/cosmos/cosmos-sdk/x/gov/types"
 )
 
-// SubmitProposal create new proposal given a content
-func (keeper Keeper) SubmitProposal(ctx sdk.Context, content Content) (Proposal, sdk.Error) {
+// SubmitProposal create new proposal given a content. Expedited proposals
+// require a higher deposit and threshold but have a shorter voting period.
+func (keeper Keeper) SubmitProposal(ctx sdk.Context, content Content, proposer sdk.AccAddress, expedited bool) (Proposal, sdk.Error) {
 	if !keeper.router.HasRoute(content.ProposalRoute()) {
 		return Proposal{}, ErrNoProposalHandlerExists(keeper.codespace, content)
 	}
 
 	// Execute the proposal content in a cache-wrapped context to validate the
 	// actual parameter changes before the proposal proceeds through the
-	// governance process. State is not persisted.
+	// governance process. State is not persisted, nor are the events emitted
+	// by the handler.
 	cacheCtx, _ := ctx.CacheContext()
+	cacheCtx = cacheCtx.WithEventManager(sdk.NewEventManager())
 	handler := keeper.router.GetRoute(content.ProposalRoute())
 	if err := handler(cacheCtx, content); err != nil {
 		return Proposal{}, ErrInvalidProposalContent(keeper.codespace, err.Result().Log)
@@ -30,7 +33,7 @@ func (keeper Keeper) SubmitProposal(ctx sdk.Context, content Content) (Proposal,
 	submitTime := ctx.BlockHeader().Time
 	depositPeriod := keeper.GetDepositParams(ctx).MaxDepositPeriod
 
-	proposal := NewProposal(content, proposalID, submitTime, submitTime.Add(depositPeriod))
+	proposal := NewProposal(content, proposalID, proposer, submitTime, submitTime.Add(depositPeriod), expedited)
 
 	keeper.SetProposal(ctx, proposal)
 	keeper.InsertInactiveProposalQueue(ctx, proposalID, proposal.DepositEndTime)
@@ -39,6 +42,34 @@ func (keeper Keeper) SubmitProposal(ctx sdk.Context, content Content) (Proposal,
 	return proposal, nil
 }
 
+// CancelProposal cancels a proposal in its deposit or voting period on behalf
+// of its proposer. The proposal and its votes are deleted, and the
+// ProposalCancelRatio of the deposits is burned while the rest is refunded.
+func (keeper Keeper) CancelProposal(ctx sdk.Context, proposalID uint64, proposer sdk.AccAddress) sdk.Error {
+	proposal, ok := keeper.GetProposal(ctx, proposalID)
+	if !ok {
+		return ErrUnknownProposal(keeper.codespace, proposalID)
+	}
+
+	if (proposal.Status != StatusDepositPeriod) && (proposal.Status != StatusVotingPeriod) {
+		return ErrAlreadyFinishedProposal(keeper.codespace, proposalID)
+	}
+
+	if !proposal.Proposer.Equals(proposer) {
+		return ErrInvalidProposer(keeper.codespace, proposalID, proposer)
+	}
+
+	cancelRatio := keeper.GetDepositParams(ctx).ProposalCancelRatio
+	keeper.chargeDeposits(ctx, proposalID, cancelRatio)
+	keeper.deleteVotes(ctx, proposalID)
+
+	// DeleteProposal also removes the proposal from the inactive and active
+	// proposal queues
+	keeper.DeleteProposal(ctx, proposalID)
+
+	return nil
+}
+
 // GetProposal get Proposal from store by ProposalID
 func (keeper Keeper) GetProposal(ctx sdk.Context, proposalID uint64) (proposal Proposal, ok bool) {
 	store := ctx.KVStore(keeper.storeKey)
@@ -145,7 +176,7 @@ func (keeper Keeper) setProposalID(ctx sdk.Context, proposalID uint64) {
 
 func (keeper Keeper) activateVotingPeriod(ctx sdk.Context, proposal Proposal) {
 	proposal.VotingStartTime = ctx.BlockHeader().Time
-	votingPeriod := keeper.GetVotingParams(ctx).VotingPeriod
+	votingPeriod := keeper.GetVotingParams(ctx).GetVotingPeriod(proposal.Expedited)
 	proposal.VotingEndTime = proposal.VotingStartTime.Add(votingPeriod)
 	proposal.Status = StatusVotingPeriod
 	keeper.SetProposal(ctx, proposal)
@@ -0,0 +1,33 @@
+package gov
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// NewProposalHandler creates a governance handler for the governance module
+// based proposals. Messages proposals are executed through the given message
+// router, while all other proposal types are handled by ProposalHandler.
+func NewProposalHandler(router sdk.Router) Handler {
+	return func(ctx sdk.Context, content Content) sdk.Error {
+		switch c := content.(type) {
+		case MessagesProposal:
+			return handleMessagesProposal(ctx, router, c)
+
+		default:
+			return ProposalHandler(ctx, c)
+		}
+	}
+}
+
+// handleMessagesProposal executes all the proposal messages in order. The
+// events of the messages are only emitted when every message succeeds; the
+// caller is responsible for discarding any state changes otherwise.
+func handleMessagesProposal(ctx sdk.Context, router sdk.Router, p MessagesProposal) sdk.Error {
+	res, failed := sdk.DispatchMsgs(ctx, router, p.Messages, nil)
+	if !res.IsOK() {
+		return ErrProposalMsgFailed(DefaultCodespace, failed, res.Log)
+	}
+
+	ctx.EventManager().EmitEvents(res.Events)
+	return nil
+}
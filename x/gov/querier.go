@@ -29,6 +29,10 @@ func NewQuerier(keeper Keeper) sdk.Querier {
 			return queryVote(ctx, path[1:], req, keeper)
 		case QueryTally:
 			return queryTally(ctx, path[1:], req, keeper)
+		case QueryTallyBreakdown:
+			return queryTallyBreakdown(ctx, path[1:], req, keeper)
+		case QueryVoteDelegations:
+			return queryVoteDelegations(ctx, path[1:], req, keeper)
 		default:
 			return nil, sdk.ErrUnknownRequest("unknown gov query endpoint")
 		}
@@ -162,6 +166,56 @@ func queryTally(ctx sdk.Context, path []string, req abci.RequestQuery, keeper Ke
 	return bz, nil
 }
 
+// nolint: unparam
+func queryTallyBreakdown(ctx sdk.Context, path []string, req abci.RequestQuery, keeper Keeper) ([]byte, sdk.Error) {
+	var params QueryProposalParams
+	err := keeper.cdc.UnmarshalJSON(req.Data, &params)
+	if err != nil {
+		return nil, sdk.ErrUnknownRequest(sdk.AppendMsgToErr("incorrectly formatted request data", err.Error()))
+	}
+
+	proposal, ok := keeper.GetProposal(ctx, params.ProposalID)
+	if !ok {
+		return nil, ErrUnknownProposal(DefaultCodespace, params.ProposalID)
+	}
+
+	var breakdown TallyBreakdown
+
+	switch proposal.Status {
+	case StatusDepositPeriod:
+		breakdown = NewTallyBreakdown(EmptyTallyResult(), nil)
+	case StatusVotingPeriod:
+		results, _, voters := tallyVotes(ctx, keeper, proposal)
+		breakdown = NewTallyBreakdown(NewTallyResultFromMap(results), voters)
+	default:
+		// votes are removed once the proposal is tallied
+		breakdown = NewTallyBreakdown(proposal.FinalTallyResult, nil)
+	}
+
+	bz, err := codec.MarshalJSONIndent(keeper.cdc, breakdown)
+	if err != nil {
+		return nil, sdk.ErrInternal(sdk.AppendMsgToErr("could not marshal result to JSON", err.Error()))
+	}
+	return bz, nil
+}
+
+// nolint: unparam
+func queryVoteDelegations(ctx sdk.Context, path []string, req abci.RequestQuery, keeper Keeper) ([]byte, sdk.Error) {
+	var params QueryVoteDelegationsParams
+	err := keeper.cdc.UnmarshalJSON(req.Data, &params)
+	if err != nil {
+		return nil, sdk.ErrUnknownRequest(sdk.AppendMsgToErr("incorrectly formatted request data", err.Error()))
+	}
+
+	delegations := keeper.GetVoteDelegationsFiltered(ctx, params.Delegator, params.Representative)
+
+	bz, err := codec.MarshalJSONIndent(keeper.cdc, delegations)
+	if err != nil {
+		return nil, sdk.ErrInternal(sdk.AppendMsgToErr("could not marshal result to JSON", err.Error()))
+	}
+	return bz, nil
+}
+
 // nolint: unparam
 func queryVotes(ctx sdk.Context, path []string, req abci.RequestQuery, keeper Keeper) ([]byte, sdk.Error) {
 	var params QueryProposalParams
@@ -169,9 +169,41 @@ func getQueriedTally(t *testing.T, ctx sdk.Context, cdc *codec.Codec, querier sd
 	return tally
 }
 
+func getQueriedTallyBreakdown(t *testing.T, ctx sdk.Context, cdc *codec.Codec, querier sdk.Querier, proposalID uint64) TallyBreakdown {
+	query := abci.RequestQuery{
+		Path: strings.Join([]string{custom, QuerierRoute, QueryTallyBreakdown}, "/"),
+		Data: cdc.MustMarshalJSON(NewQueryProposalParams(proposalID)),
+	}
+
+	bz, err := querier(ctx, []string{QueryTallyBreakdown}, query)
+	require.Nil(t, err)
+	require.NotNil(t, bz)
+
+	var breakdown TallyBreakdown
+	err2 := cdc.UnmarshalJSON(bz, &breakdown)
+	require.Nil(t, err2)
+	return breakdown
+}
+
+func getQueriedVoteDelegations(t *testing.T, ctx sdk.Context, cdc *codec.Codec, querier sdk.Querier, delegator, representative sdk.AccAddress) VoteDelegations {
+	query := abci.RequestQuery{
+		Path: strings.Join([]string{custom, QuerierRoute, QueryVoteDelegations}, "/"),
+		Data: cdc.MustMarshalJSON(NewQueryVoteDelegationsParams(delegator, representative)),
+	}
+
+	bz, err := querier(ctx, []string{QueryVoteDelegations}, query)
+	require.Nil(t, err)
+	require.NotNil(t, bz)
+
+	var delegations VoteDelegations
+	err2 := cdc.UnmarshalJSON(bz, &delegations)
+	require.Nil(t, err2)
+	return delegations
+}
+
 func TestQueryParams(t *testing.T) {
 	cdc := codec.New()
-	input := getMockApp(t, 1000, GenesisState{}, nil)
+	input := getMockApp(t, 1000, GenesisState{}, nil, nil)
 	querier := NewQuerier(input.keeper)
 
 	header := abci.Header{Height: input.mApp.LastBlockHeight() + 1}
@@ -184,7 +216,7 @@ func TestQueryParams(t *testing.T) {
 
 func TestQueries(t *testing.T) {
 	cdc := codec.New()
-	input := getMockApp(t, 1000, GenesisState{}, nil)
+	input := getMockApp(t, 1000, GenesisState{}, nil, nil)
 	querier := NewQuerier(input.keeper)
 	handler := NewHandler(input.keeper)
 
@@ -198,18 +230,18 @@ func TestQueries(t *testing.T) {
 	depositParams, _, _ := getQueriedParams(t, ctx, cdc, querier)
 
 	// input.addrs[0] proposes (and deposits) proposals #1 and #2
-	res := handler(ctx, NewMsgSubmitProposal(testProposal(), sdk.Coins{sdk.NewInt64Coin(sdk.DefaultBondDenom, 1)}, input.addrs[0]))
+	res := handler(ctx, NewMsgSubmitProposal(testProposal(), sdk.Coins{sdk.NewInt64Coin(sdk.DefaultBondDenom, 1)}, input.addrs[0], false))
 	var proposalID1 uint64
 	require.True(t, res.IsOK())
 	cdc.MustUnmarshalBinaryLengthPrefixed(res.Data, &proposalID1)
 
-	res = handler(ctx, NewMsgSubmitProposal(testProposal(), sdk.Coins{sdk.NewInt64Coin(sdk.DefaultBondDenom, 10000000)}, input.addrs[0]))
+	res = handler(ctx, NewMsgSubmitProposal(testProposal(), sdk.Coins{sdk.NewInt64Coin(sdk.DefaultBondDenom, 10000000)}, input.addrs[0], false))
 	var proposalID2 uint64
 	require.True(t, res.IsOK())
 	cdc.MustUnmarshalBinaryLengthPrefixed(res.Data, &proposalID2)
 
 	// input.addrs[1] proposes (and deposits) proposals #3
-	res = handler(ctx, NewMsgSubmitProposal(testProposal(), sdk.Coins{sdk.NewInt64Coin(sdk.DefaultBondDenom, 1)}, input.addrs[1]))
+	res = handler(ctx, NewMsgSubmitProposal(testProposal(), sdk.Coins{sdk.NewInt64Coin(sdk.DefaultBondDenom, 1)}, input.addrs[1], false))
 	var proposalID3 uint64
 	require.True(t, res.IsOK())
 	cdc.MustUnmarshalBinaryLengthPrefixed(res.Data, &proposalID3)
@@ -300,3 +332,54 @@ func TestQueries(t *testing.T) {
 	proposals = getQueriedProposals(t, ctx, cdc, querier, input.addrs[0], input.addrs[0], StatusNil, 0)
 	require.Equal(t, proposalID2, (proposals[0]).ProposalID)
 }
+
+func TestQueryRepresentatives(t *testing.T) {
+	cdc := codec.New()
+	input := getMockApp(t, 3, GenesisState{}, nil, nil)
+	querier := NewQuerier(input.keeper)
+	handler := NewHandler(input.keeper)
+
+	types.RegisterCodec(cdc)
+
+	header := abci.Header{Height: input.mApp.LastBlockHeight() + 1}
+	input.mApp.BeginBlock(abci.RequestBeginBlock{Header: header})
+
+	ctx := input.mApp.NewContext(false, abci.Header{})
+
+	res := handler(ctx, NewMsgSetRepresentative(input.addrs[0], input.addrs[2], ""))
+	require.True(t, res.IsOK())
+	res = handler(ctx, NewMsgSetRepresentative(input.addrs[1], input.addrs[2], ProposalTypeText))
+	require.True(t, res.IsOK())
+
+	// who represents addrs[0]
+	delegations := getQueriedVoteDelegations(t, ctx, cdc, querier, input.addrs[0], nil)
+	require.Equal(t, VoteDelegations{NewVoteDelegation(input.addrs[0], input.addrs[2], "")}, delegations)
+
+	// whom addrs[2] represents
+	delegations = getQueriedVoteDelegations(t, ctx, cdc, querier, nil, input.addrs[2])
+	require.Len(t, delegations, 2)
+
+	res = handler(ctx, NewMsgClearRepresentative(input.addrs[1], ProposalTypeText))
+	require.True(t, res.IsOK())
+	res = handler(ctx, NewMsgClearRepresentative(input.addrs[1], ProposalTypeText))
+	require.False(t, res.IsOK())
+
+	delegations = getQueriedVoteDelegations(t, ctx, cdc, querier, nil, input.addrs[2])
+	require.Len(t, delegations, 1)
+
+	// the breakdown of a proposal in deposit period is empty
+	proposal, err := input.keeper.SubmitProposal(ctx, testProposal(), input.addrs[0], false)
+	require.NoError(t, err)
+
+	breakdown := getQueriedTallyBreakdown(t, ctx, cdc, querier, proposal.ProposalID)
+	require.True(t, breakdown.Result.Equals(EmptyTallyResult()))
+	require.Empty(t, breakdown.Voters)
+
+	proposal.Status = StatusVotingPeriod
+	input.keeper.SetProposal(ctx, proposal)
+	require.Nil(t, input.keeper.AddVote(ctx, proposal.ProposalID, input.addrs[2], NewNonSplitVoteOption(OptionYes)))
+
+	breakdown = getQueriedTallyBreakdown(t, ctx, cdc, querier, proposal.ProposalID)
+	require.Len(t, breakdown.Voters, 1)
+	require.Equal(t, input.addrs[2], breakdown.Voters[0].Voter)
+}
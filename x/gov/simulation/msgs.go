@@ -86,7 +86,13 @@ func SimulateSubmittingVotingAndSlashingForProposal(k gov.Keeper, contentSim Con
 		fops := make([]simulation.FutureOperation, numVotes+1)
 		for i := 0; i < numVotes; i++ {
 			whenVote := ctx.BlockHeader().Time.Add(time.Duration(r.Int63n(int64(votingPeriod.Seconds()))) * time.Second)
-			fops[i] = simulation.FutureOperation{BlockTime: whenVote, Op: operationSimulateMsgVote(k, accs[whoVotes[i]], proposalID)}
+
+			// half of the voters split their voting power between several options
+			op := operationSimulateMsgVote(k, accs[whoVotes[i]], proposalID)
+			if r.Intn(2) == 0 {
+				op = operationSimulateMsgVoteWeighted(k, accs[whoVotes[i]], proposalID)
+			}
+			fops[i] = simulation.FutureOperation{BlockTime: whenVote, Op: op}
 		}
 
 		// 3) Make an operation to ensure slashes were done correctly. (Really should be a future invariant)
@@ -115,7 +121,7 @@ func SimulateTextProposalContent(r *rand.Rand, _ *baseapp.BaseApp, _ sdk.Context
 }
 
 func simulationCreateMsgSubmitProposal(r *rand.Rand, c gov.Content, s simulation.Account) (msg gov.MsgSubmitProposal, err error) {
-	msg = gov.NewMsgSubmitProposal(c, randomDeposit(r), s.Address)
+	msg = gov.NewMsgSubmitProposal(c, randomDeposit(r), s.Address, r.Intn(2) == 0)
 	if msg.ValidateBasic() != nil {
 		err = fmt.Errorf("expected msg to pass ValidateBasic: %s", msg.GetSignBytes())
 	}
@@ -188,6 +194,107 @@ func operationSimulateMsgVote(k gov.Keeper, acc simulation.Account, proposalID u
 	}
 }
 
+// SimulateMsgVoteWeighted
+// nolint: unparam
+func SimulateMsgVoteWeighted(k gov.Keeper) simulation.Operation {
+	return operationSimulateMsgVoteWeighted(k, simulation.Account{}, 0)
+}
+
+// nolint: unparam
+func operationSimulateMsgVoteWeighted(k gov.Keeper, acc simulation.Account, proposalID uint64) simulation.Operation {
+	return func(r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simulation.Account) (
+		opMsg simulation.OperationMsg, fOps []simulation.FutureOperation, err error) {
+
+		if acc.Equals(simulation.Account{}) {
+			acc = simulation.RandomAcc(r, accs)
+		}
+
+		if proposalID < 0 {
+			var ok bool
+			proposalID, ok = randomProposalID(r, k, ctx)
+			if !ok {
+				return simulation.NoOpMsg(), nil, nil
+			}
+		}
+		options := randomWeightedVotingOptions(r)
+
+		msg := gov.NewMsgVoteWeighted(acc.Address, proposalID, options)
+		if msg.ValidateBasic() != nil {
+			return simulation.NoOpMsg(), nil, fmt.Errorf("expected msg to pass ValidateBasic: %s", msg.GetSignBytes())
+		}
+
+		ctx, write := ctx.CacheContext()
+		ok := gov.NewHandler(k)(ctx, msg).IsOK()
+		if ok {
+			write()
+		}
+
+		opMsg = simulation.NewOperationMsg(msg, ok, "")
+		return opMsg, nil, nil
+	}
+}
+
+// SimulateMsgSetRepresentative
+func SimulateMsgSetRepresentative(k gov.Keeper) simulation.Operation {
+	return func(r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simulation.Account) (
+		opMsg simulation.OperationMsg, fOps []simulation.FutureOperation, err error) {
+
+		delegator := simulation.RandomAcc(r, accs)
+		representative := simulation.RandomAcc(r, accs)
+		if delegator.Equals(representative) {
+			return simulation.NoOpMsg(), nil, nil
+		}
+
+		// delegate either all the proposal types or only text proposals
+		var proposalType string
+		if r.Intn(2) == 0 {
+			proposalType = gov.ProposalTypeText
+		}
+
+		msg := gov.NewMsgSetRepresentative(delegator.Address, representative.Address, proposalType)
+		if msg.ValidateBasic() != nil {
+			return simulation.NoOpMsg(), nil, fmt.Errorf("expected msg to pass ValidateBasic: %s", msg.GetSignBytes())
+		}
+
+		ctx, write := ctx.CacheContext()
+		ok := gov.NewHandler(k)(ctx, msg).IsOK()
+		if ok {
+			write()
+		}
+
+		opMsg = simulation.NewOperationMsg(msg, ok, "")
+		return opMsg, nil, nil
+	}
+}
+
+// SimulateMsgClearRepresentative
+func SimulateMsgClearRepresentative(k gov.Keeper) simulation.Operation {
+	return func(r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simulation.Account) (
+		opMsg simulation.OperationMsg, fOps []simulation.FutureOperation, err error) {
+
+		delegator := simulation.RandomAcc(r, accs)
+		delegations := k.GetVoteDelegationsFiltered(ctx, delegator.Address, nil)
+		if len(delegations) == 0 {
+			return simulation.NoOpMsg(), nil, nil
+		}
+
+		delegation := delegations[r.Intn(len(delegations))]
+		msg := gov.NewMsgClearRepresentative(delegator.Address, delegation.ProposalType)
+		if msg.ValidateBasic() != nil {
+			return simulation.NoOpMsg(), nil, fmt.Errorf("expected msg to pass ValidateBasic: %s", msg.GetSignBytes())
+		}
+
+		ctx, write := ctx.CacheContext()
+		ok := gov.NewHandler(k)(ctx, msg).IsOK()
+		if ok {
+			write()
+		}
+
+		opMsg = simulation.NewOperationMsg(msg, ok, "")
+		return opMsg, nil, nil
+	}
+}
+
 // Pick a random deposit
 func randomDeposit(r *rand.Rand) sdk.Coins {
 	// TODO Choose based on account balance and min deposit
@@ -221,3 +328,20 @@ func randomVotingOption(r *rand.Rand) gov.VoteOption {
 	}
 	panic("should not happen")
 }
+
+// Pick random weighted voting options, with weights in hundredths adding up to 1
+func randomWeightedVotingOptions(r *rand.Rand) gov.WeightedVoteOptions {
+	w1 := r.Intn(100 + 1)
+	w2 := r.Intn(100 - w1 + 1)
+	w3 := r.Intn(100 - w1 - w2 + 1)
+	weights := []int{w1, w2, w3, 100 - w1 - w2 - w3}
+	options := []gov.VoteOption{gov.OptionYes, gov.OptionAbstain, gov.OptionNo, gov.OptionNoWithVeto}
+
+	var weightedOptions gov.WeightedVoteOptions
+	for i, weight := range weights {
+		if weight > 0 {
+			weightedOptions = append(weightedOptions, gov.NewWeightedVoteOption(options[i], sdk.NewDecWithPrec(int64(weight), 2)))
+		}
+	}
+	return weightedOptions
+}
@@ -8,15 +8,15 @@ import (
 
 // validatorGovInfo used for tallying
 type validatorGovInfo struct {
-	Address             sdk.ValAddress // address of the validator operator
-	BondedTokens        sdk.Int        // Power of a Validator
-	DelegatorShares     sdk.Dec        // Total outstanding delegator shares
-	DelegatorDeductions sdk.Dec        // Delegator deductions from validator's delegators voting independently
-	Vote                VoteOption     // Vote of the validator
+	Address             sdk.ValAddress      // address of the validator operator
+	BondedTokens        sdk.Int             // Power of a Validator
+	DelegatorShares     sdk.Dec             // Total outstanding delegator shares
+	DelegatorDeductions sdk.Dec             // Delegator deductions from validator's delegators voting independently
+	Vote                WeightedVoteOptions // Vote of the validator
 }
 
 func newValidatorGovInfo(address sdk.ValAddress, bondedTokens sdk.Int, delegatorShares,
-	delegatorDeductions sdk.Dec, vote VoteOption) validatorGovInfo {
+	delegatorDeductions sdk.Dec, vote WeightedVoteOptions) validatorGovInfo {
 
 	return validatorGovInfo{
 		Address:             address,
@@ -27,15 +27,19 @@ func newValidatorGovInfo(address sdk.ValAddress, bondedTokens sdk.Int, delegator
 	}
 }
 
-// TODO: Break into several smaller functions for clarity
-func tally(ctx sdk.Context, keeper Keeper, proposal Proposal) (passes bool, burnDeposits bool, tallyResults TallyResult) {
-	results := make(map[VoteOption]sdk.Dec)
+// tallyVotes computes the voting power given to each vote option of a proposal,
+// along with the total voting power and the voting power contributed by each
+// voter.
+func tallyVotes(ctx sdk.Context, keeper Keeper, proposal Proposal) (
+	results map[VoteOption]sdk.Dec, totalVotingPower sdk.Dec, voters []VoterTally) {
+
+	results = make(map[VoteOption]sdk.Dec)
 	results[OptionYes] = sdk.ZeroDec()
 	results[OptionAbstain] = sdk.ZeroDec()
 	results[OptionNo] = sdk.ZeroDec()
 	results[OptionNoWithVeto] = sdk.ZeroDec()
 
-	totalVotingPower := sdk.ZeroDec()
+	totalVotingPower = sdk.ZeroDec()
 	currValidators := make(map[string]validatorGovInfo)
 
 	// fetch all the bonded validators, insert them into currValidators
@@ -45,46 +49,78 @@ func tally(ctx sdk.Context, keeper Keeper, proposal Proposal) (passes bool, burn
 			validator.GetBondedTokens(),
 			validator.GetDelegatorShares(),
 			sdk.ZeroDec(),
-			OptionEmpty,
+			nil,
 		)
 
 		return false
 	})
 
-	keeper.IterateVotes(ctx, proposal.ProposalID, func(vote types.Vote) bool {
-		// if validator, just record it in the map
-		// if delegator tally voting power
-		valAddrStr := sdk.ValAddress(vote.Voter).String()
-		if val, ok := currValidators[valAddrStr]; ok {
-			val.Vote = vote.Option
-			currValidators[valAddrStr] = val
-		} else {
-			// iterate over all delegations from voter, deduct from any delegated-to validators
-			keeper.sk.IterateDelegations(ctx, vote.Voter, func(index int64, delegation exported.DelegationI) (stop bool) {
-				valAddrStr := delegation.GetValidatorAddr().String()
+	// tallyDelegations deducts the delegations of an account from any
+	// delegated-to validators and gives their voting power to the options
+	tallyDelegations := func(delegator sdk.AccAddress, options WeightedVoteOptions) sdk.Dec {
+		power := sdk.ZeroDec()
 
-				if val, ok := currValidators[valAddrStr]; ok {
-					val.DelegatorDeductions = val.DelegatorDeductions.Add(delegation.GetShares())
-					currValidators[valAddrStr] = val
+		keeper.sk.IterateDelegations(ctx, delegator, func(index int64, delegation exported.DelegationI) (stop bool) {
+			valAddrStr := delegation.GetValidatorAddr().String()
 
-					delegatorShare := delegation.GetShares().Quo(val.DelegatorShares)
-					votingPower := delegatorShare.MulInt(val.BondedTokens)
+			if val, ok := currValidators[valAddrStr]; ok {
+				val.DelegatorDeductions = val.DelegatorDeductions.Add(delegation.GetShares())
+				currValidators[valAddrStr] = val
 
-					results[vote.Option] = results[vote.Option].Add(votingPower)
-					totalVotingPower = totalVotingPower.Add(votingPower)
+				delegatorShare := delegation.GetShares().Quo(val.DelegatorShares)
+				votingPower := delegatorShare.MulInt(val.BondedTokens)
+
+				for _, option := range options {
+					subPower := votingPower.Mul(option.Weight)
+					results[option.Option] = results[option.Option].Add(subPower)
 				}
+				totalVotingPower = totalVotingPower.Add(votingPower)
+				power = power.Add(votingPower)
+			}
 
-				return false
-			})
-		}
+			return false
+		})
+
+		return power
+	}
 
-		keeper.deleteVote(ctx, vote.ProposalID, vote.Voter)
+	votes := make(map[string]int)
+	keeper.IterateVotes(ctx, proposal.ProposalID, func(vote types.Vote) bool {
+		votes[vote.Voter.String()] = len(voters)
+		voters = append(voters, NewVoterTally(vote.Voter, vote.Options, sdk.ZeroDec(), sdk.ZeroDec()))
 		return false
 	})
 
+	for i, voter := range voters {
+		// if validator, just record it in the map
+		// if delegator tally voting power
+		valAddrStr := sdk.ValAddress(voter.Voter).String()
+		if val, ok := currValidators[valAddrStr]; ok {
+			val.Vote = voter.Options
+			currValidators[valAddrStr] = val
+		} else {
+			voters[i].VotingPower = tallyDelegations(voter.Voter, voter.Options)
+		}
+	}
+
+	// delegators that didn't vote give their voting power to the first
+	// representative that did, instead of inheriting the vote of their
+	// validators. Only the delegators represented by a voter, directly or
+	// through representatives that didn't vote, are walked.
+	for i := range voters {
+		walkRepresented(ctx, keeper, voters[i].Voter, proposal.ProposalType(), votes, func(delegator sdk.AccAddress) {
+			if _, ok := currValidators[sdk.ValAddress(delegator).String()]; ok {
+				return
+			}
+
+			power := tallyDelegations(delegator, voters[i].Options)
+			voters[i].DelegatedPower = voters[i].DelegatedPower.Add(power)
+		})
+	}
+
 	// iterate over the validators again to tally their voting power
 	for _, val := range currValidators {
-		if val.Vote == OptionEmpty {
+		if len(val.Vote) == 0 {
 			continue
 		}
 
@@ -92,11 +128,71 @@ func tally(ctx sdk.Context, keeper Keeper, proposal Proposal) (passes bool, burn
 		fractionAfterDeductions := sharesAfterDeductions.Quo(val.DelegatorShares)
 		votingPower := fractionAfterDeductions.MulInt(val.BondedTokens)
 
-		results[val.Vote] = results[val.Vote].Add(votingPower)
+		for _, option := range val.Vote {
+			subPower := votingPower.Mul(option.Weight)
+			results[option.Option] = results[option.Option].Add(subPower)
+		}
 		totalVotingPower = totalVotingPower.Add(votingPower)
+
+		i := votes[sdk.AccAddress(val.Address).String()]
+		voters[i].VotingPower = votingPower
+	}
+
+	return results, totalVotingPower, voters
+}
+
+// walkRepresented calls cb on every account that didn't vote and whose chain of
+// representatives for the given proposal type first reaches the voter. The
+// accounts are found through the index of the vote delegations by
+// representative, so that only the delegations to the voter and to the
+// accounts it represents are read.
+func walkRepresented(ctx sdk.Context, keeper Keeper, voter sdk.AccAddress,
+	proposalType string, votes map[string]int, cb func(delegator sdk.AccAddress)) {
+
+	visited := map[string]bool{voter.String(): true}
+	queue := []sdk.AccAddress{voter}
+
+	for len(queue) > 0 {
+		representative := queue[0]
+		queue = queue[1:]
+
+		keeper.IterateVoteDelegationsByRepresentative(ctx, representative, func(delegation VoteDelegation) bool {
+			delegatorStr := delegation.Delegator.String()
+			if visited[delegatorStr] {
+				return false
+			}
+
+			// accounts that voted override the vote of their representative
+			if _, ok := votes[delegatorStr]; ok {
+				return false
+			}
+
+			// only follow the delegation that applies to the proposal type, a
+			// delegation for the proposal type taking precedence over a
+			// delegation for all the proposal types
+			if delegation.ProposalType != proposalType {
+				if delegation.ProposalType != "" {
+					return false
+				}
+				if _, found := keeper.GetVoteDelegation(ctx, delegation.Delegator, proposalType); found {
+					return false
+				}
+			}
+
+			visited[delegatorStr] = true
+			queue = append(queue, delegation.Delegator)
+			cb(delegation.Delegator)
+			return false
+		})
 	}
+}
+
+// TODO: Break into several smaller functions for clarity
+func tally(ctx sdk.Context, keeper Keeper, proposal Proposal) (passes bool, burnDeposits bool, tallyResults TallyResult) {
+	results, totalVotingPower, _ := tallyVotes(ctx, keeper, proposal)
 
 	tallyParams := keeper.GetTallyParams(ctx)
+	depositParams := keeper.GetDepositParams(ctx)
 	tallyResults = NewTallyResultFromMap(results)
 
 	// TODO: Upgrade the spec to cover all of these cases & remove pseudocode.
@@ -108,7 +204,7 @@ func tally(ctx sdk.Context, keeper Keeper, proposal Proposal) (passes bool, burn
 	// If there is not enough quorum of votes, the proposal fails
 	percentVoting := totalVotingPower.Quo(keeper.sk.TotalBondedTokens(ctx).ToDec())
 	if percentVoting.LT(tallyParams.Quorum) {
-		return false, true, tallyResults
+		return false, depositParams.BurnVoteQuorum, tallyResults
 	}
 
 	// If no one votes (everyone abstains), proposal fails
@@ -118,11 +214,13 @@ func tally(ctx sdk.Context, keeper Keeper, proposal Proposal) (passes bool, burn
 
 	// If more than 1/3 of voters veto, proposal fails
 	if results[OptionNoWithVeto].Quo(totalVotingPower).GT(tallyParams.Veto) {
-		return false, true, tallyResults
+		return false, depositParams.BurnVoteVeto, tallyResults
 	}
 
-	// If more than 1/2 of non-abstaining voters vote Yes, proposal passes
-	if results[OptionYes].Quo(totalVotingPower.Sub(results[OptionAbstain])).GT(tallyParams.Threshold) {
+	// If more than 1/2 of non-abstaining voters vote Yes, proposal passes. The
+	// threshold of expedited proposals is higher.
+	threshold := tallyParams.GetThreshold(proposal.Expedited)
+	if results[OptionYes].Quo(totalVotingPower.Sub(results[OptionAbstain])).GT(threshold) {
 		return true, false, tallyResults
 	}
 
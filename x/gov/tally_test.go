@@ -13,7 +13,7 @@ import (
 )
 
 func TestTallyNoOneVotes(t *testing.T) {
-	input := getMockApp(t, 10, GenesisState{}, nil)
+	input := getMockApp(t, 10, GenesisState{}, nil, nil)
 
 	header := abci.Header{Height: input.mApp.LastBlockHeight() + 1}
 	input.mApp.BeginBlock(abci.RequestBeginBlock{Header: header})
@@ -30,7 +30,7 @@ func TestTallyNoOneVotes(t *testing.T) {
 	staking.EndBlocker(ctx, input.sk)
 
 	tp := testProposal()
-	proposal, err := input.keeper.SubmitProposal(ctx, tp)
+	proposal, err := input.keeper.SubmitProposal(ctx, tp, input.addrs[0], false)
 	require.NoError(t, err)
 	proposalID := proposal.ProposalID
 	proposal.Status = StatusVotingPeriod
@@ -43,10 +43,19 @@ func TestTallyNoOneVotes(t *testing.T) {
 	require.False(t, passes)
 	require.True(t, burnDeposits)
 	require.True(t, tallyResults.Equals(EmptyTallyResult()))
+
+	// deposits are refunded when burning on missed quorum is disabled
+	depositParams := input.keeper.GetDepositParams(ctx)
+	depositParams.BurnVoteQuorum = false
+	input.keeper.setDepositParams(ctx, depositParams)
+
+	passes, burnDeposits, _ = tally(ctx, input.keeper, proposal)
+	require.False(t, passes)
+	require.False(t, burnDeposits)
 }
 
 func TestTallyNoQuorum(t *testing.T) {
-	input := getMockApp(t, 10, GenesisState{}, nil)
+	input := getMockApp(t, 10, GenesisState{}, nil, nil)
 
 	header := abci.Header{Height: input.mApp.LastBlockHeight() + 1}
 	input.mApp.BeginBlock(abci.RequestBeginBlock{Header: header})
@@ -63,13 +72,13 @@ func TestTallyNoQuorum(t *testing.T) {
 	staking.EndBlocker(ctx, input.sk)
 
 	tp := testProposal()
-	proposal, err := input.keeper.SubmitProposal(ctx, tp)
+	proposal, err := input.keeper.SubmitProposal(ctx, tp, input.addrs[0], false)
 	require.NoError(t, err)
 	proposalID := proposal.ProposalID
 	proposal.Status = StatusVotingPeriod
 	input.keeper.SetProposal(ctx, proposal)
 
-	err = input.keeper.AddVote(ctx, proposalID, input.addrs[0], OptionYes)
+	err = input.keeper.AddVote(ctx, proposalID, input.addrs[0], NewNonSplitVoteOption(OptionYes))
 	require.Nil(t, err)
 
 	proposal, ok := input.keeper.GetProposal(ctx, proposalID)
@@ -80,7 +89,7 @@ func TestTallyNoQuorum(t *testing.T) {
 }
 
 func TestTallyOnlyValidatorsAllYes(t *testing.T) {
-	input := getMockApp(t, 10, GenesisState{}, nil)
+	input := getMockApp(t, 10, GenesisState{}, nil, nil)
 
 	header := abci.Header{Height: input.mApp.LastBlockHeight() + 1}
 	input.mApp.BeginBlock(abci.RequestBeginBlock{Header: header})
@@ -97,15 +106,15 @@ func TestTallyOnlyValidatorsAllYes(t *testing.T) {
 	staking.EndBlocker(ctx, input.sk)
 
 	tp := testProposal()
-	proposal, err := input.keeper.SubmitProposal(ctx, tp)
+	proposal, err := input.keeper.SubmitProposal(ctx, tp, input.addrs[0], false)
 	require.NoError(t, err)
 	proposalID := proposal.ProposalID
 	proposal.Status = StatusVotingPeriod
 	input.keeper.SetProposal(ctx, proposal)
 
-	err = input.keeper.AddVote(ctx, proposalID, input.addrs[0], OptionYes)
+	err = input.keeper.AddVote(ctx, proposalID, input.addrs[0], NewNonSplitVoteOption(OptionYes))
 	require.Nil(t, err)
-	err = input.keeper.AddVote(ctx, proposalID, input.addrs[1], OptionYes)
+	err = input.keeper.AddVote(ctx, proposalID, input.addrs[1], NewNonSplitVoteOption(OptionYes))
 	require.Nil(t, err)
 
 	proposal, ok := input.keeper.GetProposal(ctx, proposalID)
@@ -118,7 +127,7 @@ func TestTallyOnlyValidatorsAllYes(t *testing.T) {
 }
 
 func TestTallyOnlyValidators51No(t *testing.T) {
-	input := getMockApp(t, 10, GenesisState{}, nil)
+	input := getMockApp(t, 10, GenesisState{}, nil, nil)
 
 	header := abci.Header{Height: input.mApp.LastBlockHeight() + 1}
 	input.mApp.BeginBlock(abci.RequestBeginBlock{Header: header})
@@ -135,15 +144,15 @@ func TestTallyOnlyValidators51No(t *testing.T) {
 	staking.EndBlocker(ctx, input.sk)
 
 	tp := testProposal()
-	proposal, err := input.keeper.SubmitProposal(ctx, tp)
+	proposal, err := input.keeper.SubmitProposal(ctx, tp, input.addrs[0], false)
 	require.NoError(t, err)
 	proposalID := proposal.ProposalID
 	proposal.Status = StatusVotingPeriod
 	input.keeper.SetProposal(ctx, proposal)
 
-	err = input.keeper.AddVote(ctx, proposalID, input.addrs[0], OptionYes)
+	err = input.keeper.AddVote(ctx, proposalID, input.addrs[0], NewNonSplitVoteOption(OptionYes))
 	require.Nil(t, err)
-	err = input.keeper.AddVote(ctx, proposalID, input.addrs[1], OptionNo)
+	err = input.keeper.AddVote(ctx, proposalID, input.addrs[1], NewNonSplitVoteOption(OptionNo))
 	require.Nil(t, err)
 
 	proposal, ok := input.keeper.GetProposal(ctx, proposalID)
@@ -155,7 +164,7 @@ func TestTallyOnlyValidators51No(t *testing.T) {
 }
 
 func TestTallyOnlyValidators51Yes(t *testing.T) {
-	input := getMockApp(t, 10, GenesisState{}, nil)
+	input := getMockApp(t, 10, GenesisState{}, nil, nil)
 
 	header := abci.Header{Height: input.mApp.LastBlockHeight() + 1}
 	input.mApp.BeginBlock(abci.RequestBeginBlock{Header: header})
@@ -172,17 +181,17 @@ func TestTallyOnlyValidators51Yes(t *testing.T) {
 	staking.EndBlocker(ctx, input.sk)
 
 	tp := testProposal()
-	proposal, err := input.keeper.SubmitProposal(ctx, tp)
+	proposal, err := input.keeper.SubmitProposal(ctx, tp, input.addrs[0], false)
 	require.NoError(t, err)
 	proposalID := proposal.ProposalID
 	proposal.Status = StatusVotingPeriod
 	input.keeper.SetProposal(ctx, proposal)
 
-	err = input.keeper.AddVote(ctx, proposalID, input.addrs[0], OptionYes)
+	err = input.keeper.AddVote(ctx, proposalID, input.addrs[0], NewNonSplitVoteOption(OptionYes))
 	require.Nil(t, err)
-	err = input.keeper.AddVote(ctx, proposalID, input.addrs[1], OptionYes)
+	err = input.keeper.AddVote(ctx, proposalID, input.addrs[1], NewNonSplitVoteOption(OptionYes))
 	require.Nil(t, err)
-	err = input.keeper.AddVote(ctx, proposalID, input.addrs[2], OptionNo)
+	err = input.keeper.AddVote(ctx, proposalID, input.addrs[2], NewNonSplitVoteOption(OptionNo))
 	require.Nil(t, err)
 
 	proposal, ok := input.keeper.GetProposal(ctx, proposalID)
@@ -195,7 +204,7 @@ func TestTallyOnlyValidators51Yes(t *testing.T) {
 }
 
 func TestTallyOnlyValidatorsVetoed(t *testing.T) {
-	input := getMockApp(t, 10, GenesisState{}, nil)
+	input := getMockApp(t, 10, GenesisState{}, nil, nil)
 
 	header := abci.Header{Height: input.mApp.LastBlockHeight() + 1}
 	input.mApp.BeginBlock(abci.RequestBeginBlock{Header: header})
@@ -212,17 +221,17 @@ func TestTallyOnlyValidatorsVetoed(t *testing.T) {
 	staking.EndBlocker(ctx, input.sk)
 
 	tp := testProposal()
-	proposal, err := input.keeper.SubmitProposal(ctx, tp)
+	proposal, err := input.keeper.SubmitProposal(ctx, tp, input.addrs[0], false)
 	require.NoError(t, err)
 	proposalID := proposal.ProposalID
 	proposal.Status = StatusVotingPeriod
 	input.keeper.SetProposal(ctx, proposal)
 
-	err = input.keeper.AddVote(ctx, proposalID, input.addrs[0], OptionYes)
+	err = input.keeper.AddVote(ctx, proposalID, input.addrs[0], NewNonSplitVoteOption(OptionYes))
 	require.Nil(t, err)
-	err = input.keeper.AddVote(ctx, proposalID, input.addrs[1], OptionYes)
+	err = input.keeper.AddVote(ctx, proposalID, input.addrs[1], NewNonSplitVoteOption(OptionYes))
 	require.Nil(t, err)
-	err = input.keeper.AddVote(ctx, proposalID, input.addrs[2], OptionNoWithVeto)
+	err = input.keeper.AddVote(ctx, proposalID, input.addrs[2], NewNonSplitVoteOption(OptionNoWithVeto))
 	require.Nil(t, err)
 
 	proposal, ok := input.keeper.GetProposal(ctx, proposalID)
@@ -233,10 +242,18 @@ func TestTallyOnlyValidatorsVetoed(t *testing.T) {
 	require.True(t, burnDeposits)
 	require.False(t, tallyResults.Equals(EmptyTallyResult()))
 
+	// deposits are refunded when burning on veto is disabled
+	depositParams := input.keeper.GetDepositParams(ctx)
+	depositParams.BurnVoteVeto = false
+	input.keeper.setDepositParams(ctx, depositParams)
+
+	passes, burnDeposits, _ = tally(ctx, input.keeper, proposal)
+	require.False(t, passes)
+	require.False(t, burnDeposits)
 }
 
 func TestTallyOnlyValidatorsAbstainPasses(t *testing.T) {
-	input := getMockApp(t, 10, GenesisState{}, nil)
+	input := getMockApp(t, 10, GenesisState{}, nil, nil)
 
 	header := abci.Header{Height: input.mApp.LastBlockHeight() + 1}
 	input.mApp.BeginBlock(abci.RequestBeginBlock{Header: header})
@@ -253,17 +270,17 @@ func TestTallyOnlyValidatorsAbstainPasses(t *testing.T) {
 	staking.EndBlocker(ctx, input.sk)
 
 	tp := testProposal()
-	proposal, err := input.keeper.SubmitProposal(ctx, tp)
+	proposal, err := input.keeper.SubmitProposal(ctx, tp, input.addrs[0], false)
 	require.NoError(t, err)
 	proposalID := proposal.ProposalID
 	proposal.Status = StatusVotingPeriod
 	input.keeper.SetProposal(ctx, proposal)
 
-	err = input.keeper.AddVote(ctx, proposalID, input.addrs[0], OptionAbstain)
+	err = input.keeper.AddVote(ctx, proposalID, input.addrs[0], NewNonSplitVoteOption(OptionAbstain))
 	require.Nil(t, err)
-	err = input.keeper.AddVote(ctx, proposalID, input.addrs[1], OptionNo)
+	err = input.keeper.AddVote(ctx, proposalID, input.addrs[1], NewNonSplitVoteOption(OptionNo))
 	require.Nil(t, err)
-	err = input.keeper.AddVote(ctx, proposalID, input.addrs[2], OptionYes)
+	err = input.keeper.AddVote(ctx, proposalID, input.addrs[2], NewNonSplitVoteOption(OptionYes))
 	require.Nil(t, err)
 
 	proposal, ok := input.keeper.GetProposal(ctx, proposalID)
@@ -276,7 +293,7 @@ func TestTallyOnlyValidatorsAbstainPasses(t *testing.T) {
 }
 
 func TestTallyOnlyValidatorsAbstainFails(t *testing.T) {
-	input := getMockApp(t, 10, GenesisState{}, nil)
+	input := getMockApp(t, 10, GenesisState{}, nil, nil)
 
 	header := abci.Header{Height: input.mApp.LastBlockHeight() + 1}
 	input.mApp.BeginBlock(abci.RequestBeginBlock{Header: header})
@@ -293,17 +310,17 @@ func TestTallyOnlyValidatorsAbstainFails(t *testing.T) {
 	staking.EndBlocker(ctx, input.sk)
 
 	tp := testProposal()
-	proposal, err := input.keeper.SubmitProposal(ctx, tp)
+	proposal, err := input.keeper.SubmitProposal(ctx, tp, input.addrs[0], false)
 	require.NoError(t, err)
 	proposalID := proposal.ProposalID
 	proposal.Status = StatusVotingPeriod
 	input.keeper.SetProposal(ctx, proposal)
 
-	err = input.keeper.AddVote(ctx, proposalID, input.addrs[0], OptionAbstain)
+	err = input.keeper.AddVote(ctx, proposalID, input.addrs[0], NewNonSplitVoteOption(OptionAbstain))
 	require.Nil(t, err)
-	err = input.keeper.AddVote(ctx, proposalID, input.addrs[1], OptionYes)
+	err = input.keeper.AddVote(ctx, proposalID, input.addrs[1], NewNonSplitVoteOption(OptionYes))
 	require.Nil(t, err)
-	err = input.keeper.AddVote(ctx, proposalID, input.addrs[2], OptionNo)
+	err = input.keeper.AddVote(ctx, proposalID, input.addrs[2], NewNonSplitVoteOption(OptionNo))
 	require.Nil(t, err)
 
 	proposal, ok := input.keeper.GetProposal(ctx, proposalID)
@@ -316,7 +333,7 @@ func TestTallyOnlyValidatorsAbstainFails(t *testing.T) {
 }
 
 func TestTallyOnlyValidatorsNonVoter(t *testing.T) {
-	input := getMockApp(t, 10, GenesisState{}, nil)
+	input := getMockApp(t, 10, GenesisState{}, nil, nil)
 
 	header := abci.Header{Height: input.mApp.LastBlockHeight() + 1}
 	input.mApp.BeginBlock(abci.RequestBeginBlock{Header: header})
@@ -333,15 +350,15 @@ func TestTallyOnlyValidatorsNonVoter(t *testing.T) {
 	staking.EndBlocker(ctx, input.sk)
 
 	tp := testProposal()
-	proposal, err := input.keeper.SubmitProposal(ctx, tp)
+	proposal, err := input.keeper.SubmitProposal(ctx, tp, input.addrs[0], false)
 	require.NoError(t, err)
 	proposalID := proposal.ProposalID
 	proposal.Status = StatusVotingPeriod
 	input.keeper.SetProposal(ctx, proposal)
 
-	err = input.keeper.AddVote(ctx, proposalID, input.addrs[1], OptionYes)
+	err = input.keeper.AddVote(ctx, proposalID, input.addrs[1], NewNonSplitVoteOption(OptionYes))
 	require.Nil(t, err)
-	err = input.keeper.AddVote(ctx, proposalID, input.addrs[2], OptionNo)
+	err = input.keeper.AddVote(ctx, proposalID, input.addrs[2], NewNonSplitVoteOption(OptionNo))
 	require.Nil(t, err)
 
 	proposal, ok := input.keeper.GetProposal(ctx, proposalID)
@@ -354,7 +371,7 @@ func TestTallyOnlyValidatorsNonVoter(t *testing.T) {
 }
 
 func TestTallyDelgatorOverride(t *testing.T) {
-	input := getMockApp(t, 10, GenesisState{}, nil)
+	input := getMockApp(t, 10, GenesisState{}, nil, nil)
 
 	header := abci.Header{Height: input.mApp.LastBlockHeight() + 1}
 	input.mApp.BeginBlock(abci.RequestBeginBlock{Header: header})
@@ -375,19 +392,19 @@ func TestTallyDelgatorOverride(t *testing.T) {
 	stakingHandler(ctx, delegator1Msg)
 
 	tp := testProposal()
-	proposal, err := input.keeper.SubmitProposal(ctx, tp)
+	proposal, err := input.keeper.SubmitProposal(ctx, tp, input.addrs[0], false)
 	require.NoError(t, err)
 	proposalID := proposal.ProposalID
 	proposal.Status = StatusVotingPeriod
 	input.keeper.SetProposal(ctx, proposal)
 
-	err = input.keeper.AddVote(ctx, proposalID, input.addrs[0], OptionYes)
+	err = input.keeper.AddVote(ctx, proposalID, input.addrs[0], NewNonSplitVoteOption(OptionYes))
 	require.Nil(t, err)
-	err = input.keeper.AddVote(ctx, proposalID, input.addrs[1], OptionYes)
+	err = input.keeper.AddVote(ctx, proposalID, input.addrs[1], NewNonSplitVoteOption(OptionYes))
 	require.Nil(t, err)
-	err = input.keeper.AddVote(ctx, proposalID, input.addrs[2], OptionYes)
+	err = input.keeper.AddVote(ctx, proposalID, input.addrs[2], NewNonSplitVoteOption(OptionYes))
 	require.Nil(t, err)
-	err = input.keeper.AddVote(ctx, proposalID, input.addrs[3], OptionNo)
+	err = input.keeper.AddVote(ctx, proposalID, input.addrs[3], NewNonSplitVoteOption(OptionNo))
 	require.Nil(t, err)
 
 	proposal, ok := input.keeper.GetProposal(ctx, proposalID)
@@ -400,7 +417,7 @@ func TestTallyDelgatorOverride(t *testing.T) {
 }
 
 func TestTallyDelgatorInherit(t *testing.T) {
-	input := getMockApp(t, 10, GenesisState{}, nil)
+	input := getMockApp(t, 10, GenesisState{}, nil, nil)
 
 	header := abci.Header{Height: input.mApp.LastBlockHeight() + 1}
 	input.mApp.BeginBlock(abci.RequestBeginBlock{Header: header})
@@ -421,17 +438,17 @@ func TestTallyDelgatorInherit(t *testing.T) {
 	stakingHandler(ctx, delegator1Msg)
 
 	tp := testProposal()
-	proposal, err := input.keeper.SubmitProposal(ctx, tp)
+	proposal, err := input.keeper.SubmitProposal(ctx, tp, input.addrs[0], false)
 	require.NoError(t, err)
 	proposalID := proposal.ProposalID
 	proposal.Status = StatusVotingPeriod
 	input.keeper.SetProposal(ctx, proposal)
 
-	err = input.keeper.AddVote(ctx, proposalID, input.addrs[0], OptionNo)
+	err = input.keeper.AddVote(ctx, proposalID, input.addrs[0], NewNonSplitVoteOption(OptionNo))
 	require.Nil(t, err)
-	err = input.keeper.AddVote(ctx, proposalID, input.addrs[1], OptionNo)
+	err = input.keeper.AddVote(ctx, proposalID, input.addrs[1], NewNonSplitVoteOption(OptionNo))
 	require.Nil(t, err)
-	err = input.keeper.AddVote(ctx, proposalID, input.addrs[2], OptionYes)
+	err = input.keeper.AddVote(ctx, proposalID, input.addrs[2], NewNonSplitVoteOption(OptionYes))
 	require.Nil(t, err)
 
 	proposal, ok := input.keeper.GetProposal(ctx, proposalID)
@@ -444,7 +461,7 @@ func TestTallyDelgatorInherit(t *testing.T) {
 }
 
 func TestTallyDelgatorMultipleOverride(t *testing.T) {
-	input := getMockApp(t, 10, GenesisState{}, nil)
+	input := getMockApp(t, 10, GenesisState{}, nil, nil)
 
 	header := abci.Header{Height: input.mApp.LastBlockHeight() + 1}
 	input.mApp.BeginBlock(abci.RequestBeginBlock{Header: header})
@@ -467,19 +484,19 @@ func TestTallyDelgatorMultipleOverride(t *testing.T) {
 	stakingHandler(ctx, delegator1Msg2)
 
 	tp := testProposal()
-	proposal, err := input.keeper.SubmitProposal(ctx, tp)
+	proposal, err := input.keeper.SubmitProposal(ctx, tp, input.addrs[0], false)
 	require.NoError(t, err)
 	proposalID := proposal.ProposalID
 	proposal.Status = StatusVotingPeriod
 	input.keeper.SetProposal(ctx, proposal)
 
-	err = input.keeper.AddVote(ctx, proposalID, input.addrs[0], OptionYes)
+	err = input.keeper.AddVote(ctx, proposalID, input.addrs[0], NewNonSplitVoteOption(OptionYes))
 	require.Nil(t, err)
-	err = input.keeper.AddVote(ctx, proposalID, input.addrs[1], OptionYes)
+	err = input.keeper.AddVote(ctx, proposalID, input.addrs[1], NewNonSplitVoteOption(OptionYes))
 	require.Nil(t, err)
-	err = input.keeper.AddVote(ctx, proposalID, input.addrs[2], OptionYes)
+	err = input.keeper.AddVote(ctx, proposalID, input.addrs[2], NewNonSplitVoteOption(OptionYes))
 	require.Nil(t, err)
-	err = input.keeper.AddVote(ctx, proposalID, input.addrs[3], OptionNo)
+	err = input.keeper.AddVote(ctx, proposalID, input.addrs[3], NewNonSplitVoteOption(OptionNo))
 	require.Nil(t, err)
 
 	proposal, ok := input.keeper.GetProposal(ctx, proposalID)
@@ -492,7 +509,7 @@ func TestTallyDelgatorMultipleOverride(t *testing.T) {
 }
 
 func TestTallyDelgatorMultipleInherit(t *testing.T) {
-	input := getMockApp(t, 10, GenesisState{}, nil)
+	input := getMockApp(t, 10, GenesisState{}, nil, nil)
 
 	header := abci.Header{Height: input.mApp.LastBlockHeight() + 1}
 	input.mApp.BeginBlock(abci.RequestBeginBlock{Header: header})
@@ -528,17 +545,17 @@ func TestTallyDelgatorMultipleInherit(t *testing.T) {
 	staking.EndBlocker(ctx, input.sk)
 
 	tp := testProposal()
-	proposal, err := input.keeper.SubmitProposal(ctx, tp)
+	proposal, err := input.keeper.SubmitProposal(ctx, tp, input.addrs[0], false)
 	require.NoError(t, err)
 	proposalID := proposal.ProposalID
 	proposal.Status = StatusVotingPeriod
 	input.keeper.SetProposal(ctx, proposal)
 
-	err = input.keeper.AddVote(ctx, proposalID, input.addrs[0], OptionYes)
+	err = input.keeper.AddVote(ctx, proposalID, input.addrs[0], NewNonSplitVoteOption(OptionYes))
 	require.Nil(t, err)
-	err = input.keeper.AddVote(ctx, proposalID, input.addrs[1], OptionNo)
+	err = input.keeper.AddVote(ctx, proposalID, input.addrs[1], NewNonSplitVoteOption(OptionNo))
 	require.Nil(t, err)
-	err = input.keeper.AddVote(ctx, proposalID, input.addrs[2], OptionNo)
+	err = input.keeper.AddVote(ctx, proposalID, input.addrs[2], NewNonSplitVoteOption(OptionNo))
 	require.Nil(t, err)
 
 	proposal, ok := input.keeper.GetProposal(ctx, proposalID)
@@ -551,7 +568,7 @@ func TestTallyDelgatorMultipleInherit(t *testing.T) {
 }
 
 func TestTallyJailedValidator(t *testing.T) {
-	input := getMockApp(t, 10, GenesisState{}, nil)
+	input := getMockApp(t, 10, GenesisState{}, nil, nil)
 
 	header := abci.Header{Height: input.mApp.LastBlockHeight() + 1}
 	input.mApp.BeginBlock(abci.RequestBeginBlock{Header: header})
@@ -581,17 +598,17 @@ func TestTallyJailedValidator(t *testing.T) {
 	staking.EndBlocker(ctx, input.sk)
 
 	tp := testProposal()
-	proposal, err := input.keeper.SubmitProposal(ctx, tp)
+	proposal, err := input.keeper.SubmitProposal(ctx, tp, input.addrs[0], false)
 	require.NoError(t, err)
 	proposalID := proposal.ProposalID
 	proposal.Status = StatusVotingPeriod
 	input.keeper.SetProposal(ctx, proposal)
 
-	err = input.keeper.AddVote(ctx, proposalID, input.addrs[0], OptionYes)
+	err = input.keeper.AddVote(ctx, proposalID, input.addrs[0], NewNonSplitVoteOption(OptionYes))
 	require.Nil(t, err)
-	err = input.keeper.AddVote(ctx, proposalID, input.addrs[1], OptionNo)
+	err = input.keeper.AddVote(ctx, proposalID, input.addrs[1], NewNonSplitVoteOption(OptionNo))
 	require.Nil(t, err)
-	err = input.keeper.AddVote(ctx, proposalID, input.addrs[2], OptionNo)
+	err = input.keeper.AddVote(ctx, proposalID, input.addrs[2], NewNonSplitVoteOption(OptionNo))
 	require.Nil(t, err)
 
 	proposal, ok := input.keeper.GetProposal(ctx, proposalID)
@@ -602,3 +619,234 @@ func TestTallyJailedValidator(t *testing.T) {
 	require.False(t, burnDeposits)
 	require.False(t, tallyResults.Equals(EmptyTallyResult()))
 }
+
+func TestTallyWeightedVotes(t *testing.T) {
+	input := getMockApp(t, 10, GenesisState{}, nil, nil)
+
+	header := abci.Header{Height: input.mApp.LastBlockHeight() + 1}
+	input.mApp.BeginBlock(abci.RequestBeginBlock{Header: header})
+
+	ctx := input.mApp.BaseApp.NewContext(false, abci.Header{})
+	stakingHandler := staking.NewHandler(input.sk)
+
+	valAddrs := make([]sdk.ValAddress, len(input.addrs[:2]))
+	for i, addr := range input.addrs[:2] {
+		valAddrs[i] = sdk.ValAddress(addr)
+	}
+
+	createValidators(t, stakingHandler, ctx, valAddrs, []int64{5, 5})
+
+	delTokens := sdk.TokensFromTendermintPower(5)
+	delegatorMsg := staking.NewMsgDelegate(input.addrs[2], valAddrs[1], sdk.NewCoin(sdk.DefaultBondDenom, delTokens))
+	stakingHandler(ctx, delegatorMsg)
+
+	staking.EndBlocker(ctx, input.sk)
+
+	tp := testProposal()
+	proposal, err := input.keeper.SubmitProposal(ctx, tp, input.addrs[0], false)
+	require.NoError(t, err)
+	proposalID := proposal.ProposalID
+	proposal.Status = StatusVotingPeriod
+	input.keeper.SetProposal(ctx, proposal)
+
+	err = input.keeper.AddVote(ctx, proposalID, input.addrs[0], WeightedVoteOptions{
+		NewWeightedVoteOption(OptionYes, sdk.NewDecWithPrec(5, 1)),
+		NewWeightedVoteOption(OptionNo, sdk.NewDecWithPrec(5, 1)),
+	})
+	require.Nil(t, err)
+	err = input.keeper.AddVote(ctx, proposalID, input.addrs[1], WeightedVoteOptions{
+		NewWeightedVoteOption(OptionAbstain, sdk.NewDecWithPrec(5, 1)),
+		NewWeightedVoteOption(OptionNo, sdk.NewDecWithPrec(5, 1)),
+	})
+	require.Nil(t, err)
+	err = input.keeper.AddVote(ctx, proposalID, input.addrs[2], WeightedVoteOptions{
+		NewWeightedVoteOption(OptionYes, sdk.NewDecWithPrec(6, 1)),
+		NewWeightedVoteOption(OptionNoWithVeto, sdk.NewDecWithPrec(4, 1)),
+	})
+	require.Nil(t, err)
+
+	proposal, ok := input.keeper.GetProposal(ctx, proposalID)
+	require.True(t, ok)
+	passes, burnDeposits, tallyResults := tally(ctx, input.keeper, proposal)
+
+	// the delegator's voting power isn't inherited by the second validator
+	expected := NewTallyResult(
+		sdk.TokensFromTendermintPower(55).QuoRaw(10),
+		sdk.TokensFromTendermintPower(25).QuoRaw(10),
+		sdk.TokensFromTendermintPower(50).QuoRaw(10),
+		sdk.TokensFromTendermintPower(20).QuoRaw(10),
+	)
+	require.True(t, tallyResults.Equals(expected), "%v", tallyResults)
+	require.False(t, passes)
+	require.False(t, burnDeposits)
+}
+
+func TestTallyRepresentatives(t *testing.T) {
+	input := getMockApp(t, 10, GenesisState{}, nil, nil)
+
+	header := abci.Header{Height: input.mApp.LastBlockHeight() + 1}
+	input.mApp.BeginBlock(abci.RequestBeginBlock{Header: header})
+
+	ctx := input.mApp.BaseApp.NewContext(false, abci.Header{})
+	stakingHandler := staking.NewHandler(input.sk)
+
+	valAddrs := make([]sdk.ValAddress, len(input.addrs[:3]))
+	for i, addr := range input.addrs[:3] {
+		valAddrs[i] = sdk.ValAddress(addr)
+	}
+
+	createValidators(t, stakingHandler, ctx, valAddrs, []int64{5, 6, 7})
+	staking.EndBlocker(ctx, input.sk)
+
+	delTokens := sdk.TokensFromTendermintPower(30)
+	delegator1Msg := staking.NewMsgDelegate(input.addrs[3], sdk.ValAddress(input.addrs[2]), sdk.NewCoin(sdk.DefaultBondDenom, delTokens))
+	stakingHandler(ctx, delegator1Msg)
+
+	tp := testProposal()
+	proposal, err := input.keeper.SubmitProposal(ctx, tp, input.addrs[0], false)
+	require.NoError(t, err)
+	proposalID := proposal.ProposalID
+	proposal.Status = StatusVotingPeriod
+	input.keeper.SetProposal(ctx, proposal)
+
+	// the representative has no stake of its own
+	require.Nil(t, input.keeper.AddVote(ctx, proposalID, input.addrs[0], NewNonSplitVoteOption(OptionNo)))
+	require.Nil(t, input.keeper.AddVote(ctx, proposalID, input.addrs[1], NewNonSplitVoteOption(OptionNo)))
+	require.Nil(t, input.keeper.AddVote(ctx, proposalID, input.addrs[2], NewNonSplitVoteOption(OptionYes)))
+	require.Nil(t, input.keeper.AddVote(ctx, proposalID, input.addrs[4], NewNonSplitVoteOption(OptionNo)))
+
+	// the voting power of the delegator goes either to the validator voting yes
+	// or to the representative voting no
+	inheritedNo := sdk.TokensFromTendermintPower(11)
+	representedNo := sdk.TokensFromTendermintPower(41)
+
+	testCases := []struct {
+		name        string
+		delegations VoteDelegations
+		passes      bool
+		no          sdk.Int
+	}{
+		{"no representative", nil, true, inheritedNo},
+		{
+			"representative for all proposal types",
+			VoteDelegations{NewVoteDelegation(input.addrs[3], input.addrs[4], "")},
+			false, representedNo,
+		},
+		{
+			"representative for text proposals",
+			VoteDelegations{NewVoteDelegation(input.addrs[3], input.addrs[4], ProposalTypeText)},
+			false, representedNo,
+		},
+		{
+			"representative for other proposal types",
+			VoteDelegations{NewVoteDelegation(input.addrs[3], input.addrs[4], ProposalTypeMessages)},
+			true, inheritedNo,
+		},
+		{
+			"representative for text proposals takes precedence",
+			VoteDelegations{
+				NewVoteDelegation(input.addrs[3], input.addrs[4], ""),
+				NewVoteDelegation(input.addrs[3], input.addrs[5], ProposalTypeText),
+			},
+			true, inheritedNo,
+		},
+		{
+			"replaced representative",
+			VoteDelegations{
+				NewVoteDelegation(input.addrs[3], input.addrs[4], ""),
+				NewVoteDelegation(input.addrs[3], input.addrs[5], ""),
+			},
+			true, inheritedNo,
+		},
+		{
+			"chain of representatives",
+			VoteDelegations{
+				NewVoteDelegation(input.addrs[3], input.addrs[5], ""),
+				NewVoteDelegation(input.addrs[5], input.addrs[6], ""),
+				NewVoteDelegation(input.addrs[6], input.addrs[4], ""),
+			},
+			false, representedNo,
+		},
+		{
+			"cycle of representatives",
+			VoteDelegations{
+				NewVoteDelegation(input.addrs[3], input.addrs[5], ""),
+				NewVoteDelegation(input.addrs[5], input.addrs[6], ""),
+				NewVoteDelegation(input.addrs[6], input.addrs[3], ""),
+			},
+			true, inheritedNo,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctx, _ := ctx.CacheContext()
+			for _, delegation := range tc.delegations {
+				input.keeper.setVoteDelegation(ctx, delegation)
+			}
+
+			passes, _, tallyResults := tally(ctx, input.keeper, proposal)
+			require.Equal(t, tc.passes, passes)
+			require.Equal(t, tc.no, tallyResults.No)
+		})
+	}
+
+	// the vote of the delegator overrides the vote of its representative
+	require.NoError(t, input.keeper.SetRepresentative(ctx, input.addrs[3], input.addrs[4], ""))
+	require.Nil(t, input.keeper.AddVote(ctx, proposalID, input.addrs[3], NewNonSplitVoteOption(OptionYes)))
+
+	passes, _, tallyResults := tally(ctx, input.keeper, proposal)
+	require.True(t, passes)
+	require.Equal(t, inheritedNo, tallyResults.No)
+}
+
+func TestTallyBreakdown(t *testing.T) {
+	input := getMockApp(t, 10, GenesisState{}, nil, nil)
+
+	header := abci.Header{Height: input.mApp.LastBlockHeight() + 1}
+	input.mApp.BeginBlock(abci.RequestBeginBlock{Header: header})
+
+	ctx := input.mApp.BaseApp.NewContext(false, abci.Header{})
+	stakingHandler := staking.NewHandler(input.sk)
+
+	valAddrs := []sdk.ValAddress{sdk.ValAddress(input.addrs[0]), sdk.ValAddress(input.addrs[1])}
+	createValidators(t, stakingHandler, ctx, valAddrs, []int64{5, 6})
+	staking.EndBlocker(ctx, input.sk)
+
+	for i, addr := range input.addrs[2:4] {
+		delTokens := sdk.TokensFromTendermintPower(int64(10 * (i + 1)))
+		delegatorMsg := staking.NewMsgDelegate(addr, valAddrs[0], sdk.NewCoin(sdk.DefaultBondDenom, delTokens))
+		require.True(t, stakingHandler(ctx, delegatorMsg).IsOK())
+	}
+
+	tp := testProposal()
+	proposal, err := input.keeper.SubmitProposal(ctx, tp, input.addrs[0], false)
+	require.NoError(t, err)
+	proposal.Status = StatusVotingPeriod
+	input.keeper.SetProposal(ctx, proposal)
+
+	// addrs[3] follows addrs[2], which votes itself, while the validator votes
+	// with the remaining voting power of its delegators
+	require.NoError(t, input.keeper.SetRepresentative(ctx, input.addrs[3], input.addrs[2], ""))
+	require.Nil(t, input.keeper.AddVote(ctx, proposal.ProposalID, input.addrs[0], NewNonSplitVoteOption(OptionYes)))
+	require.Nil(t, input.keeper.AddVote(ctx, proposal.ProposalID, input.addrs[2], NewNonSplitVoteOption(OptionNo)))
+
+	results, totalVotingPower, voters := tallyVotes(ctx, input.keeper, proposal)
+	require.Equal(t, sdk.TokensFromTendermintPower(35), totalVotingPower.RoundInt())
+	require.Equal(t, sdk.TokensFromTendermintPower(5), results[OptionYes].RoundInt())
+	require.Equal(t, sdk.TokensFromTendermintPower(30), results[OptionNo].RoundInt())
+
+	require.Len(t, voters, 2)
+	for _, voter := range voters {
+		switch {
+		case voter.Voter.Equals(input.addrs[0]):
+			require.Equal(t, sdk.TokensFromTendermintPower(5), voter.VotingPower.RoundInt())
+			require.True(t, voter.DelegatedPower.IsZero())
+		case voter.Voter.Equals(input.addrs[2]):
+			require.Equal(t, sdk.TokensFromTendermintPower(10), voter.VotingPower.RoundInt())
+			require.Equal(t, sdk.TokensFromTendermintPower(20), voter.DelegatedPower.RoundInt())
+		default:
+			t.Fatalf("unexpected voter %s", voter.Voter)
+		}
+	}
+}
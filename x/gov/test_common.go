@@ -19,44 +19,88 @@ import (
 	"github.com/cosmos/cosmos-sdk/x/gov/types"
 	"github.com/cosmos/cosmos-sdk/x/mock"
 	"github.com/cosmos/cosmos-sdk/x/staking"
+	"github.com/cosmos/cosmos-sdk/x/supply"
 )
 
+// name of the module account holding the community pool of testDistrKeeper
+const testDistrModuleName = "distribution"
+
+// testDistrKeeper is a minimal DistributionKeeper that keeps the community
+// pool in its module account
+type testDistrKeeper struct {
+	supplyKeeper supply.Keeper
+}
+
+func (dk testDistrKeeper) FundCommunityPool(ctx sdk.Context, amount sdk.Coins, sender sdk.AccAddress) sdk.Error {
+	return dk.supplyKeeper.SendCoinsFromAccountToModule(ctx, sender, testDistrModuleName, amount)
+}
+
 type testInput struct {
-	mApp     *mock.App
-	keeper   Keeper
-	router   Router
-	sk       staking.Keeper
-	addrs    []sdk.AccAddress
-	pubKeys  []crypto.PubKey
-	privKeys []crypto.PrivKey
+	mApp         *mock.App
+	keeper       Keeper
+	router       Router
+	sk           staking.Keeper
+	supplyKeeper supply.Keeper
+	addrs        []sdk.AccAddress
+	pubKeys      []crypto.PubKey
+	privKeys     []crypto.PrivKey
 }
 
-func getMockApp(t *testing.T, numGenAccs int, genState GenesisState, genAccs []auth.Account) testInput {
+func getMockApp(t *testing.T, numGenAccs int, genState GenesisState, genAccs []auth.Account,
+	genBalances []mock.Balance) testInput {
 	mApp := mock.NewApp()
 
 	staking.RegisterCodec(mApp.Cdc)
+	bank.RegisterCodec(mApp.Cdc)
 	RegisterCodec(mApp.Cdc)
 
 	keyStaking := sdk.NewKVStoreKey(staking.StoreKey)
 	tKeyStaking := sdk.NewTransientStoreKey(staking.TStoreKey)
 	keyGov := sdk.NewKVStoreKey(StoreKey)
+	keySupply := sdk.NewKVStoreKey(supply.StoreKey)
+
+	govAcc := auth.NewEmptyModuleAccount(types.ModuleName, auth.Burner)
+	treasuryAcc := auth.NewEmptyModuleAccount(types.TreasuryName)
+	notBondedPool := auth.NewEmptyModuleAccount(staking.NotBondedPoolName, auth.Burner, auth.Staking)
+	bondPool := auth.NewEmptyModuleAccount(staking.BondedPoolName, auth.Burner, auth.Staking)
+	distrAcc := auth.NewEmptyModuleAccount(testDistrModuleName)
+
+	blacklistedAddrs := make(map[string]bool)
+	blacklistedAddrs[govAcc.GetAddress().String()] = true
+	blacklistedAddrs[notBondedPool.GetAddress().String()] = true
+	blacklistedAddrs[bondPool.GetAddress().String()] = true
 
 	pk := mApp.ParamsKeeper
 
 	rtr := NewRouter().
-		AddRoute(RouterKey, ProposalHandler)
+		AddRoute(RouterKey, NewProposalHandler(mApp.Router()))
+
+	bk := bank.NewBaseKeeper(mApp.Cdc, mApp.KeyBank, mApp.AccountKeeper, mApp.ParamsKeeper.Subspace(bank.DefaultParamspace), bank.DefaultCodespace, blacklistedAddrs)
+	mApp.BankKeeper = bk
+	mApp.Router().AddRoute(bank.RouterKey, bank.NewHandler(bk))
+
+	maccPerms := map[string][]string{
+		types.ModuleName:          {auth.Burner},
+		types.TreasuryName:        nil,
+		staking.NotBondedPoolName: {auth.Burner, auth.Staking},
+		staking.BondedPoolName:    {auth.Burner, auth.Staking},
+		testDistrModuleName:       nil,
+	}
+	supplyKeeper := supply.NewKeeper(mApp.Cdc, keySupply, mApp.AccountKeeper, bk, maccPerms)
+	sk := staking.NewKeeper(mApp.Cdc, keyStaking, tKeyStaking, mApp.AccountKeeper, supplyKeeper, pk.Subspace(staking.DefaultParamspace), staking.DefaultCodespace)
+
+	dk := testDistrKeeper{supplyKeeper}
 
-	ck := bank.NewBaseKeeper(mApp.AccountKeeper, mApp.ParamsKeeper.Subspace(bank.DefaultParamspace), bank.DefaultCodespace)
-	sk := staking.NewKeeper(mApp.Cdc, keyStaking, tKeyStaking, ck, pk.Subspace(staking.DefaultParamspace), staking.DefaultCodespace)
-	keeper := NewKeeper(mApp.Cdc, keyGov, pk, pk.Subspace("testgov"), ck, sk, DefaultCodespace, rtr)
+	keeper := NewKeeper(mApp.Cdc, keyGov, pk, pk.Subspace("testgov"), supplyKeeper, dk, sk, DefaultCodespace, rtr)
 
 	mApp.Router().AddRoute(RouterKey, NewHandler(keeper))
 	mApp.QueryRouter().AddRoute(QuerierRoute, NewQuerier(keeper))
 
 	mApp.SetEndBlocker(getEndBlocker(keeper))
-	mApp.SetInitChainer(getInitChainer(mApp, keeper, sk, mApp.AccountKeeper, genState))
+	mApp.SetInitChainer(getInitChainer(mApp, keeper, mApp.AccountKeeper, bk, sk, supplyKeeper, genState,
+		[]auth.ModuleAccountI{govAcc, treasuryAcc, notBondedPool, bondPool, distrAcc}))
 
-	require.NoError(t, mApp.CompleteSetup(keyStaking, tKeyStaking, keyGov))
+	require.NoError(t, mApp.CompleteSetup(keyStaking, tKeyStaking, keyGov, keySupply))
 
 	valTokens := sdk.TokensFromTendermintPower(42)
 
@@ -67,42 +111,44 @@ func getMockApp(t *testing.T, numGenAccs int, genState GenesisState, genAccs []a
 	)
 
 	if genAccs == nil || len(genAccs) == 0 {
-		genAccs, addrs, pubKeys, privKeys = mock.CreateGenAccounts(numGenAccs,
+		genAccs, genBalances, addrs, pubKeys, privKeys = mock.CreateGenAccounts(numGenAccs,
 			sdk.Coins{sdk.NewCoin(sdk.DefaultBondDenom, valTokens)})
 	}
 
-	mock.SetGenesis(mApp, genAccs)
+	mock.SetGenesis(mApp, genAccs, genBalances)
 
-	return testInput{mApp, keeper, rtr, sk, addrs, pubKeys, privKeys}
+	return testInput{mApp, keeper, rtr, sk, supplyKeeper, addrs, pubKeys, privKeys}
 }
 
 // gov and staking endblocker
 func getEndBlocker(keeper Keeper) sdk.EndBlocker {
 	return func(ctx sdk.Context, req abci.RequestEndBlock) abci.ResponseEndBlock {
-		tags := EndBlocker(ctx, keeper)
-		return abci.ResponseEndBlock{
-			Tags: tags,
-		}
+		EndBlocker(ctx, keeper)
+		return abci.ResponseEndBlock{}
 	}
 }
 
 // gov and staking initchainer
-func getInitChainer(mapp *mock.App, keeper Keeper, stakingKeeper staking.Keeper,
-	accountKeeper staking.AccountKeeper, genState GenesisState) sdk.InitChainer {
+func getInitChainer(mapp *mock.App, keeper Keeper, accountKeeper staking.AccountKeeper, bankKeeper bank.Keeper,
+	stakingKeeper staking.Keeper, supplyKeeper supply.Keeper, genState GenesisState, moduleAccounts []auth.ModuleAccountI) sdk.InitChainer {
 
 	return func(ctx sdk.Context, req abci.RequestInitChain) abci.ResponseInitChain {
 		mapp.InitChainer(ctx, req)
 
-		stakingGenesis := staking.DefaultGenesisState()
-		tokens := sdk.TokensFromTendermintPower(100000)
-		stakingGenesis.Pool.NotBondedTokens = tokens
+		// set module accounts
+		for _, macc := range moduleAccounts {
+			supplyKeeper.SetModuleAccount(ctx, macc)
+		}
 
-		validators := staking.InitGenesis(ctx, stakingKeeper, accountKeeper, stakingGenesis)
+		stakingGenesis := staking.DefaultGenesisState()
+		validators := staking.InitGenesis(ctx, stakingKeeper, accountKeeper, supplyKeeper, stakingGenesis)
 		if genState.IsEmpty() {
-			InitGenesis(ctx, keeper, DefaultGenesisState())
+			InitGenesis(ctx, keeper, supplyKeeper, DefaultGenesisState())
 		} else {
-			InitGenesis(ctx, keeper, genState)
+			InitGenesis(ctx, keeper, supplyKeeper, genState)
 		}
+		bank.InitGenesis(ctx, bankKeeper, bank.DefaultGenesisState())
+		supply.InitGenesis(ctx, supplyKeeper, bankKeeper, supply.DefaultGenesisState())
 		return abci.ResponseInitChain{
 			Validators: validators,
 		}
@@ -156,14 +202,19 @@ func testProposal() Content {
 	return NewTextProposal("Test", "description")
 }
 
-const contextKeyBadProposal = "contextKeyBadProposal"
+const (
+	contextKeyBadProposal = "contextKeyBadProposal"
+	eventTypeBadProposal  = "bad_proposal"
+)
 
 // badProposalHandler implements a governance proposal handler that is identical
 // to the actual handler except this fails if the context doesn't contain a value
-// for the key contextKeyBadProposal or if the value is false.
+// for the key contextKeyBadProposal or if the value is false. It emits an event
+// of type eventTypeBadProposal in either case.
 func badProposalHandler(ctx sdk.Context, c Content) sdk.Error {
 	switch c.ProposalType() {
-	case ProposalTypeText, ProposalTypeSoftwareUpgrade:
+	case ProposalTypeText:
+		ctx.EventManager().EmitEvent(sdk.NewEvent(eventTypeBadProposal))
 		v := ctx.Value(contextKeyBadProposal)
 
 		if v == nil || !v.(bool) {
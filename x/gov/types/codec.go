@@ -15,9 +15,13 @@ func RegisterCodec(cdc *codec.Codec) {
 	cdc.RegisterConcrete(MsgSubmitProposal{}, "cosmos-sdk/MsgSubmitProposal", nil)
 	cdc.RegisterConcrete(MsgDeposit{}, "cosmos-sdk/MsgDeposit", nil)
 	cdc.RegisterConcrete(MsgVote{}, "cosmos-sdk/MsgVote", nil)
+	cdc.RegisterConcrete(MsgVoteWeighted{}, "cosmos-sdk/MsgVoteWeighted", nil)
+	cdc.RegisterConcrete(MsgCancelProposal{}, "cosmos-sdk/MsgCancelProposal", nil)
+	cdc.RegisterConcrete(MsgSetRepresentative{}, "cosmos-sdk/MsgSetRepresentative", nil)
+	cdc.RegisterConcrete(MsgClearRepresentative{}, "cosmos-sdk/MsgClearRepresentative", nil)
 
 	cdc.RegisterConcrete(TextProposal{}, "cosmos-sdk/TextProposal", nil)
-	cdc.RegisterConcrete(SoftwareUpgradeProposal{}, "cosmos-sdk/SoftwareUpgradeProposal", nil)
+	cdc.RegisterConcrete(MessagesProposal{}, "cosmos-sdk/MessagesProposal", nil)
 }
 
 // RegisterProposalTypeCodec registers an external proposal content type defined
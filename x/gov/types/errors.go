@@ -21,6 +21,10 @@ const (
 	CodeInvalidGenesis           sdk.CodeType = 9
 	CodeInvalidProposalStatus    sdk.CodeType = 10
 	CodeProposalHandlerNotExists sdk.CodeType = 11
+	CodeProposalMsgFailed        sdk.CodeType = 12
+	CodeInvalidProposer          sdk.CodeType = 13
+	CodeInvalidRepresentative    sdk.CodeType = 14
+	CodeUnknownVoteDelegation    sdk.CodeType = 15
 )
 
 func ErrUnknownProposal(codespace sdk.CodespaceType, proposalID uint64) sdk.Error {
@@ -55,6 +59,10 @@ func ErrInvalidVote(codespace sdk.CodespaceType, voteOption VoteOption) sdk.Erro
 	return sdk.NewError(codespace, CodeInvalidVote, fmt.Sprintf("'%v' is not a valid voting option", voteOption.String()))
 }
 
+func ErrInvalidWeightedVote(codespace sdk.CodespaceType, options WeightedVoteOptions) sdk.Error {
+	return sdk.NewError(codespace, CodeInvalidVote, fmt.Sprintf("'%s' are not valid weighted voting options", options))
+}
+
 func ErrInvalidGenesis(codespace sdk.CodespaceType, msg string) sdk.Error {
 	return sdk.NewError(codespace, CodeInvalidVote, msg)
 }
@@ -62,3 +70,19 @@ func ErrInvalidGenesis(codespace sdk.CodespaceType, msg string) sdk.Error {
 func ErrNoProposalHandlerExists(codespace sdk.CodespaceType, content interface{}) sdk.Error {
 	return sdk.NewError(codespace, CodeProposalHandlerNotExists, fmt.Sprintf("'%T' does not have a corresponding handler", content))
 }
+
+func ErrInvalidProposer(codespace sdk.CodespaceType, proposalID uint64, proposer sdk.AccAddress) sdk.Error {
+	return sdk.NewError(codespace, CodeInvalidProposer, fmt.Sprintf("%s is not the proposer of proposal %d", proposer, proposalID))
+}
+
+func ErrProposalMsgFailed(codespace sdk.CodespaceType, index int, log string) sdk.Error {
+	return sdk.NewError(codespace, CodeProposalMsgFailed, fmt.Sprintf("proposal message %d failed on execution: %s", index, log))
+}
+
+func ErrInvalidRepresentative(codespace sdk.CodespaceType, msg string) sdk.Error {
+	return sdk.NewError(codespace, CodeInvalidRepresentative, fmt.Sprintf("invalid representative: %s", msg))
+}
+
+func ErrUnknownVoteDelegation(codespace sdk.CodespaceType, delegator sdk.AccAddress, proposalType string) sdk.Error {
+	return sdk.NewError(codespace, CodeUnknownVoteDelegation, fmt.Sprintf("%s has no representative for proposal type '%s'", delegator, proposalType))
+}
@@ -0,0 +1,27 @@
+package types
+
+// Governance module event types
+const (
+	EventTypeSubmitProposal      = "submit_proposal"
+	EventTypeProposalDeposit     = "proposal_deposit"
+	EventTypeProposalVote        = "proposal_vote"
+	EventTypeInactiveProposal    = "inactive_proposal"
+	EventTypeActiveProposal      = "active_proposal"
+	EventTypeCancelProposal      = "cancel_proposal"
+	EventTypeSetRepresentative   = "set_representative"
+	EventTypeClearRepresentative = "clear_representative"
+
+	AttributeKeyProposalResult     = "proposal_result"
+	AttributeKeyOption             = "option"
+	AttributeKeyProposalID         = "proposal_id"
+	AttributeKeyVotingPeriodStart  = "voting_period_start"
+	AttributeKeyRepresentative     = "representative"
+	AttributeKeyProposalType       = "proposal_type"
+	AttributeValueCategory         = "governance"
+	AttributeValueProposalDropped  = "proposal_dropped"  // didn't meet min deposit
+	AttributeValueProposalPassed   = "proposal_passed"   // met vote quorum
+	AttributeValueProposalRejected = "proposal_rejected" // didn't meet vote quorum
+	AttributeValueProposalFailed   = "proposal_failed"   // error on proposal handler
+
+	AttributeValueExpeditedProposalRejected = "expedited_proposal_rejected" // didn't meet expedited vote threshold, converted to a regular proposal
+)
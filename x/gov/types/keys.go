@@ -23,6 +23,11 @@ const (
 
 	// DefaultParamspace default name for parameter store
 	DefaultParamspace = ModuleName
+
+	// TreasuryName is the name of the module account that signs the messages of
+	// the messages proposals and holds the funds they spend. It is kept apart
+	// from the module account holding the deposits.
+	TreasuryName = "gov_treasury"
 )
 
 // Keys for governance store
@@ -39,6 +44,10 @@ const (
 // - 0x10<proposalID_Bytes><depositorAddr_Bytes>: Deposit
 //
 // - 0x20<proposalID_Bytes><voterAddr_Bytes>: Voter
+//
+// - 0x30<delegatorAddr_Bytes><proposalType_Bytes>: VoteDelegation
+//
+// - 0x31<representativeAddr_Bytes><delegatorAddr_Bytes><proposalType_Bytes>: []byte{}
 var (
 	ProposalsKeyPrefix          = []byte{0x00}
 	ActiveProposalQueuePrefix   = []byte{0x01}
@@ -48,6 +57,9 @@ var (
 	DepositsKeyPrefix = []byte{0x10}
 
 	VotesKeyPrefix = []byte{0x20}
+
+	VoteDelegationsKeyPrefix                 = []byte{0x30}
+	VoteDelegationsByRepresentativeKeyPrefix = []byte{0x31}
 )
 
 var lenTime = len(sdk.FormatTimeBytes(time.Now()))
@@ -109,6 +121,30 @@ func VoteKey(proposalID uint64, voterAddr sdk.AccAddress) []byte {
 	return append(VotesKey(proposalID), voterAddr.Bytes()...)
 }
 
+// VoteDelegationsKey gets the first part of the vote delegations key based on
+// the delegator address
+func VoteDelegationsKey(delegatorAddr sdk.AccAddress) []byte {
+	return append(VoteDelegationsKeyPrefix, delegatorAddr.Bytes()...)
+}
+
+// VoteDelegationKey key of a specific vote delegation from the store
+func VoteDelegationKey(delegatorAddr sdk.AccAddress, proposalType string) []byte {
+	return append(VoteDelegationsKey(delegatorAddr), []byte(proposalType)...)
+}
+
+// VoteDelegationsByRepresentativeKey gets the first part of the vote
+// delegations by representative key based on the representative address
+func VoteDelegationsByRepresentativeKey(representativeAddr sdk.AccAddress) []byte {
+	return append(VoteDelegationsByRepresentativeKeyPrefix, representativeAddr.Bytes()...)
+}
+
+// VoteDelegationByRepresentativeKey key of a specific vote delegation in the
+// index of the vote delegations by representative
+func VoteDelegationByRepresentativeKey(representativeAddr, delegatorAddr sdk.AccAddress, proposalType string) []byte {
+	key := append(VoteDelegationsByRepresentativeKey(representativeAddr), delegatorAddr.Bytes()...)
+	return append(key, []byte(proposalType)...)
+}
+
 // Split keys function; used for iterators
 
 // SplitProposalKey split the proposal key and returns the proposal id
@@ -140,6 +176,20 @@ func SplitKeyVote(key []byte) (proposalID uint64, voterAddr sdk.AccAddress) {
 	return splitKeyWithAddress(key)
 }
 
+// SplitVoteDelegationByRepresentativeKey split the vote delegation by
+// representative key and returns the vote delegation it indexes
+func SplitVoteDelegationByRepresentativeKey(key []byte) VoteDelegation {
+	if len(key[1:]) < 2*sdk.AddrLen {
+		panic(fmt.Sprintf("unexpected key length (%d < %d)", len(key[1:]), 2*sdk.AddrLen))
+	}
+
+	representative := sdk.AccAddress(key[1 : 1+sdk.AddrLen])
+	delegator := sdk.AccAddress(key[1+sdk.AddrLen : 1+2*sdk.AddrLen])
+	proposalType := string(key[1+2*sdk.AddrLen:])
+
+	return NewVoteDelegation(delegator, representative, proposalType)
+}
+
 // private functions
 
 func splitKeyWithTime(key []byte) (proposalID uint64, endTime time.Time) {
@@ -1,6 +1,7 @@
 package types
 
 import (
+	"encoding/json"
 	"fmt"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -10,20 +11,27 @@ import (
 const (
 	TypeMsgDeposit        = "deposit"
 	TypeMsgVote           = "vote"
+	TypeMsgVoteWeighted   = "weighted_vote"
 	TypeMsgSubmitProposal = "submit_proposal"
+	TypeMsgCancelProposal = "cancel_proposal"
+
+	TypeMsgSetRepresentative   = "set_representative"
+	TypeMsgClearRepresentative = "clear_representative"
 )
 
-var _, _, _ sdk.Msg = MsgSubmitProposal{}, MsgDeposit{}, MsgVote{}
+var _, _, _, _, _ sdk.Msg = MsgSubmitProposal{}, MsgDeposit{}, MsgVote{}, MsgVoteWeighted{}, MsgCancelProposal{}
+var _, _ sdk.Msg = MsgSetRepresentative{}, MsgClearRepresentative{}
 
 // MsgSubmitProposal
 type MsgSubmitProposal struct {
 	Content        Content        `json:"content"`
 	InitialDeposit sdk.Coins      `json:"initial_deposit"` //  Initial deposit paid by sender. Must be strictly positive
 	Proposer       sdk.AccAddress `json:"proposer"`        //  Address of the proposer
+	Expedited      bool           `json:"expedited"`       //  Whether the proposal is expedited
 }
 
-func NewMsgSubmitProposal(content Content, initialDeposit sdk.Coins, proposer sdk.AccAddress) MsgSubmitProposal {
-	return MsgSubmitProposal{content, initialDeposit, proposer}
+func NewMsgSubmitProposal(content Content, initialDeposit sdk.Coins, proposer sdk.AccAddress, expedited bool) MsgSubmitProposal {
+	return MsgSubmitProposal{content, initialDeposit, proposer, expedited}
 }
 
 //nolint
@@ -35,12 +43,6 @@ func (msg MsgSubmitProposal) ValidateBasic() sdk.Error {
 	if msg.Content == nil {
 		return ErrInvalidProposalContent(DefaultCodespace, "missing content")
 	}
-	if msg.Content.ProposalType() == ProposalTypeSoftwareUpgrade {
-		// Disable software upgrade proposals as they are currently equivalent
-		// to text proposals. Re-enable once a valid software upgrade proposal
-		// handler is implemented.
-		return ErrInvalidProposalType(DefaultCodespace, msg.Content.ProposalType())
-	}
 	if msg.Proposer.Empty() {
 		return sdk.ErrInvalidAddress(msg.Proposer.String())
 	}
@@ -61,11 +63,22 @@ func (msg MsgSubmitProposal) String() string {
 	return fmt.Sprintf(`Submit Proposal Message:
   Content:         %s
   Initial Deposit: %s
-`, msg.Content.String(), msg.InitialDeposit)
+  Expedited:       %t
+`, msg.Content.String(), msg.InitialDeposit, msg.Expedited)
 }
 
 // Implements Msg.
 func (msg MsgSubmitProposal) GetSignBytes() []byte {
+	if mp, ok := msg.Content.(MessagesProposal); ok {
+		bz := ModuleCdc.MustMarshalJSON(struct {
+			Content        json.RawMessage `json:"content"`
+			InitialDeposit sdk.Coins       `json:"initial_deposit"`
+			Proposer       sdk.AccAddress  `json:"proposer"`
+			Expedited      bool            `json:"expedited"`
+		}{mp.signBytes(), msg.InitialDeposit, msg.Proposer, msg.Expedited})
+		return sdk.MustSortJSON(bz)
+	}
+
 	bz := ModuleCdc.MustMarshalJSON(msg)
 	return sdk.MustSortJSON(bz)
 }
@@ -75,6 +88,48 @@ func (msg MsgSubmitProposal) GetSigners() []sdk.AccAddress {
 	return []sdk.AccAddress{msg.Proposer}
 }
 
+// MsgCancelProposal
+type MsgCancelProposal struct {
+	ProposalID uint64         `json:"proposal_id"` // ID of the proposal
+	Proposer   sdk.AccAddress `json:"proposer"`    // Address of the proposer
+}
+
+func NewMsgCancelProposal(proposalID uint64, proposer sdk.AccAddress) MsgCancelProposal {
+	return MsgCancelProposal{proposalID, proposer}
+}
+
+// Implements Msg.
+// nolint
+func (msg MsgCancelProposal) Route() string { return RouterKey }
+func (msg MsgCancelProposal) Type() string  { return TypeMsgCancelProposal }
+
+// Implements Msg.
+func (msg MsgCancelProposal) ValidateBasic() sdk.Error {
+	if msg.Proposer.Empty() {
+		return sdk.ErrInvalidAddress(msg.Proposer.String())
+	}
+
+	return nil
+}
+
+func (msg MsgCancelProposal) String() string {
+	return fmt.Sprintf(`Cancel Proposal Message:
+  Proposer:    %s
+  Proposal ID: %d
+`, msg.Proposer, msg.ProposalID)
+}
+
+// Implements Msg.
+func (msg MsgCancelProposal) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+// Implements Msg.
+func (msg MsgCancelProposal) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.Proposer}
+}
+
 // MsgDeposit
 type MsgDeposit struct {
 	ProposalID uint64         `json:"proposal_id"` // ID of the proposal
@@ -170,3 +225,147 @@ func (msg MsgVote) GetSignBytes() []byte {
 func (msg MsgVote) GetSigners() []sdk.AccAddress {
 	return []sdk.AccAddress{msg.Voter}
 }
+
+// MsgVoteWeighted
+type MsgVoteWeighted struct {
+	ProposalID uint64              `json:"proposal_id"` // ID of the proposal
+	Voter      sdk.AccAddress      `json:"voter"`       //  address of the voter
+	Options    WeightedVoteOptions `json:"options"`     //  weighted options from OptionSet chosen by the voter
+}
+
+func NewMsgVoteWeighted(voter sdk.AccAddress, proposalID uint64, options WeightedVoteOptions) MsgVoteWeighted {
+	return MsgVoteWeighted{proposalID, voter, options}
+}
+
+// Implements Msg.
+// nolint
+func (msg MsgVoteWeighted) Route() string { return RouterKey }
+func (msg MsgVoteWeighted) Type() string  { return TypeMsgVoteWeighted }
+
+// Implements Msg.
+func (msg MsgVoteWeighted) ValidateBasic() sdk.Error {
+	if msg.Voter.Empty() {
+		return sdk.ErrInvalidAddress(msg.Voter.String())
+	}
+	if !ValidWeightedVoteOptions(msg.Options) {
+		return ErrInvalidWeightedVote(DefaultCodespace, msg.Options)
+	}
+
+	return nil
+}
+
+func (msg MsgVoteWeighted) String() string {
+	return fmt.Sprintf(`Weighted Vote Message:
+  Proposal ID: %d
+  Options:     %s
+`, msg.ProposalID, msg.Options)
+}
+
+// Implements Msg.
+func (msg MsgVoteWeighted) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+// Implements Msg.
+func (msg MsgVoteWeighted) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.Voter}
+}
+
+// MsgSetRepresentative
+type MsgSetRepresentative struct {
+	Delegator      sdk.AccAddress `json:"delegator"`      // address of the account delegating its voting power
+	Representative sdk.AccAddress `json:"representative"` // address of the representative
+	ProposalType   string         `json:"proposal_type"`  // type of the proposals to delegate, or empty for all of them
+}
+
+func NewMsgSetRepresentative(delegator, representative sdk.AccAddress, proposalType string) MsgSetRepresentative {
+	return MsgSetRepresentative{delegator, representative, proposalType}
+}
+
+// Implements Msg.
+// nolint
+func (msg MsgSetRepresentative) Route() string { return RouterKey }
+func (msg MsgSetRepresentative) Type() string  { return TypeMsgSetRepresentative }
+
+// Implements Msg.
+func (msg MsgSetRepresentative) ValidateBasic() sdk.Error {
+	if msg.Delegator.Empty() {
+		return sdk.ErrInvalidAddress(msg.Delegator.String())
+	}
+	if msg.Representative.Empty() {
+		return sdk.ErrInvalidAddress(msg.Representative.String())
+	}
+	if msg.Delegator.Equals(msg.Representative) {
+		return ErrInvalidRepresentative(DefaultCodespace, "an account cannot represent itself")
+	}
+	if msg.ProposalType != "" && !IsValidProposalType(msg.ProposalType) {
+		return ErrInvalidProposalType(DefaultCodespace, msg.ProposalType)
+	}
+
+	return nil
+}
+
+func (msg MsgSetRepresentative) String() string {
+	return fmt.Sprintf(`Set Representative Message:
+  Delegator:      %s
+  Representative: %s
+  Proposal Type:  %s
+`, msg.Delegator, msg.Representative, msg.ProposalType)
+}
+
+// Implements Msg.
+func (msg MsgSetRepresentative) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+// Implements Msg.
+func (msg MsgSetRepresentative) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.Delegator}
+}
+
+// MsgClearRepresentative
+type MsgClearRepresentative struct {
+	Delegator    sdk.AccAddress `json:"delegator"`     // address of the account delegating its voting power
+	ProposalType string         `json:"proposal_type"` // type of the proposals of the delegation, or empty for all of them
+}
+
+func NewMsgClearRepresentative(delegator sdk.AccAddress, proposalType string) MsgClearRepresentative {
+	return MsgClearRepresentative{delegator, proposalType}
+}
+
+// Implements Msg.
+// nolint
+func (msg MsgClearRepresentative) Route() string { return RouterKey }
+func (msg MsgClearRepresentative) Type() string  { return TypeMsgClearRepresentative }
+
+// Implements Msg.
+func (msg MsgClearRepresentative) ValidateBasic() sdk.Error {
+	if msg.Delegator.Empty() {
+		return sdk.ErrInvalidAddress(msg.Delegator.String())
+	}
+	if msg.ProposalType != "" && !IsValidProposalType(msg.ProposalType) {
+		return ErrInvalidProposalType(DefaultCodespace, msg.ProposalType)
+	}
+
+	return nil
+}
+
+func (msg MsgClearRepresentative) String() string {
+	return fmt.Sprintf(`Clear Representative Message:
+  Delegator:     %s
+  Proposal Type: %s
+`, msg.Delegator, msg.ProposalType)
+}
+
+// Implements Msg.
+func (msg MsgClearRepresentative) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+// Implements Msg.
+func (msg MsgClearRepresentative) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.Delegator}
+}
@@ -7,6 +7,7 @@ import (
 	"github.com/stretchr/testify/require"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 )
 
 var (
@@ -36,7 +37,6 @@ func TestMsgSubmitProposal(t *testing.T) {
 		{"Test Proposal", "the purpose of this proposal is to test", ProposalTypeText, addrs[0], coinsPos, true},
 		{"", "the purpose of this proposal is to test", ProposalTypeText, addrs[0], coinsPos, false},
 		{"Test Proposal", "", ProposalTypeText, addrs[0], coinsPos, false},
-		{"Test Proposal", "the purpose of this proposal is to test", ProposalTypeSoftwareUpgrade, addrs[0], coinsPos, false},
 		{"Test Proposal", "the purpose of this proposal is to test", ProposalTypeText, sdk.AccAddress{}, coinsPos, false},
 		{"Test Proposal", "the purpose of this proposal is to test", ProposalTypeText, addrs[0], coinsZero, true},
 		{"Test Proposal", "the purpose of this proposal is to test", ProposalTypeText, addrs[0], coinsMulti, true},
@@ -49,6 +49,7 @@ func TestMsgSubmitProposal(t *testing.T) {
 			ContentFromProposalType(tc.title, tc.description, tc.proposalType),
 			tc.initialDeposit,
 			tc.proposerAddr,
+			false,
 		)
 
 		if tc.expectPass {
@@ -59,6 +60,113 @@ func TestMsgSubmitProposal(t *testing.T) {
 	}
 }
 
+func TestMsgSubmitMessagesProposal(t *testing.T) {
+	treasuryAddr := authtypes.NewModuleAddress(TreasuryName)
+	govAddr := authtypes.NewModuleAddress(ModuleName)
+
+	tests := []struct {
+		msgs       []sdk.Msg
+		expectPass bool
+	}{
+		{[]sdk.Msg{sdk.NewTestMsg(treasuryAddr)}, true},
+		{[]sdk.Msg{sdk.NewTestMsg(treasuryAddr), sdk.NewTestMsg(treasuryAddr)}, true},
+		{nil, false},
+		{[]sdk.Msg{sdk.NewTestMsg(addrs[0])}, false},
+		{[]sdk.Msg{sdk.NewTestMsg(govAddr)}, false},
+		{[]sdk.Msg{sdk.NewTestMsg(treasuryAddr, addrs[0])}, false},
+		{[]sdk.Msg{sdk.NewTestMsg(treasuryAddr), sdk.NewTestMsg(addrs[0])}, false},
+	}
+
+	for i, tc := range tests {
+		content := NewMessagesProposal("Test Proposal", "the purpose of this proposal is to test", tc.msgs)
+		msg := NewMsgSubmitProposal(content, coinsPos, addrs[0], false)
+
+		if tc.expectPass {
+			require.NoError(t, msg.ValidateBasic(), "test: %v", i)
+		} else {
+			require.Error(t, msg.ValidateBasic(), "test: %v", i)
+		}
+	}
+}
+
+func TestMsgSubmitMessagesProposalGetSignBytes(t *testing.T) {
+	treasuryAddr := authtypes.NewModuleAddress(TreasuryName)
+	content := NewMessagesProposal("Test", "description", []sdk.Msg{sdk.NewTestMsg(treasuryAddr)})
+	msg := NewMsgSubmitProposal(content, coinsPos, addrs[0], false)
+	res := msg.GetSignBytes()
+
+	expected := `{"content":{"type":"cosmos-sdk/MessagesProposal","value":{"description":"description","messages":[["cosmos187wpssafkyaudtxler5paln0rqu6ffycn3wq7e"]],"title":"Test"}},"expedited":false,"initial_deposit":[{"amount":"1000","denom":"stake"}],"proposer":"cosmos1w3jhxap3gempvr"}`
+	require.Equal(t, expected, string(res))
+}
+
+// test ValidateBasic for MsgCancelProposal
+func TestMsgCancelProposal(t *testing.T) {
+	tests := []struct {
+		proposalID   uint64
+		proposerAddr sdk.AccAddress
+		expectPass   bool
+	}{
+		{0, addrs[0], true},
+		{1, sdk.AccAddress{}, false},
+	}
+
+	for i, tc := range tests {
+		msg := NewMsgCancelProposal(tc.proposalID, tc.proposerAddr)
+		if tc.expectPass {
+			require.NoError(t, msg.ValidateBasic(), "test: %v", i)
+		} else {
+			require.Error(t, msg.ValidateBasic(), "test: %v", i)
+		}
+	}
+}
+
+func TestMsgSetRepresentative(t *testing.T) {
+	tests := []struct {
+		delegatorAddr      sdk.AccAddress
+		representativeAddr sdk.AccAddress
+		proposalType       string
+		expectPass         bool
+	}{
+		{addrs[0], addrs[1], "", true},
+		{addrs[0], addrs[1], ProposalTypeText, true},
+		{addrs[0], addrs[1], "Other", false},
+		{addrs[0], addrs[0], "", false},
+		{sdk.AccAddress{}, addrs[1], "", false},
+		{addrs[0], sdk.AccAddress{}, "", false},
+	}
+
+	for i, tc := range tests {
+		msg := NewMsgSetRepresentative(tc.delegatorAddr, tc.representativeAddr, tc.proposalType)
+		if tc.expectPass {
+			require.NoError(t, msg.ValidateBasic(), "test: %v", i)
+		} else {
+			require.Error(t, msg.ValidateBasic(), "test: %v", i)
+		}
+	}
+}
+
+func TestMsgClearRepresentative(t *testing.T) {
+	tests := []struct {
+		delegatorAddr sdk.AccAddress
+		proposalType  string
+		expectPass    bool
+	}{
+		{addrs[0], "", true},
+		{addrs[0], ProposalTypeText, true},
+		{addrs[0], "Other", false},
+		{sdk.AccAddress{}, "", false},
+	}
+
+	for i, tc := range tests {
+		msg := NewMsgClearRepresentative(tc.delegatorAddr, tc.proposalType)
+		if tc.expectPass {
+			require.NoError(t, msg.ValidateBasic(), "test: %v", i)
+		} else {
+			require.Error(t, msg.ValidateBasic(), "test: %v", i)
+		}
+	}
+}
+
 func TestMsgDepositGetSignBytes(t *testing.T) {
 	addr := sdk.AccAddress("addr1")
 	msg := NewMsgDeposit(addr, 0, coinsPos)
@@ -117,3 +225,32 @@ func TestMsgVote(t *testing.T) {
 		}
 	}
 }
+
+func TestMsgVoteWeighted(t *testing.T) {
+	half := sdk.NewDecWithPrec(5, 1)
+	tests := []struct {
+		proposalID uint64
+		voterAddr  sdk.AccAddress
+		options    WeightedVoteOptions
+		expectPass bool
+	}{
+		{0, addrs[0], NewNonSplitVoteOption(OptionYes), true},
+		{0, sdk.AccAddress{}, NewNonSplitVoteOption(OptionYes), false},
+		{0, addrs[0], WeightedVoteOptions{NewWeightedVoteOption(OptionYes, half), NewWeightedVoteOption(OptionNo, half)}, true},
+		{0, addrs[0], WeightedVoteOptions{NewWeightedVoteOption(OptionYes, half), NewWeightedVoteOption(OptionYes, half)}, false},
+		{0, addrs[0], WeightedVoteOptions{NewWeightedVoteOption(OptionYes, half)}, false},
+		{0, addrs[0], WeightedVoteOptions{NewWeightedVoteOption(OptionYes, sdk.NewDec(2)), NewWeightedVoteOption(OptionNo, sdk.NewDec(-1))}, false},
+		{0, addrs[0], WeightedVoteOptions{NewWeightedVoteOption(OptionYes, sdk.OneDec()), NewWeightedVoteOption(OptionNo, sdk.ZeroDec())}, false},
+		{0, addrs[0], WeightedVoteOptions{NewWeightedVoteOption(VoteOption(0x13), sdk.OneDec())}, false},
+		{0, addrs[0], WeightedVoteOptions{}, false},
+	}
+
+	for i, tc := range tests {
+		msg := NewMsgVoteWeighted(tc.voterAddr, tc.proposalID, tc.options)
+		if tc.expectPass {
+			require.Nil(t, msg.ValidateBasic(), "test: %v", i)
+		} else {
+			require.NotNil(t, msg.ValidateBasic(), "test: %v", i)
+		}
+	}
+}
@@ -18,76 +18,135 @@ var (
 // Key declaration for parameters
 func ParamKeyTable() params.KeyTable {
 	return params.NewKeyTable(
-		ParamStoreKeyDepositParams, DepositParams{},
-		ParamStoreKeyVotingParams, VotingParams{},
-		ParamStoreKeyTallyParams, TallyParams{},
+		params.NewParamSetPair(ParamStoreKeyDepositParams, DepositParams{}, validateDepositParams),
+		params.NewParamSetPair(ParamStoreKeyVotingParams, VotingParams{}, validateVotingParams),
+		params.NewParamSetPair(ParamStoreKeyTallyParams, TallyParams{}, validateTallyParams),
 	)
 }
 
 // Param around deposits for governance
 type DepositParams struct {
-	MinDeposit       sdk.Coins     `json:"min_deposit,omitempty"`        //  Minimum deposit for a proposal to enter voting period.
-	MaxDepositPeriod time.Duration `json:"max_deposit_period,omitempty"` //  Maximum period for Atom holders to deposit on a proposal. Initial value: 2 months
+	MinDeposit                 sdk.Coins     `json:"min_deposit,omitempty"`           //  Minimum deposit for a proposal to enter voting period.
+	ExpeditedMinDeposit        sdk.Coins     `json:"expedited_min_deposit,omitempty"` //  Minimum deposit for an expedited proposal to enter voting period.
+	MaxDepositPeriod           time.Duration `json:"max_deposit_period,omitempty"`    //  Maximum period for Atom holders to deposit on a proposal. Initial value: 2 months
+	ProposalCancelRatio        sdk.Dec       `json:"proposal_cancel_ratio,omitempty"` //  Proportion of the deposits burned when a proposal is canceled by its proposer. Initial value: 0.5
+	BurnProposalDepositPrevote bool          `json:"burn_proposal_deposit_prevote"`   //  Burn the deposits of a proposal that doesn't reach the minimum deposit. Initial value: true
+	BurnVoteQuorum             bool          `json:"burn_vote_quorum"`                //  Burn the deposits of a proposal that doesn't reach quorum. Initial value: true
+	BurnVoteVeto               bool          `json:"burn_vote_veto"`                  //  Burn the deposits of a vetoed proposal. Initial value: true
+	BurnToCommunityPool        bool          `json:"burn_to_community_pool"`          //  Send burned deposits to the community pool instead of destroying them. Initial value: false
 }
 
 // NewDepositParams creates a new DepositParams object
-func NewDepositParams(minDeposit sdk.Coins, maxDepositPeriod time.Duration) DepositParams {
+func NewDepositParams(minDeposit, expeditedMinDeposit sdk.Coins, maxDepositPeriod time.Duration,
+	proposalCancelRatio sdk.Dec, burnProposalDepositPrevote, burnVoteQuorum, burnVoteVeto,
+	burnToCommunityPool bool) DepositParams {
+
 	return DepositParams{
-		MinDeposit:       minDeposit,
-		MaxDepositPeriod: maxDepositPeriod,
+		MinDeposit:                 minDeposit,
+		ExpeditedMinDeposit:        expeditedMinDeposit,
+		MaxDepositPeriod:           maxDepositPeriod,
+		ProposalCancelRatio:        proposalCancelRatio,
+		BurnProposalDepositPrevote: burnProposalDepositPrevote,
+		BurnVoteQuorum:             burnVoteQuorum,
+		BurnVoteVeto:               burnVoteVeto,
+		BurnToCommunityPool:        burnToCommunityPool,
+	}
+}
+
+// GetMinDeposit returns the minimum deposit for a proposal to enter the voting
+// period, depending on whether the proposal is expedited.
+func (dp DepositParams) GetMinDeposit(expedited bool) sdk.Coins {
+	if expedited {
+		return dp.ExpeditedMinDeposit
 	}
+	return dp.MinDeposit
 }
 
 func (dp DepositParams) String() string {
 	return fmt.Sprintf(`Deposit Params:
-  Min Deposit:        %s
-  Max Deposit Period: %s`, dp.MinDeposit, dp.MaxDepositPeriod)
+  Min Deposit:                   %s
+  Expedited Min Deposit:         %s
+  Max Deposit Period:            %s
+  Proposal Cancel Ratio:         %s
+  Burn Proposal Deposit Prevote: %t
+  Burn Vote Quorum:              %t
+  Burn Vote Veto:                %t
+  Burn To Community Pool:        %t`,
+		dp.MinDeposit, dp.ExpeditedMinDeposit, dp.MaxDepositPeriod, dp.ProposalCancelRatio,
+		dp.BurnProposalDepositPrevote, dp.BurnVoteQuorum, dp.BurnVoteVeto, dp.BurnToCommunityPool)
 }
 
 // Checks equality of DepositParams
 func (dp DepositParams) Equal(dp2 DepositParams) bool {
-	return dp.MinDeposit.IsEqual(dp2.MinDeposit) && dp.MaxDepositPeriod == dp2.MaxDepositPeriod
+	return dp.MinDeposit.IsEqual(dp2.MinDeposit) && dp.ExpeditedMinDeposit.IsEqual(dp2.ExpeditedMinDeposit) &&
+		dp.MaxDepositPeriod == dp2.MaxDepositPeriod && dp.ProposalCancelRatio.Equal(dp2.ProposalCancelRatio) &&
+		dp.BurnProposalDepositPrevote == dp2.BurnProposalDepositPrevote && dp.BurnVoteQuorum == dp2.BurnVoteQuorum &&
+		dp.BurnVoteVeto == dp2.BurnVoteVeto && dp.BurnToCommunityPool == dp2.BurnToCommunityPool
 }
 
 // Param around Tallying votes in governance
 type TallyParams struct {
-	Quorum    sdk.Dec `json:"quorum,omitempty"`    //  Minimum percentage of total stake needed to vote for a result to be considered valid
-	Threshold sdk.Dec `json:"threshold,omitempty"` //  Minimum proportion of Yes votes for proposal to pass. Initial value: 0.5
-	Veto      sdk.Dec `json:"veto,omitempty"`      //  Minimum value of Veto votes to Total votes ratio for proposal to be vetoed. Initial value: 1/3
+	Quorum             sdk.Dec `json:"quorum,omitempty"`              //  Minimum percentage of total stake needed to vote for a result to be considered valid
+	Threshold          sdk.Dec `json:"threshold,omitempty"`           //  Minimum proportion of Yes votes for proposal to pass. Initial value: 0.5
+	ExpeditedThreshold sdk.Dec `json:"expedited_threshold,omitempty"` //  Minimum proportion of Yes votes for an expedited proposal to pass. Initial value: 0.667
+	Veto               sdk.Dec `json:"veto,omitempty"`                //  Minimum value of Veto votes to Total votes ratio for proposal to be vetoed. Initial value: 1/3
 }
 
 // NewTallyParams creates a new TallyParams object
-func NewTallyParams(quorum, threshold, veto sdk.Dec) TallyParams {
+func NewTallyParams(quorum, threshold, expeditedThreshold, veto sdk.Dec) TallyParams {
 	return TallyParams{
-		Quorum:    quorum,
-		Threshold: threshold,
-		Veto:      veto,
+		Quorum:             quorum,
+		Threshold:          threshold,
+		ExpeditedThreshold: expeditedThreshold,
+		Veto:               veto,
+	}
+}
+
+// GetThreshold returns the minimum proportion of Yes votes for a proposal to
+// pass, depending on whether the proposal is expedited.
+func (tp TallyParams) GetThreshold(expedited bool) sdk.Dec {
+	if expedited {
+		return tp.ExpeditedThreshold
 	}
+	return tp.Threshold
 }
 
 func (tp TallyParams) String() string {
 	return fmt.Sprintf(`Tally Params:
-  Quorum:             %s
-  Threshold:          %s
-  Veto:               %s`,
-		tp.Quorum, tp.Threshold, tp.Veto)
+  Quorum:              %s
+  Threshold:           %s
+  Expedited Threshold: %s
+  Veto:                %s`,
+		tp.Quorum, tp.Threshold, tp.ExpeditedThreshold, tp.Veto)
 }
 
 // Param around Voting in governance
 type VotingParams struct {
-	VotingPeriod time.Duration `json:"voting_period,omitempty"` //  Length of the voting period.
+	VotingPeriod          time.Duration `json:"voting_period,omitempty"`           //  Length of the voting period.
+	ExpeditedVotingPeriod time.Duration `json:"expedited_voting_period,omitempty"` //  Length of the voting period of expedited proposals.
 }
 
 // NewVotingParams creates a new VotingParams object
-func NewVotingParams(votingPeriod time.Duration) VotingParams {
+func NewVotingParams(votingPeriod, expeditedVotingPeriod time.Duration) VotingParams {
 	return VotingParams{
-		VotingPeriod: votingPeriod,
+		VotingPeriod:          votingPeriod,
+		ExpeditedVotingPeriod: expeditedVotingPeriod,
 	}
 }
 
+// GetVotingPeriod returns the length of the voting period of a proposal,
+// depending on whether the proposal is expedited.
+func (vp VotingParams) GetVotingPeriod(expedited bool) time.Duration {
+	if expedited {
+		return vp.ExpeditedVotingPeriod
+	}
+	return vp.VotingPeriod
+}
+
 func (vp VotingParams) String() string {
 	return fmt.Sprintf(`Voting Params:
-  Voting Period:      %s`, vp.VotingPeriod)
+  Voting Period:           %s
+  Expedited Voting Period: %s`, vp.VotingPeriod, vp.ExpeditedVotingPeriod)
 }
 
 // Params returns all of the governance params
@@ -109,3 +168,106 @@ func NewParams(vp VotingParams, tp TallyParams, dp DepositParams) Params {
 		TallyParams:   tp,
 	}
 }
+
+// Validate checks that the deposit parameters have valid values.
+func (dp DepositParams) Validate() error {
+	if !dp.MinDeposit.IsValid() {
+		return fmt.Errorf("Governance deposit amount must be a valid sdk.Coins amount, is %s",
+			dp.MinDeposit.String())
+	}
+
+	if !dp.ExpeditedMinDeposit.IsValid() {
+		return fmt.Errorf("Governance expedited deposit amount must be a valid sdk.Coins amount, is %s",
+			dp.ExpeditedMinDeposit.String())
+	}
+
+	if dp.MaxDepositPeriod <= 0 {
+		return fmt.Errorf("Governance maximum deposit period must be positive, is %s", dp.MaxDepositPeriod)
+	}
+
+	cancelRatio := dp.ProposalCancelRatio
+	if cancelRatio.IsNil() || cancelRatio.IsNegative() || cancelRatio.GT(sdk.OneDec()) {
+		return fmt.Errorf("Governance proposal cancel ratio should be positive and less or equal to one, is %s",
+			cancelRatio.String())
+	}
+
+	return nil
+}
+
+// Validate checks that the voting parameters have valid values.
+func (vp VotingParams) Validate() error {
+	if vp.VotingPeriod <= 0 {
+		return fmt.Errorf("Governance voting period must be positive, is %s", vp.VotingPeriod)
+	}
+
+	if vp.ExpeditedVotingPeriod <= 0 {
+		return fmt.Errorf("Governance expedited voting period must be positive, is %s", vp.ExpeditedVotingPeriod)
+	}
+
+	if vp.ExpeditedVotingPeriod >= vp.VotingPeriod {
+		return fmt.Errorf("Governance expedited voting period %s should be shorter than the voting period %s",
+			vp.ExpeditedVotingPeriod, vp.VotingPeriod)
+	}
+
+	return nil
+}
+
+// Validate checks that the tally parameters have valid values.
+func (tp TallyParams) Validate() error {
+	quorum := tp.Quorum
+	if quorum.IsNil() || quorum.IsNegative() || quorum.GT(sdk.OneDec()) {
+		return fmt.Errorf("Governance vote quorum should be positive and less or equal to one, is %s",
+			quorum.String())
+	}
+
+	threshold := tp.Threshold
+	if threshold.IsNil() || threshold.IsNegative() || threshold.GT(sdk.OneDec()) {
+		return fmt.Errorf("Governance vote threshold should be positive and less or equal to one, is %s",
+			threshold.String())
+	}
+
+	expeditedThreshold := tp.ExpeditedThreshold
+	if expeditedThreshold.IsNil() || expeditedThreshold.IsNegative() || expeditedThreshold.GT(sdk.OneDec()) {
+		return fmt.Errorf("Governance expedited vote threshold should be positive and less or equal to one, is %s",
+			expeditedThreshold.String())
+	}
+	if expeditedThreshold.LTE(threshold) {
+		return fmt.Errorf("Governance expedited vote threshold %s should be greater than the vote threshold %s",
+			expeditedThreshold.String(), threshold.String())
+	}
+
+	veto := tp.Veto
+	if veto.IsNil() || veto.IsNegative() || veto.GT(sdk.OneDec()) {
+		return fmt.Errorf("Governance vote veto threshold should be positive and less or equal to one, is %s",
+			veto.String())
+	}
+
+	return nil
+}
+
+func validateDepositParams(i interface{}) error {
+	v, ok := i.(DepositParams)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	return v.Validate()
+}
+
+func validateVotingParams(i interface{}) error {
+	v, ok := i.(VotingParams)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	return v.Validate()
+}
+
+func validateTallyParams(i interface{}) error {
+	v, ok := i.(TallyParams)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	return v.Validate()
+}
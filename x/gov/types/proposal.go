@@ -7,6 +7,7 @@ import (
 	"time"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 )
 
 // Proposal defines a struct used by the governance module to allow for voting
@@ -15,6 +16,7 @@ type Proposal struct {
 	Content `json:"content"` // Proposal content interface
 
 	ProposalID       uint64         `json:"id"`                 //  ID of the proposal
+	Proposer         sdk.AccAddress `json:"proposer"`           //  Address of the proposer
 	Status           ProposalStatus `json:"proposal_status"`    // Status of the Proposal {Pending, Active, Passed, Rejected}
 	FinalTallyResult TallyResult    `json:"final_tally_result"` // Result of Tallys
 
@@ -24,17 +26,21 @@ type Proposal struct {
 
 	VotingStartTime time.Time `json:"voting_start_time"` // Time of the block where MinDeposit was reached. -1 if MinDeposit is not reached
 	VotingEndTime   time.Time `json:"voting_end_time"`   // Time that the VotingPeriod for this proposal will end and votes will be tallied
+
+	Expedited bool `json:"expedited"` // Whether the proposal is expedited
 }
 
-func NewProposal(content Content, id uint64, submitTime, depositEndTime time.Time) Proposal {
+func NewProposal(content Content, id uint64, proposer sdk.AccAddress, submitTime, depositEndTime time.Time, expedited bool) Proposal {
 	return Proposal{
 		Content:          content,
 		ProposalID:       id,
+		Proposer:         proposer,
 		Status:           StatusDepositPeriod,
 		FinalTallyResult: EmptyTallyResult(),
 		TotalDeposit:     sdk.NewCoins(),
 		SubmitTime:       submitTime,
 		DepositEndTime:   depositEndTime,
+		Expedited:        expedited,
 	}
 }
 
@@ -43,16 +49,18 @@ func (p Proposal) String() string {
 	return fmt.Sprintf(`Proposal %d:
   Title:              %s
   Type:               %s
+  Proposer:           %s
   Status:             %s
   Submit Time:        %s
   Deposit End Time:   %s
   Total Deposit:      %s
   Voting Start Time:  %s
   Voting End Time:    %s
+  Expedited:          %t
   Description:        %s`,
-		p.ProposalID, p.GetTitle(), p.ProposalType(),
+		p.ProposalID, p.GetTitle(), p.ProposalType(), p.Proposer,
 		p.Status, p.SubmitTime, p.DepositEndTime,
-		p.TotalDeposit, p.VotingStartTime, p.VotingEndTime, p.GetDescription(),
+		p.TotalDeposit, p.VotingStartTime, p.VotingEndTime, p.Expedited, p.GetDescription(),
 	)
 }
 
@@ -249,8 +257,8 @@ func (tr TallyResult) String() string {
 
 // Proposal types
 const (
-	ProposalTypeText            string = "Text"
-	ProposalTypeSoftwareUpgrade string = "SoftwareUpgrade"
+	ProposalTypeText     string = "Text"
+	ProposalTypeMessages string = "Messages"
 )
 
 // Text Proposal
@@ -280,40 +288,93 @@ func (tp TextProposal) String() string {
 `, tp.Title, tp.Description)
 }
 
-// Software Upgrade Proposals
-// TODO: We have to add fields for SUP specific arguments e.g. commit hash,
-// upgrade date, etc.
-type SoftwareUpgradeProposal struct {
-	Title       string `json:"title"`
-	Description string `json:"description"`
+// Messages Proposal
+//
+// A MessagesProposal carries a list of messages that are executed atomically
+// on behalf of the governance treasury account once the proposal passes. Each
+// message must be signed by the governance treasury account only.
+type MessagesProposal struct {
+	Title       string    `json:"title"`
+	Description string    `json:"description"`
+	Messages    []sdk.Msg `json:"messages"`
 }
 
-func NewSoftwareUpgradeProposal(title, description string) Content {
-	return SoftwareUpgradeProposal{title, description}
+func NewMessagesProposal(title, description string, msgs []sdk.Msg) Content {
+	return MessagesProposal{title, description, msgs}
 }
 
 // Implements Proposal Interface
-var _ Content = SoftwareUpgradeProposal{}
+var _ Content = MessagesProposal{}
 
 // nolint
-func (sup SoftwareUpgradeProposal) GetTitle() string       { return sup.Title }
-func (sup SoftwareUpgradeProposal) GetDescription() string { return sup.Description }
-func (sup SoftwareUpgradeProposal) ProposalRoute() string  { return RouterKey }
-func (sup SoftwareUpgradeProposal) ProposalType() string   { return ProposalTypeSoftwareUpgrade }
-func (sup SoftwareUpgradeProposal) ValidateBasic() sdk.Error {
-	return ValidateAbstract(DefaultCodespace, sup)
+func (mp MessagesProposal) GetTitle() string       { return mp.Title }
+func (mp MessagesProposal) GetDescription() string { return mp.Description }
+func (mp MessagesProposal) ProposalRoute() string  { return RouterKey }
+func (mp MessagesProposal) ProposalType() string   { return ProposalTypeMessages }
+
+// ValidateBasic performs a stateless validation of the proposal abstract and
+// of each of the messages, which must be signed by the governance treasury
+// account only.
+func (mp MessagesProposal) ValidateBasic() sdk.Error {
+	if err := ValidateAbstract(DefaultCodespace, mp); err != nil {
+		return err
+	}
+	if len(mp.Messages) == 0 {
+		return ErrInvalidProposalContent(DefaultCodespace, "proposal messages cannot be empty")
+	}
+
+	treasuryAddr := authtypes.NewModuleAddress(TreasuryName)
+	for i, msg := range mp.Messages {
+		if signer, ok := sdk.GetSingleSigner(msg); !ok || !signer.Equals(treasuryAddr) {
+			return ErrInvalidProposalContent(DefaultCodespace,
+				fmt.Sprintf("message %d must be signed by the governance treasury account %s only", i, treasuryAddr))
+		}
+		if err := msg.ValidateBasic(); err != nil {
+			return err
+		}
+	}
+
+	return nil
 }
 
-func (sup SoftwareUpgradeProposal) String() string {
-	return fmt.Sprintf(`Software Upgrade Proposal:
+func (mp MessagesProposal) String() string {
+	var b strings.Builder
+	b.WriteString(fmt.Sprintf(`Messages Proposal:
   Title:       %s
   Description: %s
-`, sup.Title, sup.Description)
+  Messages:
+`, mp.Title, mp.Description))
+
+	for _, msg := range mp.Messages {
+		b.WriteString(fmt.Sprintf("    %s/%s\n", msg.Route(), msg.Type()))
+	}
+
+	return b.String()
+}
+
+// signBytes returns the canonical JSON encoding of the proposal. The messages
+// are included through their own sign bytes, as their concrete types are not
+// registered on the module codec.
+func (mp MessagesProposal) signBytes() json.RawMessage {
+	type value struct {
+		Title       string            `json:"title"`
+		Description string            `json:"description"`
+		Messages    []json.RawMessage `json:"messages"`
+	}
+	bz, err := json.Marshal(struct {
+		Type  string `json:"type"`
+		Value value  `json:"value"`
+	}{"cosmos-sdk/MessagesProposal", value{mp.Title, mp.Description, sdk.MsgsSignBytes(mp.Messages)}})
+	if err != nil {
+		panic(err)
+	}
+
+	return bz
 }
 
 var validProposalTypes = map[string]struct{}{
-	ProposalTypeText:            {},
-	ProposalTypeSoftwareUpgrade: {},
+	ProposalTypeText:     {},
+	ProposalTypeMessages: {},
 }
 
 // RegisterProposalType registers a proposal type. It will panic if the type is
@@ -332,9 +393,6 @@ func ContentFromProposalType(title, desc, ty string) Content {
 	case ProposalTypeText:
 		return NewTextProposal(title, desc)
 
-	case ProposalTypeSoftwareUpgrade:
-		return NewSoftwareUpgradeProposal(title, desc)
-
 	default:
 		return nil
 	}
@@ -350,15 +408,18 @@ func IsValidProposalType(ty string) bool {
 }
 
 // ProposalHandler implements the Handler interface for governance module-based
-// proposals (ie. TextProposal and SoftwareUpgradeProposal). Since these are
-// merely signaling mechanisms at the moment and do not affect state, it
-// performs a no-op.
+// proposals (ie. TextProposal). Since these are merely signaling mechanisms at
+// the moment and do not affect state, it performs a no-op.
 func ProposalHandler(_ sdk.Context, c Content) sdk.Error {
 	switch c.ProposalType() {
-	case ProposalTypeText, ProposalTypeSoftwareUpgrade:
-		// both proposal types do not change state so this performs a no-op
+	case ProposalTypeText:
+		// text proposals do not change state so this performs a no-op
 		return nil
 
+	case ProposalTypeMessages:
+		// executing messages requires the message router, see NewProposalHandler
+		return sdk.ErrUnknownRequest("messages proposals cannot be handled without a message router")
+
 	default:
 		errMsg := fmt.Sprintf("unrecognized gov proposal type: %s", c.ProposalType())
 		return sdk.ErrUnknownRequest(errMsg)
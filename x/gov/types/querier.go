@@ -15,6 +15,9 @@ const (
 	QueryVote      = "vote"
 	QueryTally     = "tally"
 
+	QueryTallyBreakdown  = "tally_breakdown"
+	QueryVoteDelegations = "vote_delegations"
+
 	ParamDeposit  = "deposit"
 	ParamVoting   = "voting"
 	ParamTallying = "tallying"
@@ -24,6 +27,7 @@ const (
 // - 'custom/gov/proposal'
 // - 'custom/gov/deposits'
 // - 'custom/gov/tally'
+// - 'custom/gov/tally_breakdown'
 // - 'custom/gov/votes'
 type QueryProposalParams struct {
 	ProposalID uint64
@@ -81,3 +85,17 @@ func NewQueryProposalsParams(status ProposalStatus, limit uint64, voter, deposit
 		Limit:          limit,
 	}
 }
+
+// Params for query 'custom/gov/vote_delegations'
+type QueryVoteDelegationsParams struct {
+	Delegator      sdk.AccAddress
+	Representative sdk.AccAddress
+}
+
+// creates a new instance of QueryVoteDelegationsParams
+func NewQueryVoteDelegationsParams(delegator, representative sdk.AccAddress) QueryVoteDelegationsParams {
+	return QueryVoteDelegationsParams{
+		Delegator:      delegator,
+		Representative: representative,
+	}
+}
@@ -3,24 +3,25 @@ package types
 import (
 	"encoding/json"
 	"fmt"
+	"strings"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
 // Vote
 type Vote struct {
-	ProposalID uint64         `json:"proposal_id"` //  proposalID of the proposal
-	Voter      sdk.AccAddress `json:"voter"`       //  address of the voter
-	Option     VoteOption     `json:"option"`      //  option from OptionSet chosen by the voter
+	ProposalID uint64              `json:"proposal_id"` //  proposalID of the proposal
+	Voter      sdk.AccAddress      `json:"voter"`       //  address of the voter
+	Options    WeightedVoteOptions `json:"options"`     //  weighted options from OptionSet chosen by the voter
 }
 
 // NewVote creates a new Vote instance
-func NewVote(proposalID uint64, voter sdk.AccAddress, option VoteOption) Vote {
-	return Vote{proposalID, voter, option}
+func NewVote(proposalID uint64, voter sdk.AccAddress, options WeightedVoteOptions) Vote {
+	return Vote{proposalID, voter, options}
 }
 
 func (v Vote) String() string {
-	return fmt.Sprintf("voter %s voted with option %s on proposal %d", v.Voter, v.Option, v.ProposalID)
+	return fmt.Sprintf("voter %s voted with options %s on proposal %d", v.Voter, v.Options, v.ProposalID)
 }
 
 // Votes is a collection of Vote objects
@@ -29,7 +30,7 @@ type Votes []Vote
 func (v Votes) String() string {
 	out := fmt.Sprintf("Votes for Proposal %d:", v[0].ProposalID)
 	for _, vot := range v {
-		out += fmt.Sprintf("\n  %s: %s", vot.Voter, vot.Option)
+		out += fmt.Sprintf("\n  %s: %s", vot.Voter, vot.Options)
 	}
 	return out
 }
@@ -38,7 +39,7 @@ func (v Votes) String() string {
 func (v Vote) Equals(comp Vote) bool {
 	return v.Voter.Equals(comp.Voter) &&
 		v.ProposalID == comp.ProposalID &&
-		v.Option == comp.Option
+		v.Options.Equals(comp.Options)
 }
 
 // Empty returns whether a vote is empty.
@@ -46,6 +47,111 @@ func (v Vote) Empty() bool {
 	return v.Equals(Vote{})
 }
 
+// WeightedVoteOption defines a vote option along with the fraction of the
+// voting power given to it
+type WeightedVoteOption struct {
+	Option VoteOption `json:"option"` // option from OptionSet chosen by the voter
+	Weight sdk.Dec    `json:"weight"` // fraction of the voting power given to the option
+}
+
+// NewWeightedVoteOption creates a new WeightedVoteOption instance
+func NewWeightedVoteOption(option VoteOption, weight sdk.Dec) WeightedVoteOption {
+	return WeightedVoteOption{option, weight}
+}
+
+func (wo WeightedVoteOption) String() string {
+	return fmt.Sprintf("%s=%s", wo.Option, wo.Weight)
+}
+
+// WeightedVoteOptions is a collection of WeightedVoteOption objects
+type WeightedVoteOptions []WeightedVoteOption
+
+// NewNonSplitVoteOption creates WeightedVoteOptions giving all the voting
+// power to a single option.
+func NewNonSplitVoteOption(option VoteOption) WeightedVoteOptions {
+	return WeightedVoteOptions{NewWeightedVoteOption(option, sdk.OneDec())}
+}
+
+func (wo WeightedVoteOptions) String() string {
+	out := make([]string, len(wo))
+	for i, option := range wo {
+		out[i] = option.String()
+	}
+	return strings.Join(out, ",")
+}
+
+// Equals returns whether two collections of weighted vote options are equal.
+func (wo WeightedVoteOptions) Equals(comp WeightedVoteOptions) bool {
+	if len(wo) != len(comp) {
+		return false
+	}
+	for i := range wo {
+		if wo[i].Option != comp[i].Option || !wo[i].Weight.Equal(comp[i].Weight) {
+			return false
+		}
+	}
+	return true
+}
+
+// WeightedVoteOptionsFromString returns WeightedVoteOptions from a comma
+// separated list of option=weight pairs, e.g. "Yes=0.6,No=0.4". A single option
+// without a weight is given all the voting power. It returns an error if the
+// string is invalid.
+func WeightedVoteOptionsFromString(str string) (WeightedVoteOptions, error) {
+	if !strings.Contains(str, "=") {
+		option, err := VoteOptionFromString(str)
+		if err != nil {
+			return nil, err
+		}
+		return NewNonSplitVoteOption(option), nil
+	}
+
+	var options WeightedVoteOptions
+	for _, pair := range strings.Split(str, ",") {
+		fields := strings.Split(pair, "=")
+		if len(fields) != 2 {
+			return nil, fmt.Errorf("'%s' is not a valid weighted vote option", pair)
+		}
+
+		option, err := VoteOptionFromString(strings.TrimSpace(fields[0]))
+		if err != nil {
+			return nil, err
+		}
+
+		weight, err := sdk.NewDecFromStr(strings.TrimSpace(fields[1]))
+		if err != nil {
+			return nil, err
+		}
+
+		options = append(options, NewWeightedVoteOption(option, weight))
+	}
+	return options, nil
+}
+
+// ValidWeightedVoteOptions returns true if the weighted vote options are valid
+// and false otherwise. Every option must be valid and appear at most once, and
+// the weights must be positive and sum up to 1.
+func ValidWeightedVoteOptions(options WeightedVoteOptions) bool {
+	if len(options) == 0 {
+		return false
+	}
+
+	totalWeight := sdk.ZeroDec()
+	usedOptions := make(map[VoteOption]bool)
+	for _, option := range options {
+		if !ValidVoteOption(option.Option) || usedOptions[option.Option] {
+			return false
+		}
+		if option.Weight.IsNil() || !option.Weight.IsPositive() || option.Weight.GT(sdk.OneDec()) {
+			return false
+		}
+
+		usedOptions[option.Option] = true
+		totalWeight = totalWeight.Add(option.Weight)
+	}
+	return totalWeight.Equal(sdk.OneDec())
+}
+
 // VoteOption defines a vote option
 type VoteOption byte
 
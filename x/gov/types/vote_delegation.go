@@ -0,0 +1,87 @@
+package types
+
+import (
+	"fmt"
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// VoteDelegation defines the delegation of the governance voting power of an
+// account to a representative. A delegation with an empty proposal type applies
+// to all the proposal types that have no delegation of their own.
+type VoteDelegation struct {
+	Delegator      sdk.AccAddress `json:"delegator"`      // address of the account delegating its voting power
+	Representative sdk.AccAddress `json:"representative"` // address of the representative voting on its behalf
+	ProposalType   string         `json:"proposal_type"`  // type of the proposals the delegation applies to, or empty for all of them
+}
+
+// NewVoteDelegation creates a new VoteDelegation instance
+func NewVoteDelegation(delegator, representative sdk.AccAddress, proposalType string) VoteDelegation {
+	return VoteDelegation{delegator, representative, proposalType}
+}
+
+func (vd VoteDelegation) String() string {
+	proposalType := vd.ProposalType
+	if proposalType == "" {
+		proposalType = "all"
+	}
+
+	return fmt.Sprintf("%s is represented by %s on %s proposals", vd.Delegator, vd.Representative, proposalType)
+}
+
+// VoteDelegations is a collection of VoteDelegation objects
+type VoteDelegations []VoteDelegation
+
+func (vds VoteDelegations) String() string {
+	if len(vds) == 0 {
+		return "[]"
+	}
+
+	out := make([]string, len(vds))
+	for i, vd := range vds {
+		out[i] = vd.String()
+	}
+	return strings.Join(out, "\n")
+}
+
+// VoterTally defines the voting power a voter contributed to the tally of a
+// proposal, split between its own voting power and the voting power of the
+// accounts it represents. The voting power of a validator accounts for the
+// delegators that inherit its vote.
+type VoterTally struct {
+	Voter          sdk.AccAddress      `json:"voter"`           // address of the voter
+	Options        WeightedVoteOptions `json:"options"`         // weighted options chosen by the voter
+	VotingPower    sdk.Dec             `json:"voting_power"`    // own voting power of the voter
+	DelegatedPower sdk.Dec             `json:"delegated_power"` // voting power of the accounts represented by the voter
+}
+
+// NewVoterTally creates a new VoterTally instance
+func NewVoterTally(voter sdk.AccAddress, options WeightedVoteOptions, votingPower, delegatedPower sdk.Dec) VoterTally {
+	return VoterTally{voter, options, votingPower, delegatedPower}
+}
+
+func (vt VoterTally) String() string {
+	return fmt.Sprintf("%s voted %s with %s own and %s delegated voting power",
+		vt.Voter, vt.Options, vt.VotingPower, vt.DelegatedPower)
+}
+
+// TallyBreakdown defines the tally result of a proposal along with the voting
+// power contributed by each voter.
+type TallyBreakdown struct {
+	Result TallyResult  `json:"result"`
+	Voters []VoterTally `json:"voters"`
+}
+
+// NewTallyBreakdown creates a new TallyBreakdown instance
+func NewTallyBreakdown(result TallyResult, voters []VoterTally) TallyBreakdown {
+	return TallyBreakdown{result, voters}
+}
+
+func (tb TallyBreakdown) String() string {
+	out := fmt.Sprintf("%s\nVoters:", tb.Result)
+	for _, voter := range tb.Voters {
+		out += fmt.Sprintf("\n  %s", voter)
+	}
+	return out
+}
@@ -0,0 +1,42 @@
+package types
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func TestWeightedVoteOptionsFromString(t *testing.T) {
+	tests := []struct {
+		str        string
+		expected   WeightedVoteOptions
+		expectPass bool
+	}{
+		{"Yes", NewNonSplitVoteOption(OptionYes), true},
+		{"Yes=1", NewNonSplitVoteOption(OptionYes), true},
+		{"Yes=0.6,NoWithVeto=0.4", WeightedVoteOptions{
+			NewWeightedVoteOption(OptionYes, sdk.NewDecWithPrec(6, 1)),
+			NewWeightedVoteOption(OptionNoWithVeto, sdk.NewDecWithPrec(4, 1)),
+		}, true},
+		{"Yes = 0.5, No = 0.5", WeightedVoteOptions{
+			NewWeightedVoteOption(OptionYes, sdk.NewDecWithPrec(5, 1)),
+			NewWeightedVoteOption(OptionNo, sdk.NewDecWithPrec(5, 1)),
+		}, true},
+		{"Maybe", nil, false},
+		{"Yes=0.5,Maybe=0.5", nil, false},
+		{"Yes=half", nil, false},
+		{"Yes=0.5=0.5", nil, false},
+	}
+
+	for i, tc := range tests {
+		options, err := WeightedVoteOptionsFromString(tc.str)
+		if tc.expectPass {
+			require.NoError(t, err, "test: %v", i)
+			require.True(t, options.Equals(tc.expected), "test: %v", i)
+		} else {
+			require.Error(t, err, "test: %v", i)
+		}
+	}
+}
@@ -1,12 +1,14 @@
 package gov
 
 import (
+	"fmt"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/x/gov/types"
 )
 
 // AddVote Adds a vote on a specific proposal
-func (keeper Keeper) AddVote(ctx sdk.Context, proposalID uint64, voterAddr sdk.AccAddress, option VoteOption) sdk.Error {
+func (keeper Keeper) AddVote(ctx sdk.Context, proposalID uint64, voterAddr sdk.AccAddress, options WeightedVoteOptions) sdk.Error {
 	proposal, ok := keeper.GetProposal(ctx, proposalID)
 	if !ok {
 		return ErrUnknownProposal(keeper.codespace, proposalID)
@@ -15,13 +17,21 @@ func (keeper Keeper) AddVote(ctx sdk.Context, proposalID uint64, voterAddr sdk.A
 		return ErrInactiveProposal(keeper.codespace, proposalID)
 	}
 
-	if !ValidVoteOption(option) {
-		return ErrInvalidVote(keeper.codespace, option)
+	if !ValidWeightedVoteOptions(options) {
+		return ErrInvalidWeightedVote(keeper.codespace, options)
 	}
 
-	vote := NewVote(proposalID, voterAddr, option)
+	vote := NewVote(proposalID, voterAddr, options)
 	keeper.setVote(ctx, proposalID, voterAddr, vote)
 
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeProposalVote,
+			sdk.NewAttribute(types.AttributeKeyOption, options.String()),
+			sdk.NewAttribute(types.AttributeKeyProposalID, fmt.Sprintf("%d", proposalID)),
+		),
+	)
+
 	return nil
 }
 
@@ -71,3 +81,11 @@ func (keeper Keeper) deleteVote(ctx sdk.Context, proposalID uint64, voterAddr sd
 	store := ctx.KVStore(keeper.storeKey)
 	store.Delete(types.VoteKey(proposalID, voterAddr))
 }
+
+// deleteVotes deletes all the votes on a specific proposal
+func (keeper Keeper) deleteVotes(ctx sdk.Context, proposalID uint64) {
+	keeper.IterateVotes(ctx, proposalID, func(vote types.Vote) bool {
+		keeper.deleteVote(ctx, vote.ProposalID, vote.Voter)
+		return false
+	})
+}
@@ -0,0 +1,114 @@
+package gov
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/gov/types"
+)
+
+// SetRepresentative delegates the governance voting power of an account to a
+// representative for the given proposal type, or for all the proposal types if
+// it is empty. Any previous representative for the same proposal type is
+// replaced.
+func (keeper Keeper) SetRepresentative(ctx sdk.Context, delegator, representative sdk.AccAddress, proposalType string) sdk.Error {
+	if delegator.Equals(representative) {
+		return ErrInvalidRepresentative(keeper.codespace, "an account cannot represent itself")
+	}
+	if proposalType != "" && !IsValidProposalType(proposalType) {
+		return ErrInvalidProposalType(keeper.codespace, proposalType)
+	}
+
+	keeper.setVoteDelegation(ctx, NewVoteDelegation(delegator, representative, proposalType))
+	return nil
+}
+
+// ClearRepresentative removes the representative of an account for the given
+// proposal type.
+func (keeper Keeper) ClearRepresentative(ctx sdk.Context, delegator sdk.AccAddress, proposalType string) sdk.Error {
+	if _, found := keeper.GetVoteDelegation(ctx, delegator, proposalType); !found {
+		return ErrUnknownVoteDelegation(keeper.codespace, delegator, proposalType)
+	}
+
+	keeper.deleteVoteDelegation(ctx, delegator, proposalType)
+	return nil
+}
+
+// GetRepresentative returns the representative of an account on proposals of
+// the given type. A delegation for the proposal type takes precedence over a
+// delegation for all the proposal types.
+func (keeper Keeper) GetRepresentative(ctx sdk.Context, delegator sdk.AccAddress, proposalType string) (sdk.AccAddress, bool) {
+	if delegation, found := keeper.GetVoteDelegation(ctx, delegator, proposalType); found {
+		return delegation.Representative, true
+	}
+
+	if delegation, found := keeper.GetVoteDelegation(ctx, delegator, ""); found {
+		return delegation.Representative, true
+	}
+
+	return nil, false
+}
+
+// GetVoteDelegation gets the vote delegation of an account for a specific
+// proposal type
+func (keeper Keeper) GetVoteDelegation(ctx sdk.Context, delegator sdk.AccAddress, proposalType string) (delegation VoteDelegation, found bool) {
+	store := ctx.KVStore(keeper.storeKey)
+	bz := store.Get(types.VoteDelegationKey(delegator, proposalType))
+	if bz == nil {
+		return delegation, false
+	}
+
+	keeper.cdc.MustUnmarshalBinaryLengthPrefixed(bz, &delegation)
+	return delegation, true
+}
+
+// GetAllVoteDelegations returns all the vote delegations from the store
+func (keeper Keeper) GetAllVoteDelegations(ctx sdk.Context) (delegations VoteDelegations) {
+	keeper.IterateAllVoteDelegations(ctx, func(delegation VoteDelegation) bool {
+		delegations = append(delegations, delegation)
+		return false
+	})
+	return
+}
+
+// GetVoteDelegationsFiltered returns the vote delegations filtered by
+// delegator and representative. Empty addresses match all the delegations.
+func (keeper Keeper) GetVoteDelegationsFiltered(ctx sdk.Context, delegator, representative sdk.AccAddress) (delegations VoteDelegations) {
+	cb := func(delegation VoteDelegation) bool {
+		if len(representative) == 0 || delegation.Representative.Equals(representative) {
+			delegations = append(delegations, delegation)
+		}
+		return false
+	}
+
+	switch {
+	case len(delegator) != 0:
+		keeper.IterateVoteDelegations(ctx, delegator, cb)
+	case len(representative) != 0:
+		keeper.IterateVoteDelegationsByRepresentative(ctx, representative, cb)
+	default:
+		keeper.IterateAllVoteDelegations(ctx, cb)
+	}
+	return
+}
+
+// setVoteDelegation stores a vote delegation, replacing any previous one of
+// the delegator for the same proposal type, and indexes it by representative.
+func (keeper Keeper) setVoteDelegation(ctx sdk.Context, delegation VoteDelegation) {
+	keeper.deleteVoteDelegation(ctx, delegation.Delegator, delegation.ProposalType)
+
+	store := ctx.KVStore(keeper.storeKey)
+	bz := keeper.cdc.MustMarshalBinaryLengthPrefixed(delegation)
+	store.Set(types.VoteDelegationKey(delegation.Delegator, delegation.ProposalType), bz)
+	store.Set(types.VoteDelegationByRepresentativeKey(delegation.Representative, delegation.Delegator, delegation.ProposalType), []byte{})
+}
+
+// deleteVoteDelegation removes a vote delegation along with its index entry.
+func (keeper Keeper) deleteVoteDelegation(ctx sdk.Context, delegator sdk.AccAddress, proposalType string) {
+	delegation, found := keeper.GetVoteDelegation(ctx, delegator, proposalType)
+	if !found {
+		return
+	}
+
+	store := ctx.KVStore(keeper.storeKey)
+	store.Delete(types.VoteDelegationKey(delegator, proposalType))
+	store.Delete(types.VoteDelegationByRepresentativeKey(delegation.Representative, delegator, proposalType))
+}
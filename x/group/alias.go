@@ -0,0 +1,171 @@
+// nolint
+// autogenerated code using github.com/rigelrozanski/multitool
+// aliases generated for the following subdirectories:
+// ALIASGEN: github.com/cosmos/cosmos-sdk/x/group/keeper
+// ALIASGEN: github.com/cosmos/cosmos-sdk/x/group/types
+package group
+
+import (
+	"github.com/cosmos/cosmos-sdk/x/group/keeper"
+	"github.com/cosmos/cosmos-sdk/x/group/types"
+)
+
+const (
+	DefaultCodespace             = types.DefaultCodespace
+	CodeUnknownGroup             = types.CodeUnknownGroup
+	CodeUnknownGroupPolicy       = types.CodeUnknownGroupPolicy
+	CodeUnknownProposal          = types.CodeUnknownProposal
+	CodeUnauthorized             = types.CodeUnauthorized
+	CodeInvalidMembers           = types.CodeInvalidMembers
+	CodeInvalidDecisionPolicy    = types.CodeInvalidDecisionPolicy
+	CodeInvalidProposal          = types.CodeInvalidProposal
+	CodeNotMember                = types.CodeNotMember
+	CodeInvalidVoteChoice        = types.CodeInvalidVoteChoice
+	CodeAlreadyVoted             = types.CodeAlreadyVoted
+	CodeVotingClosed             = types.CodeVotingClosed
+	CodeProposalNotExecutable    = types.CodeProposalNotExecutable
+	CodeProposalOutdated         = types.CodeProposalOutdated
+	EventTypeCreateGroup         = types.EventTypeCreateGroup
+	EventTypeUpdateGroupMembers  = types.EventTypeUpdateGroupMembers
+	EventTypeUpdateGroupAdmin    = types.EventTypeUpdateGroupAdmin
+	EventTypeCreateGroupPolicy   = types.EventTypeCreateGroupPolicy
+	EventTypeUpdateGroupPolicy   = types.EventTypeUpdateGroupPolicy
+	EventTypeSubmitGroupProposal = types.EventTypeSubmitGroupProposal
+	EventTypeGroupVote           = types.EventTypeGroupVote
+	EventTypeExecGroupProposal   = types.EventTypeExecGroupProposal
+	AttributeKeyGroupID          = types.AttributeKeyGroupID
+	AttributeKeyAdmin            = types.AttributeKeyAdmin
+	AttributeKeyAddress          = types.AttributeKeyAddress
+	AttributeKeyProposalID       = types.AttributeKeyProposalID
+	AttributeKeyVoter            = types.AttributeKeyVoter
+	AttributeKeyChoice           = types.AttributeKeyChoice
+	AttributeKeyStatus           = types.AttributeKeyStatus
+	AttributeKeyExecutorResult   = types.AttributeKeyExecutorResult
+	AttributeValueCategory       = types.AttributeValueCategory
+	ModuleName                   = types.ModuleName
+	StoreKey                     = types.StoreKey
+	RouterKey                    = types.RouterKey
+	QuerierRoute                 = types.QuerierRoute
+	ChoiceUnspecified            = types.ChoiceUnspecified
+	ChoiceYes                    = types.ChoiceYes
+	ChoiceNo                     = types.ChoiceNo
+	ChoiceAbstain                = types.ChoiceAbstain
+	StatusNil                    = types.StatusNil
+	StatusSubmitted              = types.StatusSubmitted
+	StatusAccepted               = types.StatusAccepted
+	StatusRejected               = types.StatusRejected
+	StatusAborted                = types.StatusAborted
+	ExecutorResultNotRun         = types.ExecutorResultNotRun
+	ExecutorResultSuccess        = types.ExecutorResultSuccess
+	ExecutorResultFailure        = types.ExecutorResultFailure
+	QueryGroup                   = types.QueryGroup
+	QueryGroupMembers            = types.QueryGroupMembers
+	QueryGroupPolicy             = types.QueryGroupPolicy
+	QueryGroupPolicies           = types.QueryGroupPolicies
+	QueryProposal                = types.QueryProposal
+	QueryProposals               = types.QueryProposals
+	QueryVotes                   = types.QueryVotes
+)
+
+var (
+	// functions aliases
+	NewKeeper                   = keeper.NewKeeper
+	NewQuerier                  = keeper.NewQuerier
+	RegisterCodec               = types.RegisterCodec
+	ErrUnknownGroup             = types.ErrUnknownGroup
+	ErrUnknownGroupPolicy       = types.ErrUnknownGroupPolicy
+	ErrUnknownProposal          = types.ErrUnknownProposal
+	ErrUnauthorized             = types.ErrUnauthorized
+	ErrInvalidMembers           = types.ErrInvalidMembers
+	ErrInvalidDecisionPolicy    = types.ErrInvalidDecisionPolicy
+	ErrInvalidProposal          = types.ErrInvalidProposal
+	ErrNotMember                = types.ErrNotMember
+	ErrInvalidVoteChoice        = types.ErrInvalidVoteChoice
+	ErrAlreadyVoted             = types.ErrAlreadyVoted
+	ErrVotingClosed             = types.ErrVotingClosed
+	ErrProposalNotExecutable    = types.ErrProposalNotExecutable
+	ErrProposalOutdated         = types.ErrProposalOutdated
+	NewGenesisState             = types.NewGenesisState
+	DefaultGenesisState         = types.DefaultGenesisState
+	NewMember                   = types.NewMember
+	NewGroupInfo                = types.NewGroupInfo
+	NewGroupMember              = types.NewGroupMember
+	GroupKey                    = types.GroupKey
+	GroupMembersKey             = types.GroupMembersKey
+	GroupMemberKey              = types.GroupMemberKey
+	GroupPolicyKey              = types.GroupPolicyKey
+	GroupPoliciesByGroupKey     = types.GroupPoliciesByGroupKey
+	GroupPolicyByGroupKey       = types.GroupPolicyByGroupKey
+	ProposalKey                 = types.ProposalKey
+	VotesKey                    = types.VotesKey
+	VoteKey                     = types.VoteKey
+	NewMsgCreateGroup           = types.NewMsgCreateGroup
+	NewMsgUpdateGroupMembers    = types.NewMsgUpdateGroupMembers
+	NewMsgUpdateGroupAdmin      = types.NewMsgUpdateGroupAdmin
+	NewMsgCreateGroupPolicy     = types.NewMsgCreateGroupPolicy
+	NewMsgUpdateGroupPolicy     = types.NewMsgUpdateGroupPolicy
+	NewMsgSubmitProposal        = types.NewMsgSubmitProposal
+	NewMsgVote                  = types.NewMsgVote
+	NewMsgExec                  = types.NewMsgExec
+	NewThresholdDecisionPolicy  = types.NewThresholdDecisionPolicy
+	NewPercentageDecisionPolicy = types.NewPercentageDecisionPolicy
+	NewGroupPolicyAddress       = types.NewGroupPolicyAddress
+	NewGroupPolicyInfo          = types.NewGroupPolicyInfo
+	NewTally                    = types.NewTally
+	EmptyTally                  = types.EmptyTally
+	NewVote                     = types.NewVote
+	ChoiceFromString            = types.ChoiceFromString
+	ValidChoice                 = types.ValidChoice
+	ProposalStatusFromString    = types.ProposalStatusFromString
+	ExecutorResultFromString    = types.ExecutorResultFromString
+	NewQueryGroupParams         = types.NewQueryGroupParams
+	NewQueryGroupPolicyParams   = types.NewQueryGroupPolicyParams
+	NewQueryProposalParams      = types.NewQueryProposalParams
+
+	// variable aliases
+	ModuleCdc          = types.ModuleCdc
+	GroupIDKey         = types.GroupIDKey
+	GroupKeyPrefix     = types.GroupKeyPrefix
+	GroupMemberPrefix  = types.GroupMemberPrefix
+	GroupPolicyIDKey   = types.GroupPolicyIDKey
+	GroupPolicyPrefix  = types.GroupPolicyPrefix
+	GroupPoliciesIndex = types.GroupPoliciesIndex
+	ProposalIDKey      = types.ProposalIDKey
+	ProposalKeyPrefix  = types.ProposalKeyPrefix
+	VoteKeyPrefix      = types.VoteKeyPrefix
+)
+
+type (
+	Keeper                   = keeper.Keeper
+	GenesisState             = types.GenesisState
+	Member                   = types.Member
+	Members                  = types.Members
+	GroupInfo                = types.GroupInfo
+	GroupMember              = types.GroupMember
+	GroupMembers             = types.GroupMembers
+	MsgCreateGroup           = types.MsgCreateGroup
+	MsgUpdateGroupMembers    = types.MsgUpdateGroupMembers
+	MsgUpdateGroupAdmin      = types.MsgUpdateGroupAdmin
+	MsgCreateGroupPolicy     = types.MsgCreateGroupPolicy
+	MsgUpdateGroupPolicy     = types.MsgUpdateGroupPolicy
+	MsgSubmitProposal        = types.MsgSubmitProposal
+	MsgVote                  = types.MsgVote
+	MsgExec                  = types.MsgExec
+	DecisionPolicy           = types.DecisionPolicy
+	DecisionPolicyResult     = types.DecisionPolicyResult
+	ThresholdDecisionPolicy  = types.ThresholdDecisionPolicy
+	PercentageDecisionPolicy = types.PercentageDecisionPolicy
+	GroupPolicyInfo          = types.GroupPolicyInfo
+	GroupPolicyInfos         = types.GroupPolicyInfos
+	Proposal                 = types.Proposal
+	Proposals                = types.Proposals
+	Tally                    = types.Tally
+	Vote                     = types.Vote
+	Votes                    = types.Votes
+	Choice                   = types.Choice
+	ProposalStatus           = types.ProposalStatus
+	ExecutorResult           = types.ExecutorResult
+	QueryGroupParams         = types.QueryGroupParams
+	QueryGroupPolicyParams   = types.QueryGroupPolicyParams
+	QueryProposalParams      = types.QueryProposalParams
+)
@@ -0,0 +1,289 @@
+package cli
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/context"
+	"github.com/cosmos/cosmos-sdk/client/utils"
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/version"
+	"github.com/cosmos/cosmos-sdk/x/group/types"
+)
+
+// GetQueryCmd returns the cli query commands for this module
+func GetQueryCmd(cdc *codec.Codec) *cobra.Command {
+	// Group group queries under a subcommand
+	groupQueryCmd := &cobra.Command{
+		Use:                        types.ModuleName,
+		Short:                      "Querying commands for the group module",
+		DisableFlagParsing:         true,
+		SuggestionsMinimumDistance: 2,
+		RunE:                       utils.ValidateCmd,
+	}
+
+	groupQueryCmd.AddCommand(client.GetCommands(
+		GetCmdQueryGroup(cdc),
+		GetCmdQueryGroupMembers(cdc),
+		GetCmdQueryGroupPolicy(cdc),
+		GetCmdQueryGroupPolicies(cdc),
+		GetCmdQueryProposal(cdc),
+		GetCmdQueryProposals(cdc),
+		GetCmdQueryVotes(cdc),
+	)...)
+
+	return groupQueryCmd
+}
+
+// GetCmdQueryGroup implements the query group command.
+func GetCmdQueryGroup(cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "group [group-id]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Query a group",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the admin, metadata, version and total weight of a group.
+
+Example:
+$ %s query %s group 1
+`,
+				version.ClientName, types.ModuleName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			groupID, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return fmt.Errorf("group-id %s not a valid uint, please input a valid group-id", args[0])
+			}
+
+			var group types.GroupInfo
+			if err := queryWithParams(cliCtx, types.QueryGroup, types.NewQueryGroupParams(groupID), &group); err != nil {
+				return err
+			}
+
+			return cliCtx.PrintOutput(group)
+		},
+	}
+}
+
+// GetCmdQueryGroupMembers implements the query group members command.
+func GetCmdQueryGroupMembers(cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "group-members [group-id]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Query the members of a group",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the members of a group along with their weights.
+
+Example:
+$ %s query %s group-members 1
+`,
+				version.ClientName, types.ModuleName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			groupID, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return fmt.Errorf("group-id %s not a valid uint, please input a valid group-id", args[0])
+			}
+
+			var members types.GroupMembers
+			if err := queryWithParams(cliCtx, types.QueryGroupMembers, types.NewQueryGroupParams(groupID), &members); err != nil {
+				return err
+			}
+
+			return cliCtx.PrintOutput(members)
+		},
+	}
+}
+
+// GetCmdQueryGroupPolicy implements the query group policy account command.
+func GetCmdQueryGroupPolicy(cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "group-policy [address]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Query a group policy account",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the group, admin and decision policy of a group policy account.
+
+Example:
+$ %s query %s group-policy cosmos1skjw..
+`,
+				version.ClientName, types.ModuleName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			address, err := sdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+
+			var info types.GroupPolicyInfo
+			if err := queryWithParams(cliCtx, types.QueryGroupPolicy, types.NewQueryGroupPolicyParams(address), &info); err != nil {
+				return err
+			}
+
+			return cliCtx.PrintOutput(info)
+		},
+	}
+}
+
+// GetCmdQueryGroupPolicies implements the query group policy accounts command.
+func GetCmdQueryGroupPolicies(cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "group-policies [group-id]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Query the group policy accounts of a group",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query all the group policy accounts of a group.
+
+Example:
+$ %s query %s group-policies 1
+`,
+				version.ClientName, types.ModuleName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			groupID, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return fmt.Errorf("group-id %s not a valid uint, please input a valid group-id", args[0])
+			}
+
+			var policies types.GroupPolicyInfos
+			if err := queryWithParams(cliCtx, types.QueryGroupPolicies, types.NewQueryGroupParams(groupID), &policies); err != nil {
+				return err
+			}
+
+			return cliCtx.PrintOutput(policies)
+		},
+	}
+}
+
+// GetCmdQueryProposal implements the query group proposal command.
+func GetCmdQueryProposal(cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "proposal [proposal-id]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Query a group proposal",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the details of a group proposal, including its status and tally.
+
+Example:
+$ %s query %s proposal 1
+`,
+				version.ClientName, types.ModuleName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			proposalID, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return fmt.Errorf("proposal-id %s not a valid uint, please input a valid proposal-id", args[0])
+			}
+
+			var proposal types.Proposal
+			if err := queryWithParams(cliCtx, types.QueryProposal, types.NewQueryProposalParams(proposalID), &proposal); err != nil {
+				return err
+			}
+
+			return cliCtx.PrintOutput(proposal)
+		},
+	}
+}
+
+// GetCmdQueryProposals implements the query group proposals command.
+func GetCmdQueryProposals(cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "proposals [address]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Query the proposals of a group policy account",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query all the proposals submitted to a group policy account.
+
+Example:
+$ %s query %s proposals cosmos1skjw..
+`,
+				version.ClientName, types.ModuleName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			address, err := sdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+
+			var proposals types.Proposals
+			if err := queryWithParams(cliCtx, types.QueryProposals, types.NewQueryGroupPolicyParams(address), &proposals); err != nil {
+				return err
+			}
+
+			return cliCtx.PrintOutput(proposals)
+		},
+	}
+}
+
+// GetCmdQueryVotes implements the query group proposal votes command.
+func GetCmdQueryVotes(cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "votes [proposal-id]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Query the votes on a group proposal",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query all the votes cast on a group proposal.
+
+Example:
+$ %s query %s votes 1
+`,
+				version.ClientName, types.ModuleName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			proposalID, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return fmt.Errorf("proposal-id %s not a valid uint, please input a valid proposal-id", args[0])
+			}
+
+			var votes types.Votes
+			if err := queryWithParams(cliCtx, types.QueryVotes, types.NewQueryProposalParams(proposalID), &votes); err != nil {
+				return err
+			}
+
+			return cliCtx.PrintOutput(votes)
+		},
+	}
+}
+
+// queryWithParams queries the given group endpoint with the params and
+// unmarshals the result into ptr.
+func queryWithParams(cliCtx context.CLIContext, endpoint string, params, ptr interface{}) error {
+	bz, err := cliCtx.Codec.MarshalJSON(params)
+	if err != nil {
+		return err
+	}
+
+	route := fmt.Sprintf("custom/%s/%s", types.QuerierRoute, endpoint)
+	res, err := cliCtx.QueryWithData(route, bz)
+	if err != nil {
+		return err
+	}
+
+	return cliCtx.Codec.UnmarshalJSON(res, ptr)
+}
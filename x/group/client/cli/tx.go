@@ -0,0 +1,408 @@
+package cli
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/context"
+	"github.com/cosmos/cosmos-sdk/client/utils"
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/version"
+	"github.com/cosmos/cosmos-sdk/x/auth"
+	"github.com/cosmos/cosmos-sdk/x/group/types"
+)
+
+// group flags
+const (
+	FlagMetadata   = "metadata"
+	FlagThreshold  = "threshold"
+	FlagPercentage = "percentage"
+	FlagTimeout    = "timeout"
+)
+
+// GetTxCmd returns the transaction commands for this module
+func GetTxCmd(cdc *codec.Codec) *cobra.Command {
+	groupTxCmd := &cobra.Command{
+		Use:                        types.ModuleName,
+		Short:                      "Group transactions subcommands",
+		DisableFlagParsing:         true,
+		SuggestionsMinimumDistance: 2,
+		RunE:                       utils.ValidateCmd,
+	}
+
+	groupTxCmd.AddCommand(client.PostCommands(
+		GetCmdCreateGroup(cdc),
+		GetCmdUpdateGroupMembers(cdc),
+		GetCmdUpdateGroupAdmin(cdc),
+		GetCmdCreateGroupPolicy(cdc),
+		GetCmdUpdateGroupPolicy(cdc),
+		GetCmdSubmitProposal(cdc),
+		GetCmdVote(cdc),
+		GetCmdExec(cdc),
+	)...)
+
+	return groupTxCmd
+}
+
+// GetCmdCreateGroup implements the create group command.
+func GetCmdCreateGroup(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "create-group [members-json-file]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Create a group administered by you with the given members",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Create a group administered by you with the members listed in a JSON file.
+
+Example:
+$ %s tx %s create-group members.json --metadata "treasury" --from mykey
+
+Where members.json contains:
+
+[
+  {
+    "address": "cosmos1skjw..",
+    "weight": "1",
+    "metadata": "alice"
+  },
+  {
+    "address": "cosmos1gghj..",
+    "weight": "2",
+    "metadata": "bob"
+  }
+]
+`,
+				version.ClientName, types.ModuleName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			txBldr := auth.NewTxBuilderFromCLI().WithTxEncoder(utils.GetTxEncoder(cdc))
+			cliCtx := context.NewCLIContext().WithCodec(cdc).WithAccountDecoder(cdc)
+
+			members, err := ParseMembersJSON(cdc, args[0])
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgCreateGroup(cliCtx.GetFromAddress(), members, viper.GetString(FlagMetadata))
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return utils.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
+		},
+	}
+
+	cmd.Flags().String(FlagMetadata, "", "The metadata of the group")
+
+	return cmd
+}
+
+// GetCmdUpdateGroupMembers implements the update group members command.
+func GetCmdUpdateGroupMembers(cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "update-group-members [group-id] [members-json-file]",
+		Args:  cobra.ExactArgs(2),
+		Short: "Add, update or remove members of a group you administer",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Add, update or remove members of a group you administer, with the member
+updates listed in a JSON file in the same format as for create-group. A member
+with a zero weight is removed from the group. The proposals submitted to the
+group policy accounts of the group before the update are aborted.
+
+Example:
+$ %s tx %s update-group-members 1 members.json --from mykey
+`,
+				version.ClientName, types.ModuleName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			txBldr := auth.NewTxBuilderFromCLI().WithTxEncoder(utils.GetTxEncoder(cdc))
+			cliCtx := context.NewCLIContext().WithCodec(cdc).WithAccountDecoder(cdc)
+
+			groupID, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return fmt.Errorf("group-id %s not a valid uint, please input a valid group-id", args[0])
+			}
+
+			members, err := ParseMembersJSON(cdc, args[1])
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgUpdateGroupMembers(cliCtx.GetFromAddress(), groupID, members)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return utils.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
+		},
+	}
+}
+
+// GetCmdUpdateGroupAdmin implements the update group admin command.
+func GetCmdUpdateGroupAdmin(cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "update-group-admin [group-id] [new-admin]",
+		Args:  cobra.ExactArgs(2),
+		Short: "Transfer the administration of a group you administer",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Transfer the administration of a group you administer to another address.
+
+Example:
+$ %s tx %s update-group-admin 1 cosmos1skjw.. --from mykey
+`,
+				version.ClientName, types.ModuleName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			txBldr := auth.NewTxBuilderFromCLI().WithTxEncoder(utils.GetTxEncoder(cdc))
+			cliCtx := context.NewCLIContext().WithCodec(cdc).WithAccountDecoder(cdc)
+
+			groupID, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return fmt.Errorf("group-id %s not a valid uint, please input a valid group-id", args[0])
+			}
+
+			newAdmin, err := sdk.AccAddressFromBech32(args[1])
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgUpdateGroupAdmin(cliCtx.GetFromAddress(), groupID, newAdmin)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return utils.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
+		},
+	}
+}
+
+// GetCmdCreateGroupPolicy implements the create group policy account command.
+func GetCmdCreateGroupPolicy(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "create-group-policy [group-id]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Create a group policy account for a group you administer",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Create a group policy account for a group you administer, with either a
+threshold or a percentage decision policy. The proposals of the account accept
+votes until the timeout.
+
+Example:
+$ %s tx %s create-group-policy 1 --threshold 2 --timeout 72h --from mykey
+$ %s tx %s create-group-policy 1 --percentage 0.5 --timeout 72h --from mykey
+`,
+				version.ClientName, types.ModuleName, version.ClientName, types.ModuleName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			txBldr := auth.NewTxBuilderFromCLI().WithTxEncoder(utils.GetTxEncoder(cdc))
+			cliCtx := context.NewCLIContext().WithCodec(cdc).WithAccountDecoder(cdc)
+
+			groupID, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return fmt.Errorf("group-id %s not a valid uint, please input a valid group-id", args[0])
+			}
+
+			policy, err := decisionPolicyFromFlags()
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgCreateGroupPolicy(cliCtx.GetFromAddress(), groupID, viper.GetString(FlagMetadata), policy)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return utils.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
+		},
+	}
+
+	cmd.Flags().String(FlagMetadata, "", "The metadata of the group policy account")
+	cmd.Flags().String(FlagThreshold, "", "The weight of yes votes required to accept a proposal")
+	cmd.Flags().String(FlagPercentage, "", "The percentage of the total weight required to accept a proposal")
+	cmd.Flags().String(FlagTimeout, "", "The duration during which the proposals accept votes")
+	cmd.MarkFlagRequired(FlagTimeout)
+
+	return cmd
+}
+
+// GetCmdUpdateGroupPolicy implements the update group policy account command.
+func GetCmdUpdateGroupPolicy(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "update-group-policy [address]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Replace the decision policy of a group policy account you administer",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Replace the decision policy of a group policy account you administer. The
+proposals submitted to the account before the update are aborted.
+
+Example:
+$ %s tx %s update-group-policy cosmos1skjw.. --threshold 3 --timeout 24h --from mykey
+`,
+				version.ClientName, types.ModuleName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			txBldr := auth.NewTxBuilderFromCLI().WithTxEncoder(utils.GetTxEncoder(cdc))
+			cliCtx := context.NewCLIContext().WithCodec(cdc).WithAccountDecoder(cdc)
+
+			address, err := sdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+
+			policy, err := decisionPolicyFromFlags()
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgUpdateGroupPolicy(cliCtx.GetFromAddress(), address, policy)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return utils.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
+		},
+	}
+
+	cmd.Flags().String(FlagThreshold, "", "The weight of yes votes required to accept a proposal")
+	cmd.Flags().String(FlagPercentage, "", "The percentage of the total weight required to accept a proposal")
+	cmd.Flags().String(FlagTimeout, "", "The duration during which the proposals accept votes")
+	cmd.MarkFlagRequired(FlagTimeout)
+
+	return cmd
+}
+
+// GetCmdSubmitProposal implements the submit group proposal command.
+func GetCmdSubmitProposal(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "submit-proposal [address] [tx-json-file]",
+		Args:  cobra.ExactArgs(2),
+		Short: "Submit a proposal to execute messages on behalf of a group policy account",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Submit a proposal to execute the messages of a transaction, generated with
+--generate-only, on behalf of a group policy account. The messages must be
+signed by the group policy account only, and you must be a member of its group.
+
+Example:
+$ %s tx %s submit-proposal cosmos1skjw.. tx.json --metadata "pay the bills" --from mykey
+`,
+				version.ClientName, types.ModuleName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			txBldr := auth.NewTxBuilderFromCLI().WithTxEncoder(utils.GetTxEncoder(cdc))
+			cliCtx := context.NewCLIContext().WithCodec(cdc).WithAccountDecoder(cdc)
+
+			address, err := sdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+
+			stdTx, err := utils.ReadStdTxFromFile(cdc, args[1])
+			if err != nil {
+				return err
+			}
+
+			proposers := []sdk.AccAddress{cliCtx.GetFromAddress()}
+			msg := types.NewMsgSubmitProposal(address, proposers, viper.GetString(FlagMetadata), stdTx.GetMsgs())
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return utils.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
+		},
+	}
+
+	cmd.Flags().String(FlagMetadata, "", "The metadata of the proposal")
+
+	return cmd
+}
+
+// GetCmdVote implements the vote on a group proposal command.
+func GetCmdVote(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "vote [proposal-id] [choice]",
+		Args:  cobra.ExactArgs(2),
+		Short: "Vote on a group proposal",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Vote on a proposal submitted to a group policy account of a group you are a
+member of. Your vote is weighted by your weight in the group.
+Choices are Yes, No and Abstain.
+
+Example:
+$ %s tx %s vote 1 Yes --from mykey
+`,
+				version.ClientName, types.ModuleName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			txBldr := auth.NewTxBuilderFromCLI().WithTxEncoder(utils.GetTxEncoder(cdc))
+			cliCtx := context.NewCLIContext().WithCodec(cdc).WithAccountDecoder(cdc)
+
+			proposalID, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return fmt.Errorf("proposal-id %s not a valid uint, please input a valid proposal-id", args[0])
+			}
+
+			choice, err := types.ChoiceFromString(args[1])
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgVote(proposalID, cliCtx.GetFromAddress(), choice, viper.GetString(FlagMetadata))
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return utils.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
+		},
+	}
+
+	cmd.Flags().String(FlagMetadata, "", "The metadata of the vote")
+
+	return cmd
+}
+
+// GetCmdExec implements the execute group proposal command.
+func GetCmdExec(cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "exec [proposal-id]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Finalize a group proposal and execute its messages if it is accepted",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Finalize a group proposal once its decision policy reached a final decision,
+and execute its messages if it is accepted. A failed execution can be retried.
+
+Example:
+$ %s tx %s exec 1 --from mykey
+`,
+				version.ClientName, types.ModuleName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			txBldr := auth.NewTxBuilderFromCLI().WithTxEncoder(utils.GetTxEncoder(cdc))
+			cliCtx := context.NewCLIContext().WithCodec(cdc).WithAccountDecoder(cdc)
+
+			proposalID, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return fmt.Errorf("proposal-id %s not a valid uint, please input a valid proposal-id", args[0])
+			}
+
+			msg := types.NewMsgExec(proposalID, cliCtx.GetFromAddress())
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return utils.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
+		},
+	}
+}
@@ -0,0 +1,61 @@
+package cli
+
+import (
+	"fmt"
+	"io/ioutil"
+	"time"
+
+	"github.com/spf13/viper"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/group/types"
+)
+
+// ParseMembersJSON reads and parses a list of members from a file.
+func ParseMembersJSON(cdc *codec.Codec, membersFile string) (types.Members, error) {
+	var members types.Members
+
+	contents, err := ioutil.ReadFile(membersFile)
+	if err != nil {
+		return members, err
+	}
+
+	if err := cdc.UnmarshalJSON(contents, &members); err != nil {
+		return members, err
+	}
+
+	return members, nil
+}
+
+// decisionPolicyFromFlags returns the decision policy given by the threshold
+// or percentage flag, along with the timeout flag.
+func decisionPolicyFromFlags() (types.DecisionPolicy, error) {
+	timeout, err := time.ParseDuration(viper.GetString(FlagTimeout))
+	if err != nil {
+		return nil, err
+	}
+
+	threshold, percentage := viper.GetString(FlagThreshold), viper.GetString(FlagPercentage)
+	switch {
+	case threshold != "" && percentage != "":
+		return nil, fmt.Errorf("only one of --%s and --%s can be set", FlagThreshold, FlagPercentage)
+
+	case threshold != "":
+		dec, err := sdk.NewDecFromStr(threshold)
+		if err != nil {
+			return nil, err
+		}
+		return types.NewThresholdDecisionPolicy(dec, timeout), nil
+
+	case percentage != "":
+		dec, err := sdk.NewDecFromStr(percentage)
+		if err != nil {
+			return nil, err
+		}
+		return types.NewPercentageDecisionPolicy(dec, timeout), nil
+
+	default:
+		return nil, fmt.Errorf("one of --%s and --%s must be set", FlagThreshold, FlagPercentage)
+	}
+}
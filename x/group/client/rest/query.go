@@ -0,0 +1,118 @@
+package rest
+
+import (
+	"fmt"
+	"net/http"
+	"strconv"
+
+	"github.com/gorilla/mux"
+
+	"github.com/cosmos/cosmos-sdk/client/context"
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/rest"
+	"github.com/cosmos/cosmos-sdk/x/group/types"
+)
+
+func registerQueryRoutes(cliCtx context.CLIContext, r *mux.Router, cdc *codec.Codec) {
+	// Query a group
+	r.HandleFunc(
+		"/group/groups/{groupID}",
+		groupIDHandlerFn(cdc, cliCtx, types.QueryGroup),
+	).Methods("GET")
+
+	// Query the members of a group
+	r.HandleFunc(
+		"/group/groups/{groupID}/members",
+		groupIDHandlerFn(cdc, cliCtx, types.QueryGroupMembers),
+	).Methods("GET")
+
+	// Query the group policy accounts of a group
+	r.HandleFunc(
+		"/group/groups/{groupID}/policies",
+		groupIDHandlerFn(cdc, cliCtx, types.QueryGroupPolicies),
+	).Methods("GET")
+
+	// Query a group policy account
+	r.HandleFunc(
+		"/group/policies/{address}",
+		groupPolicyHandlerFn(cdc, cliCtx, types.QueryGroupPolicy),
+	).Methods("GET")
+
+	// Query the proposals of a group policy account
+	r.HandleFunc(
+		"/group/policies/{address}/proposals",
+		groupPolicyHandlerFn(cdc, cliCtx, types.QueryProposals),
+	).Methods("GET")
+
+	// Query a group proposal
+	r.HandleFunc(
+		"/group/proposals/{proposalID}",
+		proposalIDHandlerFn(cdc, cliCtx, types.QueryProposal),
+	).Methods("GET")
+
+	// Query the votes on a group proposal
+	r.HandleFunc(
+		"/group/proposals/{proposalID}/votes",
+		proposalIDHandlerFn(cdc, cliCtx, types.QueryVotes),
+	).Methods("GET")
+}
+
+// HTTP request handler to query a group endpoint taking a group ID
+func groupIDHandlerFn(cdc *codec.Codec, cliCtx context.CLIContext, endpoint string) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		groupID, err := strconv.ParseUint(mux.Vars(r)["groupID"], 10, 64)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		queryWithParams(w, cdc, cliCtx, endpoint, types.NewQueryGroupParams(groupID))
+	}
+}
+
+// HTTP request handler to query a group endpoint taking a group policy account
+// address
+func groupPolicyHandlerFn(cdc *codec.Codec, cliCtx context.CLIContext, endpoint string) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		address, err := sdk.AccAddressFromBech32(mux.Vars(r)["address"])
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		queryWithParams(w, cdc, cliCtx, endpoint, types.NewQueryGroupPolicyParams(address))
+	}
+}
+
+// HTTP request handler to query a group endpoint taking a proposal ID
+func proposalIDHandlerFn(cdc *codec.Codec, cliCtx context.CLIContext, endpoint string) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		proposalID, err := strconv.ParseUint(mux.Vars(r)["proposalID"], 10, 64)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		queryWithParams(w, cdc, cliCtx, endpoint, types.NewQueryProposalParams(proposalID))
+	}
+}
+
+func queryWithParams(w http.ResponseWriter, cdc *codec.Codec, cliCtx context.CLIContext, endpoint string,
+	params interface{}) {
+
+	bz, err := cdc.MarshalJSON(params)
+	if err != nil {
+		rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	route := fmt.Sprintf("custom/%s/%s", types.QuerierRoute, endpoint)
+	res, err := cliCtx.QueryWithData(route, bz)
+	if err != nil {
+		rest.WriteErrorResponse(w, http.StatusInternalServerError, err.Error())
+		return
+	}
+
+	rest.PostProcessResponse(w, cdc, res, cliCtx.Indent)
+}
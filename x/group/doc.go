@@ -0,0 +1,30 @@
+/*
+Package group provides on-chain multisig accounts, whose members can be updated
+without changing their address, as an alternative to the offline multisig keys
+of x/auth.
+
+A group is a set of members with voting weights, administered by an admin
+account through MsgCreateGroup, MsgUpdateGroupMembers and MsgUpdateGroupAdmin.
+A member is removed from a group by updating its weight to zero.
+
+A group policy account is an account of a group, created with a decision policy
+through MsgCreateGroupPolicy, whose address is derived from its ID so that no
+private key exists for it. Two decision policies are provided:
+
+  - ThresholdDecisionPolicy accepts a proposal once the weight of its yes votes
+    reaches a threshold.
+  - PercentageDecisionPolicy accepts a proposal once the weight of its yes votes
+    reaches a percentage of the total weight of the group.
+
+Members of the group submit proposals to execute messages on behalf of a group
+policy account through MsgSubmitProposal, and vote on them through MsgVote
+until the timeout of the decision policy. Once the decision policy reached a
+final decision, anyone can finalize the proposal through MsgExec, which
+dispatches the messages of an accepted proposal through the application router
+as if the group policy account had signed them.
+
+Updating the members of a group or the decision policy of a group policy account
+aborts the proposals submitted before the update, as their votes were cast
+under different rules.
+*/
+package group
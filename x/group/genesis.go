@@ -0,0 +1,72 @@
+package group
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// InitGenesis stores the groups, group policy accounts, proposals and votes of
+// the genesis state. The group policy accounts themselves are created by the
+// accounts genesis.
+func InitGenesis(ctx sdk.Context, k Keeper, data GenesisState) {
+	k.SetNextID(ctx, GroupIDKey, data.NextGroupID)
+	k.SetNextID(ctx, GroupPolicyIDKey, data.NextGroupPolicyID)
+	k.SetNextID(ctx, ProposalIDKey, data.NextProposalID)
+
+	for _, group := range data.Groups {
+		k.SetGroup(ctx, group)
+	}
+	for _, member := range data.GroupMembers {
+		k.SetGroupMember(ctx, member)
+	}
+	for _, info := range data.GroupPolicies {
+		k.SetGroupPolicy(ctx, info)
+	}
+	for _, proposal := range data.Proposals {
+		k.SetProposal(ctx, proposal)
+	}
+	for _, vote := range data.Votes {
+		k.SetVote(ctx, vote)
+	}
+}
+
+// ExportGenesis returns a GenesisState for a given context and keeper.
+func ExportGenesis(ctx sdk.Context, k Keeper) GenesisState {
+	groups := []GroupInfo{}
+	k.IterateAllGroups(ctx, func(group GroupInfo) bool {
+		groups = append(groups, group)
+		return false
+	})
+
+	members := []GroupMember{}
+	k.IterateAllGroupMembers(ctx, func(member GroupMember) bool {
+		members = append(members, member)
+		return false
+	})
+
+	policies := []GroupPolicyInfo{}
+	k.IterateAllGroupPolicies(ctx, func(info GroupPolicyInfo) bool {
+		policies = append(policies, info)
+		return false
+	})
+
+	proposals := []Proposal{}
+	k.IterateAllProposals(ctx, func(proposal Proposal) bool {
+		proposals = append(proposals, proposal)
+		return false
+	})
+
+	votes := []Vote{}
+	k.IterateAllVotes(ctx, func(vote Vote) bool {
+		votes = append(votes, vote)
+		return false
+	})
+
+	return NewGenesisState(k.GetNextID(ctx, GroupIDKey), groups, members,
+		k.GetNextID(ctx, GroupPolicyIDKey), policies, k.GetNextID(ctx, ProposalIDKey), proposals, votes)
+}
+
+// ValidateGenesis performs basic validation of group genesis data returning an
+// error for any failed validation criteria.
+func ValidateGenesis(data GenesisState) error {
+	return data.ValidateBasic()
+}
@@ -0,0 +1,145 @@
+package group
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// NewHandler returns a handler for group messages
+func NewHandler(k Keeper) sdk.Handler {
+	return func(ctx sdk.Context, msg sdk.Msg) sdk.Result {
+		switch msg := msg.(type) {
+		case MsgCreateGroup:
+			return handleMsgCreateGroup(ctx, k, msg)
+
+		case MsgUpdateGroupMembers:
+			return handleMsgUpdateGroupMembers(ctx, k, msg)
+
+		case MsgUpdateGroupAdmin:
+			return handleMsgUpdateGroupAdmin(ctx, k, msg)
+
+		case MsgCreateGroupPolicy:
+			return handleMsgCreateGroupPolicy(ctx, k, msg)
+
+		case MsgUpdateGroupPolicy:
+			return handleMsgUpdateGroupPolicy(ctx, k, msg)
+
+		case MsgSubmitProposal:
+			return handleMsgSubmitProposal(ctx, k, msg)
+
+		case MsgVote:
+			return handleMsgVote(ctx, k, msg)
+
+		case MsgExec:
+			return handleMsgExec(ctx, k, msg)
+
+		default:
+			errMsg := fmt.Sprintf("unrecognized group message type: %T", msg)
+			return sdk.ErrUnknownRequest(errMsg).Result()
+		}
+	}
+}
+
+func handleMsgCreateGroup(ctx sdk.Context, k Keeper, msg MsgCreateGroup) sdk.Result {
+	groupID, err := k.CreateGroup(ctx, msg.Admin, msg.Members, msg.Metadata)
+	if err != nil {
+		return err.Result()
+	}
+
+	emitMessageEvent(ctx, msg.Admin)
+
+	return sdk.Result{
+		Data:   ModuleCdc.MustMarshalBinaryLengthPrefixed(groupID),
+		Events: ctx.EventManager().Events(),
+	}
+}
+
+func handleMsgUpdateGroupMembers(ctx sdk.Context, k Keeper, msg MsgUpdateGroupMembers) sdk.Result {
+	if err := k.UpdateGroupMembers(ctx, msg.Admin, msg.GroupID, msg.MemberUpdates); err != nil {
+		return err.Result()
+	}
+
+	emitMessageEvent(ctx, msg.Admin)
+
+	return sdk.Result{Events: ctx.EventManager().Events()}
+}
+
+func handleMsgUpdateGroupAdmin(ctx sdk.Context, k Keeper, msg MsgUpdateGroupAdmin) sdk.Result {
+	if err := k.UpdateGroupAdmin(ctx, msg.Admin, msg.GroupID, msg.NewAdmin); err != nil {
+		return err.Result()
+	}
+
+	emitMessageEvent(ctx, msg.Admin)
+
+	return sdk.Result{Events: ctx.EventManager().Events()}
+}
+
+func handleMsgCreateGroupPolicy(ctx sdk.Context, k Keeper, msg MsgCreateGroupPolicy) sdk.Result {
+	address, err := k.CreateGroupPolicy(ctx, msg.Admin, msg.GroupID, msg.Metadata, msg.DecisionPolicy)
+	if err != nil {
+		return err.Result()
+	}
+
+	emitMessageEvent(ctx, msg.Admin)
+
+	return sdk.Result{
+		Data:   address.Bytes(),
+		Events: ctx.EventManager().Events(),
+	}
+}
+
+func handleMsgUpdateGroupPolicy(ctx sdk.Context, k Keeper, msg MsgUpdateGroupPolicy) sdk.Result {
+	if err := k.UpdateGroupPolicy(ctx, msg.Admin, msg.Address, msg.DecisionPolicy); err != nil {
+		return err.Result()
+	}
+
+	emitMessageEvent(ctx, msg.Admin)
+
+	return sdk.Result{Events: ctx.EventManager().Events()}
+}
+
+func handleMsgSubmitProposal(ctx sdk.Context, k Keeper, msg MsgSubmitProposal) sdk.Result {
+	proposalID, err := k.SubmitProposal(ctx, msg.Address, msg.Proposers, msg.Metadata, msg.Msgs)
+	if err != nil {
+		return err.Result()
+	}
+
+	emitMessageEvent(ctx, msg.Proposers[0])
+
+	return sdk.Result{
+		Data:   ModuleCdc.MustMarshalBinaryLengthPrefixed(proposalID),
+		Events: ctx.EventManager().Events(),
+	}
+}
+
+func handleMsgVote(ctx sdk.Context, k Keeper, msg MsgVote) sdk.Result {
+	if err := k.Vote(ctx, msg.ProposalID, msg.Voter, msg.Choice, msg.Metadata); err != nil {
+		return err.Result()
+	}
+
+	emitMessageEvent(ctx, msg.Voter)
+
+	return sdk.Result{Events: ctx.EventManager().Events()}
+}
+
+func handleMsgExec(ctx sdk.Context, k Keeper, msg MsgExec) sdk.Result {
+	res := k.Exec(ctx, msg.ProposalID)
+	if !res.IsOK() {
+		return res
+	}
+
+	emitMessageEvent(ctx, msg.Signer)
+
+	return sdk.Result{Data: res.Data, Events: ctx.EventManager().Events().AppendEvents(res.Events)}
+}
+
+func emitMessageEvent(ctx sdk.Context, sender sdk.AccAddress) {
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, sender.String()),
+		),
+	)
+}
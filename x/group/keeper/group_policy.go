@@ -0,0 +1,135 @@
+package keeper
+
+import (
+	"fmt"
+	"strconv"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/group/types"
+)
+
+// CreateGroupPolicy creates a group policy account for the group with the given
+// decision policy, and returns its address. The admin of the group becomes the
+// admin of the account.
+func (k Keeper) CreateGroupPolicy(ctx sdk.Context, admin sdk.AccAddress, groupID uint64, metadata string,
+	policy types.DecisionPolicy) (sdk.AccAddress, sdk.Error) {
+
+	if _, err := k.getGroupByAdmin(ctx, admin, groupID); err != nil {
+		return nil, err
+	}
+
+	if err := policy.ValidateBasic(); err != nil {
+		return nil, err
+	}
+
+	// the address of the next ID may already be used by an account, for
+	// instance if coins were sent to it ahead of time, in which case the ID is
+	// skipped so that the creation can't be blocked
+	address := types.NewGroupPolicyAddress(k.getNextID(ctx, types.GroupPolicyIDKey))
+	for k.ak.GetAccount(ctx, address) != nil {
+		address = types.NewGroupPolicyAddress(k.getNextID(ctx, types.GroupPolicyIDKey))
+	}
+
+	// the account has no public key, so that it can only be used through the
+	// proposals of the group
+	k.ak.SetAccount(ctx, k.ak.NewAccountWithAddress(ctx, address))
+	k.SetGroupPolicy(ctx, types.NewGroupPolicyInfo(address, groupID, admin, metadata, 1, policy))
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeCreateGroupPolicy,
+			sdk.NewAttribute(types.AttributeKeyGroupID, strconv.FormatUint(groupID, 10)),
+			sdk.NewAttribute(types.AttributeKeyAddress, address.String()),
+		),
+	)
+
+	return address, nil
+}
+
+// UpdateGroupPolicy replaces the decision policy of the group policy account.
+// The version of the account is incremented, so that the proposals submitted
+// under the previous decision policy can no longer pass.
+func (k Keeper) UpdateGroupPolicy(ctx sdk.Context, admin, address sdk.AccAddress,
+	policy types.DecisionPolicy) sdk.Error {
+
+	info, found := k.GetGroupPolicy(ctx, address)
+	if !found {
+		return types.ErrUnknownGroupPolicy(types.DefaultCodespace, address)
+	}
+	if !info.Admin.Equals(admin) {
+		return types.ErrUnauthorized(types.DefaultCodespace,
+			fmt.Sprintf("%s is not the admin of group policy account %s", admin, address))
+	}
+
+	if err := policy.ValidateBasic(); err != nil {
+		return err
+	}
+
+	info.DecisionPolicy = policy
+	info.Version++
+	k.SetGroupPolicy(ctx, info)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeUpdateGroupPolicy,
+			sdk.NewAttribute(types.AttributeKeyAddress, address.String()),
+		),
+	)
+
+	return nil
+}
+
+// GetGroupPolicy returns the group policy account with the given address.
+func (k Keeper) GetGroupPolicy(ctx sdk.Context, address sdk.AccAddress) (info types.GroupPolicyInfo, found bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.GroupPolicyKey(address))
+	if bz == nil {
+		return info, false
+	}
+
+	k.cdc.MustUnmarshalBinaryLengthPrefixed(bz, &info)
+	return info, true
+}
+
+// SetGroupPolicy stores the group policy account, and indexes it by group.
+func (k Keeper) SetGroupPolicy(ctx sdk.Context, info types.GroupPolicyInfo) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.GroupPolicyKey(info.Address), k.cdc.MustMarshalBinaryLengthPrefixed(info))
+	store.Set(types.GroupPolicyByGroupKey(info.GroupID, info.Address), []byte{})
+}
+
+// IterateGroupPolicies iterates over all the group policy accounts of the
+// group and calls cb with each of them. The iteration stops if cb returns true.
+func (k Keeper) IterateGroupPolicies(ctx sdk.Context, groupID uint64, cb func(info types.GroupPolicyInfo) (stop bool)) {
+	store := ctx.KVStore(k.storeKey)
+	prefix := types.GroupPoliciesByGroupKey(groupID)
+	iterator := sdk.KVStorePrefixIterator(store, prefix)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		address := sdk.AccAddress(iterator.Key()[len(prefix):])
+		info, found := k.GetGroupPolicy(ctx, address)
+		if !found {
+			panic(fmt.Sprintf("indexed group policy account %s not found", address))
+		}
+		if cb(info) {
+			break
+		}
+	}
+}
+
+// IterateAllGroupPolicies iterates over all the group policy accounts and calls
+// cb with each of them. The iteration stops if cb returns true.
+func (k Keeper) IterateAllGroupPolicies(ctx sdk.Context, cb func(info types.GroupPolicyInfo) (stop bool)) {
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, types.GroupPolicyPrefix)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var info types.GroupPolicyInfo
+		k.cdc.MustUnmarshalBinaryLengthPrefixed(iterator.Value(), &info)
+		if cb(info) {
+			break
+		}
+	}
+}
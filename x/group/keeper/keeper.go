@@ -0,0 +1,261 @@
+package keeper
+
+import (
+	"fmt"
+	"strconv"
+
+	"github.com/tendermint/tendermint/libs/log"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/group/types"
+)
+
+// Keeper manages groups, their group policy accounts and the proposals
+// submitted to them, and executes the messages of the accepted proposals.
+type Keeper struct {
+	cdc      *codec.Codec
+	storeKey sdk.StoreKey
+	ak       types.AccountKeeper
+	router   sdk.Router
+}
+
+// NewKeeper creates a new group Keeper instance. The router is used to
+// dispatch the messages of accepted proposals to their handlers.
+func NewKeeper(cdc *codec.Codec, storeKey sdk.StoreKey, ak types.AccountKeeper, router sdk.Router) Keeper {
+	return Keeper{
+		cdc:      cdc,
+		storeKey: storeKey,
+		ak:       ak,
+		router:   router,
+	}
+}
+
+// Logger returns a module-specific logger.
+func (k Keeper) Logger(ctx sdk.Context) log.Logger {
+	return ctx.Logger().With("module", fmt.Sprintf("x/%s", types.ModuleName))
+}
+
+// CreateGroup creates a new group administered by admin with the given
+// members, and returns its ID.
+func (k Keeper) CreateGroup(ctx sdk.Context, admin sdk.AccAddress, members types.Members,
+	metadata string) (uint64, sdk.Error) {
+
+	if err := members.ValidateBasic(); err != nil {
+		return 0, err
+	}
+
+	groupID := k.getNextID(ctx, types.GroupIDKey)
+	totalWeight := sdk.ZeroDec()
+	for _, m := range members {
+		if !m.Weight.IsPositive() {
+			return 0, types.ErrInvalidMembers(types.DefaultCodespace,
+				fmt.Sprintf("weight of %s must be positive", m.Address))
+		}
+
+		k.SetGroupMember(ctx, types.NewGroupMember(groupID, m))
+		totalWeight = totalWeight.Add(m.Weight)
+	}
+
+	k.SetGroup(ctx, types.NewGroupInfo(groupID, admin, metadata, 1, totalWeight))
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeCreateGroup,
+			sdk.NewAttribute(types.AttributeKeyGroupID, strconv.FormatUint(groupID, 10)),
+			sdk.NewAttribute(types.AttributeKeyAdmin, admin.String()),
+		),
+	)
+
+	return groupID, nil
+}
+
+// UpdateGroupMembers adds, updates or removes members of the group, a member
+// with a zero weight being removed. The version of the group is incremented,
+// so that the proposals submitted for the previous members can no longer pass.
+func (k Keeper) UpdateGroupMembers(ctx sdk.Context, admin sdk.AccAddress, groupID uint64,
+	updates types.Members) sdk.Error {
+
+	group, err := k.getGroupByAdmin(ctx, admin, groupID)
+	if err != nil {
+		return err
+	}
+
+	if err := updates.ValidateBasic(); err != nil {
+		return err
+	}
+
+	totalWeight := group.TotalWeight
+	for _, m := range updates {
+		if prev, found := k.GetGroupMember(ctx, groupID, m.Address); found {
+			totalWeight = totalWeight.Sub(prev.Member.Weight)
+		} else if m.Weight.IsZero() {
+			return types.ErrNotMember(types.DefaultCodespace, groupID, m.Address)
+		}
+
+		if m.Weight.IsZero() {
+			ctx.KVStore(k.storeKey).Delete(types.GroupMemberKey(groupID, m.Address))
+			continue
+		}
+
+		k.SetGroupMember(ctx, types.NewGroupMember(groupID, m))
+		totalWeight = totalWeight.Add(m.Weight)
+	}
+
+	group.TotalWeight = totalWeight
+	group.Version++
+	k.SetGroup(ctx, group)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeUpdateGroupMembers,
+			sdk.NewAttribute(types.AttributeKeyGroupID, strconv.FormatUint(groupID, 10)),
+		),
+	)
+
+	return nil
+}
+
+// UpdateGroupAdmin transfers the administration of the group to newAdmin.
+func (k Keeper) UpdateGroupAdmin(ctx sdk.Context, admin sdk.AccAddress, groupID uint64,
+	newAdmin sdk.AccAddress) sdk.Error {
+
+	group, err := k.getGroupByAdmin(ctx, admin, groupID)
+	if err != nil {
+		return err
+	}
+
+	group.Admin = newAdmin
+	k.SetGroup(ctx, group)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeUpdateGroupAdmin,
+			sdk.NewAttribute(types.AttributeKeyGroupID, strconv.FormatUint(groupID, 10)),
+			sdk.NewAttribute(types.AttributeKeyAdmin, newAdmin.String()),
+		),
+	)
+
+	return nil
+}
+
+// GetGroup returns the group with the given ID.
+func (k Keeper) GetGroup(ctx sdk.Context, groupID uint64) (group types.GroupInfo, found bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.GroupKey(groupID))
+	if bz == nil {
+		return group, false
+	}
+
+	k.cdc.MustUnmarshalBinaryLengthPrefixed(bz, &group)
+	return group, true
+}
+
+// GetGroupMember returns the member of the group with the given address.
+func (k Keeper) GetGroupMember(ctx sdk.Context, groupID uint64,
+	address sdk.AccAddress) (member types.GroupMember, found bool) {
+
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.GroupMemberKey(groupID, address))
+	if bz == nil {
+		return member, false
+	}
+
+	k.cdc.MustUnmarshalBinaryLengthPrefixed(bz, &member)
+	return member, true
+}
+
+// IterateAllGroups iterates over all the groups and calls cb with each of
+// them. The iteration stops if cb returns true.
+func (k Keeper) IterateAllGroups(ctx sdk.Context, cb func(group types.GroupInfo) (stop bool)) {
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, types.GroupKeyPrefix)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var group types.GroupInfo
+		k.cdc.MustUnmarshalBinaryLengthPrefixed(iterator.Value(), &group)
+		if cb(group) {
+			break
+		}
+	}
+}
+
+// IterateGroupMembers iterates over all the members of the group and calls cb
+// with each of them. The iteration stops if cb returns true.
+func (k Keeper) IterateGroupMembers(ctx sdk.Context, groupID uint64, cb func(member types.GroupMember) (stop bool)) {
+	k.iterateGroupMembers(ctx, types.GroupMembersKey(groupID), cb)
+}
+
+// IterateAllGroupMembers iterates over the members of all the groups and calls
+// cb with each of them. The iteration stops if cb returns true.
+func (k Keeper) IterateAllGroupMembers(ctx sdk.Context, cb func(member types.GroupMember) (stop bool)) {
+	k.iterateGroupMembers(ctx, types.GroupMemberPrefix, cb)
+}
+
+// getGroupByAdmin returns the group with the given ID, provided that it is
+// administered by admin.
+func (k Keeper) getGroupByAdmin(ctx sdk.Context, admin sdk.AccAddress, groupID uint64) (types.GroupInfo, sdk.Error) {
+	group, found := k.GetGroup(ctx, groupID)
+	if !found {
+		return group, types.ErrUnknownGroup(types.DefaultCodespace, groupID)
+	}
+	if !group.Admin.Equals(admin) {
+		return group, types.ErrUnauthorized(types.DefaultCodespace,
+			fmt.Sprintf("%s is not the admin of group %d", admin, groupID))
+	}
+	return group, nil
+}
+
+// SetGroup stores the group.
+func (k Keeper) SetGroup(ctx sdk.Context, group types.GroupInfo) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.GroupKey(group.ID), k.cdc.MustMarshalBinaryLengthPrefixed(group))
+}
+
+// SetGroupMember stores the member of a group.
+func (k Keeper) SetGroupMember(ctx sdk.Context, member types.GroupMember) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.GroupMemberKey(member.GroupID, member.Member.Address), k.cdc.MustMarshalBinaryLengthPrefixed(member))
+}
+
+func (k Keeper) iterateGroupMembers(ctx sdk.Context, prefix []byte, cb func(member types.GroupMember) (stop bool)) {
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, prefix)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var member types.GroupMember
+		k.cdc.MustUnmarshalBinaryLengthPrefixed(iterator.Value(), &member)
+		if cb(member) {
+			break
+		}
+	}
+}
+
+// GetNextID returns the next ID stored under the given key, which is one if
+// no ID was assigned yet.
+func (k Keeper) GetNextID(ctx sdk.Context, key []byte) uint64 {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(key)
+	if bz == nil {
+		return 1
+	}
+
+	var id uint64
+	k.cdc.MustUnmarshalBinaryLengthPrefixed(bz, &id)
+	return id
+}
+
+// SetNextID sets the next ID to assign under the given key.
+func (k Keeper) SetNextID(ctx sdk.Context, key []byte, id uint64) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(key, k.cdc.MustMarshalBinaryLengthPrefixed(id))
+}
+
+// getNextID returns the next ID stored under the given key and increments it.
+func (k Keeper) getNextID(ctx sdk.Context, key []byte) uint64 {
+	id := k.GetNextID(ctx, key)
+	k.SetNextID(ctx, key, id+1)
+	return id
+}
@@ -0,0 +1,284 @@
+package keeper
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	abci "github.com/tendermint/tendermint/abci/types"
+	"github.com/tendermint/tendermint/crypto/ed25519"
+	dbm "github.com/tendermint/tendermint/libs/db"
+	"github.com/tendermint/tendermint/libs/log"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/store"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/auth"
+	"github.com/cosmos/cosmos-sdk/x/bank"
+	"github.com/cosmos/cosmos-sdk/x/group/types"
+	"github.com/cosmos/cosmos-sdk/x/params"
+)
+
+var (
+	adminAddr     = sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address())
+	member1Addr   = sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address())
+	member2Addr   = sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address())
+	member3Addr   = sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address())
+	recipientAddr = sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address())
+
+	initCoins = sdk.NewCoins(sdk.NewInt64Coin("stake", 1000))
+	sendCoins = sdk.NewCoins(sdk.NewInt64Coin("stake", 100))
+)
+
+func createTestInput(t *testing.T) (sdk.Context, bank.Keeper, Keeper) {
+	keyAcc := sdk.NewKVStoreKey(auth.StoreKey)
+	keyParams := sdk.NewKVStoreKey(params.StoreKey)
+	tkeyParams := sdk.NewTransientStoreKey(params.TStoreKey)
+	keyBank := sdk.NewKVStoreKey(bank.StoreKey)
+	keyGroup := sdk.NewKVStoreKey(types.StoreKey)
+
+	db := dbm.NewMemDB()
+	ms := store.NewCommitMultiStore(db)
+	ms.MountStoreWithDB(keyAcc, sdk.StoreTypeIAVL, db)
+	ms.MountStoreWithDB(keyBank, sdk.StoreTypeIAVL, db)
+	ms.MountStoreWithDB(keyParams, sdk.StoreTypeIAVL, db)
+	ms.MountStoreWithDB(tkeyParams, sdk.StoreTypeTransient, db)
+	ms.MountStoreWithDB(keyGroup, sdk.StoreTypeIAVL, db)
+	require.NoError(t, ms.LoadLatestVersion())
+
+	cdc := codec.New()
+	sdk.RegisterCodec(cdc)
+	auth.RegisterCodec(cdc)
+	bank.RegisterCodec(cdc)
+	types.RegisterCodec(cdc)
+	codec.RegisterCrypto(cdc)
+
+	ctx := sdk.NewContext(ms, abci.Header{ChainID: "group-chain", Height: 1, Time: time.Now()}, false, log.NewNopLogger())
+	pk := params.NewKeeper(cdc, keyParams, tkeyParams, params.DefaultCodespace)
+	ak := auth.NewAccountKeeper(cdc, keyAcc, pk.Subspace(auth.DefaultParamspace), auth.ProtoBaseAccount)
+	bk := bank.NewBaseKeeper(cdc, keyBank, ak, pk.Subspace(bank.DefaultParamspace), bank.DefaultCodespace, make(map[string]bool))
+	bk.SetParams(ctx, bank.DefaultParams())
+
+	router := baseapp.NewRouter()
+	router.AddRoute(bank.RouterKey, bank.NewHandler(bk))
+
+	return ctx, bk, NewKeeper(cdc, keyGroup, ak, router)
+}
+
+// createTestGroupPolicy creates a group of three members of weight one and a
+// group policy account funded with initCoins.
+func createTestGroupPolicy(t *testing.T, ctx sdk.Context, bk bank.Keeper, k Keeper,
+	policy types.DecisionPolicy) (uint64, sdk.AccAddress) {
+
+	members := types.Members{
+		types.NewMember(member1Addr, sdk.OneDec(), ""),
+		types.NewMember(member2Addr, sdk.OneDec(), ""),
+		types.NewMember(member3Addr, sdk.OneDec(), ""),
+	}
+	groupID, err := k.CreateGroup(ctx, adminAddr, members, "")
+	require.NoError(t, err)
+
+	address, err := k.CreateGroupPolicy(ctx, adminAddr, groupID, "", policy)
+	require.NoError(t, err)
+	require.NoError(t, bk.SetCoins(ctx, address, initCoins))
+
+	return groupID, address
+}
+
+func TestKeeperUpdateGroupMembers(t *testing.T) {
+	ctx, bk, k := createTestInput(t)
+	policy := types.NewThresholdDecisionPolicy(sdk.NewDec(2), time.Hour)
+	groupID, address := createTestGroupPolicy(t, ctx, bk, k, policy)
+
+	group, found := k.GetGroup(ctx, groupID)
+	require.True(t, found)
+	require.Equal(t, sdk.NewDec(3), group.TotalWeight)
+	require.Equal(t, uint64(1), group.Version)
+
+	// only the admin can update the members
+	updates := types.Members{types.NewMember(member1Addr, sdk.NewDec(3), "")}
+	require.Error(t, k.UpdateGroupMembers(ctx, member1Addr, groupID, updates))
+
+	// members are updated, removed and added without changing the address of
+	// the group policy account
+	updates = types.Members{
+		types.NewMember(member1Addr, sdk.NewDec(3), ""),
+		types.NewMember(member2Addr, sdk.ZeroDec(), ""),
+		types.NewMember(recipientAddr, sdk.NewDec(2), ""),
+	}
+	require.NoError(t, k.UpdateGroupMembers(ctx, adminAddr, groupID, updates))
+
+	group, _ = k.GetGroup(ctx, groupID)
+	require.Equal(t, sdk.NewDec(6), group.TotalWeight)
+	require.Equal(t, uint64(2), group.Version)
+
+	_, found = k.GetGroupMember(ctx, groupID, member2Addr)
+	require.False(t, found)
+	member, found := k.GetGroupMember(ctx, groupID, member1Addr)
+	require.True(t, found)
+	require.Equal(t, sdk.NewDec(3), member.Member.Weight)
+
+	info, found := k.GetGroupPolicy(ctx, address)
+	require.True(t, found)
+	require.Equal(t, groupID, info.GroupID)
+
+	// removing a member which is not in the group fails
+	updates = types.Members{types.NewMember(member2Addr, sdk.ZeroDec(), "")}
+	require.Error(t, k.UpdateGroupMembers(ctx, adminAddr, groupID, updates))
+}
+
+func TestKeeperCreateGroupPolicyAddrTaken(t *testing.T) {
+	ctx, bk, k := createTestInput(t)
+	groupID, err := k.CreateGroup(ctx, adminAddr, types.Members{types.NewMember(member1Addr, sdk.OneDec(), "")}, "")
+	require.NoError(t, err)
+
+	// an account is created at the address of the next group policy account by
+	// sending coins to it
+	nextID := k.GetNextID(ctx, types.GroupPolicyIDKey)
+	takenAddr := types.NewGroupPolicyAddress(nextID)
+	require.NoError(t, bk.SetCoins(ctx, takenAddr, initCoins))
+
+	// the taken address is skipped
+	policy := types.NewThresholdDecisionPolicy(sdk.OneDec(), time.Hour)
+	address, err := k.CreateGroupPolicy(ctx, adminAddr, groupID, "", policy)
+	require.NoError(t, err)
+	require.Equal(t, types.NewGroupPolicyAddress(nextID+1), address)
+	require.Equal(t, nextID+2, k.GetNextID(ctx, types.GroupPolicyIDKey))
+
+	_, found := k.GetGroupPolicy(ctx, takenAddr)
+	require.False(t, found)
+	_, found = k.GetGroupPolicy(ctx, address)
+	require.True(t, found)
+	require.Equal(t, initCoins, bk.GetCoins(ctx, takenAddr))
+}
+
+func TestKeeperThresholdProposal(t *testing.T) {
+	ctx, bk, k := createTestInput(t)
+	policy := types.NewThresholdDecisionPolicy(sdk.NewDec(2), time.Hour)
+	_, address := createTestGroupPolicy(t, ctx, bk, k, policy)
+
+	msgs := []sdk.Msg{bank.NewMsgSend(address, recipientAddr, sendCoins)}
+
+	// only members can submit proposals
+	_, err := k.SubmitProposal(ctx, address, []sdk.AccAddress{recipientAddr}, "", msgs)
+	require.Error(t, err)
+
+	// messages must be signed by the group policy account
+	badMsgs := []sdk.Msg{bank.NewMsgSend(member1Addr, recipientAddr, sendCoins)}
+	_, err = k.SubmitProposal(ctx, address, []sdk.AccAddress{member1Addr}, "", badMsgs)
+	require.Error(t, err)
+
+	proposalID, err := k.SubmitProposal(ctx, address, []sdk.AccAddress{member1Addr}, "", msgs)
+	require.NoError(t, err)
+
+	require.NoError(t, k.Vote(ctx, proposalID, member1Addr, types.ChoiceYes, ""))
+	require.Error(t, k.Vote(ctx, proposalID, member1Addr, types.ChoiceNo, ""))
+	require.Error(t, k.Vote(ctx, proposalID, recipientAddr, types.ChoiceYes, ""))
+
+	// the threshold is not reached yet
+	res := k.Exec(ctx, proposalID)
+	require.Equal(t, types.CodeProposalNotExecutable, res.Code)
+
+	require.NoError(t, k.Vote(ctx, proposalID, member2Addr, types.ChoiceYes, ""))
+	res = k.Exec(ctx, proposalID)
+	require.True(t, res.IsOK(), res.Log)
+
+	proposal, found := k.GetProposal(ctx, proposalID)
+	require.True(t, found)
+	require.Equal(t, types.StatusAccepted, proposal.Status)
+	require.Equal(t, types.ExecutorResultSuccess, proposal.ExecutorResult)
+	require.Equal(t, initCoins.Sub(sendCoins), bk.GetCoins(ctx, address))
+	require.Equal(t, sendCoins, bk.GetCoins(ctx, recipientAddr))
+
+	// the proposal can only be executed once, and no longer accepts votes
+	res = k.Exec(ctx, proposalID)
+	require.Equal(t, types.CodeProposalNotExecutable, res.Code)
+	require.Error(t, k.Vote(ctx, proposalID, member3Addr, types.ChoiceYes, ""))
+}
+
+func TestKeeperPercentageProposalRejected(t *testing.T) {
+	ctx, bk, k := createTestInput(t)
+	policy := types.NewPercentageDecisionPolicy(sdk.NewDecWithPrec(5, 1), time.Hour)
+	_, address := createTestGroupPolicy(t, ctx, bk, k, policy)
+
+	msgs := []sdk.Msg{bank.NewMsgSend(address, recipientAddr, sendCoins)}
+	proposalID, err := k.SubmitProposal(ctx, address, []sdk.AccAddress{member1Addr}, "", msgs)
+	require.NoError(t, err)
+
+	require.NoError(t, k.Vote(ctx, proposalID, member1Addr, types.ChoiceYes, ""))
+	require.NoError(t, k.Vote(ctx, proposalID, member2Addr, types.ChoiceNo, ""))
+
+	// the decision is not final until the end of the voting period
+	res := k.Exec(ctx, proposalID)
+	require.Equal(t, types.CodeProposalNotExecutable, res.Code)
+
+	endCtx := ctx.WithBlockTime(ctx.BlockHeader().Time.Add(time.Hour))
+	require.Error(t, k.Vote(endCtx, proposalID, member3Addr, types.ChoiceYes, ""))
+
+	res = k.Exec(endCtx, proposalID)
+	require.True(t, res.IsOK(), res.Log)
+
+	proposal, _ := k.GetProposal(ctx, proposalID)
+	require.Equal(t, types.StatusRejected, proposal.Status)
+	require.Equal(t, types.ExecutorResultNotRun, proposal.ExecutorResult)
+	require.Equal(t, initCoins, bk.GetCoins(ctx, address))
+}
+
+func TestKeeperProposalAborted(t *testing.T) {
+	ctx, bk, k := createTestInput(t)
+	policy := types.NewThresholdDecisionPolicy(sdk.NewDec(2), time.Hour)
+	groupID, address := createTestGroupPolicy(t, ctx, bk, k, policy)
+
+	msgs := []sdk.Msg{bank.NewMsgSend(address, recipientAddr, sendCoins)}
+	proposalID, err := k.SubmitProposal(ctx, address, []sdk.AccAddress{member1Addr}, "", msgs)
+	require.NoError(t, err)
+	require.NoError(t, k.Vote(ctx, proposalID, member1Addr, types.ChoiceYes, ""))
+
+	// updating the members makes the votes of the previous members obsolete
+	updates := types.Members{types.NewMember(member3Addr, sdk.ZeroDec(), "")}
+	require.NoError(t, k.UpdateGroupMembers(ctx, adminAddr, groupID, updates))
+	require.Error(t, k.Vote(ctx, proposalID, member2Addr, types.ChoiceYes, ""))
+
+	res := k.Exec(ctx, proposalID)
+	require.True(t, res.IsOK(), res.Log)
+
+	proposal, _ := k.GetProposal(ctx, proposalID)
+	require.Equal(t, types.StatusAborted, proposal.Status)
+	require.Equal(t, initCoins, bk.GetCoins(ctx, address))
+}
+
+func TestKeeperProposalExecutionFailure(t *testing.T) {
+	ctx, bk, k := createTestInput(t)
+	policy := types.NewThresholdDecisionPolicy(sdk.OneDec(), time.Hour)
+	_, address := createTestGroupPolicy(t, ctx, bk, k, policy)
+
+	// the second message spends more than the remaining balance
+	msgs := []sdk.Msg{
+		bank.NewMsgSend(address, recipientAddr, sendCoins),
+		bank.NewMsgSend(address, recipientAddr, initCoins),
+	}
+	proposalID, err := k.SubmitProposal(ctx, address, []sdk.AccAddress{member1Addr}, "", msgs)
+	require.NoError(t, err)
+	require.NoError(t, k.Vote(ctx, proposalID, member1Addr, types.ChoiceYes, ""))
+
+	// the proposal is accepted, but none of its messages is executed
+	res := k.Exec(ctx, proposalID)
+	require.True(t, res.IsOK(), res.Log)
+
+	proposal, _ := k.GetProposal(ctx, proposalID)
+	require.Equal(t, types.StatusAccepted, proposal.Status)
+	require.Equal(t, types.ExecutorResultFailure, proposal.ExecutorResult)
+	require.Equal(t, initCoins, bk.GetCoins(ctx, address))
+	require.True(t, bk.GetCoins(ctx, recipientAddr).IsZero())
+
+	// the execution is retried once the account holds enough coins
+	require.NoError(t, bk.SetCoins(ctx, address, initCoins.Add(sendCoins)))
+	res = k.Exec(ctx, proposalID)
+	require.True(t, res.IsOK(), res.Log)
+
+	proposal, _ = k.GetProposal(ctx, proposalID)
+	require.Equal(t, types.ExecutorResultSuccess, proposal.ExecutorResult)
+	require.Equal(t, initCoins.Add(sendCoins), bk.GetCoins(ctx, recipientAddr))
+}
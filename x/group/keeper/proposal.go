@@ -0,0 +1,308 @@
+package keeper
+
+import (
+	"fmt"
+	"strconv"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/group/types"
+)
+
+// SubmitProposal submits a proposal to execute msgs on behalf of the group
+// policy account, and returns its ID. Every proposer must be a member of the
+// group of the account.
+func (k Keeper) SubmitProposal(ctx sdk.Context, address sdk.AccAddress, proposers []sdk.AccAddress,
+	metadata string, msgs []sdk.Msg) (uint64, sdk.Error) {
+
+	policy, found := k.GetGroupPolicy(ctx, address)
+	if !found {
+		return 0, types.ErrUnknownGroupPolicy(types.DefaultCodespace, address)
+	}
+
+	group, found := k.GetGroup(ctx, policy.GroupID)
+	if !found {
+		return 0, types.ErrUnknownGroup(types.DefaultCodespace, policy.GroupID)
+	}
+
+	for _, proposer := range proposers {
+		if _, found := k.GetGroupMember(ctx, group.ID, proposer); !found {
+			return 0, types.ErrNotMember(types.DefaultCodespace, group.ID, proposer)
+		}
+	}
+
+	for i, msg := range msgs {
+		signers := msg.GetSigners()
+		if len(signers) != 1 || !signers[0].Equals(address) {
+			return 0, types.ErrInvalidProposal(types.DefaultCodespace,
+				fmt.Sprintf("message %d must be signed by the group policy account %s only", i, address))
+		}
+		if k.router.Route(msg.Route()) == nil {
+			return 0, sdk.ErrUnknownRequest("unrecognized message type: " + msg.Route())
+		}
+	}
+
+	submitTime := ctx.BlockHeader().Time
+	proposal := types.Proposal{
+		ID:                 k.getNextID(ctx, types.ProposalIDKey),
+		Address:            address,
+		Metadata:           metadata,
+		Proposers:          proposers,
+		SubmitTime:         submitTime,
+		GroupVersion:       group.Version,
+		GroupPolicyVersion: policy.Version,
+		Status:             types.StatusSubmitted,
+		VoteState:          types.EmptyTally(),
+		VotingPeriodEnd:    submitTime.Add(policy.DecisionPolicy.GetTimeout()),
+		ExecutorResult:     types.ExecutorResultNotRun,
+		Msgs:               msgs,
+	}
+	k.SetProposal(ctx, proposal)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeSubmitGroupProposal,
+			sdk.NewAttribute(types.AttributeKeyProposalID, strconv.FormatUint(proposal.ID, 10)),
+			sdk.NewAttribute(types.AttributeKeyAddress, address.String()),
+		),
+	)
+
+	return proposal.ID, nil
+}
+
+// Vote casts the vote of a member of the group on the proposal, weighted by
+// the weight of the member. A member can only vote once, before the end of the
+// voting period.
+func (k Keeper) Vote(ctx sdk.Context, proposalID uint64, voter sdk.AccAddress, choice types.Choice,
+	metadata string) sdk.Error {
+
+	if !types.ValidChoice(choice) {
+		return types.ErrInvalidVoteChoice(types.DefaultCodespace, choice)
+	}
+
+	proposal, found := k.GetProposal(ctx, proposalID)
+	if !found {
+		return types.ErrUnknownProposal(types.DefaultCodespace, proposalID)
+	}
+
+	blockTime := ctx.BlockHeader().Time
+	if proposal.Status != types.StatusSubmitted || !blockTime.Before(proposal.VotingPeriodEnd) {
+		return types.ErrVotingClosed(types.DefaultCodespace, proposalID)
+	}
+
+	policy, group, err := k.getProposalGroup(ctx, proposal)
+	if err != nil {
+		return err
+	}
+	if proposal.GroupVersion != group.Version || proposal.GroupPolicyVersion != policy.Version {
+		return types.ErrProposalOutdated(types.DefaultCodespace, proposalID)
+	}
+
+	member, found := k.GetGroupMember(ctx, group.ID, voter)
+	if !found {
+		return types.ErrNotMember(types.DefaultCodespace, group.ID, voter)
+	}
+
+	if _, found := k.GetVote(ctx, proposalID, voter); found {
+		return types.ErrAlreadyVoted(types.DefaultCodespace, proposalID, voter)
+	}
+
+	k.SetVote(ctx, types.NewVote(proposalID, voter, choice, metadata, blockTime))
+	proposal.VoteState = proposal.VoteState.Add(choice, member.Member.Weight)
+	k.SetProposal(ctx, proposal)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeGroupVote,
+			sdk.NewAttribute(types.AttributeKeyProposalID, strconv.FormatUint(proposalID, 10)),
+			sdk.NewAttribute(types.AttributeKeyVoter, voter.String()),
+			sdk.NewAttribute(types.AttributeKeyChoice, choice.String()),
+		),
+	)
+
+	return nil
+}
+
+// Exec finalizes the proposal once its decision policy reached a final
+// decision, and executes its messages if it is accepted. A proposal whose
+// group members or decision policy changed since its submission is aborted.
+//
+// A failed execution does not revert the finalization of the proposal: its
+// executor result is set to failure and the execution may be retried.
+func (k Keeper) Exec(ctx sdk.Context, proposalID uint64) sdk.Result {
+	proposal, found := k.GetProposal(ctx, proposalID)
+	if !found {
+		return types.ErrUnknownProposal(types.DefaultCodespace, proposalID).Result()
+	}
+
+	finalized := false
+	if proposal.Status == types.StatusSubmitted {
+		if err := k.finalizeProposal(ctx, &proposal); err != nil {
+			return err.Result()
+		}
+		finalized = true
+	}
+
+	var res sdk.Result
+	switch {
+	case proposal.Status != types.StatusAccepted && !finalized:
+		return types.ErrProposalNotExecutable(types.DefaultCodespace, proposalID,
+			fmt.Sprintf("the proposal is %s", proposal.Status)).Result()
+	case proposal.Status != types.StatusAccepted:
+		// the proposal was just rejected or aborted, there is nothing to execute
+	case proposal.ExecutorResult == types.ExecutorResultSuccess:
+		return types.ErrProposalNotExecutable(types.DefaultCodespace, proposalID, "already executed").Result()
+	default:
+		// the messages are executed in a cached context, so that a failing
+		// message does not leave the state changes of the previous ones
+		cacheCtx, writeCache := ctx.CacheContext()
+		res, _ = sdk.DispatchMsgs(cacheCtx, k.router, proposal.Msgs, nil)
+		if res.IsOK() {
+			writeCache()
+			proposal.ExecutorResult = types.ExecutorResultSuccess
+		} else {
+			k.Logger(ctx).Info(fmt.Sprintf("execution of group proposal %d failed: %s", proposalID, res.Log))
+			proposal.ExecutorResult = types.ExecutorResultFailure
+		}
+	}
+
+	k.SetProposal(ctx, proposal)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeExecGroupProposal,
+			sdk.NewAttribute(types.AttributeKeyProposalID, strconv.FormatUint(proposalID, 10)),
+			sdk.NewAttribute(types.AttributeKeyStatus, proposal.Status.String()),
+			sdk.NewAttribute(types.AttributeKeyExecutorResult, proposal.ExecutorResult.String()),
+		),
+	)
+
+	if proposal.ExecutorResult != types.ExecutorResultSuccess {
+		return sdk.Result{}
+	}
+	return sdk.Result{Data: res.Data, Events: res.Events}
+}
+
+// finalizeProposal sets the final status of the submitted proposal according
+// to the decision policy of its group policy account. It returns an error if
+// the decision is not final yet.
+func (k Keeper) finalizeProposal(ctx sdk.Context, proposal *types.Proposal) sdk.Error {
+	policy, group, err := k.getProposalGroup(ctx, *proposal)
+	if err != nil {
+		return err
+	}
+
+	if proposal.GroupVersion != group.Version || proposal.GroupPolicyVersion != policy.Version {
+		proposal.Status = types.StatusAborted
+		return nil
+	}
+
+	votingEnded := !ctx.BlockHeader().Time.Before(proposal.VotingPeriodEnd)
+	result := policy.DecisionPolicy.Allow(proposal.VoteState, group.TotalWeight, votingEnded)
+	if !result.Final {
+		return types.ErrProposalNotExecutable(types.DefaultCodespace, proposal.ID,
+			"the decision policy has not reached a final decision")
+	}
+
+	if result.Allow {
+		proposal.Status = types.StatusAccepted
+	} else {
+		proposal.Status = types.StatusRejected
+	}
+	return nil
+}
+
+// getProposalGroup returns the group policy account of the proposal and its
+// group.
+func (k Keeper) getProposalGroup(ctx sdk.Context, proposal types.Proposal) (types.GroupPolicyInfo,
+	types.GroupInfo, sdk.Error) {
+
+	policy, found := k.GetGroupPolicy(ctx, proposal.Address)
+	if !found {
+		return policy, types.GroupInfo{}, types.ErrUnknownGroupPolicy(types.DefaultCodespace, proposal.Address)
+	}
+
+	group, found := k.GetGroup(ctx, policy.GroupID)
+	if !found {
+		return policy, group, types.ErrUnknownGroup(types.DefaultCodespace, policy.GroupID)
+	}
+
+	return policy, group, nil
+}
+
+// GetProposal returns the proposal with the given ID.
+func (k Keeper) GetProposal(ctx sdk.Context, proposalID uint64) (proposal types.Proposal, found bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.ProposalKey(proposalID))
+	if bz == nil {
+		return proposal, false
+	}
+
+	k.cdc.MustUnmarshalBinaryLengthPrefixed(bz, &proposal)
+	return proposal, true
+}
+
+// SetProposal stores the proposal.
+func (k Keeper) SetProposal(ctx sdk.Context, proposal types.Proposal) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.ProposalKey(proposal.ID), k.cdc.MustMarshalBinaryLengthPrefixed(proposal))
+}
+
+// IterateAllProposals iterates over all the proposals and calls cb with each
+// of them. The iteration stops if cb returns true.
+func (k Keeper) IterateAllProposals(ctx sdk.Context, cb func(proposal types.Proposal) (stop bool)) {
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, types.ProposalKeyPrefix)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var proposal types.Proposal
+		k.cdc.MustUnmarshalBinaryLengthPrefixed(iterator.Value(), &proposal)
+		if cb(proposal) {
+			break
+		}
+	}
+}
+
+// GetVote returns the vote of the voter on the proposal.
+func (k Keeper) GetVote(ctx sdk.Context, proposalID uint64, voter sdk.AccAddress) (vote types.Vote, found bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.VoteKey(proposalID, voter))
+	if bz == nil {
+		return vote, false
+	}
+
+	k.cdc.MustUnmarshalBinaryLengthPrefixed(bz, &vote)
+	return vote, true
+}
+
+// SetVote stores the vote.
+func (k Keeper) SetVote(ctx sdk.Context, vote types.Vote) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.VoteKey(vote.ProposalID, vote.Voter), k.cdc.MustMarshalBinaryLengthPrefixed(vote))
+}
+
+// IterateVotes iterates over all the votes on the proposal and calls cb with
+// each of them. The iteration stops if cb returns true.
+func (k Keeper) IterateVotes(ctx sdk.Context, proposalID uint64, cb func(vote types.Vote) (stop bool)) {
+	k.iterateVotes(ctx, types.VotesKey(proposalID), cb)
+}
+
+// IterateAllVotes iterates over the votes on all the proposals and calls cb
+// with each of them. The iteration stops if cb returns true.
+func (k Keeper) IterateAllVotes(ctx sdk.Context, cb func(vote types.Vote) (stop bool)) {
+	k.iterateVotes(ctx, types.VoteKeyPrefix, cb)
+}
+
+func (k Keeper) iterateVotes(ctx sdk.Context, prefix []byte, cb func(vote types.Vote) (stop bool)) {
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, prefix)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var vote types.Vote
+		k.cdc.MustUnmarshalBinaryLengthPrefixed(iterator.Value(), &vote)
+		if cb(vote) {
+			break
+		}
+	}
+}
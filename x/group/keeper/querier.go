@@ -0,0 +1,167 @@
+package keeper
+
+import (
+	abci "github.com/tendermint/tendermint/abci/types"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/group/types"
+)
+
+// NewQuerier creates a querier for group REST endpoints
+func NewQuerier(k Keeper) sdk.Querier {
+	return func(ctx sdk.Context, path []string, req abci.RequestQuery) ([]byte, sdk.Error) {
+		switch path[0] {
+		case types.QueryGroup:
+			return queryGroup(ctx, req, k)
+
+		case types.QueryGroupMembers:
+			return queryGroupMembers(ctx, req, k)
+
+		case types.QueryGroupPolicy:
+			return queryGroupPolicy(ctx, req, k)
+
+		case types.QueryGroupPolicies:
+			return queryGroupPolicies(ctx, req, k)
+
+		case types.QueryProposal:
+			return queryProposal(ctx, req, k)
+
+		case types.QueryProposals:
+			return queryProposals(ctx, req, k)
+
+		case types.QueryVotes:
+			return queryVotes(ctx, req, k)
+
+		default:
+			return nil, sdk.ErrUnknownRequest("unknown group query endpoint")
+		}
+	}
+}
+
+func queryGroup(ctx sdk.Context, req abci.RequestQuery, k Keeper) ([]byte, sdk.Error) {
+	var params types.QueryGroupParams
+
+	err := k.cdc.UnmarshalJSON(req.Data, &params)
+	if err != nil {
+		return nil, sdk.ErrUnknownRequest(sdk.AppendMsgToErr("incorrectly formatted request data", err.Error()))
+	}
+
+	group, found := k.GetGroup(ctx, params.GroupID)
+	if !found {
+		return nil, types.ErrUnknownGroup(types.DefaultCodespace, params.GroupID)
+	}
+
+	return marshalJSONIndent(k.cdc, group)
+}
+
+func queryGroupMembers(ctx sdk.Context, req abci.RequestQuery, k Keeper) ([]byte, sdk.Error) {
+	var params types.QueryGroupParams
+
+	err := k.cdc.UnmarshalJSON(req.Data, &params)
+	if err != nil {
+		return nil, sdk.ErrUnknownRequest(sdk.AppendMsgToErr("incorrectly formatted request data", err.Error()))
+	}
+
+	members := types.GroupMembers{}
+	k.IterateGroupMembers(ctx, params.GroupID, func(member types.GroupMember) bool {
+		members = append(members, member)
+		return false
+	})
+
+	return marshalJSONIndent(k.cdc, members)
+}
+
+func queryGroupPolicy(ctx sdk.Context, req abci.RequestQuery, k Keeper) ([]byte, sdk.Error) {
+	var params types.QueryGroupPolicyParams
+
+	err := k.cdc.UnmarshalJSON(req.Data, &params)
+	if err != nil {
+		return nil, sdk.ErrUnknownRequest(sdk.AppendMsgToErr("incorrectly formatted request data", err.Error()))
+	}
+
+	info, found := k.GetGroupPolicy(ctx, params.Address)
+	if !found {
+		return nil, types.ErrUnknownGroupPolicy(types.DefaultCodespace, params.Address)
+	}
+
+	return marshalJSONIndent(k.cdc, info)
+}
+
+func queryGroupPolicies(ctx sdk.Context, req abci.RequestQuery, k Keeper) ([]byte, sdk.Error) {
+	var params types.QueryGroupParams
+
+	err := k.cdc.UnmarshalJSON(req.Data, &params)
+	if err != nil {
+		return nil, sdk.ErrUnknownRequest(sdk.AppendMsgToErr("incorrectly formatted request data", err.Error()))
+	}
+
+	policies := types.GroupPolicyInfos{}
+	k.IterateGroupPolicies(ctx, params.GroupID, func(info types.GroupPolicyInfo) bool {
+		policies = append(policies, info)
+		return false
+	})
+
+	return marshalJSONIndent(k.cdc, policies)
+}
+
+func queryProposal(ctx sdk.Context, req abci.RequestQuery, k Keeper) ([]byte, sdk.Error) {
+	var params types.QueryProposalParams
+
+	err := k.cdc.UnmarshalJSON(req.Data, &params)
+	if err != nil {
+		return nil, sdk.ErrUnknownRequest(sdk.AppendMsgToErr("incorrectly formatted request data", err.Error()))
+	}
+
+	proposal, found := k.GetProposal(ctx, params.ProposalID)
+	if !found {
+		return nil, types.ErrUnknownProposal(types.DefaultCodespace, params.ProposalID)
+	}
+
+	return marshalJSONIndent(k.cdc, proposal)
+}
+
+func queryProposals(ctx sdk.Context, req abci.RequestQuery, k Keeper) ([]byte, sdk.Error) {
+	var params types.QueryGroupPolicyParams
+
+	err := k.cdc.UnmarshalJSON(req.Data, &params)
+	if err != nil {
+		return nil, sdk.ErrUnknownRequest(sdk.AppendMsgToErr("incorrectly formatted request data", err.Error()))
+	}
+
+	proposals := types.Proposals{}
+	k.IterateAllProposals(ctx, func(proposal types.Proposal) bool {
+		if proposal.Address.Equals(params.Address) {
+			proposals = append(proposals, proposal)
+		}
+		return false
+	})
+
+	return marshalJSONIndent(k.cdc, proposals)
+}
+
+func queryVotes(ctx sdk.Context, req abci.RequestQuery, k Keeper) ([]byte, sdk.Error) {
+	var params types.QueryProposalParams
+
+	err := k.cdc.UnmarshalJSON(req.Data, &params)
+	if err != nil {
+		return nil, sdk.ErrUnknownRequest(sdk.AppendMsgToErr("incorrectly formatted request data", err.Error()))
+	}
+
+	votes := types.Votes{}
+	k.IterateVotes(ctx, params.ProposalID, func(vote types.Vote) bool {
+		votes = append(votes, vote)
+		return false
+	})
+
+	return marshalJSONIndent(k.cdc, votes)
+}
+
+func marshalJSONIndent(cdc *codec.Codec, obj interface{}) ([]byte, sdk.Error) {
+	res, err := codec.MarshalJSONIndent(cdc, obj)
+	if err != nil {
+		return nil, sdk.ErrInternal(sdk.AppendMsgToErr("failed to JSON marshal result: %s", err.Error()))
+	}
+
+	return res, nil
+}
@@ -0,0 +1,31 @@
+package types
+
+import (
+	"github.com/cosmos/cosmos-sdk/codec"
+)
+
+// RegisterCodec registers the group module types on the given codec.
+func RegisterCodec(cdc *codec.Codec) {
+	cdc.RegisterInterface((*DecisionPolicy)(nil), nil)
+	cdc.RegisterConcrete(ThresholdDecisionPolicy{}, "cosmos-sdk/ThresholdDecisionPolicy", nil)
+	cdc.RegisterConcrete(PercentageDecisionPolicy{}, "cosmos-sdk/PercentageDecisionPolicy", nil)
+
+	cdc.RegisterConcrete(MsgCreateGroup{}, "cosmos-sdk/MsgCreateGroup", nil)
+	cdc.RegisterConcrete(MsgUpdateGroupMembers{}, "cosmos-sdk/MsgUpdateGroupMembers", nil)
+	cdc.RegisterConcrete(MsgUpdateGroupAdmin{}, "cosmos-sdk/MsgUpdateGroupAdmin", nil)
+	cdc.RegisterConcrete(MsgCreateGroupPolicy{}, "cosmos-sdk/MsgCreateGroupPolicy", nil)
+	cdc.RegisterConcrete(MsgUpdateGroupPolicy{}, "cosmos-sdk/MsgUpdateGroupPolicy", nil)
+	cdc.RegisterConcrete(MsgSubmitProposal{}, "cosmos-sdk/group/MsgSubmitProposal", nil)
+	cdc.RegisterConcrete(MsgVote{}, "cosmos-sdk/group/MsgVote", nil)
+	cdc.RegisterConcrete(MsgExec{}, "cosmos-sdk/group/MsgExec", nil)
+}
+
+// ModuleCdc is the generic sealed codec to be used throughout the module
+var ModuleCdc *codec.Codec
+
+func init() {
+	ModuleCdc = codec.New()
+	RegisterCodec(ModuleCdc)
+	codec.RegisterCrypto(ModuleCdc)
+	ModuleCdc.Seal()
+}
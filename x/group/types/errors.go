@@ -0,0 +1,98 @@
+package types
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// Group module codespace constants
+const (
+	DefaultCodespace sdk.CodespaceType = ModuleName
+
+	CodeUnknownGroup          sdk.CodeType = 1
+	CodeUnknownGroupPolicy    sdk.CodeType = 2
+	CodeUnknownProposal       sdk.CodeType = 3
+	CodeUnauthorized          sdk.CodeType = 4
+	CodeInvalidMembers        sdk.CodeType = 5
+	CodeInvalidDecisionPolicy sdk.CodeType = 6
+	CodeInvalidProposal       sdk.CodeType = 7
+	CodeNotMember             sdk.CodeType = 8
+	CodeInvalidVoteChoice     sdk.CodeType = 9
+	CodeAlreadyVoted          sdk.CodeType = 10
+	CodeVotingClosed          sdk.CodeType = 11
+	CodeProposalNotExecutable sdk.CodeType = 12
+	CodeProposalOutdated      sdk.CodeType = 13
+)
+
+// ErrUnknownGroup returns an error for an unknown group ID.
+func ErrUnknownGroup(codespace sdk.CodespaceType, groupID uint64) sdk.Error {
+	return sdk.NewError(codespace, CodeUnknownGroup, fmt.Sprintf("unknown group %d", groupID))
+}
+
+// ErrUnknownGroupPolicy returns an error for an unknown group policy account.
+func ErrUnknownGroupPolicy(codespace sdk.CodespaceType, address sdk.AccAddress) sdk.Error {
+	return sdk.NewError(codespace, CodeUnknownGroupPolicy, fmt.Sprintf("unknown group policy account %s", address))
+}
+
+// ErrUnknownProposal returns an error for an unknown proposal ID.
+func ErrUnknownProposal(codespace sdk.CodespaceType, proposalID uint64) sdk.Error {
+	return sdk.NewError(codespace, CodeUnknownProposal, fmt.Sprintf("unknown group proposal %d", proposalID))
+}
+
+// ErrUnauthorized returns an error for when the signer of a message is not the
+// admin of the group or group policy account it updates.
+func ErrUnauthorized(codespace sdk.CodespaceType, msg string) sdk.Error {
+	return sdk.NewError(codespace, CodeUnauthorized, "unauthorized: "+msg)
+}
+
+// ErrInvalidMembers returns an error for an invalid set of group members.
+func ErrInvalidMembers(codespace sdk.CodespaceType, msg string) sdk.Error {
+	return sdk.NewError(codespace, CodeInvalidMembers, "invalid group members: "+msg)
+}
+
+// ErrInvalidDecisionPolicy returns an error for an invalid decision policy.
+func ErrInvalidDecisionPolicy(codespace sdk.CodespaceType, msg string) sdk.Error {
+	return sdk.NewError(codespace, CodeInvalidDecisionPolicy, "invalid decision policy: "+msg)
+}
+
+// ErrInvalidProposal returns an error for an invalid group proposal.
+func ErrInvalidProposal(codespace sdk.CodespaceType, msg string) sdk.Error {
+	return sdk.NewError(codespace, CodeInvalidProposal, "invalid group proposal: "+msg)
+}
+
+// ErrNotMember returns an error for an address which is not a member of the
+// group.
+func ErrNotMember(codespace sdk.CodespaceType, groupID uint64, address sdk.AccAddress) sdk.Error {
+	return sdk.NewError(codespace, CodeNotMember, fmt.Sprintf("%s is not a member of group %d", address, groupID))
+}
+
+// ErrInvalidVoteChoice returns an error for an invalid vote choice.
+func ErrInvalidVoteChoice(codespace sdk.CodespaceType, choice Choice) sdk.Error {
+	return sdk.NewError(codespace, CodeInvalidVoteChoice, fmt.Sprintf("'%v' is not a valid vote choice", choice))
+}
+
+// ErrAlreadyVoted returns an error for a voter voting twice on a proposal.
+func ErrAlreadyVoted(codespace sdk.CodespaceType, proposalID uint64, voter sdk.AccAddress) sdk.Error {
+	return sdk.NewError(codespace, CodeAlreadyVoted, fmt.Sprintf("%s already voted on group proposal %d", voter, proposalID))
+}
+
+// ErrVotingClosed returns an error for a vote on a proposal which no longer
+// accepts votes.
+func ErrVotingClosed(codespace sdk.CodespaceType, proposalID uint64) sdk.Error {
+	return sdk.NewError(codespace, CodeVotingClosed, fmt.Sprintf("voting on group proposal %d is closed", proposalID))
+}
+
+// ErrProposalNotExecutable returns an error for a proposal which cannot be
+// executed in its current state.
+func ErrProposalNotExecutable(codespace sdk.CodespaceType, proposalID uint64, msg string) sdk.Error {
+	return sdk.NewError(codespace, CodeProposalNotExecutable,
+		fmt.Sprintf("group proposal %d cannot be executed: %s", proposalID, msg))
+}
+
+// ErrProposalOutdated returns an error for a proposal whose group members or
+// decision policy changed since it was submitted.
+func ErrProposalOutdated(codespace sdk.CodespaceType, proposalID uint64) sdk.Error {
+	return sdk.NewError(codespace, CodeProposalOutdated,
+		fmt.Sprintf("group or decision policy of group proposal %d changed since its submission", proposalID))
+}
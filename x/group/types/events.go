@@ -0,0 +1,24 @@
+package types
+
+// group module event types
+const (
+	EventTypeCreateGroup         = "create_group"
+	EventTypeUpdateGroupMembers  = "update_group_members"
+	EventTypeUpdateGroupAdmin    = "update_group_admin"
+	EventTypeCreateGroupPolicy   = "create_group_policy"
+	EventTypeUpdateGroupPolicy   = "update_group_policy"
+	EventTypeSubmitGroupProposal = "submit_group_proposal"
+	EventTypeGroupVote           = "group_vote"
+	EventTypeExecGroupProposal   = "exec_group_proposal"
+
+	AttributeKeyGroupID        = "group_id"
+	AttributeKeyAdmin          = "admin"
+	AttributeKeyAddress        = "address"
+	AttributeKeyProposalID     = "proposal_id"
+	AttributeKeyVoter          = "voter"
+	AttributeKeyChoice         = "choice"
+	AttributeKeyStatus         = "status"
+	AttributeKeyExecutorResult = "executor_result"
+
+	AttributeValueCategory = ModuleName
+)
@@ -0,0 +1,108 @@
+package types
+
+import (
+	"fmt"
+)
+
+// GenesisState contains the groups, group policy accounts, proposals and votes
+// at genesis, along with the next IDs to assign.
+type GenesisState struct {
+	NextGroupID       uint64            `json:"next_group_id"`
+	Groups            []GroupInfo       `json:"groups"`
+	GroupMembers      []GroupMember     `json:"group_members"`
+	NextGroupPolicyID uint64            `json:"next_group_policy_id"`
+	GroupPolicies     []GroupPolicyInfo `json:"group_policies"`
+	NextProposalID    uint64            `json:"next_proposal_id"`
+	Proposals         []Proposal        `json:"proposals"`
+	Votes             []Vote            `json:"votes"`
+}
+
+// NewGenesisState creates a new genesis state.
+func NewGenesisState(nextGroupID uint64, groups []GroupInfo, members []GroupMember,
+	nextGroupPolicyID uint64, policies []GroupPolicyInfo, nextProposalID uint64,
+	proposals []Proposal, votes []Vote) GenesisState {
+
+	return GenesisState{
+		NextGroupID:       nextGroupID,
+		Groups:            groups,
+		GroupMembers:      members,
+		NextGroupPolicyID: nextGroupPolicyID,
+		GroupPolicies:     policies,
+		NextProposalID:    nextProposalID,
+		Proposals:         proposals,
+		Votes:             votes,
+	}
+}
+
+// DefaultGenesisState returns a default genesis state
+func DefaultGenesisState() GenesisState {
+	return NewGenesisState(1, []GroupInfo{}, []GroupMember{}, 1, []GroupPolicyInfo{}, 1, []Proposal{}, []Vote{})
+}
+
+// ValidateBasic ensures the genesis state is valid: every group, group policy
+// account and proposal must have an ID lower than the next ID to assign, and
+// must refer to existing groups, group policy accounts and proposals.
+func (data GenesisState) ValidateBasic() error {
+	if data.NextGroupID == 0 || data.NextGroupPolicyID == 0 || data.NextProposalID == 0 {
+		return fmt.Errorf("next group, group policy and proposal IDs must be positive")
+	}
+
+	groups := make(map[uint64]bool)
+	for _, g := range data.Groups {
+		if err := g.ValidateBasic(); err != nil {
+			return err
+		}
+		if g.ID >= data.NextGroupID {
+			return fmt.Errorf("group %d is not lower than the next group ID %d", g.ID, data.NextGroupID)
+		}
+		if groups[g.ID] {
+			return fmt.Errorf("duplicate group %d", g.ID)
+		}
+		groups[g.ID] = true
+	}
+
+	for _, gm := range data.GroupMembers {
+		if !groups[gm.GroupID] {
+			return fmt.Errorf("member %s of unknown group %d", gm.Member.Address, gm.GroupID)
+		}
+		if err := gm.Member.ValidateBasic(); err != nil {
+			return err
+		}
+		if !gm.Member.Weight.IsPositive() {
+			return fmt.Errorf("weight of member %s of group %d must be positive", gm.Member.Address, gm.GroupID)
+		}
+	}
+
+	policies := make(map[string]bool)
+	for _, p := range data.GroupPolicies {
+		if err := p.ValidateBasic(); err != nil {
+			return err
+		}
+		if !groups[p.GroupID] {
+			return fmt.Errorf("group policy account %s of unknown group %d", p.Address, p.GroupID)
+		}
+		policies[p.Address.String()] = true
+	}
+
+	proposals := make(map[uint64]bool)
+	for _, p := range data.Proposals {
+		if p.ID == 0 || p.ID >= data.NextProposalID {
+			return fmt.Errorf("invalid group proposal ID %d", p.ID)
+		}
+		if !policies[p.Address.String()] {
+			return fmt.Errorf("group proposal %d of unknown group policy account %s", p.ID, p.Address)
+		}
+		proposals[p.ID] = true
+	}
+
+	for _, v := range data.Votes {
+		if !proposals[v.ProposalID] {
+			return fmt.Errorf("vote of %s on unknown group proposal %d", v.Voter, v.ProposalID)
+		}
+		if !ValidChoice(v.Choice) {
+			return ErrInvalidVoteChoice(DefaultCodespace, v.Choice)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,147 @@
+package types
+
+import (
+	"fmt"
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// Member defines an address of a group along with its voting weight.
+type Member struct {
+	Address  sdk.AccAddress `json:"address"`
+	Weight   sdk.Dec        `json:"weight"`
+	Metadata string         `json:"metadata"`
+}
+
+// NewMember creates a new Member instance.
+func NewMember(address sdk.AccAddress, weight sdk.Dec, metadata string) Member {
+	return Member{
+		Address:  address,
+		Weight:   weight,
+		Metadata: metadata,
+	}
+}
+
+// ValidateBasic performs basic validation of the member. A zero weight is
+// allowed, as it is used to remove a member from a group.
+func (m Member) ValidateBasic() sdk.Error {
+	if m.Address.Empty() {
+		return sdk.ErrInvalidAddress("missing member address")
+	}
+	if m.Weight.IsNil() || m.Weight.IsNegative() {
+		return ErrInvalidMembers(DefaultCodespace, fmt.Sprintf("invalid weight for %s", m.Address))
+	}
+	return nil
+}
+
+// String implements the Stringer interface.
+func (m Member) String() string {
+	return fmt.Sprintf("%s: %s", m.Address, m.Weight)
+}
+
+// Members is a collection of Member
+type Members []Member
+
+// ValidateBasic performs basic validation of the members, which must be valid
+// and unique.
+func (ms Members) ValidateBasic() sdk.Error {
+	seen := make(map[string]bool)
+	for _, m := range ms {
+		if err := m.ValidateBasic(); err != nil {
+			return err
+		}
+
+		addr := m.Address.String()
+		if seen[addr] {
+			return ErrInvalidMembers(DefaultCodespace, fmt.Sprintf("duplicate member %s", addr))
+		}
+		seen[addr] = true
+	}
+	return nil
+}
+
+// String implements the Stringer interface.
+func (ms Members) String() string {
+	out := make([]string, len(ms))
+	for i, m := range ms {
+		out[i] = m.String()
+	}
+	return strings.Join(out, "\n")
+}
+
+// GroupInfo defines a group, administered by Admin. Version is incremented
+// every time the members of the group change, so that proposals submitted for
+// a previous set of members can be identified.
+type GroupInfo struct {
+	ID          uint64         `json:"id"`
+	Admin       sdk.AccAddress `json:"admin"`
+	Metadata    string         `json:"metadata"`
+	Version     uint64         `json:"version"`
+	TotalWeight sdk.Dec        `json:"total_weight"`
+}
+
+// NewGroupInfo creates a new GroupInfo instance.
+func NewGroupInfo(id uint64, admin sdk.AccAddress, metadata string, version uint64, totalWeight sdk.Dec) GroupInfo {
+	return GroupInfo{
+		ID:          id,
+		Admin:       admin,
+		Metadata:    metadata,
+		Version:     version,
+		TotalWeight: totalWeight,
+	}
+}
+
+// ValidateBasic performs basic validation of the group.
+func (g GroupInfo) ValidateBasic() sdk.Error {
+	if g.ID == 0 {
+		return ErrUnknownGroup(DefaultCodespace, g.ID)
+	}
+	if g.Admin.Empty() {
+		return sdk.ErrInvalidAddress("missing group admin address")
+	}
+	if g.TotalWeight.IsNil() || g.TotalWeight.IsNegative() {
+		return ErrInvalidMembers(DefaultCodespace, "invalid total weight")
+	}
+	return nil
+}
+
+// String implements the Stringer interface.
+func (g GroupInfo) String() string {
+	return fmt.Sprintf(`Group %d:
+  Admin:        %s
+  Metadata:     %s
+  Version:      %d
+  Total Weight: %s`, g.ID, g.Admin, g.Metadata, g.Version, g.TotalWeight)
+}
+
+// GroupMember defines a member of a group, as stored in the KVStore.
+type GroupMember struct {
+	GroupID uint64 `json:"group_id"`
+	Member  Member `json:"member"`
+}
+
+// NewGroupMember creates a new GroupMember instance.
+func NewGroupMember(groupID uint64, member Member) GroupMember {
+	return GroupMember{
+		GroupID: groupID,
+		Member:  member,
+	}
+}
+
+// String implements the Stringer interface.
+func (gm GroupMember) String() string {
+	return fmt.Sprintf("Group %d member %s", gm.GroupID, gm.Member)
+}
+
+// GroupMembers is a collection of GroupMember
+type GroupMembers []GroupMember
+
+// String implements the Stringer interface.
+func (gms GroupMembers) String() string {
+	out := make([]string, len(gms))
+	for i, gm := range gms {
+		out[i] = gm.String()
+	}
+	return strings.Join(out, "\n")
+}
@@ -0,0 +1,101 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+const (
+	// ModuleName is the name of this module
+	ModuleName = "group"
+
+	// StoreKey is the store key string for the group module
+	StoreKey = ModuleName
+
+	// RouterKey is the message route for the group module
+	RouterKey = ModuleName
+
+	// QuerierRoute is the querier route for the group module
+	QuerierRoute = ModuleName
+)
+
+// Keys for group store
+// Items are stored with the following key: values
+//
+// - 0x00: nextGroupID_Bytes
+//
+// - 0x01<groupID_Bytes>: GroupInfo
+//
+// - 0x02<groupID_Bytes><member_Bytes>: GroupMember
+//
+// - 0x10: nextGroupPolicyID_Bytes
+//
+// - 0x11<address_Bytes>: GroupPolicyInfo
+//
+// - 0x12<groupID_Bytes><address_Bytes>: []byte{}
+//
+// - 0x20: nextProposalID_Bytes
+//
+// - 0x21<proposalID_Bytes>: Proposal
+//
+// - 0x22<proposalID_Bytes><voter_Bytes>: Vote
+var (
+	GroupIDKey         = []byte{0x00}
+	GroupKeyPrefix     = []byte{0x01}
+	GroupMemberPrefix  = []byte{0x02}
+	GroupPolicyIDKey   = []byte{0x10}
+	GroupPolicyPrefix  = []byte{0x11}
+	GroupPoliciesIndex = []byte{0x12}
+	ProposalIDKey      = []byte{0x20}
+	ProposalKeyPrefix  = []byte{0x21}
+	VoteKeyPrefix      = []byte{0x22}
+)
+
+// GroupKey returns the key under which the group with the given ID is stored.
+func GroupKey(groupID uint64) []byte {
+	return append(GroupKeyPrefix, sdk.Uint64ToBigEndian(groupID)...)
+}
+
+// GroupMembersKey returns the prefix of the keys of all the members of a group.
+func GroupMembersKey(groupID uint64) []byte {
+	return append(GroupMemberPrefix, sdk.Uint64ToBigEndian(groupID)...)
+}
+
+// GroupMemberKey returns the key under which a member of a group is stored.
+func GroupMemberKey(groupID uint64, member sdk.AccAddress) []byte {
+	return append(GroupMembersKey(groupID), member.Bytes()...)
+}
+
+// GroupPolicyKey returns the key under which the group policy account with
+// the given address is stored.
+func GroupPolicyKey(address sdk.AccAddress) []byte {
+	return append(GroupPolicyPrefix, address.Bytes()...)
+}
+
+// GroupPoliciesByGroupKey returns the prefix of the index keys of all the group
+// policy accounts of a group.
+func GroupPoliciesByGroupKey(groupID uint64) []byte {
+	return append(GroupPoliciesIndex, sdk.Uint64ToBigEndian(groupID)...)
+}
+
+// GroupPolicyByGroupKey returns the index key of a group policy account of a
+// group.
+func GroupPolicyByGroupKey(groupID uint64, address sdk.AccAddress) []byte {
+	return append(GroupPoliciesByGroupKey(groupID), address.Bytes()...)
+}
+
+// ProposalKey returns the key under which the proposal with the given ID is
+// stored.
+func ProposalKey(proposalID uint64) []byte {
+	return append(ProposalKeyPrefix, sdk.Uint64ToBigEndian(proposalID)...)
+}
+
+// VotesKey returns the prefix of the keys of all the votes on a proposal.
+func VotesKey(proposalID uint64) []byte {
+	return append(VoteKeyPrefix, sdk.Uint64ToBigEndian(proposalID)...)
+}
+
+// VoteKey returns the key under which the vote of a voter on a proposal is
+// stored.
+func VoteKey(proposalID uint64, voter sdk.AccAddress) []byte {
+	return append(VotesKey(proposalID), voter.Bytes()...)
+}
@@ -0,0 +1,430 @@
+package types
+
+import (
+	"encoding/json"
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// ensure Msg interface compliance at compile time
+var (
+	_ sdk.Msg = MsgCreateGroup{}
+	_ sdk.Msg = MsgUpdateGroupMembers{}
+	_ sdk.Msg = MsgUpdateGroupAdmin{}
+	_ sdk.Msg = MsgCreateGroupPolicy{}
+	_ sdk.Msg = MsgUpdateGroupPolicy{}
+	_ sdk.Msg = MsgSubmitProposal{}
+	_ sdk.Msg = MsgVote{}
+	_ sdk.Msg = MsgExec{}
+)
+
+// MsgCreateGroup creates a group with the given members, administered by
+// Admin.
+type MsgCreateGroup struct {
+	Admin    sdk.AccAddress `json:"admin"`
+	Members  Members        `json:"members"`
+	Metadata string         `json:"metadata"`
+}
+
+// NewMsgCreateGroup creates a new MsgCreateGroup.
+func NewMsgCreateGroup(admin sdk.AccAddress, members Members, metadata string) MsgCreateGroup {
+	return MsgCreateGroup{
+		Admin:    admin,
+		Members:  members,
+		Metadata: metadata,
+	}
+}
+
+// Route implements sdk.Msg
+func (msg MsgCreateGroup) Route() string { return RouterKey }
+
+// Type implements sdk.Msg
+func (msg MsgCreateGroup) Type() string { return "create_group" }
+
+// ValidateBasic implements sdk.Msg
+func (msg MsgCreateGroup) ValidateBasic() sdk.Error {
+	if msg.Admin.Empty() {
+		return sdk.ErrInvalidAddress("missing admin address")
+	}
+	if len(msg.Members) == 0 {
+		return ErrInvalidMembers(DefaultCodespace, "a group must have at least one member")
+	}
+	for _, m := range msg.Members {
+		if m.Weight.IsNil() || !m.Weight.IsPositive() {
+			return ErrInvalidMembers(DefaultCodespace, fmt.Sprintf("weight of %s must be positive", m.Address))
+		}
+	}
+	return msg.Members.ValidateBasic()
+}
+
+// GetSignBytes implements sdk.Msg
+func (msg MsgCreateGroup) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(msg))
+}
+
+// GetSigners implements sdk.Msg
+func (msg MsgCreateGroup) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.Admin}
+}
+
+// MsgUpdateGroupMembers adds, updates or removes members of a group. A member
+// with a zero weight is removed from the group.
+type MsgUpdateGroupMembers struct {
+	Admin         sdk.AccAddress `json:"admin"`
+	GroupID       uint64         `json:"group_id"`
+	MemberUpdates Members        `json:"member_updates"`
+}
+
+// NewMsgUpdateGroupMembers creates a new MsgUpdateGroupMembers.
+func NewMsgUpdateGroupMembers(admin sdk.AccAddress, groupID uint64, memberUpdates Members) MsgUpdateGroupMembers {
+	return MsgUpdateGroupMembers{
+		Admin:         admin,
+		GroupID:       groupID,
+		MemberUpdates: memberUpdates,
+	}
+}
+
+// Route implements sdk.Msg
+func (msg MsgUpdateGroupMembers) Route() string { return RouterKey }
+
+// Type implements sdk.Msg
+func (msg MsgUpdateGroupMembers) Type() string { return "update_group_members" }
+
+// ValidateBasic implements sdk.Msg
+func (msg MsgUpdateGroupMembers) ValidateBasic() sdk.Error {
+	if msg.Admin.Empty() {
+		return sdk.ErrInvalidAddress("missing admin address")
+	}
+	if msg.GroupID == 0 {
+		return ErrUnknownGroup(DefaultCodespace, msg.GroupID)
+	}
+	if len(msg.MemberUpdates) == 0 {
+		return ErrInvalidMembers(DefaultCodespace, "no member updates")
+	}
+	return msg.MemberUpdates.ValidateBasic()
+}
+
+// GetSignBytes implements sdk.Msg
+func (msg MsgUpdateGroupMembers) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(msg))
+}
+
+// GetSigners implements sdk.Msg
+func (msg MsgUpdateGroupMembers) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.Admin}
+}
+
+// MsgUpdateGroupAdmin transfers the administration of a group to NewAdmin.
+type MsgUpdateGroupAdmin struct {
+	Admin    sdk.AccAddress `json:"admin"`
+	GroupID  uint64         `json:"group_id"`
+	NewAdmin sdk.AccAddress `json:"new_admin"`
+}
+
+// NewMsgUpdateGroupAdmin creates a new MsgUpdateGroupAdmin.
+func NewMsgUpdateGroupAdmin(admin sdk.AccAddress, groupID uint64, newAdmin sdk.AccAddress) MsgUpdateGroupAdmin {
+	return MsgUpdateGroupAdmin{
+		Admin:    admin,
+		GroupID:  groupID,
+		NewAdmin: newAdmin,
+	}
+}
+
+// Route implements sdk.Msg
+func (msg MsgUpdateGroupAdmin) Route() string { return RouterKey }
+
+// Type implements sdk.Msg
+func (msg MsgUpdateGroupAdmin) Type() string { return "update_group_admin" }
+
+// ValidateBasic implements sdk.Msg
+func (msg MsgUpdateGroupAdmin) ValidateBasic() sdk.Error {
+	if msg.Admin.Empty() {
+		return sdk.ErrInvalidAddress("missing admin address")
+	}
+	if msg.NewAdmin.Empty() {
+		return sdk.ErrInvalidAddress("missing new admin address")
+	}
+	if msg.GroupID == 0 {
+		return ErrUnknownGroup(DefaultCodespace, msg.GroupID)
+	}
+	return nil
+}
+
+// GetSignBytes implements sdk.Msg
+func (msg MsgUpdateGroupAdmin) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(msg))
+}
+
+// GetSigners implements sdk.Msg
+func (msg MsgUpdateGroupAdmin) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.Admin}
+}
+
+// MsgCreateGroupPolicy creates a group policy account for a group, with the
+// given decision policy. It must be signed by the admin of the group, who
+// becomes the admin of the group policy account.
+type MsgCreateGroupPolicy struct {
+	Admin          sdk.AccAddress `json:"admin"`
+	GroupID        uint64         `json:"group_id"`
+	Metadata       string         `json:"metadata"`
+	DecisionPolicy DecisionPolicy `json:"decision_policy"`
+}
+
+// NewMsgCreateGroupPolicy creates a new MsgCreateGroupPolicy.
+func NewMsgCreateGroupPolicy(admin sdk.AccAddress, groupID uint64, metadata string,
+	policy DecisionPolicy) MsgCreateGroupPolicy {
+
+	return MsgCreateGroupPolicy{
+		Admin:          admin,
+		GroupID:        groupID,
+		Metadata:       metadata,
+		DecisionPolicy: policy,
+	}
+}
+
+// Route implements sdk.Msg
+func (msg MsgCreateGroupPolicy) Route() string { return RouterKey }
+
+// Type implements sdk.Msg
+func (msg MsgCreateGroupPolicy) Type() string { return "create_group_policy" }
+
+// ValidateBasic implements sdk.Msg
+func (msg MsgCreateGroupPolicy) ValidateBasic() sdk.Error {
+	if msg.Admin.Empty() {
+		return sdk.ErrInvalidAddress("missing admin address")
+	}
+	if msg.GroupID == 0 {
+		return ErrUnknownGroup(DefaultCodespace, msg.GroupID)
+	}
+	if msg.DecisionPolicy == nil {
+		return ErrInvalidDecisionPolicy(DefaultCodespace, "missing decision policy")
+	}
+	return msg.DecisionPolicy.ValidateBasic()
+}
+
+// GetSignBytes implements sdk.Msg
+func (msg MsgCreateGroupPolicy) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(msg))
+}
+
+// GetSigners implements sdk.Msg
+func (msg MsgCreateGroupPolicy) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.Admin}
+}
+
+// MsgUpdateGroupPolicy replaces the decision policy of a group policy account.
+type MsgUpdateGroupPolicy struct {
+	Admin          sdk.AccAddress `json:"admin"`
+	Address        sdk.AccAddress `json:"address"`
+	DecisionPolicy DecisionPolicy `json:"decision_policy"`
+}
+
+// NewMsgUpdateGroupPolicy creates a new MsgUpdateGroupPolicy.
+func NewMsgUpdateGroupPolicy(admin, address sdk.AccAddress, policy DecisionPolicy) MsgUpdateGroupPolicy {
+	return MsgUpdateGroupPolicy{
+		Admin:          admin,
+		Address:        address,
+		DecisionPolicy: policy,
+	}
+}
+
+// Route implements sdk.Msg
+func (msg MsgUpdateGroupPolicy) Route() string { return RouterKey }
+
+// Type implements sdk.Msg
+func (msg MsgUpdateGroupPolicy) Type() string { return "update_group_policy" }
+
+// ValidateBasic implements sdk.Msg
+func (msg MsgUpdateGroupPolicy) ValidateBasic() sdk.Error {
+	if msg.Admin.Empty() {
+		return sdk.ErrInvalidAddress("missing admin address")
+	}
+	if msg.Address.Empty() {
+		return sdk.ErrInvalidAddress("missing group policy address")
+	}
+	if msg.DecisionPolicy == nil {
+		return ErrInvalidDecisionPolicy(DefaultCodespace, "missing decision policy")
+	}
+	return msg.DecisionPolicy.ValidateBasic()
+}
+
+// GetSignBytes implements sdk.Msg
+func (msg MsgUpdateGroupPolicy) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(msg))
+}
+
+// GetSigners implements sdk.Msg
+func (msg MsgUpdateGroupPolicy) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.Admin}
+}
+
+// MsgSubmitProposal submits a proposal to execute Msgs on behalf of the group
+// policy account at Address. It must be signed by all the proposers, who must
+// be members of the group of the account. Each message must be signed by the
+// group policy account only.
+type MsgSubmitProposal struct {
+	Address   sdk.AccAddress   `json:"address"`
+	Proposers []sdk.AccAddress `json:"proposers"`
+	Metadata  string           `json:"metadata"`
+	Msgs      []sdk.Msg        `json:"msgs"`
+}
+
+// NewMsgSubmitProposal creates a new MsgSubmitProposal.
+func NewMsgSubmitProposal(address sdk.AccAddress, proposers []sdk.AccAddress, metadata string,
+	msgs []sdk.Msg) MsgSubmitProposal {
+
+	return MsgSubmitProposal{
+		Address:   address,
+		Proposers: proposers,
+		Metadata:  metadata,
+		Msgs:      msgs,
+	}
+}
+
+// Route implements sdk.Msg
+func (msg MsgSubmitProposal) Route() string { return RouterKey }
+
+// Type implements sdk.Msg
+func (msg MsgSubmitProposal) Type() string { return "submit_proposal" }
+
+// ValidateBasic implements sdk.Msg
+func (msg MsgSubmitProposal) ValidateBasic() sdk.Error {
+	if msg.Address.Empty() {
+		return sdk.ErrInvalidAddress("missing group policy address")
+	}
+	if len(msg.Proposers) == 0 {
+		return ErrInvalidProposal(DefaultCodespace, "missing proposers")
+	}
+
+	seen := make(map[string]bool)
+	for _, proposer := range msg.Proposers {
+		if proposer.Empty() {
+			return sdk.ErrInvalidAddress("missing proposer address")
+		}
+		if seen[proposer.String()] {
+			return ErrInvalidProposal(DefaultCodespace, fmt.Sprintf("duplicate proposer %s", proposer))
+		}
+		seen[proposer.String()] = true
+	}
+
+	for i, m := range msg.Msgs {
+		if signer, ok := sdk.GetSingleSigner(m); !ok || !signer.Equals(msg.Address) {
+			return ErrInvalidProposal(DefaultCodespace,
+				fmt.Sprintf("message %d must be signed by the group policy account %s only", i, msg.Address))
+		}
+		if err := m.ValidateBasic(); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// GetSignBytes implements sdk.Msg. The proposed messages are included through
+// their own sign bytes, as their concrete types are not registered on the
+// module codec.
+func (msg MsgSubmitProposal) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(struct {
+		Address   sdk.AccAddress    `json:"address"`
+		Proposers []sdk.AccAddress  `json:"proposers"`
+		Metadata  string            `json:"metadata"`
+		Msgs      []json.RawMessage `json:"msgs"`
+	}{msg.Address, msg.Proposers, msg.Metadata, sdk.MsgsSignBytes(msg.Msgs)})
+	return sdk.MustSortJSON(bz)
+}
+
+// GetSigners implements sdk.Msg
+func (msg MsgSubmitProposal) GetSigners() []sdk.AccAddress {
+	return msg.Proposers
+}
+
+// MsgVote casts the vote of a group member on a proposal.
+type MsgVote struct {
+	ProposalID uint64         `json:"proposal_id"`
+	Voter      sdk.AccAddress `json:"voter"`
+	Choice     Choice         `json:"choice"`
+	Metadata   string         `json:"metadata"`
+}
+
+// NewMsgVote creates a new MsgVote.
+func NewMsgVote(proposalID uint64, voter sdk.AccAddress, choice Choice, metadata string) MsgVote {
+	return MsgVote{
+		ProposalID: proposalID,
+		Voter:      voter,
+		Choice:     choice,
+		Metadata:   metadata,
+	}
+}
+
+// Route implements sdk.Msg
+func (msg MsgVote) Route() string { return RouterKey }
+
+// Type implements sdk.Msg
+func (msg MsgVote) Type() string { return "vote" }
+
+// ValidateBasic implements sdk.Msg
+func (msg MsgVote) ValidateBasic() sdk.Error {
+	if msg.Voter.Empty() {
+		return sdk.ErrInvalidAddress("missing voter address")
+	}
+	if msg.ProposalID == 0 {
+		return ErrUnknownProposal(DefaultCodespace, msg.ProposalID)
+	}
+	if !ValidChoice(msg.Choice) {
+		return ErrInvalidVoteChoice(DefaultCodespace, msg.Choice)
+	}
+	return nil
+}
+
+// GetSignBytes implements sdk.Msg
+func (msg MsgVote) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(msg))
+}
+
+// GetSigners implements sdk.Msg
+func (msg MsgVote) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.Voter}
+}
+
+// MsgExec executes the messages of an accepted proposal, or finalizes the
+// proposal once its voting period ended. It can be signed by any account.
+type MsgExec struct {
+	ProposalID uint64         `json:"proposal_id"`
+	Signer     sdk.AccAddress `json:"signer"`
+}
+
+// NewMsgExec creates a new MsgExec.
+func NewMsgExec(proposalID uint64, signer sdk.AccAddress) MsgExec {
+	return MsgExec{
+		ProposalID: proposalID,
+		Signer:     signer,
+	}
+}
+
+// Route implements sdk.Msg
+func (msg MsgExec) Route() string { return RouterKey }
+
+// Type implements sdk.Msg
+func (msg MsgExec) Type() string { return "exec" }
+
+// ValidateBasic implements sdk.Msg
+func (msg MsgExec) ValidateBasic() sdk.Error {
+	if msg.Signer.Empty() {
+		return sdk.ErrInvalidAddress("missing signer address")
+	}
+	if msg.ProposalID == 0 {
+		return ErrUnknownProposal(DefaultCodespace, msg.ProposalID)
+	}
+	return nil
+}
+
+// GetSignBytes implements sdk.Msg
+func (msg MsgExec) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(msg))
+}
+
+// GetSigners implements sdk.Msg
+func (msg MsgExec) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.Signer}
+}
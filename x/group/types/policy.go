@@ -0,0 +1,227 @@
+package types
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/tendermint/tendermint/crypto"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// DecisionPolicy defines the interface of the rules deciding whether the
+// proposals of a group policy account pass.
+type DecisionPolicy interface {
+	// GetTimeout returns the duration after the submission of a proposal during
+	// which its votes are accepted.
+	GetTimeout() time.Duration
+
+	// Allow returns whether the proposal is accepted given its tally and the
+	// total weight of the group, and whether this decision is final. The
+	// decision is always final once the voting period ended.
+	Allow(tally Tally, totalWeight sdk.Dec, votingEnded bool) DecisionPolicyResult
+
+	// ValidateBasic performs a stateless validation of the decision policy.
+	ValidateBasic() sdk.Error
+}
+
+// DecisionPolicyResult is the result of a decision policy for a proposal.
+type DecisionPolicyResult struct {
+	Allow bool
+	Final bool
+}
+
+//-----------------------------------------------------------------------------
+// Threshold Decision Policy
+
+var _ DecisionPolicy = ThresholdDecisionPolicy{}
+
+// ThresholdDecisionPolicy accepts a proposal once the weight of its yes votes
+// reaches the threshold. A threshold greater than the total weight of the
+// group requires all the members to vote yes.
+type ThresholdDecisionPolicy struct {
+	Threshold sdk.Dec       `json:"threshold"`
+	Timeout   time.Duration `json:"timeout"`
+}
+
+// NewThresholdDecisionPolicy creates a new ThresholdDecisionPolicy.
+func NewThresholdDecisionPolicy(threshold sdk.Dec, timeout time.Duration) ThresholdDecisionPolicy {
+	return ThresholdDecisionPolicy{
+		Threshold: threshold,
+		Timeout:   timeout,
+	}
+}
+
+// GetTimeout implements DecisionPolicy.
+func (p ThresholdDecisionPolicy) GetTimeout() time.Duration {
+	return p.Timeout
+}
+
+// Allow implements DecisionPolicy. The proposal is rejected before the end of
+// the voting period once the threshold cannot be reached anymore.
+func (p ThresholdDecisionPolicy) Allow(tally Tally, totalWeight sdk.Dec, votingEnded bool) DecisionPolicyResult {
+	threshold := sdk.MinDec(p.Threshold, totalWeight)
+	if totalWeight.IsPositive() && tally.Yes.GTE(threshold) {
+		return DecisionPolicyResult{Allow: true, Final: true}
+	}
+
+	undecided := totalWeight.Sub(tally.Total())
+	if !totalWeight.IsPositive() || tally.Yes.Add(undecided).LT(threshold) {
+		return DecisionPolicyResult{Allow: false, Final: true}
+	}
+
+	return DecisionPolicyResult{Allow: false, Final: votingEnded}
+}
+
+// ValidateBasic implements DecisionPolicy.
+func (p ThresholdDecisionPolicy) ValidateBasic() sdk.Error {
+	if p.Threshold.IsNil() || !p.Threshold.IsPositive() {
+		return ErrInvalidDecisionPolicy(DefaultCodespace, "threshold must be positive")
+	}
+	if p.Timeout <= 0 {
+		return ErrInvalidDecisionPolicy(DefaultCodespace, "timeout must be positive")
+	}
+	return nil
+}
+
+// String implements the Stringer interface.
+func (p ThresholdDecisionPolicy) String() string {
+	return fmt.Sprintf("Threshold: %s, Timeout: %s", p.Threshold, p.Timeout)
+}
+
+//-----------------------------------------------------------------------------
+// Percentage Decision Policy
+
+var _ DecisionPolicy = PercentageDecisionPolicy{}
+
+// PercentageDecisionPolicy accepts a proposal once the weight of its yes votes
+// reaches the given percentage of the total weight of the group.
+type PercentageDecisionPolicy struct {
+	Percentage sdk.Dec       `json:"percentage"`
+	Timeout    time.Duration `json:"timeout"`
+}
+
+// NewPercentageDecisionPolicy creates a new PercentageDecisionPolicy.
+func NewPercentageDecisionPolicy(percentage sdk.Dec, timeout time.Duration) PercentageDecisionPolicy {
+	return PercentageDecisionPolicy{
+		Percentage: percentage,
+		Timeout:    timeout,
+	}
+}
+
+// GetTimeout implements DecisionPolicy.
+func (p PercentageDecisionPolicy) GetTimeout() time.Duration {
+	return p.Timeout
+}
+
+// Allow implements DecisionPolicy. The proposal is rejected before the end of
+// the voting period once the percentage cannot be reached anymore.
+func (p PercentageDecisionPolicy) Allow(tally Tally, totalWeight sdk.Dec, votingEnded bool) DecisionPolicyResult {
+	if !totalWeight.IsPositive() {
+		return DecisionPolicyResult{Allow: false, Final: true}
+	}
+
+	if tally.Yes.Quo(totalWeight).GTE(p.Percentage) {
+		return DecisionPolicyResult{Allow: true, Final: true}
+	}
+
+	undecided := totalWeight.Sub(tally.Total())
+	if tally.Yes.Add(undecided).Quo(totalWeight).LT(p.Percentage) {
+		return DecisionPolicyResult{Allow: false, Final: true}
+	}
+
+	return DecisionPolicyResult{Allow: false, Final: votingEnded}
+}
+
+// ValidateBasic implements DecisionPolicy.
+func (p PercentageDecisionPolicy) ValidateBasic() sdk.Error {
+	if p.Percentage.IsNil() || !p.Percentage.IsPositive() || p.Percentage.GT(sdk.OneDec()) {
+		return ErrInvalidDecisionPolicy(DefaultCodespace, "percentage must be positive and at most 1")
+	}
+	if p.Timeout <= 0 {
+		return ErrInvalidDecisionPolicy(DefaultCodespace, "timeout must be positive")
+	}
+	return nil
+}
+
+// String implements the Stringer interface.
+func (p PercentageDecisionPolicy) String() string {
+	return fmt.Sprintf("Percentage: %s, Timeout: %s", p.Percentage, p.Timeout)
+}
+
+//-----------------------------------------------------------------------------
+// Group Policy Account
+
+// NewGroupPolicyAddress returns the address of the group policy account with
+// the given ID. The address is derived from the module name and the ID, so
+// that no private key exists for it.
+func NewGroupPolicyAddress(id uint64) sdk.AccAddress {
+	return sdk.AccAddress(crypto.AddressHash(append([]byte(ModuleName), sdk.Uint64ToBigEndian(id)...)))
+}
+
+// GroupPolicyInfo defines an account of a group whose messages are executed
+// once a proposal passes its decision policy. Version is incremented every
+// time the decision policy changes, so that proposals submitted under a
+// previous decision policy can be identified.
+type GroupPolicyInfo struct {
+	Address        sdk.AccAddress `json:"address"`
+	GroupID        uint64         `json:"group_id"`
+	Admin          sdk.AccAddress `json:"admin"`
+	Metadata       string         `json:"metadata"`
+	Version        uint64         `json:"version"`
+	DecisionPolicy DecisionPolicy `json:"decision_policy"`
+}
+
+// NewGroupPolicyInfo creates a new GroupPolicyInfo instance.
+func NewGroupPolicyInfo(address sdk.AccAddress, groupID uint64, admin sdk.AccAddress, metadata string,
+	version uint64, policy DecisionPolicy) GroupPolicyInfo {
+
+	return GroupPolicyInfo{
+		Address:        address,
+		GroupID:        groupID,
+		Admin:          admin,
+		Metadata:       metadata,
+		Version:        version,
+		DecisionPolicy: policy,
+	}
+}
+
+// ValidateBasic performs basic validation of the group policy account.
+func (p GroupPolicyInfo) ValidateBasic() sdk.Error {
+	if p.Address.Empty() {
+		return sdk.ErrInvalidAddress("missing group policy address")
+	}
+	if p.GroupID == 0 {
+		return ErrUnknownGroup(DefaultCodespace, p.GroupID)
+	}
+	if p.Admin.Empty() {
+		return sdk.ErrInvalidAddress("missing group policy admin address")
+	}
+	if p.DecisionPolicy == nil {
+		return ErrInvalidDecisionPolicy(DefaultCodespace, "missing decision policy")
+	}
+	return p.DecisionPolicy.ValidateBasic()
+}
+
+// String implements the Stringer interface.
+func (p GroupPolicyInfo) String() string {
+	return fmt.Sprintf(`Group Policy Account %s:
+  Group:           %d
+  Admin:           %s
+  Metadata:        %s
+  Version:         %d
+  Decision Policy: %v`, p.Address, p.GroupID, p.Admin, p.Metadata, p.Version, p.DecisionPolicy)
+}
+
+// GroupPolicyInfos is a collection of GroupPolicyInfo
+type GroupPolicyInfos []GroupPolicyInfo
+
+// String implements the Stringer interface.
+func (ps GroupPolicyInfos) String() string {
+	out := make([]string, len(ps))
+	for i, p := range ps {
+		out[i] = p.String()
+	}
+	return strings.Join(out, "\n")
+}
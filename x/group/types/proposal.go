@@ -0,0 +1,353 @@
+package types
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// Proposal defines a group proposal, submitted by members of the group of a
+// group policy account, whose messages are executed on behalf of the group
+// policy account once the proposal is accepted.
+type Proposal struct {
+	ID                 uint64           `json:"id"`
+	Address            sdk.AccAddress   `json:"address"` // address of the group policy account
+	Metadata           string           `json:"metadata"`
+	Proposers          []sdk.AccAddress `json:"proposers"`
+	SubmitTime         time.Time        `json:"submit_time"`
+	GroupVersion       uint64           `json:"group_version"`        // version of the group at submission
+	GroupPolicyVersion uint64           `json:"group_policy_version"` // version of the group policy at submission
+	Status             ProposalStatus   `json:"status"`
+	VoteState          Tally            `json:"vote_state"`
+	VotingPeriodEnd    time.Time        `json:"voting_period_end"`
+	ExecutorResult     ExecutorResult   `json:"executor_result"`
+	Msgs               []sdk.Msg        `json:"msgs"`
+}
+
+// String implements the Stringer interface.
+func (p Proposal) String() string {
+	return fmt.Sprintf(`Group Proposal %d:
+  Group Policy Account: %s
+  Metadata:             %s
+  Proposers:            %v
+  Submit Time:          %s
+  Voting Period End:    %s
+  Status:               %s
+  Executor Result:      %s
+  Vote State:           %s
+  Messages:             %d`,
+		p.ID, p.Address, p.Metadata, p.Proposers, p.SubmitTime, p.VotingPeriodEnd,
+		p.Status, p.ExecutorResult, p.VoteState, len(p.Msgs),
+	)
+}
+
+// Proposals is a collection of Proposal
+type Proposals []Proposal
+
+// String implements the Stringer interface.
+func (ps Proposals) String() string {
+	out := make([]string, len(ps))
+	for i, p := range ps {
+		out[i] = p.String()
+	}
+	return strings.Join(out, "\n")
+}
+
+// Tally defines the sum of the weights of the votes on a proposal per choice.
+type Tally struct {
+	Yes     sdk.Dec `json:"yes"`
+	No      sdk.Dec `json:"no"`
+	Abstain sdk.Dec `json:"abstain"`
+}
+
+// NewTally creates a new Tally instance.
+func NewTally(yes, no, abstain sdk.Dec) Tally {
+	return Tally{
+		Yes:     yes,
+		No:      no,
+		Abstain: abstain,
+	}
+}
+
+// EmptyTally returns a Tally without any vote.
+func EmptyTally() Tally {
+	return NewTally(sdk.ZeroDec(), sdk.ZeroDec(), sdk.ZeroDec())
+}
+
+// Add returns the tally with the weight added to the given choice.
+func (t Tally) Add(choice Choice, weight sdk.Dec) Tally {
+	switch choice {
+	case ChoiceYes:
+		t.Yes = t.Yes.Add(weight)
+	case ChoiceNo:
+		t.No = t.No.Add(weight)
+	case ChoiceAbstain:
+		t.Abstain = t.Abstain.Add(weight)
+	}
+	return t
+}
+
+// Total returns the total weight of the votes.
+func (t Tally) Total() sdk.Dec {
+	return t.Yes.Add(t.No).Add(t.Abstain)
+}
+
+// String implements the Stringer interface.
+func (t Tally) String() string {
+	return fmt.Sprintf("Yes: %s, No: %s, Abstain: %s", t.Yes, t.No, t.Abstain)
+}
+
+// Vote defines the vote of a group member on a proposal.
+type Vote struct {
+	ProposalID uint64         `json:"proposal_id"`
+	Voter      sdk.AccAddress `json:"voter"`
+	Choice     Choice         `json:"choice"`
+	Metadata   string         `json:"metadata"`
+	SubmitTime time.Time      `json:"submit_time"`
+}
+
+// NewVote creates a new Vote instance.
+func NewVote(proposalID uint64, voter sdk.AccAddress, choice Choice, metadata string, submitTime time.Time) Vote {
+	return Vote{
+		ProposalID: proposalID,
+		Voter:      voter,
+		Choice:     choice,
+		Metadata:   metadata,
+		SubmitTime: submitTime,
+	}
+}
+
+// String implements the Stringer interface.
+func (v Vote) String() string {
+	return fmt.Sprintf("voter %s voted %s on group proposal %d", v.Voter, v.Choice, v.ProposalID)
+}
+
+// Votes is a collection of Vote
+type Votes []Vote
+
+// String implements the Stringer interface.
+func (vs Votes) String() string {
+	out := make([]string, len(vs))
+	for i, v := range vs {
+		out[i] = v.String()
+	}
+	return strings.Join(out, "\n")
+}
+
+//-----------------------------------------------------------------------------
+// Choice
+
+// Choice defines a vote choice on a group proposal
+type Choice byte
+
+// Vote choices
+const (
+	ChoiceUnspecified Choice = 0x00
+	ChoiceYes         Choice = 0x01
+	ChoiceNo          Choice = 0x02
+	ChoiceAbstain     Choice = 0x03
+)
+
+// ChoiceFromString returns a Choice from a string. It returns an error if the
+// string is invalid.
+func ChoiceFromString(str string) (Choice, error) {
+	switch str {
+	case "Yes":
+		return ChoiceYes, nil
+
+	case "No":
+		return ChoiceNo, nil
+
+	case "Abstain":
+		return ChoiceAbstain, nil
+
+	default:
+		return Choice(0xff), fmt.Errorf("'%s' is not a valid vote choice", str)
+	}
+}
+
+// ValidChoice returns true if the vote choice is valid and false otherwise.
+func ValidChoice(choice Choice) bool {
+	return choice == ChoiceYes || choice == ChoiceNo || choice == ChoiceAbstain
+}
+
+// MarshalJSON marshals to JSON using string.
+func (c Choice) MarshalJSON() ([]byte, error) {
+	return json.Marshal(c.String())
+}
+
+// UnmarshalJSON decodes from JSON string.
+func (c *Choice) UnmarshalJSON(data []byte) error {
+	var s string
+	if err := json.Unmarshal(data, &s); err != nil {
+		return err
+	}
+
+	choice, err := ChoiceFromString(s)
+	if err != nil {
+		return err
+	}
+
+	*c = choice
+	return nil
+}
+
+// String implements the Stringer interface.
+func (c Choice) String() string {
+	switch c {
+	case ChoiceYes:
+		return "Yes"
+	case ChoiceNo:
+		return "No"
+	case ChoiceAbstain:
+		return "Abstain"
+	default:
+		return ""
+	}
+}
+
+//-----------------------------------------------------------------------------
+// ProposalStatus
+
+// ProposalStatus defines the status of a group proposal
+type ProposalStatus byte
+
+// Valid proposal statuses
+const (
+	StatusNil       ProposalStatus = 0x00
+	StatusSubmitted ProposalStatus = 0x01
+	StatusAccepted  ProposalStatus = 0x02
+	StatusRejected  ProposalStatus = 0x03
+	StatusAborted   ProposalStatus = 0x04
+)
+
+// ProposalStatusFromString returns a ProposalStatus from a string. It returns
+// an error if the string is invalid.
+func ProposalStatusFromString(str string) (ProposalStatus, error) {
+	switch str {
+	case "Submitted":
+		return StatusSubmitted, nil
+
+	case "Accepted":
+		return StatusAccepted, nil
+
+	case "Rejected":
+		return StatusRejected, nil
+
+	case "Aborted":
+		return StatusAborted, nil
+
+	case "":
+		return StatusNil, nil
+
+	default:
+		return ProposalStatus(0xff), fmt.Errorf("'%s' is not a valid group proposal status", str)
+	}
+}
+
+// MarshalJSON marshals to JSON using string.
+func (status ProposalStatus) MarshalJSON() ([]byte, error) {
+	return json.Marshal(status.String())
+}
+
+// UnmarshalJSON decodes from JSON string.
+func (status *ProposalStatus) UnmarshalJSON(data []byte) error {
+	var s string
+	if err := json.Unmarshal(data, &s); err != nil {
+		return err
+	}
+
+	bz, err := ProposalStatusFromString(s)
+	if err != nil {
+		return err
+	}
+
+	*status = bz
+	return nil
+}
+
+// String implements the Stringer interface.
+func (status ProposalStatus) String() string {
+	switch status {
+	case StatusSubmitted:
+		return "Submitted"
+	case StatusAccepted:
+		return "Accepted"
+	case StatusRejected:
+		return "Rejected"
+	case StatusAborted:
+		return "Aborted"
+	default:
+		return ""
+	}
+}
+
+//-----------------------------------------------------------------------------
+// ExecutorResult
+
+// ExecutorResult defines the result of the execution of the messages of a
+// group proposal
+type ExecutorResult byte
+
+// Valid executor results
+const (
+	ExecutorResultNotRun  ExecutorResult = 0x00
+	ExecutorResultSuccess ExecutorResult = 0x01
+	ExecutorResultFailure ExecutorResult = 0x02
+)
+
+// ExecutorResultFromString returns an ExecutorResult from a string. It returns
+// an error if the string is invalid.
+func ExecutorResultFromString(str string) (ExecutorResult, error) {
+	switch str {
+	case "NotRun":
+		return ExecutorResultNotRun, nil
+
+	case "Success":
+		return ExecutorResultSuccess, nil
+
+	case "Failure":
+		return ExecutorResultFailure, nil
+
+	default:
+		return ExecutorResult(0xff), fmt.Errorf("'%s' is not a valid executor result", str)
+	}
+}
+
+// MarshalJSON marshals to JSON using string.
+func (r ExecutorResult) MarshalJSON() ([]byte, error) {
+	return json.Marshal(r.String())
+}
+
+// UnmarshalJSON decodes from JSON string.
+func (r *ExecutorResult) UnmarshalJSON(data []byte) error {
+	var s string
+	if err := json.Unmarshal(data, &s); err != nil {
+		return err
+	}
+
+	bz, err := ExecutorResultFromString(s)
+	if err != nil {
+		return err
+	}
+
+	*r = bz
+	return nil
+}
+
+// String implements the Stringer interface.
+func (r ExecutorResult) String() string {
+	switch r {
+	case ExecutorResultNotRun:
+		return "NotRun"
+	case ExecutorResultSuccess:
+		return "Success"
+	case ExecutorResultFailure:
+		return "Failure"
+	default:
+		return ""
+	}
+}
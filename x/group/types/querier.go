@@ -0,0 +1,48 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// query endpoints supported by the group querier
+const (
+	QueryGroup         = "group"
+	QueryGroupMembers  = "group_members"
+	QueryGroupPolicy   = "group_policy"
+	QueryGroupPolicies = "group_policies"
+	QueryProposal      = "proposal"
+	QueryProposals     = "proposals"
+	QueryVotes         = "votes"
+)
+
+// QueryGroupParams defines the params for querying a group, its members or its
+// group policy accounts.
+type QueryGroupParams struct {
+	GroupID uint64 `json:"group_id"`
+}
+
+// NewQueryGroupParams creates a new QueryGroupParams instance.
+func NewQueryGroupParams(groupID uint64) QueryGroupParams {
+	return QueryGroupParams{GroupID: groupID}
+}
+
+// QueryGroupPolicyParams defines the params for querying a group policy account
+// or its proposals.
+type QueryGroupPolicyParams struct {
+	Address sdk.AccAddress `json:"address"`
+}
+
+// NewQueryGroupPolicyParams creates a new QueryGroupPolicyParams instance.
+func NewQueryGroupPolicyParams(address sdk.AccAddress) QueryGroupPolicyParams {
+	return QueryGroupPolicyParams{Address: address}
+}
+
+// QueryProposalParams defines the params for querying a proposal or its votes.
+type QueryProposalParams struct {
+	ProposalID uint64 `json:"proposal_id"`
+}
+
+// NewQueryProposalParams creates a new QueryProposalParams instance.
+func NewQueryProposalParams(proposalID uint64) QueryProposalParams {
+	return QueryProposalParams{ProposalID: proposalID}
+}
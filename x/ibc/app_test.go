@@ -21,9 +21,10 @@ func getMockApp(t *testing.T) *mock.App {
 	RegisterCodec(mapp.Cdc)
 	keyIBC := sdk.NewKVStoreKey("ibc")
 	ibcMapper := NewMapper(mapp.Cdc, keyIBC, DefaultCodespace)
-	bankKeeper := bank.NewBaseKeeper(mapp.AccountKeeper,
+	bankKeeper := bank.NewBaseKeeper(mapp.Cdc, mapp.KeyBank, mapp.AccountKeeper,
 		mapp.ParamsKeeper.Subspace(bank.DefaultParamspace),
-		bank.DefaultCodespace)
+		bank.DefaultCodespace, make(map[string]bool))
+	mapp.BankKeeper = bankKeeper
 	mapp.Router().AddRoute("ibc", NewHandler(ibcMapper, bankKeeper))
 
 	require.NoError(t, mapp.CompleteSetup(keyIBC))
@@ -39,15 +40,15 @@ func TestIBCMsgs(t *testing.T) {
 	priv1 := secp256k1.GenPrivKey()
 	addr1 := sdk.AccAddress(priv1.PubKey().Address())
 	coins := sdk.Coins{sdk.NewInt64Coin("foocoin", 10)}
-	var emptyCoins sdk.Coins
+	emptyCoins := sdk.NewCoins()
 
 	acc := &auth.BaseAccount{
 		Address: addr1,
-		Coins:   coins,
 	}
 	accs := []auth.Account{acc}
+	balances := []mock.Balance{{Address: addr1, Coins: coins}}
 
-	mock.SetGenesis(mapp, accs)
+	mock.SetGenesis(mapp, accs, balances)
 
 	// A checkTx context (true)
 	ctxCheck := mapp.BaseApp.NewContext(true, abci.Header{})
@@ -34,6 +34,7 @@ func setupTestInput() testInput {
 
 	ibcKey := sdk.NewKVStoreKey("ibcCapKey")
 	authCapKey := sdk.NewKVStoreKey("authCapKey")
+	keyBank := sdk.NewKVStoreKey(bank.StoreKey)
 	fckCapKey := sdk.NewKVStoreKey("fckCapKey")
 	keyParams := sdk.NewKVStoreKey("params")
 	tkeyParams := sdk.NewTransientStoreKey("transient_params")
@@ -41,6 +42,7 @@ func setupTestInput() testInput {
 	ms := store.NewCommitMultiStore(db)
 	ms.MountStoreWithDB(ibcKey, sdk.StoreTypeIAVL, db)
 	ms.MountStoreWithDB(authCapKey, sdk.StoreTypeIAVL, db)
+	ms.MountStoreWithDB(keyBank, sdk.StoreTypeIAVL, db)
 	ms.MountStoreWithDB(fckCapKey, sdk.StoreTypeIAVL, db)
 	ms.MountStoreWithDB(keyParams, sdk.StoreTypeIAVL, db)
 	ms.MountStoreWithDB(tkeyParams, sdk.StoreTypeTransient, db)
@@ -50,7 +52,7 @@ func setupTestInput() testInput {
 	ak := auth.NewAccountKeeper(
 		cdc, authCapKey, pk.Subspace(auth.DefaultParamspace), auth.ProtoBaseAccount,
 	)
-	bk := bank.NewBaseKeeper(ak, pk.Subspace(bank.DefaultParamspace), bank.DefaultCodespace)
+	bk := bank.NewBaseKeeper(cdc, keyBank, ak, pk.Subspace(bank.DefaultParamspace), bank.DefaultCodespace, make(map[string]bool))
 	ctx := sdk.NewContext(ms, abci.Header{ChainID: "test-chain-id"}, false, log.NewNopLogger())
 
 	ak.SetParams(ctx, auth.DefaultParams())
@@ -82,9 +84,7 @@ func newAddress() sdk.AccAddress {
 }
 
 func getCoins(ck bank.Keeper, ctx sdk.Context, addr sdk.AccAddress) (sdk.Coins, sdk.Error) {
-	zero := sdk.Coins(nil)
-	coins, err := ck.AddCoins(ctx, addr, zero)
-	return coins, err
+	return ck.GetCoins(ctx, addr), nil
 }
 
 func TestIBC(t *testing.T) {
@@ -94,7 +94,7 @@ func TestIBC(t *testing.T) {
 	src := newAddress()
 	dest := newAddress()
 	chainid := "ibcchain"
-	zero := sdk.Coins(nil)
+	zero := sdk.NewCoins()
 	mycoins := sdk.Coins{sdk.NewInt64Coin("mycoin", 10)}
 
 	coins, err := input.bk.AddCoins(ctx, src, mycoins)
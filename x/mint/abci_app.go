@@ -12,15 +12,24 @@ func BeginBlocker(ctx sdk.Context, k Keeper) {
 	params := k.GetParams(ctx)
 
 	// recalculate inflation rate
-	totalSupply := k.sk.TotalTokens(ctx)
-	bondedRatio := k.sk.BondedRatio(ctx)
+	totalStakingSupply := k.StakingTokenSupply(ctx)
+	bondedRatio := k.BondedRatio(ctx)
 	minter.Inflation = minter.NextInflationRate(params, bondedRatio)
-	minter.AnnualProvisions = minter.NextAnnualProvisions(params, totalSupply)
+	minter.AnnualProvisions = minter.NextAnnualProvisions(params, totalStakingSupply)
 	k.SetMinter(ctx, minter)
 
-	// mint coins, add to collected fees, update supply
+	// mint coins, update supply
 	mintedCoin := minter.BlockProvision(params)
-	k.fck.AddCollectedFees(ctx, sdk.Coins{mintedCoin})
-	k.sk.InflateSupply(ctx, mintedCoin.Amount)
+	mintedCoins := sdk.NewCoins(mintedCoin)
 
+	err := k.MintCoins(ctx, mintedCoins)
+	if err != nil {
+		panic(err)
+	}
+
+	// send the minted coins to the fee collector account
+	err = k.AddCollectedFees(ctx, mintedCoins)
+	if err != nil {
+		panic(err)
+	}
 }
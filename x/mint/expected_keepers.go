@@ -4,14 +4,16 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
-// expected staking keeper
+// StakingKeeper defines the expected staking keeper
 type StakingKeeper interface {
-	TotalTokens(ctx sdk.Context) sdk.Int
+	StakingTokenSupply(ctx sdk.Context) sdk.Int
 	BondedRatio(ctx sdk.Context) sdk.Dec
-	InflateSupply(ctx sdk.Context, newTokens sdk.Int)
 }
 
-// expected fee collection keeper interface
-type FeeCollectionKeeper interface {
-	AddCollectedFees(sdk.Context, sdk.Coins) sdk.Coins
+// SupplyKeeper defines the expected supply keeper
+type SupplyKeeper interface {
+	GetModuleAddress(name string) sdk.AccAddress
+
+	SendCoinsFromModuleToModule(ctx sdk.Context, senderModule, recipientModule string, amt sdk.Coins) sdk.Error
+	MintCoins(ctx sdk.Context, name string, amt sdk.Coins) sdk.Error
 }
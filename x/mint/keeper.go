@@ -1,29 +1,41 @@
 package mint
 
 import (
+	"fmt"
+
 	"github.com/cosmos/cosmos-sdk/codec"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/x/params"
 )
 
-// keeper of the staking store
+// keeper of the mint store
 type Keeper struct {
-	storeKey   sdk.StoreKey
-	cdc        *codec.Codec
-	paramSpace params.Subspace
-	sk         StakingKeeper
-	fck        FeeCollectionKeeper
+	storeKey         sdk.StoreKey
+	cdc              *codec.Codec
+	paramSpace       params.Subspace
+	sk               StakingKeeper
+	supplyKeeper     SupplyKeeper
+	feeCollectorName string
 }
 
+// NewKeeper creates a new mint Keeper. It panics if the mint module account
+// has not been registered in the supply keeper.
 func NewKeeper(cdc *codec.Codec, key sdk.StoreKey,
-	paramSpace params.Subspace, sk StakingKeeper, fck FeeCollectionKeeper) Keeper {
+	paramSpace params.Subspace, sk StakingKeeper, supplyKeeper SupplyKeeper,
+	feeCollectorName string) Keeper {
+
+	// ensure mint module account is set
+	if addr := supplyKeeper.GetModuleAddress(ModuleName); addr == nil {
+		panic(fmt.Sprintf("the %s module account has not been set", ModuleName))
+	}
 
 	keeper := Keeper{
-		storeKey:   key,
-		cdc:        cdc,
-		paramSpace: paramSpace.WithKeyTable(ParamKeyTable()),
-		sk:         sk,
-		fck:        fck,
+		storeKey:         key,
+		cdc:              cdc,
+		paramSpace:       paramSpace.WithKeyTable(ParamKeyTable()),
+		sk:               sk,
+		supplyKeeper:     supplyKeeper,
+		feeCollectorName: feeCollectorName,
 	}
 	return keeper
 }
@@ -60,3 +72,33 @@ func (k Keeper) GetParams(ctx sdk.Context) (params Params) {
 func (k Keeper) SetParams(ctx sdk.Context, params Params) {
 	k.paramSpace.SetParamSet(ctx, &params)
 }
+
+//______________________________________________________________________
+
+// StakingTokenSupply implements an alias call to the underlying staking keeper's
+// StakingTokenSupply to be used in BeginBlocker.
+func (k Keeper) StakingTokenSupply(ctx sdk.Context) sdk.Int {
+	return k.sk.StakingTokenSupply(ctx)
+}
+
+// BondedRatio implements an alias call to the underlying staking keeper's
+// BondedRatio to be used in BeginBlocker.
+func (k Keeper) BondedRatio(ctx sdk.Context) sdk.Dec {
+	return k.sk.BondedRatio(ctx)
+}
+
+// MintCoins implements an alias call to the underlying supply keeper's
+// MintCoins to be used in BeginBlocker.
+func (k Keeper) MintCoins(ctx sdk.Context, newCoins sdk.Coins) sdk.Error {
+	if newCoins.Empty() {
+		// skip as no coins need to be minted
+		return nil
+	}
+	return k.supplyKeeper.MintCoins(ctx, ModuleName, newCoins)
+}
+
+// AddCollectedFees implements an alias call to the underlying supply keeper's
+// SendCoinsFromModuleToModule to be used in BeginBlocker.
+func (k Keeper) AddCollectedFees(ctx sdk.Context, fees sdk.Coins) sdk.Error {
+	return k.supplyKeeper.SendCoinsFromModuleToModule(ctx, ModuleName, k.feeCollectorName, fees)
+}
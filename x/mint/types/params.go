@@ -59,18 +59,27 @@ func DefaultParams() Params {
 
 // validate params
 func ValidateParams(params Params) error {
-	if params.GoalBonded.LT(sdk.ZeroDec()) {
-		return fmt.Errorf("mint parameter GoalBonded should be positive, is %s ", params.GoalBonded.String())
+	if err := validateMintDenom(params.MintDenom); err != nil {
+		return err
 	}
-	if params.GoalBonded.GT(sdk.OneDec()) {
-		return fmt.Errorf("mint parameter GoalBonded must be <= 1, is %s", params.GoalBonded.String())
+	if err := validateInflationRateChange(params.InflationRateChange); err != nil {
+		return err
+	}
+	if err := validateInflationMax(params.InflationMax); err != nil {
+		return err
+	}
+	if err := validateInflationMin(params.InflationMin); err != nil {
+		return err
+	}
+	if err := validateGoalBonded(params.GoalBonded); err != nil {
+		return err
+	}
+	if err := validateBlocksPerYear(params.BlocksPerYear); err != nil {
+		return err
 	}
 	if params.InflationMax.LT(params.InflationMin) {
 		return fmt.Errorf("mint parameter Max inflation must be greater than or equal to min inflation")
 	}
-	if params.MintDenom == "" {
-		return fmt.Errorf("mint parameter MintDenom can't be an empty string")
-	}
 	return nil
 }
 
@@ -91,11 +100,95 @@ func (p Params) String() string {
 // Implements params.ParamSet
 func (p *Params) ParamSetPairs() params.ParamSetPairs {
 	return params.ParamSetPairs{
-		{KeyMintDenom, &p.MintDenom},
-		{KeyInflationRateChange, &p.InflationRateChange},
-		{KeyInflationMax, &p.InflationMax},
-		{KeyInflationMin, &p.InflationMin},
-		{KeyGoalBonded, &p.GoalBonded},
-		{KeyBlocksPerYear, &p.BlocksPerYear},
+		params.NewParamSetPair(KeyMintDenom, &p.MintDenom, validateMintDenom),
+		params.NewParamSetPair(KeyInflationRateChange, &p.InflationRateChange, validateInflationRateChange),
+		params.NewParamSetPair(KeyInflationMax, &p.InflationMax, validateInflationMax),
+		params.NewParamSetPair(KeyInflationMin, &p.InflationMin, validateInflationMin),
+		params.NewParamSetPair(KeyGoalBonded, &p.GoalBonded, validateGoalBonded),
+		params.NewParamSetPair(KeyBlocksPerYear, &p.BlocksPerYear, validateBlocksPerYear),
+	}
+}
+
+func validateMintDenom(i interface{}) error {
+	v, ok := i.(string)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v == "" {
+		return fmt.Errorf("mint parameter MintDenom can't be an empty string")
+	}
+	if err := sdk.ValidateDenom(v); err != nil {
+		return fmt.Errorf("mint parameter MintDenom: %s", err)
 	}
+
+	return nil
+}
+
+func validateInflationRateChange(i interface{}) error {
+	v, ok := i.(sdk.Dec)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v.IsNil() || v.IsNegative() || v.GT(sdk.OneDec()) {
+		return fmt.Errorf("mint parameter InflationRateChange must be between 0 and 1, is %s", v)
+	}
+
+	return nil
+}
+
+func validateInflationMax(i interface{}) error {
+	v, ok := i.(sdk.Dec)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v.IsNil() || v.IsNegative() || v.GT(sdk.OneDec()) {
+		return fmt.Errorf("mint parameter InflationMax must be between 0 and 1, is %s", v)
+	}
+
+	return nil
+}
+
+func validateInflationMin(i interface{}) error {
+	v, ok := i.(sdk.Dec)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v.IsNil() || v.IsNegative() || v.GT(sdk.OneDec()) {
+		return fmt.Errorf("mint parameter InflationMin must be between 0 and 1, is %s", v)
+	}
+
+	return nil
+}
+
+func validateGoalBonded(i interface{}) error {
+	v, ok := i.(sdk.Dec)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v.IsNil() || !v.IsPositive() {
+		return fmt.Errorf("mint parameter GoalBonded should be positive, is %s", v)
+	}
+	if v.GT(sdk.OneDec()) {
+		return fmt.Errorf("mint parameter GoalBonded must be <= 1, is %s", v)
+	}
+
+	return nil
+}
+
+func validateBlocksPerYear(i interface{}) error {
+	v, ok := i.(uint64)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v == 0 {
+		return fmt.Errorf("mint parameter BlocksPerYear must be positive")
+	}
+
+	return nil
 }
@@ -28,22 +28,54 @@ const chainID = ""
 // capabilities aren't needed for testing.
 type App struct {
 	*bam.BaseApp
-	Cdc              *codec.Codec // Cdc is public since the codec is passed into the module anyways
-	KeyMain          *sdk.KVStoreKey
-	KeyAccount       *sdk.KVStoreKey
-	KeyFeeCollection *sdk.KVStoreKey
-	KeyParams        *sdk.KVStoreKey
-	TKeyParams       *sdk.TransientStoreKey
+	Cdc        *codec.Codec // Cdc is public since the codec is passed into the module anyways
+	KeyMain    *sdk.KVStoreKey
+	KeyAccount *sdk.KVStoreKey
+	KeyBank    *sdk.KVStoreKey
+	KeyParams  *sdk.KVStoreKey
+	TKeyParams *sdk.TransientStoreKey
 
 	// TODO: Abstract this out from not needing to be auth specifically
-	AccountKeeper       auth.AccountKeeper
-	FeeCollectionKeeper auth.FeeCollectionKeeper
-	ParamsKeeper        params.Keeper
+	AccountKeeper auth.AccountKeeper
+	ParamsKeeper  params.Keeper
+
+	// BankKeeper holds the balances of the accounts. It must be set by the
+	// module being tested before the genesis is loaded.
+	BankKeeper BankKeeper
 
 	GenesisAccounts  []auth.Account
+	GenesisBalances  []Balance
 	TotalCoinsSupply sdk.Coins
 }
 
+// BankKeeper defines the bank keeper used by the mock app to set and check the
+// balances of the accounts.
+type BankKeeper interface {
+	GetCoins(ctx sdk.Context, addr sdk.AccAddress) sdk.Coins
+	SetCoins(ctx sdk.Context, addr sdk.AccAddress, amt sdk.Coins) sdk.Error
+	SendCoins(ctx sdk.Context, fromAddr sdk.AccAddress, toAddr sdk.AccAddress, amt sdk.Coins) sdk.Error
+}
+
+// Balance defines the coins held by an account at genesis.
+type Balance struct {
+	Address sdk.AccAddress
+	Coins   sdk.Coins
+}
+
+// supplyKeeper is used by the ante handler of the mock app to deduct fees. The
+// fees are moved through the bank keeper of the app.
+type supplyKeeper struct {
+	auth.DummySupplyKeeper
+	app *App
+}
+
+// SendCoinsFromAccountToModule transfers coins from an account to a module
+// account through the bank keeper of the app.
+func (sk supplyKeeper) SendCoinsFromAccountToModule(ctx sdk.Context, fromAddr sdk.AccAddress, recipientModule string, amt sdk.Coins) sdk.Error {
+	moduleAcc := sk.GetModuleAccount(ctx, recipientModule)
+	return sk.app.BankKeeper.SendCoins(ctx, fromAddr, moduleAcc.GetAddress(), amt)
+}
+
 // NewApp partially constructs a new app on the memstore for module and genesis
 // testing.
 func NewApp() *App {
@@ -59,7 +91,7 @@ func NewApp() *App {
 		Cdc:              cdc,
 		KeyMain:          sdk.NewKVStoreKey(bam.MainStoreKey),
 		KeyAccount:       sdk.NewKVStoreKey(auth.StoreKey),
-		KeyFeeCollection: sdk.NewKVStoreKey("fee"),
+		KeyBank:          sdk.NewKVStoreKey("bank"),
 		KeyParams:        sdk.NewKVStoreKey("params"),
 		TKeyParams:       sdk.NewTransientStoreKey("transient_params"),
 		TotalCoinsSupply: sdk.NewCoins(),
@@ -74,15 +106,13 @@ func NewApp() *App {
 		app.ParamsKeeper.Subspace(auth.DefaultParamspace),
 		auth.ProtoBaseAccount,
 	)
-	app.FeeCollectionKeeper = auth.NewFeeCollectionKeeper(
-		app.Cdc,
-		app.KeyFeeCollection,
-	)
+
+	supplyKeeper := supplyKeeper{auth.NewDummySupplyKeeper(app.AccountKeeper, app.KeyBank), app}
 
 	// Initialize the app. The chainers and blockers can be overwritten before
 	// calling complete setup.
 	app.SetInitChainer(app.InitChainer)
-	app.SetAnteHandler(auth.NewAnteHandler(app.AccountKeeper, app.FeeCollectionKeeper, auth.DefaultSigVerificationGasConsumer))
+	app.SetAnteHandler(auth.NewAnteHandler(app.AccountKeeper, supplyKeeper, nil, auth.DefaultSigVerificationGasConsumer))
 
 	// Not sealing for custom extension
 
@@ -94,7 +124,7 @@ func NewApp() *App {
 func (app *App) CompleteSetup(newKeys ...sdk.StoreKey) error {
 	newKeys = append(
 		newKeys,
-		app.KeyMain, app.KeyAccount, app.KeyParams, app.TKeyParams, app.KeyFeeCollection,
+		app.KeyMain, app.KeyAccount, app.KeyBank, app.KeyParams, app.TKeyParams,
 	)
 
 	for _, key := range newKeys {
@@ -119,11 +149,15 @@ func (app *App) InitChainer(ctx sdk.Context, _ abci.RequestInitChain) abci.Respo
 	// Load the genesis accounts
 	for _, genacc := range app.GenesisAccounts {
 		acc := app.AccountKeeper.NewAccountWithAddress(ctx, genacc.GetAddress())
-		acc.SetCoins(genacc.GetCoins())
 		app.AccountKeeper.SetAccount(ctx, acc)
 	}
 
-	auth.InitGenesis(ctx, app.AccountKeeper, app.FeeCollectionKeeper, auth.DefaultGenesisState())
+	// Load the genesis balances
+	for _, balance := range app.GenesisBalances {
+		app.BankKeeper.SetCoins(ctx, balance.Address, balance.Coins)
+	}
+
+	auth.InitGenesis(ctx, app.AccountKeeper, auth.DefaultGenesisState())
 
 	return abci.ResponseInitChain{}
 }
@@ -169,9 +203,9 @@ func (b AddrKeysSlice) Swap(i, j int) {
 	b[j], b[i] = b[i], b[j]
 }
 
-// CreateGenAccounts generates genesis accounts loaded with coins, and returns
-// their addresses, pubkeys, and privkeys.
-func CreateGenAccounts(numAccs int, genCoins sdk.Coins) (genAccs []auth.Account,
+// CreateGenAccounts generates genesis accounts along with their balances of
+// coins, and returns their addresses, pubkeys, and privkeys.
+func CreateGenAccounts(numAccs int, genCoins sdk.Coins) (genAccs []auth.Account, genBalances []Balance,
 	addrs []sdk.AccAddress, pubKeys []crypto.PubKey, privKeys []crypto.PrivKey) {
 
 	addrKeysSlice := AddrKeysSlice{}
@@ -192,18 +226,19 @@ func CreateGenAccounts(numAccs int, genCoins sdk.Coins) (genAccs []auth.Account,
 		privKeys = append(privKeys, addrKeysSlice[i].PrivKey)
 		genAccs = append(genAccs, &auth.BaseAccount{
 			Address: addrKeysSlice[i].Address,
-			Coins:   genCoins,
 		})
+		genBalances = append(genBalances, Balance{addrKeysSlice[i].Address, genCoins})
 	}
 
 	return
 }
 
-// SetGenesis sets the mock app genesis accounts.
-func SetGenesis(app *App, accs []auth.Account) {
+// SetGenesis sets the mock app genesis accounts and balances.
+func SetGenesis(app *App, accs []auth.Account, balances []Balance) {
 	// Pass the accounts in via the application (lazy) instead of through
 	// RequestInitChain.
 	app.GenesisAccounts = accs
+	app.GenesisBalances = balances
 
 	app.InitChain(abci.RequestInitChain{})
 	app.Commit()
@@ -288,6 +323,7 @@ func GeneratePrivKeyAddressPairsFromRand(rand *rand.Rand, n int) (keys []crypto.
 // nolint: errcheck
 func RandomSetGenesis(r *rand.Rand, app *App, addrs []sdk.AccAddress, denoms []string) {
 	accts := make([]auth.Account, len(addrs))
+	balances := make([]Balance, len(addrs))
 	randCoinIntervals := []BigInterval{
 		{sdk.NewIntWithDecimal(1, 0), sdk.NewIntWithDecimal(1, 1)},
 		{sdk.NewIntWithDecimal(1, 2), sdk.NewIntWithDecimal(1, 3)},
@@ -307,10 +343,11 @@ func RandomSetGenesis(r *rand.Rand, app *App, addrs []sdk.AccAddress, denoms []s
 		app.TotalCoinsSupply = app.TotalCoinsSupply.Add(coins)
 		baseAcc := auth.NewBaseAccountWithAddress(addrs[i])
 
-		(&baseAcc).SetCoins(coins)
 		accts[i] = &baseAcc
+		balances[i] = Balance{addrs[i], sdk.NewCoins(coins...)}
 	}
 	app.GenesisAccounts = accts
+	app.GenesisBalances = balances
 }
 
 // GenSequenceOfTxs generates a set of signed transactions of messages, such
@@ -13,9 +13,9 @@ import (
 const msgRoute = "testMsg"
 
 var (
-	numAccts                       = 2
-	genCoins                       = sdk.Coins{sdk.NewInt64Coin("foocoin", 77)}
-	accs, addrs, pubKeys, privKeys = CreateGenAccounts(numAccts, genCoins)
+	numAccts                          = 2
+	genCoins                          = sdk.Coins{sdk.NewInt64Coin("foocoin", 77)}
+	accs, _, addrs, pubKeys, privKeys = CreateGenAccounts(numAccts, genCoins)
 )
 
 // testMsg is a mock transaction that has a validation which can fail.
@@ -52,7 +52,7 @@ func TestCheckAndDeliverGenTx(t *testing.T) {
 	mApp := getMockApp(t)
 	mApp.Cdc.RegisterConcrete(testMsg{}, "mock/testMsg", nil)
 
-	SetGenesis(mApp, accs)
+	SetGenesis(mApp, accs, nil)
 	ctxCheck := mApp.BaseApp.NewContext(true, abci.Header{})
 
 	msg := testMsg{signers: []sdk.AccAddress{addrs[0]}, positiveNum: 1}
@@ -91,7 +91,7 @@ func TestCheckGenTx(t *testing.T) {
 	mApp := getMockApp(t)
 	mApp.Cdc.RegisterConcrete(testMsg{}, "mock/testMsg", nil)
 
-	SetGenesis(mApp, accs)
+	SetGenesis(mApp, accs, nil)
 
 	msg1 := testMsg{signers: []sdk.AccAddress{addrs[0]}, positiveNum: 1}
 	CheckGenTx(
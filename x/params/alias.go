@@ -11,43 +11,64 @@ import (
 )
 
 const (
-	StoreKey             = subspace.StoreKey
-	TStoreKey            = subspace.TStoreKey
-	TestParamStore       = subspace.TestParamStore
-	DefaultCodespace     = types.DefaultCodespace
-	CodeUnknownSubspace  = types.CodeUnknownSubspace
-	CodeSettingParameter = types.CodeSettingParameter
-	CodeEmptyData        = types.CodeEmptyData
-	ModuleName           = types.ModuleName
-	RouterKey            = types.RouterKey
-	ProposalTypeChange   = types.ProposalTypeChange
+	StoreKey                          = subspace.StoreKey
+	TStoreKey                         = subspace.TStoreKey
+	TestParamStore                    = subspace.TestParamStore
+	DefaultCodespace                  = types.DefaultCodespace
+	CodeUnknownSubspace               = types.CodeUnknownSubspace
+	CodeSettingParameter              = types.CodeSettingParameter
+	CodeEmptyData                     = types.CodeEmptyData
+	CodeUnknownKey                    = types.CodeUnknownKey
+	ModuleName                        = types.ModuleName
+	RouterKey                         = types.RouterKey
+	QuerierRoute                      = types.QuerierRoute
+	QuerySubspace                     = types.QuerySubspace
+	QueryKeys                         = types.QueryKeys
+	ProposalTypeChange                = types.ProposalTypeChange
+	CodeInvalidConsensusParams        = types.CodeInvalidConsensusParams
+	ProposalTypeConsensusParamsChange = types.ProposalTypeConsensusParamsChange
 )
 
 var (
 	// functions aliases
-	NewSubspace                = subspace.NewSubspace
-	NewKeyTable                = subspace.NewKeyTable
-	DefaultTestComponents      = subspace.DefaultTestComponents
-	RegisterCodec              = types.RegisterCodec
-	ErrUnknownSubspace         = types.ErrUnknownSubspace
-	ErrSettingParameter        = types.ErrSettingParameter
-	ErrEmptyChanges            = types.ErrEmptyChanges
-	ErrEmptySubspace           = types.ErrEmptySubspace
-	ErrEmptyKey                = types.ErrEmptyKey
-	ErrEmptyValue              = types.ErrEmptyValue
-	NewParameterChangeProposal = types.NewParameterChangeProposal
-	NewParamChange             = types.NewParamChange
-	NewParamChangeWithSubkey   = types.NewParamChangeWithSubkey
-	ValidateChanges            = types.ValidateChanges
+	NewSubspace                      = subspace.NewSubspace
+	NewKeyTable                      = subspace.NewKeyTable
+	NewParamSetPair                  = subspace.NewParamSetPair
+	DefaultTestComponents            = subspace.DefaultTestComponents
+	RegisterCodec                    = types.RegisterCodec
+	ErrUnknownSubspace               = types.ErrUnknownSubspace
+	ErrUnknownKey                    = types.ErrUnknownKey
+	ErrSettingParameter              = types.ErrSettingParameter
+	ErrEmptyChanges                  = types.ErrEmptyChanges
+	ErrEmptySubspace                 = types.ErrEmptySubspace
+	ErrEmptyKey                      = types.ErrEmptyKey
+	ErrEmptyValue                    = types.ErrEmptyValue
+	NewParameterChangeProposal       = types.NewParameterChangeProposal
+	NewParamChange                   = types.NewParamChange
+	NewParamChangeWithSubkey         = types.NewParamChangeWithSubkey
+	ValidateChanges                  = types.ValidateChanges
+	NewQuerySubspaceParams           = types.NewQuerySubspaceParams
+	NewSubspaceParamsResponse        = types.NewSubspaceParamsResponse
+	NewSubspaceKeysResponse          = types.NewSubspaceKeysResponse
+	ErrEmptyConsensusParams          = types.ErrEmptyConsensusParams
+	ErrInvalidConsensusParams        = types.ErrInvalidConsensusParams
+	NewConsensusParamsChangeProposal = types.NewConsensusParamsChangeProposal
+	ValidateConsensusParamsUpdate    = types.ValidateConsensusParamsUpdate
+	MergeConsensusParams             = types.MergeConsensusParams
 )
 
 type (
-	ParamSetPair            = subspace.ParamSetPair
-	ParamSetPairs           = subspace.ParamSetPairs
-	ParamSet                = subspace.ParamSet
-	Subspace                = subspace.Subspace
-	ReadOnlySubspace        = subspace.ReadOnlySubspace
-	KeyTable                = subspace.KeyTable
-	ParameterChangeProposal = types.ParameterChangeProposal
-	ParamChange             = types.ParamChange
+	ValueValidatorFn              = subspace.ValueValidatorFn
+	ParamSetPair                  = subspace.ParamSetPair
+	ParamSetPairs                 = subspace.ParamSetPairs
+	ParamSet                      = subspace.ParamSet
+	Subspace                      = subspace.Subspace
+	ReadOnlySubspace              = subspace.ReadOnlySubspace
+	KeyTable                      = subspace.KeyTable
+	ParameterChangeProposal       = types.ParameterChangeProposal
+	ParamChange                   = types.ParamChange
+	QuerySubspaceParams           = types.QuerySubspaceParams
+	SubspaceParamsResponse        = types.SubspaceParamsResponse
+	SubspaceKeysResponse          = types.SubspaceKeysResponse
+	ConsensusParamsChangeProposal = types.ConsensusParamsChangeProposal
 )
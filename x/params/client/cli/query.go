@@ -0,0 +1,116 @@
+package cli
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/context"
+	"github.com/cosmos/cosmos-sdk/client/utils"
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/version"
+	"github.com/cosmos/cosmos-sdk/x/params/types"
+)
+
+// GetQueryCmd returns the cli query commands for the params module.
+func GetQueryCmd(cdc *codec.Codec) *cobra.Command {
+	paramsQueryCmd := &cobra.Command{
+		Use:                        types.ModuleName,
+		Short:                      "Querying commands for the params module",
+		DisableFlagParsing:         true,
+		SuggestionsMinimumDistance: 2,
+		RunE:                       utils.ValidateCmd,
+	}
+
+	paramsQueryCmd.AddCommand(
+		client.GetCommands(
+			GetCmdQuerySubspaceParam(cdc),
+			GetCmdQuerySubspaceKeys(cdc),
+		)...,
+	)
+
+	return paramsQueryCmd
+}
+
+// GetCmdQuerySubspaceParam implements a command to return the current value of
+// a parameter of any registered subspace.
+func GetCmdQuerySubspaceParam(cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "subspace [subspace] [key]",
+		Args:  cobra.ExactArgs(2),
+		Short: "Query the raw value of a parameter of any subspace",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the stored value of a parameter by its subspace and key.
+The registered keys of a subspace can be listed with the "keys" command.
+
+Example:
+$ %s query params subspace staking MaxValidators
+`,
+				version.ClientName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			bz, err := cdc.MarshalJSON(types.NewQuerySubspaceParams(args[0], args[1]))
+			if err != nil {
+				return err
+			}
+
+			route := fmt.Sprintf("custom/%s/%s", types.QuerierRoute, types.QuerySubspace)
+			res, err := cliCtx.QueryWithData(route, bz)
+			if err != nil {
+				return err
+			}
+
+			var resp types.SubspaceParamsResponse
+			if err := cdc.UnmarshalJSON(res, &resp); err != nil {
+				return err
+			}
+
+			return cliCtx.PrintOutput(resp)
+		},
+	}
+}
+
+// GetCmdQuerySubspaceKeys implements a command to return all the parameter
+// keys registered in a subspace.
+func GetCmdQuerySubspaceKeys(cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "keys [subspace]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Query the parameter keys registered in a subspace",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query all the parameter keys registered in a subspace.
+
+Example:
+$ %s query params keys staking
+`,
+				version.ClientName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			bz, err := cdc.MarshalJSON(types.NewQuerySubspaceParams(args[0], ""))
+			if err != nil {
+				return err
+			}
+
+			route := fmt.Sprintf("custom/%s/%s", types.QuerierRoute, types.QueryKeys)
+			res, err := cliCtx.QueryWithData(route, bz)
+			if err != nil {
+				return err
+			}
+
+			var resp types.SubspaceKeysResponse
+			if err := cdc.UnmarshalJSON(res, &resp); err != nil {
+				return err
+			}
+
+			return cliCtx.PrintOutput(resp)
+		},
+	}
+}
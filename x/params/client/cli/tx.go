@@ -12,7 +12,7 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/version"
 	auth "github.com/cosmos/cosmos-sdk/x/auth"
-	"github.com/cosmos/cosmos-sdk/x/gov"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
 	paramscutils "github.com/cosmos/cosmos-sdk/x/params/client/utils"
 	"github.com/cosmos/cosmos-sdk/x/params/types"
 )
@@ -77,7 +77,71 @@ Where proposal.json contains:
 			from := cliCtx.GetFromAddress()
 			content := types.NewParameterChangeProposal(proposal.Title, proposal.Description, proposal.Changes.ToParamChanges())
 
-			msg := gov.NewMsgSubmitProposal(content, proposal.Deposit, from)
+			msg := govtypes.NewMsgSubmitProposal(content, proposal.Deposit, from, false)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return utils.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
+		},
+	}
+
+	return cmd
+}
+
+// GetCmdSubmitConsensusParamsProposal implements a command handler for
+// submitting a consensus params change proposal transaction.
+func GetCmdSubmitConsensusParamsProposal(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "consensus-params-change [proposal-file]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Submit a consensus params change proposal",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Submit a proposal to update the Tendermint consensus params along with an
+initial deposit. The proposal details must be supplied via a JSON file. Only the
+groups of params which are present (block, evidence, validator) are updated, each
+group being replaced as a whole. The new params take effect from the block after
+the one in which the proposal passes.
+
+Example:
+$ %s tx gov submit-proposal consensus-params-change <path/to/proposal.json> --from=<key_or_address>
+
+Where proposal.json contains:
+
+{
+  "title": "Raise Block Gas Limit",
+  "description": "Double the maximum gas per block",
+  "block": {
+    "max_bytes": "22020096",
+    "max_gas": "20000000"
+  },
+  "deposit": [
+    {
+      "denom": "stake",
+      "amount": "10000"
+    }
+  ]
+}
+`,
+				version.ClientName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			txBldr := auth.NewTxBuilderFromCLI().WithTxEncoder(utils.GetTxEncoder(cdc))
+			cliCtx := context.NewCLIContext().
+				WithCodec(cdc).
+				WithAccountDecoder(cdc)
+
+			proposal, err := paramscutils.ParseConsensusParamsChangeProposalJSON(cdc, args[0])
+			if err != nil {
+				return err
+			}
+
+			from := cliCtx.GetFromAddress()
+			content := types.NewConsensusParamsChangeProposal(proposal.Title, proposal.Description,
+				proposal.Block, proposal.Evidence, proposal.Validator)
+
+			msg := govtypes.NewMsgSubmitProposal(content, proposal.Deposit, from, false)
 			if err := msg.ValidateBasic(); err != nil {
 				return err
 			}
@@ -6,5 +6,8 @@ import (
 	"github.com/cosmos/cosmos-sdk/x/params/client/rest"
 )
 
-// param change proposal handler
-var ProposalHandler = govclient.NewProposalHandler(cli.GetCmdSubmitProposal, rest.ProposalRESTHandler)
+// param change and consensus params change proposal handlers
+var (
+	ProposalHandler                = govclient.NewProposalHandler(cli.GetCmdSubmitProposal, rest.ProposalRESTHandler)
+	ConsensusParamsProposalHandler = govclient.NewProposalHandler(cli.GetCmdSubmitConsensusParamsProposal, rest.ConsensusParamsProposalRESTHandler)
+)
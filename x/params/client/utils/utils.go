@@ -4,10 +4,12 @@ import (
 	"encoding/json"
 	"io/ioutil"
 
+	abci "github.com/tendermint/tendermint/abci/types"
+
 	"github.com/cosmos/cosmos-sdk/codec"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/rest"
-	"github.com/cosmos/cosmos-sdk/x/params"
+	"github.com/cosmos/cosmos-sdk/x/params/types"
 )
 
 type (
@@ -43,6 +45,32 @@ type (
 		Proposer    sdk.AccAddress   `json:"proposer"`
 		Deposit     sdk.Coins        `json:"deposit"`
 	}
+
+	// ConsensusParamsChangeProposalJSON defines a ConsensusParamsChangeProposal
+	// with a deposit used to parse consensus params change proposals from a JSON
+	// file.
+	ConsensusParamsChangeProposalJSON struct {
+		Title       string                `json:"title"`
+		Description string                `json:"description"`
+		Block       *abci.BlockParams     `json:"block,omitempty"`
+		Evidence    *abci.EvidenceParams  `json:"evidence,omitempty"`
+		Validator   *abci.ValidatorParams `json:"validator,omitempty"`
+		Deposit     sdk.Coins             `json:"deposit"`
+	}
+
+	// ConsensusParamsChangeProposalReq defines a consensus params change
+	// proposal request body.
+	ConsensusParamsChangeProposalReq struct {
+		BaseReq rest.BaseReq `json:"base_req"`
+
+		Title       string                `json:"title"`
+		Description string                `json:"description"`
+		Block       *abci.BlockParams     `json:"block,omitempty"`
+		Evidence    *abci.EvidenceParams  `json:"evidence,omitempty"`
+		Validator   *abci.ValidatorParams `json:"validator,omitempty"`
+		Proposer    sdk.AccAddress        `json:"proposer"`
+		Deposit     sdk.Coins             `json:"deposit"`
+	}
 )
 
 func NewParamChangeJSON(subspace, key, subkey string, value json.RawMessage) ParamChangeJSON {
@@ -50,14 +78,14 @@ func NewParamChangeJSON(subspace, key, subkey string, value json.RawMessage) Par
 }
 
 // ToParamChange converts a ParamChangeJSON object to ParamChange.
-func (pcj ParamChangeJSON) ToParamChange() params.ParamChange {
-	return params.NewParamChangeWithSubkey(pcj.Subspace, pcj.Key, pcj.Subkey, string(pcj.Value))
+func (pcj ParamChangeJSON) ToParamChange() types.ParamChange {
+	return types.NewParamChangeWithSubkey(pcj.Subspace, pcj.Key, pcj.Subkey, string(pcj.Value))
 }
 
 // ToParamChanges converts a slice of ParamChangeJSON objects to a slice of
 // ParamChange.
-func (pcj ParamChangesJSON) ToParamChanges() []params.ParamChange {
-	res := make([]params.ParamChange, len(pcj))
+func (pcj ParamChangesJSON) ToParamChanges() []types.ParamChange {
+	res := make([]types.ParamChange, len(pcj))
 	for i, pc := range pcj {
 		res[i] = pc.ToParamChange()
 	}
@@ -80,3 +108,22 @@ func ParseParamChangeProposalJSON(cdc *codec.Codec, proposalFile string) (ParamC
 
 	return proposal, nil
 }
+
+// ParseConsensusParamsChangeProposalJSON reads and parses a
+// ConsensusParamsChangeProposalJSON from file.
+func ParseConsensusParamsChangeProposalJSON(cdc *codec.Codec,
+	proposalFile string) (ConsensusParamsChangeProposalJSON, error) {
+
+	proposal := ConsensusParamsChangeProposalJSON{}
+
+	contents, err := ioutil.ReadFile(proposalFile)
+	if err != nil {
+		return proposal, err
+	}
+
+	if err := cdc.UnmarshalJSON(contents, &proposal); err != nil {
+		return proposal, err
+	}
+
+	return proposal, nil
+}
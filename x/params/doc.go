@@ -35,11 +35,21 @@ recommended to use the same name with the module's.
 
 	func ParamKeyTable() params.KeyTable {
 		return params.NewKeyTable(
-			KeyParameter1, MyStruct{},
-			KeyParameter2, MyStruct{},
+			params.NewParamSetPair(KeyParameter1, MyStruct{}, validateMyStruct),
+			params.NewParamSetPair(KeyParameter2, MyStruct{}, validateMyStruct),
 		)
 	}
 
+	func validateMyStruct(i interface{}) error {
+		_, ok := i.(MyStruct)
+		if !ok {
+			return fmt.Errorf("invalid parameter type: %T", i)
+		}
+
+		// validate the parameter value
+		return nil
+	}
+
 	func NewKeeper(cdc *codec.Codec, key sdk.StoreKey, ps params.Subspace) Keeper {
 		return Keeper {
 			cdc: cdc,
@@ -52,6 +62,11 @@ Pass a params.Subspace to NewKeeper with DefaultParamspace (or another)
 
 	app.myKeeper = mymodule.NewKeeper(app.paramStore.SubStore(mymodule.DefaultParamspace))
 
+Every parameter is registered along with a validation function. Set and Update
+reject values for which the validation function returns an error, so that
+parameters can never be set to invalid values, including through governance
+parameter change proposals.
+
 Now we can access to the paramstore using Paramstore Keys
 
 	var param MyStruct
@@ -64,7 +79,7 @@ inheriting the key properties.
 
 	func ParamKeyTable() params.KeyTable {
 		return params.NewKeyTable(
-			KeyParamMain, MyStruct{},
+			params.NewParamSetPair(KeyParamMain, MyStruct{}, validateMyStruct),
 		)
 	}
 
@@ -84,11 +99,11 @@ be able to be passed to SetParamSet.
 	}
 
 	// Implements params.ParamSet
-	// KeyValuePairs must return the list of (ParamKey, PointerToTheField)
-	func (p *MyParams) KeyValuePairs() params.KeyValuePairs {
-		return params.KeyFieldPairs {
-			{KeyParameter1, &p.Parameter1},
-			{KeyParameter2, &p.Parameter2},
+	// ParamSetPairs must return the list of (ParamKey, PointerToTheField, ValidatorFn)
+	func (p *MyParams) ParamSetPairs() params.ParamSetPairs {
+		return params.ParamSetPairs{
+			params.NewParamSetPair(KeyParameter1, &p.Parameter1, validateParameter1),
+			params.NewParamSetPair(KeyParameter2, &p.Parameter2, validateParameter2),
 		}
 	}
 
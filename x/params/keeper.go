@@ -1,13 +1,22 @@
 package params
 
 import (
+	"github.com/gogo/protobuf/proto"
+
 	"github.com/cosmos/cosmos-sdk/codec"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/x/params/subspace"
+	"github.com/cosmos/cosmos-sdk/x/params/types"
 
+	abci "github.com/tendermint/tendermint/abci/types"
 	"github.com/tendermint/tendermint/libs/log"
 )
 
+// consensusParamsUpdateKey is the transient store key of the consensus params
+// updated during the current block. It holds no '/', so that it cannot collide
+// with the keys of the subspaces.
+var consensusParamsUpdateKey = []byte("consensus_params_update")
+
 // Keeper of the global paramstore
 type Keeper struct {
 	cdc       *codec.Codec
@@ -60,3 +69,31 @@ func (k Keeper) GetSubspace(s string) (Subspace, bool) {
 	}
 	return *space, ok
 }
+
+// SetConsensusParamsUpdate records an update of the consensus params, to be
+// returned to Tendermint at the end of the current block. It is merged with the
+// updates already recorded during the block.
+func (k Keeper) SetConsensusParamsUpdate(ctx sdk.Context, update *abci.ConsensusParams) {
+	merged := types.MergeConsensusParams(k.GetConsensusParamsUpdate(ctx), update)
+
+	bz, err := proto.Marshal(merged)
+	if err != nil {
+		panic(err)
+	}
+	ctx.TransientStore(k.tkey).Set(consensusParamsUpdateKey, bz)
+}
+
+// GetConsensusParamsUpdate returns the update of the consensus params recorded
+// during the current block, or nil if there is none.
+func (k Keeper) GetConsensusParamsUpdate(ctx sdk.Context) *abci.ConsensusParams {
+	bz := ctx.TransientStore(k.tkey).Get(consensusParamsUpdateKey)
+	if bz == nil {
+		return nil
+	}
+
+	update := &abci.ConsensusParams{}
+	if err := proto.Unmarshal(bz, update); err != nil {
+		panic(err)
+	}
+	return update
+}
@@ -13,6 +13,9 @@ func NewParamChangeProposalHandler(k Keeper) govtypes.Handler {
 		case ParameterChangeProposal:
 			return handleParameterChangeProposal(ctx, k, c)
 
+		case ConsensusParamsChangeProposal:
+			return handleConsensusParamsChangeProposal(ctx, k, c)
+
 		default:
 			errMsg := fmt.Sprintf("unrecognized param proposal content type: %T", c)
 			return sdk.ErrUnknownRequest(errMsg)
@@ -48,3 +51,15 @@ func handleParameterChangeProposal(ctx sdk.Context, k Keeper, p ParameterChangeP
 
 	return nil
 }
+
+func handleConsensusParamsChangeProposal(ctx sdk.Context, k Keeper, p ConsensusParamsChangeProposal) sdk.Error {
+	update := p.ConsensusParamsUpdate()
+	if err := ValidateConsensusParamsUpdate(update); err != nil {
+		return err
+	}
+
+	k.Logger(ctx).Info(fmt.Sprintf("updating consensus params; %s", update))
+	k.SetConsensusParamsUpdate(ctx, update)
+
+	return nil
+}
@@ -1,6 +1,7 @@
 package params_test
 
 import (
+	"fmt"
 	"testing"
 
 	"github.com/stretchr/testify/require"
@@ -43,11 +44,37 @@ type testParams struct {
 
 func (tp *testParams) ParamSetPairs() subspace.ParamSetPairs {
 	return subspace.ParamSetPairs{
-		{[]byte(keyMaxValidators), &tp.MaxValidators},
-		{[]byte(keySlashingRate), &tp.SlashingRate},
+		params.NewParamSetPair([]byte(keyMaxValidators), &tp.MaxValidators, validateMaxValidators),
+		params.NewParamSetPair([]byte(keySlashingRate), &tp.SlashingRate, validateSlashingRate),
 	}
 }
 
+func validateMaxValidators(i interface{}) error {
+	v, ok := i.(uint16)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v == 0 {
+		return fmt.Errorf("max validators must be positive")
+	}
+
+	return nil
+}
+
+func validateSlashingRate(i interface{}) error {
+	v, ok := i.(testParamsSlashingRate)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v.DoubleSign > 100 || v.Downtime > 100 {
+		return fmt.Errorf("slashing rates must not exceed 100")
+	}
+
+	return nil
+}
+
 func testProposal(changes ...params.ParamChange) params.ParameterChangeProposal {
 	return params.NewParameterChangeProposal(
 		"Test",
@@ -106,6 +133,23 @@ func TestProposalHandlerFailed(t *testing.T) {
 	require.False(t, ss.Has(input.ctx, []byte(keyMaxValidators)))
 }
 
+func TestProposalHandlerInvalidValue(t *testing.T) {
+	input := newTestInput(t)
+	ss := input.keeper.Subspace(testSubspace).WithKeyTable(
+		params.NewKeyTable().RegisterParamSet(&testParams{}),
+	)
+
+	hdlr := params.NewParamChangeProposalHandler(input.keeper)
+
+	tp := testProposal(params.NewParamChange(testSubspace, keyMaxValidators, "0"))
+	require.Error(t, hdlr(input.ctx, tp))
+	require.False(t, ss.Has(input.ctx, []byte(keyMaxValidators)))
+
+	tp = testProposal(params.NewParamChange(testSubspace, keySlashingRate, `{"downtime": 101}`))
+	require.Error(t, hdlr(input.ctx, tp))
+	require.False(t, ss.Has(input.ctx, []byte(keySlashingRate)))
+}
+
 func TestProposalHandlerUpdateOmitempty(t *testing.T) {
 	input := newTestInput(t)
 	ss := input.keeper.Subspace(testSubspace).WithKeyTable(
@@ -127,3 +171,25 @@ func TestProposalHandlerUpdateOmitempty(t *testing.T) {
 	ss.Get(input.ctx, []byte(keySlashingRate), &param)
 	require.Equal(t, testParamsSlashingRate{10, 7}, param)
 }
+
+func TestConsensusParamsProposalHandler(t *testing.T) {
+	input := newTestInput(t)
+	hdlr := params.NewParamChangeProposalHandler(input.keeper)
+
+	require.Nil(t, input.keeper.GetConsensusParamsUpdate(input.ctx))
+
+	block := &abci.BlockParams{MaxBytes: 1000, MaxGas: 5000000}
+	tp := params.NewConsensusParamsChangeProposal("Test", "description", block, nil, nil)
+	require.NoError(t, hdlr(input.ctx, tp))
+	require.Equal(t, &abci.ConsensusParams{Block: block}, input.keeper.GetConsensusParamsUpdate(input.ctx))
+
+	// proposals passing in the same block are merged
+	evidence := &abci.EvidenceParams{MaxAge: 1000}
+	tp = params.NewConsensusParamsChangeProposal("Test", "description", nil, evidence, nil)
+	require.NoError(t, hdlr(input.ctx, tp))
+	require.Equal(t, &abci.ConsensusParams{Block: block, Evidence: evidence}, input.keeper.GetConsensusParamsUpdate(input.ctx))
+
+	tp = params.NewConsensusParamsChangeProposal("Test", "description", nil, &abci.EvidenceParams{MaxAge: 0}, nil)
+	require.Error(t, hdlr(input.ctx, tp))
+	require.Equal(t, &abci.ConsensusParams{Block: block, Evidence: evidence}, input.keeper.GetConsensusParamsUpdate(input.ctx))
+}
@@ -0,0 +1,91 @@
+package params
+
+import (
+	"encoding/json"
+	"fmt"
+
+	abci "github.com/tendermint/tendermint/abci/types"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/params/types"
+)
+
+// NewQuerier returns a new querier handler for the params module. It allows
+// querying the parameters of any subspace registered in the Keeper.
+func NewQuerier(k Keeper) sdk.Querier {
+	return func(ctx sdk.Context, path []string, req abci.RequestQuery) ([]byte, sdk.Error) {
+		switch path[0] {
+		case types.QuerySubspace:
+			return querySubspaceParam(ctx, req, k)
+
+		case types.QueryKeys:
+			return querySubspaceKeys(ctx, req, k)
+
+		default:
+			return nil, sdk.ErrUnknownRequest(fmt.Sprintf("unknown params query endpoint: %s", path[0]))
+		}
+	}
+}
+
+func querySubspaceParam(ctx sdk.Context, req abci.RequestQuery, k Keeper) ([]byte, sdk.Error) {
+	var params types.QuerySubspaceParams
+
+	if err := k.cdc.UnmarshalJSON(req.Data, &params); err != nil {
+		return nil, sdk.ErrUnknownRequest(sdk.AppendMsgToErr("failed to parse params", err.Error()))
+	}
+
+	ss, ok := k.GetSubspace(params.Subspace)
+	if !ok {
+		return nil, types.ErrUnknownSubspace(k.codespace, params.Subspace)
+	}
+
+	if !ss.HasKey([]byte(params.Key)) {
+		return nil, types.ErrUnknownKey(k.codespace, params.Subspace, params.Key)
+	}
+
+	raw := ss.GetRaw(ctx, []byte(params.Key))
+	value, err := ss.GetValue(ctx, []byte(params.Key))
+	if err != nil {
+		return nil, sdk.ErrInternal(sdk.AppendMsgToErr("failed to decode parameter", err.Error()))
+	}
+
+	var bz json.RawMessage
+	if value != nil {
+		bz, err = codec.MarshalJSONIndent(k.cdc, value)
+		if err != nil {
+			return nil, sdk.ErrInternal(sdk.AppendMsgToErr("failed to marshal JSON", err.Error()))
+		}
+	}
+
+	resp := types.NewSubspaceParamsResponse(params.Subspace, params.Key, raw, bz)
+
+	res, err := codec.MarshalJSONIndent(k.cdc, resp)
+	if err != nil {
+		return nil, sdk.ErrInternal(sdk.AppendMsgToErr("failed to marshal JSON", err.Error()))
+	}
+
+	return res, nil
+}
+
+func querySubspaceKeys(ctx sdk.Context, req abci.RequestQuery, k Keeper) ([]byte, sdk.Error) {
+	var params types.QuerySubspaceParams
+
+	if err := k.cdc.UnmarshalJSON(req.Data, &params); err != nil {
+		return nil, sdk.ErrUnknownRequest(sdk.AppendMsgToErr("failed to parse params", err.Error()))
+	}
+
+	ss, ok := k.GetSubspace(params.Subspace)
+	if !ok {
+		return nil, types.ErrUnknownSubspace(k.codespace, params.Subspace)
+	}
+
+	resp := types.NewSubspaceKeysResponse(params.Subspace, ss.Keys())
+
+	res, err := codec.MarshalJSONIndent(k.cdc, resp)
+	if err != nil {
+		return nil, sdk.ErrInternal(sdk.AppendMsgToErr("failed to marshal JSON", err.Error()))
+	}
+
+	return res, nil
+}
@@ -0,0 +1,76 @@
+package params
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	abci "github.com/tendermint/tendermint/abci/types"
+)
+
+func TestQuerier(t *testing.T) {
+	cdc, ctx, _, _, keeper := testComponents()
+
+	table := NewKeyTable(
+		NewParamSetPair([]byte("key2"), int64(0), validateNoOp),
+		NewParamSetPair([]byte("key1"), int64(0), validateNoOp),
+		NewParamSetPair([]byte("unset"), string(""), validateNoOp),
+	)
+	space := keeper.Subspace("test").WithKeyTable(table)
+	space.Set(ctx, []byte("key1"), int64(10))
+
+	querier := NewQuerier(keeper)
+
+	query := func(path, subspace, key string) ([]byte, error) {
+		req := abci.RequestQuery{Data: cdc.MustMarshalJSON(NewQuerySubspaceParams(subspace, key))}
+		res, err := querier(ctx, []string{path}, req)
+		if err != nil {
+			return nil, err
+		}
+		return res, nil
+	}
+
+	// query a set parameter
+	res, err := query(QuerySubspace, "test", "key1")
+	require.NoError(t, err)
+
+	var param SubspaceParamsResponse
+	require.NoError(t, cdc.UnmarshalJSON(res, &param))
+	require.Equal(t, NewSubspaceParamsResponse("test", "key1", space.GetRaw(ctx, []byte("key1")), []byte(`"10"`)), param)
+
+	var value int64
+	require.NoError(t, cdc.UnmarshalJSON(param.Raw, &value))
+	require.Equal(t, int64(10), value)
+
+	// the value is the JSON of the parameter decoded through its registered type
+	value = 0
+	require.NoError(t, cdc.UnmarshalJSON(param.Value, &value))
+	require.Equal(t, int64(10), value)
+
+	// query a registered parameter that has never been set
+	res, err = query(QuerySubspace, "test", "unset")
+	require.NoError(t, err)
+	require.NoError(t, cdc.UnmarshalJSON(res, &param))
+	require.Empty(t, param.Value)
+
+	// query the registered keys
+	res, err = query(QueryKeys, "test", "")
+	require.NoError(t, err)
+
+	var keys SubspaceKeysResponse
+	require.NoError(t, cdc.UnmarshalJSON(res, &keys))
+	require.Equal(t, NewSubspaceKeysResponse("test", []string{"key1", "key2", "unset"}), keys)
+
+	// unknown subspaces, keys and endpoints fail
+	_, err = query(QuerySubspace, "foo", "key1")
+	require.Error(t, err)
+
+	_, err = query(QueryKeys, "foo", "")
+	require.Error(t, err)
+
+	_, err = query(QuerySubspace, "test", "foo")
+	require.Error(t, err)
+
+	_, err = query("foo", "test", "key1")
+	require.Error(t, err)
+}
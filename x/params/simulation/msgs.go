@@ -28,6 +28,24 @@ func (spc simParamChange) compKey() string {
 // where each simParamChange corresponds to a ParamChange with a simValue
 // function to generate a simulated new value.
 var paramChangePool = []simParamChange{
+	// bank parameters
+	{
+		"bank",
+		"sendenableddenoms",
+		"",
+		func(r *rand.Rand) string {
+			return fmt.Sprintf(`[{"denom": "%s", "enabled": %t}]`,
+				sdk.DefaultBondDenom, simulation.ModuleParamSimulator["SendEnabled"](r).(bool))
+		},
+	},
+	{
+		"bank",
+		"sendenabled",
+		"",
+		func(r *rand.Rand) string {
+			return fmt.Sprintf("%t", simulation.ModuleParamSimulator["SendEnabled"](r).(bool))
+		},
+	},
 	// staking parameters
 	{
 		"staking",
@@ -85,7 +103,13 @@ var paramChangePool = []simParamChange{
 		"votingparams",
 		"",
 		func(r *rand.Rand) string {
-			return fmt.Sprintf(`{"voting_period": "%d"}`, simulation.ModuleParamSimulator["VotingParams/VotingPeriod"](r).(time.Duration))
+			// the expedited voting period must remain shorter than the voting period
+			vp := simulation.ModuleParamSimulator["VotingParams/VotingPeriod"](r).(time.Duration)
+			evp := simulation.ModuleParamSimulator["VotingParams/ExpeditedVotingPeriod"](r).(time.Duration)
+			if evp >= vp {
+				evp = vp / 2
+			}
+			return fmt.Sprintf(`{"voting_period": "%d", "expedited_voting_period": "%d"}`, vp, evp)
 		},
 	},
 	{
@@ -107,6 +131,7 @@ var paramChangePool = []simParamChange{
 			}{
 				{"quorum", simulation.ModuleParamSimulator["TallyParams/Quorum"](r).(sdk.Dec)},
 				{"threshold", simulation.ModuleParamSimulator["TallyParams/Threshold"](r).(sdk.Dec)},
+				{"expedited_threshold", simulation.ModuleParamSimulator["TallyParams/ExpeditedThreshold"](r).(sdk.Dec)},
 				{"veto", simulation.ModuleParamSimulator["TallyParams/Veto"](r).(sdk.Dec)},
 			}
 
@@ -1,9 +1,19 @@
 package subspace
 
+// ValueValidatorFn validates a parameter value. It receives the dereferenced
+// value of the parameter and returns an error if the value is invalid.
+type ValueValidatorFn func(value interface{}) error
+
 // Used for associating paramsubspace key and field of param structs
 type ParamSetPair struct {
-	Key   []byte
-	Value interface{}
+	Key         []byte
+	Value       interface{}
+	ValidatorFn ValueValidatorFn
+}
+
+// NewParamSetPair creates a new ParamSetPair instance
+func NewParamSetPair(key []byte, value interface{}, vfn ValueValidatorFn) ParamSetPair {
+	return ParamSetPair{key, value, vfn}
 }
 
 // Slice of KeyFieldPair
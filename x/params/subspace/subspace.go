@@ -2,7 +2,9 @@ package subspace
 
 import (
 	"errors"
+	"fmt"
 	"reflect"
+	"sort"
 
 	"github.com/cosmos/cosmos-sdk/codec"
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -130,6 +132,28 @@ func (s Subspace) GetRaw(ctx sdk.Context, key []byte) []byte {
 	return store.Get(key)
 }
 
+// GetValue returns the parameter decoded into a new value of the type
+// registered for its key, or nil if the parameter is not set. It returns an
+// error if the key is not registered.
+func (s Subspace) GetValue(ctx sdk.Context, key []byte) (interface{}, error) {
+	attr, ok := s.table.m[string(key)]
+	if !ok {
+		return nil, fmt.Errorf("parameter %s not registered", key)
+	}
+
+	bz := s.GetRaw(ctx, key)
+	if bz == nil {
+		return nil, nil
+	}
+
+	ptr := reflect.New(attr.ty)
+	if err := s.cdc.UnmarshalJSON(bz, ptr.Interface()); err != nil {
+		return nil, err
+	}
+
+	return ptr.Elem().Interface(), nil
+}
+
 // Check if the parameter is set in the store
 func (s Subspace) Has(ctx sdk.Context, key []byte) bool {
 	store := s.kvStore(ctx)
@@ -159,12 +183,34 @@ func (s Subspace) checkType(store sdk.KVStore, key []byte, param interface{}) {
 	}
 }
 
-// Set stores the parameter. It returns error if stored parameter has different type from input.
-// It also set to the transient store to record change.
+// Validate checks the parameter value against the validation function
+// registered for its key. It returns an error if the key is not registered or
+// if the value is invalid.
+func (s Subspace) Validate(ctx sdk.Context, key []byte, param interface{}) error {
+	attr, ok := s.table.m[string(key)]
+	if !ok {
+		return fmt.Errorf("parameter %s not registered", key)
+	}
+
+	// validation functions operate on the dereferenced value
+	value := reflect.Indirect(reflect.ValueOf(param)).Interface()
+	if err := attr.vfn(value); err != nil {
+		return fmt.Errorf("invalid value for parameter %s: %s", key, err)
+	}
+
+	return nil
+}
+
+// Set stores the parameter. It panics if the stored parameter has a different
+// type from the input or if the value is invalid. It also set to the transient
+// store to record change.
 func (s Subspace) Set(ctx sdk.Context, key []byte, param interface{}) {
 	store := s.kvStore(ctx)
 
 	s.checkType(store, key, param)
+	if err := s.Validate(ctx, key, param); err != nil {
+		panic(err)
+	}
 
 	bz, err := s.cdc.MarshalJSON(param)
 	if err != nil {
@@ -178,8 +224,8 @@ func (s Subspace) Set(ctx sdk.Context, key []byte, param interface{}) {
 }
 
 // Update stores raw parameter bytes. It returns error if the stored parameter
-// has a different type from the input. It also sets to the transient store to
-// record change.
+// has a different type from the input or if the resulting value is invalid. It
+// also sets to the transient store to record change.
 func (s Subspace) Update(ctx sdk.Context, key []byte, param []byte) error {
 	attr, ok := s.table.m[string(key)]
 	if !ok {
@@ -194,6 +240,11 @@ func (s Subspace) Update(ctx sdk.Context, key []byte, param []byte) error {
 		return err
 	}
 
+	err = s.Validate(ctx, key, dest)
+	if err != nil {
+		return err
+	}
+
 	s.Set(ctx, key, dest)
 	tStore := s.transientStore(ctx)
 	tStore.Set(key, []byte{})
@@ -202,11 +253,14 @@ func (s Subspace) Update(ctx sdk.Context, key []byte, param []byte) error {
 }
 
 // SetWithSubkey set a parameter with a key and subkey
-// Checks parameter type only over the key
+// Checks parameter type and value only over the key
 func (s Subspace) SetWithSubkey(ctx sdk.Context, key []byte, subkey []byte, param interface{}) {
 	store := s.kvStore(ctx)
 
 	s.checkType(store, key, param)
+	if err := s.Validate(ctx, key, param); err != nil {
+		panic(err)
+	}
 
 	newkey := concatKeys(key, subkey)
 
@@ -221,7 +275,7 @@ func (s Subspace) SetWithSubkey(ctx sdk.Context, key []byte, subkey []byte, para
 }
 
 // UpdateWithSubkey stores raw parameter bytes  with a key and subkey. It checks
-// the parameter type only over the key.
+// the parameter type and value only over the key.
 func (s Subspace) UpdateWithSubkey(ctx sdk.Context, key []byte, subkey []byte, param []byte) error {
 	concatkey := concatKeys(key, subkey)
 
@@ -238,6 +292,11 @@ func (s Subspace) UpdateWithSubkey(ctx sdk.Context, key []byte, subkey []byte, p
 		return err
 	}
 
+	err = s.Validate(ctx, key, dest)
+	if err != nil {
+		return err
+	}
+
 	s.SetWithSubkey(ctx, key, subkey, dest)
 	tStore := s.transientStore(ctx)
 	tStore.Set(concatkey, []byte{})
@@ -269,6 +328,23 @@ func (s Subspace) Name() string {
 	return string(s.name)
 }
 
+// Keys returns the sorted list of parameter keys registered in the KeyTable
+func (s Subspace) Keys() []string {
+	keys := make([]string, 0, len(s.table.m))
+	for k := range s.table.m {
+		keys = append(keys, k)
+	}
+
+	sort.Strings(keys)
+	return keys
+}
+
+// HasKey returns whether the parameter key is registered in the KeyTable
+func (s Subspace) HasKey(key []byte) bool {
+	_, ok := s.table.m[string(key)]
+	return ok
+}
+
 // Wrapper of Subspace, provides immutable functions only
 type ReadOnlySubspace struct {
 	s Subspace
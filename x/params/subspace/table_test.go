@@ -1,6 +1,7 @@
 package subspace
 
 import (
+	"errors"
 	"testing"
 
 	"github.com/stretchr/testify/require"
@@ -13,23 +14,53 @@ type testparams struct {
 
 func (tp *testparams) ParamSetPairs() ParamSetPairs {
 	return ParamSetPairs{
-		{[]byte("i"), &tp.i},
-		{[]byte("b"), &tp.b},
+		NewParamSetPair([]byte("i"), &tp.i, validateNoOp),
+		NewParamSetPair([]byte("b"), &tp.b, validateNoOp),
 	}
 }
 
+func validateNoOp(_ interface{}) error { return nil }
+
 func TestKeyTable(t *testing.T) {
 	table := NewKeyTable()
 
-	require.Panics(t, func() { table.RegisterType([]byte(""), nil) })
-	require.Panics(t, func() { table.RegisterType([]byte("!@#$%"), nil) })
-	require.Panics(t, func() { table.RegisterType([]byte("hello,"), nil) })
-	require.Panics(t, func() { table.RegisterType([]byte("hello"), nil) })
+	require.Panics(t, func() { table.RegisterType(ParamSetPair{[]byte(""), nil, validateNoOp}) })
+	require.Panics(t, func() { table.RegisterType(ParamSetPair{[]byte("!@#$%"), nil, validateNoOp}) })
+	require.Panics(t, func() { table.RegisterType(ParamSetPair{[]byte("hello,"), nil, validateNoOp}) })
+	require.Panics(t, func() { table.RegisterType(ParamSetPair{[]byte("hello"), nil, validateNoOp}) })
+	require.Panics(t, func() { table.RegisterType(ParamSetPair{[]byte("hello"), bool(false), nil}) })
 
-	require.NotPanics(t, func() { table.RegisterType([]byte("hello"), bool(false)) })
-	require.NotPanics(t, func() { table.RegisterType([]byte("world"), int64(0)) })
-	require.Panics(t, func() { table.RegisterType([]byte("hello"), bool(false)) })
+	require.NotPanics(t, func() { table.RegisterType(ParamSetPair{[]byte("hello"), bool(false), validateNoOp}) })
+	require.NotPanics(t, func() { table.RegisterType(ParamSetPair{[]byte("world"), int64(0), validateNoOp}) })
+	require.Panics(t, func() { table.RegisterType(ParamSetPair{[]byte("hello"), bool(false), validateNoOp}) })
 
 	require.NotPanics(t, func() { table.RegisterParamSet(&testparams{}) })
 	require.Panics(t, func() { table.RegisterParamSet(&testparams{}) })
 }
+
+func TestSubspaceValidate(t *testing.T) {
+	ctx, space, _ := DefaultTestComponents(t)
+
+	validatePositive := func(i interface{}) error {
+		if i.(int64) <= 0 {
+			return errors.New("value must be positive")
+		}
+		return nil
+	}
+	space = space.WithKeyTable(NewKeyTable(NewParamSetPair([]byte("positive"), int64(0), validatePositive)))
+
+	key := []byte("positive")
+	require.NoError(t, space.Validate(ctx, key, int64(1)))
+	require.Error(t, space.Validate(ctx, key, int64(0)))
+	require.Error(t, space.Validate(ctx, []byte("unknown"), int64(1)))
+
+	require.NotPanics(t, func() { space.Set(ctx, key, int64(1)) })
+	require.Panics(t, func() { space.Set(ctx, key, int64(-1)) })
+
+	require.Error(t, space.Update(ctx, key, []byte(`"0"`)))
+	require.NoError(t, space.Update(ctx, key, []byte(`"2"`)))
+
+	var value int64
+	space.Get(ctx, key, &value)
+	require.Equal(t, int64(2), value)
+}
@@ -16,4 +16,5 @@ func init() {
 // RegisterCodec registers all necessary param module types with a given codec.
 func RegisterCodec(cdc *codec.Codec) {
 	cdc.RegisterConcrete(ParameterChangeProposal{}, "cosmos-sdk/ParameterChangeProposal", nil)
+	cdc.RegisterConcrete(ConsensusParamsChangeProposal{}, "cosmos-sdk/ConsensusParamsChangeProposal", nil)
 }
@@ -0,0 +1,139 @@
+package types
+
+import (
+	"fmt"
+	"strings"
+
+	abci "github.com/tendermint/tendermint/abci/types"
+	tmtypes "github.com/tendermint/tendermint/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+)
+
+const (
+	// ProposalTypeConsensusParamsChange defines the type for a
+	// ConsensusParamsChangeProposal
+	ProposalTypeConsensusParamsChange = "ConsensusParamsChange"
+)
+
+// Assert ConsensusParamsChangeProposal implements govtypes.Content at compile-time
+var _ govtypes.Content = ConsensusParamsChangeProposal{}
+
+func init() {
+	govtypes.RegisterProposalType(ProposalTypeConsensusParamsChange)
+	govtypes.RegisterProposalTypeCodec(ConsensusParamsChangeProposal{}, "cosmos-sdk/ConsensusParamsChangeProposal")
+}
+
+// ConsensusParamsChangeProposal defines a proposal which updates the Tendermint
+// consensus params. Only the non-nil groups of params are updated, each of them
+// being replaced as a whole.
+type ConsensusParamsChangeProposal struct {
+	Title       string                `json:"title"`
+	Description string                `json:"description"`
+	Block       *abci.BlockParams     `json:"block,omitempty"`
+	Evidence    *abci.EvidenceParams  `json:"evidence,omitempty"`
+	Validator   *abci.ValidatorParams `json:"validator,omitempty"`
+}
+
+func NewConsensusParamsChangeProposal(title, description string, block *abci.BlockParams,
+	evidence *abci.EvidenceParams, validator *abci.ValidatorParams) ConsensusParamsChangeProposal {
+
+	return ConsensusParamsChangeProposal{title, description, block, evidence, validator}
+}
+
+// GetTitle returns the title of a consensus params change proposal.
+func (cpp ConsensusParamsChangeProposal) GetTitle() string { return cpp.Title }
+
+// GetDescription returns the description of a consensus params change proposal.
+func (cpp ConsensusParamsChangeProposal) GetDescription() string { return cpp.Description }
+
+// ProposalRoute returns the routing key of a consensus params change proposal.
+func (cpp ConsensusParamsChangeProposal) ProposalRoute() string { return RouterKey }
+
+// ProposalType returns the type of a consensus params change proposal.
+func (cpp ConsensusParamsChangeProposal) ProposalType() string {
+	return ProposalTypeConsensusParamsChange
+}
+
+// ValidateBasic validates the consensus params change proposal
+func (cpp ConsensusParamsChangeProposal) ValidateBasic() sdk.Error {
+	err := govtypes.ValidateAbstract(DefaultCodespace, cpp)
+	if err != nil {
+		return err
+	}
+
+	return ValidateConsensusParamsUpdate(cpp.ConsensusParamsUpdate())
+}
+
+// ConsensusParamsUpdate returns the consensus params updated by the proposal.
+func (cpp ConsensusParamsChangeProposal) ConsensusParamsUpdate() *abci.ConsensusParams {
+	return &abci.ConsensusParams{
+		Block:     cpp.Block,
+		Evidence:  cpp.Evidence,
+		Validator: cpp.Validator,
+	}
+}
+
+// String implements the Stringer interface.
+func (cpp ConsensusParamsChangeProposal) String() string {
+	var b strings.Builder
+
+	b.WriteString(fmt.Sprintf(`Consensus Params Change Proposal:
+  Title:       %s
+  Description: %s
+`, cpp.Title, cpp.Description))
+
+	if cpp.Block != nil {
+		b.WriteString(fmt.Sprintf("  Block:       MaxBytes: %d, MaxGas: %d\n", cpp.Block.MaxBytes, cpp.Block.MaxGas))
+	}
+	if cpp.Evidence != nil {
+		b.WriteString(fmt.Sprintf("  Evidence:    MaxAge: %d\n", cpp.Evidence.MaxAge))
+	}
+	if cpp.Validator != nil {
+		b.WriteString(fmt.Sprintf("  Validator:   PubKeyTypes: %v\n", cpp.Validator.PubKeyTypes))
+	}
+
+	return b.String()
+}
+
+// ValidateConsensusParamsUpdate checks that a consensus params update is not
+// empty and that the params it updates are within Tendermint's limits.
+func ValidateConsensusParamsUpdate(update *abci.ConsensusParams) sdk.Error {
+	if update == nil || (update.Block == nil && update.Evidence == nil && update.Validator == nil) {
+		return ErrEmptyConsensusParams(DefaultCodespace)
+	}
+
+	// the params which are not updated are taken from the defaults, so that
+	// only the updated ones are checked
+	params := tmtypes.DefaultConsensusParams().Update(update)
+	if err := params.Validate(); err != nil {
+		return ErrInvalidConsensusParams(DefaultCodespace, err.Error())
+	}
+
+	return nil
+}
+
+// MergeConsensusParams returns the consensus params with the non-nil groups of
+// params of the update replacing the ones of params.
+func MergeConsensusParams(params, update *abci.ConsensusParams) *abci.ConsensusParams {
+	res := &abci.ConsensusParams{}
+	if params != nil {
+		*res = *params
+	}
+
+	if update == nil {
+		return res
+	}
+	if update.Block != nil {
+		res.Block = update.Block
+	}
+	if update.Evidence != nil {
+		res.Evidence = update.Evidence
+	}
+	if update.Validator != nil {
+		res.Validator = update.Validator
+	}
+
+	return res
+}
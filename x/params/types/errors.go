@@ -13,6 +13,9 @@ const (
 	CodeUnknownSubspace  sdk.CodeType = 1
 	CodeSettingParameter sdk.CodeType = 2
 	CodeEmptyData        sdk.CodeType = 3
+	CodeUnknownKey       sdk.CodeType = 4
+
+	CodeInvalidConsensusParams sdk.CodeType = 5
 )
 
 // ErrUnknownSubspace returns an unknown subspace error.
@@ -20,6 +23,11 @@ func ErrUnknownSubspace(codespace sdk.CodespaceType, space string) sdk.Error {
 	return sdk.NewError(codespace, CodeUnknownSubspace, fmt.Sprintf("unknown subspace %s", space))
 }
 
+// ErrUnknownKey returns an error for a key not registered in the subspace.
+func ErrUnknownKey(codespace sdk.CodespaceType, space, key string) sdk.Error {
+	return sdk.NewError(codespace, CodeUnknownKey, fmt.Sprintf("unknown parameter key %s in subspace %s", key, space))
+}
+
 // ErrSettingParameter returns an error for failing to set a parameter.
 func ErrSettingParameter(codespace sdk.CodespaceType, key, subkey, value, msg string) sdk.Error {
 	return sdk.NewError(codespace, CodeSettingParameter, fmt.Sprintf("error setting parameter %s on %s (%s): %s", value, key, subkey, msg))
@@ -44,3 +52,15 @@ func ErrEmptyKey(codespace sdk.CodespaceType) sdk.Error {
 func ErrEmptyValue(codespace sdk.CodespaceType) sdk.Error {
 	return sdk.NewError(codespace, CodeEmptyData, "parameter value is empty")
 }
+
+// ErrEmptyConsensusParams returns an error for a consensus params update which
+// does not update any param.
+func ErrEmptyConsensusParams(codespace sdk.CodespaceType) sdk.Error {
+	return sdk.NewError(codespace, CodeEmptyData, "consensus params update is empty")
+}
+
+// ErrInvalidConsensusParams returns an error for consensus params outside of
+// Tendermint's limits.
+func ErrInvalidConsensusParams(codespace sdk.CodespaceType, msg string) sdk.Error {
+	return sdk.NewError(codespace, CodeInvalidConsensusParams, fmt.Sprintf("invalid consensus params: %s", msg))
+}
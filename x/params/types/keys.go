@@ -6,4 +6,7 @@ const (
 
 	// RouterKey defines the routing key for a ParameterChangeProposal
 	RouterKey = "params"
+
+	// QuerierRoute defines the querier route for the params module
+	QuerierRoute = "params"
 )
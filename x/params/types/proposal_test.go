@@ -4,6 +4,7 @@ import (
 	"testing"
 
 	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
 )
 
 func TestParameterChangeProposal(t *testing.T) {
@@ -29,3 +30,33 @@ func TestParameterChangeProposal(t *testing.T) {
 	pcp = NewParameterChangeProposal("test title", "test description", []ParamChange{pc5})
 	require.Error(t, pcp.ValidateBasic())
 }
+
+func TestConsensusParamsChangeProposal(t *testing.T) {
+	block := &abci.BlockParams{MaxBytes: 1000, MaxGas: 5000000}
+	cpp := NewConsensusParamsChangeProposal("test title", "test description", block, nil, nil)
+
+	require.Equal(t, "test title", cpp.GetTitle())
+	require.Equal(t, "test description", cpp.GetDescription())
+	require.Equal(t, RouterKey, cpp.ProposalRoute())
+	require.Equal(t, ProposalTypeConsensusParamsChange, cpp.ProposalType())
+	require.Nil(t, cpp.ValidateBasic())
+
+	cpp = NewConsensusParamsChangeProposal("test title", "test description", nil, nil, nil)
+	require.Error(t, cpp.ValidateBasic())
+
+	cpp = NewConsensusParamsChangeProposal("test title", "test description",
+		&abci.BlockParams{MaxBytes: 0, MaxGas: 5000000}, nil, nil)
+	require.Error(t, cpp.ValidateBasic())
+
+	cpp = NewConsensusParamsChangeProposal("test title", "test description",
+		&abci.BlockParams{MaxBytes: 1000, MaxGas: -2}, nil, nil)
+	require.Error(t, cpp.ValidateBasic())
+
+	cpp = NewConsensusParamsChangeProposal("test title", "test description",
+		nil, &abci.EvidenceParams{MaxAge: 0}, nil)
+	require.Error(t, cpp.ValidateBasic())
+
+	cpp = NewConsensusParamsChangeProposal("test title", "test description",
+		nil, nil, &abci.ValidatorParams{PubKeyTypes: []string{"unknown"}})
+	require.Error(t, cpp.ValidateBasic())
+}
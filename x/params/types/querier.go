@@ -0,0 +1,74 @@
+package types
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+)
+
+// Query endpoints supported by the params querier
+const (
+	QuerySubspace = "subspace"
+	QueryKeys     = "keys"
+)
+
+// QuerySubspaceParams defines the params for querying a parameter, or the
+// registered keys, of a subspace. The key is ignored when querying keys.
+type QuerySubspaceParams struct {
+	Subspace string `json:"subspace"`
+	Key      string `json:"key"`
+}
+
+// NewQuerySubspaceParams creates a new instance of QuerySubspaceParams.
+func NewQuerySubspaceParams(subspace, key string) QuerySubspaceParams {
+	return QuerySubspaceParams{
+		Subspace: subspace,
+		Key:      key,
+	}
+}
+
+// SubspaceParamsResponse defines the response of a subspace parameter query.
+// Raw contains the value exactly as stored, while Value contains the JSON of
+// the value decoded through the type registered for the key. Both are empty if
+// the parameter has never been set.
+type SubspaceParamsResponse struct {
+	Subspace string          `json:"subspace"`
+	Key      string          `json:"key"`
+	Raw      []byte          `json:"raw"`
+	Value    json.RawMessage `json:"value"`
+}
+
+// NewSubspaceParamsResponse creates a new instance of SubspaceParamsResponse.
+func NewSubspaceParamsResponse(subspace, key string, raw []byte, value json.RawMessage) SubspaceParamsResponse {
+	return SubspaceParamsResponse{
+		Subspace: subspace,
+		Key:      key,
+		Raw:      raw,
+		Value:    value,
+	}
+}
+
+func (spr SubspaceParamsResponse) String() string {
+	return strings.TrimSpace(fmt.Sprintf(`Subspace: %s
+Key:      %s
+Value:    %s`, spr.Subspace, spr.Key, spr.Value))
+}
+
+// SubspaceKeysResponse defines the response of a subspace keys query.
+type SubspaceKeysResponse struct {
+	Subspace string   `json:"subspace"`
+	Keys     []string `json:"keys"`
+}
+
+// NewSubspaceKeysResponse creates a new instance of SubspaceKeysResponse.
+func NewSubspaceKeysResponse(subspace string, keys []string) SubspaceKeysResponse {
+	return SubspaceKeysResponse{
+		Subspace: subspace,
+		Keys:     keys,
+	}
+}
+
+func (skr SubspaceKeysResponse) String() string {
+	return strings.TrimSpace(fmt.Sprintf(`Subspace: %s
+Keys:     %s`, skr.Subspace, strings.Join(skr.Keys, ", ")))
+}
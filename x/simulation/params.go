@@ -52,18 +52,45 @@ var (
 		"SigVerifyCostSecp256k1": func(r *rand.Rand) interface{} {
 			return uint64(RandIntBetween(r, 500, 1000))
 		},
+		"SendEnabled": func(r *rand.Rand) interface{} {
+			return r.Int63n(2) == 0
+		},
 		"DepositParams/MinDeposit": func(r *rand.Rand) interface{} {
 			return sdk.Coins{sdk.NewInt64Coin(sdk.DefaultBondDenom, int64(RandIntBetween(r, 1, 1e3)))}
 		},
+		"DepositParams/ExpeditedMinDeposit": func(r *rand.Rand) interface{} {
+			return sdk.Coins{sdk.NewInt64Coin(sdk.DefaultBondDenom, int64(RandIntBetween(r, 1e3, 2e3)))}
+		},
+		"DepositParams/ProposalCancelRatio": func(r *rand.Rand) interface{} {
+			return sdk.NewDecWithPrec(int64(RandIntBetween(r, 0, 100)), 2)
+		},
+		"DepositParams/BurnProposalDepositPrevote": func(r *rand.Rand) interface{} {
+			return r.Int63n(2) == 0
+		},
+		"DepositParams/BurnVoteQuorum": func(r *rand.Rand) interface{} {
+			return r.Int63n(2) == 0
+		},
+		"DepositParams/BurnVoteVeto": func(r *rand.Rand) interface{} {
+			return r.Int63n(2) == 0
+		},
+		"DepositParams/BurnToCommunityPool": func(r *rand.Rand) interface{} {
+			return r.Int63n(2) == 0
+		},
 		"VotingParams/VotingPeriod": func(r *rand.Rand) interface{} {
 			return time.Duration(RandIntBetween(r, 1, 2*60*60*24*2)) * time.Second
 		},
+		"VotingParams/ExpeditedVotingPeriod": func(r *rand.Rand) interface{} {
+			return time.Duration(RandIntBetween(r, 1, 60*60*24)) * time.Second
+		},
 		"TallyParams/Quorum": func(r *rand.Rand) interface{} {
 			return sdk.NewDecWithPrec(int64(RandIntBetween(r, 334, 500)), 3)
 		},
 		"TallyParams/Threshold": func(r *rand.Rand) interface{} {
 			return sdk.NewDecWithPrec(int64(RandIntBetween(r, 450, 550)), 3)
 		},
+		"TallyParams/ExpeditedThreshold": func(r *rand.Rand) interface{} {
+			return sdk.NewDecWithPrec(int64(RandIntBetween(r, 551, 667)), 3)
+		},
 		"TallyParams/Veto": func(r *rand.Rand) interface{} {
 			return sdk.NewDecWithPrec(int64(RandIntBetween(r, 250, 334)), 3)
 		},
@@ -73,6 +100,9 @@ var (
 		"MaxValidators": func(r *rand.Rand) interface{} {
 			return uint16(r.Intn(250) + 1)
 		},
+		"HistoricalEntries": func(r *rand.Rand) interface{} {
+			return uint16(r.Intn(1000))
+		},
 		"SignedBlocksWindow": func(r *rand.Rand) interface{} {
 			return int64(RandIntBetween(r, 10, 1000))
 		},
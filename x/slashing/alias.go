@@ -9,24 +9,35 @@ import (
 )
 
 const (
-	DefaultCodespace            = types.DefaultCodespace
-	CodeInvalidValidator        = types.CodeInvalidValidator
-	CodeValidatorJailed         = types.CodeValidatorJailed
-	CodeValidatorNotJailed      = types.CodeValidatorNotJailed
-	CodeMissingSelfDelegation   = types.CodeMissingSelfDelegation
-	CodeSelfDelegationTooLow    = types.CodeSelfDelegationTooLow
-	CodeMissingSigningInfo      = types.CodeMissingSigningInfo
-	ModuleName                  = types.ModuleName
-	StoreKey                    = types.StoreKey
-	RouterKey                   = types.RouterKey
-	QuerierRoute                = types.QuerierRoute
-	QueryParameters             = types.QueryParameters
-	QuerySigningInfo            = types.QuerySigningInfo
-	QuerySigningInfos           = types.QuerySigningInfos
-	DefaultParamspace           = types.DefaultParamspace
-	DefaultMaxEvidenceAge       = types.DefaultMaxEvidenceAge
-	DefaultSignedBlocksWindow   = types.DefaultSignedBlocksWindow
-	DefaultDowntimeJailDuration = types.DefaultDowntimeJailDuration
+	EventTypeSlash                 = types.EventTypeSlash
+	EventTypeLiveness              = types.EventTypeLiveness
+	AttributeKeyAddress            = types.AttributeKeyAddress
+	AttributeKeyHeight             = types.AttributeKeyHeight
+	AttributeKeyPower              = types.AttributeKeyPower
+	AttributeKeyReason             = types.AttributeKeyReason
+	AttributeKeyJailed             = types.AttributeKeyJailed
+	AttributeKeyMissedBlocks       = types.AttributeKeyMissedBlocks
+	AttributeValueDoubleSign       = types.AttributeValueDoubleSign
+	AttributeValueMissingSignature = types.AttributeValueMissingSignature
+	AttributeValueCategory         = types.AttributeValueCategory
+	DefaultCodespace               = types.DefaultCodespace
+	CodeInvalidValidator           = types.CodeInvalidValidator
+	CodeValidatorJailed            = types.CodeValidatorJailed
+	CodeValidatorNotJailed         = types.CodeValidatorNotJailed
+	CodeMissingSelfDelegation      = types.CodeMissingSelfDelegation
+	CodeSelfDelegationTooLow       = types.CodeSelfDelegationTooLow
+	CodeMissingSigningInfo         = types.CodeMissingSigningInfo
+	ModuleName                     = types.ModuleName
+	StoreKey                       = types.StoreKey
+	RouterKey                      = types.RouterKey
+	QuerierRoute                   = types.QuerierRoute
+	QueryParameters                = types.QueryParameters
+	QuerySigningInfo               = types.QuerySigningInfo
+	QuerySigningInfos              = types.QuerySigningInfos
+	DefaultParamspace              = types.DefaultParamspace
+	DefaultMaxEvidenceAge          = types.DefaultMaxEvidenceAge
+	DefaultSignedBlocksWindow      = types.DefaultSignedBlocksWindow
+	DefaultDowntimeJailDuration    = types.DefaultDowntimeJailDuration
 )
 
 var (
@@ -13,6 +13,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/x/mock"
 	"github.com/cosmos/cosmos-sdk/x/staking"
 	"github.com/cosmos/cosmos-sdk/x/staking/types"
+	"github.com/cosmos/cosmos-sdk/x/supply"
 )
 
 var (
@@ -31,17 +32,35 @@ func getMockApp(t *testing.T) (*mock.App, staking.Keeper, Keeper) {
 	keyStaking := sdk.NewKVStoreKey(staking.StoreKey)
 	tkeyStaking := sdk.NewTransientStoreKey(staking.TStoreKey)
 	keySlashing := sdk.NewKVStoreKey(StoreKey)
-
-	bankKeeper := bank.NewBaseKeeper(mapp.AccountKeeper, mapp.ParamsKeeper.Subspace(bank.DefaultParamspace), bank.DefaultCodespace)
-	stakingKeeper := staking.NewKeeper(mapp.Cdc, keyStaking, tkeyStaking, bankKeeper, mapp.ParamsKeeper.Subspace(staking.DefaultParamspace), staking.DefaultCodespace)
+	keySupply := sdk.NewKVStoreKey(supply.StoreKey)
+
+	feeCollector := auth.NewEmptyModuleAccount(auth.FeeCollectorName)
+	notBondedPool := auth.NewEmptyModuleAccount(types.NotBondedPoolName, auth.Burner, auth.Staking)
+	bondPool := auth.NewEmptyModuleAccount(types.BondedPoolName, auth.Burner, auth.Staking)
+
+	blacklistedAddrs := make(map[string]bool)
+	blacklistedAddrs[feeCollector.GetAddress().String()] = true
+	blacklistedAddrs[notBondedPool.GetAddress().String()] = true
+	blacklistedAddrs[bondPool.GetAddress().String()] = true
+
+	bankKeeper := bank.NewBaseKeeper(mapp.Cdc, mapp.KeyBank, mapp.AccountKeeper, mapp.ParamsKeeper.Subspace(bank.DefaultParamspace), bank.DefaultCodespace, blacklistedAddrs)
+	mapp.BankKeeper = bankKeeper
+	maccPerms := map[string][]string{
+		auth.FeeCollectorName:   nil,
+		types.NotBondedPoolName: {auth.Burner, auth.Staking},
+		types.BondedPoolName:    {auth.Burner, auth.Staking},
+	}
+	supplyKeeper := supply.NewKeeper(mapp.Cdc, keySupply, mapp.AccountKeeper, bankKeeper, maccPerms)
+	stakingKeeper := staking.NewKeeper(mapp.Cdc, keyStaking, tkeyStaking, mapp.AccountKeeper, supplyKeeper, mapp.ParamsKeeper.Subspace(staking.DefaultParamspace), staking.DefaultCodespace)
 	keeper := NewKeeper(mapp.Cdc, keySlashing, stakingKeeper, mapp.ParamsKeeper.Subspace(DefaultParamspace), DefaultCodespace)
 	mapp.Router().AddRoute(staking.RouterKey, staking.NewHandler(stakingKeeper))
 	mapp.Router().AddRoute(RouterKey, NewHandler(keeper))
 
 	mapp.SetEndBlocker(getEndBlocker(stakingKeeper))
-	mapp.SetInitChainer(getInitChainer(mapp, stakingKeeper, mapp.AccountKeeper))
+	mapp.SetInitChainer(getInitChainer(mapp, stakingKeeper, mapp.AccountKeeper, supplyKeeper,
+		[]auth.ModuleAccountI{feeCollector, notBondedPool, bondPool}))
 
-	require.NoError(t, mapp.CompleteSetup(keyStaking, tkeyStaking, keySlashing))
+	require.NoError(t, mapp.CompleteSetup(keyStaking, tkeyStaking, keySlashing, keySupply))
 
 	return mapp, stakingKeeper, keeper
 }
@@ -49,22 +68,26 @@ func getMockApp(t *testing.T) (*mock.App, staking.Keeper, Keeper) {
 // staking endblocker
 func getEndBlocker(keeper staking.Keeper) sdk.EndBlocker {
 	return func(ctx sdk.Context, req abci.RequestEndBlock) abci.ResponseEndBlock {
-		validatorUpdates, tags := staking.EndBlocker(ctx, keeper)
+		validatorUpdates := staking.EndBlocker(ctx, keeper)
 		return abci.ResponseEndBlock{
 			ValidatorUpdates: validatorUpdates,
-			Tags:             tags,
 		}
 	}
 }
 
 // overwrite the mock init chainer
-func getInitChainer(mapp *mock.App, keeper staking.Keeper, accountKeeper types.AccountKeeper) sdk.InitChainer {
+func getInitChainer(mapp *mock.App, keeper staking.Keeper, accountKeeper types.AccountKeeper, supplyKeeper types.SupplyKeeper,
+	moduleAccounts []auth.ModuleAccountI) sdk.InitChainer {
 	return func(ctx sdk.Context, req abci.RequestInitChain) abci.ResponseInitChain {
 		mapp.InitChainer(ctx, req)
+
+		// set module accounts
+		for _, macc := range moduleAccounts {
+			supplyKeeper.SetModuleAccount(ctx, macc)
+		}
+
 		stakingGenesis := staking.DefaultGenesisState()
-		tokens := sdk.TokensFromTendermintPower(100000)
-		stakingGenesis.Pool.NotBondedTokens = tokens
-		validators := staking.InitGenesis(ctx, keeper, accountKeeper, stakingGenesis)
+		validators := staking.InitGenesis(ctx, keeper, accountKeeper, supplyKeeper, stakingGenesis)
 		return abci.ResponseInitChain{
 			Validators: validators,
 		}
@@ -97,10 +120,10 @@ func TestSlashingMsgs(t *testing.T) {
 
 	acc1 := &auth.BaseAccount{
 		Address: addr1,
-		Coins:   sdk.Coins{genCoin},
 	}
 	accs := []auth.Account{acc1}
-	mock.SetGenesis(mapp, accs)
+	balances := []mock.Balance{{Address: addr1, Coins: sdk.Coins{genCoin}}}
+	mock.SetGenesis(mapp, accs, balances)
 
 	description := staking.NewDescription("foo_moniker", "", "", "")
 	commission := staking.NewCommissionRates(sdk.ZeroDec(), sdk.ZeroDec(), sdk.ZeroDec())
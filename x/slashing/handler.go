@@ -3,8 +3,10 @@ package slashing
 import (
 	"fmt"
 
+	"github.com/tendermint/tendermint/crypto"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
-	"github.com/cosmos/cosmos-sdk/x/slashing/tags"
+	evidencetypes "github.com/cosmos/cosmos-sdk/x/evidence/types"
 )
 
 func NewHandler(k Keeper) sdk.Handler {
@@ -64,12 +66,31 @@ func handleMsgUnjail(ctx sdk.Context, msg MsgUnjail, k Keeper) sdk.Result {
 	// unjail the validator
 	k.sk.Unjail(ctx, consAddr)
 
-	tags := sdk.NewTags(
-		tags.Category, tags.TxCategory,
-		tags.Sender, msg.ValidatorAddr.String(),
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.ValidatorAddr.String()),
+		),
 	)
 
-	return sdk.Result{
-		Tags: tags,
+	return sdk.Result{Events: ctx.EventManager().Events()}
+}
+
+// NewEquivocationHandler returns an evidence handler which slashes, jails and
+// tombstones the validators which double-signed. It is meant to be routed for
+// the equivocation evidence route of the evidence module.
+func NewEquivocationHandler(k Keeper) evidencetypes.Handler {
+	return func(ctx sdk.Context, evidence evidencetypes.Evidence) sdk.Error {
+		switch evidence := evidence.(type) {
+		case evidencetypes.Equivocation:
+			k.HandleDoubleSign(ctx, crypto.Address(evidence.GetConsensusAddress()), evidence.GetHeight(),
+				evidence.GetTime(), evidence.GetValidatorPower())
+			return nil
+
+		default:
+			errMsg := fmt.Sprintf("unrecognized slashing evidence type: %T", evidence)
+			return sdk.ErrUnknownRequest(errMsg)
+		}
 	}
 }
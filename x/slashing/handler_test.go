@@ -8,6 +8,7 @@ import (
 	"github.com/stretchr/testify/require"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	evidencetypes "github.com/cosmos/cosmos-sdk/x/evidence/types"
 	"github.com/cosmos/cosmos-sdk/x/staking"
 )
 
@@ -131,3 +132,32 @@ func TestInvalidMsg(t *testing.T) {
 	require.False(t, res.IsOK())
 	require.True(t, strings.Contains(res.Log, "unrecognized slashing message type"))
 }
+
+func TestEquivocationHandler(t *testing.T) {
+	ctx, _, sk, _, keeper := createTestInput(t, keeperTestParams())
+	// validator added pre-genesis
+	ctx = ctx.WithBlockHeight(-1)
+	power := int64(100)
+	amt := sdk.TokensFromTendermintPower(power)
+	operatorAddr, val := addrs[0], pks[0]
+	got := staking.NewHandler(sk)(ctx, NewTestMsgCreateValidator(operatorAddr, val, amt))
+	require.True(t, got.IsOK())
+	staking.EndBlocker(ctx, sk)
+
+	// handle a signature to set signing info
+	keeper.HandleValidatorSignature(ctx, val.Address(), amt.Int64(), true)
+
+	oldTokens := sk.Validator(ctx, operatorAddr).GetTokens()
+
+	evidence := evidencetypes.NewEquivocation(0, time.Unix(0, 0), power, sdk.ConsAddress(val.Address()))
+	require.Nil(t, NewEquivocationHandler(keeper)(ctx, evidence))
+
+	// the validator is slashed, jailed and tombstoned
+	require.True(t, sk.Validator(ctx, operatorAddr).IsJailed())
+	require.True(t, sk.Validator(ctx, operatorAddr).GetTokens().LT(oldTokens))
+
+	info, found := keeper.getValidatorSigningInfo(ctx, sdk.ConsAddress(val.Address()))
+	require.True(t, found)
+	require.True(t, info.Tombstoned)
+	require.True(t, DoubleSignJailEndTime.Equal(info.JailedUntil))
+}
@@ -37,6 +37,30 @@ func (k Keeper) AfterValidatorRemoved(ctx sdk.Context, address sdk.ConsAddress)
 	k.deleteAddrPubkeyRelation(ctx, crypto.Address(address))
 }
 
+// When the consensus pubkey of a validator is rotated, add the new address-pubkey
+// relation and move the signing info over to the new consensus address. The
+// previous relation is kept to handle the signatures and evidence of the
+// previous key.
+func (k Keeper) AfterValidatorConsPubKeyRotated(ctx sdk.Context, oldConsAddr, newConsAddr sdk.ConsAddress, valAddr sdk.ValAddress) {
+	validator := k.sk.Validator(ctx, valAddr)
+	k.addPubkey(ctx, validator.GetConsPubKey())
+
+	signingInfo, found := k.getValidatorSigningInfo(ctx, oldConsAddr)
+	if !found {
+		return
+	}
+
+	signingInfo.Address = newConsAddr
+	k.SetValidatorSigningInfo(ctx, newConsAddr, signingInfo)
+	k.deleteValidatorSigningInfo(ctx, oldConsAddr)
+
+	k.IterateValidatorMissedBlockBitArray(ctx, oldConsAddr, func(index int64, missed bool) bool {
+		k.setValidatorMissedBlockBitArray(ctx, newConsAddr, index, missed)
+		return false
+	})
+	k.clearValidatorMissedBlockBitArray(ctx, oldConsAddr)
+}
+
 //_________________________________________________________________________________________
 
 // Hooks wrapper struct for slashing keeper
@@ -66,6 +90,11 @@ func (h Hooks) AfterValidatorCreated(ctx sdk.Context, valAddr sdk.ValAddress) {
 	h.k.AfterValidatorCreated(ctx, valAddr)
 }
 
+// Implements sdk.ValidatorHooks
+func (h Hooks) AfterValidatorConsPubKeyRotated(ctx sdk.Context, oldConsAddr, newConsAddr sdk.ConsAddress, valAddr sdk.ValAddress) {
+	h.k.AfterValidatorConsPubKeyRotated(ctx, oldConsAddr, newConsAddr, valAddr)
+}
+
 // nolint - unused hooks
 func (h Hooks) AfterValidatorBeginUnbonding(_ sdk.Context, _ sdk.ConsAddress, _ sdk.ValAddress)  {}
 func (h Hooks) BeforeValidatorModified(_ sdk.Context, _ sdk.ValAddress)                          {}
@@ -86,6 +86,57 @@ func TestHandleDoubleSign(t *testing.T) {
 
 // Test that a validator is slashed correctly
 // when we discover evidence of infraction
+// Test that a validator is still slashed for signatures and evidence of its
+// previous consensus pubkey after a rotation
+func TestHandleDoubleSignAfterConsPubKeyRotation(t *testing.T) {
+
+	// initial setup
+	ctx, _, sk, _, keeper := createTestInput(t, keeperTestParams())
+	power := int64(100)
+	amt := sdk.TokensFromTendermintPower(power)
+	operatorAddr, oldVal, newVal := addrs[0], pks[0], pks[1]
+	got := staking.NewHandler(sk)(ctx, NewTestMsgCreateValidator(operatorAddr, oldVal, amt))
+	require.True(t, got.IsOK())
+	staking.EndBlocker(ctx, sk)
+
+	// handle signatures to set signing info and the missed blocks
+	keeper.HandleValidatorSignature(ctx, oldVal.Address(), power, true)
+	keeper.HandleValidatorSignature(ctx, oldVal.Address(), power, false)
+
+	// rotate the consensus pubkey
+	got = staking.NewHandler(sk)(ctx, staking.NewMsgRotateConsPubKey(operatorAddr, newVal))
+	require.True(t, got.IsOK())
+	staking.EndBlocker(ctx, sk)
+
+	// signing info is moved to the new consensus address
+	oldConsAddr, newConsAddr := sdk.ConsAddress(oldVal.Address()), sdk.ConsAddress(newVal.Address())
+	_, found := keeper.getValidatorSigningInfo(ctx, oldConsAddr)
+	require.False(t, found)
+	info, found := keeper.getValidatorSigningInfo(ctx, newConsAddr)
+	require.True(t, found)
+	require.Equal(t, newConsAddr, info.Address)
+	require.Equal(t, int64(2), info.IndexOffset)
+	require.Equal(t, int64(1), info.MissedBlocksCounter)
+	require.True(t, keeper.getValidatorMissedBlockBitArray(ctx, newConsAddr, 1))
+	require.False(t, keeper.getValidatorMissedBlockBitArray(ctx, oldConsAddr, 1))
+
+	// signatures of the previous pubkey are still handled
+	keeper.HandleValidatorSignature(ctx, oldVal.Address(), power, true)
+	info, _ = keeper.getValidatorSigningInfo(ctx, newConsAddr)
+	require.Equal(t, int64(3), info.IndexOffset)
+
+	oldTokens := sk.Validator(ctx, operatorAddr).GetTokens()
+
+	// double sign with the previous pubkey
+	keeper.HandleDoubleSign(ctx, oldVal.Address(), 0, time.Unix(0, 0), power)
+
+	// should be jailed, slashed and tombstoned
+	require.True(t, sk.Validator(ctx, operatorAddr).IsJailed())
+	require.True(t, sk.Validator(ctx, operatorAddr).GetTokens().LT(oldTokens))
+	info, _ = keeper.getValidatorSigningInfo(ctx, newConsAddr)
+	require.True(t, info.Tombstoned)
+}
+
 func TestPastMaxEvidenceAge(t *testing.T) {
 
 	// initial setup
@@ -174,8 +225,8 @@ func TestHandleAbsentValidator(t *testing.T) {
 	// validator should be bonded still
 	validator, _ := sk.GetValidatorByConsAddr(ctx, sdk.GetConsAddress(val))
 	require.Equal(t, sdk.Bonded, validator.GetStatus())
-	pool := sk.GetPool(ctx)
-	require.True(sdk.IntEq(t, amt, pool.BondedTokens))
+	bondPool := sk.GetBondedPool(ctx)
+	require.True(sdk.IntEq(t, amt, ck.GetCoins(ctx, bondPool.GetAddress()).AmountOf(sk.BondDenom(ctx))))
 
 	// 501st block missed
 	ctx = ctx.WithBlockHeight(height)
@@ -231,8 +282,8 @@ func TestHandleAbsentValidator(t *testing.T) {
 	require.Equal(t, sdk.Bonded, validator.GetStatus())
 
 	// validator should have been slashed
-	pool = sk.GetPool(ctx)
-	require.Equal(t, amt.Int64()-slashAmt, pool.BondedTokens.Int64())
+	bondPool = sk.GetBondedPool(ctx)
+	require.Equal(t, amt.Int64()-slashAmt, ck.GetCoins(ctx, bondPool.GetAddress()).AmountOf(sk.BondDenom(ctx)).Int64())
 
 	// Validator start height should not have been changed
 	info, found = keeper.getValidatorSigningInfo(ctx, sdk.ConsAddress(val.Address()))
@@ -311,9 +362,9 @@ func TestHandleNewValidator(t *testing.T) {
 	// validator should be bonded still, should not have been jailed or slashed
 	validator, _ := sk.GetValidatorByConsAddr(ctx, sdk.GetConsAddress(val))
 	require.Equal(t, sdk.Bonded, validator.GetStatus())
-	pool := sk.GetPool(ctx)
+	bondPool := sk.GetBondedPool(ctx)
 	expTokens := sdk.TokensFromTendermintPower(100)
-	require.Equal(t, expTokens, pool.BondedTokens)
+	require.Equal(t, expTokens, ck.GetCoins(ctx, bondPool.GetAddress()).AmountOf(sk.BondDenom(ctx)))
 }
 
 // Test a jailed validator being "down" twice
@@ -395,7 +446,7 @@ func TestValidatorDippingInAndOut(t *testing.T) {
 	newAmt := sdk.TokensFromTendermintPower(101)
 	got = sh(ctx, NewTestMsgCreateValidator(addrs[1], pks[1], newAmt))
 	require.True(t, got.IsOK())
-	validatorUpdates, _ := staking.EndBlocker(ctx, sk)
+	validatorUpdates := staking.EndBlocker(ctx, sk)
 	require.Equal(t, 2, len(validatorUpdates))
 	validator, _ := sk.GetValidator(ctx, addr)
 	require.Equal(t, sdk.Unbonding, validator.Status)
@@ -408,7 +459,7 @@ func TestValidatorDippingInAndOut(t *testing.T) {
 	delTokens := sdk.TokensFromTendermintPower(3)
 	got = sh(ctx, newTestMsgDelegate(sdk.AccAddress(addrs[2]), addrs[0], delTokens))
 	require.True(t, got.IsOK())
-	validatorUpdates, _ = staking.EndBlocker(ctx, sk)
+	validatorUpdates = staking.EndBlocker(ctx, sk)
 	require.Equal(t, 2, len(validatorUpdates))
 	validator, _ = sk.GetValidator(ctx, addr)
 	require.Equal(t, sdk.Bonded, validator.Status)
@@ -42,6 +42,12 @@ func (k Keeper) SetValidatorSigningInfo(ctx sdk.Context, address sdk.ConsAddress
 	store.Set(types.GetValidatorSigningInfoKey(address), bz)
 }
 
+// Stored by *validator* address (not operator address)
+func (k Keeper) deleteValidatorSigningInfo(ctx sdk.Context, address sdk.ConsAddress) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.GetValidatorSigningInfoKey(address))
+}
+
 // Stored by *validator* address (not operator address)
 func (k Keeper) getValidatorMissedBlockBitArray(ctx sdk.Context, address sdk.ConsAddress, index int64) (missed bool) {
 	store := ctx.KVStore(k.storeKey)
@@ -1,13 +0,0 @@
-package tags
-
-import (
-	sdk "github.com/cosmos/cosmos-sdk/types"
-)
-
-// Slashing tags
-var (
-	TxCategory = "slashing"
-
-	Category = sdk.TagCategory
-	Sender   = sdk.TagSender
-)
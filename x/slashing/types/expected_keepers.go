@@ -39,4 +39,6 @@ type StakingHooks interface {
 	AfterValidatorRemoved(ctx sdk.Context, consAddr sdk.ConsAddress, valAddr sdk.ValAddress) // Must be called when a validator is deleted
 
 	AfterValidatorBonded(ctx sdk.Context, consAddr sdk.ConsAddress, valAddr sdk.ValAddress) // Must be called when a validator is bonded
+
+	AfterValidatorConsPubKeyRotated(ctx sdk.Context, oldConsAddr, newConsAddr sdk.ConsAddress, valAddr sdk.ValAddress) // Must be called when a validator consensus pubkey is rotated
 }
@@ -28,9 +28,14 @@ const (
 	TStoreKey                          = types.TStoreKey
 	QuerierRoute                       = types.QuerierRoute
 	RouterKey                          = types.RouterKey
+	NotBondedPoolName                  = types.NotBondedPoolName
+	BondedPoolName                     = types.BondedPoolName
+	TokenizedSharesPoolName            = types.TokenizedSharesPoolName
+	TokenizedSharesDenomPrefix         = types.TokenizedSharesDenomPrefix
 	DefaultUnbondingTime               = types.DefaultUnbondingTime
 	DefaultMaxValidators               = types.DefaultMaxValidators
 	DefaultMaxEntries                  = types.DefaultMaxEntries
+	DefaultHistoricalEntries           = types.DefaultHistoricalEntries
 	QueryValidators                    = types.QueryValidators
 	QueryValidator                     = types.QueryValidator
 	QueryDelegatorDelegations          = types.QueryDelegatorDelegations
@@ -45,6 +50,7 @@ const (
 	QueryDelegatorValidator            = types.QueryDelegatorValidator
 	QueryPool                          = types.QueryPool
 	QueryParameters                    = types.QueryParameters
+	QueryHistoricalInfo                = types.QueryHistoricalInfo
 	MaxMonikerLength                   = types.MaxMonikerLength
 	MaxIdentityLength                  = types.MaxIdentityLength
 	MaxWebsiteLength                   = types.MaxWebsiteLength
@@ -56,7 +62,7 @@ var (
 	// functions aliases
 	RegisterInvariants                 = keeper.RegisterInvariants
 	AllInvariants                      = keeper.AllInvariants
-	SupplyInvariants                   = keeper.SupplyInvariants
+	ModuleAccountInvariants            = keeper.ModuleAccountInvariants
 	NonNegativePowerInvariant          = keeper.NonNegativePowerInvariant
 	PositiveDelegationInvariant        = keeper.PositiveDelegationInvariant
 	DelegatorSharesInvariant           = keeper.DelegatorSharesInvariant
@@ -138,10 +144,27 @@ var (
 	ErrBothShareMsgsGiven              = types.ErrBothShareMsgsGiven
 	ErrNeitherShareMsgsGiven           = types.ErrNeitherShareMsgsGiven
 	ErrMissingSignature                = types.ErrMissingSignature
+	ErrConsPubKeyRotationInProgress    = types.ErrConsPubKeyRotationInProgress
+	ErrNoUnbondingDelegationEntry      = types.ErrNoUnbondingDelegationEntry
+	ErrBadCreationHeight               = types.ErrBadCreationHeight
+	ErrNotEnoughUnbondingBalance       = types.ErrNotEnoughUnbondingBalance
+	ErrNoHistoricalInfo                = types.ErrNoHistoricalInfo
+	ErrNotTokenizedSharesDenom         = types.ErrNotTokenizedSharesDenom
+	ErrTokenizeSharesAmountTooSmall    = types.ErrTokenizeSharesAmountTooSmall
+	ErrTokenizeReceivingRedelegation   = types.ErrTokenizeReceivingRedelegation
+	ErrTokenizeVestingDelegation       = types.ErrTokenizeVestingDelegation
 	NewGenesisState                    = types.NewGenesisState
 	DefaultGenesisState                = types.DefaultGenesisState
 	NewMultiStakingHooks               = types.NewMultiStakingHooks
 	GetValidatorKey                    = types.GetValidatorKey
+	GetConsPubKeyRotationKey           = types.GetConsPubKeyRotationKey
+	GetOldConsAddrQueueKey             = types.GetOldConsAddrQueueKey
+	GetOldConsAddrQueueTimeKey         = types.GetOldConsAddrQueueTimeKey
+	GetValidatorOldConsAddrKey         = types.GetValidatorOldConsAddrKey
+	GetValidatorOldConsAddrsKey        = types.GetValidatorOldConsAddrsKey
+	GetHistoricalInfoKey               = types.GetHistoricalInfoKey
+	GetTokenizedSharesRecordKey        = types.GetTokenizedSharesRecordKey
+	GetTokenizedSharesByValIndexKey    = types.GetTokenizedSharesByValIndexKey
 	GetValidatorByConsAddrKey          = types.GetValidatorByConsAddrKey
 	AddressFromLastValidatorPowerKey   = types.AddressFromLastValidatorPowerKey
 	GetValidatorsByPowerIndexKey       = types.GetValidatorsByPowerIndexKey
@@ -171,13 +194,27 @@ var (
 	NewMsgDelegate                     = types.NewMsgDelegate
 	NewMsgBeginRedelegate              = types.NewMsgBeginRedelegate
 	NewMsgUndelegate                   = types.NewMsgUndelegate
+	NewMsgCancelUnbondingDelegation    = types.NewMsgCancelUnbondingDelegation
+	NewMsgRotateConsPubKey             = types.NewMsgRotateConsPubKey
+	NewMsgTokenizeShares               = types.NewMsgTokenizeShares
+	NewMsgRedeemTokensForShares        = types.NewMsgRedeemTokensForShares
 	NewParams                          = types.NewParams
 	DefaultParams                      = types.DefaultParams
 	MustUnmarshalParams                = types.MustUnmarshalParams
 	UnmarshalParams                    = types.UnmarshalParams
-	InitialPool                        = types.InitialPool
-	MustUnmarshalPool                  = types.MustUnmarshalPool
-	UnmarshalPool                      = types.UnmarshalPool
+	NewPool                            = types.NewPool
+	NewConsPubKeyRotation              = types.NewConsPubKeyRotation
+	NewHistoricalInfo                  = types.NewHistoricalInfo
+	MustMarshalHistoricalInfo          = types.MustMarshalHistoricalInfo
+	MustUnmarshalHistoricalInfo        = types.MustUnmarshalHistoricalInfo
+	UnmarshalHistoricalInfo            = types.UnmarshalHistoricalInfo
+	NewQueryHistoricalInfoParams       = types.NewQueryHistoricalInfoParams
+	MustMarshalConsPubKeyRotation      = types.MustMarshalConsPubKeyRotation
+	MustUnmarshalConsPubKeyRotation    = types.MustUnmarshalConsPubKeyRotation
+	TokenizedSharesDenom               = types.TokenizedSharesDenom
+	TokenizedSharesRecordIDFromDenom   = types.TokenizedSharesRecordIDFromDenom
+	IsTokenizedSharesDenom             = types.IsTokenizedSharesDenom
+	NewTokenizedSharesRecord           = types.NewTokenizedSharesRecord
 	NewQueryDelegatorParams            = types.NewQueryDelegatorParams
 	NewQueryValidatorParams            = types.NewQueryValidatorParams
 	NewQueryBondsParams                = types.NewQueryBondsParams
@@ -193,7 +230,6 @@ var (
 	Addrs                            = keeper.Addrs
 	PKs                              = keeper.PKs
 	ModuleCdc                        = types.ModuleCdc
-	PoolKey                          = types.PoolKey
 	LastValidatorPowerKey            = types.LastValidatorPowerKey
 	LastTotalPowerKey                = types.LastTotalPowerKey
 	ValidatorsKey                    = types.ValidatorsKey
@@ -208,57 +244,72 @@ var (
 	UnbondingQueueKey                = types.UnbondingQueueKey
 	RedelegationQueueKey             = types.RedelegationQueueKey
 	ValidatorQueueKey                = types.ValidatorQueueKey
+	ConsPubKeyRotationKey            = types.ConsPubKeyRotationKey
+	OldConsAddrQueueKey              = types.OldConsAddrQueueKey
+	ValidatorOldConsAddrsKey         = types.ValidatorOldConsAddrsKey
+	HistoricalInfoKey                = types.HistoricalInfoKey
+	TokenizedSharesRecordKey         = types.TokenizedSharesRecordKey
+	TokenizedSharesByValIndexKey     = types.TokenizedSharesByValIndexKey
+	LastTokenizedSharesRecordIDKey   = types.LastTokenizedSharesRecordIDKey
 	KeyUnbondingTime                 = types.KeyUnbondingTime
 	KeyMaxValidators                 = types.KeyMaxValidators
 	KeyMaxEntries                    = types.KeyMaxEntries
+	KeyHistoricalEntries             = types.KeyHistoricalEntries
 	KeyBondDenom                     = types.KeyBondDenom
 )
 
 type (
-	Keeper                    = keeper.Keeper
-	Commission                = types.Commission
-	CommissionRates           = types.CommissionRates
-	DVPair                    = types.DVPair
-	DVVTriplet                = types.DVVTriplet
-	Delegation                = types.Delegation
-	Delegations               = types.Delegations
-	UnbondingDelegation       = types.UnbondingDelegation
-	UnbondingDelegationEntry  = types.UnbondingDelegationEntry
-	UnbondingDelegations      = types.UnbondingDelegations
-	Redelegation              = types.Redelegation
-	RedelegationEntry         = types.RedelegationEntry
-	Redelegations             = types.Redelegations
-	DelegationResponse        = types.DelegationResponse
-	DelegationResponses       = types.DelegationResponses
-	RedelegationResponse      = types.RedelegationResponse
-	RedelegationEntryResponse = types.RedelegationEntryResponse
-	RedelegationResponses     = types.RedelegationResponses
-	CodeType                  = types.CodeType
-	DistributionKeeper        = types.DistributionKeeper
-	FeeCollectionKeeper       = types.FeeCollectionKeeper
-	BankKeeper                = types.BankKeeper
-	AccountKeeper             = types.AccountKeeper
-	ValidatorSet              = types.ValidatorSet
-	DelegationSet             = types.DelegationSet
-	StakingHooks              = types.StakingHooks
-	GenesisState              = types.GenesisState
-	LastValidatorPower        = types.LastValidatorPower
-	MultiStakingHooks         = types.MultiStakingHooks
-	MsgCreateValidator        = types.MsgCreateValidator
-	MsgEditValidator          = types.MsgEditValidator
-	MsgDelegate               = types.MsgDelegate
-	MsgBeginRedelegate        = types.MsgBeginRedelegate
-	MsgUndelegate             = types.MsgUndelegate
-	Params                    = types.Params
-	Pool                      = types.Pool
-	QueryDelegatorParams      = types.QueryDelegatorParams
-	QueryValidatorParams      = types.QueryValidatorParams
-	QueryBondsParams          = types.QueryBondsParams
-	QueryRedelegationParams   = types.QueryRedelegationParams
-	QueryValidatorsParams     = types.QueryValidatorsParams
-	Validator                 = types.Validator
-	Validators                = types.Validators
-	Description               = types.Description
-	DelegationI               = exported.DelegationI
-	ValidatorI                = exported.ValidatorI
+	Keeper                       = keeper.Keeper
+	Commission                   = types.Commission
+	CommissionRates              = types.CommissionRates
+	DVPair                       = types.DVPair
+	DVVTriplet                   = types.DVVTriplet
+	Delegation                   = types.Delegation
+	Delegations                  = types.Delegations
+	UnbondingDelegation          = types.UnbondingDelegation
+	UnbondingDelegationEntry     = types.UnbondingDelegationEntry
+	UnbondingDelegations         = types.UnbondingDelegations
+	Redelegation                 = types.Redelegation
+	RedelegationEntry            = types.RedelegationEntry
+	Redelegations                = types.Redelegations
+	DelegationResponse           = types.DelegationResponse
+	DelegationResponses          = types.DelegationResponses
+	RedelegationResponse         = types.RedelegationResponse
+	RedelegationEntryResponse    = types.RedelegationEntryResponse
+	RedelegationResponses        = types.RedelegationResponses
+	CodeType                     = types.CodeType
+	DistributionKeeper           = types.DistributionKeeper
+	AccountKeeper                = types.AccountKeeper
+	SupplyKeeper                 = types.SupplyKeeper
+	ValidatorSet                 = types.ValidatorSet
+	DelegationSet                = types.DelegationSet
+	StakingHooks                 = types.StakingHooks
+	GenesisState                 = types.GenesisState
+	LastValidatorPower           = types.LastValidatorPower
+	MultiStakingHooks            = types.MultiStakingHooks
+	MsgCreateValidator           = types.MsgCreateValidator
+	MsgEditValidator             = types.MsgEditValidator
+	MsgDelegate                  = types.MsgDelegate
+	MsgBeginRedelegate           = types.MsgBeginRedelegate
+	MsgUndelegate                = types.MsgUndelegate
+	MsgCancelUnbondingDelegation = types.MsgCancelUnbondingDelegation
+	MsgRotateConsPubKey          = types.MsgRotateConsPubKey
+	MsgTokenizeShares            = types.MsgTokenizeShares
+	MsgRedeemTokensForShares     = types.MsgRedeemTokensForShares
+	Params                       = types.Params
+	Pool                         = types.Pool
+	ConsPubKeyRotation           = types.ConsPubKeyRotation
+	HistoricalInfo               = types.HistoricalInfo
+	TokenizedSharesRecord        = types.TokenizedSharesRecord
+	QueryHistoricalInfoParams    = types.QueryHistoricalInfoParams
+	QueryDelegatorParams         = types.QueryDelegatorParams
+	QueryValidatorParams         = types.QueryValidatorParams
+	QueryBondsParams             = types.QueryBondsParams
+	QueryRedelegationParams      = types.QueryRedelegationParams
+	QueryValidatorsParams        = types.QueryValidatorsParams
+	Validator                    = types.Validator
+	Validators                   = types.Validators
+	Description                  = types.Description
+	DelegationI                  = exported.DelegationI
+	ValidatorI                   = exported.ValidatorI
 )
@@ -11,6 +11,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/x/bank"
 	"github.com/cosmos/cosmos-sdk/x/mock"
 	"github.com/cosmos/cosmos-sdk/x/staking/types"
+	"github.com/cosmos/cosmos-sdk/x/supply"
 )
 
 // getMockApp returns an initialized mock application for this module.
@@ -21,41 +22,62 @@ func getMockApp(t *testing.T) (*mock.App, Keeper) {
 
 	keyStaking := sdk.NewKVStoreKey(StoreKey)
 	tkeyStaking := sdk.NewTransientStoreKey(TStoreKey)
-
-	bankKeeper := bank.NewBaseKeeper(mApp.AccountKeeper, mApp.ParamsKeeper.Subspace(bank.DefaultParamspace), bank.DefaultCodespace)
-	keeper := NewKeeper(mApp.Cdc, keyStaking, tkeyStaking, bankKeeper, mApp.ParamsKeeper.Subspace(DefaultParamspace), DefaultCodespace)
+	keySupply := sdk.NewKVStoreKey(supply.StoreKey)
+
+	feeCollector := auth.NewEmptyModuleAccount(auth.FeeCollectorName)
+	notBondedPool := auth.NewEmptyModuleAccount(types.NotBondedPoolName, auth.Burner, auth.Staking)
+	bondPool := auth.NewEmptyModuleAccount(types.BondedPoolName, auth.Burner, auth.Staking)
+
+	blacklistedAddrs := make(map[string]bool)
+	blacklistedAddrs[feeCollector.GetAddress().String()] = true
+	blacklistedAddrs[notBondedPool.GetAddress().String()] = true
+	blacklistedAddrs[bondPool.GetAddress().String()] = true
+
+	bankKeeper := bank.NewBaseKeeper(mApp.Cdc, mApp.KeyBank, mApp.AccountKeeper, mApp.ParamsKeeper.Subspace(bank.DefaultParamspace), bank.DefaultCodespace, blacklistedAddrs)
+	mApp.BankKeeper = bankKeeper
+	maccPerms := map[string][]string{
+		auth.FeeCollectorName:   nil,
+		types.NotBondedPoolName: {auth.Burner, auth.Staking},
+		types.BondedPoolName:    {auth.Burner, auth.Staking},
+	}
+	supplyKeeper := supply.NewKeeper(mApp.Cdc, keySupply, mApp.AccountKeeper, bankKeeper, maccPerms)
+	keeper := NewKeeper(mApp.Cdc, keyStaking, tkeyStaking, mApp.AccountKeeper, supplyKeeper, mApp.ParamsKeeper.Subspace(DefaultParamspace), DefaultCodespace)
 
 	mApp.Router().AddRoute(RouterKey, NewHandler(keeper))
 	mApp.SetEndBlocker(getEndBlocker(keeper))
-	mApp.SetInitChainer(getInitChainer(mApp, keeper, mApp.AccountKeeper))
+	mApp.SetInitChainer(getInitChainer(mApp, keeper, mApp.AccountKeeper, bankKeeper, supplyKeeper,
+		[]auth.ModuleAccountI{feeCollector, notBondedPool, bondPool}))
 
-	require.NoError(t, mApp.CompleteSetup(keyStaking, tkeyStaking))
+	require.NoError(t, mApp.CompleteSetup(keyStaking, tkeyStaking, keySupply))
 	return mApp, keeper
 }
 
 // getEndBlocker returns a staking endblocker.
 func getEndBlocker(keeper Keeper) sdk.EndBlocker {
 	return func(ctx sdk.Context, req abci.RequestEndBlock) abci.ResponseEndBlock {
-		validatorUpdates, tags := EndBlocker(ctx, keeper)
+		validatorUpdates := EndBlocker(ctx, keeper)
 
 		return abci.ResponseEndBlock{
 			ValidatorUpdates: validatorUpdates,
-			Tags:             tags,
 		}
 	}
 }
 
 // getInitChainer initializes the chainer of the mock app and sets the genesis
 // state. It returns an empty ResponseInitChain.
-func getInitChainer(mapp *mock.App, keeper Keeper, accountKeeper types.AccountKeeper) sdk.InitChainer {
+func getInitChainer(mapp *mock.App, keeper Keeper, accountKeeper types.AccountKeeper, bankKeeper bank.Keeper, supplyKeeper supply.Keeper,
+	moduleAccounts []auth.ModuleAccountI) sdk.InitChainer {
 	return func(ctx sdk.Context, req abci.RequestInitChain) abci.ResponseInitChain {
 		mapp.InitChainer(ctx, req)
 
-		stakingGenesis := DefaultGenesisState()
-		tokens := sdk.TokensFromTendermintPower(100000)
-		stakingGenesis.Pool.NotBondedTokens = tokens
+		// set module accounts
+		for _, macc := range moduleAccounts {
+			supplyKeeper.SetModuleAccount(ctx, macc)
+		}
 
-		validators := InitGenesis(ctx, keeper, accountKeeper, stakingGenesis)
+		stakingGenesis := DefaultGenesisState()
+		validators := InitGenesis(ctx, keeper, accountKeeper, supplyKeeper, stakingGenesis)
+		supply.InitGenesis(ctx, supplyKeeper, bankKeeper, supply.DefaultGenesisState())
 		return abci.ResponseInitChain{
 			Validators: validators,
 		}
@@ -99,17 +121,15 @@ func TestStakingMsgs(t *testing.T) {
 	genCoin := sdk.NewCoin(sdk.DefaultBondDenom, genTokens)
 	bondCoin := sdk.NewCoin(sdk.DefaultBondDenom, bondTokens)
 
-	acc1 := &auth.BaseAccount{
-		Address: addr1,
-		Coins:   sdk.Coins{genCoin},
-	}
-	acc2 := &auth.BaseAccount{
-		Address: addr2,
-		Coins:   sdk.Coins{genCoin},
-	}
+	acc1 := &auth.BaseAccount{Address: addr1}
+	acc2 := &auth.BaseAccount{Address: addr2}
 	accs := []auth.Account{acc1, acc2}
+	balances := []mock.Balance{
+		{Address: addr1, Coins: sdk.Coins{genCoin}},
+		{Address: addr2, Coins: sdk.Coins{genCoin}},
+	}
 
-	mock.SetGenesis(mApp, accs)
+	mock.SetGenesis(mApp, accs, balances)
 	mock.CheckBalance(t, mApp, addr1, sdk.Coins{genCoin})
 	mock.CheckBalance(t, mApp, addr2, sdk.Coins{genCoin})
 
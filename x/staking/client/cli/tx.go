@@ -3,6 +3,7 @@ package cli
 import (
 	"fmt"
 	"os"
+	"strconv"
 	"strings"
 
 	"github.com/spf13/cobra"
@@ -35,9 +36,13 @@ func GetTxCmd(storeKey string, cdc *codec.Codec) *cobra.Command {
 	stakingTxCmd.AddCommand(client.PostCommands(
 		GetCmdCreateValidator(cdc),
 		GetCmdEditValidator(cdc),
+		GetCmdRotateConsPubKey(cdc),
 		GetCmdDelegate(cdc),
 		GetCmdRedelegate(storeKey, cdc),
 		GetCmdUnbond(storeKey, cdc),
+		GetCmdCancelUnbond(cdc),
+		GetCmdTokenizeShares(cdc),
+		GetCmdRedeemTokens(cdc),
 	)...)
 
 	return stakingTxCmd
@@ -136,6 +141,40 @@ func GetCmdEditValidator(cdc *codec.Codec) *cobra.Command {
 	return cmd
 }
 
+// GetCmdRotateConsPubKey implements the rotate consensus pubkey command.
+func GetCmdRotateConsPubKey(cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "rotate-cons-pubkey [new-pubkey]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Rotate the consensus pubkey of your validator",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Replace the consensus pubkey of your validator by a new Bech32 consensus pubkey.
+The rotation is applied at the end of the block.
+
+Example:
+$ %s tx staking rotate-cons-pubkey cosmosvalconspub1zcjduepq0vu2zgkgk49efa0nqwzndanq5m4c7pa3u4apz4g2r9gspqg6g9cs3k9cuf --from mykey
+`,
+				version.ClientName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			txBldr := auth.NewTxBuilderFromCLI().WithTxEncoder(auth.DefaultTxEncoder(cdc))
+			cliCtx := context.NewCLIContext().
+				WithCodec(cdc).
+				WithAccountDecoder(cdc)
+
+			pk, err := sdk.GetConsPubKeyBech32(args[0])
+			if err != nil {
+				return err
+			}
+
+			valAddr := cliCtx.GetFromAddress()
+			msg := types.NewMsgRotateConsPubKey(sdk.ValAddress(valAddr), pk)
+			return utils.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
+		},
+	}
+}
+
 // GetCmdDelegate implements the delegate command.
 func GetCmdDelegate(cdc *codec.Codec) *cobra.Command {
 	return &cobra.Command{
@@ -255,6 +294,120 @@ $ %s tx staking unbond cosmosvaloper1gghjut3ccd8ay0zduzj64hwre2fxs9ldmqhffj 100s
 	}
 }
 
+// GetCmdCancelUnbond implements the cancel unbonding delegation command.
+func GetCmdCancelUnbond(cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "cancel-unbond [validator-addr] [amount] [creation-height]",
+		Short: "Cancel unbonding delegation and delegate back to the validator",
+		Args:  cobra.ExactArgs(3),
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Cancel an amount of the unbonding delegation entry created at the given height and delegate it back to the validator.
+
+Example:
+$ %s tx staking cancel-unbond cosmosvaloper1gghjut3ccd8ay0zduzj64hwre2fxs9ldmqhffj 100stake 123123 --from mykey
+`,
+				version.ClientName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			txBldr := auth.NewTxBuilderFromCLI().WithTxEncoder(auth.DefaultTxEncoder(cdc))
+			cliCtx := context.NewCLIContext().
+				WithCodec(cdc).
+				WithAccountDecoder(cdc)
+
+			delAddr := cliCtx.GetFromAddress()
+			valAddr, err := sdk.ValAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+
+			amount, err := sdk.ParseCoin(args[1])
+			if err != nil {
+				return err
+			}
+
+			creationHeight, err := strconv.ParseInt(args[2], 10, 64)
+			if err != nil {
+				return fmt.Errorf("invalid creation height %s: %v", args[2], err)
+			}
+
+			msg := types.NewMsgCancelUnbondingDelegation(delAddr, valAddr, amount, creationHeight)
+			return utils.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
+		},
+	}
+}
+
+// GetCmdTokenizeShares implements the tokenize shares command handler.
+func GetCmdTokenizeShares(cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "tokenize-share [validator-addr] [amount]",
+		Short: "Tokenize part of a delegation into share tokens of the validator",
+		Args:  cobra.ExactArgs(2),
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Convert the shares of a delegation worth the given amount into share tokens of the validator, which can be transferred and redeemed for shares.
+
+Example:
+$ %s tx staking tokenize-share cosmosvaloper1gghjut3ccd8ay0zduzj64hwre2fxs9ldmqhffj 100stake --from mykey
+`,
+				version.ClientName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			txBldr := auth.NewTxBuilderFromCLI().WithTxEncoder(auth.DefaultTxEncoder(cdc))
+			cliCtx := context.NewCLIContext().
+				WithCodec(cdc).
+				WithAccountDecoder(cdc)
+
+			delAddr := cliCtx.GetFromAddress()
+			valAddr, err := sdk.ValAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+
+			amount, err := sdk.ParseCoin(args[1])
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgTokenizeShares(delAddr, valAddr, amount)
+			return utils.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
+		},
+	}
+}
+
+// GetCmdRedeemTokens implements the redeem share tokens command handler.
+func GetCmdRedeemTokens(cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "redeem-tokens [amount]",
+		Short: "Redeem share tokens of a validator for a delegation",
+		Args:  cobra.ExactArgs(1),
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Redeem share tokens of a validator for their shares, delegated from the sender, and their part of the rewards accrued by the shares.
+
+Example:
+$ %s tx staking redeem-tokens 100stk1 --from mykey
+`,
+				version.ClientName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			txBldr := auth.NewTxBuilderFromCLI().WithTxEncoder(auth.DefaultTxEncoder(cdc))
+			cliCtx := context.NewCLIContext().
+				WithCodec(cdc).
+				WithAccountDecoder(cdc)
+
+			delAddr := cliCtx.GetFromAddress()
+			amount, err := sdk.ParseCoin(args[0])
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgRedeemTokensForShares(delAddr, amount)
+			return utils.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
+		},
+	}
+}
+
 //__________________________________________________________
 
 var (
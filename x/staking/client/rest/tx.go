@@ -23,10 +23,22 @@ func registerTxRoutes(cliCtx context.CLIContext, r *mux.Router, cdc *codec.Codec
 		"/staking/delegators/{delegatorAddr}/unbonding_delegations",
 		postUnbondingDelegationsHandlerFn(cdc, cliCtx),
 	).Methods("POST")
+	r.HandleFunc(
+		"/staking/delegators/{delegatorAddr}/unbonding_delegations/cancel",
+		postCancelUnbondingDelegationHandlerFn(cdc, cliCtx),
+	).Methods("POST")
 	r.HandleFunc(
 		"/staking/delegators/{delegatorAddr}/redelegations",
 		postRedelegationsHandlerFn(cdc, cliCtx),
 	).Methods("POST")
+	r.HandleFunc(
+		"/staking/delegators/{delegatorAddr}/tokenize_shares",
+		postTokenizeSharesHandlerFn(cdc, cliCtx),
+	).Methods("POST")
+	r.HandleFunc(
+		"/staking/delegators/{delegatorAddr}/redeem_tokens",
+		postRedeemTokensHandlerFn(cdc, cliCtx),
+	).Methods("POST")
 }
 
 type (
@@ -54,6 +66,33 @@ type (
 		ValidatorAddress sdk.ValAddress `json:"validator_address"` // in bech32
 		Amount           sdk.Coin       `json:"amount"`
 	}
+
+	// CancelUnbondingDelegationRequest defines the properties of a cancel
+	// unbonding delegation request's body.
+	CancelUnbondingDelegationRequest struct {
+		BaseReq          rest.BaseReq   `json:"base_req"`
+		DelegatorAddress sdk.AccAddress `json:"delegator_address"` // in bech32
+		ValidatorAddress sdk.ValAddress `json:"validator_address"` // in bech32
+		Amount           sdk.Coin       `json:"amount"`
+		CreationHeight   int64          `json:"creation_height"`
+	}
+
+	// TokenizeSharesRequest defines the properties of a tokenize shares
+	// request's body.
+	TokenizeSharesRequest struct {
+		BaseReq          rest.BaseReq   `json:"base_req"`
+		DelegatorAddress sdk.AccAddress `json:"delegator_address"` // in bech32
+		ValidatorAddress sdk.ValAddress `json:"validator_address"` // in bech32
+		Amount           sdk.Coin       `json:"amount"`
+	}
+
+	// RedeemTokensRequest defines the properties of a redeem share tokens
+	// request's body.
+	RedeemTokensRequest struct {
+		BaseReq          rest.BaseReq   `json:"base_req"`
+		DelegatorAddress sdk.AccAddress `json:"delegator_address"` // in bech32
+		Amount           sdk.Coin       `json:"amount"`
+	}
 )
 
 func postDelegationsHandlerFn(cdc *codec.Codec, cliCtx context.CLIContext) http.HandlerFunc {
@@ -157,3 +196,105 @@ func postUnbondingDelegationsHandlerFn(cdc *codec.Codec, cliCtx context.CLIConte
 		clientrest.WriteGenerateStdTxResponse(w, cdc, cliCtx, req.BaseReq, []sdk.Msg{msg})
 	}
 }
+
+func postCancelUnbondingDelegationHandlerFn(cdc *codec.Codec, cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		var req CancelUnbondingDelegationRequest
+
+		if !rest.ReadRESTReq(w, r, cdc, &req) {
+			return
+		}
+
+		req.BaseReq = req.BaseReq.Sanitize()
+		if !req.BaseReq.ValidateBasic(w) {
+			return
+		}
+
+		msg := types.NewMsgCancelUnbondingDelegation(req.DelegatorAddress, req.ValidatorAddress, req.Amount, req.CreationHeight)
+		if err := msg.ValidateBasic(); err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		fromAddr, err := sdk.AccAddressFromBech32(req.BaseReq.From)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		if !bytes.Equal(fromAddr, req.DelegatorAddress) {
+			rest.WriteErrorResponse(w, http.StatusUnauthorized, "must use own delegator address")
+			return
+		}
+
+		clientrest.WriteGenerateStdTxResponse(w, cdc, cliCtx, req.BaseReq, []sdk.Msg{msg})
+	}
+}
+
+func postTokenizeSharesHandlerFn(cdc *codec.Codec, cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		var req TokenizeSharesRequest
+
+		if !rest.ReadRESTReq(w, r, cdc, &req) {
+			return
+		}
+
+		req.BaseReq = req.BaseReq.Sanitize()
+		if !req.BaseReq.ValidateBasic(w) {
+			return
+		}
+
+		msg := types.NewMsgTokenizeShares(req.DelegatorAddress, req.ValidatorAddress, req.Amount)
+		if err := msg.ValidateBasic(); err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		fromAddr, err := sdk.AccAddressFromBech32(req.BaseReq.From)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		if !bytes.Equal(fromAddr, req.DelegatorAddress) {
+			rest.WriteErrorResponse(w, http.StatusUnauthorized, "must use own delegator address")
+			return
+		}
+
+		clientrest.WriteGenerateStdTxResponse(w, cdc, cliCtx, req.BaseReq, []sdk.Msg{msg})
+	}
+}
+
+func postRedeemTokensHandlerFn(cdc *codec.Codec, cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		var req RedeemTokensRequest
+
+		if !rest.ReadRESTReq(w, r, cdc, &req) {
+			return
+		}
+
+		req.BaseReq = req.BaseReq.Sanitize()
+		if !req.BaseReq.ValidateBasic(w) {
+			return
+		}
+
+		msg := types.NewMsgRedeemTokensForShares(req.DelegatorAddress, req.Amount)
+		if err := msg.ValidateBasic(); err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		fromAddr, err := sdk.AccAddressFromBech32(req.BaseReq.From)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		if !bytes.Equal(fromAddr, req.DelegatorAddress) {
+			rest.WriteErrorResponse(w, http.StatusUnauthorized, "must use own delegator address")
+			return
+		}
+
+		clientrest.WriteGenerateStdTxResponse(w, cdc, cliCtx, req.BaseReq, []sdk.Msg{msg})
+	}
+}
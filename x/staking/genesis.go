@@ -7,7 +7,6 @@ import (
 	tmtypes "github.com/tendermint/tendermint/types"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
-	"github.com/cosmos/cosmos-sdk/x/auth"
 	"github.com/cosmos/cosmos-sdk/x/staking/exported"
 	"github.com/cosmos/cosmos-sdk/x/staking/types"
 )
@@ -17,7 +16,11 @@ import (
 // setting the indexes. In addition, it also sets any delegations found in
 // data. Finally, it updates the bonded validators.
 // Returns final validator set after applying all declaration and delegations
-func InitGenesis(ctx sdk.Context, keeper Keeper, accountKeeper types.AccountKeeper, data types.GenesisState) (res []abci.ValidatorUpdate) {
+func InitGenesis(ctx sdk.Context, keeper Keeper, accountKeeper types.AccountKeeper,
+	supplyKeeper types.SupplyKeeper, data types.GenesisState) (res []abci.ValidatorUpdate) {
+
+	bondedTokens := sdk.ZeroInt()
+	notBondedTokens := sdk.ZeroInt()
 
 	// We need to pretend to be "n blocks before genesis", where "n" is the
 	// validator update delay, so that e.g. slashing periods are correctly
@@ -26,18 +29,6 @@ func InitGenesis(ctx sdk.Context, keeper Keeper, accountKeeper types.AccountKeep
 	// genesis.json are in block 0.
 	ctx = ctx.WithBlockHeight(1 - sdk.ValidatorUpdateDelay)
 
-	// manually set the total supply for staking based on accounts if not provided
-	if data.Pool.NotBondedTokens.IsZero() {
-		accountKeeper.IterateAccounts(ctx,
-			func(acc auth.Account) (stop bool) {
-				data.Pool.NotBondedTokens = data.Pool.NotBondedTokens.
-					Add(acc.GetCoins().AmountOf(data.Params.BondDenom))
-				return false
-			},
-		)
-	}
-
-	keeper.SetPool(ctx, data.Pool) // TODO remove pool from genesis data and always calculate?
 	keeper.SetParams(ctx, data.Params)
 	keeper.SetLastTotalPower(ctx, data.LastTotalPower)
 
@@ -57,6 +48,15 @@ func InitGenesis(ctx sdk.Context, keeper Keeper, accountKeeper types.AccountKeep
 		if validator.IsUnbonding() {
 			keeper.InsertValidatorQueue(ctx, validator)
 		}
+
+		switch validator.GetStatus() {
+		case sdk.Bonded:
+			bondedTokens = bondedTokens.Add(validator.GetTokens())
+		case sdk.Unbonding, sdk.Unbonded:
+			notBondedTokens = notBondedTokens.Add(validator.GetTokens())
+		default:
+			panic("invalid validator status")
+		}
 	}
 
 	for _, delegation := range data.Delegations {
@@ -75,6 +75,7 @@ func InitGenesis(ctx sdk.Context, keeper Keeper, accountKeeper types.AccountKeep
 		keeper.SetUnbondingDelegation(ctx, ubd)
 		for _, entry := range ubd.Entries {
 			keeper.InsertUBDQueue(ctx, ubd, entry.CompletionTime)
+			notBondedTokens = notBondedTokens.Add(entry.Balance)
 		}
 	}
 
@@ -85,6 +86,38 @@ func InitGenesis(ctx sdk.Context, keeper Keeper, accountKeeper types.AccountKeep
 		}
 	}
 
+	for _, record := range data.TokenizedSharesRecords {
+		keeper.SetTokenizedSharesRecord(ctx, record)
+	}
+	keeper.SetLastTokenizedSharesRecordID(ctx, data.LastTokenizedSharesRecordID)
+
+	bondedCoins := sdk.NewCoins(sdk.NewCoin(data.Params.BondDenom, bondedTokens))
+	notBondedCoins := sdk.NewCoins(sdk.NewCoin(data.Params.BondDenom, notBondedTokens))
+
+	// check if the unbonded and bonded pools accounts exists
+	bondedPool := keeper.GetBondedPool(ctx)
+	if bondedPool == nil {
+		panic(fmt.Sprintf("%s module account has not been set", types.BondedPoolName))
+	}
+
+	// add coins if not provided on genesis
+	if supplyKeeper.GetModuleAccountCoins(ctx, types.BondedPoolName).IsZero() {
+		if err := supplyKeeper.SetModuleAccountCoins(ctx, types.BondedPoolName, bondedCoins); err != nil {
+			panic(err)
+		}
+	}
+
+	notBondedPool := keeper.GetNotBondedPool(ctx)
+	if notBondedPool == nil {
+		panic(fmt.Sprintf("%s module account has not been set", types.NotBondedPoolName))
+	}
+
+	if supplyKeeper.GetModuleAccountCoins(ctx, types.NotBondedPoolName).IsZero() {
+		if err := supplyKeeper.SetModuleAccountCoins(ctx, types.NotBondedPoolName, notBondedCoins); err != nil {
+			panic(err)
+		}
+	}
+
 	// don't need to run Tendermint updates if we exported
 	if data.Exported {
 		for _, lv := range data.LastValidatorPowers {
@@ -105,10 +138,9 @@ func InitGenesis(ctx sdk.Context, keeper Keeper, accountKeeper types.AccountKeep
 }
 
 // ExportGenesis returns a GenesisState for a given context and keeper. The
-// GenesisState will contain the pool, params, validators, and bonds found in
-// the keeper.
+// GenesisState will contain the params, validators, and bonds found in the
+// keeper.
 func ExportGenesis(ctx sdk.Context, keeper Keeper) types.GenesisState {
-	pool := keeper.GetPool(ctx)
 	params := keeper.GetParams(ctx)
 	lastTotalPower := keeper.GetLastTotalPower(ctx)
 	validators := keeper.GetAllValidators(ctx)
@@ -123,6 +155,12 @@ func ExportGenesis(ctx sdk.Context, keeper Keeper) types.GenesisState {
 		redelegations = append(redelegations, red)
 		return false
 	})
+	var tokenizedSharesRecords []types.TokenizedSharesRecord
+	keeper.IterateTokenizedSharesRecords(ctx, func(record types.TokenizedSharesRecord) (stop bool) {
+		tokenizedSharesRecords = append(tokenizedSharesRecords, record)
+		return false
+	})
+	lastTokenizedSharesRecordID := keeper.GetLastTokenizedSharesRecordID(ctx)
 	var lastValidatorPowers []types.LastValidatorPower
 	keeper.IterateLastValidatorPowers(ctx, func(addr sdk.ValAddress, power int64) (stop bool) {
 		lastValidatorPowers = append(lastValidatorPowers, types.LastValidatorPower{addr, power})
@@ -130,15 +168,16 @@ func ExportGenesis(ctx sdk.Context, keeper Keeper) types.GenesisState {
 	})
 
 	return types.GenesisState{
-		Pool:                 pool,
-		Params:               params,
-		LastTotalPower:       lastTotalPower,
-		LastValidatorPowers:  lastValidatorPowers,
-		Validators:           validators,
-		Delegations:          delegations,
-		UnbondingDelegations: unbondingDelegations,
-		Redelegations:        redelegations,
-		Exported:             true,
+		Params:                      params,
+		LastTotalPower:              lastTotalPower,
+		LastValidatorPowers:         lastValidatorPowers,
+		Validators:                  validators,
+		Delegations:                 delegations,
+		UnbondingDelegations:        unbondingDelegations,
+		Redelegations:               redelegations,
+		TokenizedSharesRecords:      tokenizedSharesRecords,
+		LastTokenizedSharesRecordID: lastTokenizedSharesRecordID,
+		Exported:                    true,
 	}
 }
 
@@ -168,6 +207,10 @@ func ValidateGenesis(data types.GenesisState) error {
 	if err != nil {
 		return err
 	}
+	err = validateGenesisStateTokenizedSharesRecords(data)
+	if err != nil {
+		return err
+	}
 
 	return nil
 }
@@ -190,3 +233,35 @@ func validateGenesisStateValidators(validators []types.Validator) (err error) {
 	}
 	return
 }
+
+func validateGenesisStateTokenizedSharesRecords(data types.GenesisState) error {
+	valMap := make(map[string]bool, len(data.Validators))
+	for _, val := range data.Validators {
+		valMap[val.OperatorAddress.String()] = true
+	}
+
+	idMap := make(map[uint64]bool, len(data.TokenizedSharesRecords))
+	recordValMap := make(map[string]bool, len(data.TokenizedSharesRecords))
+	for _, record := range data.TokenizedSharesRecords {
+		if record.ID == 0 || record.ID > data.LastTokenizedSharesRecordID {
+			return fmt.Errorf("invalid tokenized shares record ID %d, last ID is %d",
+				record.ID, data.LastTokenizedSharesRecordID)
+		}
+		if idMap[record.ID] {
+			return fmt.Errorf("duplicate tokenized shares denom in genesis state: %s", record.GetShareTokenDenom())
+		}
+		valAddr := record.ValidatorAddress.String()
+		if !valMap[valAddr] {
+			return fmt.Errorf("tokenized shares record %d of unknown validator %s", record.ID, valAddr)
+		}
+		if recordValMap[valAddr] {
+			return fmt.Errorf("duplicate tokenized shares record of validator %s in genesis state", valAddr)
+		}
+		if !record.Rewards.IsValid() {
+			return fmt.Errorf("invalid rewards of tokenized shares record %d: %s", record.ID, record.Rewards)
+		}
+		idMap[record.ID] = true
+		recordValMap[valAddr] = true
+	}
+	return nil
+}
@@ -17,10 +17,8 @@ import (
 )
 
 func TestInitGenesis(t *testing.T) {
-	ctx, accKeeper, keeper := keep.CreateTestInput(t, false, 1000)
+	ctx, accKeeper, _, keeper, supplyKeeper := keep.CreateTestInput(t, false, 1000)
 
-	pool := keeper.GetPool(ctx)
-	pool.BondedTokens = sdk.TokensFromTendermintPower(2)
 	valTokens := sdk.TokensFromTendermintPower(1)
 
 	params := keeper.GetParams(ctx)
@@ -41,11 +39,13 @@ func TestInitGenesis(t *testing.T) {
 	validators[1].Tokens = valTokens
 	validators[1].DelegatorShares = valTokens.ToDec()
 
-	genesisState := types.NewGenesisState(pool, params, validators, delegations)
-	vals := InitGenesis(ctx, keeper, accKeeper, genesisState)
+	genesisState := types.NewGenesisState(params, validators, delegations)
+	vals := InitGenesis(ctx, keeper, accKeeper, supplyKeeper, genesisState)
+
+	// the bonded pool holds the tokens of the bonded genesis validators
+	require.Equal(t, valTokens.MulRaw(2), supplyKeeper.GetModuleAccountCoins(ctx, BondedPoolName).AmountOf(params.BondDenom))
 
 	actualGenesis := ExportGenesis(ctx, keeper)
-	require.Equal(t, genesisState.Pool, actualGenesis.Pool)
 	require.Equal(t, genesisState.Params, actualGenesis.Params)
 	require.Equal(t, genesisState.Delegations, actualGenesis.Delegations)
 	require.EqualValues(t, keeper.GetAllValidators(ctx), actualGenesis.Validators)
@@ -71,13 +71,9 @@ func TestInitGenesisLargeValidatorSet(t *testing.T) {
 	size := 200
 	require.True(t, size > 100)
 
-	ctx, accKeeper, keeper := keep.CreateTestInput(t, false, 1000)
+	ctx, accKeeper, _, keeper, supplyKeeper := keep.CreateTestInput(t, false, 1000)
 
 	// Assigning 2 to the first 100 vals, 1 to the rest
-	pool := keeper.GetPool(ctx)
-	bondedTokens := sdk.TokensFromTendermintPower(int64(200 + (size - 100)))
-	pool.BondedTokens = bondedTokens
-
 	params := keeper.GetParams(ctx)
 	delegations := []Delegation{}
 	validators := make([]Validator, size)
@@ -96,8 +92,8 @@ func TestInitGenesisLargeValidatorSet(t *testing.T) {
 		validators[i].DelegatorShares = tokens.ToDec()
 	}
 
-	genesisState := types.NewGenesisState(pool, params, validators, delegations)
-	vals := InitGenesis(ctx, keeper, accKeeper, genesisState)
+	genesisState := types.NewGenesisState(params, validators, delegations)
+	vals := InitGenesis(ctx, keeper, accKeeper, supplyKeeper, genesisState)
 
 	abcivals := make([]abci.ValidatorUpdate, 100)
 	for i, val := range validators[:100] {
@@ -113,6 +109,9 @@ func TestValidateGenesis(t *testing.T) {
 	genValidators1[0] = types.NewValidator(sdk.ValAddress(pk.Address()), pk, types.NewDescription("", "", "", ""))
 	genValidators1[0].Tokens = sdk.OneInt()
 	genValidators1[0].DelegatorShares = sdk.OneDec()
+	tokenizedVal := genValidators1[0]
+	unknownValAddr := sdk.ValAddress(ed25519.GenPrivKey().PubKey().Address())
+	rewards := sdk.NewCoins(sdk.NewInt64Coin("foocoin", 10))
 
 	tests := []struct {
 		name    string
@@ -134,6 +133,58 @@ func TestValidateGenesis(t *testing.T) {
 			(*data).Validators[0].Jailed = true
 			(*data).Validators[0].Status = sdk.Bonded
 		}, true},
+		// validate tokenized shares records
+		{"tokenized shares record", func(data *types.GenesisState) {
+			(*data).Validators = []types.Validator{tokenizedVal}
+			(*data).TokenizedSharesRecords = []types.TokenizedSharesRecord{
+				types.NewTokenizedSharesRecord(2, tokenizedVal.OperatorAddress, rewards),
+			}
+			(*data).LastTokenizedSharesRecordID = 3
+		}, false},
+		{"zero tokenized shares record ID", func(data *types.GenesisState) {
+			(*data).Validators = []types.Validator{tokenizedVal}
+			(*data).TokenizedSharesRecords = []types.TokenizedSharesRecord{
+				types.NewTokenizedSharesRecord(0, tokenizedVal.OperatorAddress, rewards),
+			}
+			(*data).LastTokenizedSharesRecordID = 3
+		}, true},
+		{"tokenized shares record ID above the last one", func(data *types.GenesisState) {
+			(*data).Validators = []types.Validator{tokenizedVal}
+			(*data).TokenizedSharesRecords = []types.TokenizedSharesRecord{
+				types.NewTokenizedSharesRecord(4, tokenizedVal.OperatorAddress, rewards),
+			}
+			(*data).LastTokenizedSharesRecordID = 3
+		}, true},
+		{"duplicate tokenized shares denom", func(data *types.GenesisState) {
+			(*data).Validators = []types.Validator{tokenizedVal}
+			(*data).TokenizedSharesRecords = []types.TokenizedSharesRecord{
+				types.NewTokenizedSharesRecord(1, tokenizedVal.OperatorAddress, rewards),
+				types.NewTokenizedSharesRecord(1, unknownValAddr, rewards),
+			}
+			(*data).LastTokenizedSharesRecordID = 3
+		}, true},
+		{"duplicate tokenized shares record of a validator", func(data *types.GenesisState) {
+			(*data).Validators = []types.Validator{tokenizedVal}
+			(*data).TokenizedSharesRecords = []types.TokenizedSharesRecord{
+				types.NewTokenizedSharesRecord(1, tokenizedVal.OperatorAddress, rewards),
+				types.NewTokenizedSharesRecord(2, tokenizedVal.OperatorAddress, rewards),
+			}
+			(*data).LastTokenizedSharesRecordID = 3
+		}, true},
+		{"tokenized shares record of unknown validator", func(data *types.GenesisState) {
+			(*data).Validators = []types.Validator{tokenizedVal}
+			(*data).TokenizedSharesRecords = []types.TokenizedSharesRecord{
+				types.NewTokenizedSharesRecord(1, unknownValAddr, rewards),
+			}
+			(*data).LastTokenizedSharesRecordID = 3
+		}, true},
+		{"non-positive tokenized shares rewards", func(data *types.GenesisState) {
+			(*data).Validators = []types.Validator{tokenizedVal}
+			(*data).TokenizedSharesRecords = []types.TokenizedSharesRecord{
+				types.NewTokenizedSharesRecord(1, tokenizedVal.OperatorAddress, sdk.Coins{sdk.NewInt64Coin("foocoin", 0)}),
+			}
+			(*data).LastTokenizedSharesRecordID = 3
+		}, true},
 	}
 
 	for _, tt := range tests {
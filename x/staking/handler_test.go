@@ -34,7 +34,7 @@ func TestValidatorByPowerIndex(t *testing.T) {
 
 	initPower := int64(1000000)
 	initBond := sdk.TokensFromTendermintPower(initPower)
-	ctx, _, keeper := keep.CreateTestInput(t, false, initPower)
+	ctx, _, _, keeper, _ := keep.CreateTestInput(t, false, initPower)
 	_ = setInstantUnbondPeriod(keeper, ctx)
 
 	// create validator
@@ -114,7 +114,7 @@ func TestValidatorByPowerIndex(t *testing.T) {
 }
 
 func TestDuplicatesMsgCreateValidator(t *testing.T) {
-	ctx, _, keeper := keep.CreateTestInput(t, false, 1000)
+	ctx, _, _, keeper, _ := keep.CreateTestInput(t, false, 1000)
 
 	addr1, addr2 := sdk.ValAddress(keep.Addrs[0]), sdk.ValAddress(keep.Addrs[1])
 	pk1, pk2 := keep.PKs[0], keep.PKs[1]
@@ -166,7 +166,7 @@ func TestDuplicatesMsgCreateValidator(t *testing.T) {
 }
 
 func TestInvalidPubKeyTypeMsgCreateValidator(t *testing.T) {
-	ctx, _, keeper := keep.CreateTestInput(t, false, 1000)
+	ctx, _, _, keeper, _ := keep.CreateTestInput(t, false, 1000)
 
 	addr := sdk.ValAddress(keep.Addrs[0])
 	invalidPk := secp256k1.GenPrivKey().PubKey()
@@ -185,7 +185,7 @@ func TestInvalidPubKeyTypeMsgCreateValidator(t *testing.T) {
 }
 
 func TestLegacyValidatorDelegations(t *testing.T) {
-	ctx, _, keeper := keep.CreateTestInput(t, false, int64(1000))
+	ctx, _, _, keeper, _ := keep.CreateTestInput(t, false, int64(1000))
 	setInstantUnbondPeriod(keeper, ctx)
 
 	bondAmount := sdk.TokensFromTendermintPower(10)
@@ -279,7 +279,7 @@ func TestLegacyValidatorDelegations(t *testing.T) {
 func TestIncrementsMsgDelegate(t *testing.T) {
 	initPower := int64(1000)
 	initBond := sdk.TokensFromTendermintPower(initPower)
-	ctx, accMapper, keeper := keep.CreateTestInput(t, false, initPower)
+	ctx, _, bk, keeper, supplyKeeper := keep.CreateTestInput(t, false, initPower)
 	params := keeper.GetParams(ctx)
 
 	bondAmount := sdk.TokensFromTendermintPower(10)
@@ -306,8 +306,7 @@ func TestIncrementsMsgDelegate(t *testing.T) {
 	require.True(t, found)
 	require.Equal(t, bondAmount, bond.Shares.RoundInt())
 
-	pool := keeper.GetPool(ctx)
-	require.Equal(t, bondAmount, pool.BondedTokens)
+	require.Equal(t, bondAmount, supplyKeeper.GetModuleAccountCoins(ctx, BondedPoolName).AmountOf(keeper.BondDenom(ctx)))
 
 	// just send the same msgbond multiple times
 	msgDelegate := NewTestMsgDelegate(delegatorAddr, validatorAddr, bondAmount)
@@ -330,7 +329,7 @@ func TestIncrementsMsgDelegate(t *testing.T) {
 
 		gotBond := bond.Shares.RoundInt()
 		gotDelegatorShares := validator.DelegatorShares.RoundInt()
-		gotDelegatorAcc := accMapper.GetAccount(ctx, delegatorAddr).GetCoins().AmountOf(params.BondDenom)
+		gotDelegatorAcc := bk.GetCoins(ctx, delegatorAddr).AmountOf(params.BondDenom)
 
 		require.Equal(t, expBond, gotBond,
 			"i: %v\nexpBond: %v\ngotBond: %v\nvalidator: %v\nbond: %v\n",
@@ -349,7 +348,7 @@ func TestEditValidatorDecreaseMinSelfDelegation(t *testing.T) {
 
 	initPower := int64(100)
 	initBond := sdk.TokensFromTendermintPower(100)
-	ctx, _, keeper := keep.CreateTestInput(t, false, initPower)
+	ctx, _, _, keeper, _ := keep.CreateTestInput(t, false, initPower)
 	_ = setInstantUnbondPeriod(keeper, ctx)
 
 	// create validator
@@ -381,7 +380,7 @@ func TestEditValidatorIncreaseMinSelfDelegationBeyondCurrentBond(t *testing.T) {
 
 	initPower := int64(100)
 	initBond := sdk.TokensFromTendermintPower(100)
-	ctx, _, keeper := keep.CreateTestInput(t, false, initPower)
+	ctx, _, _, keeper, _ := keep.CreateTestInput(t, false, initPower)
 	_ = setInstantUnbondPeriod(keeper, ctx)
 
 	// create validator
@@ -408,10 +407,168 @@ func TestEditValidatorIncreaseMinSelfDelegationBeyondCurrentBond(t *testing.T) {
 	require.False(t, got.IsOK(), "should not be able to increase minSelfDelegation above current self delegation")
 }
 
+func TestRotateConsPubKey(t *testing.T) {
+	validatorAddr, validatorAddr2 := sdk.ValAddress(keep.Addrs[0]), sdk.ValAddress(keep.Addrs[1])
+
+	initPower := int64(100)
+	initBond := sdk.TokensFromTendermintPower(initPower)
+	ctx, _, _, keeper, _ := keep.CreateTestInput(t, false, 1000)
+
+	// create validators
+	got := handleMsgCreateValidator(ctx, NewTestMsgCreateValidator(validatorAddr, keep.PKs[0], initBond), keeper)
+	require.True(t, got.IsOK(), "expected create-validator to be ok, got %v", got)
+	got = handleMsgCreateValidator(ctx, NewTestMsgCreateValidator(validatorAddr2, keep.PKs[1], initBond), keeper)
+	require.True(t, got.IsOK(), "expected create-validator to be ok, got %v", got)
+
+	// must end-block
+	updates := keeper.ApplyAndReturnValidatorSetUpdates(ctx)
+	require.Equal(t, 2, len(updates))
+
+	// cannot rotate to the pubkey of another validator
+	got = handleMsgRotateConsPubKey(ctx, NewMsgRotateConsPubKey(validatorAddr, keep.PKs[1]), keeper)
+	require.False(t, got.IsOK(), "expected rotation to an existing pubkey to fail")
+
+	// cannot rotate the pubkey of a non-existent validator
+	got = handleMsgRotateConsPubKey(ctx, NewMsgRotateConsPubKey(sdk.ValAddress(keep.Addrs[2]), keep.PKs[2]), keeper)
+	require.False(t, got.IsOK(), "expected rotation of a non-existent validator to fail")
+
+	got = handleMsgRotateConsPubKey(ctx, NewMsgRotateConsPubKey(validatorAddr, keep.PKs[2]), keeper)
+	require.True(t, got.IsOK(), "expected rotation to be ok, got %v", got)
+
+	// only a single rotation may be pending
+	got = handleMsgRotateConsPubKey(ctx, NewMsgRotateConsPubKey(validatorAddr, keep.PKs[3]), keeper)
+	require.False(t, got.IsOK(), "expected second pending rotation to fail")
+
+	// cannot rotate to a pubkey about to be used by another validator
+	got = handleMsgRotateConsPubKey(ctx, NewMsgRotateConsPubKey(validatorAddr2, keep.PKs[2]), keeper)
+	require.False(t, got.IsOK(), "expected rotation to a pending pubkey to fail")
+
+	// the rotation is only applied at the end of the block
+	validator, found := keeper.GetValidator(ctx, validatorAddr)
+	require.True(t, found)
+	require.Equal(t, keep.PKs[0], validator.ConsPubKey)
+
+	// the previous pubkey is removed from the validator set and the new one added
+	updates = keeper.ApplyAndReturnValidatorSetUpdates(ctx)
+	require.Equal(t, 2, len(updates))
+	validator, found = keeper.GetValidator(ctx, validatorAddr)
+	require.True(t, found)
+	require.Equal(t, keep.PKs[2], validator.ConsPubKey)
+	require.Equal(t, abci.ValidatorUpdate{PubKey: tmtypes.TM2PB.PubKey(keep.PKs[0]), Power: 0}, updates[0])
+	require.Equal(t, validator.ABCIValidatorUpdate(), updates[1])
+
+	_, found = keeper.GetConsPubKeyRotation(ctx, validatorAddr)
+	require.False(t, found)
+
+	// the validator is found by both its previous and its new consensus address
+	validator, found = keeper.GetValidatorByConsAddr(ctx, sdk.GetConsAddress(keep.PKs[0]))
+	require.True(t, found)
+	require.Equal(t, validatorAddr, validator.OperatorAddress)
+	validator, found = keeper.GetValidatorByConsAddr(ctx, sdk.GetConsAddress(keep.PKs[2]))
+	require.True(t, found)
+	require.Equal(t, validatorAddr, validator.OperatorAddress)
+
+	// the validator can rotate again
+	got = handleMsgRotateConsPubKey(ctx, NewMsgRotateConsPubKey(validatorAddr, keep.PKs[3]), keeper)
+	require.True(t, got.IsOK(), "expected rotation to be ok, got %v", got)
+	updates = keeper.ApplyAndReturnValidatorSetUpdates(ctx)
+	require.Equal(t, 2, len(updates))
+
+	// the previous consensus addresses are removed at the end of the unbonding
+	// period
+	ctx = ctx.WithBlockTime(ctx.BlockHeader().Time.Add(keeper.UnbondingTime(ctx)))
+	EndBlocker(ctx, keeper)
+	_, found = keeper.GetValidatorByConsAddr(ctx, sdk.GetConsAddress(keep.PKs[0]))
+	require.False(t, found)
+	_, found = keeper.GetValidatorByConsAddr(ctx, sdk.GetConsAddress(keep.PKs[2]))
+	require.False(t, found)
+	validator, found = keeper.GetValidatorByConsAddr(ctx, sdk.GetConsAddress(keep.PKs[3]))
+	require.True(t, found)
+	require.Equal(t, validatorAddr, validator.OperatorAddress)
+}
+
+func TestRotateConsPubKeyRemoveValidator(t *testing.T) {
+	validatorAddr := sdk.ValAddress(keep.Addrs[0])
+	initBond := sdk.TokensFromTendermintPower(100)
+	ctx, _, _, keeper, _ := keep.CreateTestInput(t, false, 1000)
+
+	got := handleMsgCreateValidator(ctx, NewTestMsgCreateValidator(validatorAddr, keep.PKs[0], initBond), keeper)
+	require.True(t, got.IsOK(), "expected create-validator to be ok, got %v", got)
+	EndBlocker(ctx, keeper)
+
+	// the previous consensus address is kept for longer than the validator
+	// takes to unbond
+	params := keeper.GetParams(ctx)
+	params.UnbondingTime = 2 * time.Hour
+	keeper.SetParams(ctx, params)
+
+	got = handleMsgRotateConsPubKey(ctx, NewMsgRotateConsPubKey(validatorAddr, keep.PKs[1]), keeper)
+	require.True(t, got.IsOK(), "expected rotation to be ok, got %v", got)
+	EndBlocker(ctx, keeper)
+
+	params.UnbondingTime = time.Hour
+	keeper.SetParams(ctx, params)
+
+	unbondAmt := sdk.NewCoin(sdk.DefaultBondDenom, initBond)
+	got = handleMsgUndelegate(ctx, NewMsgUndelegate(sdk.AccAddress(validatorAddr), validatorAddr, unbondAmt), keeper)
+	require.True(t, got.IsOK(), "expected undelegation to be ok, got %v", got)
+	EndBlocker(ctx, keeper)
+
+	// the indexes of all the consensus addresses of the validator are removed
+	// along with the validator
+	ctx = ctx.WithBlockTime(ctx.BlockHeader().Time.Add(time.Hour))
+	EndBlocker(ctx, keeper)
+	_, found := keeper.GetValidator(ctx, validatorAddr)
+	require.False(t, found)
+	_, found = keeper.GetValidatorByConsAddr(ctx, sdk.GetConsAddress(keep.PKs[0]))
+	require.False(t, found)
+	_, found = keeper.GetValidatorByConsAddr(ctx, sdk.GetConsAddress(keep.PKs[1]))
+	require.False(t, found)
+}
+
+func TestRotateConsPubKeyTaken(t *testing.T) {
+	validatorAddr, validatorAddr2 := sdk.ValAddress(keep.Addrs[0]), sdk.ValAddress(keep.Addrs[1])
+	initBond := sdk.TokensFromTendermintPower(100)
+	ctx, _, _, keeper, _ := keep.CreateTestInput(t, false, 1000)
+
+	got := handleMsgCreateValidator(ctx, NewTestMsgCreateValidator(validatorAddr, keep.PKs[0], initBond), keeper)
+	require.True(t, got.IsOK(), "expected create-validator to be ok, got %v", got)
+	EndBlocker(ctx, keeper)
+
+	got = handleMsgRotateConsPubKey(ctx, NewMsgRotateConsPubKey(validatorAddr, keep.PKs[1]), keeper)
+	require.True(t, got.IsOK(), "expected rotation to be ok, got %v", got)
+
+	// cannot create a validator with a pubkey about to be used by another
+	// validator
+	got = handleMsgCreateValidator(ctx, NewTestMsgCreateValidator(validatorAddr2, keep.PKs[1], initBond), keeper)
+	require.False(t, got.IsOK(), "expected create-validator with a pending pubkey to fail")
+
+	// a validator taking the pubkey before the rotation is applied, e.g.
+	// through the genesis of an exported state, drops the rotation
+	validator2 := NewValidator(validatorAddr2, keep.PKs[1], Description{})
+	keeper.SetValidator(ctx, validator2)
+	keeper.SetValidatorByConsAddr(ctx, validator2)
+
+	EndBlocker(ctx, keeper)
+
+	_, found := keeper.GetConsPubKeyRotation(ctx, validatorAddr)
+	require.False(t, found)
+	validator, found := keeper.GetValidator(ctx, validatorAddr)
+	require.True(t, found)
+	require.Equal(t, keep.PKs[0], validator.ConsPubKey)
+
+	validator, found = keeper.GetValidatorByConsAddr(ctx, sdk.GetConsAddress(keep.PKs[0]))
+	require.True(t, found)
+	require.Equal(t, validatorAddr, validator.OperatorAddress)
+	validator, found = keeper.GetValidatorByConsAddr(ctx, sdk.GetConsAddress(keep.PKs[1]))
+	require.True(t, found)
+	require.Equal(t, validatorAddr2, validator.OperatorAddress)
+}
+
 func TestIncrementsMsgUnbond(t *testing.T) {
 	initPower := int64(1000)
 	initBond := sdk.TokensFromTendermintPower(initPower)
-	ctx, accMapper, keeper := keep.CreateTestInput(t, false, initPower)
+	ctx, _, bk, keeper, _ := keep.CreateTestInput(t, false, initPower)
 	params := setInstantUnbondPeriod(keeper, ctx)
 	denom := params.BondDenom
 
@@ -423,14 +580,14 @@ func TestIncrementsMsgUnbond(t *testing.T) {
 	require.True(t, got.IsOK(), "expected create-validator to be ok, got %v", got)
 
 	// initial balance
-	amt1 := accMapper.GetAccount(ctx, delegatorAddr).GetCoins().AmountOf(denom)
+	amt1 := bk.GetCoins(ctx, delegatorAddr).AmountOf(denom)
 
 	msgDelegate := NewTestMsgDelegate(delegatorAddr, validatorAddr, initBond)
 	got = handleMsgDelegate(ctx, msgDelegate, keeper)
 	require.True(t, got.IsOK(), "expected delegation to be ok, got %v", got)
 
 	// balance should have been subtracted after delegation
-	amt2 := accMapper.GetAccount(ctx, delegatorAddr).GetCoins().AmountOf(denom)
+	amt2 := bk.GetCoins(ctx, delegatorAddr).AmountOf(denom)
 	require.True(sdk.IntEq(t, amt1.Sub(initBond), amt2))
 
 	// apply TM updates
@@ -467,7 +624,7 @@ func TestIncrementsMsgUnbond(t *testing.T) {
 
 		gotBond := bond.Shares.RoundInt()
 		gotDelegatorShares := validator.DelegatorShares.RoundInt()
-		gotDelegatorAcc := accMapper.GetAccount(ctx, delegatorAddr).GetCoins().AmountOf(params.BondDenom)
+		gotDelegatorAcc := bk.GetCoins(ctx, delegatorAddr).AmountOf(params.BondDenom)
 
 		require.Equal(t, expBond, gotBond,
 			"i: %v\nexpBond: %v\ngotBond: %v\nvalidator: %v\nbond: %v\n",
@@ -506,10 +663,98 @@ func TestIncrementsMsgUnbond(t *testing.T) {
 		"got: %v\nmsgUnbond: %v\nshares: %s\nleftBonded: %s\n", got.Log, msgUndelegate, unbondAmt, leftBonded)
 }
 
+func TestCancelUnbondingDelegation(t *testing.T) {
+	initPower := int64(1000)
+	initBond := sdk.TokensFromTendermintPower(initPower)
+	ctx, _, _, keeper, supplyKeeper := keep.CreateTestInput(t, false, initPower)
+	denom := keeper.GetParams(ctx).BondDenom
+
+	// create validator, delegate
+	validatorAddr, delegatorAddr := sdk.ValAddress(keep.Addrs[0]), keep.Addrs[1]
+
+	msgCreateValidator := NewTestMsgCreateValidator(validatorAddr, keep.PKs[0], initBond)
+	got := handleMsgCreateValidator(ctx, msgCreateValidator, keeper)
+	require.True(t, got.IsOK(), "expected create-validator to be ok, got %v", got)
+
+	msgDelegate := NewTestMsgDelegate(delegatorAddr, validatorAddr, initBond)
+	got = handleMsgDelegate(ctx, msgDelegate, keeper)
+	require.True(t, got.IsOK(), "expected delegation to be ok, got %v", got)
+
+	keeper.ApplyAndReturnValidatorSetUpdates(ctx)
+
+	// unbond at two different heights and times
+	unbondAmt := sdk.NewCoin(denom, sdk.NewInt(100))
+	ctx = ctx.WithBlockHeight(1).WithBlockTime(time.Unix(1, 0))
+	got = handleMsgUndelegate(ctx, NewMsgUndelegate(delegatorAddr, validatorAddr, unbondAmt), keeper)
+	require.True(t, got.IsOK(), "expected undelegation to be ok, got %v", got)
+	completionTime1 := time.Unix(1, 0).Add(keeper.UnbondingTime(ctx))
+
+	ctx = ctx.WithBlockHeight(2).WithBlockTime(time.Unix(2, 0))
+	got = handleMsgUndelegate(ctx, NewMsgUndelegate(delegatorAddr, validatorAddr, unbondAmt), keeper)
+	require.True(t, got.IsOK(), "expected undelegation to be ok, got %v", got)
+
+	keeper.ApplyAndReturnValidatorSetUpdates(ctx)
+	notBondedTokens := supplyKeeper.GetModuleAccountCoins(ctx, types.NotBondedPoolName).AmountOf(denom)
+
+	// cannot cancel an unknown entry, more than the balance or another denom
+	cancelAmt := sdk.NewCoin(denom, sdk.NewInt(40))
+	got = handleMsgCancelUnbondingDelegation(ctx, NewMsgCancelUnbondingDelegation(delegatorAddr, validatorAddr, cancelAmt, 3), keeper)
+	require.False(t, got.IsOK(), "expected cancel of an unknown entry to fail")
+	got = handleMsgCancelUnbondingDelegation(ctx, NewMsgCancelUnbondingDelegation(delegatorAddr, validatorAddr, unbondAmt.Add(unbondAmt), 1), keeper)
+	require.False(t, got.IsOK(), "expected cancel beyond the balance to fail")
+	got = handleMsgCancelUnbondingDelegation(ctx, NewMsgCancelUnbondingDelegation(delegatorAddr, validatorAddr, sdk.NewCoin("foo", sdk.NewInt(40)), 1), keeper)
+	require.False(t, got.IsOK(), "expected cancel of another denom to fail")
+
+	// partially cancel the first entry
+	delegation, found := keeper.GetDelegation(ctx, delegatorAddr, validatorAddr)
+	require.True(t, found)
+	oldShares := delegation.Shares
+
+	got = handleMsgCancelUnbondingDelegation(ctx, NewMsgCancelUnbondingDelegation(delegatorAddr, validatorAddr, cancelAmt, 1), keeper)
+	require.True(t, got.IsOK(), "expected cancel to be ok, got %v", got)
+
+	ubd, found := keeper.GetUnbondingDelegation(ctx, delegatorAddr, validatorAddr)
+	require.True(t, found)
+	require.Len(t, ubd.Entries, 2)
+	require.Equal(t, sdk.NewInt(60), ubd.Entries[0].Balance)
+	require.Equal(t, sdk.NewInt(60), ubd.Entries[0].InitialBalance)
+	require.Len(t, keeper.GetUBDQueueTimeSlice(ctx, completionTime1), 1)
+
+	delegation, found = keeper.GetDelegation(ctx, delegatorAddr, validatorAddr)
+	require.True(t, found)
+	require.Equal(t, oldShares.Add(sdk.NewDec(40)), delegation.Shares)
+
+	// cancel the remaining balance of the first entry
+	got = handleMsgCancelUnbondingDelegation(ctx, NewMsgCancelUnbondingDelegation(delegatorAddr, validatorAddr, sdk.NewCoin(denom, sdk.NewInt(60)), 1), keeper)
+	require.True(t, got.IsOK(), "expected cancel to be ok, got %v", got)
+
+	ubd, found = keeper.GetUnbondingDelegation(ctx, delegatorAddr, validatorAddr)
+	require.True(t, found)
+	require.Len(t, ubd.Entries, 1)
+	require.Equal(t, int64(2), ubd.Entries[0].CreationHeight)
+	require.Empty(t, keeper.GetUBDQueueTimeSlice(ctx, completionTime1))
+
+	// cancel the whole second entry
+	got = handleMsgCancelUnbondingDelegation(ctx, NewMsgCancelUnbondingDelegation(delegatorAddr, validatorAddr, unbondAmt, 2), keeper)
+	require.True(t, got.IsOK(), "expected cancel to be ok, got %v", got)
+
+	_, found = keeper.GetUnbondingDelegation(ctx, delegatorAddr, validatorAddr)
+	require.False(t, found)
+
+	keeper.ApplyAndReturnValidatorSetUpdates(ctx)
+
+	// the tokens are moved back to the bonded pool
+	validator, found := keeper.GetValidator(ctx, validatorAddr)
+	require.True(t, found)
+	require.Equal(t, initBond.MulRaw(2), validator.BondedTokens())
+	require.Equal(t, initBond.MulRaw(2), supplyKeeper.GetModuleAccountCoins(ctx, types.BondedPoolName).AmountOf(denom))
+	require.Equal(t, notBondedTokens.Sub(unbondAmt.Amount.MulRaw(2)), supplyKeeper.GetModuleAccountCoins(ctx, types.NotBondedPoolName).AmountOf(denom))
+}
+
 func TestMultipleMsgCreateValidator(t *testing.T) {
 	initPower := int64(1000)
 	initTokens := sdk.TokensFromTendermintPower(initPower)
-	ctx, accMapper, keeper := keep.CreateTestInput(t, false, initPower)
+	ctx, _, bk, keeper, _ := keep.CreateTestInput(t, false, initPower)
 	params := setInstantUnbondPeriod(keeper, ctx)
 
 	validatorAddrs := []sdk.ValAddress{
@@ -537,7 +782,7 @@ func TestMultipleMsgCreateValidator(t *testing.T) {
 
 		val := validators[i]
 		balanceExpd := initTokens.Sub(valTokens)
-		balanceGot := accMapper.GetAccount(ctx, delegatorAddrs[i]).GetCoins().AmountOf(params.BondDenom)
+		balanceGot := bk.GetCoins(ctx, delegatorAddrs[i]).AmountOf(params.BondDenom)
 
 		require.Equal(t, i+1, len(validators), "expected %d validators got %d, validators: %v", i+1, len(validators), validators)
 		require.Equal(t, valTokens, val.DelegatorShares.RoundInt(), "expected %d shares, got %d", 10, val.DelegatorShares)
@@ -570,13 +815,13 @@ func TestMultipleMsgCreateValidator(t *testing.T) {
 		_, found = keeper.GetValidator(ctx, validatorAddr)
 		require.False(t, found)
 
-		gotBalance := accMapper.GetAccount(ctx, delegatorAddrs[i]).GetCoins().AmountOf(params.BondDenom)
+		gotBalance := bk.GetCoins(ctx, delegatorAddrs[i]).AmountOf(params.BondDenom)
 		require.Equal(t, initTokens, gotBalance, "expected account to have %d, got %d", initTokens, gotBalance)
 	}
 }
 
 func TestMultipleMsgDelegate(t *testing.T) {
-	ctx, _, keeper := keep.CreateTestInput(t, false, 1000)
+	ctx, _, _, keeper, _ := keep.CreateTestInput(t, false, 1000)
 	validatorAddr, delegatorAddrs := sdk.ValAddress(keep.Addrs[0]), keep.Addrs[1:]
 	_ = setInstantUnbondPeriod(keeper, ctx)
 
@@ -618,7 +863,7 @@ func TestMultipleMsgDelegate(t *testing.T) {
 }
 
 func TestJailValidator(t *testing.T) {
-	ctx, _, keeper := keep.CreateTestInput(t, false, 1000)
+	ctx, _, _, keeper, _ := keep.CreateTestInput(t, false, 1000)
 	validatorAddr, delegatorAddr := sdk.ValAddress(keep.Addrs[0]), keep.Addrs[1]
 	_ = setInstantUnbondPeriod(keeper, ctx)
 
@@ -664,7 +909,7 @@ func TestJailValidator(t *testing.T) {
 }
 
 func TestValidatorQueue(t *testing.T) {
-	ctx, _, keeper := keep.CreateTestInput(t, false, 1000)
+	ctx, _, _, keeper, _ := keep.CreateTestInput(t, false, 1000)
 	validatorAddr, delegatorAddr := sdk.ValAddress(keep.Addrs[0]), keep.Addrs[1]
 
 	// set the unbonding time
@@ -722,7 +967,7 @@ func TestValidatorQueue(t *testing.T) {
 }
 
 func TestUnbondingPeriod(t *testing.T) {
-	ctx, _, keeper := keep.CreateTestInput(t, false, 1000)
+	ctx, _, _, keeper, _ := keep.CreateTestInput(t, false, 1000)
 	validatorAddr := sdk.ValAddress(keep.Addrs[0])
 
 	// set the unbonding time
@@ -768,7 +1013,7 @@ func TestUnbondingPeriod(t *testing.T) {
 }
 
 func TestUnbondingFromUnbondingValidator(t *testing.T) {
-	ctx, _, keeper := keep.CreateTestInput(t, false, 1000)
+	ctx, _, _, keeper, _ := keep.CreateTestInput(t, false, 1000)
 	validatorAddr, delegatorAddr := sdk.ValAddress(keep.Addrs[0]), keep.Addrs[1]
 
 	// create the validator
@@ -809,7 +1054,7 @@ func TestUnbondingFromUnbondingValidator(t *testing.T) {
 }
 
 func TestRedelegationPeriod(t *testing.T) {
-	ctx, AccMapper, keeper := keep.CreateTestInput(t, false, 1000)
+	ctx, _, bk, keeper, _ := keep.CreateTestInput(t, false, 1000)
 	validatorAddr, validatorAddr2 := sdk.ValAddress(keep.Addrs[0]), sdk.ValAddress(keep.Addrs[1])
 	denom := keeper.GetParams(ctx).BondDenom
 
@@ -822,20 +1067,20 @@ func TestRedelegationPeriod(t *testing.T) {
 	msgCreateValidator := NewTestMsgCreateValidator(validatorAddr, keep.PKs[0], sdk.NewInt(10))
 
 	// initial balance
-	amt1 := AccMapper.GetAccount(ctx, sdk.AccAddress(validatorAddr)).GetCoins().AmountOf(denom)
+	amt1 := bk.GetCoins(ctx, sdk.AccAddress(validatorAddr)).AmountOf(denom)
 
 	got := handleMsgCreateValidator(ctx, msgCreateValidator, keeper)
 	require.True(t, got.IsOK(), "expected no error on runMsgCreateValidator")
 
 	// balance should have been subtracted after creation
-	amt2 := AccMapper.GetAccount(ctx, sdk.AccAddress(validatorAddr)).GetCoins().AmountOf(denom)
+	amt2 := bk.GetCoins(ctx, sdk.AccAddress(validatorAddr)).AmountOf(denom)
 	require.Equal(t, amt1.Sub(sdk.NewInt(10)).Int64(), amt2.Int64(), "expected coins to be subtracted")
 
 	msgCreateValidator = NewTestMsgCreateValidator(validatorAddr2, keep.PKs[1], sdk.NewInt(10))
 	got = handleMsgCreateValidator(ctx, msgCreateValidator, keeper)
 	require.True(t, got.IsOK(), "expected no error on runMsgCreateValidator")
 
-	bal1 := AccMapper.GetAccount(ctx, sdk.AccAddress(validatorAddr)).GetCoins()
+	bal1 := bk.GetCoins(ctx, sdk.AccAddress(validatorAddr))
 
 	// begin redelegate
 	redAmt := sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(10))
@@ -844,7 +1089,7 @@ func TestRedelegationPeriod(t *testing.T) {
 	require.True(t, got.IsOK(), "expected no error, %v", got)
 
 	// origin account should not lose tokens as with a regular delegation
-	bal2 := AccMapper.GetAccount(ctx, sdk.AccAddress(validatorAddr)).GetCoins()
+	bal2 := bk.GetCoins(ctx, sdk.AccAddress(validatorAddr))
 	require.Equal(t, bal1, bal2)
 
 	origHeader := ctx.BlockHeader()
@@ -868,7 +1113,7 @@ func TestRedelegationPeriod(t *testing.T) {
 }
 
 func TestTransitiveRedelegation(t *testing.T) {
-	ctx, _, keeper := keep.CreateTestInput(t, false, 1000)
+	ctx, _, _, keeper, _ := keep.CreateTestInput(t, false, 1000)
 	validatorAddr := sdk.ValAddress(keep.Addrs[0])
 	validatorAddr2 := sdk.ValAddress(keep.Addrs[1])
 	validatorAddr3 := sdk.ValAddress(keep.Addrs[2])
@@ -911,7 +1156,7 @@ func TestTransitiveRedelegation(t *testing.T) {
 }
 
 func TestMultipleRedelegationAtSameTime(t *testing.T) {
-	ctx, _, keeper := keep.CreateTestInput(t, false, 1000)
+	ctx, _, _, keeper, _ := keep.CreateTestInput(t, false, 1000)
 	valAddr := sdk.ValAddress(keep.Addrs[0])
 	valAddr2 := sdk.ValAddress(keep.Addrs[1])
 
@@ -963,7 +1208,7 @@ func TestMultipleRedelegationAtSameTime(t *testing.T) {
 }
 
 func TestMultipleRedelegationAtUniqueTimes(t *testing.T) {
-	ctx, _, keeper := keep.CreateTestInput(t, false, 1000)
+	ctx, _, _, keeper, _ := keep.CreateTestInput(t, false, 1000)
 	valAddr := sdk.ValAddress(keep.Addrs[0])
 	valAddr2 := sdk.ValAddress(keep.Addrs[1])
 
@@ -1017,7 +1262,7 @@ func TestMultipleRedelegationAtUniqueTimes(t *testing.T) {
 }
 
 func TestMultipleUnbondingDelegationAtSameTime(t *testing.T) {
-	ctx, _, keeper := keep.CreateTestInput(t, false, 1000)
+	ctx, _, _, keeper, _ := keep.CreateTestInput(t, false, 1000)
 	valAddr := sdk.ValAddress(keep.Addrs[0])
 
 	// set the unbonding time
@@ -1064,7 +1309,7 @@ func TestMultipleUnbondingDelegationAtSameTime(t *testing.T) {
 }
 
 func TestMultipleUnbondingDelegationAtUniqueTimes(t *testing.T) {
-	ctx, _, keeper := keep.CreateTestInput(t, false, 1000)
+	ctx, _, _, keeper, _ := keep.CreateTestInput(t, false, 1000)
 	valAddr := sdk.ValAddress(keep.Addrs[0])
 
 	// set the unbonding time
@@ -1118,7 +1363,7 @@ func TestMultipleUnbondingDelegationAtUniqueTimes(t *testing.T) {
 }
 
 func TestUnbondingWhenExcessValidators(t *testing.T) {
-	ctx, _, keeper := keep.CreateTestInput(t, false, 1000)
+	ctx, _, _, keeper, _ := keep.CreateTestInput(t, false, 1000)
 	validatorAddr1 := sdk.ValAddress(keep.Addrs[0])
 	validatorAddr2 := sdk.ValAddress(keep.Addrs[1])
 	validatorAddr3 := sdk.ValAddress(keep.Addrs[2])
@@ -1174,7 +1419,7 @@ func TestUnbondingWhenExcessValidators(t *testing.T) {
 }
 
 func TestBondUnbondRedelegateSlashTwice(t *testing.T) {
-	ctx, _, keeper := keep.CreateTestInput(t, false, 1000)
+	ctx, _, _, keeper, _ := keep.CreateTestInput(t, false, 1000)
 	valA, valB, del := sdk.ValAddress(keep.Addrs[0]), sdk.ValAddress(keep.Addrs[1]), keep.Addrs[2]
 	consAddr0 := sdk.ConsAddress(keep.PKs[0].Address())
 
@@ -1281,3 +1526,95 @@ func TestInvalidMsg(t *testing.T) {
 	require.False(t, res.IsOK())
 	require.True(t, strings.Contains(res.Log, "unrecognized staking message type"))
 }
+
+func TestTokenizeShares(t *testing.T) {
+	initPower := int64(1000)
+	initBond := sdk.TokensFromTendermintPower(initPower)
+	ctx, _, bk, keeper, supplyKeeper := keep.CreateTestInput(t, false, initPower)
+	denom := keeper.GetParams(ctx).BondDenom
+
+	// create validator, delegate
+	validatorAddr, delegatorAddr, holderAddr := sdk.ValAddress(keep.Addrs[0]), keep.Addrs[1], keep.Addrs[2]
+
+	msgCreateValidator := NewTestMsgCreateValidator(validatorAddr, keep.PKs[0], initBond)
+	got := handleMsgCreateValidator(ctx, msgCreateValidator, keeper)
+	require.True(t, got.IsOK(), "expected create-validator to be ok, got %v", got)
+
+	msgDelegate := NewTestMsgDelegate(delegatorAddr, validatorAddr, initBond)
+	got = handleMsgDelegate(ctx, msgDelegate, keeper)
+	require.True(t, got.IsOK(), "expected delegation to be ok, got %v", got)
+
+	keeper.ApplyAndReturnValidatorSetUpdates(ctx)
+	bondedTokens := supplyKeeper.GetModuleAccountCoins(ctx, types.BondedPoolName).AmountOf(denom)
+
+	// cannot tokenize more than the delegation or another denom
+	got = handleMsgTokenizeShares(ctx, NewMsgTokenizeShares(delegatorAddr, validatorAddr, sdk.NewCoin(denom, initBond.AddRaw(1))), keeper)
+	require.False(t, got.IsOK(), "expected tokenizing beyond the delegation to fail")
+	got = handleMsgTokenizeShares(ctx, NewMsgTokenizeShares(delegatorAddr, validatorAddr, sdk.NewCoin("foo", initBond)), keeper)
+	require.False(t, got.IsOK(), "expected tokenizing another denom to fail")
+
+	// tokenize half of the delegation
+	tokenizeAmt := initBond.QuoRaw(2)
+	got = handleMsgTokenizeShares(ctx, NewMsgTokenizeShares(delegatorAddr, validatorAddr, sdk.NewCoin(denom, tokenizeAmt)), keeper)
+	require.True(t, got.IsOK(), "expected tokenizing to be ok, got %v", got)
+
+	record, found := keeper.GetTokenizedSharesRecordByValidator(ctx, validatorAddr)
+	require.True(t, found)
+	require.Equal(t, uint64(1), record.ID)
+	shareDenom := record.GetShareTokenDenom()
+	require.NoError(t, sdk.ValidateDenom(shareDenom))
+	require.Equal(t, tokenizeAmt, bk.GetCoins(ctx, delegatorAddr).AmountOf(shareDenom))
+
+	delegation, found := keeper.GetDelegation(ctx, delegatorAddr, validatorAddr)
+	require.True(t, found)
+	require.Equal(t, sdk.NewDecFromInt(initBond.Sub(tokenizeAmt)), delegation.Shares)
+
+	poolAddr := supplyKeeper.GetModuleAddress(types.TokenizedSharesPoolName)
+	poolDelegation, found := keeper.GetDelegation(ctx, poolAddr, validatorAddr)
+	require.True(t, found)
+	require.Equal(t, sdk.NewDecFromInt(tokenizeAmt), poolDelegation.Shares)
+
+	// the tokens stay bonded to the validator
+	require.Equal(t, bondedTokens, supplyKeeper.GetModuleAccountCoins(ctx, types.BondedPoolName).AmountOf(denom))
+
+	// slash the validator by half
+	ctx = ctx.WithBlockHeight(1)
+	keeper.Slash(ctx, sdk.GetConsAddress(keep.PKs[0]), 1, initPower*2, sdk.NewDecWithPrec(5, 1))
+
+	// the holder of the share tokens redeems them for the slashed shares
+	shareTokens := sdk.NewCoin(shareDenom, tokenizeAmt)
+	got = handleMsgRedeemTokensForShares(ctx, NewMsgRedeemTokensForShares(holderAddr, shareTokens), keeper)
+	require.False(t, got.IsOK(), "expected redeeming without share tokens to fail")
+
+	require.NoError(t, bk.SendCoins(ctx, delegatorAddr, holderAddr, sdk.NewCoins(shareTokens)))
+
+	got = handleMsgRedeemTokensForShares(ctx, NewMsgRedeemTokensForShares(holderAddr, shareTokens), keeper)
+	require.True(t, got.IsOK(), "expected redeeming to be ok, got %v", got)
+
+	validator, found := keeper.GetValidator(ctx, validatorAddr)
+	require.True(t, found)
+	delegation, found = keeper.GetDelegation(ctx, holderAddr, validatorAddr)
+	require.True(t, found)
+	require.Equal(t, sdk.NewDecFromInt(tokenizeAmt), delegation.Shares)
+	require.Equal(t, tokenizeAmt.QuoRaw(2), validator.TokensFromShares(delegation.Shares).TruncateInt())
+
+	// all the share tokens are burnt and the pool delegation is removed
+	require.True(t, bk.GetCoins(ctx, holderAddr).AmountOf(shareDenom).IsZero())
+	require.True(t, supplyKeeper.GetSupply(ctx).Total.AmountOf(shareDenom).IsZero())
+	_, found = keeper.GetDelegation(ctx, poolAddr, validatorAddr)
+	require.False(t, found)
+
+	// the shares of another validator are tokenized into a denom of their own
+	validatorAddr2 := sdk.ValAddress(keep.Addrs[3])
+	got = handleMsgCreateValidator(ctx, NewTestMsgCreateValidator(validatorAddr2, keep.PKs[3], initBond), keeper)
+	require.True(t, got.IsOK(), "expected create-validator to be ok, got %v", got)
+	got = handleMsgTokenizeShares(ctx, NewMsgTokenizeShares(sdk.AccAddress(validatorAddr2), validatorAddr2, sdk.NewCoin(denom, tokenizeAmt)), keeper)
+	require.True(t, got.IsOK(), "expected tokenizing to be ok, got %v", got)
+
+	record2, found := keeper.GetTokenizedSharesRecordByValidator(ctx, validatorAddr2)
+	require.True(t, found)
+	require.Equal(t, uint64(2), record2.ID)
+	require.NotEqual(t, shareDenom, record2.GetShareTokenDenom())
+	require.Equal(t, tokenizeAmt, bk.GetCoins(ctx, sdk.AccAddress(validatorAddr2)).AmountOf(record2.GetShareTokenDenom()))
+	require.Equal(t, uint64(2), keeper.GetLastTokenizedSharesRecordID(ctx))
+}
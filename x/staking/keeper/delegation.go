@@ -232,6 +232,27 @@ func (k Keeper) InsertUBDQueue(ctx sdk.Context, ubd types.UnbondingDelegation,
 	}
 }
 
+// Remove an unbonding delegation from the appropriate timeslice in the unbonding
+// queue, removing the timeslice if it becomes empty
+func (k Keeper) RemoveFromUBDQueue(ctx sdk.Context, ubd types.UnbondingDelegation,
+	completionTime time.Time) {
+
+	timeSlice := k.GetUBDQueueTimeSlice(ctx, completionTime)
+	for i, dvPair := range timeSlice {
+		if dvPair.DelegatorAddress.Equals(ubd.DelegatorAddress) && dvPair.ValidatorAddress.Equals(ubd.ValidatorAddress) {
+			timeSlice = append(timeSlice[:i], timeSlice[i+1:]...)
+			break
+		}
+	}
+
+	if len(timeSlice) == 0 {
+		store := ctx.KVStore(k.storeKey)
+		store.Delete(types.GetUnbondingDelegationTimeKey(completionTime))
+	} else {
+		k.SetUBDQueueTimeSlice(ctx, completionTime, timeSlice)
+	}
+}
+
 // Returns all the unbonding queue timeslices from time 0 until endTime
 func (k Keeper) UBDQueueIterator(ctx sdk.Context, endTime time.Time) sdk.Iterator {
 	store := ctx.KVStore(k.storeKey)
@@ -445,7 +466,8 @@ func (k Keeper) DequeueAllMatureRedelegationQueue(ctx sdk.Context, currTime time
 }
 
 // Perform a delegation, set/update everything necessary within the store.
-func (k Keeper) Delegate(ctx sdk.Context, delAddr sdk.AccAddress, bondAmt sdk.Int,
+// tokenSrc indicates the bond status of the incoming funds.
+func (k Keeper) Delegate(ctx sdk.Context, delAddr sdk.AccAddress, bondAmt sdk.Int, tokenSrc sdk.BondStatus,
 	validator types.Validator, subtractAccount bool) (newShares sdk.Dec, err sdk.Error) {
 
 	// In some situations, the exchange rate becomes invalid, e.g. if
@@ -468,11 +490,45 @@ func (k Keeper) Delegate(ctx sdk.Context, delAddr sdk.AccAddress, bondAmt sdk.In
 		k.BeforeDelegationCreated(ctx, delAddr, validator.OperatorAddress)
 	}
 
+	// if subtractAccount is true then we are
+	// performing a delegation and not a redelegation, thus the source tokens are
+	// all non bonded
 	if subtractAccount {
-		_, err := k.bankKeeper.DelegateCoins(ctx, delegation.DelegatorAddress, sdk.Coins{sdk.NewCoin(k.GetParams(ctx).BondDenom, bondAmt)})
+		if tokenSrc == sdk.Bonded {
+			panic("delegation token source cannot be bonded")
+		}
+
+		var sendName string
+		switch {
+		case validator.IsBonded():
+			sendName = types.BondedPoolName
+		case validator.IsUnbonding(), validator.IsUnbonded():
+			sendName = types.NotBondedPoolName
+		default:
+			panic("invalid validator status")
+		}
+
+		coins := sdk.NewCoins(sdk.NewCoin(k.BondDenom(ctx), bondAmt))
+		err := k.supplyKeeper.DelegateCoinsFromAccountToModule(ctx, delegation.DelegatorAddress, sendName, coins)
 		if err != nil {
 			return sdk.Dec{}, err
 		}
+	} else {
+		// potentially transfer tokens between pools, if
+		switch {
+		case tokenSrc == sdk.Bonded && validator.IsBonded():
+			// do nothing
+		case (tokenSrc == sdk.Unbonded || tokenSrc == sdk.Unbonding) && !validator.IsBonded():
+			// do nothing
+		case (tokenSrc == sdk.Unbonded || tokenSrc == sdk.Unbonding) && validator.IsBonded():
+			// transfer pools
+			k.notBondedTokensToBonded(ctx, bondAmt)
+		case tokenSrc == sdk.Bonded && !validator.IsBonded():
+			// transfer pools
+			k.bondedTokensToNotBonded(ctx, bondAmt)
+		default:
+			panic("unknown token source bond status")
+		}
 	}
 
 	validator, newShares = k.AddValidatorTokensAndShares(ctx, validator, bondAmt)
@@ -583,13 +639,23 @@ func (k Keeper) Undelegate(
 	ctx sdk.Context, delAddr sdk.AccAddress, valAddr sdk.ValAddress, sharesAmount sdk.Dec,
 ) (time.Time, sdk.Error) {
 
+	validator, found := k.GetValidator(ctx, valAddr)
+	if !found {
+		return time.Time{}, types.ErrNoValidatorFound(k.Codespace())
+	}
+
+	if k.HasMaxUnbondingDelegationEntries(ctx, delAddr, valAddr) {
+		return time.Time{}, types.ErrMaxUnbondingDelegationEntries(k.Codespace())
+	}
+
 	returnAmount, err := k.unbond(ctx, delAddr, valAddr, sharesAmount)
 	if err != nil {
 		return time.Time{}, err
 	}
 
-	if k.HasMaxUnbondingDelegationEntries(ctx, delAddr, valAddr) {
-		return time.Time{}, types.ErrMaxUnbondingDelegationEntries(k.Codespace())
+	// transfer the validator tokens to the not bonded pool
+	if validator.IsBonded() {
+		k.bondedTokensToNotBonded(ctx, returnAmount)
 	}
 
 	completionTime := ctx.BlockHeader().Time.Add(k.UnbondingTime(ctx))
@@ -620,7 +686,8 @@ func (k Keeper) CompleteUnbonding(ctx sdk.Context, delAddr sdk.AccAddress,
 
 			// track undelegation only when remaining or truncated shares are non-zero
 			if !entry.Balance.IsZero() {
-				_, err := k.bankKeeper.UndelegateCoins(ctx, ubd.DelegatorAddress, sdk.Coins{sdk.NewCoin(k.GetParams(ctx).BondDenom, entry.Balance)})
+				amt := sdk.NewCoins(sdk.NewCoin(k.GetParams(ctx).BondDenom, entry.Balance))
+				err := k.supplyKeeper.UndelegateCoinsFromModuleToAccount(ctx, types.NotBondedPoolName, ubd.DelegatorAddress, amt)
 				if err != nil {
 					return err
 				}
@@ -638,6 +705,69 @@ func (k Keeper) CompleteUnbonding(ctx sdk.Context, delAddr sdk.AccAddress,
 	return nil
 }
 
+// CancelUnbondingDelegation cancels an amount of the immature unbonding
+// delegation entry created at the given height and delegates it back to the
+// validator. The entry is removed from the unbonding delegation, and from the
+// unbonding queue, once its whole balance is cancelled.
+func (k Keeper) CancelUnbondingDelegation(ctx sdk.Context, delAddr sdk.AccAddress,
+	valAddr sdk.ValAddress, creationHeight int64, amount sdk.Int) (newShares sdk.Dec, err sdk.Error) {
+
+	validator, found := k.GetValidator(ctx, valAddr)
+	if !found {
+		return sdk.ZeroDec(), types.ErrNoValidatorFound(k.Codespace())
+	}
+
+	ubd, found := k.GetUnbondingDelegation(ctx, delAddr, valAddr)
+	if !found {
+		return sdk.ZeroDec(), types.ErrNoUnbondingDelegation(k.Codespace())
+	}
+
+	// find the first immature entry created at the given height, mature
+	// entries are completed by the end-blocker
+	ctxTime := ctx.BlockHeader().Time
+	index := -1
+	for i, entry := range ubd.Entries {
+		if entry.CreationHeight == creationHeight && !entry.IsMature(ctxTime) {
+			index = i
+			break
+		}
+	}
+	if index < 0 {
+		return sdk.ZeroDec(), types.ErrNoUnbondingDelegationEntry(k.Codespace())
+	}
+
+	entry := ubd.Entries[index]
+	if amount.GT(entry.Balance) {
+		return sdk.ZeroDec(), types.ErrNotEnoughUnbondingBalance(k.Codespace(), entry.Balance.String())
+	}
+
+	// the unbonding tokens are held by the not bonded pool
+	newShares, err = k.Delegate(ctx, delAddr, amount, sdk.Unbonding, validator, false)
+	if err != nil {
+		return sdk.ZeroDec(), err
+	}
+
+	if amount.Equal(entry.Balance) {
+		ubd.RemoveEntry(int64(index))
+		k.RemoveFromUBDQueue(ctx, ubd, entry.CompletionTime)
+	} else {
+		// reduce the initial balance as well so that slashing the entry
+		// applies to the remaining balance only
+		entry.Balance = entry.Balance.Sub(amount)
+		entry.InitialBalance = entry.InitialBalance.Sub(amount)
+		ubd.Entries[index] = entry
+	}
+
+	// set the unbonding delegation or remove it if there are no more entries
+	if len(ubd.Entries) == 0 {
+		k.RemoveUnbondingDelegation(ctx, ubd)
+	} else {
+		k.SetUnbondingDelegation(ctx, ubd)
+	}
+
+	return newShares, nil
+}
+
 // begin unbonding / redelegation; create a redelegation record
 func (k Keeper) BeginRedelegation(ctx sdk.Context, delAddr sdk.AccAddress,
 	valSrcAddr, valDstAddr sdk.ValAddress, sharesAmount sdk.Dec) (
@@ -656,6 +786,11 @@ func (k Keeper) BeginRedelegation(ctx sdk.Context, delAddr sdk.AccAddress,
 		return time.Time{}, types.ErrMaxRedelegationEntries(k.Codespace())
 	}
 
+	srcValidator, found := k.GetValidator(ctx, valSrcAddr)
+	if !found {
+		return time.Time{}, types.ErrNoValidatorFound(k.Codespace())
+	}
+
 	returnAmount, err := k.unbond(ctx, delAddr, valSrcAddr, sharesAmount)
 	if err != nil {
 		return time.Time{}, err
@@ -669,7 +804,7 @@ func (k Keeper) BeginRedelegation(ctx sdk.Context, delAddr sdk.AccAddress,
 		return time.Time{}, types.ErrBadRedelegationDst(k.Codespace())
 	}
 
-	sharesCreated, err := k.Delegate(ctx, delAddr, returnAmount, dstValidator, false)
+	sharesCreated, err := k.Delegate(ctx, delAddr, returnAmount, srcValidator.GetStatus(), dstValidator, false)
 	if err != nil {
 		return time.Time{}, err
 	}
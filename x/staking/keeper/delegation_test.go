@@ -13,18 +13,16 @@ import (
 
 // tests GetDelegation, GetDelegatorDelegations, SetDelegation, RemoveDelegation, GetDelegatorDelegations
 func TestDelegation(t *testing.T) {
-	ctx, _, keeper := CreateTestInput(t, false, 10)
-	pool := keeper.GetPool(ctx)
+	ctx, _, _, keeper, _ := CreateTestInput(t, false, 10)
 
 	//construct the validators
 	amts := []sdk.Int{sdk.NewInt(9), sdk.NewInt(8), sdk.NewInt(7)}
 	var validators [3]types.Validator
 	for i, amt := range amts {
 		validators[i] = types.NewValidator(addrVals[i], PKs[i], types.Description{})
-		validators[i], pool, _ = validators[i].AddTokensFromDel(pool, amt)
+		validators[i], _ = validators[i].AddTokensFromDel(amt)
 	}
 
-	keeper.SetPool(ctx, pool)
 	validators[0] = TestingUpdateValidator(keeper, ctx, validators[0], true)
 	validators[1] = TestingUpdateValidator(keeper, ctx, validators[1], true)
 	validators[2] = TestingUpdateValidator(keeper, ctx, validators[2], true)
@@ -130,7 +128,7 @@ func TestDelegation(t *testing.T) {
 
 // tests Get/Set/Remove UnbondingDelegation
 func TestUnbondingDelegation(t *testing.T) {
-	ctx, _, keeper := CreateTestInput(t, false, 0)
+	ctx, _, _, keeper, _ := CreateTestInput(t, false, 0)
 
 	ubd := types.NewUnbondingDelegation(addrDels[0], addrVals[0], 0,
 		time.Unix(0, 0), sdk.NewInt(5))
@@ -169,20 +167,18 @@ func TestUnbondingDelegation(t *testing.T) {
 }
 
 func TestUnbondDelegation(t *testing.T) {
-	ctx, _, keeper := CreateTestInput(t, false, 0)
-	pool := keeper.GetPool(ctx)
+	ctx, _, _, keeper, _ := CreateTestInput(t, false, 0)
 	startTokens := sdk.TokensFromTendermintPower(10)
-	pool.NotBondedTokens = startTokens
+
+	require.NoError(t, keeper.supplyKeeper.SetModuleAccountCoins(ctx, types.NotBondedPoolName, sdk.NewCoins(sdk.NewCoin(keeper.BondDenom(ctx), startTokens))))
 
 	//create a validator and a delegator to that validator
 	validator := types.NewValidator(addrVals[0], PKs[0], types.Description{})
-	validator, pool, issuedShares := validator.AddTokensFromDel(pool, startTokens)
+	validator, issuedShares := validator.AddTokensFromDel(startTokens)
 	require.Equal(t, startTokens, issuedShares.RoundInt())
-	keeper.SetPool(ctx, pool)
 	validator = TestingUpdateValidator(keeper, ctx, validator, true)
 
-	pool = keeper.GetPool(ctx)
-	require.Equal(t, startTokens, pool.BondedTokens)
+	require.Equal(t, startTokens, keeper.supplyKeeper.GetModuleAccountCoins(ctx, types.BondedPoolName).AmountOf(keeper.BondDenom(ctx)))
 	require.Equal(t, startTokens, validator.BondedTokens())
 
 	delegation := types.NewDelegation(addrDels[0], addrVals[0], issuedShares)
@@ -197,30 +193,25 @@ func TestUnbondDelegation(t *testing.T) {
 	require.True(t, found)
 	validator, found = keeper.GetValidator(ctx, addrVals[0])
 	require.True(t, found)
-	pool = keeper.GetPool(ctx)
 
 	remainingTokens := startTokens.Sub(bondTokens)
 	require.Equal(t, remainingTokens, delegation.Shares.RoundInt())
 	require.Equal(t, remainingTokens, validator.BondedTokens())
-	require.Equal(t, bondTokens, pool.NotBondedTokens, "%v", pool)
-	require.Equal(t, remainingTokens, pool.BondedTokens)
 }
 
 func TestUnbondingDelegationsMaxEntries(t *testing.T) {
-	ctx, _, keeper := CreateTestInput(t, false, 1)
-	pool := keeper.GetPool(ctx)
+	ctx, _, _, keeper, _ := CreateTestInput(t, false, 1)
 	startTokens := sdk.TokensFromTendermintPower(10)
-	pool.NotBondedTokens = startTokens
+
+	require.NoError(t, keeper.supplyKeeper.SetModuleAccountCoins(ctx, types.NotBondedPoolName, sdk.NewCoins(sdk.NewCoin(keeper.BondDenom(ctx), startTokens))))
 
 	// create a validator and a delegator to that validator
 	validator := types.NewValidator(addrVals[0], PKs[0], types.Description{})
-	validator, pool, issuedShares := validator.AddTokensFromDel(pool, startTokens)
+	validator, issuedShares := validator.AddTokensFromDel(startTokens)
 	require.Equal(t, startTokens, issuedShares.RoundInt())
-	keeper.SetPool(ctx, pool)
 	validator = TestingUpdateValidator(keeper, ctx, validator, true)
 
-	pool = keeper.GetPool(ctx)
-	require.Equal(t, startTokens, pool.BondedTokens)
+	require.Equal(t, startTokens, keeper.supplyKeeper.GetModuleAccountCoins(ctx, types.BondedPoolName).AmountOf(keeper.BondDenom(ctx)))
 	require.Equal(t, startTokens, validator.BondedTokens())
 
 	delegation := types.NewDelegation(addrDels[0], addrVals[0], issuedShares)
@@ -254,33 +245,33 @@ func TestUnbondingDelegationsMaxEntries(t *testing.T) {
 // shift it from the bonded to unbonding state and jailed
 func TestUndelegateSelfDelegationBelowMinSelfDelegation(t *testing.T) {
 
-	ctx, _, keeper := CreateTestInput(t, false, 0)
-	pool := keeper.GetPool(ctx)
+	ctx, _, _, keeper, _ := CreateTestInput(t, false, 0)
 	startTokens := sdk.TokensFromTendermintPower(20)
-	pool.NotBondedTokens = startTokens
+
+	require.NoError(t, keeper.supplyKeeper.SetModuleAccountCoins(ctx, types.NotBondedPoolName, sdk.NewCoins(sdk.NewCoin(keeper.BondDenom(ctx), startTokens))))
 
 	//create a validator with a self-delegation
 	validator := types.NewValidator(addrVals[0], PKs[0], types.Description{})
 
 	valTokens := sdk.TokensFromTendermintPower(10)
 	validator.MinSelfDelegation = valTokens
-	validator, pool, issuedShares := validator.AddTokensFromDel(pool, valTokens)
+	validator, issuedShares := validator.AddTokensFromDel(valTokens)
 	require.Equal(t, valTokens, issuedShares.RoundInt())
 
-	keeper.SetPool(ctx, pool)
 	validator = TestingUpdateValidator(keeper, ctx, validator, true)
-	pool = keeper.GetPool(ctx)
 	selfDelegation := types.NewDelegation(sdk.AccAddress(addrVals[0].Bytes()), addrVals[0], issuedShares)
 	keeper.SetDelegation(ctx, selfDelegation)
 
 	// create a second delegation to this validator
 	keeper.DeleteValidatorByPowerIndex(ctx, validator)
 	delTokens := sdk.TokensFromTendermintPower(10)
-	validator, pool, issuedShares = validator.AddTokensFromDel(pool, delTokens)
+	validator, issuedShares = validator.AddTokensFromDel(delTokens)
 	require.Equal(t, delTokens, issuedShares.RoundInt())
-	keeper.SetPool(ctx, pool)
+
+	// the validator is already bonded, so the new tokens go straight to the bonded pool
+	require.NoError(t, keeper.supplyKeeper.SetModuleAccountCoins(ctx, types.BondedPoolName, keeper.supplyKeeper.GetModuleAccountCoins(ctx, types.BondedPoolName).Add(sdk.NewCoins(sdk.NewCoin(keeper.BondDenom(ctx), delTokens)))))
+
 	validator = TestingUpdateValidator(keeper, ctx, validator, true)
-	pool = keeper.GetPool(ctx)
 	delegation := types.NewDelegation(addrDels[0], addrVals[0], issuedShares)
 	keeper.SetDelegation(ctx, delegation)
 
@@ -300,31 +291,31 @@ func TestUndelegateSelfDelegationBelowMinSelfDelegation(t *testing.T) {
 }
 
 func TestUndelegateFromUnbondingValidator(t *testing.T) {
-	ctx, _, keeper := CreateTestInput(t, false, 0)
-	pool := keeper.GetPool(ctx)
+	ctx, _, _, keeper, _ := CreateTestInput(t, false, 0)
 	startTokens := sdk.TokensFromTendermintPower(20)
-	pool.NotBondedTokens = startTokens
+
+	require.NoError(t, keeper.supplyKeeper.SetModuleAccountCoins(ctx, types.NotBondedPoolName, sdk.NewCoins(sdk.NewCoin(keeper.BondDenom(ctx), startTokens))))
 
 	//create a validator with a self-delegation
 	validator := types.NewValidator(addrVals[0], PKs[0], types.Description{})
 
 	valTokens := sdk.TokensFromTendermintPower(10)
-	validator, pool, issuedShares := validator.AddTokensFromDel(pool, valTokens)
+	validator, issuedShares := validator.AddTokensFromDel(valTokens)
 	require.Equal(t, valTokens, issuedShares.RoundInt())
-	keeper.SetPool(ctx, pool)
 	validator = TestingUpdateValidator(keeper, ctx, validator, true)
-	pool = keeper.GetPool(ctx)
 	selfDelegation := types.NewDelegation(sdk.AccAddress(addrVals[0].Bytes()), addrVals[0], issuedShares)
 	keeper.SetDelegation(ctx, selfDelegation)
 
 	// create a second delegation to this validator
 	keeper.DeleteValidatorByPowerIndex(ctx, validator)
 	delTokens := sdk.TokensFromTendermintPower(10)
-	validator, pool, issuedShares = validator.AddTokensFromDel(pool, delTokens)
+	validator, issuedShares = validator.AddTokensFromDel(delTokens)
 	require.Equal(t, delTokens, issuedShares.RoundInt())
-	keeper.SetPool(ctx, pool)
+
+	// the validator is already bonded, so the new tokens go straight to the bonded pool
+	require.NoError(t, keeper.supplyKeeper.SetModuleAccountCoins(ctx, types.BondedPoolName, keeper.supplyKeeper.GetModuleAccountCoins(ctx, types.BondedPoolName).Add(sdk.NewCoins(sdk.NewCoin(keeper.BondDenom(ctx), delTokens)))))
+
 	validator = TestingUpdateValidator(keeper, ctx, validator, true)
-	pool = keeper.GetPool(ctx)
 	delegation := types.NewDelegation(addrDels[0], addrVals[0], issuedShares)
 	keeper.SetDelegation(ctx, delegation)
 
@@ -369,20 +360,18 @@ func TestUndelegateFromUnbondingValidator(t *testing.T) {
 }
 
 func TestUndelegateFromUnbondedValidator(t *testing.T) {
-	ctx, _, keeper := CreateTestInput(t, false, 1)
-	pool := keeper.GetPool(ctx)
+	ctx, _, _, keeper, _ := CreateTestInput(t, false, 1)
 	startTokens := sdk.TokensFromTendermintPower(20)
-	pool.NotBondedTokens = startTokens
+
+	require.NoError(t, keeper.supplyKeeper.SetModuleAccountCoins(ctx, types.NotBondedPoolName, sdk.NewCoins(sdk.NewCoin(keeper.BondDenom(ctx), startTokens))))
 
 	// create a validator with a self-delegation
 	validator := types.NewValidator(addrVals[0], PKs[0], types.Description{})
 
 	valTokens := sdk.TokensFromTendermintPower(10)
-	validator, pool, issuedShares := validator.AddTokensFromDel(pool, valTokens)
+	validator, issuedShares := validator.AddTokensFromDel(valTokens)
 	require.Equal(t, valTokens, issuedShares.RoundInt())
-	keeper.SetPool(ctx, pool)
 	validator = TestingUpdateValidator(keeper, ctx, validator, true)
-	pool = keeper.GetPool(ctx)
 	val0AccAddr := sdk.AccAddress(addrVals[0].Bytes())
 	selfDelegation := types.NewDelegation(val0AccAddr, addrVals[0], issuedShares)
 	keeper.SetDelegation(ctx, selfDelegation)
@@ -390,11 +379,13 @@ func TestUndelegateFromUnbondedValidator(t *testing.T) {
 	// create a second delegation to this validator
 	keeper.DeleteValidatorByPowerIndex(ctx, validator)
 	delTokens := sdk.TokensFromTendermintPower(10)
-	validator, pool, issuedShares = validator.AddTokensFromDel(pool, delTokens)
+	validator, issuedShares = validator.AddTokensFromDel(delTokens)
 	require.Equal(t, delTokens, issuedShares.RoundInt())
-	keeper.SetPool(ctx, pool)
+
+	// the validator is already bonded, so the new tokens go straight to the bonded pool
+	require.NoError(t, keeper.supplyKeeper.SetModuleAccountCoins(ctx, types.BondedPoolName, keeper.supplyKeeper.GetModuleAccountCoins(ctx, types.BondedPoolName).Add(sdk.NewCoins(sdk.NewCoin(keeper.BondDenom(ctx), delTokens)))))
+
 	validator = TestingUpdateValidator(keeper, ctx, validator, true)
-	pool = keeper.GetPool(ctx)
 	delegation := types.NewDelegation(addrDels[0], addrVals[0], issuedShares)
 	keeper.SetDelegation(ctx, delegation)
 
@@ -440,20 +431,18 @@ func TestUndelegateFromUnbondedValidator(t *testing.T) {
 }
 
 func TestUnbondingAllDelegationFromValidator(t *testing.T) {
-	ctx, _, keeper := CreateTestInput(t, false, 0)
-	pool := keeper.GetPool(ctx)
+	ctx, _, _, keeper, _ := CreateTestInput(t, false, 0)
 	startTokens := sdk.TokensFromTendermintPower(20)
-	pool.NotBondedTokens = startTokens
+
+	require.NoError(t, keeper.supplyKeeper.SetModuleAccountCoins(ctx, types.NotBondedPoolName, sdk.NewCoins(sdk.NewCoin(keeper.BondDenom(ctx), startTokens))))
 
 	//create a validator with a self-delegation
 	validator := types.NewValidator(addrVals[0], PKs[0], types.Description{})
 
 	valTokens := sdk.TokensFromTendermintPower(10)
-	validator, pool, issuedShares := validator.AddTokensFromDel(pool, valTokens)
+	validator, issuedShares := validator.AddTokensFromDel(valTokens)
 	require.Equal(t, valTokens, issuedShares.RoundInt())
-	keeper.SetPool(ctx, pool)
 	validator = TestingUpdateValidator(keeper, ctx, validator, true)
-	pool = keeper.GetPool(ctx)
 	val0AccAddr := sdk.AccAddress(addrVals[0].Bytes())
 	selfDelegation := types.NewDelegation(val0AccAddr, addrVals[0], issuedShares)
 	keeper.SetDelegation(ctx, selfDelegation)
@@ -461,11 +450,13 @@ func TestUnbondingAllDelegationFromValidator(t *testing.T) {
 	// create a second delegation to this validator
 	keeper.DeleteValidatorByPowerIndex(ctx, validator)
 	delTokens := sdk.TokensFromTendermintPower(10)
-	validator, pool, issuedShares = validator.AddTokensFromDel(pool, delTokens)
+	validator, issuedShares = validator.AddTokensFromDel(delTokens)
 	require.Equal(t, delTokens, issuedShares.RoundInt())
-	keeper.SetPool(ctx, pool)
+
+	// the validator is already bonded, so the new tokens go straight to the bonded pool
+	require.NoError(t, keeper.supplyKeeper.SetModuleAccountCoins(ctx, types.BondedPoolName, keeper.supplyKeeper.GetModuleAccountCoins(ctx, types.BondedPoolName).Add(sdk.NewCoins(sdk.NewCoin(keeper.BondDenom(ctx), delTokens)))))
+
 	validator = TestingUpdateValidator(keeper, ctx, validator, true)
-	pool = keeper.GetPool(ctx)
 	delegation := types.NewDelegation(addrDels[0], addrVals[0], issuedShares)
 	keeper.SetDelegation(ctx, delegation)
 
@@ -500,7 +491,7 @@ func TestUnbondingAllDelegationFromValidator(t *testing.T) {
 
 // Make sure that that the retrieving the delegations doesn't affect the state
 func TestGetRedelegationsFromValidator(t *testing.T) {
-	ctx, _, keeper := CreateTestInput(t, false, 0)
+	ctx, _, _, keeper, _ := CreateTestInput(t, false, 0)
 
 	rd := types.NewRedelegation(addrDels[0], addrVals[0], addrVals[1], 0,
 		time.Unix(0, 0), sdk.NewInt(5),
@@ -524,7 +515,7 @@ func TestGetRedelegationsFromValidator(t *testing.T) {
 
 // tests Get/Set/Remove/Has UnbondingDelegation
 func TestRedelegation(t *testing.T) {
-	ctx, _, keeper := CreateTestInput(t, false, 0)
+	ctx, _, _, keeper, _ := CreateTestInput(t, false, 0)
 
 	rd := types.NewRedelegation(addrDels[0], addrVals[0], addrVals[1], 0,
 		time.Unix(0, 0), sdk.NewInt(5),
@@ -584,19 +575,17 @@ func TestRedelegation(t *testing.T) {
 }
 
 func TestRedelegateToSameValidator(t *testing.T) {
-	ctx, _, keeper := CreateTestInput(t, false, 0)
-	pool := keeper.GetPool(ctx)
+	ctx, _, _, keeper, _ := CreateTestInput(t, false, 0)
 	startTokens := sdk.TokensFromTendermintPower(30)
-	pool.NotBondedTokens = startTokens
+
+	require.NoError(t, keeper.supplyKeeper.SetModuleAccountCoins(ctx, types.NotBondedPoolName, sdk.NewCoins(sdk.NewCoin(keeper.BondDenom(ctx), startTokens))))
 
 	// create a validator with a self-delegation
 	validator := types.NewValidator(addrVals[0], PKs[0], types.Description{})
 	valTokens := sdk.TokensFromTendermintPower(10)
-	validator, pool, issuedShares := validator.AddTokensFromDel(pool, valTokens)
+	validator, issuedShares := validator.AddTokensFromDel(valTokens)
 	require.Equal(t, valTokens, issuedShares.RoundInt())
-	keeper.SetPool(ctx, pool)
 	validator = TestingUpdateValidator(keeper, ctx, validator, true)
-	pool = keeper.GetPool(ctx)
 	val0AccAddr := sdk.AccAddress(addrVals[0].Bytes())
 	selfDelegation := types.NewDelegation(val0AccAddr, addrVals[0], issuedShares)
 	keeper.SetDelegation(ctx, selfDelegation)
@@ -607,29 +596,25 @@ func TestRedelegateToSameValidator(t *testing.T) {
 }
 
 func TestRedelegationMaxEntries(t *testing.T) {
-	ctx, _, keeper := CreateTestInput(t, false, 0)
-	pool := keeper.GetPool(ctx)
+	ctx, _, _, keeper, _ := CreateTestInput(t, false, 0)
 	startTokens := sdk.TokensFromTendermintPower(20)
-	pool.NotBondedTokens = startTokens
+
+	require.NoError(t, keeper.supplyKeeper.SetModuleAccountCoins(ctx, types.NotBondedPoolName, sdk.NewCoins(sdk.NewCoin(keeper.BondDenom(ctx), startTokens))))
 
 	// create a validator with a self-delegation
 	validator := types.NewValidator(addrVals[0], PKs[0], types.Description{})
 	valTokens := sdk.TokensFromTendermintPower(10)
-	validator, pool, issuedShares := validator.AddTokensFromDel(pool, valTokens)
+	validator, issuedShares := validator.AddTokensFromDel(valTokens)
 	require.Equal(t, valTokens, issuedShares.RoundInt())
-	keeper.SetPool(ctx, pool)
 	validator = TestingUpdateValidator(keeper, ctx, validator, true)
-	pool = keeper.GetPool(ctx)
 	val0AccAddr := sdk.AccAddress(addrVals[0].Bytes())
 	selfDelegation := types.NewDelegation(val0AccAddr, addrVals[0], issuedShares)
 	keeper.SetDelegation(ctx, selfDelegation)
 
 	// create a second validator
 	validator2 := types.NewValidator(addrVals[1], PKs[1], types.Description{})
-	validator2, pool, issuedShares = validator2.AddTokensFromDel(pool, valTokens)
+	validator2, issuedShares = validator2.AddTokensFromDel(valTokens)
 	require.Equal(t, valTokens, issuedShares.RoundInt())
-	pool.BondedTokens = pool.BondedTokens.Add(valTokens)
-	keeper.SetPool(ctx, pool)
 	validator2 = TestingUpdateValidator(keeper, ctx, validator2, true)
 	require.Equal(t, sdk.Bonded, validator2.Status)
 
@@ -658,39 +643,33 @@ func TestRedelegationMaxEntries(t *testing.T) {
 }
 
 func TestRedelegateSelfDelegation(t *testing.T) {
-	ctx, _, keeper := CreateTestInput(t, false, 0)
-	pool := keeper.GetPool(ctx)
+	ctx, _, _, keeper, _ := CreateTestInput(t, false, 0)
 	startTokens := sdk.TokensFromTendermintPower(30)
-	pool.NotBondedTokens = startTokens
+
+	require.NoError(t, keeper.supplyKeeper.SetModuleAccountCoins(ctx, types.NotBondedPoolName, sdk.NewCoins(sdk.NewCoin(keeper.BondDenom(ctx), startTokens))))
 
 	//create a validator with a self-delegation
 	validator := types.NewValidator(addrVals[0], PKs[0], types.Description{})
 	valTokens := sdk.TokensFromTendermintPower(10)
-	validator, pool, issuedShares := validator.AddTokensFromDel(pool, valTokens)
+	validator, issuedShares := validator.AddTokensFromDel(valTokens)
 	require.Equal(t, valTokens, issuedShares.RoundInt())
-	keeper.SetPool(ctx, pool)
 	validator = TestingUpdateValidator(keeper, ctx, validator, true)
-	pool = keeper.GetPool(ctx)
 	val0AccAddr := sdk.AccAddress(addrVals[0].Bytes())
 	selfDelegation := types.NewDelegation(val0AccAddr, addrVals[0], issuedShares)
 	keeper.SetDelegation(ctx, selfDelegation)
 
 	// create a second validator
 	validator2 := types.NewValidator(addrVals[1], PKs[1], types.Description{})
-	validator2, pool, issuedShares = validator2.AddTokensFromDel(pool, valTokens)
+	validator2, issuedShares = validator2.AddTokensFromDel(valTokens)
 	require.Equal(t, valTokens, issuedShares.RoundInt())
-	pool.BondedTokens = pool.BondedTokens.Add(valTokens)
-	keeper.SetPool(ctx, pool)
 	validator2 = TestingUpdateValidator(keeper, ctx, validator2, true)
 	require.Equal(t, sdk.Bonded, validator2.Status)
 
 	// create a second delegation to validator 1
 	delTokens := sdk.TokensFromTendermintPower(10)
-	validator, pool, issuedShares = validator.AddTokensFromDel(pool, delTokens)
+	validator, issuedShares = validator.AddTokensFromDel(delTokens)
 	require.Equal(t, delTokens, issuedShares.RoundInt())
-	keeper.SetPool(ctx, pool)
 	validator = TestingUpdateValidator(keeper, ctx, validator, true)
-	pool = keeper.GetPool(ctx)
 
 	delegation := types.NewDelegation(addrDels[0], addrVals[0], issuedShares)
 	keeper.SetDelegation(ctx, delegation)
@@ -709,20 +688,18 @@ func TestRedelegateSelfDelegation(t *testing.T) {
 }
 
 func TestRedelegateFromUnbondingValidator(t *testing.T) {
-	ctx, _, keeper := CreateTestInput(t, false, 0)
-	pool := keeper.GetPool(ctx)
+	ctx, _, _, keeper, _ := CreateTestInput(t, false, 0)
 	startTokens := sdk.TokensFromTendermintPower(30)
-	pool.NotBondedTokens = startTokens
+
+	require.NoError(t, keeper.supplyKeeper.SetModuleAccountCoins(ctx, types.NotBondedPoolName, sdk.NewCoins(sdk.NewCoin(keeper.BondDenom(ctx), startTokens))))
 
 	//create a validator with a self-delegation
 	validator := types.NewValidator(addrVals[0], PKs[0], types.Description{})
 
 	valTokens := sdk.TokensFromTendermintPower(10)
-	validator, pool, issuedShares := validator.AddTokensFromDel(pool, valTokens)
+	validator, issuedShares := validator.AddTokensFromDel(valTokens)
 	require.Equal(t, valTokens, issuedShares.RoundInt())
-	keeper.SetPool(ctx, pool)
 	validator = TestingUpdateValidator(keeper, ctx, validator, true)
-	pool = keeper.GetPool(ctx)
 	val0AccAddr := sdk.AccAddress(addrVals[0].Bytes())
 	selfDelegation := types.NewDelegation(val0AccAddr, addrVals[0], issuedShares)
 	keeper.SetDelegation(ctx, selfDelegation)
@@ -730,19 +707,16 @@ func TestRedelegateFromUnbondingValidator(t *testing.T) {
 	// create a second delegation to this validator
 	keeper.DeleteValidatorByPowerIndex(ctx, validator)
 	delTokens := sdk.TokensFromTendermintPower(10)
-	validator, pool, issuedShares = validator.AddTokensFromDel(pool, delTokens)
+	validator, issuedShares = validator.AddTokensFromDel(delTokens)
 	require.Equal(t, delTokens, issuedShares.RoundInt())
-	keeper.SetPool(ctx, pool)
 	validator = TestingUpdateValidator(keeper, ctx, validator, true)
-	pool = keeper.GetPool(ctx)
 	delegation := types.NewDelegation(addrDels[0], addrVals[0], issuedShares)
 	keeper.SetDelegation(ctx, delegation)
 
 	// create a second validator
 	validator2 := types.NewValidator(addrVals[1], PKs[1], types.Description{})
-	validator2, pool, issuedShares = validator2.AddTokensFromDel(pool, valTokens)
+	validator2, issuedShares = validator2.AddTokensFromDel(valTokens)
 	require.Equal(t, valTokens, issuedShares.RoundInt())
-	keeper.SetPool(ctx, pool)
 	validator2 = TestingUpdateValidator(keeper, ctx, validator2, true)
 
 	header := ctx.BlockHeader()
@@ -788,20 +762,18 @@ func TestRedelegateFromUnbondingValidator(t *testing.T) {
 }
 
 func TestRedelegateFromUnbondedValidator(t *testing.T) {
-	ctx, _, keeper := CreateTestInput(t, false, 0)
-	pool := keeper.GetPool(ctx)
+	ctx, _, _, keeper, _ := CreateTestInput(t, false, 0)
 	startTokens := sdk.TokensFromTendermintPower(30)
-	pool.NotBondedTokens = startTokens
+
+	require.NoError(t, keeper.supplyKeeper.SetModuleAccountCoins(ctx, types.NotBondedPoolName, sdk.NewCoins(sdk.NewCoin(keeper.BondDenom(ctx), startTokens))))
 
 	//create a validator with a self-delegation
 	validator := types.NewValidator(addrVals[0], PKs[0], types.Description{})
 
 	valTokens := sdk.TokensFromTendermintPower(10)
-	validator, pool, issuedShares := validator.AddTokensFromDel(pool, valTokens)
+	validator, issuedShares := validator.AddTokensFromDel(valTokens)
 	require.Equal(t, valTokens, issuedShares.RoundInt())
-	keeper.SetPool(ctx, pool)
 	validator = TestingUpdateValidator(keeper, ctx, validator, true)
-	pool = keeper.GetPool(ctx)
 	val0AccAddr := sdk.AccAddress(addrVals[0].Bytes())
 	selfDelegation := types.NewDelegation(val0AccAddr, addrVals[0], issuedShares)
 	keeper.SetDelegation(ctx, selfDelegation)
@@ -809,19 +781,16 @@ func TestRedelegateFromUnbondedValidator(t *testing.T) {
 	// create a second delegation to this validator
 	keeper.DeleteValidatorByPowerIndex(ctx, validator)
 	delTokens := sdk.TokensFromTendermintPower(10)
-	validator, pool, issuedShares = validator.AddTokensFromDel(pool, delTokens)
+	validator, issuedShares = validator.AddTokensFromDel(delTokens)
 	require.Equal(t, delTokens, issuedShares.RoundInt())
-	keeper.SetPool(ctx, pool)
 	validator = TestingUpdateValidator(keeper, ctx, validator, true)
-	pool = keeper.GetPool(ctx)
 	delegation := types.NewDelegation(addrDels[0], addrVals[0], issuedShares)
 	keeper.SetDelegation(ctx, delegation)
 
 	// create a second validator
 	validator2 := types.NewValidator(addrVals[1], PKs[1], types.Description{})
-	validator2, pool, issuedShares = validator2.AddTokensFromDel(pool, valTokens)
+	validator2, issuedShares = validator2.AddTokensFromDel(valTokens)
 	require.Equal(t, valTokens, issuedShares.RoundInt())
-	keeper.SetPool(ctx, pool)
 	validator2 = TestingUpdateValidator(keeper, ctx, validator2, true)
 	require.Equal(t, sdk.Bonded, validator2.Status)
 
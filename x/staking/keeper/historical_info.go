@@ -0,0 +1,61 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/staking/types"
+)
+
+// GetHistoricalInfo gets the historical info at a given height
+func (k Keeper) GetHistoricalInfo(ctx sdk.Context, height int64) (types.HistoricalInfo, bool) {
+	store := ctx.KVStore(k.storeKey)
+	value := store.Get(types.GetHistoricalInfoKey(height))
+	if value == nil {
+		return types.HistoricalInfo{}, false
+	}
+
+	hi := types.MustUnmarshalHistoricalInfo(k.cdc, value)
+	return hi, true
+}
+
+// SetHistoricalInfo sets the historical info at a given height
+func (k Keeper) SetHistoricalInfo(ctx sdk.Context, height int64, hi types.HistoricalInfo) {
+	store := ctx.KVStore(k.storeKey)
+	value := types.MustMarshalHistoricalInfo(k.cdc, hi)
+	store.Set(types.GetHistoricalInfoKey(height), value)
+}
+
+// DeleteHistoricalInfo deletes the historical info at a given height
+func (k Keeper) DeleteHistoricalInfo(ctx sdk.Context, height int64) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.GetHistoricalInfoKey(height))
+}
+
+// TrackHistoricalInfo saves the latest historical info and deletes the
+// historical entries older than the HistoricalEntries parameter
+func (k Keeper) TrackHistoricalInfo(ctx sdk.Context) {
+	entryNum := int64(k.HistoricalEntries(ctx))
+
+	// Prune the entries older than the persisted window. The parameter may
+	// have been decreased, so keep deleting until an entry is not found.
+	pruneHeight := ctx.BlockHeight() - entryNum
+	if entryNum == 0 {
+		// the current height is not persisted either
+		pruneHeight = ctx.BlockHeight() - 1
+	}
+	for i := pruneHeight; i >= 0; i-- {
+		if _, found := k.GetHistoricalInfo(ctx, i); !found {
+			break
+		}
+		k.DeleteHistoricalInfo(ctx, i)
+	}
+
+	// nothing to persist if the parameter is 0
+	if entryNum == 0 {
+		return
+	}
+
+	// persist the header and validator set of the current height
+	lastVals := k.GetLastValidators(ctx)
+	hi := types.NewHistoricalInfo(ctx.BlockHeader(), lastVals)
+	k.SetHistoricalInfo(ctx, ctx.BlockHeight(), hi)
+}
@@ -0,0 +1,88 @@
+package keeper
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/staking/types"
+)
+
+func TestHistoricalInfo(t *testing.T) {
+	ctx, _, _, keeper, _ := CreateTestInput(t, false, 10)
+
+	var validators []types.Validator
+	for i, valAddr := range addrVals[:3] {
+		validator := types.NewValidator(valAddr, PKs[i], types.Description{})
+		validators = append(validators, validator)
+	}
+
+	hi := types.NewHistoricalInfo(ctx.BlockHeader(), validators)
+	keeper.SetHistoricalInfo(ctx, 2, hi)
+
+	recv, found := keeper.GetHistoricalInfo(ctx, 2)
+	require.True(t, found, "HistoricalInfo not found after set")
+	require.Equal(t, hi, recv, "HistoricalInfo not equal")
+
+	keeper.DeleteHistoricalInfo(ctx, 2)
+
+	recv, found = keeper.GetHistoricalInfo(ctx, 2)
+	require.False(t, found, "HistoricalInfo found after delete")
+	require.Equal(t, types.HistoricalInfo{}, recv, "HistoricalInfo is not empty")
+}
+
+func TestTrackHistoricalInfo(t *testing.T) {
+	ctx, _, _, keeper, _ := CreateTestInput(t, false, 10)
+
+	// set the historical entries in params to 5
+	params := types.DefaultParams()
+	params.HistoricalEntries = 5
+	keeper.SetParams(ctx, params)
+
+	// set historical info at heights 4 and 5, which are to be pruned at height 10
+	header := abci.Header{ChainID: "HelloChain", Height: 5}
+	hi4 := types.NewHistoricalInfo(abci.Header{ChainID: "HelloChain", Height: 4}, []types.Validator{})
+	hi5 := types.NewHistoricalInfo(header, []types.Validator{})
+	keeper.SetHistoricalInfo(ctx, 4, hi4)
+	keeper.SetHistoricalInfo(ctx, 5, hi5)
+
+	// bond two validators
+	val1 := types.NewValidator(sdk.ValAddress(Addrs[0]), PKs[0], types.Description{})
+	val1.Status = sdk.Bonded
+	val1.Tokens = sdk.TokensFromTendermintPower(10)
+	keeper.SetValidator(ctx, val1)
+	keeper.SetLastValidatorPower(ctx, val1.OperatorAddress, 10)
+	val2 := types.NewValidator(sdk.ValAddress(Addrs[1]), PKs[1], types.Description{})
+	val2.Status = sdk.Bonded
+	val2.Tokens = sdk.TokensFromTendermintPower(80)
+	keeper.SetValidator(ctx, val2)
+	keeper.SetLastValidatorPower(ctx, val2.OperatorAddress, 80)
+
+	// the historical info of height 10 is persisted and the old entries pruned
+	header = abci.Header{ChainID: "HelloChain", Height: 10}
+	ctx = ctx.WithBlockHeader(header).WithBlockHeight(10)
+	keeper.TrackHistoricalInfo(ctx)
+
+	recv, found := keeper.GetHistoricalInfo(ctx, 10)
+	require.True(t, found, "GetHistoricalInfo failed after BeginBlock")
+	require.Equal(t, types.NewHistoricalInfo(header, keeper.GetLastValidators(ctx)), recv)
+	require.Len(t, recv.ValSet, 2)
+
+	_, found = keeper.GetHistoricalInfo(ctx, 4)
+	require.False(t, found, "GetHistoricalInfo did not prune earlier height")
+	_, found = keeper.GetHistoricalInfo(ctx, 5)
+	require.False(t, found, "GetHistoricalInfo did not prune first prune height")
+
+	// nothing is persisted, and all entries are pruned, once disabled
+	params.HistoricalEntries = 0
+	keeper.SetParams(ctx, params)
+	ctx = ctx.WithBlockHeight(11)
+	keeper.TrackHistoricalInfo(ctx)
+
+	_, found = keeper.GetHistoricalInfo(ctx, 10)
+	require.False(t, found)
+	_, found = keeper.GetHistoricalInfo(ctx, 11)
+	require.False(t, found)
+}
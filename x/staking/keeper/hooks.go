@@ -43,6 +43,13 @@ func (k Keeper) AfterValidatorBeginUnbonding(ctx sdk.Context, consAddr sdk.ConsA
 	}
 }
 
+// AfterValidatorConsPubKeyRotated - call hook if registered
+func (k Keeper) AfterValidatorConsPubKeyRotated(ctx sdk.Context, oldConsAddr, newConsAddr sdk.ConsAddress, valAddr sdk.ValAddress) {
+	if k.hooks != nil {
+		k.hooks.AfterValidatorConsPubKeyRotated(ctx, oldConsAddr, newConsAddr, valAddr)
+	}
+}
+
 // BeforeDelegationCreated - call hook if registered
 func (k Keeper) BeforeDelegationCreated(ctx sdk.Context, delAddr sdk.AccAddress, valAddr sdk.ValAddress) {
 	if k.hooks != nil {
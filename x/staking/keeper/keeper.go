@@ -2,6 +2,7 @@ package keeper
 
 import (
 	"container/list"
+	"fmt"
 
 	"github.com/tendermint/tendermint/libs/log"
 
@@ -24,7 +25,8 @@ type Keeper struct {
 	storeKey           sdk.StoreKey
 	storeTKey          sdk.StoreKey
 	cdc                *codec.Codec
-	bankKeeper         types.BankKeeper
+	accountKeeper      types.AccountKeeper
+	supplyKeeper       types.SupplyKeeper
 	hooks              types.StakingHooks
 	paramstore         params.Subspace
 	validatorCache     map[string]cachedValidator
@@ -34,14 +36,25 @@ type Keeper struct {
 	codespace sdk.CodespaceType
 }
 
-func NewKeeper(cdc *codec.Codec, key, tkey sdk.StoreKey, bk types.BankKeeper,
-	paramstore params.Subspace, codespace sdk.CodespaceType) Keeper {
+// NewKeeper creates a new staking Keeper instance
+func NewKeeper(cdc *codec.Codec, key, tkey sdk.StoreKey, accountKeeper types.AccountKeeper,
+	supplyKeeper types.SupplyKeeper, paramstore params.Subspace, codespace sdk.CodespaceType) Keeper {
+
+	// ensure bonded and not bonded module accounts are set
+	if addr := supplyKeeper.GetModuleAddress(types.BondedPoolName); addr == nil {
+		panic(fmt.Sprintf("%s module account has not been set", types.BondedPoolName))
+	}
+
+	if addr := supplyKeeper.GetModuleAddress(types.NotBondedPoolName); addr == nil {
+		panic(fmt.Sprintf("%s module account has not been set", types.NotBondedPoolName))
+	}
 
 	keeper := Keeper{
 		storeKey:           key,
 		storeTKey:          tkey,
 		cdc:                cdc,
-		bankKeeper:         bk,
+		accountKeeper:      accountKeeper,
+		supplyKeeper:       supplyKeeper,
 		paramstore:         paramstore.WithKeyTable(ParamKeyTable()),
 		hooks:              nil,
 		validatorCache:     make(map[string]cachedValidator, aminoCacheSize),
@@ -68,24 +81,6 @@ func (k Keeper) Codespace() sdk.CodespaceType {
 	return k.codespace
 }
 
-// get the pool
-func (k Keeper) GetPool(ctx sdk.Context) (pool types.Pool) {
-	store := ctx.KVStore(k.storeKey)
-	b := store.Get(types.PoolKey)
-	if b == nil {
-		panic("stored pool should not have been nil")
-	}
-	k.cdc.MustUnmarshalBinaryLengthPrefixed(b, &pool)
-	return
-}
-
-// set the pool
-func (k Keeper) SetPool(ctx sdk.Context, pool types.Pool) {
-	store := ctx.KVStore(k.storeKey)
-	b := k.cdc.MustMarshalBinaryLengthPrefixed(pool)
-	store.Set(types.PoolKey, b)
-}
-
 // Load the last total validator power.
 func (k Keeper) GetLastTotalPower(ctx sdk.Context) (power sdk.Int) {
 	store := ctx.KVStore(k.storeKey)
@@ -0,0 +1,90 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/auth"
+	"github.com/cosmos/cosmos-sdk/x/staking/types"
+)
+
+// GetBondedPool returns the bonded tokens pool's module account
+func (k Keeper) GetBondedPool(ctx sdk.Context) (bondedPool auth.ModuleAccountI) {
+	return k.supplyKeeper.GetModuleAccount(ctx, types.BondedPoolName)
+}
+
+// GetNotBondedPool returns the not bonded tokens pool's module account
+func (k Keeper) GetNotBondedPool(ctx sdk.Context) (notBondedPool auth.ModuleAccountI) {
+	return k.supplyKeeper.GetModuleAccount(ctx, types.NotBondedPoolName)
+}
+
+// GetTokenizedSharesPool returns the module account holding the delegations
+// of the tokenized shares
+func (k Keeper) GetTokenizedSharesPool(ctx sdk.Context) (tokenizedSharesPool auth.ModuleAccountI) {
+	return k.supplyKeeper.GetModuleAccount(ctx, types.TokenizedSharesPoolName)
+}
+
+// bondedTokensToNotBonded transfers coins from the bonded to the not bonded pool within staking
+func (k Keeper) bondedTokensToNotBonded(ctx sdk.Context, tokens sdk.Int) {
+	if tokens.IsZero() {
+		return
+	}
+
+	coins := sdk.NewCoins(sdk.NewCoin(k.BondDenom(ctx), tokens))
+	err := k.supplyKeeper.SendCoinsFromModuleToModule(ctx, types.BondedPoolName, types.NotBondedPoolName, coins)
+	if err != nil {
+		panic(err)
+	}
+}
+
+// notBondedTokensToBonded transfers coins from the not bonded to the bonded pool within staking
+func (k Keeper) notBondedTokensToBonded(ctx sdk.Context, tokens sdk.Int) {
+	if tokens.IsZero() {
+		return
+	}
+
+	coins := sdk.NewCoins(sdk.NewCoin(k.BondDenom(ctx), tokens))
+	err := k.supplyKeeper.SendCoinsFromModuleToModule(ctx, types.NotBondedPoolName, types.BondedPoolName, coins)
+	if err != nil {
+		panic(err)
+	}
+}
+
+// burnBondedTokens removes coins from the bonded pool module account
+func (k Keeper) burnBondedTokens(ctx sdk.Context, amt sdk.Int) sdk.Error {
+	if !amt.IsPositive() {
+		// skip as no coins need to be burned
+		return nil
+	}
+
+	coins := sdk.NewCoins(sdk.NewCoin(k.BondDenom(ctx), amt))
+	return k.supplyKeeper.BurnCoins(ctx, types.BondedPoolName, coins)
+}
+
+// burnNotBondedTokens removes coins from the not bonded pool module account
+func (k Keeper) burnNotBondedTokens(ctx sdk.Context, amt sdk.Int) sdk.Error {
+	if !amt.IsPositive() {
+		// skip as no coins need to be burned
+		return nil
+	}
+
+	coins := sdk.NewCoins(sdk.NewCoin(k.BondDenom(ctx), amt))
+	return k.supplyKeeper.BurnCoins(ctx, types.NotBondedPoolName, coins)
+}
+
+// TotalBondedTokens total staking tokens supply which is bonded
+func (k Keeper) TotalBondedTokens(ctx sdk.Context) sdk.Int {
+	return k.supplyKeeper.GetModuleAccountCoins(ctx, types.BondedPoolName).AmountOf(k.BondDenom(ctx))
+}
+
+// StakingTokenSupply staking tokens from the total supply
+func (k Keeper) StakingTokenSupply(ctx sdk.Context) sdk.Int {
+	return k.supplyKeeper.GetSupply(ctx).Total.AmountOf(k.BondDenom(ctx))
+}
+
+// BondedRatio the fraction of the staking tokens which are currently bonded
+func (k Keeper) BondedRatio(ctx sdk.Context) sdk.Dec {
+	stakeSupply := k.StakingTokenSupply(ctx)
+	if stakeSupply.IsPositive() {
+		return k.TotalBondedTokens(ctx).ToDec().QuoInt(stakeSupply)
+	}
+	return sdk.ZeroDec()
+}
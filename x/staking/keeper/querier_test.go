@@ -20,18 +20,16 @@ var (
 
 func TestNewQuerier(t *testing.T) {
 	cdc := codec.New()
-	ctx, _, r := CreateTestInput(t, false, 1000)
-	pool := r.GetPool(ctx)
+	ctx, _, _, r, _ := CreateTestInput(t, false, 1000)
 	// Create Validators
 	amts := []sdk.Int{sdk.NewInt(9), sdk.NewInt(8)}
 	var validators [2]types.Validator
 	for i, amt := range amts {
 		validators[i] = types.NewValidator(sdk.ValAddress(Addrs[i]), PKs[i], types.Description{})
-		validators[i], pool, _ = validators[i].AddTokensFromDel(pool, amt)
+		validators[i], _ = validators[i].AddTokensFromDel(amt)
 		r.SetValidator(ctx, validators[i])
 		r.SetValidatorByPowerIndex(ctx, validators[i])
 	}
-	r.SetPool(ctx, pool)
 
 	query := abci.RequestQuery{
 		Path: "",
@@ -92,7 +90,7 @@ func TestNewQuerier(t *testing.T) {
 
 func TestQueryParametersPool(t *testing.T) {
 	cdc := codec.New()
-	ctx, _, r := CreateTestInput(t, false, 1000)
+	ctx, _, _, r, _ := CreateTestInput(t, false, 1000)
 
 	res, err := queryParameters(ctx, r)
 	require.Nil(t, err)
@@ -106,15 +104,16 @@ func TestQueryParametersPool(t *testing.T) {
 	require.Nil(t, err)
 
 	var pool types.Pool
+	bondDenom := sdk.DefaultBondDenom
 	errRes = cdc.UnmarshalJSON(res, &pool)
 	require.Nil(t, errRes)
-	require.Equal(t, r.GetPool(ctx), pool)
+	require.Equal(t, r.supplyKeeper.GetModuleAccountCoins(ctx, types.NotBondedPoolName).AmountOf(bondDenom), pool.NotBondedTokens)
+	require.Equal(t, r.supplyKeeper.GetModuleAccountCoins(ctx, types.BondedPoolName).AmountOf(bondDenom), pool.BondedTokens)
 }
 
 func TestQueryValidators(t *testing.T) {
 	cdc := codec.New()
-	ctx, _, r := CreateTestInput(t, false, 10000)
-	pool := r.GetPool(ctx)
+	ctx, _, _, r, _ := CreateTestInput(t, false, 10000)
 	params := r.GetParams(ctx)
 
 	// Create Validators
@@ -123,11 +122,10 @@ func TestQueryValidators(t *testing.T) {
 	var validators [3]types.Validator
 	for i, amt := range amts {
 		validators[i] = types.NewValidator(sdk.ValAddress(Addrs[i]), PKs[i], types.Description{})
-		validators[i], pool, _ = validators[i].AddTokensFromDel(pool, amt)
-		validators[i], pool = validators[i].UpdateStatus(pool, status[i])
+		validators[i], _ = validators[i].AddTokensFromDel(amt)
+		validators[i] = validators[i].UpdateStatus(status[i])
 	}
 
-	r.SetPool(ctx, pool)
 	r.SetValidator(ctx, validators[0])
 	r.SetValidator(ctx, validators[1])
 	r.SetValidator(ctx, validators[2])
@@ -178,7 +176,7 @@ func TestQueryValidators(t *testing.T) {
 
 func TestQueryDelegation(t *testing.T) {
 	cdc := codec.New()
-	ctx, _, r := CreateTestInput(t, false, 10000)
+	ctx, _, _, r, _ := CreateTestInput(t, false, 10000)
 	params := r.GetParams(ctx)
 
 	// Create Validators and Delegation
@@ -191,7 +189,7 @@ func TestQueryDelegation(t *testing.T) {
 	r.SetValidatorByPowerIndex(ctx, val2)
 
 	delTokens := sdk.TokensFromTendermintPower(20)
-	r.Delegate(ctx, addrAcc2, delTokens, val1, true)
+	r.Delegate(ctx, addrAcc2, delTokens, sdk.Unbonded, val1, true)
 
 	// apply TM updates
 	r.ApplyAndReturnValidatorSetUpdates(ctx)
@@ -398,7 +396,7 @@ func TestQueryDelegation(t *testing.T) {
 
 func TestQueryRedelegations(t *testing.T) {
 	cdc := codec.New()
-	ctx, _, r := CreateTestInput(t, false, 10000)
+	ctx, _, _, r, _ := CreateTestInput(t, false, 10000)
 
 	// Create Validators and Delegation
 	val1 := types.NewValidator(addrVal1, pk1, types.Description{})
@@ -407,7 +405,7 @@ func TestQueryRedelegations(t *testing.T) {
 	r.SetValidator(ctx, val2)
 
 	delAmount := sdk.TokensFromTendermintPower(100)
-	r.Delegate(ctx, addrAcc2, delAmount, val1, true)
+	r.Delegate(ctx, addrAcc2, delAmount, sdk.Unbonded, val1, true)
 	_ = r.ApplyAndReturnValidatorSetUpdates(ctx)
 
 	rdAmount := sdk.TokensFromTendermintPower(20)
@@ -463,7 +461,7 @@ func TestQueryRedelegations(t *testing.T) {
 
 func TestQueryUnbondingDelegation(t *testing.T) {
 	cdc := codec.New()
-	ctx, _, keeper := CreateTestInput(t, false, 10000)
+	ctx, _, _, keeper, _ := CreateTestInput(t, false, 10000)
 
 	// Create Validators and Delegation
 	val1 := types.NewValidator(addrVal1, pk1, types.Description{})
@@ -471,7 +469,7 @@ func TestQueryUnbondingDelegation(t *testing.T) {
 
 	// delegate
 	delAmount := sdk.TokensFromTendermintPower(100)
-	_, err := keeper.Delegate(ctx, addrAcc1, delAmount, val1, true)
+	_, err := keeper.Delegate(ctx, addrAcc1, delAmount, sdk.Unbonded, val1, true)
 	require.NoError(t, err)
 	_ = keeper.ApplyAndReturnValidatorSetUpdates(ctx)
 
@@ -551,3 +549,45 @@ func TestQueryUnbondingDelegation(t *testing.T) {
 	require.NoError(t, cdc.UnmarshalJSON(res, &ubDels))
 	require.Equal(t, 0, len(ubDels))
 }
+
+func TestQueryHistoricalInfo(t *testing.T) {
+	cdc := codec.New()
+	ctx, _, _, keeper, _ := CreateTestInput(t, false, 10000)
+
+	// Create Validators and Delegation
+	val1 := types.NewValidator(addrVal1, pk1, types.Description{})
+	val2 := types.NewValidator(addrVal2, pk2, types.Description{})
+	vals := []types.Validator{val1, val2}
+	keeper.SetValidator(ctx, val1)
+	keeper.SetValidator(ctx, val2)
+
+	header := abci.Header{
+		ChainID: "HelloChain",
+		Height:  5,
+	}
+	hi := types.NewHistoricalInfo(header, vals)
+	keeper.SetHistoricalInfo(ctx, 5, hi)
+
+	queryHistoricalParams := types.NewQueryHistoricalInfoParams(4)
+	bz, errRes := cdc.MarshalJSON(queryHistoricalParams)
+	require.Nil(t, errRes)
+	query := abci.RequestQuery{
+		Path: "/custom/staking/historicalInfo",
+		Data: bz,
+	}
+	res, err := queryHistoricalInfo(ctx, query, keeper)
+	require.NotNil(t, err, "Invalid query passed")
+	require.Nil(t, res, "Invalid query returned non-nil result")
+
+	queryHistoricalParams = types.NewQueryHistoricalInfoParams(5)
+	bz, errRes = cdc.MarshalJSON(queryHistoricalParams)
+	require.Nil(t, errRes)
+	query.Data = bz
+	res, err = queryHistoricalInfo(ctx, query, keeper)
+	require.Nil(t, err, "Valid query passed")
+	require.NotNil(t, res, "Valid query returned nil result")
+
+	var recv types.HistoricalInfo
+	require.NoError(t, cdc.UnmarshalJSON(res, &recv))
+	require.Equal(t, hi, recv, "HistoricalInfo query returned wrong result")
+}
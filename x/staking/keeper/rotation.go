@@ -0,0 +1,196 @@
+package keeper
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/tendermint/tendermint/crypto"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/staking/types"
+)
+
+// get the pending consensus pubkey rotation of a validator
+func (k Keeper) GetConsPubKeyRotation(ctx sdk.Context, valAddr sdk.ValAddress) (rotation types.ConsPubKeyRotation, found bool) {
+	store := ctx.KVStore(k.storeKey)
+	value := store.Get(types.GetConsPubKeyRotationKey(valAddr))
+	if value == nil {
+		return rotation, false
+	}
+
+	rotation = types.MustUnmarshalConsPubKeyRotation(k.cdc, value)
+	return rotation, true
+}
+
+// set a pending consensus pubkey rotation, applied at the end of the block
+func (k Keeper) SetConsPubKeyRotation(ctx sdk.Context, rotation types.ConsPubKeyRotation) {
+	store := ctx.KVStore(k.storeKey)
+	bz := types.MustMarshalConsPubKeyRotation(k.cdc, rotation)
+	store.Set(types.GetConsPubKeyRotationKey(rotation.ValidatorAddress), bz)
+}
+
+// remove the pending consensus pubkey rotation of a validator
+func (k Keeper) DeleteConsPubKeyRotation(ctx sdk.Context, valAddr sdk.ValAddress) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.GetConsPubKeyRotationKey(valAddr))
+}
+
+// iterate through the pending consensus pubkey rotations
+func (k Keeper) IterateConsPubKeyRotations(ctx sdk.Context, fn func(rotation types.ConsPubKeyRotation) (stop bool)) {
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, types.ConsPubKeyRotationKey)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		rotation := types.MustUnmarshalConsPubKeyRotation(k.cdc, iterator.Value())
+		if fn(rotation) {
+			break
+		}
+	}
+}
+
+// IsConsPubKeyRotationPending returns true if a pending consensus pubkey
+// rotation, of any validator, is to the given consensus address.
+func (k Keeper) IsConsPubKeyRotationPending(ctx sdk.Context, consAddr sdk.ConsAddress) (pending bool) {
+	k.IterateConsPubKeyRotations(ctx, func(rotation types.ConsPubKeyRotation) bool {
+		pending = sdk.GetConsAddress(rotation.NewPubKey).Equals(consAddr)
+		return pending
+	})
+	return pending
+}
+
+// Apply the pending consensus pubkey rotations, and return the previous
+// consensus pubkeys of the rotated validators by operator address.
+//
+// The index of the previous consensus address of a validator is kept until
+// the end of the unbonding period, so that the validator can still be found,
+// e.g. to be slashed for double-signs made with its previous key.
+func (k Keeper) applyConsPubKeyRotations(ctx sdk.Context) map[[sdk.AddrLen]byte]crypto.PubKey {
+	var rotations []types.ConsPubKeyRotation
+	k.IterateConsPubKeyRotations(ctx, func(rotation types.ConsPubKeyRotation) bool {
+		rotations = append(rotations, rotation)
+		return false
+	})
+
+	oldPubKeys := make(map[[sdk.AddrLen]byte]crypto.PubKey)
+	for _, rotation := range rotations {
+		k.DeleteConsPubKeyRotation(ctx, rotation.ValidatorAddress)
+
+		// the validator may have been removed since the rotation was requested
+		validator, found := k.GetValidator(ctx, rotation.ValidatorAddress)
+		if !found {
+			continue
+		}
+
+		// the new pubkey may have been taken by another validator since the
+		// rotation was requested, in which case the rotation is dropped
+		if _, found := k.GetValidatorByConsAddr(ctx, sdk.GetConsAddress(rotation.NewPubKey)); found {
+			k.Logger(ctx).Info(fmt.Sprintf("dropping consensus pubkey rotation of validator %s: pubkey %s is taken",
+				validator.OperatorAddress, sdk.MustBech32ifyConsPub(rotation.NewPubKey)))
+			continue
+		}
+
+		oldPubKey, oldConsAddr := validator.ConsPubKey, validator.ConsAddress()
+		validator.ConsPubKey = rotation.NewPubKey
+		k.SetValidator(ctx, validator)
+		k.SetValidatorByConsAddr(ctx, validator)
+		k.insertOldConsAddrQueue(ctx, validator.OperatorAddress, oldConsAddr,
+			ctx.BlockHeader().Time.Add(k.UnbondingTime(ctx)))
+
+		var valAddrBytes [sdk.AddrLen]byte
+		copy(valAddrBytes[:], validator.OperatorAddress[:])
+		oldPubKeys[valAddrBytes] = oldPubKey
+
+		k.AfterValidatorConsPubKeyRotated(ctx, oldConsAddr, validator.ConsAddress(), validator.OperatorAddress)
+
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				types.EventTypeCompleteRotation,
+				sdk.NewAttribute(types.AttributeKeyValidator, validator.OperatorAddress.String()),
+				sdk.NewAttribute(types.AttributeKeyConsPubKey, sdk.MustBech32ifyConsPub(validator.ConsPubKey)),
+			),
+		)
+	}
+
+	return oldPubKeys
+}
+
+// queue the deletion of the index of a previous consensus address of a
+// validator at the given completion time, replacing any earlier deletion of
+// the same index
+func (k Keeper) insertOldConsAddrQueue(ctx sdk.Context, valAddr sdk.ValAddress, consAddr sdk.ConsAddress,
+	completionTime time.Time) {
+
+	store := ctx.KVStore(k.storeKey)
+	key := types.GetValidatorOldConsAddrKey(valAddr, consAddr)
+
+	if bz := store.Get(key); bz != nil {
+		store.Delete(types.GetOldConsAddrQueueKey(mustParseTimeBytes(bz), consAddr))
+	}
+
+	store.Set(key, sdk.FormatTimeBytes(completionTime))
+	store.Set(types.GetOldConsAddrQueueKey(completionTime, consAddr), valAddr)
+}
+
+// DeleteMatureOldConsAddrs deletes the indexes of the previous consensus
+// addresses of rotated validators whose unbonding period has ended.
+func (k Keeper) DeleteMatureOldConsAddrs(ctx sdk.Context) {
+	store := ctx.KVStore(k.storeKey)
+	iterator := store.Iterator(types.OldConsAddrQueueKey,
+		sdk.PrefixEndBytes(types.GetOldConsAddrQueueTimeKey(ctx.BlockHeader().Time)))
+	defer iterator.Close()
+
+	var keys [][]byte
+	for ; iterator.Valid(); iterator.Next() {
+		keys = append(keys, iterator.Key())
+	}
+
+	for _, key := range keys {
+		valAddr := sdk.ValAddress(store.Get(key))
+		consAddr := sdk.ConsAddress(key[len(key)-sdk.AddrLen:])
+
+		store.Delete(key)
+		k.deleteOldConsAddr(ctx, valAddr, consAddr)
+	}
+}
+
+// delete the indexes of all the previous consensus addresses of a validator
+func (k Keeper) deleteValidatorOldConsAddrs(ctx sdk.Context, valAddr sdk.ValAddress) {
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, types.GetValidatorOldConsAddrsKey(valAddr))
+	defer iterator.Close()
+
+	var keys [][]byte
+	for ; iterator.Valid(); iterator.Next() {
+		keys = append(keys, iterator.Key())
+	}
+
+	for _, key := range keys {
+		consAddr := sdk.ConsAddress(key[len(key)-sdk.AddrLen:])
+
+		store.Delete(types.GetOldConsAddrQueueKey(mustParseTimeBytes(store.Get(key)), consAddr))
+		k.deleteOldConsAddr(ctx, valAddr, consAddr)
+	}
+}
+
+// delete the index of a previous consensus address of a validator, unless the
+// validator uses it again
+func (k Keeper) deleteOldConsAddr(ctx sdk.Context, valAddr sdk.ValAddress, consAddr sdk.ConsAddress) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.GetValidatorOldConsAddrKey(valAddr, consAddr))
+
+	validator, found := k.GetValidatorByConsAddr(ctx, consAddr)
+	if !found || !validator.OperatorAddress.Equals(valAddr) || validator.ConsAddress().Equals(consAddr) {
+		return
+	}
+
+	store.Delete(types.GetValidatorByConsAddrKey(consAddr))
+}
+
+func mustParseTimeBytes(bz []byte) time.Time {
+	t, err := sdk.ParseTimeBytes(bz)
+	if err != nil {
+		panic(err)
+	}
+	return t
+}
@@ -116,13 +116,21 @@ func (k Keeper) Slash(ctx sdk.Context, consAddr sdk.ConsAddress, infractionHeigh
 	}
 
 	// Deduct from validator's bonded tokens and update the validator.
-	// The deducted tokens are returned to pool.NotBondedTokens.
-	// TODO: Move the token accounting outside of `RemoveValidatorTokens` so it is less confusing
+	// Burn the slashed tokens from the pool account and decrease the total supply.
 	validator = k.RemoveValidatorTokens(ctx, validator, tokensToBurn)
-	pool := k.GetPool(ctx)
-	// Burn the slashed tokens, which are now loose.
-	pool.NotBondedTokens = pool.NotBondedTokens.Sub(tokensToBurn)
-	k.SetPool(ctx, pool)
+
+	switch validator.GetStatus() {
+	case sdk.Bonded:
+		if err := k.burnBondedTokens(ctx, tokensToBurn); err != nil {
+			panic(err)
+		}
+	case sdk.Unbonding, sdk.Unbonded:
+		if err := k.burnNotBondedTokens(ctx, tokensToBurn); err != nil {
+			panic(err)
+		}
+	default:
+		panic("invalid validator status")
+	}
 
 	// Log that a slash occurred!
 	logger.Info(fmt.Sprintf(
@@ -195,12 +203,12 @@ func (k Keeper) slashUnbondingDelegation(ctx sdk.Context, unbondingDelegation ty
 		entry.Balance = entry.Balance.Sub(unbondingSlashAmount)
 		unbondingDelegation.Entries[i] = entry
 		k.SetUnbondingDelegation(ctx, unbondingDelegation)
-		pool := k.GetPool(ctx)
 
 		// Burn not-bonded tokens
 		// Ref https://github.com/cosmos/cosmos-sdk/pull/1278#discussion_r198657760
-		pool.NotBondedTokens = pool.NotBondedTokens.Sub(unbondingSlashAmount)
-		k.SetPool(ctx, pool)
+		if err := k.burnNotBondedTokens(ctx, unbondingSlashAmount); err != nil {
+			panic(err)
+		}
 	}
 
 	return totalSlashAmount
@@ -250,15 +258,30 @@ func (k Keeper) slashRedelegation(ctx sdk.Context, validator types.Validator, re
 			sharesToUnbond = delegation.Shares
 		}
 
+		dstValidator, found := k.GetValidator(ctx, redelegation.ValidatorDstAddress)
+		if !found {
+			panic("destination validator not found")
+		}
+
 		tokensToBurn, err := k.unbond(ctx, redelegation.DelegatorAddress, redelegation.ValidatorDstAddress, sharesToUnbond)
 		if err != nil {
 			panic(fmt.Errorf("error unbonding delegator: %v", err))
 		}
 
-		// Burn not-bonded tokens
-		pool := k.GetPool(ctx)
-		pool.NotBondedTokens = pool.NotBondedTokens.Sub(tokensToBurn)
-		k.SetPool(ctx, pool)
+		// tokens of a redelegation currently live in the destination validator
+		// therefore we must burn tokens from the destination validator's bonding status
+		switch {
+		case dstValidator.IsBonded():
+			if err := k.burnBondedTokens(ctx, tokensToBurn); err != nil {
+				panic(err)
+			}
+		case dstValidator.IsUnbonded() || dstValidator.IsUnbonding():
+			if err := k.burnNotBondedTokens(ctx, tokensToBurn); err != nil {
+				panic(err)
+			}
+		default:
+			panic("unknown validator status")
+		}
 	}
 
 	return totalSlashAmount
@@ -17,23 +17,20 @@ import (
 func setupHelper(t *testing.T, power int64) (sdk.Context, Keeper, types.Params) {
 
 	// setup
-	ctx, _, keeper := CreateTestInput(t, false, power)
+	ctx, _, _, keeper, _ := CreateTestInput(t, false, power)
 	params := keeper.GetParams(ctx)
-	pool := keeper.GetPool(ctx)
 	numVals := int64(3)
 	amt := sdk.TokensFromTendermintPower(power)
-	pool.NotBondedTokens = amt.MulRaw(numVals)
+
+	require.NoError(t, keeper.supplyKeeper.SetModuleAccountCoins(ctx, types.NotBondedPoolName, sdk.NewCoins(sdk.NewCoin(keeper.BondDenom(ctx), amt.MulRaw(numVals)))))
 
 	// add numVals validators
 	for i := int64(0); i < numVals; i++ {
 		validator := types.NewValidator(addrVals[i], PKs[i], types.Description{})
-		validator, pool, _ = validator.AddTokensFromDel(pool, amt)
-		pool.BondedTokens = pool.BondedTokens.Add(amt)
-		keeper.SetPool(ctx, pool)
+		validator, _ = validator.AddTokensFromDel(amt)
 		validator = TestingUpdateValidator(keeper, ctx, validator, true)
 		keeper.SetValidatorByConsAddr(ctx, validator)
 	}
-	pool = keeper.GetPool(ctx)
 
 	return ctx, keeper, params
 }
@@ -78,6 +75,9 @@ func TestSlashUnbondingDelegation(t *testing.T) {
 
 	keeper.SetUnbondingDelegation(ctx, ubd)
 
+	// the unbonding tokens are held by the not bonded pool
+	require.NoError(t, keeper.supplyKeeper.SetModuleAccountCoins(ctx, types.NotBondedPoolName, sdk.NewCoins(sdk.NewCoin(keeper.BondDenom(ctx), sdk.NewInt(10)))))
+
 	// unbonding started prior to the infraction height, stakw didn't contribute
 	slashAmount := keeper.slashUnbondingDelegation(ctx, ubd, 1, fraction)
 	require.Equal(t, int64(0), slashAmount.Int64())
@@ -89,7 +89,7 @@ func TestSlashUnbondingDelegation(t *testing.T) {
 	require.Equal(t, int64(0), slashAmount.Int64())
 
 	// test valid slash, before expiration timestamp and to which stake contributed
-	oldPool := keeper.GetPool(ctx)
+	oldNotBonded := keeper.supplyKeeper.GetModuleAccountCoins(ctx, types.NotBondedPoolName).AmountOf(keeper.BondDenom(ctx))
 	ctx = ctx.WithBlockHeader(abci.Header{Time: time.Unix(0, 0)})
 	keeper.SetUnbondingDelegation(ctx, ubd)
 	slashAmount = keeper.slashUnbondingDelegation(ctx, ubd, 0, fraction)
@@ -103,8 +103,8 @@ func TestSlashUnbondingDelegation(t *testing.T) {
 
 	// balance decreased
 	require.Equal(t, sdk.NewInt(5), ubd.Entries[0].Balance)
-	newPool := keeper.GetPool(ctx)
-	require.Equal(t, int64(5), oldPool.NotBondedTokens.Sub(newPool.NotBondedTokens).Int64())
+	newNotBonded := keeper.supplyKeeper.GetModuleAccountCoins(ctx, types.NotBondedPoolName).AmountOf(keeper.BondDenom(ctx))
+	require.Equal(t, int64(5), oldNotBonded.Sub(newNotBonded).Int64())
 }
 
 // tests slashRedelegation
@@ -138,7 +138,7 @@ func TestSlashRedelegation(t *testing.T) {
 	require.Equal(t, int64(0), slashAmount.Int64())
 
 	// test valid slash, before expiration timestamp and to which stake contributed
-	oldPool := keeper.GetPool(ctx)
+	oldBonded := keeper.supplyKeeper.GetModuleAccountCoins(ctx, types.BondedPoolName).AmountOf(keeper.BondDenom(ctx))
 	ctx = ctx.WithBlockHeader(abci.Header{Time: time.Unix(0, 0)})
 	keeper.SetRedelegation(ctx, rd)
 	validator, found = keeper.GetValidator(ctx, addrVals[1])
@@ -162,8 +162,8 @@ func TestSlashRedelegation(t *testing.T) {
 	require.Equal(t, int64(5), del.Shares.RoundInt64())
 
 	// pool bonded tokens decreased
-	newPool := keeper.GetPool(ctx)
-	require.Equal(t, int64(5), oldPool.BondedTokens.Sub(newPool.BondedTokens).Int64())
+	newBonded := keeper.supplyKeeper.GetModuleAccountCoins(ctx, types.BondedPoolName).AmountOf(keeper.BondDenom(ctx))
+	require.Equal(t, int64(5), oldBonded.Sub(newBonded).Int64())
 }
 
 // tests Slash at a future height (must panic)
@@ -181,7 +181,7 @@ func TestSlashAtNegativeHeight(t *testing.T) {
 	consAddr := sdk.ConsAddress(PKs[0].Address())
 	fraction := sdk.NewDecWithPrec(5, 1)
 
-	oldPool := keeper.GetPool(ctx)
+	oldBonded := keeper.supplyKeeper.GetModuleAccountCoins(ctx, types.BondedPoolName).AmountOf(keeper.BondDenom(ctx))
 	validator, found := keeper.GetValidatorByConsAddr(ctx, consAddr)
 	require.True(t, found)
 	keeper.Slash(ctx, consAddr, -2, 10, fraction)
@@ -189,7 +189,7 @@ func TestSlashAtNegativeHeight(t *testing.T) {
 	// read updated state
 	validator, found = keeper.GetValidatorByConsAddr(ctx, consAddr)
 	require.True(t, found)
-	newPool := keeper.GetPool(ctx)
+	newBonded := keeper.supplyKeeper.GetModuleAccountCoins(ctx, types.BondedPoolName).AmountOf(keeper.BondDenom(ctx))
 
 	// end block
 	updates := keeper.ApplyAndReturnValidatorSetUpdates(ctx)
@@ -199,7 +199,7 @@ func TestSlashAtNegativeHeight(t *testing.T) {
 	// power decreased
 	require.Equal(t, int64(5), validator.GetTendermintPower())
 	// pool bonded shares decreased
-	require.Equal(t, sdk.TokensFromTendermintPower(5), oldPool.BondedTokens.Sub(newPool.BondedTokens))
+	require.Equal(t, sdk.TokensFromTendermintPower(5), oldBonded.Sub(newBonded))
 }
 
 // tests Slash at the current height
@@ -208,7 +208,7 @@ func TestSlashValidatorAtCurrentHeight(t *testing.T) {
 	consAddr := sdk.ConsAddress(PKs[0].Address())
 	fraction := sdk.NewDecWithPrec(5, 1)
 
-	oldPool := keeper.GetPool(ctx)
+	oldBonded := keeper.supplyKeeper.GetModuleAccountCoins(ctx, types.BondedPoolName).AmountOf(keeper.BondDenom(ctx))
 	validator, found := keeper.GetValidatorByConsAddr(ctx, consAddr)
 	require.True(t, found)
 	keeper.Slash(ctx, consAddr, ctx.BlockHeight(), 10, fraction)
@@ -216,7 +216,7 @@ func TestSlashValidatorAtCurrentHeight(t *testing.T) {
 	// read updated state
 	validator, found = keeper.GetValidatorByConsAddr(ctx, consAddr)
 	require.True(t, found)
-	newPool := keeper.GetPool(ctx)
+	newBonded := keeper.supplyKeeper.GetModuleAccountCoins(ctx, types.BondedPoolName).AmountOf(keeper.BondDenom(ctx))
 
 	// end block
 	updates := keeper.ApplyAndReturnValidatorSetUpdates(ctx)
@@ -226,7 +226,7 @@ func TestSlashValidatorAtCurrentHeight(t *testing.T) {
 	// power decreased
 	require.Equal(t, int64(5), validator.GetTendermintPower())
 	// pool bonded shares decreased
-	require.Equal(t, sdk.TokensFromTendermintPower(5), oldPool.BondedTokens.Sub(newPool.BondedTokens))
+	require.Equal(t, sdk.TokensFromTendermintPower(5), oldBonded.Sub(newBonded))
 }
 
 // tests Slash at a previous height with an unbonding delegation
@@ -242,9 +242,12 @@ func TestSlashWithUnbondingDelegation(t *testing.T) {
 		time.Unix(0, 0), ubdTokens)
 	keeper.SetUnbondingDelegation(ctx, ubd)
 
+	// the unbonding tokens are held by the not bonded pool
+	require.NoError(t, keeper.supplyKeeper.SetModuleAccountCoins(ctx, types.NotBondedPoolName, sdk.NewCoins(sdk.NewCoin(keeper.BondDenom(ctx), ubdTokens))))
+
 	// slash validator for the first time
 	ctx = ctx.WithBlockHeight(12)
-	oldPool := keeper.GetPool(ctx)
+	oldBonded := keeper.supplyKeeper.GetModuleAccountCoins(ctx, types.BondedPoolName).AmountOf(keeper.BondDenom(ctx))
 	validator, found := keeper.GetValidatorByConsAddr(ctx, consAddr)
 	require.True(t, found)
 	keeper.Slash(ctx, consAddr, 10, 10, fraction)
@@ -260,9 +263,9 @@ func TestSlashWithUnbondingDelegation(t *testing.T) {
 	// balance decreased
 	require.Equal(t, sdk.TokensFromTendermintPower(2), ubd.Entries[0].Balance)
 	// read updated pool
-	newPool := keeper.GetPool(ctx)
+	newBonded := keeper.supplyKeeper.GetModuleAccountCoins(ctx, types.BondedPoolName).AmountOf(keeper.BondDenom(ctx))
 	// bonded tokens burned
-	require.Equal(t, sdk.TokensFromTendermintPower(3), oldPool.BondedTokens.Sub(newPool.BondedTokens))
+	require.Equal(t, sdk.TokensFromTendermintPower(3), oldBonded.Sub(newBonded))
 	// read updated validator
 	validator, found = keeper.GetValidatorByConsAddr(ctx, consAddr)
 	require.True(t, found)
@@ -281,9 +284,9 @@ func TestSlashWithUnbondingDelegation(t *testing.T) {
 	// balance decreased again
 	require.Equal(t, sdk.NewInt(0), ubd.Entries[0].Balance)
 	// read updated pool
-	newPool = keeper.GetPool(ctx)
+	newBonded = keeper.supplyKeeper.GetModuleAccountCoins(ctx, types.BondedPoolName).AmountOf(keeper.BondDenom(ctx))
 	// bonded tokens burned again
-	require.Equal(t, sdk.TokensFromTendermintPower(6), oldPool.BondedTokens.Sub(newPool.BondedTokens))
+	require.Equal(t, sdk.TokensFromTendermintPower(6), oldBonded.Sub(newBonded))
 	// read updated validator
 	validator, found = keeper.GetValidatorByConsAddr(ctx, consAddr)
 	require.True(t, found)
@@ -302,9 +305,9 @@ func TestSlashWithUnbondingDelegation(t *testing.T) {
 	// balance unchanged
 	require.Equal(t, sdk.NewInt(0), ubd.Entries[0].Balance)
 	// read updated pool
-	newPool = keeper.GetPool(ctx)
+	newBonded = keeper.supplyKeeper.GetModuleAccountCoins(ctx, types.BondedPoolName).AmountOf(keeper.BondDenom(ctx))
 	// bonded tokens burned again
-	require.Equal(t, sdk.TokensFromTendermintPower(9), oldPool.BondedTokens.Sub(newPool.BondedTokens))
+	require.Equal(t, sdk.TokensFromTendermintPower(9), oldBonded.Sub(newBonded))
 	// read updated validator
 	validator, found = keeper.GetValidatorByConsAddr(ctx, consAddr)
 	require.True(t, found)
@@ -323,9 +326,9 @@ func TestSlashWithUnbondingDelegation(t *testing.T) {
 	// balance unchanged
 	require.Equal(t, sdk.NewInt(0), ubd.Entries[0].Balance)
 	// read updated pool
-	newPool = keeper.GetPool(ctx)
+	newBonded = keeper.supplyKeeper.GetModuleAccountCoins(ctx, types.BondedPoolName).AmountOf(keeper.BondDenom(ctx))
 	// just 1 bonded token burned again since that's all the validator now has
-	require.Equal(t, sdk.TokensFromTendermintPower(10), oldPool.BondedTokens.Sub(newPool.BondedTokens))
+	require.Equal(t, sdk.TokensFromTendermintPower(10), oldBonded.Sub(newBonded))
 	// apply TM updates
 	keeper.ApplyAndReturnValidatorSetUpdates(ctx)
 	// read updated validator
@@ -352,13 +355,11 @@ func TestSlashWithRedelegation(t *testing.T) {
 	keeper.SetDelegation(ctx, del)
 
 	// update bonded tokens
-	pool := keeper.GetPool(ctx)
-	pool.BondedTokens = pool.BondedTokens.Add(rdTokens)
-	keeper.SetPool(ctx, pool)
+	require.NoError(t, keeper.supplyKeeper.SetModuleAccountCoins(ctx, types.BondedPoolName, keeper.supplyKeeper.GetModuleAccountCoins(ctx, types.BondedPoolName).Add(sdk.NewCoins(sdk.NewCoin(keeper.BondDenom(ctx), rdTokens)))))
 
 	// slash validator
 	ctx = ctx.WithBlockHeight(12)
-	oldPool := keeper.GetPool(ctx)
+	oldBonded := keeper.supplyKeeper.GetModuleAccountCoins(ctx, types.BondedPoolName).AmountOf(keeper.BondDenom(ctx))
 	validator, found := keeper.GetValidatorByConsAddr(ctx, consAddr)
 	require.True(t, found)
 	keeper.Slash(ctx, consAddr, 10, 10, fraction)
@@ -368,9 +369,9 @@ func TestSlashWithRedelegation(t *testing.T) {
 	require.True(t, found)
 	require.Len(t, rd.Entries, 1)
 	// read updated pool
-	newPool := keeper.GetPool(ctx)
+	newBonded := keeper.supplyKeeper.GetModuleAccountCoins(ctx, types.BondedPoolName).AmountOf(keeper.BondDenom(ctx))
 	// bonded tokens burned
-	require.Equal(t, sdk.TokensFromTendermintPower(5), oldPool.BondedTokens.Sub(newPool.BondedTokens))
+	require.Equal(t, sdk.TokensFromTendermintPower(5), oldBonded.Sub(newBonded))
 	// read updated validator
 	validator, found = keeper.GetValidatorByConsAddr(ctx, consAddr)
 	require.True(t, found)
@@ -391,9 +392,9 @@ func TestSlashWithRedelegation(t *testing.T) {
 	require.True(t, found)
 	require.Len(t, rd.Entries, 1)
 	// read updated pool
-	newPool = keeper.GetPool(ctx)
+	newBonded = keeper.supplyKeeper.GetModuleAccountCoins(ctx, types.BondedPoolName).AmountOf(keeper.BondDenom(ctx))
 	// seven bonded tokens burned
-	require.Equal(t, sdk.TokensFromTendermintPower(12), oldPool.BondedTokens.Sub(newPool.BondedTokens))
+	require.Equal(t, sdk.TokensFromTendermintPower(12), oldBonded.Sub(newBonded))
 	// read updated validator
 	validator, found = keeper.GetValidatorByConsAddr(ctx, consAddr)
 	require.True(t, found)
@@ -411,9 +412,9 @@ func TestSlashWithRedelegation(t *testing.T) {
 	require.True(t, found)
 	require.Len(t, rd.Entries, 1)
 	// read updated pool
-	newPool = keeper.GetPool(ctx)
+	newBonded = keeper.supplyKeeper.GetModuleAccountCoins(ctx, types.BondedPoolName).AmountOf(keeper.BondDenom(ctx))
 	// four more bonded tokens burned
-	require.Equal(t, sdk.TokensFromTendermintPower(16), oldPool.BondedTokens.Sub(newPool.BondedTokens))
+	require.Equal(t, sdk.TokensFromTendermintPower(16), oldBonded.Sub(newBonded))
 	// apply TM updates
 	keeper.ApplyAndReturnValidatorSetUpdates(ctx)
 	// read updated validator
@@ -434,9 +435,9 @@ func TestSlashWithRedelegation(t *testing.T) {
 	require.True(t, found)
 	require.Len(t, rd.Entries, 1)
 	// read updated pool
-	newPool = keeper.GetPool(ctx)
+	newBonded = keeper.supplyKeeper.GetModuleAccountCoins(ctx, types.BondedPoolName).AmountOf(keeper.BondDenom(ctx))
 	// no more bonded tokens burned
-	require.Equal(t, sdk.TokensFromTendermintPower(16), oldPool.BondedTokens.Sub(newPool.BondedTokens))
+	require.Equal(t, sdk.TokensFromTendermintPower(16), oldBonded.Sub(newBonded))
 	// read updated validator
 	// power still zero, still in unbonding period
 	validator, _ = keeper.GetValidatorByConsAddr(ctx, consAddr)
@@ -467,9 +468,13 @@ func TestSlashBoth(t *testing.T) {
 		time.Unix(0, 0), ubdATokens)
 	keeper.SetUnbondingDelegation(ctx, ubdA)
 
+	// the unbonding tokens are held by the not bonded pool
+	require.NoError(t, keeper.supplyKeeper.SetModuleAccountCoins(ctx, types.NotBondedPoolName, sdk.NewCoins(sdk.NewCoin(keeper.BondDenom(ctx), ubdATokens))))
+
 	// slash validator
 	ctx = ctx.WithBlockHeight(12)
-	oldPool := keeper.GetPool(ctx)
+	oldNotBonded := keeper.supplyKeeper.GetModuleAccountCoins(ctx, types.NotBondedPoolName).AmountOf(keeper.BondDenom(ctx))
+	oldBonded := keeper.supplyKeeper.GetModuleAccountCoins(ctx, types.BondedPoolName).AmountOf(keeper.BondDenom(ctx))
 	validator, found := keeper.GetValidatorByConsAddr(ctx, sdk.GetConsAddress(PKs[0]))
 	require.True(t, found)
 	consAddr0 := sdk.ConsAddress(PKs[0].Address())
@@ -480,11 +485,12 @@ func TestSlashBoth(t *testing.T) {
 	require.True(t, found)
 	require.Len(t, rdA.Entries, 1)
 	// read updated pool
-	newPool := keeper.GetPool(ctx)
+	newNotBonded := keeper.supplyKeeper.GetModuleAccountCoins(ctx, types.NotBondedPoolName).AmountOf(keeper.BondDenom(ctx))
+	newBonded := keeper.supplyKeeper.GetModuleAccountCoins(ctx, types.BondedPoolName).AmountOf(keeper.BondDenom(ctx))
 	// not-bonded tokens burned
-	require.Equal(t, sdk.TokensFromTendermintPower(2), oldPool.NotBondedTokens.Sub(newPool.NotBondedTokens))
+	require.Equal(t, sdk.TokensFromTendermintPower(2), oldNotBonded.Sub(newNotBonded))
 	// bonded tokens burned
-	require.Equal(t, sdk.TokensFromTendermintPower(3), oldPool.BondedTokens.Sub(newPool.BondedTokens))
+	require.Equal(t, sdk.TokensFromTendermintPower(3), oldBonded.Sub(newBonded))
 	// read updated validator
 	validator, found = keeper.GetValidatorByConsAddr(ctx, sdk.GetConsAddress(PKs[0]))
 	require.True(t, found)
@@ -23,6 +23,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/x/bank"
 	"github.com/cosmos/cosmos-sdk/x/params"
 	"github.com/cosmos/cosmos-sdk/x/staking/types"
+	"github.com/cosmos/cosmos-sdk/x/supply"
 )
 
 // dummy addresses used for testing
@@ -68,7 +69,10 @@ func MakeTestCodec() *codec.Codec {
 
 	// Register AppAccount
 	cdc.RegisterInterface((*auth.Account)(nil), nil)
-	cdc.RegisterConcrete(&auth.BaseAccount{}, "test/staking/Account", nil)
+	cdc.RegisterConcrete(&auth.BaseAccount{}, "test/staking/BaseAccount", nil)
+	cdc.RegisterConcrete(&auth.ModuleAccount{}, "test/staking/ModuleAccount", nil)
+	cdc.RegisterConcrete(&auth.DelayedVestingAccount{}, "test/staking/DelayedVestingAccount", nil)
+	supply.RegisterCodec(cdc)
 	codec.RegisterCrypto(cdc)
 
 	return cdc
@@ -77,7 +81,7 @@ func MakeTestCodec() *codec.Codec {
 // Hogpodge of all sorts of input required for testing.
 // `initPower` is converted to an amount of tokens.
 // If `initPower` is 0, no addrs get created.
-func CreateTestInput(t *testing.T, isCheckTx bool, initPower int64) (sdk.Context, auth.AccountKeeper, Keeper) {
+func CreateTestInput(t *testing.T, isCheckTx bool, initPower int64) (sdk.Context, auth.AccountKeeper, bank.Keeper, Keeper, types.SupplyKeeper) {
 
 	initCoins := sdk.TokensFromTendermintPower(initPower)
 
@@ -86,6 +90,8 @@ func CreateTestInput(t *testing.T, isCheckTx bool, initPower int64) (sdk.Context
 	keyAcc := sdk.NewKVStoreKey(auth.StoreKey)
 	keyParams := sdk.NewKVStoreKey(params.StoreKey)
 	tkeyParams := sdk.NewTransientStoreKey(params.TStoreKey)
+	keyBank := sdk.NewKVStoreKey(bank.StoreKey)
+	keySupply := sdk.NewKVStoreKey(supply.StoreKey)
 
 	db := dbm.NewMemDB()
 	ms := store.NewCommitMultiStore(db)
@@ -94,6 +100,8 @@ func CreateTestInput(t *testing.T, isCheckTx bool, initPower int64) (sdk.Context
 	ms.MountStoreWithDB(keyAcc, sdk.StoreTypeIAVL, db)
 	ms.MountStoreWithDB(keyParams, sdk.StoreTypeIAVL, db)
 	ms.MountStoreWithDB(tkeyParams, sdk.StoreTypeTransient, db)
+	ms.MountStoreWithDB(keyBank, sdk.StoreTypeIAVL, db)
+	ms.MountStoreWithDB(keySupply, sdk.StoreTypeIAVL, db)
 	err := ms.LoadLatestVersion()
 	require.Nil(t, err)
 
@@ -116,31 +124,61 @@ func CreateTestInput(t *testing.T, isCheckTx bool, initPower int64) (sdk.Context
 		auth.ProtoBaseAccount, // prototype
 	)
 
-	ck := bank.NewBaseKeeper(
+	feeCollectorAcc := auth.NewEmptyModuleAccount(auth.FeeCollectorName)
+	notBondedPool := auth.NewEmptyModuleAccount(types.NotBondedPoolName, auth.Burner, auth.Staking)
+	bondPool := auth.NewEmptyModuleAccount(types.BondedPoolName, auth.Burner, auth.Staking)
+
+	blacklistedAddrs := make(map[string]bool)
+	blacklistedAddrs[feeCollectorAcc.GetAddress().String()] = true
+	blacklistedAddrs[notBondedPool.GetAddress().String()] = true
+	blacklistedAddrs[bondPool.GetAddress().String()] = true
+
+	bk := bank.NewBaseKeeper(
+		cdc,
+		keyBank,
 		accountKeeper,
 		pk.Subspace(bank.DefaultParamspace),
 		bank.DefaultCodespace,
+		blacklistedAddrs,
 	)
 
-	keeper := NewKeeper(cdc, keyStaking, tkeyStaking, ck, pk.Subspace(DefaultParamspace), types.DefaultCodespace)
-	keeper.SetPool(ctx, types.InitialPool())
+	maccPerms := map[string][]string{
+		auth.FeeCollectorName:         nil,
+		types.NotBondedPoolName:       {auth.Burner, auth.Staking},
+		types.BondedPoolName:          {auth.Burner, auth.Staking},
+		types.TokenizedSharesPoolName: {auth.Minter, auth.Burner},
+	}
+	supplyKeeper := supply.NewKeeper(cdc, keySupply, accountKeeper, bk, maccPerms)
+
+	// the not bonded pool holds as many tokens as all the accounts combined, so
+	// tests can add tokens to validators directly and still bond them
+	accTokens := sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, initCoins.MulRaw(int64(len(Addrs)))))
+	totalSupply := accTokens.Add(accTokens)
+	supplyKeeper.SetSupply(ctx, supply.NewSupply(totalSupply))
+
+	keeper := NewKeeper(cdc, keyStaking, tkeyStaking, accountKeeper, supplyKeeper, pk.Subspace(DefaultParamspace), types.DefaultCodespace)
 	keeper.SetParams(ctx, types.DefaultParams())
 
-	// fill all the addresses with some coins, set the loose pool tokens simultaneously
+	// fill all the addresses with some coins
 	for _, addr := range Addrs {
-		pool := keeper.GetPool(ctx)
 		err := error(nil)
 		if !initCoins.IsZero() {
-			_, err = ck.AddCoins(ctx, addr, sdk.Coins{
+			_, err = bk.AddCoins(ctx, addr, sdk.Coins{
 				{keeper.BondDenom(ctx), initCoins},
 			})
 		}
 		require.Nil(t, err)
-		pool.NotBondedTokens = pool.NotBondedTokens.Add(initCoins)
-		keeper.SetPool(ctx, pool)
 	}
 
-	return ctx, accountKeeper, keeper
+	// set module accounts
+	supplyKeeper.SetModuleAccount(ctx, feeCollectorAcc)
+	supplyKeeper.SetModuleAccount(ctx, notBondedPool)
+	supplyKeeper.SetModuleAccount(ctx, bondPool)
+
+	err = supplyKeeper.SetModuleAccountCoins(ctx, types.NotBondedPoolName, accTokens)
+	require.NoError(t, err)
+
+	return ctx, accountKeeper, bk, keeper, supplyKeeper
 }
 
 func NewPubKey(pk string) (res crypto.PubKey) {
@@ -0,0 +1,330 @@
+package keeper
+
+import (
+	"bytes"
+	"encoding/binary"
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/auth"
+	"github.com/cosmos/cosmos-sdk/x/staking/types"
+)
+
+// get the tokenized shares record with the given ID
+func (k Keeper) GetTokenizedSharesRecord(ctx sdk.Context, id uint64) (record types.TokenizedSharesRecord, found bool) {
+	store := ctx.KVStore(k.storeKey)
+	value := store.Get(types.GetTokenizedSharesRecordKey(id))
+	if value == nil {
+		return record, false
+	}
+
+	k.cdc.MustUnmarshalBinaryLengthPrefixed(value, &record)
+	return record, true
+}
+
+// get the tokenized shares record of a validator
+func (k Keeper) GetTokenizedSharesRecordByValidator(ctx sdk.Context,
+	valAddr sdk.ValAddress) (record types.TokenizedSharesRecord, found bool) {
+
+	store := ctx.KVStore(k.storeKey)
+	value := store.Get(types.GetTokenizedSharesByValIndexKey(valAddr))
+	if value == nil {
+		return record, false
+	}
+
+	return k.GetTokenizedSharesRecord(ctx, binary.BigEndian.Uint64(value))
+}
+
+// set a tokenized shares record and its validator index
+func (k Keeper) SetTokenizedSharesRecord(ctx sdk.Context, record types.TokenizedSharesRecord) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.GetTokenizedSharesRecordKey(record.ID), k.cdc.MustMarshalBinaryLengthPrefixed(record))
+	store.Set(types.GetTokenizedSharesByValIndexKey(record.ValidatorAddress), sdk.Uint64ToBigEndian(record.ID))
+}
+
+// delete the tokenized shares record of a validator, its ID is not reused
+func (k Keeper) deleteTokenizedSharesRecord(ctx sdk.Context, valAddr sdk.ValAddress) {
+	record, found := k.GetTokenizedSharesRecordByValidator(ctx, valAddr)
+	if !found {
+		return
+	}
+
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.GetTokenizedSharesRecordKey(record.ID))
+	store.Delete(types.GetTokenizedSharesByValIndexKey(valAddr))
+}
+
+// get the ID of the last tokenized shares record
+func (k Keeper) GetLastTokenizedSharesRecordID(ctx sdk.Context) uint64 {
+	store := ctx.KVStore(k.storeKey)
+	value := store.Get(types.LastTokenizedSharesRecordIDKey)
+	if value == nil {
+		return 0
+	}
+
+	return binary.BigEndian.Uint64(value)
+}
+
+// set the ID of the last tokenized shares record
+func (k Keeper) SetLastTokenizedSharesRecordID(ctx sdk.Context, id uint64) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.LastTokenizedSharesRecordIDKey, sdk.Uint64ToBigEndian(id))
+}
+
+// iterate through the tokenized shares records, ordered by ID
+func (k Keeper) IterateTokenizedSharesRecords(ctx sdk.Context, fn func(record types.TokenizedSharesRecord) (stop bool)) {
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, types.TokenizedSharesRecordKey)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var record types.TokenizedSharesRecord
+		k.cdc.MustUnmarshalBinaryLengthPrefixed(iterator.Value(), &record)
+		if fn(record) {
+			break
+		}
+	}
+}
+
+// TokenizeShares converts the shares of a delegation worth the given amount of
+// tokens into share tokens of the validator, sent to the delegator. The shares
+// are moved to the delegation of the tokenized shares pool, and the share
+// tokens are minted at the rate of the tokens already in circulation to the
+// shares held by the pool.
+func (k Keeper) TokenizeShares(ctx sdk.Context, delAddr sdk.AccAddress, valAddr sdk.ValAddress,
+	amount sdk.Int) (shareTokens sdk.Coin, err sdk.Error) {
+
+	validator, found := k.GetValidator(ctx, valAddr)
+	if !found {
+		return shareTokens, types.ErrNoValidatorFound(k.Codespace())
+	}
+
+	// the shares of an incoming redelegation must remain slashable for the
+	// infractions of the source validator
+	if k.HasReceivingRedelegation(ctx, delAddr, valAddr) {
+		return shareTokens, types.ErrTokenizeReceivingRedelegation(k.Codespace())
+	}
+
+	// the delegations of a vesting account may hold its locked coins, which
+	// must not be turned into liquid share tokens
+	if acc, ok := k.accountKeeper.GetAccount(ctx, delAddr).(auth.VestingAccount); ok &&
+		!acc.GetVestingCoins(ctx.BlockHeader().Time).IsZero() {
+		return shareTokens, types.ErrTokenizeVestingDelegation(k.Codespace())
+	}
+
+	// the first tokenization of the shares of a validator creates its record,
+	// with the next ID so that its share tokens get a denom of their own
+	record, recordFound := k.GetTokenizedSharesRecordByValidator(ctx, valAddr)
+	if !recordFound {
+		record = types.NewTokenizedSharesRecord(k.GetLastTokenizedSharesRecordID(ctx)+1, valAddr, sdk.NewCoins())
+	}
+
+	// settle the rewards of the shares already tokenized at the current rate
+	validator, record, err = k.withdrawTokenizedSharesRewards(ctx, validator, record)
+	if err != nil {
+		return shareTokens, err
+	}
+
+	shares, err := k.ValidateUnbondAmount(ctx, delAddr, valAddr, amount)
+	if err != nil {
+		return shareTokens, err
+	}
+
+	poolShares, supply := k.tokenizedSharesRate(ctx, record)
+
+	tokens := shares.TruncateInt()
+	if poolShares.IsPositive() && supply.IsPositive() {
+		tokens = shares.MulInt(supply).Quo(poolShares).TruncateInt()
+	}
+	if !tokens.IsPositive() {
+		return shareTokens, types.ErrTokenizeSharesAmountTooSmall(k.Codespace())
+	}
+
+	poolAddr := k.GetTokenizedSharesPool(ctx).GetAddress()
+	if err := k.transferDelegationShares(ctx, delAddr, poolAddr, validator, shares); err != nil {
+		return shareTokens, err
+	}
+
+	if !recordFound {
+		k.SetLastTokenizedSharesRecordID(ctx, record.ID)
+	}
+	k.SetTokenizedSharesRecord(ctx, record)
+
+	shareTokens = sdk.NewCoin(record.GetShareTokenDenom(), tokens)
+	coins := sdk.NewCoins(shareTokens)
+	if err := k.supplyKeeper.MintCoins(ctx, types.TokenizedSharesPoolName, coins); err != nil {
+		return shareTokens, err
+	}
+	if err := k.supplyKeeper.SendCoinsFromModuleToAccount(ctx, types.TokenizedSharesPoolName, delAddr, coins); err != nil {
+		return shareTokens, err
+	}
+
+	return shareTokens, nil
+}
+
+// RedeemTokensForShares burns share tokens of a validator and moves their
+// part of the shares held by the tokenized shares pool to the delegation of
+// the redeemer, along with their part of the rewards held for the shares.
+func (k Keeper) RedeemTokensForShares(ctx sdk.Context, delAddr sdk.AccAddress,
+	shareTokens sdk.Coin) (valAddr sdk.ValAddress, err sdk.Error) {
+
+	id, ok := types.TokenizedSharesRecordIDFromDenom(shareTokens.Denom)
+	if !ok {
+		return valAddr, types.ErrNotTokenizedSharesDenom(k.Codespace(), shareTokens.Denom)
+	}
+	record, found := k.GetTokenizedSharesRecord(ctx, id)
+	if !found {
+		return valAddr, types.ErrNotTokenizedSharesDenom(k.Codespace(), shareTokens.Denom)
+	}
+	valAddr = record.ValidatorAddress
+
+	validator, found := k.GetValidator(ctx, valAddr)
+	if !found {
+		return valAddr, types.ErrNoValidatorFound(k.Codespace())
+	}
+
+	validator, record, err = k.withdrawTokenizedSharesRewards(ctx, validator, record)
+	if err != nil {
+		return valAddr, err
+	}
+
+	poolShares, supply := k.tokenizedSharesRate(ctx, record)
+	if shareTokens.Amount.GT(supply) {
+		return valAddr, sdk.ErrInsufficientCoins(fmt.Sprintf("insufficient share tokens: %s < %s",
+			sdk.NewCoin(shareTokens.Denom, supply), shareTokens))
+	}
+
+	// the last share tokens redeem all the remaining shares and rewards
+	shares := poolShares
+	rewards := record.Rewards
+	if shareTokens.Amount.LT(supply) {
+		shares = poolShares.MulInt(shareTokens.Amount).QuoInt(supply)
+
+		var redeemed sdk.Coins
+		for _, reward := range rewards {
+			redeemed = append(redeemed, sdk.NewCoin(reward.Denom, reward.Amount.Mul(shareTokens.Amount).Quo(supply)))
+		}
+		rewards = sdk.NewCoins(redeemed...)
+	}
+	if !shares.IsPositive() {
+		return valAddr, types.ErrTokenizeSharesAmountTooSmall(k.Codespace())
+	}
+
+	coins := sdk.NewCoins(shareTokens)
+	if err := k.supplyKeeper.SendCoinsFromAccountToModule(ctx, delAddr, types.TokenizedSharesPoolName, coins); err != nil {
+		return valAddr, err
+	}
+	if err := k.supplyKeeper.BurnCoins(ctx, types.TokenizedSharesPoolName, coins); err != nil {
+		return valAddr, err
+	}
+
+	poolAddr := k.GetTokenizedSharesPool(ctx).GetAddress()
+	if err := k.transferDelegationShares(ctx, poolAddr, delAddr, validator, shares); err != nil {
+		return valAddr, err
+	}
+
+	record.Rewards = record.Rewards.Sub(rewards)
+	k.SetTokenizedSharesRecord(ctx, record)
+
+	if !rewards.IsZero() {
+		if err := k.supplyKeeper.SendCoinsFromModuleToAccount(ctx, types.TokenizedSharesPoolName, delAddr, rewards); err != nil {
+			return valAddr, err
+		}
+	}
+
+	return valAddr, nil
+}
+
+// tokenizedSharesRate returns the shares of the validator of a tokenized
+// shares record held by the tokenized shares pool and the supply of the share
+// tokens of the record
+func (k Keeper) tokenizedSharesRate(ctx sdk.Context, record types.TokenizedSharesRecord) (poolShares sdk.Dec, supply sdk.Int) {
+	poolShares = sdk.ZeroDec()
+	if delegation, found := k.GetDelegation(ctx, k.GetTokenizedSharesPool(ctx).GetAddress(), record.ValidatorAddress); found {
+		poolShares = delegation.Shares
+	}
+
+	supply = k.supplyKeeper.GetSupply(ctx).Total.AmountOf(record.GetShareTokenDenom())
+	return poolShares, supply
+}
+
+// withdrawTokenizedSharesRewards withdraws the distribution rewards of the
+// tokenized shares of a validator. Rewards in the bond denom are delegated
+// back to the validator, which raises the value of the share tokens, while
+// the other rewards are added to the record, held for the holders of the share
+// tokens until they redeem them.
+func (k Keeper) withdrawTokenizedSharesRewards(ctx sdk.Context, validator types.Validator,
+	record types.TokenizedSharesRecord) (types.Validator, types.TokenizedSharesRecord, sdk.Error) {
+
+	poolAddr := k.GetTokenizedSharesPool(ctx).GetAddress()
+	if _, found := k.GetDelegation(ctx, poolAddr, validator.OperatorAddress); !found {
+		return validator, record, nil
+	}
+
+	// the hooks withdraw the rewards of the delegation to the pool
+	before := k.supplyKeeper.GetModuleAccountCoins(ctx, types.TokenizedSharesPoolName)
+	k.BeforeDelegationSharesModified(ctx, poolAddr, validator.OperatorAddress)
+	k.AfterDelegationModified(ctx, poolAddr, validator.OperatorAddress)
+	rewards := k.supplyKeeper.GetModuleAccountCoins(ctx, types.TokenizedSharesPoolName).Sub(before)
+
+	bondAmt := rewards.AmountOf(k.BondDenom(ctx))
+	if bondAmt.IsPositive() && !validator.InvalidExRate() {
+		if _, err := k.Delegate(ctx, poolAddr, bondAmt, sdk.Unbonded, validator, true); err != nil {
+			return validator, record, err
+		}
+
+		rewards = rewards.Sub(sdk.NewCoins(sdk.NewCoin(k.BondDenom(ctx), bondAmt)))
+		validator = k.mustGetValidator(ctx, validator.OperatorAddress)
+	}
+
+	record.Rewards = record.Rewards.Add(rewards)
+	return validator, record, nil
+}
+
+// transferDelegationShares moves shares from a delegation to another one of
+// the same validator, leaving the tokens of the validator untouched
+func (k Keeper) transferDelegationShares(ctx sdk.Context, srcAddr, dstAddr sdk.AccAddress,
+	validator types.Validator, shares sdk.Dec) sdk.Error {
+
+	valAddr := validator.OperatorAddress
+
+	srcDelegation, found := k.GetDelegation(ctx, srcAddr, valAddr)
+	if !found {
+		return types.ErrNoDelegatorForAddress(k.Codespace())
+	}
+	if srcDelegation.Shares.LT(shares) {
+		return types.ErrNotEnoughDelegationShares(k.Codespace(), srcDelegation.Shares.String())
+	}
+
+	k.BeforeDelegationSharesModified(ctx, srcAddr, valAddr)
+	srcDelegation.Shares = srcDelegation.Shares.Sub(shares)
+
+	// moving the self delegation of the operator below its minimum jails the
+	// validator, as when unbonding it
+	if bytes.Equal(srcAddr, validator.OperatorAddress) && !validator.Jailed &&
+		validator.TokensFromShares(srcDelegation.Shares).TruncateInt().LT(validator.MinSelfDelegation) {
+
+		k.jailValidator(ctx, validator)
+	}
+
+	if srcDelegation.Shares.IsZero() {
+		k.RemoveDelegation(ctx, srcDelegation)
+	} else {
+		k.SetDelegation(ctx, srcDelegation)
+		k.AfterDelegationModified(ctx, srcAddr, valAddr)
+	}
+
+	dstDelegation, found := k.GetDelegation(ctx, dstAddr, valAddr)
+	if found {
+		k.BeforeDelegationSharesModified(ctx, dstAddr, valAddr)
+	} else {
+		dstDelegation = types.NewDelegation(dstAddr, valAddr, sdk.ZeroDec())
+		k.BeforeDelegationCreated(ctx, dstAddr, valAddr)
+	}
+
+	dstDelegation.Shares = dstDelegation.Shares.Add(shares)
+	k.SetDelegation(ctx, dstDelegation)
+	k.AfterDelegationModified(ctx, dstAddr, valAddr)
+
+	return nil
+}
@@ -0,0 +1,59 @@
+package keeper
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/auth"
+	"github.com/cosmos/cosmos-sdk/x/staking/types"
+)
+
+func TestTokenizeSharesVestingAccount(t *testing.T) {
+	ctx, ak, bk, keeper, _ := CreateTestInput(t, false, 1000)
+	ctx = ctx.WithBlockTime(time.Unix(1000, 0))
+	denom := keeper.BondDenom(ctx)
+
+	validator := types.NewValidator(addrVals[0], PKs[0], types.Description{})
+	keeper.SetValidator(ctx, validator)
+
+	// the coins of the delegator vest in an hour
+	delAddr := addrDels[0]
+	vestingAmt := sdk.TokensFromTendermintPower(100)
+	endTime := ctx.BlockHeader().Time.Add(time.Hour)
+
+	acc := ak.GetAccount(ctx, delAddr).(*auth.BaseAccount)
+	vacc := auth.NewDelayedVestingAccount(acc, sdk.NewCoins(sdk.NewCoin(denom, vestingAmt)), endTime.Unix())
+	ak.SetAccount(ctx, vacc)
+
+	// the delegation holds both vesting and free coins
+	delAmt := vestingAmt.MulRaw(2)
+	_, err := keeper.Delegate(ctx, delAddr, delAmt, sdk.Unbonded, validator, true)
+	require.NoError(t, err)
+
+	vacc = ak.GetAccount(ctx, delAddr).(*auth.DelayedVestingAccount)
+	require.Equal(t, vestingAmt, vacc.GetDelegatedVesting().AmountOf(denom))
+
+	// the shares can't be tokenized while coins are vesting
+	_, err = keeper.TokenizeShares(ctx, delAddr, addrVals[0], vestingAmt)
+	require.Error(t, err)
+	require.Equal(t, types.CodeInvalidDelegation, err.Code())
+
+	delegation, found := keeper.GetDelegation(ctx, delAddr, addrVals[0])
+	require.True(t, found)
+	require.Equal(t, sdk.NewDecFromInt(delAmt), delegation.Shares)
+	_, found = keeper.GetTokenizedSharesRecordByValidator(ctx, addrVals[0])
+	require.False(t, found)
+
+	// the shares can be tokenized once the coins have vested
+	ctx = ctx.WithBlockTime(endTime)
+	shareTokens, err := keeper.TokenizeShares(ctx, delAddr, addrVals[0], vestingAmt)
+	require.NoError(t, err)
+	require.Equal(t, vestingAmt, bk.GetCoins(ctx, delAddr).AmountOf(shareTokens.Denom))
+
+	delegation, found = keeper.GetDelegation(ctx, delAddr, addrVals[0])
+	require.True(t, found)
+	require.Equal(t, sdk.NewDecFromInt(delAmt.Sub(vestingAmt)), delegation.Shares)
+}
@@ -6,12 +6,14 @@ import (
 	"sort"
 
 	abci "github.com/tendermint/tendermint/abci/types"
+	tmtypes "github.com/tendermint/tendermint/types"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/x/staking/types"
 )
 
 // Apply and return accumulated updates to the bonded validator set. Also,
+// * Applies the pending consensus pubkey rotations.
 // * Updates the active valset as keyed by LastValidatorPowerKey.
 // * Updates the total power as keyed by LastTotalPowerKey.
 // * Updates validator status' according to updated powers.
@@ -34,6 +36,10 @@ func (k Keeper) ApplyAndReturnValidatorSetUpdates(ctx sdk.Context) (updates []ab
 	// (see LastValidatorPowerKey).
 	last := k.getLastValidatorsByAddr(ctx)
 
+	// Rotate the consensus pubkeys. The previous pubkeys of the rotated
+	// validators of the last validator set are removed from Tendermint.
+	oldPubKeys := k.applyConsPubKeyRotations(ctx)
+
 	// Iterate over validators, highest power to lowest.
 	iterator := sdk.KVStoreReversePrefixIterator(store, types.ValidatorsByPowerIndexKey)
 	defer iterator.Close()
@@ -74,8 +80,12 @@ func (k Keeper) ApplyAndReturnValidatorSetUpdates(ctx sdk.Context) (updates []ab
 		newPower := validator.TendermintPower()
 		newPowerBytes := k.cdc.MustMarshalBinaryLengthPrefixed(newPower)
 
-		// update the validator set if power has changed
-		if !found || !bytes.Equal(oldPowerBytes, newPowerBytes) {
+		// update the validator set if power or consensus pubkey has changed
+		oldPubKey, rotated := oldPubKeys[valAddrBytes]
+		if !found || rotated || !bytes.Equal(oldPowerBytes, newPowerBytes) {
+			if found && rotated {
+				updates = append(updates, abci.ValidatorUpdate{PubKey: tmtypes.TM2PB.PubKey(oldPubKey), Power: 0})
+			}
 			updates = append(updates, validator.ABCIValidatorUpdate())
 
 			// set validator power on lookup index
@@ -105,8 +115,15 @@ func (k Keeper) ApplyAndReturnValidatorSetUpdates(ctx sdk.Context) (updates []ab
 		// delete from the bonded validator index
 		k.DeleteLastValidatorPower(ctx, sdk.ValAddress(valAddrBytes))
 
-		// update the validator set
-		updates = append(updates, validator.ABCIValidatorUpdateZero())
+		// update the validator set, Tendermint only knows the previous
+		// consensus pubkey of a validator rotated in this block
+		var valAddr [sdk.AddrLen]byte
+		copy(valAddr[:], valAddrBytes)
+		if oldPubKey, rotated := oldPubKeys[valAddr]; rotated {
+			updates = append(updates, abci.ValidatorUpdate{PubKey: tmtypes.TM2PB.PubKey(oldPubKey), Power: 0})
+		} else {
+			updates = append(updates, validator.ABCIValidatorUpdateZero())
+		}
 	}
 
 	// set total power on lookup index if there are any updates
@@ -176,10 +193,9 @@ func (k Keeper) bondValidator(ctx sdk.Context, validator types.Validator) types.
 	// delete the validator by power index, as the key will change
 	k.DeleteValidatorByPowerIndex(ctx, validator)
 
-	// set the status
-	pool := k.GetPool(ctx)
-	validator, pool = validator.UpdateStatus(pool, sdk.Bonded)
-	k.SetPool(ctx, pool)
+	// set the status and move the validator tokens to the bonded pool
+	validator = validator.UpdateStatus(sdk.Bonded)
+	k.notBondedTokensToBonded(ctx, validator.Tokens)
 
 	// save the now bonded validator record to the two referenced stores
 	k.SetValidator(ctx, validator)
@@ -207,10 +223,9 @@ func (k Keeper) beginUnbondingValidator(ctx sdk.Context, validator types.Validat
 		panic(fmt.Sprintf("should not already be unbonded or unbonding, validator: %v\n", validator))
 	}
 
-	// set the status
-	pool := k.GetPool(ctx)
-	validator, pool = validator.UpdateStatus(pool, sdk.Unbonding)
-	k.SetPool(ctx, pool)
+	// set the status and move the validator tokens to the not bonded pool
+	validator = validator.UpdateStatus(sdk.Unbonding)
+	k.bondedTokensToNotBonded(ctx, validator.Tokens)
 
 	// set the unbonding completion time and completion height appropriately
 	validator.UnbondingCompletionTime = ctx.BlockHeader().Time.Add(params.UnbondingTime)
@@ -231,9 +246,7 @@ func (k Keeper) beginUnbondingValidator(ctx sdk.Context, validator types.Validat
 
 // perform all the store operations for when a validator status becomes unbonded
 func (k Keeper) completeUnbondingValidator(ctx sdk.Context, validator types.Validator) types.Validator {
-	pool := k.GetPool(ctx)
-	validator, pool = validator.UpdateStatus(pool, sdk.Unbonded)
-	k.SetPool(ctx, pool)
+	validator = validator.UpdateStatus(sdk.Unbonded)
 	k.SetValidator(ctx, validator)
 	return validator
 }
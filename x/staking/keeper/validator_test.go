@@ -17,8 +17,7 @@ import (
 //_______________________________________________________
 
 func TestSetValidator(t *testing.T) {
-	ctx, _, keeper := CreateTestInput(t, false, 10)
-	pool := keeper.GetPool(ctx)
+	ctx, _, _, keeper, _ := CreateTestInput(t, false, 10)
 
 	valPubKey := PKs[0]
 	valAddr := sdk.ValAddress(valPubKey.Address().Bytes())
@@ -26,11 +25,10 @@ func TestSetValidator(t *testing.T) {
 
 	// test how the validator is set from a purely unbonbed pool
 	validator := types.NewValidator(valAddr, valPubKey, types.Description{})
-	validator, pool, _ = validator.AddTokensFromDel(pool, valTokens)
+	validator, _ = validator.AddTokensFromDel(valTokens)
 	require.Equal(t, sdk.Unbonded, validator.Status)
 	assert.Equal(t, valTokens, validator.Tokens)
 	assert.Equal(t, valTokens, validator.DelegatorShares.RoundInt())
-	keeper.SetPool(ctx, pool)
 	keeper.SetValidator(ctx, validator)
 	keeper.SetValidatorByPowerIndex(ctx, validator)
 
@@ -72,38 +70,31 @@ func TestSetValidator(t *testing.T) {
 }
 
 func TestUpdateValidatorByPowerIndex(t *testing.T) {
-	ctx, _, keeper := CreateTestInput(t, false, 0)
-	pool := keeper.GetPool(ctx)
+	ctx, _, _, keeper, _ := CreateTestInput(t, false, 0)
 
-	// create a random pool
-	pool.NotBondedTokens = sdk.NewInt(10000)
-	pool.BondedTokens = sdk.NewInt(1234)
-	keeper.SetPool(ctx, pool)
+	// fund the not bonded pool
+	require.NoError(t, keeper.supplyKeeper.SetModuleAccountCoins(ctx, types.NotBondedPoolName, sdk.NewCoins(sdk.NewCoin(keeper.BondDenom(ctx), sdk.NewInt(10000)))))
 
 	// add a validator
 	validator := types.NewValidator(addrVals[0], PKs[0], types.Description{})
-	validator, pool, delSharesCreated := validator.AddTokensFromDel(pool, sdk.NewInt(100))
+	validator, delSharesCreated := validator.AddTokensFromDel(sdk.NewInt(100))
 	require.Equal(t, sdk.Unbonded, validator.Status)
 	require.Equal(t, int64(100), validator.Tokens.Int64())
-	keeper.SetPool(ctx, pool)
 	TestingUpdateValidator(keeper, ctx, validator, true)
 	validator, found := keeper.GetValidator(ctx, addrVals[0])
 	require.True(t, found)
-	require.Equal(t, int64(100), validator.Tokens.Int64(), "\nvalidator %v\npool %v", validator, pool)
+	require.Equal(t, int64(100), validator.Tokens.Int64(), "\nvalidator %v", validator)
 
-	pool = keeper.GetPool(ctx)
 	power := types.GetValidatorsByPowerIndexKey(validator)
 	require.True(t, validatorByPowerIndexExists(keeper, ctx, power))
 
 	// burn half the delegator shares
 	keeper.DeleteValidatorByPowerIndex(ctx, validator)
-	validator, pool, burned := validator.RemoveDelShares(pool, delSharesCreated.Quo(sdk.NewDec(2)))
+	validator, burned := validator.RemoveDelShares(delSharesCreated.Quo(sdk.NewDec(2)))
 	require.Equal(t, int64(50), burned.Int64())
-	keeper.SetPool(ctx, pool)                            // update the pool
 	TestingUpdateValidator(keeper, ctx, validator, true) // update the validator, possibly kicking it out
 	require.False(t, validatorByPowerIndexExists(keeper, ctx, power))
 
-	pool = keeper.GetPool(ctx)
 	validator, found = keeper.GetValidator(ctx, addrVals[0])
 	require.True(t, found)
 	power = types.GetValidatorsByPowerIndexKey(validator)
@@ -115,27 +106,23 @@ func TestUpdateBondedValidatorsDecreaseCliff(t *testing.T) {
 	maxVals := 5
 
 	// create context, keeper, and pool for tests
-	ctx, _, keeper := CreateTestInput(t, false, 0)
-	pool := keeper.GetPool(ctx)
+	ctx, _, _, keeper, _ := CreateTestInput(t, false, 0)
 
 	// create keeper parameters
 	params := keeper.GetParams(ctx)
 	params.MaxValidators = uint16(maxVals)
 	keeper.SetParams(ctx, params)
 
-	// create a random pool
-	pool.NotBondedTokens = sdk.TokensFromTendermintPower(10000)
-	pool.BondedTokens = sdk.TokensFromTendermintPower(1234)
-	keeper.SetPool(ctx, pool)
+	// fund the not bonded pool
+	require.NoError(t, keeper.supplyKeeper.SetModuleAccountCoins(ctx, types.NotBondedPoolName, sdk.NewCoins(sdk.NewCoin(keeper.BondDenom(ctx), sdk.TokensFromTendermintPower(10000)))))
 
 	validators := make([]types.Validator, numVals)
 	for i := 0; i < len(validators); i++ {
 		moniker := fmt.Sprintf("val#%d", int64(i))
 		val := types.NewValidator(sdk.ValAddress(Addrs[i]), PKs[i], types.Description{Moniker: moniker})
 		delTokens := sdk.TokensFromTendermintPower(int64((i + 1) * 10))
-		val, pool, _ = val.AddTokensFromDel(pool, delTokens)
+		val, _ = val.AddTokensFromDel(delTokens)
 
-		keeper.SetPool(ctx, pool)
 		val = TestingUpdateValidator(keeper, ctx, val, true)
 		validators[i] = val
 	}
@@ -146,8 +133,7 @@ func TestUpdateBondedValidatorsDecreaseCliff(t *testing.T) {
 	// validator and next in line cliff validator
 	keeper.DeleteValidatorByPowerIndex(ctx, nextCliffVal)
 	shares := sdk.TokensFromTendermintPower(21)
-	nextCliffVal, pool, _ = nextCliffVal.RemoveDelShares(pool, shares.ToDec())
-	keeper.SetPool(ctx, pool)
+	nextCliffVal, _ = nextCliffVal.RemoveDelShares(shares.ToDec())
 	nextCliffVal = TestingUpdateValidator(keeper, ctx, nextCliffVal, true)
 
 	expectedValStatus := map[int]sdk.BondStatus{
@@ -169,19 +155,17 @@ func TestUpdateBondedValidatorsDecreaseCliff(t *testing.T) {
 
 func TestSlashToZeroPowerRemoved(t *testing.T) {
 	// initialize setup
-	ctx, _, keeper := CreateTestInput(t, false, 100)
-	pool := keeper.GetPool(ctx)
+	ctx, _, _, keeper, _ := CreateTestInput(t, false, 100)
 
 	// add a validator
 	validator := types.NewValidator(addrVals[0], PKs[0], types.Description{})
 	valTokens := sdk.TokensFromTendermintPower(100)
-	validator, pool, _ = validator.AddTokensFromDel(pool, valTokens)
+	validator, _ = validator.AddTokensFromDel(valTokens)
 	require.Equal(t, sdk.Unbonded, validator.Status)
 	require.Equal(t, valTokens, validator.Tokens)
-	keeper.SetPool(ctx, pool)
 	keeper.SetValidatorByConsAddr(ctx, validator)
 	validator = TestingUpdateValidator(keeper, ctx, validator, true)
-	require.Equal(t, valTokens, validator.Tokens, "\nvalidator %v\npool %v", validator, pool)
+	require.Equal(t, valTokens, validator.Tokens, "\nvalidator %v", validator)
 
 	// slash the validator by 100%
 	consAddr0 := sdk.ConsAddress(PKs[0].Address())
@@ -195,8 +179,7 @@ func TestSlashToZeroPowerRemoved(t *testing.T) {
 
 // This function tests UpdateValidator, GetValidator, GetLastValidators, RemoveValidator
 func TestValidatorBasics(t *testing.T) {
-	ctx, _, keeper := CreateTestInput(t, false, 1000)
-	pool := keeper.GetPool(ctx)
+	ctx, _, _, keeper, _ := CreateTestInput(t, false, 1000)
 
 	//construct the validators
 	var validators [3]types.Validator
@@ -206,8 +189,7 @@ func TestValidatorBasics(t *testing.T) {
 		validators[i].Status = sdk.Unbonded
 		validators[i].Tokens = sdk.ZeroInt()
 		tokens := sdk.TokensFromTendermintPower(power)
-		validators[i], pool, _ = validators[i].AddTokensFromDel(pool, tokens)
-		keeper.SetPool(ctx, pool)
+		validators[i], _ = validators[i].AddTokensFromDel(tokens)
 	}
 	assert.Equal(t, sdk.TokensFromTendermintPower(9), validators[0].Tokens)
 	assert.Equal(t, sdk.TokensFromTendermintPower(8), validators[1].Tokens)
@@ -222,8 +204,7 @@ func TestValidatorBasics(t *testing.T) {
 	resVals = keeper.GetValidators(ctx, 2)
 	require.Zero(t, len(resVals))
 
-	pool = keeper.GetPool(ctx)
-	assert.True(sdk.IntEq(t, sdk.ZeroInt(), pool.BondedTokens))
+	assert.True(sdk.IntEq(t, sdk.ZeroInt(), keeper.supplyKeeper.GetModuleAccountCoins(ctx, types.BondedPoolName).AmountOf(keeper.BondDenom(ctx))))
 
 	// set and retrieve a record
 	validators[0] = TestingUpdateValidator(keeper, ctx, validators[0], true)
@@ -246,8 +227,7 @@ func TestValidatorBasics(t *testing.T) {
 	assert.Equal(t, sdk.Bonded, validators[0].Status)
 	assert.True(sdk.IntEq(t, sdk.TokensFromTendermintPower(9), validators[0].BondedTokens()))
 
-	pool = keeper.GetPool(ctx)
-	assert.True(sdk.IntEq(t, pool.BondedTokens, validators[0].BondedTokens()))
+	assert.True(sdk.IntEq(t, keeper.supplyKeeper.GetModuleAccountCoins(ctx, types.BondedPoolName).AmountOf(keeper.BondDenom(ctx)), validators[0].BondedTokens()))
 
 	// modify a records, save, and retrieve
 	validators[0].Status = sdk.Bonded
@@ -301,7 +281,7 @@ func TestValidatorBasics(t *testing.T) {
 
 // test how the validators are sorted, tests GetBondedValidatorsByPower
 func GetValidatorSortingUnmixed(t *testing.T) {
-	ctx, _, keeper := CreateTestInput(t, false, 1000)
+	ctx, _, _, keeper, _ := CreateTestInput(t, false, 1000)
 
 	// initialize some validators into the state
 	amts := []int64{0, 100, 1, 400, 200}
@@ -376,7 +356,7 @@ func GetValidatorSortingUnmixed(t *testing.T) {
 }
 
 func GetValidatorSortingMixed(t *testing.T) {
-	ctx, _, keeper := CreateTestInput(t, false, 1000)
+	ctx, _, _, keeper, _ := CreateTestInput(t, false, 1000)
 
 	// now 2 max resValidators
 	params := keeper.GetParams(ctx)
@@ -441,7 +421,7 @@ func GetValidatorSortingMixed(t *testing.T) {
 
 // TODO separate out into multiple tests
 func TestGetValidatorsEdgeCases(t *testing.T) {
-	ctx, _, keeper := CreateTestInput(t, false, 1000)
+	ctx, _, _, keeper, _ := CreateTestInput(t, false, 1000)
 	var found bool
 
 	// now 2 max resValidators
@@ -454,12 +434,10 @@ func TestGetValidatorsEdgeCases(t *testing.T) {
 	powers := []int64{0, 100, 400, 400}
 	var validators [4]types.Validator
 	for i, power := range powers {
-		pool := keeper.GetPool(ctx)
 		moniker := fmt.Sprintf("val#%d", int64(i))
 		validators[i] = types.NewValidator(sdk.ValAddress(Addrs[i]), PKs[i], types.Description{Moniker: moniker})
 		tokens := sdk.TokensFromTendermintPower(power)
-		validators[i], pool, _ = validators[i].AddTokensFromDel(pool, tokens)
-		keeper.SetPool(ctx, pool)
+		validators[i], _ = validators[i].AddTokensFromDel(tokens)
 		validators[i] = TestingUpdateValidator(keeper, ctx, validators[i], true)
 	}
 
@@ -472,11 +450,9 @@ func TestGetValidatorsEdgeCases(t *testing.T) {
 	assert.True(ValEq(t, validators[2], resValidators[0]))
 	assert.True(ValEq(t, validators[3], resValidators[1]))
 
-	pool := keeper.GetPool(ctx)
 	keeper.DeleteValidatorByPowerIndex(ctx, validators[0])
 	delTokens := sdk.TokensFromTendermintPower(500)
-	validators[0], pool, _ = validators[0].AddTokensFromDel(pool, delTokens)
-	keeper.SetPool(ctx, pool)
+	validators[0], _ = validators[0].AddTokensFromDel(delTokens)
 	validators[0] = TestingUpdateValidator(keeper, ctx, validators[0], true)
 	resValidators = keeper.GetBondedValidatorsByPower(ctx)
 	require.Equal(t, nMax, uint16(len(resValidators)))
@@ -493,8 +469,7 @@ func TestGetValidatorsEdgeCases(t *testing.T) {
 	validators[3], found = keeper.GetValidator(ctx, validators[3].OperatorAddress)
 	require.True(t, found)
 	keeper.DeleteValidatorByPowerIndex(ctx, validators[3])
-	validators[3], pool, _ = validators[3].AddTokensFromDel(pool, sdk.NewInt(1))
-	keeper.SetPool(ctx, pool)
+	validators[3], _ = validators[3].AddTokensFromDel(sdk.NewInt(1))
 	validators[3] = TestingUpdateValidator(keeper, ctx, validators[3], true)
 	resValidators = keeper.GetBondedValidatorsByPower(ctx)
 	require.Equal(t, nMax, uint16(len(resValidators)))
@@ -503,8 +478,7 @@ func TestGetValidatorsEdgeCases(t *testing.T) {
 
 	// validator 3 kicked out temporarily
 	keeper.DeleteValidatorByPowerIndex(ctx, validators[3])
-	validators[3], pool, _ = validators[3].RemoveDelShares(pool, sdk.NewDec(201))
-	keeper.SetPool(ctx, pool)
+	validators[3], _ = validators[3].RemoveDelShares(sdk.NewDec(201))
 	validators[3] = TestingUpdateValidator(keeper, ctx, validators[3], true)
 	resValidators = keeper.GetBondedValidatorsByPower(ctx)
 	require.Equal(t, nMax, uint16(len(resValidators)))
@@ -513,8 +487,7 @@ func TestGetValidatorsEdgeCases(t *testing.T) {
 
 	// validator 4 does not get spot back
 	keeper.DeleteValidatorByPowerIndex(ctx, validators[3])
-	validators[3], pool, _ = validators[3].AddTokensFromDel(pool, sdk.NewInt(200))
-	keeper.SetPool(ctx, pool)
+	validators[3], _ = validators[3].AddTokensFromDel(sdk.NewInt(200))
 	validators[3] = TestingUpdateValidator(keeper, ctx, validators[3], true)
 	resValidators = keeper.GetBondedValidatorsByPower(ctx)
 	require.Equal(t, nMax, uint16(len(resValidators)))
@@ -525,8 +498,7 @@ func TestGetValidatorsEdgeCases(t *testing.T) {
 }
 
 func TestValidatorBondHeight(t *testing.T) {
-	ctx, _, keeper := CreateTestInput(t, false, 1000)
-	pool := keeper.GetPool(ctx)
+	ctx, _, _, keeper, _ := CreateTestInput(t, false, 1000)
 
 	// now 2 max resValidators
 	params := keeper.GetParams(ctx)
@@ -542,10 +514,9 @@ func TestValidatorBondHeight(t *testing.T) {
 	tokens0 := sdk.TokensFromTendermintPower(200)
 	tokens1 := sdk.TokensFromTendermintPower(100)
 	tokens2 := sdk.TokensFromTendermintPower(100)
-	validators[0], pool, _ = validators[0].AddTokensFromDel(pool, tokens0)
-	validators[1], pool, _ = validators[1].AddTokensFromDel(pool, tokens1)
-	validators[2], pool, _ = validators[2].AddTokensFromDel(pool, tokens2)
-	keeper.SetPool(ctx, pool)
+	validators[0], _ = validators[0].AddTokensFromDel(tokens0)
+	validators[1], _ = validators[1].AddTokensFromDel(tokens1)
+	validators[2], _ = validators[2].AddTokensFromDel(tokens2)
 
 	validators[0] = TestingUpdateValidator(keeper, ctx, validators[0], true)
 
@@ -555,8 +526,6 @@ func TestValidatorBondHeight(t *testing.T) {
 	validators[1] = TestingUpdateValidator(keeper, ctx, validators[1], true)
 	validators[2] = TestingUpdateValidator(keeper, ctx, validators[2], true)
 
-	pool = keeper.GetPool(ctx)
-
 	resValidators := keeper.GetBondedValidatorsByPower(ctx)
 	require.Equal(t, uint16(len(resValidators)), params.MaxValidators)
 
@@ -565,9 +534,8 @@ func TestValidatorBondHeight(t *testing.T) {
 	keeper.DeleteValidatorByPowerIndex(ctx, validators[1])
 	keeper.DeleteValidatorByPowerIndex(ctx, validators[2])
 	delTokens := sdk.TokensFromTendermintPower(50)
-	validators[1], pool, _ = validators[1].AddTokensFromDel(pool, delTokens)
-	validators[2], pool, _ = validators[2].AddTokensFromDel(pool, delTokens)
-	keeper.SetPool(ctx, pool)
+	validators[1], _ = validators[1].AddTokensFromDel(delTokens)
+	validators[2], _ = validators[2].AddTokensFromDel(delTokens)
 	validators[2] = TestingUpdateValidator(keeper, ctx, validators[2], true)
 	resValidators = keeper.GetBondedValidatorsByPower(ctx)
 	require.Equal(t, params.MaxValidators, uint16(len(resValidators)))
@@ -577,7 +545,7 @@ func TestValidatorBondHeight(t *testing.T) {
 }
 
 func TestFullValidatorSetPowerChange(t *testing.T) {
-	ctx, _, keeper := CreateTestInput(t, false, 1000)
+	ctx, _, _, keeper, _ := CreateTestInput(t, false, 1000)
 	params := keeper.GetParams(ctx)
 	max := 2
 	params.MaxValidators = uint16(2)
@@ -587,11 +555,9 @@ func TestFullValidatorSetPowerChange(t *testing.T) {
 	powers := []int64{0, 100, 400, 400, 200}
 	var validators [5]types.Validator
 	for i, power := range powers {
-		pool := keeper.GetPool(ctx)
 		validators[i] = types.NewValidator(sdk.ValAddress(Addrs[i]), PKs[i], types.Description{})
 		tokens := sdk.TokensFromTendermintPower(power)
-		validators[i], pool, _ = validators[i].AddTokensFromDel(pool, tokens)
-		keeper.SetPool(ctx, pool)
+		validators[i], _ = validators[i].AddTokensFromDel(tokens)
 		TestingUpdateValidator(keeper, ctx, validators[i], true)
 	}
 	for i := range powers {
@@ -610,10 +576,8 @@ func TestFullValidatorSetPowerChange(t *testing.T) {
 	assert.True(ValEq(t, validators[3], resValidators[1]))
 
 	// test a swap in voting power
-	pool := keeper.GetPool(ctx)
 	tokens := sdk.TokensFromTendermintPower(600)
-	validators[0], pool, _ = validators[0].AddTokensFromDel(pool, tokens)
-	keeper.SetPool(ctx, pool)
+	validators[0], _ = validators[0].AddTokensFromDel(tokens)
 	validators[0] = TestingUpdateValidator(keeper, ctx, validators[0], true)
 	resValidators = keeper.GetBondedValidatorsByPower(ctx)
 	assert.Equal(t, max, len(resValidators))
@@ -622,20 +586,18 @@ func TestFullValidatorSetPowerChange(t *testing.T) {
 }
 
 func TestApplyAndReturnValidatorSetUpdatesAllNone(t *testing.T) {
-	ctx, _, keeper := CreateTestInput(t, false, 1000)
+	ctx, _, _, keeper, _ := CreateTestInput(t, false, 1000)
 
 	powers := []int64{10, 20}
 	var validators [2]types.Validator
 	for i, power := range powers {
-		pool := keeper.GetPool(ctx)
 
 		valPubKey := PKs[i+1]
 		valAddr := sdk.ValAddress(valPubKey.Address().Bytes())
 
 		validators[i] = types.NewValidator(valAddr, valPubKey, types.Description{})
 		tokens := sdk.TokensFromTendermintPower(power)
-		validators[i], pool, _ = validators[i].AddTokensFromDel(pool, tokens)
-		keeper.SetPool(ctx, pool)
+		validators[i], _ = validators[i].AddTokensFromDel(tokens)
 	}
 
 	// test from nothing to something
@@ -655,17 +617,15 @@ func TestApplyAndReturnValidatorSetUpdatesAllNone(t *testing.T) {
 }
 
 func TestApplyAndReturnValidatorSetUpdatesIdentical(t *testing.T) {
-	ctx, _, keeper := CreateTestInput(t, false, 1000)
+	ctx, _, _, keeper, _ := CreateTestInput(t, false, 1000)
 
 	powers := []int64{10, 20}
 	var validators [2]types.Validator
 	for i, power := range powers {
-		pool := keeper.GetPool(ctx)
 		validators[i] = types.NewValidator(sdk.ValAddress(Addrs[i]), PKs[i], types.Description{})
 
 		tokens := sdk.TokensFromTendermintPower(power)
-		validators[i], pool, _ = validators[i].AddTokensFromDel(pool, tokens)
-		keeper.SetPool(ctx, pool)
+		validators[i], _ = validators[i].AddTokensFromDel(tokens)
 	}
 	validators[0] = TestingUpdateValidator(keeper, ctx, validators[0], false)
 	validators[1] = TestingUpdateValidator(keeper, ctx, validators[1], false)
@@ -679,17 +639,15 @@ func TestApplyAndReturnValidatorSetUpdatesIdentical(t *testing.T) {
 }
 
 func TestApplyAndReturnValidatorSetUpdatesSingleValueChange(t *testing.T) {
-	ctx, _, keeper := CreateTestInput(t, false, 1000)
+	ctx, _, _, keeper, _ := CreateTestInput(t, false, 1000)
 
 	powers := []int64{10, 20}
 	var validators [2]types.Validator
 	for i, power := range powers {
-		pool := keeper.GetPool(ctx)
 		validators[i] = types.NewValidator(sdk.ValAddress(Addrs[i]), PKs[i], types.Description{})
 
 		tokens := sdk.TokensFromTendermintPower(power)
-		validators[i], pool, _ = validators[i].AddTokensFromDel(pool, tokens)
-		keeper.SetPool(ctx, pool)
+		validators[i], _ = validators[i].AddTokensFromDel(tokens)
 	}
 	validators[0] = TestingUpdateValidator(keeper, ctx, validators[0], false)
 	validators[1] = TestingUpdateValidator(keeper, ctx, validators[1], false)
@@ -708,17 +666,15 @@ func TestApplyAndReturnValidatorSetUpdatesSingleValueChange(t *testing.T) {
 }
 
 func TestApplyAndReturnValidatorSetUpdatesMultipleValueChange(t *testing.T) {
-	ctx, _, keeper := CreateTestInput(t, false, 1000)
+	ctx, _, _, keeper, _ := CreateTestInput(t, false, 1000)
 
 	powers := []int64{10, 20}
 	var validators [2]types.Validator
 	for i, power := range powers {
-		pool := keeper.GetPool(ctx)
 		validators[i] = types.NewValidator(sdk.ValAddress(Addrs[i]), PKs[i], types.Description{})
 
 		tokens := sdk.TokensFromTendermintPower(power)
-		validators[i], pool, _ = validators[i].AddTokensFromDel(pool, tokens)
-		keeper.SetPool(ctx, pool)
+		validators[i], _ = validators[i].AddTokensFromDel(tokens)
 	}
 	validators[0] = TestingUpdateValidator(keeper, ctx, validators[0], false)
 	validators[1] = TestingUpdateValidator(keeper, ctx, validators[1], false)
@@ -726,12 +682,10 @@ func TestApplyAndReturnValidatorSetUpdatesMultipleValueChange(t *testing.T) {
 
 	// test multiple value change
 	//  tendermintUpdate set: {c1, c3} -> {c1', c3'}
-	pool := keeper.GetPool(ctx)
 	delTokens1 := sdk.TokensFromTendermintPower(190)
 	delTokens2 := sdk.TokensFromTendermintPower(80)
-	validators[0], pool, _ = validators[0].AddTokensFromDel(pool, delTokens1)
-	validators[1], pool, _ = validators[1].AddTokensFromDel(pool, delTokens2)
-	keeper.SetPool(ctx, pool)
+	validators[0], _ = validators[0].AddTokensFromDel(delTokens1)
+	validators[1], _ = validators[1].AddTokensFromDel(delTokens2)
 	validators[0] = TestingUpdateValidator(keeper, ctx, validators[0], false)
 	validators[1] = TestingUpdateValidator(keeper, ctx, validators[1], false)
 
@@ -742,17 +696,15 @@ func TestApplyAndReturnValidatorSetUpdatesMultipleValueChange(t *testing.T) {
 }
 
 func TestApplyAndReturnValidatorSetUpdatesInserted(t *testing.T) {
-	ctx, _, keeper := CreateTestInput(t, false, 1000)
+	ctx, _, _, keeper, _ := CreateTestInput(t, false, 1000)
 
 	powers := []int64{10, 20, 5, 15, 25}
 	var validators [5]types.Validator
 	for i, power := range powers {
-		pool := keeper.GetPool(ctx)
 		validators[i] = types.NewValidator(sdk.ValAddress(Addrs[i]), PKs[i], types.Description{})
 
 		tokens := sdk.TokensFromTendermintPower(power)
-		validators[i], pool, _ = validators[i].AddTokensFromDel(pool, tokens)
-		keeper.SetPool(ctx, pool)
+		validators[i], _ = validators[i].AddTokensFromDel(tokens)
 	}
 
 	validators[0] = TestingUpdateValidator(keeper, ctx, validators[0], false)
@@ -788,7 +740,7 @@ func TestApplyAndReturnValidatorSetUpdatesInserted(t *testing.T) {
 }
 
 func TestApplyAndReturnValidatorSetUpdatesWithCliffValidator(t *testing.T) {
-	ctx, _, keeper := CreateTestInput(t, false, 1000)
+	ctx, _, _, keeper, _ := CreateTestInput(t, false, 1000)
 	params := types.DefaultParams()
 	params.MaxValidators = 2
 	keeper.SetParams(ctx, params)
@@ -796,12 +748,10 @@ func TestApplyAndReturnValidatorSetUpdatesWithCliffValidator(t *testing.T) {
 	powers := []int64{10, 20, 5}
 	var validators [5]types.Validator
 	for i, power := range powers {
-		pool := keeper.GetPool(ctx)
 		validators[i] = types.NewValidator(sdk.ValAddress(Addrs[i]), PKs[i], types.Description{})
 
 		tokens := sdk.TokensFromTendermintPower(power)
-		validators[i], pool, _ = validators[i].AddTokensFromDel(pool, tokens)
-		keeper.SetPool(ctx, pool)
+		validators[i], _ = validators[i].AddTokensFromDel(tokens)
 	}
 	validators[0] = TestingUpdateValidator(keeper, ctx, validators[0], false)
 	validators[1] = TestingUpdateValidator(keeper, ctx, validators[1], false)
@@ -817,10 +767,8 @@ func TestApplyAndReturnValidatorSetUpdatesWithCliffValidator(t *testing.T) {
 	//  tendermintUpdate set: {}     -> {c0, c4}
 	require.Equal(t, 0, len(keeper.ApplyAndReturnValidatorSetUpdates(ctx)))
 
-	pool := keeper.GetPool(ctx)
 	tokens := sdk.TokensFromTendermintPower(10)
-	validators[2], pool, _ = validators[2].AddTokensFromDel(pool, tokens)
-	keeper.SetPool(ctx, pool)
+	validators[2], _ = validators[2].AddTokensFromDel(tokens)
 	keeper.SetValidator(ctx, validators[2])
 	keeper.SetValidatorByPowerIndex(ctx, validators[2])
 	updates = keeper.ApplyAndReturnValidatorSetUpdates(ctx)
@@ -831,17 +779,15 @@ func TestApplyAndReturnValidatorSetUpdatesWithCliffValidator(t *testing.T) {
 }
 
 func TestApplyAndReturnValidatorSetUpdatesPowerDecrease(t *testing.T) {
-	ctx, _, keeper := CreateTestInput(t, false, 1000)
+	ctx, _, _, keeper, _ := CreateTestInput(t, false, 1000)
 
 	powers := []int64{100, 100}
 	var validators [2]types.Validator
 	for i, power := range powers {
-		pool := keeper.GetPool(ctx)
 		validators[i] = types.NewValidator(sdk.ValAddress(Addrs[i]), PKs[i], types.Description{})
 
 		tokens := sdk.TokensFromTendermintPower(power)
-		validators[i], pool, _ = validators[i].AddTokensFromDel(pool, tokens)
-		keeper.SetPool(ctx, pool)
+		validators[i], _ = validators[i].AddTokensFromDel(tokens)
 	}
 	validators[0] = TestingUpdateValidator(keeper, ctx, validators[0], false)
 	validators[1] = TestingUpdateValidator(keeper, ctx, validators[1], false)
@@ -853,12 +799,10 @@ func TestApplyAndReturnValidatorSetUpdatesPowerDecrease(t *testing.T) {
 
 	// test multiple value change
 	//  tendermintUpdate set: {c1, c3} -> {c1', c3'}
-	pool := keeper.GetPool(ctx)
 	delTokens1 := sdk.TokensFromTendermintPower(20)
 	delTokens2 := sdk.TokensFromTendermintPower(30)
-	validators[0], pool, _ = validators[0].RemoveDelShares(pool, delTokens1.ToDec())
-	validators[1], pool, _ = validators[1].RemoveDelShares(pool, delTokens2.ToDec())
-	keeper.SetPool(ctx, pool)
+	validators[0], _ = validators[0].RemoveDelShares(delTokens1.ToDec())
+	validators[1], _ = validators[1].RemoveDelShares(delTokens2.ToDec())
 	validators[0] = TestingUpdateValidator(keeper, ctx, validators[0], false)
 	validators[1] = TestingUpdateValidator(keeper, ctx, validators[1], false)
 
@@ -874,7 +818,7 @@ func TestApplyAndReturnValidatorSetUpdatesPowerDecrease(t *testing.T) {
 }
 
 func TestApplyAndReturnValidatorSetUpdatesNewValidator(t *testing.T) {
-	ctx, _, keeper := CreateTestInput(t, false, 1000)
+	ctx, _, _, keeper, _ := CreateTestInput(t, false, 1000)
 	params := keeper.GetParams(ctx)
 	params.MaxValidators = uint16(3)
 
@@ -885,15 +829,13 @@ func TestApplyAndReturnValidatorSetUpdatesNewValidator(t *testing.T) {
 
 	// initialize some validators into the state
 	for i, power := range powers {
-		pool := keeper.GetPool(ctx)
 		valPubKey := PKs[i+1]
 		valAddr := sdk.ValAddress(valPubKey.Address().Bytes())
 
 		validators[i] = types.NewValidator(valAddr, valPubKey, types.Description{})
 		tokens := sdk.TokensFromTendermintPower(power)
-		validators[i], pool, _ = validators[i].AddTokensFromDel(pool, tokens)
+		validators[i], _ = validators[i].AddTokensFromDel(tokens)
 
-		keeper.SetPool(ctx, pool)
 		keeper.SetValidator(ctx, validators[i])
 		keeper.SetValidatorByPowerIndex(ctx, validators[i])
 	}
@@ -910,30 +852,26 @@ func TestApplyAndReturnValidatorSetUpdatesNewValidator(t *testing.T) {
 
 	// update initial validator set
 	for i, power := range powers {
-		pool := keeper.GetPool(ctx)
 		keeper.DeleteValidatorByPowerIndex(ctx, validators[i])
 		tokens := sdk.TokensFromTendermintPower(power)
-		validators[i], pool, _ = validators[i].AddTokensFromDel(pool, tokens)
+		validators[i], _ = validators[i].AddTokensFromDel(tokens)
 
-		keeper.SetPool(ctx, pool)
 		keeper.SetValidator(ctx, validators[i])
 		keeper.SetValidatorByPowerIndex(ctx, validators[i])
 	}
 
 	// add a new validator that goes from zero power, to non-zero power, back to
 	// zero power
-	pool := keeper.GetPool(ctx)
 	valPubKey := PKs[len(validators)+1]
 	valAddr := sdk.ValAddress(valPubKey.Address().Bytes())
 	amt := sdk.NewInt(100)
 
 	validator := types.NewValidator(valAddr, valPubKey, types.Description{})
-	validator, pool, _ = validator.AddTokensFromDel(pool, amt)
+	validator, _ = validator.AddTokensFromDel(amt)
 
-	keeper.SetPool(ctx, pool)
 	keeper.SetValidator(ctx, validator)
 
-	validator, pool, _ = validator.RemoveDelShares(pool, amt.ToDec())
+	validator, _ = validator.RemoveDelShares(amt.ToDec())
 	keeper.SetValidator(ctx, validator)
 	keeper.SetValidatorByPowerIndex(ctx, validator)
 
@@ -943,10 +881,9 @@ func TestApplyAndReturnValidatorSetUpdatesNewValidator(t *testing.T) {
 
 	validator = types.NewValidator(valAddr, valPubKey, types.Description{})
 	tokens := sdk.TokensFromTendermintPower(500)
-	validator, pool, _ = validator.AddTokensFromDel(pool, tokens)
+	validator, _ = validator.AddTokensFromDel(tokens)
 	keeper.SetValidator(ctx, validator)
 	keeper.SetValidatorByPowerIndex(ctx, validator)
-	keeper.SetPool(ctx, pool)
 
 	// verify initial Tendermint updates are correct
 	updates = keeper.ApplyAndReturnValidatorSetUpdates(ctx)
@@ -960,7 +897,7 @@ func TestApplyAndReturnValidatorSetUpdatesNewValidator(t *testing.T) {
 }
 
 func TestApplyAndReturnValidatorSetUpdatesBondTransition(t *testing.T) {
-	ctx, _, keeper := CreateTestInput(t, false, 1000)
+	ctx, _, _, keeper, _ := CreateTestInput(t, false, 1000)
 	params := keeper.GetParams(ctx)
 	params.MaxValidators = uint16(2)
 
@@ -971,15 +908,13 @@ func TestApplyAndReturnValidatorSetUpdatesBondTransition(t *testing.T) {
 
 	// initialize some validators into the state
 	for i, power := range powers {
-		pool := keeper.GetPool(ctx)
 		moniker := fmt.Sprintf("%d", i)
 		valPubKey := PKs[i+1]
 		valAddr := sdk.ValAddress(valPubKey.Address().Bytes())
 
 		validators[i] = types.NewValidator(valAddr, valPubKey, types.Description{Moniker: moniker})
 		tokens := sdk.TokensFromTendermintPower(power)
-		validators[i], pool, _ = validators[i].AddTokensFromDel(pool, tokens)
-		keeper.SetPool(ctx, pool)
+		validators[i], _ = validators[i].AddTokensFromDel(tokens)
 		keeper.SetValidator(ctx, validators[i])
 		keeper.SetValidatorByPowerIndex(ctx, validators[i])
 	}
@@ -996,7 +931,6 @@ func TestApplyAndReturnValidatorSetUpdatesBondTransition(t *testing.T) {
 
 	// delegate to validator with lowest power but not enough to bond
 	ctx = ctx.WithBlockHeight(1)
-	pool := keeper.GetPool(ctx)
 
 	var found bool
 	validators[0], found = keeper.GetValidator(ctx, validators[0].OperatorAddress)
@@ -1004,8 +938,7 @@ func TestApplyAndReturnValidatorSetUpdatesBondTransition(t *testing.T) {
 
 	keeper.DeleteValidatorByPowerIndex(ctx, validators[0])
 	tokens := sdk.TokensFromTendermintPower(1)
-	validators[0], pool, _ = validators[0].AddTokensFromDel(pool, tokens)
-	keeper.SetPool(ctx, pool)
+	validators[0], _ = validators[0].AddTokensFromDel(tokens)
 	keeper.SetValidator(ctx, validators[0])
 	keeper.SetValidatorByPowerIndex(ctx, validators[0])
 
@@ -1015,14 +948,12 @@ func TestApplyAndReturnValidatorSetUpdatesBondTransition(t *testing.T) {
 	// create a series of events that will bond and unbond the validator with
 	// lowest power in a single block context (height)
 	ctx = ctx.WithBlockHeight(2)
-	pool = keeper.GetPool(ctx)
 
 	validators[1], found = keeper.GetValidator(ctx, validators[1].OperatorAddress)
 	require.True(t, found)
 
 	keeper.DeleteValidatorByPowerIndex(ctx, validators[0])
-	validators[0], pool, _ = validators[0].RemoveDelShares(pool, validators[0].DelegatorShares)
-	keeper.SetPool(ctx, pool)
+	validators[0], _ = validators[0].RemoveDelShares(validators[0].DelegatorShares)
 	keeper.SetValidator(ctx, validators[0])
 	keeper.SetValidatorByPowerIndex(ctx, validators[0])
 	updates = keeper.ApplyAndReturnValidatorSetUpdates(ctx)
@@ -1030,8 +961,7 @@ func TestApplyAndReturnValidatorSetUpdatesBondTransition(t *testing.T) {
 
 	keeper.DeleteValidatorByPowerIndex(ctx, validators[1])
 	tokens = sdk.TokensFromTendermintPower(250)
-	validators[1], pool, _ = validators[1].AddTokensFromDel(pool, tokens)
-	keeper.SetPool(ctx, pool)
+	validators[1], _ = validators[1].AddTokensFromDel(tokens)
 	keeper.SetValidator(ctx, validators[1])
 	keeper.SetValidatorByPowerIndex(ctx, validators[1])
 
@@ -1044,7 +974,7 @@ func TestApplyAndReturnValidatorSetUpdatesBondTransition(t *testing.T) {
 }
 
 func TestUpdateValidatorCommission(t *testing.T) {
-	ctx, _, keeper := CreateTestInput(t, false, 1000)
+	ctx, _, _, keeper, _ := CreateTestInput(t, false, 1000)
 	ctx = ctx.WithBlockHeader(abci.Header{Time: time.Now().UTC()})
 
 	commission1 := types.NewCommissionWithTime(
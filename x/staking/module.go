@@ -95,22 +95,20 @@ func (AppModuleBasic) BuildCreateValidatorMsg(cliCtx context.CLIContext,
 // app module
 type AppModule struct {
 	AppModuleBasic
-	keeper      Keeper
-	fcKeeper    FeeCollectionKeeper
-	distrKeeper DistributionKeeper
-	accKeeper   AccountKeeper
+	keeper       Keeper
+	accKeeper    types.AccountKeeper
+	supplyKeeper types.SupplyKeeper
 }
 
 // NewAppModule creates a new AppModule object
-func NewAppModule(keeper Keeper, fcKeeper types.FeeCollectionKeeper,
-	distrKeeper types.DistributionKeeper, accKeeper AccountKeeper) AppModule {
+func NewAppModule(keeper Keeper, accKeeper types.AccountKeeper,
+	supplyKeeper types.SupplyKeeper) AppModule {
 
 	return AppModule{
 		AppModuleBasic: AppModuleBasic{},
 		keeper:         keeper,
-		fcKeeper:       fcKeeper,
-		distrKeeper:    distrKeeper,
 		accKeeper:      accKeeper,
+		supplyKeeper:   supplyKeeper,
 	}
 }
 
@@ -121,7 +119,7 @@ func (AppModule) Name() string {
 
 // register invariants
 func (am AppModule) RegisterInvariants(ir sdk.InvariantRouter) {
-	RegisterInvariants(ir, am.keeper, am.fcKeeper, am.distrKeeper, am.accKeeper)
+	RegisterInvariants(ir, am.keeper)
 }
 
 // module message route name
@@ -148,7 +146,7 @@ func (am AppModule) NewQuerierHandler() sdk.Querier {
 func (am AppModule) InitGenesis(ctx sdk.Context, data json.RawMessage) []abci.ValidatorUpdate {
 	var genesisState GenesisState
 	ModuleCdc.MustUnmarshalJSON(data, &genesisState)
-	return InitGenesis(ctx, am.keeper, am.accKeeper, genesisState)
+	return InitGenesis(ctx, am.keeper, am.accKeeper, am.supplyKeeper, genesisState)
 }
 
 // module export genesis
@@ -158,11 +156,11 @@ func (am AppModule) ExportGenesis(ctx sdk.Context) json.RawMessage {
 }
 
 // module begin-block
-func (AppModule) BeginBlock(_ sdk.Context, _ abci.RequestBeginBlock) sdk.Tags {
-	return sdk.EmptyTags()
+func (am AppModule) BeginBlock(ctx sdk.Context, _ abci.RequestBeginBlock) {
+	BeginBlocker(ctx, am.keeper)
 }
 
 // module end-block
-func (am AppModule) EndBlock(ctx sdk.Context, _ abci.RequestEndBlock) ([]abci.ValidatorUpdate, sdk.Tags) {
+func (am AppModule) EndBlock(ctx sdk.Context, _ abci.RequestEndBlock) []abci.ValidatorUpdate {
 	return EndBlocker(ctx, am.keeper)
 }
@@ -7,13 +7,14 @@ import (
 	"github.com/cosmos/cosmos-sdk/baseapp"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/x/auth"
+	"github.com/cosmos/cosmos-sdk/x/bank"
 	"github.com/cosmos/cosmos-sdk/x/simulation"
 	"github.com/cosmos/cosmos-sdk/x/staking"
 	"github.com/cosmos/cosmos-sdk/x/staking/keeper"
 )
 
 // SimulateMsgCreateValidator
-func SimulateMsgCreateValidator(m auth.AccountKeeper, k staking.Keeper) simulation.Operation {
+func SimulateMsgCreateValidator(bk bank.ViewKeeper, k staking.Keeper) simulation.Operation {
 	handler := staking.NewHandler(k)
 	return func(r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context,
 		accs []simulation.Account) (
@@ -33,7 +34,7 @@ func SimulateMsgCreateValidator(m auth.AccountKeeper, k staking.Keeper) simulati
 
 		acc := simulation.RandomAcc(r, accs)
 		address := sdk.ValAddress(acc.Address)
-		amount := m.GetAccount(ctx, acc.Address).GetCoins().AmountOf(denom)
+		amount := bk.GetCoins(ctx, acc.Address).AmountOf(denom)
 		if amount.GT(sdk.ZeroInt()) {
 			amount = simulation.RandomAmount(r, amount)
 		}
@@ -97,7 +98,7 @@ func SimulateMsgEditValidator(k staking.Keeper) simulation.Operation {
 }
 
 // SimulateMsgDelegate
-func SimulateMsgDelegate(m auth.AccountKeeper, k staking.Keeper) simulation.Operation {
+func SimulateMsgDelegate(bk bank.ViewKeeper, k staking.Keeper) simulation.Operation {
 	handler := staking.NewHandler(k)
 	return func(r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context,
 		accs []simulation.Account) (opMsg simulation.OperationMsg, fOps []simulation.FutureOperation, err error) {
@@ -110,7 +111,7 @@ func SimulateMsgDelegate(m auth.AccountKeeper, k staking.Keeper) simulation.Oper
 		validatorAddress := val.GetOperator()
 		delegatorAcc := simulation.RandomAcc(r, accs)
 		delegatorAddress := delegatorAcc.Address
-		amount := m.GetAccount(ctx, delegatorAddress).GetCoins().AmountOf(denom)
+		amount := bk.GetCoins(ctx, delegatorAddress).AmountOf(denom)
 		if amount.GT(sdk.ZeroInt()) {
 			amount = simulation.RandomAmount(r, amount)
 		}
@@ -178,8 +179,137 @@ func SimulateMsgUndelegate(m auth.AccountKeeper, k staking.Keeper) simulation.Op
 	}
 }
 
+// SimulateMsgCancelUnbondingDelegation
+func SimulateMsgCancelUnbondingDelegation(k staking.Keeper) simulation.Operation {
+	handler := staking.NewHandler(k)
+	return func(r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context,
+		accs []simulation.Account) (opMsg simulation.OperationMsg, fOps []simulation.FutureOperation, err error) {
+
+		delegatorAcc := simulation.RandomAcc(r, accs)
+		delegatorAddress := delegatorAcc.Address
+		ubds := k.GetUnbondingDelegations(ctx, delegatorAddress, k.MaxValidators(ctx))
+		if len(ubds) == 0 {
+			return simulation.NoOpMsg(), nil, nil
+		}
+		ubd := ubds[r.Intn(len(ubds))]
+
+		entry := ubd.Entries[r.Intn(len(ubd.Entries))]
+		if entry.IsMature(ctx.BlockHeader().Time) {
+			return simulation.NoOpMsg(), nil, nil
+		}
+
+		cancelAmt := simulation.RandomAmount(r, entry.Balance)
+		if cancelAmt.Equal(sdk.ZeroInt()) {
+			return simulation.NoOpMsg(), nil, nil
+		}
+
+		msg := staking.NewMsgCancelUnbondingDelegation(
+			delegatorAddress, ubd.ValidatorAddress, sdk.NewCoin(k.GetParams(ctx).BondDenom, cancelAmt), entry.CreationHeight,
+		)
+		if msg.ValidateBasic() != nil {
+			return simulation.NoOpMsg(), nil, fmt.Errorf("expected msg to pass ValidateBasic: %s, got error %v",
+				msg.GetSignBytes(), msg.ValidateBasic())
+		}
+
+		ctx, write := ctx.CacheContext()
+		ok := handler(ctx, msg).IsOK()
+		if ok {
+			write()
+		}
+
+		opMsg = simulation.NewOperationMsg(msg, ok, "")
+		return opMsg, nil, nil
+	}
+}
+
+// SimulateMsgTokenizeShares
+func SimulateMsgTokenizeShares(k staking.Keeper) simulation.Operation {
+	handler := staking.NewHandler(k)
+	return func(r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context,
+		accs []simulation.Account) (opMsg simulation.OperationMsg, fOps []simulation.FutureOperation, err error) {
+
+		delegatorAcc := simulation.RandomAcc(r, accs)
+		delegatorAddress := delegatorAcc.Address
+		delegations := k.GetAllDelegatorDelegations(ctx, delegatorAddress)
+		if len(delegations) == 0 {
+			return simulation.NoOpMsg(), nil, nil
+		}
+		delegation := delegations[r.Intn(len(delegations))]
+
+		validator, found := k.GetValidator(ctx, delegation.GetValidatorAddr())
+		if !found {
+			return simulation.NoOpMsg(), nil, nil
+		}
+
+		totalBond := validator.TokensFromShares(delegation.GetShares()).TruncateInt()
+		tokenizeAmt := simulation.RandomAmount(r, totalBond)
+		if tokenizeAmt.Equal(sdk.ZeroInt()) {
+			return simulation.NoOpMsg(), nil, nil
+		}
+
+		msg := staking.NewMsgTokenizeShares(
+			delegatorAddress, delegation.ValidatorAddress, sdk.NewCoin(k.GetParams(ctx).BondDenom, tokenizeAmt),
+		)
+		if msg.ValidateBasic() != nil {
+			return simulation.NoOpMsg(), nil, fmt.Errorf("expected msg to pass ValidateBasic: %s, got error %v",
+				msg.GetSignBytes(), msg.ValidateBasic())
+		}
+
+		ctx, write := ctx.CacheContext()
+		ok := handler(ctx, msg).IsOK()
+		if ok {
+			write()
+		}
+
+		opMsg = simulation.NewOperationMsg(msg, ok, "")
+		return opMsg, nil, nil
+	}
+}
+
+// SimulateMsgRedeemTokensForShares
+func SimulateMsgRedeemTokensForShares(bk bank.ViewKeeper, k staking.Keeper) simulation.Operation {
+	handler := staking.NewHandler(k)
+	return func(r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context,
+		accs []simulation.Account) (opMsg simulation.OperationMsg, fOps []simulation.FutureOperation, err error) {
+
+		delegatorAcc := simulation.RandomAcc(r, accs)
+		delegatorAddress := delegatorAcc.Address
+
+		var shareTokens sdk.Coins
+		for _, coin := range bk.GetCoins(ctx, delegatorAddress) {
+			if staking.IsTokenizedSharesDenom(coin.Denom) {
+				shareTokens = append(shareTokens, coin)
+			}
+		}
+		if len(shareTokens) == 0 {
+			return simulation.NoOpMsg(), nil, nil
+		}
+		coin := shareTokens[r.Intn(len(shareTokens))]
+
+		redeemAmt := simulation.RandomAmount(r, coin.Amount)
+		if redeemAmt.Equal(sdk.ZeroInt()) {
+			return simulation.NoOpMsg(), nil, nil
+		}
+
+		msg := staking.NewMsgRedeemTokensForShares(delegatorAddress, sdk.NewCoin(coin.Denom, redeemAmt))
+		if msg.ValidateBasic() != nil {
+			return simulation.NoOpMsg(), nil, fmt.Errorf("expected msg to pass ValidateBasic: %s, got error %v",
+				msg.GetSignBytes(), msg.ValidateBasic())
+		}
+
+		ctx, write := ctx.CacheContext()
+		ok := handler(ctx, msg).IsOK()
+		if ok {
+			write()
+		}
+
+		opMsg = simulation.NewOperationMsg(msg, ok, "")
+		return opMsg, nil, nil
+	}
+}
+
 // SimulateMsgBeginRedelegate
-func SimulateMsgBeginRedelegate(m auth.AccountKeeper, k staking.Keeper) simulation.Operation {
+func SimulateMsgBeginRedelegate(bk bank.ViewKeeper, k staking.Keeper) simulation.Operation {
 	handler := staking.NewHandler(k)
 	return func(r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context,
 		accs []simulation.Account) (opMsg simulation.OperationMsg, fOps []simulation.FutureOperation, err error) {
@@ -195,7 +325,7 @@ func SimulateMsgBeginRedelegate(m auth.AccountKeeper, k staking.Keeper) simulati
 		delegatorAcc := simulation.RandomAcc(r, accs)
 		delegatorAddress := delegatorAcc.Address
 		// TODO
-		amount := m.GetAccount(ctx, delegatorAddress).GetCoins().AmountOf(denom)
+		amount := bk.GetCoins(ctx, delegatorAddress).AmountOf(denom)
 		if amount.GT(sdk.ZeroInt()) {
 			amount = simulation.RandomAmount(r, amount)
 		}
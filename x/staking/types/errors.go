@@ -166,6 +166,18 @@ func ErrMaxUnbondingDelegationEntries(codespace sdk.CodespaceType) sdk.Error {
 		"too many unbonding delegation entries in this delegator/validator duo, please wait for some entries to mature")
 }
 
+func ErrNoUnbondingDelegationEntry(codespace sdk.CodespaceType) sdk.Error {
+	return sdk.NewError(codespace, CodeInvalidDelegation, "no immature unbonding delegation entry found at this creation height")
+}
+
+func ErrBadCreationHeight(codespace sdk.CodespaceType) sdk.Error {
+	return sdk.NewError(codespace, CodeInvalidInput, "creation height must be >= 0")
+}
+
+func ErrNotEnoughUnbondingBalance(codespace sdk.CodespaceType, balance string) sdk.Error {
+	return sdk.NewError(codespace, CodeInvalidDelegation, fmt.Sprintf("not enough unbonding balance only have %v", balance))
+}
+
 func ErrBadRedelegationAddr(codespace sdk.CodespaceType) sdk.Error {
 	return sdk.NewError(codespace, CodeInvalidInput, "unexpected address length for this (address, srcValidator, dstValidator) tuple")
 }
@@ -212,3 +224,30 @@ func ErrNeitherShareMsgsGiven(codespace sdk.CodespaceType) sdk.Error {
 func ErrMissingSignature(codespace sdk.CodespaceType) sdk.Error {
 	return sdk.NewError(codespace, CodeInvalidValidator, "missing signature")
 }
+
+func ErrConsPubKeyRotationInProgress(codespace sdk.CodespaceType) sdk.Error {
+	return sdk.NewError(codespace, CodeInvalidValidator,
+		"a consensus pubkey rotation of this validator is already pending, it is applied at the end of the block")
+}
+
+func ErrNoHistoricalInfo(codespace sdk.CodespaceType) sdk.Error {
+	return sdk.NewError(codespace, CodeInvalidInput, "no historical info found")
+}
+
+func ErrNotTokenizedSharesDenom(codespace sdk.CodespaceType, denom string) sdk.Error {
+	return sdk.NewError(codespace, CodeInvalidInput, fmt.Sprintf("%s is not a tokenized shares denom", denom))
+}
+
+func ErrTokenizeSharesAmountTooSmall(codespace sdk.CodespaceType) sdk.Error {
+	return sdk.NewError(codespace, CodeInvalidDelegation, "amount is too small to be tokenized or redeemed")
+}
+
+func ErrTokenizeReceivingRedelegation(codespace sdk.CodespaceType) sdk.Error {
+	return sdk.NewError(codespace, CodeInvalidDelegation,
+		"cannot tokenize shares of a delegation with an incoming redelegation in progress, it must complete first")
+}
+
+func ErrTokenizeVestingDelegation(codespace sdk.CodespaceType) sdk.Error {
+	return sdk.NewError(codespace, CodeInvalidDelegation,
+		"cannot tokenize shares of a vesting account until its coins have vested")
+}
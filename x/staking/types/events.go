@@ -0,0 +1,29 @@
+package types
+
+// staking module event types
+const (
+	EventTypeCompleteUnbonding    = "complete_unbonding"
+	EventTypeCompleteRedelegation = "complete_redelegation"
+	EventTypeCreateValidator      = "create_validator"
+	EventTypeEditValidator        = "edit_validator"
+	EventTypeDelegate             = "delegate"
+	EventTypeUnbond               = "unbond"
+	EventTypeRedelegate           = "redelegate"
+	EventTypeCancelUnbonding      = "cancel_unbonding_delegation"
+	EventTypeRotateConsPubKey     = "rotate_cons_pubkey"
+	EventTypeCompleteRotation     = "complete_cons_pubkey_rotation"
+	EventTypeTokenizeShares       = "tokenize_shares"
+	EventTypeRedeemShares         = "redeem_tokens_for_shares"
+
+	AttributeKeyValidator         = "validator"
+	AttributeKeyCommissionRate    = "commission_rate"
+	AttributeKeyMinSelfDelegation = "min_self_delegation"
+	AttributeKeySrcValidator      = "source_validator"
+	AttributeKeyDstValidator      = "destination_validator"
+	AttributeKeyDelegator         = "delegator"
+	AttributeKeyCompletionTime    = "completion_time"
+	AttributeKeyCreationHeight    = "creation_height"
+	AttributeKeyConsPubKey        = "consensus_pubkey"
+	AttributeKeyShareTokens       = "share_tokens"
+	AttributeValueCategory        = ModuleName
+)
@@ -4,28 +4,42 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/x/auth"
 	"github.com/cosmos/cosmos-sdk/x/staking/exported"
+	supply "github.com/cosmos/cosmos-sdk/x/supply/types"
 )
 
-// expected coin keeper
+// DistributionKeeper expected distribution keeper (noalias)
 type DistributionKeeper interface {
 	GetFeePoolCommunityCoins(ctx sdk.Context) sdk.DecCoins
 	GetValidatorOutstandingRewardsCoins(ctx sdk.Context, val sdk.ValAddress) sdk.DecCoins
 }
 
-// expected fee collection keeper
-type FeeCollectionKeeper interface {
-	GetCollectedFees(ctx sdk.Context) sdk.Coins
+// AccountKeeper defines the expected account keeper (noalias)
+type AccountKeeper interface {
+	IterateAccounts(ctx sdk.Context, process func(auth.Account) (stop bool))
+	GetAccount(ctx sdk.Context, addr sdk.AccAddress) auth.Account
 }
 
-// expected bank keeper
-type BankKeeper interface {
-	DelegateCoins(ctx sdk.Context, addr sdk.AccAddress, amt sdk.Coins) (sdk.Tags, sdk.Error)
-	UndelegateCoins(ctx sdk.Context, addr sdk.AccAddress, amt sdk.Coins) (sdk.Tags, sdk.Error)
-}
+// SupplyKeeper defines the expected supply Keeper (noalias)
+type SupplyKeeper interface {
+	GetSupply(ctx sdk.Context) supply.Supply
 
-// AccountKeeper expected Account keeper
-type AccountKeeper interface {
-	IterateAccounts(ctx sdk.Context, process func(auth.Account) (stop bool))
+	GetModuleAddress(name string) sdk.AccAddress
+	GetModuleAccount(ctx sdk.Context, moduleName string) auth.ModuleAccountI
+
+	GetModuleAccountCoins(ctx sdk.Context, moduleName string) sdk.Coins
+
+	// used to set the initial pool balances at genesis
+	SetModuleAccount(sdk.Context, auth.ModuleAccountI)
+	SetModuleAccountCoins(ctx sdk.Context, moduleName string, amt sdk.Coins) sdk.Error
+
+	SendCoinsFromModuleToModule(ctx sdk.Context, senderPool, recipientPool string, amt sdk.Coins) sdk.Error
+	SendCoinsFromModuleToAccount(ctx sdk.Context, senderModule string, recipientAddr sdk.AccAddress, amt sdk.Coins) sdk.Error
+	SendCoinsFromAccountToModule(ctx sdk.Context, senderAddr sdk.AccAddress, recipientModule string, amt sdk.Coins) sdk.Error
+	UndelegateCoinsFromModuleToAccount(ctx sdk.Context, senderModule string, recipientAddr sdk.AccAddress, amt sdk.Coins) sdk.Error
+	DelegateCoinsFromAccountToModule(ctx sdk.Context, senderAddr sdk.AccAddress, recipientModule string, amt sdk.Coins) sdk.Error
+
+	MintCoins(ctx sdk.Context, name string, amt sdk.Coins) sdk.Error
+	BurnCoins(ctx sdk.Context, name string, amt sdk.Coins) sdk.Error
 }
 
 // ValidatorSet expected properties for the set of all validators
@@ -45,7 +59,7 @@ type ValidatorSet interface {
 	Validator(sdk.Context, sdk.ValAddress) exported.ValidatorI            // get a particular validator by operator address
 	ValidatorByConsAddr(sdk.Context, sdk.ConsAddress) exported.ValidatorI // get a particular validator by consensus address
 	TotalBondedTokens(sdk.Context) sdk.Int                                // total bonded tokens within the validator set
-	TotalTokens(sdk.Context) sdk.Int                                      // total token supply
+	StakingTokenSupply(sdk.Context) sdk.Int                               // total staking token supply
 
 	// slash the validator and delegators of the validator, specifying offence height, offence power, and slash fraction
 	Slash(sdk.Context, sdk.ConsAddress, int64, int64, sdk.Dec)
@@ -86,6 +100,8 @@ type StakingHooks interface {
 	AfterValidatorBonded(ctx sdk.Context, consAddr sdk.ConsAddress, valAddr sdk.ValAddress)         // Must be called when a validator is bonded
 	AfterValidatorBeginUnbonding(ctx sdk.Context, consAddr sdk.ConsAddress, valAddr sdk.ValAddress) // Must be called when a validator begins unbonding
 
+	AfterValidatorConsPubKeyRotated(ctx sdk.Context, oldConsAddr, newConsAddr sdk.ConsAddress, valAddr sdk.ValAddress) // Must be called when a validator's consensus pubkey is rotated
+
 	BeforeDelegationCreated(ctx sdk.Context, delAddr sdk.AccAddress, valAddr sdk.ValAddress)        // Must be called when a delegation is created
 	BeforeDelegationSharesModified(ctx sdk.Context, delAddr sdk.AccAddress, valAddr sdk.ValAddress) // Must be called when a delegation's shares are modified
 	BeforeDelegationRemoved(ctx sdk.Context, delAddr sdk.AccAddress, valAddr sdk.ValAddress)        // Must be called when a delegation is removed
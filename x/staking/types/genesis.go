@@ -6,15 +6,16 @@ import (
 
 // GenesisState - all staking state that must be provided at genesis
 type GenesisState struct {
-	Pool                 Pool                  `json:"pool"`
-	Params               Params                `json:"params"`
-	LastTotalPower       sdk.Int               `json:"last_total_power"`
-	LastValidatorPowers  []LastValidatorPower  `json:"last_validator_powers"`
-	Validators           Validators            `json:"validators"`
-	Delegations          Delegations           `json:"delegations"`
-	UnbondingDelegations []UnbondingDelegation `json:"unbonding_delegations"`
-	Redelegations        []Redelegation        `json:"redelegations"`
-	Exported             bool                  `json:"exported"`
+	Params                      Params                  `json:"params"`
+	LastTotalPower              sdk.Int                 `json:"last_total_power"`
+	LastValidatorPowers         []LastValidatorPower    `json:"last_validator_powers"`
+	Validators                  Validators              `json:"validators"`
+	Delegations                 Delegations             `json:"delegations"`
+	UnbondingDelegations        []UnbondingDelegation   `json:"unbonding_delegations"`
+	Redelegations               []Redelegation          `json:"redelegations"`
+	TokenizedSharesRecords      []TokenizedSharesRecord `json:"tokenized_shares_records"`
+	LastTokenizedSharesRecordID uint64                  `json:"last_tokenized_shares_record_id"`
+	Exported                    bool                    `json:"exported"`
 }
 
 // Last validator power, needed for validator set update logic
@@ -23,9 +24,8 @@ type LastValidatorPower struct {
 	Power   int64
 }
 
-func NewGenesisState(pool Pool, params Params, validators []Validator, delegations []Delegation) GenesisState {
+func NewGenesisState(params Params, validators []Validator, delegations []Delegation) GenesisState {
 	return GenesisState{
-		Pool:        pool,
 		Params:      params,
 		Validators:  validators,
 		Delegations: delegations,
@@ -35,7 +35,6 @@ func NewGenesisState(pool Pool, params Params, validators []Validator, delegatio
 // get raw genesis raw message for testing
 func DefaultGenesisState() GenesisState {
 	return GenesisState{
-		Pool:   InitialPool(),
 		Params: DefaultParams(),
 	}
 }
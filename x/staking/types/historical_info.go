@@ -0,0 +1,54 @@
+package types
+
+import (
+	"fmt"
+	"strings"
+
+	abci "github.com/tendermint/tendermint/abci/types"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+)
+
+// HistoricalInfo contains the header and validator set of a given height,
+// persisted for the light-client verification of the chain at that height.
+type HistoricalInfo struct {
+	Header abci.Header `json:"header"`
+	ValSet Validators  `json:"valset"`
+}
+
+// NewHistoricalInfo creates a new HistoricalInfo instance.
+func NewHistoricalInfo(header abci.Header, valSet Validators) HistoricalInfo {
+	return HistoricalInfo{
+		Header: header,
+		ValSet: valSet,
+	}
+}
+
+// return the historical info
+func MustMarshalHistoricalInfo(cdc *codec.Codec, hi HistoricalInfo) []byte {
+	return cdc.MustMarshalBinaryLengthPrefixed(hi)
+}
+
+// unmarshal a historical info from a store value
+func MustUnmarshalHistoricalInfo(cdc *codec.Codec, value []byte) HistoricalInfo {
+	hi, err := UnmarshalHistoricalInfo(cdc, value)
+	if err != nil {
+		panic(err)
+	}
+	return hi
+}
+
+// unmarshal a historical info from a store value
+func UnmarshalHistoricalInfo(cdc *codec.Codec, value []byte) (hi HistoricalInfo, err error) {
+	err = cdc.UnmarshalBinaryLengthPrefixed(value, &hi)
+	return hi, err
+}
+
+// String implements the Stringer interface for a HistoricalInfo.
+func (hi HistoricalInfo) String() string {
+	return strings.TrimSpace(fmt.Sprintf(`Historical Info:
+  Height:     %d
+  Time:       %s
+  App Hash:   %X
+  Validators: %s`, hi.Header.Height, hi.Header.Time, hi.Header.AppHash, hi.ValSet))
+}
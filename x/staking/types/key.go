@@ -12,8 +12,6 @@ import (
 //nolint
 var (
 	// Keys for store prefixes
-	PoolKey = []byte{0x00} // key for the staking pools
-
 	// Last* values are constant during a block.
 	LastValidatorPowerKey = []byte{0x11} // prefix for each key to a validator index, for bonded validators
 	LastTotalPowerKey     = []byte{0x12} // prefix for the total power
@@ -32,6 +30,15 @@ var (
 	UnbondingQueueKey    = []byte{0x41} // prefix for the timestamps in unbonding queue
 	RedelegationQueueKey = []byte{0x42} // prefix for the timestamps in redelegations queue
 	ValidatorQueueKey    = []byte{0x43} // prefix for the timestamps in validator queue
+
+	HistoricalInfoKey        = []byte{0x50} // prefix for the historical info, by height
+	ConsPubKeyRotationKey    = []byte{0x51} // prefix for each key to a pending consensus pubkey rotation, by validator operator
+	OldConsAddrQueueKey      = []byte{0x52} // prefix for the timestamps in the queue of the previous consensus addresses of rotated validators
+	ValidatorOldConsAddrsKey = []byte{0x53} // prefix for each key to a previous consensus address of a rotated validator, by validator operator
+
+	TokenizedSharesRecordKey       = []byte{0x60} // prefix for each key to a tokenized shares record, by ID
+	TokenizedSharesByValIndexKey   = []byte{0x61} // prefix for each key to a tokenized shares record index, by validator operator
+	LastTokenizedSharesRecordIDKey = []byte{0x62} // key for the ID of the last tokenized shares record
 )
 
 // gets the key for the validator with address
@@ -40,6 +47,54 @@ func GetValidatorKey(operatorAddr sdk.ValAddress) []byte {
 	return append(ValidatorsKey, operatorAddr.Bytes()...)
 }
 
+// gets the key for the historical info of the given height
+// VALUE: staking/HistoricalInfo
+func GetHistoricalInfoKey(height int64) []byte {
+	return append(HistoricalInfoKey, sdk.Uint64ToBigEndian(uint64(height))...)
+}
+
+// gets the key for the pending consensus pubkey rotation of a validator
+// VALUE: staking/ConsPubKeyRotation
+func GetConsPubKeyRotationKey(operatorAddr sdk.ValAddress) []byte {
+	return append(ConsPubKeyRotationKey, operatorAddr.Bytes()...)
+}
+
+// gets the key for a previous consensus address of a validator in the queue
+// of the indexes to delete at the given completion time
+// VALUE: validator operator address ([]byte)
+func GetOldConsAddrQueueKey(completionTime time.Time, consAddr sdk.ConsAddress) []byte {
+	return append(GetOldConsAddrQueueTimeKey(completionTime), consAddr.Bytes()...)
+}
+
+// gets the prefix of the previous consensus addresses in the queue for the
+// given completion time
+func GetOldConsAddrQueueTimeKey(completionTime time.Time) []byte {
+	return append(OldConsAddrQueueKey, sdk.FormatTimeBytes(completionTime)...)
+}
+
+// gets the key for a previous consensus address of a validator
+// VALUE: completion time of the index of the consensus address
+func GetValidatorOldConsAddrKey(operatorAddr sdk.ValAddress, consAddr sdk.ConsAddress) []byte {
+	return append(GetValidatorOldConsAddrsKey(operatorAddr), consAddr.Bytes()...)
+}
+
+// gets the prefix for all the previous consensus addresses of a validator
+func GetValidatorOldConsAddrsKey(operatorAddr sdk.ValAddress) []byte {
+	return append(ValidatorOldConsAddrsKey, operatorAddr.Bytes()...)
+}
+
+// gets the key for the tokenized shares record with the given ID
+// VALUE: staking/TokenizedSharesRecord
+func GetTokenizedSharesRecordKey(id uint64) []byte {
+	return append(TokenizedSharesRecordKey, sdk.Uint64ToBigEndian(id)...)
+}
+
+// gets the key for the index of the tokenized shares record of a validator
+// VALUE: tokenized shares record ID ([]byte)
+func GetTokenizedSharesByValIndexKey(operatorAddr sdk.ValAddress) []byte {
+	return append(TokenizedSharesByValIndexKey, operatorAddr.Bytes()...)
+}
+
 // gets the key for the validator with pubkey
 // VALUE: validator operator address ([]byte)
 func GetValidatorByConsAddrKey(addr sdk.ConsAddress) []byte {
@@ -15,7 +15,11 @@ var (
 	_ sdk.Msg = &MsgEditValidator{}
 	_ sdk.Msg = &MsgDelegate{}
 	_ sdk.Msg = &MsgUndelegate{}
+	_ sdk.Msg = &MsgCancelUnbondingDelegation{}
 	_ sdk.Msg = &MsgBeginRedelegate{}
+	_ sdk.Msg = &MsgRotateConsPubKey{}
+	_ sdk.Msg = &MsgTokenizeShares{}
+	_ sdk.Msg = &MsgRedeemTokensForShares{}
 )
 
 //______________________________________________________________________
@@ -343,3 +347,202 @@ func (msg MsgUndelegate) ValidateBasic() sdk.Error {
 	}
 	return nil
 }
+
+// MsgCancelUnbondingDelegation - struct for cancelling an unbonding delegation
+// entry, re-delegating its tokens to the validator
+type MsgCancelUnbondingDelegation struct {
+	DelegatorAddress sdk.AccAddress `json:"delegator_address"`
+	ValidatorAddress sdk.ValAddress `json:"validator_address"`
+	Amount           sdk.Coin       `json:"amount"`
+	CreationHeight   int64          `json:"creation_height"`
+}
+
+func NewMsgCancelUnbondingDelegation(delAddr sdk.AccAddress, valAddr sdk.ValAddress,
+	amount sdk.Coin, creationHeight int64) MsgCancelUnbondingDelegation {
+
+	return MsgCancelUnbondingDelegation{
+		DelegatorAddress: delAddr,
+		ValidatorAddress: valAddr,
+		Amount:           amount,
+		CreationHeight:   creationHeight,
+	}
+}
+
+//nolint
+func (msg MsgCancelUnbondingDelegation) Route() string { return RouterKey }
+func (msg MsgCancelUnbondingDelegation) Type() string  { return "cancel_unbonding_delegation" }
+func (msg MsgCancelUnbondingDelegation) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.DelegatorAddress}
+}
+
+// get the bytes for the message signer to sign on
+func (msg MsgCancelUnbondingDelegation) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+// quick validity check
+func (msg MsgCancelUnbondingDelegation) ValidateBasic() sdk.Error {
+	if msg.DelegatorAddress.Empty() {
+		return ErrNilDelegatorAddr(DefaultCodespace)
+	}
+	if msg.ValidatorAddress.Empty() {
+		return ErrNilValidatorAddr(DefaultCodespace)
+	}
+	if msg.Amount.Amount.LTE(sdk.ZeroInt()) {
+		return ErrBadDelegationAmount(DefaultCodespace)
+	}
+	if msg.CreationHeight < 0 {
+		return ErrBadCreationHeight(DefaultCodespace)
+	}
+	return nil
+}
+
+// MsgRotateConsPubKey - struct for rotating the consensus key of a validator
+type MsgRotateConsPubKey struct {
+	ValidatorAddress sdk.ValAddress `json:"validator_address"`
+	NewPubKey        crypto.PubKey  `json:"new_pubkey"`
+}
+
+type msgRotateConsPubKeyJSON struct {
+	ValidatorAddress sdk.ValAddress `json:"validator_address"`
+	NewPubKey        string         `json:"new_pubkey"`
+}
+
+func NewMsgRotateConsPubKey(valAddr sdk.ValAddress, newPubKey crypto.PubKey) MsgRotateConsPubKey {
+	return MsgRotateConsPubKey{
+		ValidatorAddress: valAddr,
+		NewPubKey:        newPubKey,
+	}
+}
+
+//nolint
+func (msg MsgRotateConsPubKey) Route() string { return RouterKey }
+func (msg MsgRotateConsPubKey) Type() string  { return "rotate_cons_pubkey" }
+func (msg MsgRotateConsPubKey) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{sdk.AccAddress(msg.ValidatorAddress)}
+}
+
+// MarshalJSON implements the json.Marshaler interface to provide custom JSON
+// serialization of the MsgRotateConsPubKey type.
+func (msg MsgRotateConsPubKey) MarshalJSON() ([]byte, error) {
+	return json.Marshal(msgRotateConsPubKeyJSON{
+		ValidatorAddress: msg.ValidatorAddress,
+		NewPubKey:        sdk.MustBech32ifyConsPub(msg.NewPubKey),
+	})
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface to provide custom
+// JSON deserialization of the MsgRotateConsPubKey type.
+func (msg *MsgRotateConsPubKey) UnmarshalJSON(bz []byte) error {
+	var msgRotateJSON msgRotateConsPubKeyJSON
+	if err := json.Unmarshal(bz, &msgRotateJSON); err != nil {
+		return err
+	}
+
+	msg.ValidatorAddress = msgRotateJSON.ValidatorAddress
+	var err error
+	msg.NewPubKey, err = sdk.GetConsPubKeyBech32(msgRotateJSON.NewPubKey)
+	return err
+}
+
+// get the bytes for the message signer to sign on
+func (msg MsgRotateConsPubKey) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+// quick validity check
+func (msg MsgRotateConsPubKey) ValidateBasic() sdk.Error {
+	if msg.ValidatorAddress.Empty() {
+		return ErrNilValidatorAddr(DefaultCodespace)
+	}
+	if msg.NewPubKey == nil {
+		return sdk.NewError(DefaultCodespace, CodeInvalidInput, "new consensus pubkey is nil")
+	}
+	return nil
+}
+
+// MsgTokenizeShares - struct for converting part of a delegation into
+// fungible share tokens of the validator
+type MsgTokenizeShares struct {
+	DelegatorAddress sdk.AccAddress `json:"delegator_address"`
+	ValidatorAddress sdk.ValAddress `json:"validator_address"`
+	Amount           sdk.Coin       `json:"amount"`
+}
+
+func NewMsgTokenizeShares(delAddr sdk.AccAddress, valAddr sdk.ValAddress, amount sdk.Coin) MsgTokenizeShares {
+	return MsgTokenizeShares{
+		DelegatorAddress: delAddr,
+		ValidatorAddress: valAddr,
+		Amount:           amount,
+	}
+}
+
+//nolint
+func (msg MsgTokenizeShares) Route() string { return RouterKey }
+func (msg MsgTokenizeShares) Type() string  { return "tokenize_shares" }
+func (msg MsgTokenizeShares) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.DelegatorAddress}
+}
+
+// get the bytes for the message signer to sign on
+func (msg MsgTokenizeShares) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+// quick validity check
+func (msg MsgTokenizeShares) ValidateBasic() sdk.Error {
+	if msg.DelegatorAddress.Empty() {
+		return ErrNilDelegatorAddr(DefaultCodespace)
+	}
+	if msg.ValidatorAddress.Empty() {
+		return ErrNilValidatorAddr(DefaultCodespace)
+	}
+	if msg.Amount.Amount.LTE(sdk.ZeroInt()) {
+		return ErrBadSharesAmount(DefaultCodespace)
+	}
+	return nil
+}
+
+// MsgRedeemTokensForShares - struct for redeeming share tokens of a validator
+// back into a delegation
+type MsgRedeemTokensForShares struct {
+	DelegatorAddress sdk.AccAddress `json:"delegator_address"`
+	Amount           sdk.Coin       `json:"amount"`
+}
+
+func NewMsgRedeemTokensForShares(delAddr sdk.AccAddress, amount sdk.Coin) MsgRedeemTokensForShares {
+	return MsgRedeemTokensForShares{
+		DelegatorAddress: delAddr,
+		Amount:           amount,
+	}
+}
+
+//nolint
+func (msg MsgRedeemTokensForShares) Route() string { return RouterKey }
+func (msg MsgRedeemTokensForShares) Type() string  { return "redeem_tokens_for_shares" }
+func (msg MsgRedeemTokensForShares) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.DelegatorAddress}
+}
+
+// get the bytes for the message signer to sign on
+func (msg MsgRedeemTokensForShares) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+// quick validity check
+func (msg MsgRedeemTokensForShares) ValidateBasic() sdk.Error {
+	if msg.DelegatorAddress.Empty() {
+		return ErrNilDelegatorAddr(DefaultCodespace)
+	}
+	if msg.Amount.Amount.LTE(sdk.ZeroInt()) {
+		return ErrBadSharesAmount(DefaultCodespace)
+	}
+	if !IsTokenizedSharesDenom(msg.Amount.Denom) {
+		return ErrNotTokenizedSharesDenom(DefaultCodespace, msg.Amount.Denom)
+	}
+	return nil
+}
@@ -154,3 +154,101 @@ func TestMsgUndelegate(t *testing.T) {
 		}
 	}
 }
+
+// test ValidateBasic for MsgRotateConsPubKey
+func TestMsgRotateConsPubKey(t *testing.T) {
+	tests := []struct {
+		name          string
+		validatorAddr sdk.ValAddress
+		pubkey        crypto.PubKey
+		expectPass    bool
+	}{
+		{"regular", valAddr1, pk2, true},
+		{"empty validator", emptyAddr, pk2, false},
+		{"empty pubkey", valAddr1, nil, false},
+	}
+
+	for _, tc := range tests {
+		msg := NewMsgRotateConsPubKey(tc.validatorAddr, tc.pubkey)
+		if tc.expectPass {
+			require.Nil(t, msg.ValidateBasic(), "test: %v", tc.name)
+		} else {
+			require.NotNil(t, msg.ValidateBasic(), "test: %v", tc.name)
+		}
+	}
+}
+
+// test ValidateBasic for MsgCancelUnbondingDelegation
+func TestMsgTokenizeShares(t *testing.T) {
+	tests := []struct {
+		name          string
+		delegatorAddr sdk.AccAddress
+		validatorAddr sdk.ValAddress
+		amount        sdk.Coin
+		expectPass    bool
+	}{
+		{"regular", sdk.AccAddress(valAddr1), valAddr2, sdk.NewInt64Coin(sdk.DefaultBondDenom, 1), true},
+		{"zero amount", sdk.AccAddress(valAddr1), valAddr2, sdk.NewInt64Coin(sdk.DefaultBondDenom, 0), false},
+		{"empty delegator", sdk.AccAddress(emptyAddr), valAddr1, sdk.NewInt64Coin(sdk.DefaultBondDenom, 1), false},
+		{"empty validator", sdk.AccAddress(valAddr1), emptyAddr, sdk.NewInt64Coin(sdk.DefaultBondDenom, 1), false},
+	}
+
+	for _, tc := range tests {
+		msg := NewMsgTokenizeShares(tc.delegatorAddr, tc.validatorAddr, tc.amount)
+		if tc.expectPass {
+			require.Nil(t, msg.ValidateBasic(), "test: %v", tc.name)
+		} else {
+			require.NotNil(t, msg.ValidateBasic(), "test: %v", tc.name)
+		}
+	}
+}
+
+func TestMsgRedeemTokensForShares(t *testing.T) {
+	tests := []struct {
+		name          string
+		delegatorAddr sdk.AccAddress
+		amount        sdk.Coin
+		expectPass    bool
+	}{
+		{"regular", sdk.AccAddress(valAddr1), sdk.NewInt64Coin(TokenizedSharesDenom(1), 1), true},
+		{"zero amount", sdk.AccAddress(valAddr1), sdk.NewInt64Coin(TokenizedSharesDenom(1), 0), false},
+		{"not a share token", sdk.AccAddress(valAddr1), sdk.NewInt64Coin(sdk.DefaultBondDenom, 1), false},
+		{"zero record ID", sdk.AccAddress(valAddr1), sdk.NewInt64Coin(TokenizedSharesDenom(0), 1), false},
+		{"empty delegator", sdk.AccAddress(emptyAddr), sdk.NewInt64Coin(TokenizedSharesDenom(1), 1), false},
+	}
+
+	for _, tc := range tests {
+		msg := NewMsgRedeemTokensForShares(tc.delegatorAddr, tc.amount)
+		if tc.expectPass {
+			require.Nil(t, msg.ValidateBasic(), "test: %v", tc.name)
+		} else {
+			require.NotNil(t, msg.ValidateBasic(), "test: %v", tc.name)
+		}
+	}
+}
+
+func TestMsgCancelUnbondingDelegation(t *testing.T) {
+	tests := []struct {
+		name           string
+		delegatorAddr  sdk.AccAddress
+		validatorAddr  sdk.ValAddress
+		amount         sdk.Coin
+		creationHeight int64
+		expectPass     bool
+	}{
+		{"regular", sdk.AccAddress(valAddr1), valAddr2, sdk.NewInt64Coin(sdk.DefaultBondDenom, 1), 1, true},
+		{"zero amount", sdk.AccAddress(valAddr1), valAddr2, sdk.NewInt64Coin(sdk.DefaultBondDenom, 0), 1, false},
+		{"negative creation height", sdk.AccAddress(valAddr1), valAddr2, sdk.NewInt64Coin(sdk.DefaultBondDenom, 1), -1, false},
+		{"empty delegator", sdk.AccAddress(emptyAddr), valAddr1, sdk.NewInt64Coin(sdk.DefaultBondDenom, 1), 1, false},
+		{"empty validator", sdk.AccAddress(valAddr1), emptyAddr, sdk.NewInt64Coin(sdk.DefaultBondDenom, 1), 1, false},
+	}
+
+	for _, tc := range tests {
+		msg := NewMsgCancelUnbondingDelegation(tc.delegatorAddr, tc.validatorAddr, tc.amount, tc.creationHeight)
+		if tc.expectPass {
+			require.Nil(t, msg.ValidateBasic(), "test: %v", tc.name)
+		} else {
+			require.NotNil(t, msg.ValidateBasic(), "test: %v", tc.name)
+		}
+	}
+}
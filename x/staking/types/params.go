@@ -21,45 +21,52 @@ const (
 
 	// Default maximum entries in a UBD/RED pair
 	DefaultMaxEntries uint16 = 7
+
+	// Default number of historical info entries to persist
+	DefaultHistoricalEntries uint16 = 100
 )
 
 // nolint - Keys for parameter access
 var (
-	KeyUnbondingTime = []byte("UnbondingTime")
-	KeyMaxValidators = []byte("MaxValidators")
-	KeyMaxEntries    = []byte("KeyMaxEntries")
-	KeyBondDenom     = []byte("BondDenom")
+	KeyUnbondingTime     = []byte("UnbondingTime")
+	KeyMaxValidators     = []byte("MaxValidators")
+	KeyMaxEntries        = []byte("KeyMaxEntries")
+	KeyHistoricalEntries = []byte("HistoricalEntries")
+	KeyBondDenom         = []byte("BondDenom")
 )
 
 var _ params.ParamSet = (*Params)(nil)
 
 // Params defines the high level settings for staking
 type Params struct {
-	UnbondingTime time.Duration `json:"unbonding_time"` // time duration of unbonding
-	MaxValidators uint16        `json:"max_validators"` // maximum number of validators (max uint16 = 65535)
-	MaxEntries    uint16        `json:"max_entries"`    // max entries for either unbonding delegation or redelegation (per pair/trio)
+	UnbondingTime     time.Duration `json:"unbonding_time"`     // time duration of unbonding
+	MaxValidators     uint16        `json:"max_validators"`     // maximum number of validators (max uint16 = 65535)
+	MaxEntries        uint16        `json:"max_entries"`        // max entries for either unbonding delegation or redelegation (per pair/trio)
+	HistoricalEntries uint16        `json:"historical_entries"` // number of historical info entries, of the most recent heights, to persist
 	// note: we need to be a bit careful about potential overflow here, since this is user-determined
 	BondDenom string `json:"bond_denom"` // bondable coin denomination
 }
 
-func NewParams(unbondingTime time.Duration, maxValidators, maxEntries uint16,
+func NewParams(unbondingTime time.Duration, maxValidators, maxEntries, historicalEntries uint16,
 	bondDenom string) Params {
 
 	return Params{
-		UnbondingTime: unbondingTime,
-		MaxValidators: maxValidators,
-		MaxEntries:    maxEntries,
-		BondDenom:     bondDenom,
+		UnbondingTime:     unbondingTime,
+		MaxValidators:     maxValidators,
+		MaxEntries:        maxEntries,
+		HistoricalEntries: historicalEntries,
+		BondDenom:         bondDenom,
 	}
 }
 
 // Implements params.ParamSet
 func (p *Params) ParamSetPairs() params.ParamSetPairs {
 	return params.ParamSetPairs{
-		{KeyUnbondingTime, &p.UnbondingTime},
-		{KeyMaxValidators, &p.MaxValidators},
-		{KeyMaxEntries, &p.MaxEntries},
-		{KeyBondDenom, &p.BondDenom},
+		params.NewParamSetPair(KeyUnbondingTime, &p.UnbondingTime, validateUnbondingTime),
+		params.NewParamSetPair(KeyMaxValidators, &p.MaxValidators, validateMaxValidators),
+		params.NewParamSetPair(KeyMaxEntries, &p.MaxEntries, validateMaxEntries),
+		params.NewParamSetPair(KeyHistoricalEntries, &p.HistoricalEntries, validateHistoricalEntries),
+		params.NewParamSetPair(KeyBondDenom, &p.BondDenom, validateBondDenom),
 	}
 }
 
@@ -73,17 +80,18 @@ func (p Params) Equal(p2 Params) bool {
 
 // DefaultParams returns a default set of parameters.
 func DefaultParams() Params {
-	return NewParams(DefaultUnbondingTime, DefaultMaxValidators, DefaultMaxEntries, sdk.DefaultBondDenom)
+	return NewParams(DefaultUnbondingTime, DefaultMaxValidators, DefaultMaxEntries, DefaultHistoricalEntries, sdk.DefaultBondDenom)
 }
 
 // String returns a human readable string representation of the parameters.
 func (p Params) String() string {
 	return fmt.Sprintf(`Params:
-  Unbonding Time:    %s
-  Max Validators:    %d
-  Max Entries:       %d
-  Bonded Coin Denom: %s`, p.UnbondingTime,
-		p.MaxValidators, p.MaxEntries, p.BondDenom)
+  Unbonding Time:     %s
+  Max Validators:     %d
+  Max Entries:        %d
+  Historical Entries: %d
+  Bonded Coin Denom:  %s`, p.UnbondingTime,
+		p.MaxValidators, p.MaxEntries, p.HistoricalEntries, p.BondDenom)
 }
 
 // unmarshal the current staking params value from store key or panic
@@ -106,11 +114,75 @@ func UnmarshalParams(cdc *codec.Codec, value []byte) (params Params, err error)
 
 // validate a set of params
 func (p Params) Validate() error {
-	if p.BondDenom == "" {
-		return fmt.Errorf("staking parameter BondDenom can't be an empty string")
+	if err := validateBondDenom(p.BondDenom); err != nil {
+		return err
+	}
+	if err := validateMaxValidators(p.MaxValidators); err != nil {
+		return err
+	}
+	return nil
+}
+
+func validateUnbondingTime(i interface{}) error {
+	v, ok := i.(time.Duration)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
 	}
-	if p.MaxValidators == 0 {
+
+	if v < 0 {
+		return fmt.Errorf("staking parameter UnbondingTime must not be negative: %s", v)
+	}
+
+	return nil
+}
+
+func validateMaxValidators(i interface{}) error {
+	v, ok := i.(uint16)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v == 0 {
 		return fmt.Errorf("staking parameter MaxValidators must be a positive integer")
 	}
+
+	return nil
+}
+
+func validateMaxEntries(i interface{}) error {
+	v, ok := i.(uint16)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v == 0 {
+		return fmt.Errorf("staking parameter MaxEntries must be a positive integer")
+	}
+
+	return nil
+}
+
+func validateHistoricalEntries(i interface{}) error {
+	_, ok := i.(uint16)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	return nil
+}
+
+func validateBondDenom(i interface{}) error {
+	v, ok := i.(string)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v == "" {
+		return fmt.Errorf("staking parameter BondDenom can't be an empty string")
+	}
+	if err := sdk.ValidateDenom(v); err != nil {
+		return fmt.Errorf("staking parameter BondDenom: %s", err)
+	}
+
 	return nil
 }
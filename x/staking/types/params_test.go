@@ -1,7 +1,9 @@
 package types
 
 import (
+	"reflect"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/require"
 )
@@ -19,3 +21,16 @@ func TestParamsEqual(t *testing.T) {
 	ok = p1.Equal(p2)
 	require.False(t, ok)
 }
+
+func TestParamSetPairsValidation(t *testing.T) {
+	p := DefaultParams()
+	for _, pair := range p.ParamSetPairs() {
+		require.NoError(t, pair.ValidatorFn(reflect.Indirect(reflect.ValueOf(pair.Value)).Interface()), string(pair.Key))
+	}
+
+	require.Error(t, validateMaxValidators(uint16(0)))
+	require.Error(t, validateMaxEntries(uint16(0)))
+	require.Error(t, validateBondDenom(""))
+	require.Error(t, validateUnbondingTime(time.Duration(-1)))
+	require.Error(t, validateMaxValidators(int64(1)))
+}
@@ -0,0 +1,46 @@
+package types
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/tendermint/tendermint/crypto"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// ConsPubKeyRotation is a pending rotation of the consensus pubkey of a
+// validator, which is applied at the end of the block in which it was
+// requested.
+type ConsPubKeyRotation struct {
+	ValidatorAddress sdk.ValAddress `json:"validator_address"`
+	NewPubKey        crypto.PubKey  `json:"new_pubkey"`
+}
+
+// NewConsPubKeyRotation creates a new ConsPubKeyRotation instance.
+func NewConsPubKeyRotation(valAddr sdk.ValAddress, newPubKey crypto.PubKey) ConsPubKeyRotation {
+	return ConsPubKeyRotation{
+		ValidatorAddress: valAddr,
+		NewPubKey:        newPubKey,
+	}
+}
+
+// return the rotation
+func MustMarshalConsPubKeyRotation(cdc *codec.Codec, rotation ConsPubKeyRotation) []byte {
+	return cdc.MustMarshalBinaryLengthPrefixed(rotation)
+}
+
+// unmarshal a rotation from a store value
+func MustUnmarshalConsPubKeyRotation(cdc *codec.Codec, value []byte) ConsPubKeyRotation {
+	var rotation ConsPubKeyRotation
+	cdc.MustUnmarshalBinaryLengthPrefixed(value, &rotation)
+	return rotation
+}
+
+// String implements the Stringer interface for a ConsPubKeyRotation.
+func (r ConsPubKeyRotation) String() string {
+	return strings.TrimSpace(fmt.Sprintf(`Consensus PubKey Rotation:
+  Validator:  %s
+  New PubKey: %s`, r.ValidatorAddress, sdk.MustBech32ifyConsPub(r.NewPubKey)))
+}
@@ -0,0 +1,67 @@
+package types
+
+import (
+	"strconv"
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// TokenizedSharesDenomPrefix is the prefix of the denom of the share tokens
+// of a validator, followed by the ID of its tokenized shares record. The IDs
+// are assigned sequentially so that no two validators share a denom, and the
+// denom stays within the 16 characters allowed for a coin denom up to 13 digit
+// IDs.
+const TokenizedSharesDenomPrefix = "stk"
+
+// TokenizedSharesDenom returns the denom of the share tokens of the tokenized
+// shares record with the given ID
+func TokenizedSharesDenom(id uint64) string {
+	return TokenizedSharesDenomPrefix + strconv.FormatUint(id, 10)
+}
+
+// TokenizedSharesRecordIDFromDenom returns the ID of the tokenized shares
+// record of a share tokens denom, and false if the denom does not have the
+// format of the share tokens
+func TokenizedSharesRecordIDFromDenom(denom string) (id uint64, ok bool) {
+	if !strings.HasPrefix(denom, TokenizedSharesDenomPrefix) || sdk.ValidateDenom(denom) != nil {
+		return 0, false
+	}
+
+	id, err := strconv.ParseUint(strings.TrimPrefix(denom, TokenizedSharesDenomPrefix), 10, 64)
+	if err != nil || id == 0 || TokenizedSharesDenom(id) != denom {
+		return 0, false
+	}
+
+	return id, true
+}
+
+// IsTokenizedSharesDenom returns true if the denom has the format of the
+// share tokens of a validator
+func IsTokenizedSharesDenom(denom string) bool {
+	_, ok := TokenizedSharesRecordIDFromDenom(denom)
+	return ok
+}
+
+// TokenizedSharesRecord is the state of the tokenized shares of a validator,
+// the rewards not in the bond denom accrued by the shares are held until the
+// share tokens are redeemed
+type TokenizedSharesRecord struct {
+	ID               uint64         `json:"id"`
+	ValidatorAddress sdk.ValAddress `json:"validator_address"`
+	Rewards          sdk.Coins      `json:"rewards"`
+}
+
+// NewTokenizedSharesRecord creates a new TokenizedSharesRecord instance
+func NewTokenizedSharesRecord(id uint64, valAddr sdk.ValAddress, rewards sdk.Coins) TokenizedSharesRecord {
+	return TokenizedSharesRecord{
+		ID:               id,
+		ValidatorAddress: valAddr,
+		Rewards:          rewards,
+	}
+}
+
+// GetShareTokenDenom returns the denom of the share tokens of the record
+func (r TokenizedSharesRecord) GetShareTokenDenom() string {
+	return TokenizedSharesDenom(r.ID)
+}
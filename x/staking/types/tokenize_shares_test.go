@@ -0,0 +1,37 @@
+package types
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestTokenizedSharesRecordIDFromDenom(t *testing.T) {
+	tests := []struct {
+		denom string
+		id    uint64
+		ok    bool
+	}{
+		{"stk1", 1, true},
+		{"stk42", 42, true},
+		{"stk9999999999999", 9999999999999, true},
+		{"stk0", 0, false},
+		{"stk01", 0, false},
+		{"stk", 0, false},
+		{"stk-1", 0, false},
+		{"stk1a", 0, false},
+		{"stk10000000000000", 0, false},
+		{"stake", 0, false},
+		{"foo1", 0, false},
+	}
+
+	for _, tc := range tests {
+		id, ok := TokenizedSharesRecordIDFromDenom(tc.denom)
+		require.Equal(t, tc.ok, ok, "denom: %s", tc.denom)
+		require.Equal(t, tc.id, id, "denom: %s", tc.denom)
+		require.Equal(t, tc.ok, IsTokenizedSharesDenom(tc.denom), "denom: %s", tc.denom)
+		if tc.ok {
+			require.Equal(t, tc.denom, TokenizedSharesDenom(id))
+		}
+	}
+}
@@ -303,41 +303,13 @@ func (v Validator) ABCIValidatorUpdateZero() abci.ValidatorUpdate {
 
 // UpdateStatus updates the location of the shares within a validator
 // to reflect the new status
-func (v Validator) UpdateStatus(pool Pool, NewStatus sdk.BondStatus) (Validator, Pool) {
-
-	switch v.Status {
-	case sdk.Unbonded:
-
-		switch NewStatus {
-		case sdk.Unbonded:
-			return v, pool
-		case sdk.Bonded:
-			pool = pool.notBondedTokensToBonded(v.Tokens)
-		}
-	case sdk.Unbonding:
-
-		switch NewStatus {
-		case sdk.Unbonding:
-			return v, pool
-		case sdk.Bonded:
-			pool = pool.notBondedTokensToBonded(v.Tokens)
-		}
-	case sdk.Bonded:
-
-		switch NewStatus {
-		case sdk.Bonded:
-			return v, pool
-		default:
-			pool = pool.bondedTokensToNotBonded(v.Tokens)
-		}
-	}
-
-	v.Status = NewStatus
-	return v, pool
+func (v Validator) UpdateStatus(newStatus sdk.BondStatus) Validator {
+	v.Status = newStatus
+	return v
 }
 
 // removes tokens from a validator
-func (v Validator) RemoveTokens(pool Pool, tokens sdk.Int) (Validator, Pool) {
+func (v Validator) RemoveTokens(tokens sdk.Int) Validator {
 	if tokens.IsNegative() {
 		panic(fmt.Sprintf("should not happen: trying to remove negative tokens %v", tokens))
 	}
@@ -345,11 +317,7 @@ func (v Validator) RemoveTokens(pool Pool, tokens sdk.Int) (Validator, Pool) {
 		panic(fmt.Sprintf("should not happen: only have %v tokens, trying to remove %v", v.Tokens, tokens))
 	}
 	v.Tokens = v.Tokens.Sub(tokens)
-	// TODO: It is not obvious from the name of the function that this will happen. Either justify or move outside.
-	if v.IsBonded() {
-		pool = pool.bondedTokensToNotBonded(tokens)
-	}
-	return v, pool
+	return v
 }
 
 // SetInitialCommission attempts to set a validator's initial commission. An
@@ -364,8 +332,7 @@ func (v Validator) SetInitialCommission(commission Commission) (Validator, sdk.E
 }
 
 // AddTokensFromDel adds tokens to a validator
-// CONTRACT: Tokens are assumed to have come from not-bonded pool.
-func (v Validator) AddTokensFromDel(pool Pool, amount sdk.Int) (Validator, Pool, sdk.Dec) {
+func (v Validator) AddTokensFromDel(amount sdk.Int) (Validator, sdk.Dec) {
 
 	// calculate the shares to issue
 	var issuedShares sdk.Dec
@@ -381,21 +348,16 @@ func (v Validator) AddTokensFromDel(pool Pool, amount sdk.Int) (Validator, Pool,
 		issuedShares = shares
 	}
 
-	if v.IsBonded() {
-		pool = pool.notBondedTokensToBonded(amount)
-	}
-
 	v.Tokens = v.Tokens.Add(amount)
 	v.DelegatorShares = v.DelegatorShares.Add(issuedShares)
 
-	return v, pool, issuedShares
+	return v, issuedShares
 }
 
 // RemoveDelShares removes delegator shares from a validator.
 // NOTE: because token fractions are left in the valiadator,
 //       the exchange rate of future shares of this validator can increase.
-// CONTRACT: Tokens are assumed to move to the not-bonded pool.
-func (v Validator) RemoveDelShares(pool Pool, delShares sdk.Dec) (Validator, Pool, sdk.Int) {
+func (v Validator) RemoveDelShares(delShares sdk.Dec) (Validator, sdk.Int) {
 
 	remainingShares := v.DelegatorShares.Sub(delShares)
 	var issuedTokens sdk.Int
@@ -416,11 +378,8 @@ func (v Validator) RemoveDelShares(pool Pool, delShares sdk.Dec) (Validator, Poo
 	}
 
 	v.DelegatorShares = remainingShares
-	if v.IsBonded() {
-		pool = pool.bondedTokensToNotBonded(issuedTokens)
-	}
 
-	return v, pool, issuedTokens
+	return v, issuedTokens
 }
 
 // In some situations, the exchange rate becomes invalid, e.g. if
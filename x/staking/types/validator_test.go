@@ -85,7 +85,6 @@ func TestShareTokens(t *testing.T) {
 }
 
 func TestRemoveTokens(t *testing.T) {
-
 	validator := Validator{
 		OperatorAddress: valAddr1,
 		ConsPubKey:      pk1,
@@ -94,37 +93,24 @@ func TestRemoveTokens(t *testing.T) {
 		DelegatorShares: sdk.NewDec(100),
 	}
 
-	pool := InitialPool()
-	pool.NotBondedTokens = sdk.NewInt(10)
-	pool.BondedTokens = validator.BondedTokens()
-
-	validator, pool = validator.UpdateStatus(pool, sdk.Bonded)
-	require.Equal(t, sdk.Bonded, validator.Status)
-
 	// remove tokens and test check everything
-	validator, pool = validator.RemoveTokens(pool, sdk.NewInt(10))
+	validator = validator.RemoveTokens(sdk.NewInt(10))
 	require.Equal(t, int64(90), validator.Tokens.Int64())
-	require.Equal(t, int64(90), pool.BondedTokens.Int64())
-	require.Equal(t, int64(20), pool.NotBondedTokens.Int64())
 
 	// update validator to unbonded and remove some more tokens
-	validator, pool = validator.UpdateStatus(pool, sdk.Unbonded)
+	validator = validator.UpdateStatus(sdk.Unbonded)
 	require.Equal(t, sdk.Unbonded, validator.Status)
-	require.Equal(t, int64(0), pool.BondedTokens.Int64())
-	require.Equal(t, int64(110), pool.NotBondedTokens.Int64())
 
-	validator, pool = validator.RemoveTokens(pool, sdk.NewInt(10))
+	validator = validator.RemoveTokens(sdk.NewInt(10))
+	require.Panics(t, func() { validator.RemoveTokens(sdk.NewInt(-1)) })
+	require.Panics(t, func() { validator.RemoveTokens(sdk.NewInt(100)) })
 	require.Equal(t, int64(80), validator.Tokens.Int64())
-	require.Equal(t, int64(0), pool.BondedTokens.Int64())
-	require.Equal(t, int64(110), pool.NotBondedTokens.Int64())
 }
 
 func TestAddTokensValidatorBonded(t *testing.T) {
-	pool := InitialPool()
-	pool.NotBondedTokens = sdk.NewInt(10)
 	validator := NewValidator(valAddr1, pk1, Description{})
-	validator, pool = validator.UpdateStatus(pool, sdk.Bonded)
-	validator, pool, delShares := validator.AddTokensFromDel(pool, sdk.NewInt(10))
+	validator = validator.UpdateStatus(sdk.Bonded)
+	validator, delShares := validator.AddTokensFromDel(sdk.NewInt(10))
 
 	assert.True(sdk.DecEq(t, sdk.NewDec(10), delShares))
 	assert.True(sdk.IntEq(t, sdk.NewInt(10), validator.BondedTokens()))
@@ -132,11 +118,9 @@ func TestAddTokensValidatorBonded(t *testing.T) {
 }
 
 func TestAddTokensValidatorUnbonding(t *testing.T) {
-	pool := InitialPool()
-	pool.NotBondedTokens = sdk.NewInt(10)
 	validator := NewValidator(valAddr1, pk1, Description{})
-	validator, pool = validator.UpdateStatus(pool, sdk.Unbonding)
-	validator, pool, delShares := validator.AddTokensFromDel(pool, sdk.NewInt(10))
+	validator = validator.UpdateStatus(sdk.Unbonding)
+	validator, delShares := validator.AddTokensFromDel(sdk.NewInt(10))
 
 	assert.True(sdk.DecEq(t, sdk.NewDec(10), delShares))
 	assert.Equal(t, sdk.Unbonding, validator.Status)
@@ -145,11 +129,9 @@ func TestAddTokensValidatorUnbonding(t *testing.T) {
 }
 
 func TestAddTokensValidatorUnbonded(t *testing.T) {
-	pool := InitialPool()
-	pool.NotBondedTokens = sdk.NewInt(10)
 	validator := NewValidator(valAddr1, pk1, Description{})
-	validator, pool = validator.UpdateStatus(pool, sdk.Unbonded)
-	validator, pool, delShares := validator.AddTokensFromDel(pool, sdk.NewInt(10))
+	validator = validator.UpdateStatus(sdk.Unbonded)
+	validator, delShares := validator.AddTokensFromDel(sdk.NewInt(10))
 
 	assert.True(sdk.DecEq(t, sdk.NewDec(10), delShares))
 	assert.Equal(t, sdk.Unbonded, validator.Status)
@@ -166,22 +148,12 @@ func TestRemoveDelShares(t *testing.T) {
 		Tokens:          sdk.NewInt(100),
 		DelegatorShares: sdk.NewDec(100),
 	}
-	poolA := InitialPool()
-	poolA.NotBondedTokens = sdk.NewInt(10)
-	poolA.BondedTokens = valA.BondedTokens()
 
 	// Remove delegator shares
-	valB, poolB, coinsB := valA.RemoveDelShares(poolA, sdk.NewDec(10))
+	valB, coinsB := valA.RemoveDelShares(sdk.NewDec(10))
 	require.Equal(t, int64(10), coinsB.Int64())
 	require.Equal(t, int64(90), valB.DelegatorShares.RoundInt64())
 	require.Equal(t, int64(90), valB.BondedTokens().Int64())
-	require.Equal(t, int64(90), poolB.BondedTokens.Int64())
-	require.Equal(t, int64(20), poolB.NotBondedTokens.Int64())
-
-	// conservation of tokens
-	require.True(sdk.IntEq(t,
-		poolB.NotBondedTokens.Add(poolB.BondedTokens),
-		poolA.NotBondedTokens.Add(poolA.BondedTokens)))
 
 	// specific case from random tests
 	poolTokens := sdk.NewInt(5102)
@@ -193,80 +165,56 @@ func TestRemoveDelShares(t *testing.T) {
 		Tokens:          poolTokens,
 		DelegatorShares: delShares,
 	}
-	pool := Pool{
-		BondedTokens:    sdk.NewInt(248305),
-		NotBondedTokens: sdk.NewInt(232147),
-	}
+
 	shares := sdk.NewDec(29)
-	_, newPool, tokens := validator.RemoveDelShares(pool, shares)
+	_, tokens := validator.RemoveDelShares(shares)
 
 	require.True(sdk.IntEq(t, sdk.NewInt(1286), tokens))
-
-	require.True(sdk.IntEq(t,
-		newPool.NotBondedTokens.Add(newPool.BondedTokens),
-		pool.NotBondedTokens.Add(pool.BondedTokens)))
 }
 
 func TestAddTokensFromDel(t *testing.T) {
 	val := NewValidator(valAddr1, pk1, Description{})
-	pool := InitialPool()
-	pool.NotBondedTokens = sdk.NewInt(10)
 
-	val, pool, shares := val.AddTokensFromDel(pool, sdk.NewInt(6))
+	val, shares := val.AddTokensFromDel(sdk.NewInt(6))
 	require.True(sdk.DecEq(t, sdk.NewDec(6), shares))
 	require.True(sdk.DecEq(t, sdk.NewDec(6), val.DelegatorShares))
 	require.True(sdk.IntEq(t, sdk.NewInt(6), val.Tokens))
-	require.True(sdk.IntEq(t, sdk.NewInt(0), pool.BondedTokens))
-	require.True(sdk.IntEq(t, sdk.NewInt(10), pool.NotBondedTokens))
 
-	val, pool, shares = val.AddTokensFromDel(pool, sdk.NewInt(3))
+	val, shares = val.AddTokensFromDel(sdk.NewInt(3))
 	require.True(sdk.DecEq(t, sdk.NewDec(3), shares))
 	require.True(sdk.DecEq(t, sdk.NewDec(9), val.DelegatorShares))
 	require.True(sdk.IntEq(t, sdk.NewInt(9), val.Tokens))
-	require.True(sdk.IntEq(t, sdk.NewInt(0), pool.BondedTokens))
-	require.True(sdk.IntEq(t, sdk.NewInt(10), pool.NotBondedTokens))
 }
 
 func TestUpdateStatus(t *testing.T) {
-	pool := InitialPool()
-	pool.NotBondedTokens = sdk.NewInt(100)
-
 	validator := NewValidator(valAddr1, pk1, Description{})
-	validator, pool, _ = validator.AddTokensFromDel(pool, sdk.NewInt(100))
+	validator, _ = validator.AddTokensFromDel(sdk.NewInt(100))
 	require.Equal(t, sdk.Unbonded, validator.Status)
 	require.Equal(t, int64(100), validator.Tokens.Int64())
-	require.Equal(t, int64(0), pool.BondedTokens.Int64())
-	require.Equal(t, int64(100), pool.NotBondedTokens.Int64())
 
-	validator, pool = validator.UpdateStatus(pool, sdk.Bonded)
+	// Unbonded to Bonded
+	validator = validator.UpdateStatus(sdk.Bonded)
 	require.Equal(t, sdk.Bonded, validator.Status)
-	require.Equal(t, int64(100), validator.Tokens.Int64())
-	require.Equal(t, int64(100), pool.BondedTokens.Int64())
-	require.Equal(t, int64(0), pool.NotBondedTokens.Int64())
 
-	validator, pool = validator.UpdateStatus(pool, sdk.Unbonding)
+	// Bonded to Unbonding
+	validator = validator.UpdateStatus(sdk.Unbonding)
 	require.Equal(t, sdk.Unbonding, validator.Status)
-	require.Equal(t, int64(100), validator.Tokens.Int64())
-	require.Equal(t, int64(0), pool.BondedTokens.Int64())
-	require.Equal(t, int64(100), pool.NotBondedTokens.Int64())
+
+	// Unbonding to Bonded
+	validator = validator.UpdateStatus(sdk.Bonded)
+	require.Equal(t, sdk.Bonded, validator.Status)
 }
 
 func TestPossibleOverflow(t *testing.T) {
-	poolTokens := sdk.NewInt(2159)
 	delShares := sdk.NewDec(391432570689183511).Quo(sdk.NewDec(40113011844664))
 	validator := Validator{
 		OperatorAddress: valAddr1,
 		ConsPubKey:      pk1,
 		Status:          sdk.Bonded,
-		Tokens:          poolTokens,
+		Tokens:          sdk.NewInt(2159),
 		DelegatorShares: delShares,
 	}
-	pool := Pool{
-		NotBondedTokens: sdk.NewInt(100),
-		BondedTokens:    poolTokens,
-	}
-	tokens := int64(71)
-	newValidator, _, _ := validator.AddTokensFromDel(pool, sdk.NewInt(tokens))
+	newValidator, _ := validator.AddTokensFromDel(sdk.NewInt(71))
 
 	require.False(t, newValidator.DelegatorShares.IsNegative())
 	require.False(t, newValidator.Tokens.IsNegative())
@@ -0,0 +1,48 @@
+// nolint
+// autogenerated code using github.com/rigelrozanski/multitool
+// aliases generated for the following subdirectories:
+// ALIASGEN: github.com/cosmos/cosmos-sdk/x/supply/keeper
+// ALIASGEN: github.com/cosmos/cosmos-sdk/x/supply/types
+package supply
+
+import (
+	"github.com/cosmos/cosmos-sdk/x/supply/keeper"
+	"github.com/cosmos/cosmos-sdk/x/supply/types"
+)
+
+const (
+	ModuleName       = types.ModuleName
+	StoreKey         = types.StoreKey
+	RouterKey        = types.RouterKey
+	QuerierRoute     = types.QuerierRoute
+	QueryTotalSupply = types.QueryTotalSupply
+	QuerySupplyOf    = types.QuerySupplyOf
+)
+
+var (
+	// functions aliases
+	RegisterInvariants       = keeper.RegisterInvariants
+	AllInvariants            = keeper.AllInvariants
+	TotalSupply              = keeper.TotalSupply
+	NewKeeper                = keeper.NewKeeper
+	NewQuerier               = keeper.NewQuerier
+	RegisterCodec            = types.RegisterCodec
+	NewGenesisState          = types.NewGenesisState
+	DefaultGenesisState      = types.DefaultGenesisState
+	NewPermissionsForAddress = types.NewPermissionsForAddress
+	NewQuerySupplyOfParams   = types.NewQuerySupplyOfParams
+	NewSupply                = types.NewSupply
+	DefaultSupply            = types.DefaultSupply
+
+	// variable aliases
+	SupplyKey = keeper.SupplyKey
+	ModuleCdc = types.ModuleCdc
+)
+
+type (
+	Keeper                = keeper.Keeper
+	GenesisState          = types.GenesisState
+	PermissionsForAddress = types.PermissionsForAddress
+	QuerySupplyOfParams   = types.QuerySupplyOfParams
+	Supply                = types.Supply
+)
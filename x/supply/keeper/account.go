@@ -0,0 +1,86 @@
+package keeper
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/auth"
+)
+
+// GetModuleAddress returns an address based on the module name. It returns nil
+// if the module has not been registered in the keeper.
+func (k Keeper) GetModuleAddress(moduleName string) sdk.AccAddress {
+	permAddr, ok := k.permAddrs[moduleName]
+	if !ok {
+		return nil
+	}
+	return permAddr.GetAddress()
+}
+
+// GetModuleAddressAndPermissions returns an address and permissions based on
+// the module name
+func (k Keeper) GetModuleAddressAndPermissions(moduleName string) (addr sdk.AccAddress, permissions []string) {
+	permAddr, ok := k.permAddrs[moduleName]
+	if !ok {
+		return addr, permissions
+	}
+	return permAddr.GetAddress(), permAddr.GetPermissions()
+}
+
+// GetModuleAccountAndPermissions gets the module account from the auth account
+// store and its registered permissions. The account is created if it doesn't
+// exist yet.
+func (k Keeper) GetModuleAccountAndPermissions(ctx sdk.Context, moduleName string) (auth.ModuleAccountI, []string) {
+	addr, perms := k.GetModuleAddressAndPermissions(moduleName)
+	if addr == nil {
+		return nil, []string{}
+	}
+
+	acc := k.ak.GetAccount(ctx, addr)
+	if acc != nil {
+		macc, ok := acc.(auth.ModuleAccountI)
+		if !ok {
+			panic(fmt.Sprintf("account %s is not a module account", addr))
+		}
+		return macc, perms
+	}
+
+	// create a new module account
+	macc := auth.NewEmptyModuleAccount(moduleName, perms...)
+	maccI := (k.ak.NewAccount(ctx, macc)).(auth.ModuleAccountI) // set the account number
+	k.SetModuleAccount(ctx, maccI)
+
+	return maccI, perms
+}
+
+// GetModuleAccount gets the module account from the auth account store. The
+// account is created if it doesn't exist yet.
+func (k Keeper) GetModuleAccount(ctx sdk.Context, moduleName string) auth.ModuleAccountI {
+	acc, _ := k.GetModuleAccountAndPermissions(ctx, moduleName)
+	return acc
+}
+
+// SetModuleAccount sets the module account to the auth account store
+func (k Keeper) SetModuleAccount(ctx sdk.Context, macc auth.ModuleAccountI) {
+	k.ak.SetAccount(ctx, macc)
+}
+
+// GetModuleAccountCoins returns the coins held by a module account. It returns
+// empty coins if the module has not been registered in the keeper.
+func (k Keeper) GetModuleAccountCoins(ctx sdk.Context, moduleName string) sdk.Coins {
+	addr := k.GetModuleAddress(moduleName)
+	if addr == nil {
+		return sdk.NewCoins()
+	}
+	return k.bk.GetCoins(ctx, addr)
+}
+
+// SetModuleAccountCoins sets the coins held by a module account. It is meant
+// to be used only when initializing the module balances at genesis.
+func (k Keeper) SetModuleAccountCoins(ctx sdk.Context, moduleName string, amt sdk.Coins) sdk.Error {
+	macc := k.GetModuleAccount(ctx, moduleName)
+	if macc == nil {
+		return sdk.ErrUnknownAddress(fmt.Sprintf("module account %s does not exist", moduleName))
+	}
+	return k.bk.SetCoins(ctx, macc.GetAddress(), amt)
+}
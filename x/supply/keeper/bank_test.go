@@ -0,0 +1,126 @@
+package keeper
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/auth"
+	"github.com/cosmos/cosmos-sdk/x/supply/types"
+)
+
+func TestSendCoins(t *testing.T) {
+	nAccs := int64(4)
+	ctx, ak, keeper := createTestInput(t, false, initialPower, nAccs)
+
+	baseAcc := ak.NewAccountWithAddress(ctx, auth.NewModuleAddress("baseAcc"))
+
+	keeper.SetModuleAccount(ctx, holderAcc)
+	keeper.SetModuleAccount(ctx, burnerAcc)
+	ak.SetAccount(ctx, baseAcc)
+
+	err := keeper.SetModuleAccountCoins(ctx, holder, initCoins)
+	require.NoError(t, err)
+
+	require.Error(t, keeper.SendCoinsFromModuleToModule(ctx, "", holder, initCoins))
+	require.Error(t, keeper.SendCoinsFromModuleToModule(ctx, auth.Burner, "", initCoins))
+	require.Error(t, keeper.SendCoinsFromModuleToAccount(ctx, "", baseAcc.GetAddress(), initCoins))
+
+	require.Error(
+		t,
+		keeper.SendCoinsFromModuleToAccount(ctx, holder, baseAcc.GetAddress(), initCoins.Add(initCoins)),
+	)
+
+	err = keeper.SendCoinsFromModuleToModule(ctx, holder, auth.Burner, initCoins)
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewCoins(), keeper.GetModuleAccountCoins(ctx, holder))
+	require.Equal(t, initCoins, keeper.GetModuleAccountCoins(ctx, auth.Burner))
+
+	err = keeper.SendCoinsFromModuleToAccount(ctx, auth.Burner, baseAcc.GetAddress(), initCoins)
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewCoins(), keeper.GetModuleAccountCoins(ctx, auth.Burner))
+	require.Equal(t, initCoins, keeper.bk.GetCoins(ctx, baseAcc.GetAddress()))
+
+	err = keeper.SendCoinsFromAccountToModule(ctx, baseAcc.GetAddress(), auth.Burner, initCoins)
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewCoins(), keeper.bk.GetCoins(ctx, baseAcc.GetAddress()))
+	require.Equal(t, initCoins, keeper.GetModuleAccountCoins(ctx, auth.Burner))
+}
+
+func TestMintCoins(t *testing.T) {
+	nAccs := int64(4)
+	ctx, _, keeper := createTestInput(t, false, initialPower, nAccs)
+
+	keeper.SetModuleAccount(ctx, burnerAcc)
+	keeper.SetModuleAccount(ctx, minterAcc)
+	keeper.SetModuleAccount(ctx, multiPermAcc)
+	keeper.SetModuleAccount(ctx, randomPermAcc)
+
+	initialSupply := keeper.GetSupply(ctx)
+
+	require.Error(t, keeper.MintCoins(ctx, "", initCoins), "no module account")
+	require.Panics(t, func() { keeper.MintCoins(ctx, auth.Burner, initCoins) }, "invalid permission")
+	require.Panics(t, func() {
+		keeper.MintCoins(ctx, auth.Minter, sdk.Coins{sdk.Coin{Denom: "denom", Amount: sdk.NewInt(-10)}})
+	}, "insufficient coins")
+	require.Panics(t, func() { keeper.MintCoins(ctx, randomPerm, initCoins) })
+
+	err := keeper.MintCoins(ctx, auth.Minter, initCoins)
+	require.NoError(t, err)
+	require.Equal(t, initCoins, keeper.GetModuleAccountCoins(ctx, auth.Minter))
+	require.Equal(t, initialSupply.Total.Add(initCoins), keeper.GetSupply(ctx).Total)
+
+	// test same functionality on module account with multiple permissions
+	initialSupply = keeper.GetSupply(ctx)
+
+	err = keeper.MintCoins(ctx, multiPermAcc.GetName(), initCoins)
+	require.NoError(t, err)
+	require.Equal(t, initCoins, keeper.GetModuleAccountCoins(ctx, multiPermAcc.GetName()))
+	require.Equal(t, initialSupply.Total.Add(initCoins), keeper.GetSupply(ctx).Total)
+}
+
+func TestBurnCoins(t *testing.T) {
+	nAccs := int64(4)
+	ctx, _, keeper := createTestInput(t, false, initialPower, nAccs)
+
+	keeper.SetModuleAccount(ctx, burnerAcc)
+	require.NoError(t, keeper.SetModuleAccountCoins(ctx, auth.Burner, initCoins))
+
+	initialSupply := keeper.GetSupply(ctx)
+	initialSupply = initialSupply.Inflate(initCoins)
+	keeper.SetSupply(ctx, initialSupply)
+
+	require.Error(t, keeper.BurnCoins(ctx, "", initCoins), "no module account")
+	require.Panics(t, func() { keeper.BurnCoins(ctx, auth.Minter, initCoins) }, "invalid permission")
+	require.Panics(t, func() { keeper.BurnCoins(ctx, randomPerm, initialSupply.Total) }, "random permission")
+	require.Panics(t, func() { keeper.BurnCoins(ctx, auth.Burner, initialSupply.Total) }, "insufficient coins")
+
+	err := keeper.BurnCoins(ctx, auth.Burner, initCoins)
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewCoins(), keeper.GetModuleAccountCoins(ctx, auth.Burner))
+	require.Equal(t, initialSupply.Total.Sub(initCoins), keeper.GetSupply(ctx).Total)
+
+	// test same functionality on module account with multiple permissions
+	initialSupply = keeper.GetSupply(ctx)
+	initialSupply = initialSupply.Inflate(initCoins)
+	keeper.SetSupply(ctx, initialSupply)
+
+	keeper.SetModuleAccount(ctx, multiPermAcc)
+	require.NoError(t, keeper.SetModuleAccountCoins(ctx, multiPermAcc.GetName(), initCoins))
+
+	err = keeper.BurnCoins(ctx, multiPermAcc.GetName(), initCoins)
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewCoins(), keeper.GetModuleAccountCoins(ctx, multiPermAcc.GetName()))
+	require.Equal(t, initialSupply.Total.Sub(initCoins), keeper.GetSupply(ctx).Total)
+}
+
+func TestTotalSupplyInvariant(t *testing.T) {
+	nAccs := int64(4)
+	ctx, _, keeper := createTestInput(t, false, initialPower, nAccs)
+
+	require.NoError(t, TotalSupply(keeper)(ctx))
+
+	keeper.SetSupply(ctx, types.NewSupply(initCoins))
+	require.Error(t, TotalSupply(keeper)(ctx))
+}
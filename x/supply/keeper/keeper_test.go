@@ -0,0 +1,49 @@
+package keeper
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/auth"
+	"github.com/cosmos/cosmos-sdk/x/supply/types"
+)
+
+func TestSupply(t *testing.T) {
+	initialPower := int64(100)
+	initTokens := sdk.TokensFromTendermintPower(initialPower)
+	nAccs := int64(4)
+
+	ctx, _, keeper := createTestInput(t, false, initialPower, nAccs)
+
+	total := keeper.GetSupply(ctx).Total
+	expectedTotal := sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, initTokens.MulRaw(nAccs)))
+
+	require.Equal(t, expectedTotal, total)
+
+	keeper.SetSupply(ctx, types.NewSupply(initCoins))
+	require.Equal(t, initCoins, keeper.GetSupply(ctx).Total)
+}
+
+func TestGetModuleAccount(t *testing.T) {
+	ctx, ak, keeper := createTestInput(t, false, initialPower, 0)
+
+	// unregistered module
+	require.Nil(t, keeper.GetModuleAddress("unregistered"))
+	require.Nil(t, keeper.GetModuleAccount(ctx, "unregistered"))
+
+	// the account is created on first access
+	require.Nil(t, ak.GetAccount(ctx, keeper.GetModuleAddress(auth.Minter)))
+	macc := keeper.GetModuleAccount(ctx, auth.Minter)
+	require.NotNil(t, macc)
+	require.Equal(t, auth.Minter, macc.GetName())
+	require.True(t, macc.HasPermission(auth.Minter))
+	require.False(t, macc.HasPermission(auth.Burner))
+	require.Equal(t, macc, ak.GetAccount(ctx, keeper.GetModuleAddress(auth.Minter)))
+
+	// a regular account at the module address can't be used as a module account
+	baseAcc := ak.NewAccountWithAddress(ctx, keeper.GetModuleAddress(holder))
+	ak.SetAccount(ctx, baseAcc)
+	require.Panics(t, func() { keeper.GetModuleAccount(ctx, holder) })
+}
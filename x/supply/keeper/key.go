@@ -0,0 +1,9 @@
+package keeper
+
+// Keys for supply store
+// Items are stored with the following key: values
+//
+// - 0x00: Supply
+var (
+	SupplyKey = []byte{0x00}
+)
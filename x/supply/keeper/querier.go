@@ -0,0 +1,57 @@
+package keeper
+
+import (
+	"fmt"
+
+	abci "github.com/tendermint/tendermint/abci/types"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/supply/types"
+)
+
+// NewQuerier creates a querier for supply REST endpoints
+func NewQuerier(k Keeper) sdk.Querier {
+	return func(ctx sdk.Context, path []string, req abci.RequestQuery) ([]byte, sdk.Error) {
+		switch path[0] {
+
+		case types.QueryTotalSupply:
+			return queryTotalSupply(ctx, k)
+
+		case types.QuerySupplyOf:
+			return querySupplyOf(ctx, req, k)
+
+		default:
+			return nil, sdk.ErrUnknownRequest(fmt.Sprintf("unknown supply query endpoint: %s", path[0]))
+		}
+	}
+}
+
+func queryTotalSupply(ctx sdk.Context, k Keeper) ([]byte, sdk.Error) {
+	totalSupply := k.GetSupply(ctx).Total
+
+	res, err := codec.MarshalJSONIndent(k.cdc, totalSupply)
+	if err != nil {
+		return nil, sdk.ErrInternal(sdk.AppendMsgToErr("failed to JSON marshal result: %s", err.Error()))
+	}
+
+	return res, nil
+}
+
+func querySupplyOf(ctx sdk.Context, req abci.RequestQuery, k Keeper) ([]byte, sdk.Error) {
+	var params types.QuerySupplyOfParams
+
+	err := k.cdc.UnmarshalJSON(req.Data, &params)
+	if err != nil {
+		return nil, sdk.ErrUnknownRequest(sdk.AppendMsgToErr("incorrectly formatted request data", err.Error()))
+	}
+
+	supply := k.GetSupply(ctx).Total.AmountOf(params.Denom)
+
+	res, err := codec.MarshalJSONIndent(k.cdc, supply)
+	if err != nil {
+		return nil, sdk.ErrInternal(sdk.AppendMsgToErr("failed to JSON marshal result: %s", err.Error()))
+	}
+
+	return res, nil
+}
@@ -0,0 +1,56 @@
+package keeper
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	abci "github.com/tendermint/tendermint/abci/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/supply/types"
+)
+
+func TestNewQuerier(t *testing.T) {
+	ctx, _, keeper := createTestInput(t, false, 1000, 2)
+
+	supplyCoins := sdk.NewCoins(
+		sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(100)),
+		sdk.NewCoin("photon", sdk.NewInt(50)),
+	)
+	keeper.SetSupply(ctx, types.NewSupply(supplyCoins))
+
+	query := abci.RequestQuery{
+		Path: "",
+		Data: []byte{},
+	}
+
+	querier := NewQuerier(keeper)
+
+	bz, err := querier(ctx, []string{"other"}, query)
+	require.NotNil(t, err)
+	require.Nil(t, bz)
+
+	bz, err = querier(ctx, []string{types.QueryTotalSupply}, query)
+	require.Nil(t, err)
+
+	var total sdk.Coins
+	require.NoError(t, keeper.cdc.UnmarshalJSON(bz, &total))
+	require.Equal(t, supplyCoins, total)
+
+	query.Path = fmt.Sprintf("/custom/supply/%s", types.QuerySupplyOf)
+	query.Data = keeper.cdc.MustMarshalJSON(types.NewQuerySupplyOfParams("photon"))
+
+	bz, err = querier(ctx, []string{types.QuerySupplyOf}, query)
+	require.Nil(t, err)
+
+	var supply sdk.Int
+	require.NoError(t, keeper.cdc.UnmarshalJSON(bz, &supply))
+	require.True(t, supply.Equal(sdk.NewInt(50)))
+
+	// invalid request data
+	query.Data = []byte("invalid")
+	_, err = querier(ctx, []string{types.QuerySupplyOf}, query)
+	require.NotNil(t, err)
+}
@@ -0,0 +1,121 @@
+// nolint: deadcode unused
+package keeper
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	abci "github.com/tendermint/tendermint/abci/types"
+	"github.com/tendermint/tendermint/crypto/secp256k1"
+	dbm "github.com/tendermint/tendermint/libs/db"
+	"github.com/tendermint/tendermint/libs/log"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/store"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/auth"
+	"github.com/cosmos/cosmos-sdk/x/bank"
+	"github.com/cosmos/cosmos-sdk/x/params"
+	"github.com/cosmos/cosmos-sdk/x/supply/types"
+)
+
+// nolint: deadcode unused
+const (
+	holder     = "holder"
+	multiPerm  = "multiple permissions account"
+	randomPerm = "random permission"
+)
+
+// nolint: deadcode unused
+var (
+	holderAcc     = auth.NewEmptyModuleAccount(holder)
+	burnerAcc     = auth.NewEmptyModuleAccount(auth.Burner, auth.Burner)
+	minterAcc     = auth.NewEmptyModuleAccount(auth.Minter, auth.Minter)
+	multiPermAcc  = auth.NewEmptyModuleAccount(multiPerm, auth.Burner, auth.Minter, auth.Staking)
+	randomPermAcc = auth.NewEmptyModuleAccount(randomPerm)
+
+	initTokens = sdk.TokensFromTendermintPower(initialPower)
+	initCoins  = sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, initTokens))
+)
+
+// nolint: deadcode unused
+const initialPower = int64(100)
+
+// create a codec used only for testing
+func makeTestCodec() *codec.Codec {
+	var cdc = codec.New()
+
+	bank.RegisterCodec(cdc)
+	auth.RegisterCodec(cdc)
+	types.RegisterCodec(cdc)
+	codec.RegisterCrypto(cdc)
+
+	return cdc
+}
+
+// nolint: deadcode unused
+func createTestInput(t *testing.T, isCheckTx bool, initPower int64, nAccs int64) (sdk.Context, auth.AccountKeeper, Keeper) {
+
+	keyAcc := sdk.NewKVStoreKey(auth.StoreKey)
+	keyParams := sdk.NewKVStoreKey(params.StoreKey)
+	tkeyParams := sdk.NewTransientStoreKey(params.TStoreKey)
+	keyBank := sdk.NewKVStoreKey(bank.StoreKey)
+	keySupply := sdk.NewKVStoreKey(types.StoreKey)
+
+	db := dbm.NewMemDB()
+	ms := store.NewCommitMultiStore(db)
+	ms.MountStoreWithDB(keyAcc, sdk.StoreTypeIAVL, db)
+	ms.MountStoreWithDB(keyParams, sdk.StoreTypeIAVL, db)
+	ms.MountStoreWithDB(tkeyParams, sdk.StoreTypeTransient, db)
+	ms.MountStoreWithDB(keyBank, sdk.StoreTypeIAVL, db)
+	ms.MountStoreWithDB(keySupply, sdk.StoreTypeIAVL, db)
+	err := ms.LoadLatestVersion()
+	require.Nil(t, err)
+
+	ctx := sdk.NewContext(ms, abci.Header{ChainID: "supply-chain"}, isCheckTx, log.NewNopLogger())
+	cdc := makeTestCodec()
+
+	pk := params.NewKeeper(cdc, keyParams, tkeyParams, params.DefaultCodespace)
+	ak := auth.NewAccountKeeper(cdc, keyAcc, pk.Subspace(auth.DefaultParamspace), auth.ProtoBaseAccount)
+	bk := bank.NewBaseKeeper(cdc, keyBank, ak, pk.Subspace(bank.DefaultParamspace), bank.DefaultCodespace, make(map[string]bool))
+
+	valTokens := sdk.TokensFromTendermintPower(initPower)
+
+	initialCoins := sdk.NewCoins(
+		sdk.NewCoin(sdk.DefaultBondDenom, valTokens),
+	)
+
+	createTestAccs(ctx, int(nAccs), initialCoins, &ak, bk)
+
+	maccPerms := map[string][]string{
+		holder:      nil,
+		auth.Minter: {auth.Minter},
+		auth.Burner: {auth.Burner},
+		multiPerm:   {auth.Minter, auth.Burner, auth.Staking},
+		randomPerm:  {"random"},
+	}
+	keeper := NewKeeper(cdc, keySupply, ak, bk, maccPerms)
+	totalSupply := sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, valTokens.MulRaw(nAccs)))
+
+	keeper.SetSupply(ctx, types.NewSupply(totalSupply))
+
+	return ctx, ak, keeper
+}
+
+// nolint: unparam deadcode unused
+func createTestAccs(ctx sdk.Context, numAccs int, initialCoins sdk.Coins, ak *auth.AccountKeeper, bk bank.Keeper) (accs []auth.Account) {
+	for i := 0; i < numAccs; i++ {
+		privKey := secp256k1.GenPrivKey()
+		pubKey := privKey.PubKey()
+		addr := sdk.AccAddress(pubKey.Address())
+		acc := auth.NewBaseAccountWithAddress(addr)
+		acc.PubKey = pubKey
+		acc.AccountNumber = uint64(i)
+		ak.SetAccount(ctx, &acc)
+		if err := bk.SetCoins(ctx, addr, initialCoins); err != nil {
+			panic(err)
+		}
+	}
+	return
+}
@@ -0,0 +1,15 @@
+package types
+
+const (
+	// ModuleName is the module name constant used in many places
+	ModuleName = "supply"
+
+	// StoreKey is the store key string for supply
+	StoreKey = ModuleName
+
+	// RouterKey is the message route for supply
+	RouterKey = ModuleName
+
+	// QuerierRoute is the querier route for supply
+	QuerierRoute = ModuleName
+)
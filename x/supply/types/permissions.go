@@ -0,0 +1,40 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/auth"
+)
+
+// PermissionsForAddress defines all the registered permissions for an address
+type PermissionsForAddress struct {
+	permissions []string
+	address     sdk.AccAddress
+}
+
+// NewPermissionsForAddress creates a new PermissionsForAddress object
+func NewPermissionsForAddress(name string, permissions []string) PermissionsForAddress {
+	return PermissionsForAddress{
+		permissions: permissions,
+		address:     auth.NewModuleAddress(name),
+	}
+}
+
+// HasPermission returns whether the PermissionsForAddress contains permission.
+func (pa PermissionsForAddress) HasPermission(permission string) bool {
+	for _, perm := range pa.permissions {
+		if perm == permission {
+			return true
+		}
+	}
+	return false
+}
+
+// GetAddress returns the address of the PermissionsForAddress object
+func (pa PermissionsForAddress) GetAddress() sdk.AccAddress {
+	return pa.address
+}
+
+// GetPermissions returns the permissions granted to the address
+func (pa PermissionsForAddress) GetPermissions() []string {
+	return pa.permissions
+}
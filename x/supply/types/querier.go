@@ -0,0 +1,20 @@
+package types
+
+// query endpoints supported by the supply Querier
+const (
+	QueryTotalSupply = "total_supply"
+	QuerySupplyOf    = "supply_of"
+)
+
+// QuerySupplyOfParams defines the params for the following queries:
+//
+// - 'custom/supply/supply_of'
+type QuerySupplyOfParams struct {
+	Denom string
+}
+
+// NewQuerySupplyOfParams creates a new instance to query the total supply
+// of a given denomination
+func NewQuerySupplyOfParams(denom string) QuerySupplyOfParams {
+	return QuerySupplyOfParams{denom}
+}
@@ -0,0 +1,48 @@
+package upgrade
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// BeginBlocker will check if there is a scheduled plan and if it is ready to
+// be executed. If it is ready, it will execute it if the handler is installed,
+// and panic/halt otherwise. If the plan is not ready, it will ensure the
+// handler is not registered too early (and abort otherwise).
+//
+// The purpose is to ensure the binary is switched EXACTLY at the desired
+// block, and to allow a migration to be executed if needed upon this switch
+// (migration defined in the new binary).
+func BeginBlocker(ctx sdk.Context, k Keeper) {
+	plan, found := k.GetUpgradePlan(ctx)
+	if !found {
+		return
+	}
+
+	if plan.ShouldExecute(ctx) {
+		if !k.HasHandler(plan.Name) {
+			upgradeMsg := fmt.Sprintf("UPGRADE %q NEEDED at height %d: %s", plan.Name, plan.Height, plan.Info)
+
+			// We don't have an upgrade handler for this upgrade name, meaning
+			// this software is out of date so shutdown.
+			k.Logger(ctx).Error(upgradeMsg)
+			panic(upgradeMsg)
+		}
+
+		// We have an upgrade handler for this upgrade name, so apply the
+		// upgrade.
+		k.Logger(ctx).Info(fmt.Sprintf("applying upgrade %q at height %d", plan.Name, plan.Height))
+		ctx = ctx.WithBlockGasMeter(sdk.NewInfiniteGasMeter())
+		k.ApplyUpgrade(ctx, plan)
+		return
+	}
+
+	// If we have a pending upgrade, but it is not yet time, make sure we did
+	// not set the handler already.
+	if k.HasHandler(plan.Name) {
+		downgradeMsg := fmt.Sprintf("BINARY UPDATED BEFORE TRIGGER! UPGRADE %q - in binary but not executed on chain", plan.Name)
+		k.Logger(ctx).Error(downgradeMsg)
+		panic(downgradeMsg)
+	}
+}
@@ -0,0 +1,193 @@
+package upgrade
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	abci "github.com/tendermint/tendermint/abci/types"
+	dbm "github.com/tendermint/tendermint/libs/db"
+	"github.com/tendermint/tendermint/libs/log"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/store"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+)
+
+type testInput struct {
+	ctx     sdk.Context
+	cdc     *codec.Codec
+	keeper  Keeper
+	handler govtypes.Handler
+}
+
+func newTestInput(t *testing.T) testInput {
+	db := dbm.NewMemDB()
+	key := sdk.NewKVStoreKey(StoreKey)
+
+	ms := store.NewCommitMultiStore(db)
+	ms.MountStoreWithDB(key, sdk.StoreTypeIAVL, db)
+	require.NoError(t, ms.LoadLatestVersion())
+
+	cdc := codec.New()
+	RegisterCodec(cdc)
+
+	keeper := NewKeeper(cdc, key, DefaultCodespace)
+	ctx := sdk.NewContext(ms, abci.Header{Height: 10}, false, log.NewNopLogger())
+
+	return testInput{ctx, cdc, keeper, NewSoftwareUpgradeProposalHandler(keeper)}
+}
+
+func (input testInput) schedule(t *testing.T, name string, height int64) {
+	p := NewSoftwareUpgradeProposal("prop", "prop", NewPlan(name, height, ""))
+	require.NoError(t, input.handler(input.ctx, p))
+}
+
+func TestRequireName(t *testing.T) {
+	input := newTestInput(t)
+
+	p := NewSoftwareUpgradeProposal("prop", "prop", NewPlan("", 15, ""))
+	require.Error(t, input.handler(input.ctx, p))
+}
+
+func TestCantSchedulePast(t *testing.T) {
+	input := newTestInput(t)
+
+	p := NewSoftwareUpgradeProposal("prop", "prop", NewPlan("test", input.ctx.BlockHeight(), ""))
+	require.Error(t, input.handler(input.ctx, p))
+}
+
+func TestHaltIfNoHandler(t *testing.T) {
+	input := newTestInput(t)
+	input.schedule(t, "test", 15)
+
+	// no-op before the upgrade height
+	require.NotPanics(t, func() {
+		BeginBlocker(input.ctx.WithBlockHeight(14), input.keeper)
+	})
+
+	require.Panics(t, func() {
+		BeginBlocker(input.ctx.WithBlockHeight(15), input.keeper)
+	})
+}
+
+func TestHaltIfHandlerSetEarly(t *testing.T) {
+	input := newTestInput(t)
+	input.schedule(t, "test", 15)
+
+	input.keeper.SetUpgradeHandler("test", func(_ sdk.Context, _ Plan) {})
+	require.Panics(t, func() {
+		BeginBlocker(input.ctx.WithBlockHeight(14), input.keeper)
+	})
+}
+
+func TestApplyUpgrade(t *testing.T) {
+	input := newTestInput(t)
+	input.schedule(t, "test", 15)
+
+	called := 0
+	input.keeper.SetUpgradeHandler("test", func(_ sdk.Context, plan Plan) {
+		require.Equal(t, "test", plan.Name)
+		called++
+	})
+
+	ctx := input.ctx.WithBlockHeight(15)
+	require.NotPanics(t, func() {
+		BeginBlocker(ctx, input.keeper)
+	})
+	require.Equal(t, 1, called)
+
+	// the plan is cleared and recorded as done
+	_, found := input.keeper.GetUpgradePlan(ctx)
+	require.False(t, found)
+	require.Equal(t, int64(15), input.keeper.GetDoneHeight(ctx, "test"))
+
+	// a completed upgrade name cannot be scheduled again
+	p := NewSoftwareUpgradeProposal("prop", "prop", NewPlan("test", 20, ""))
+	require.Error(t, input.handler(ctx, p))
+
+	// the handler is not invoked again on subsequent blocks
+	BeginBlocker(ctx.WithBlockHeight(16), input.keeper)
+	require.Equal(t, 1, called)
+}
+
+func TestCancelUpgrade(t *testing.T) {
+	input := newTestInput(t)
+
+	cancel := NewCancelSoftwareUpgradeProposal("cancel", "cancel")
+	require.Error(t, input.handler(input.ctx, cancel))
+
+	input.schedule(t, "test", 15)
+	require.NoError(t, input.handler(input.ctx, cancel))
+
+	_, found := input.keeper.GetUpgradePlan(input.ctx)
+	require.False(t, found)
+
+	require.NotPanics(t, func() {
+		BeginBlocker(input.ctx.WithBlockHeight(15), input.keeper)
+	})
+}
+
+func TestExportImportGenesis(t *testing.T) {
+	input := newTestInput(t)
+
+	input.keeper.SetUpgradeHandler("done", func(_ sdk.Context, _ Plan) {})
+	input.schedule(t, "done", 15)
+	BeginBlocker(input.ctx.WithBlockHeight(15), input.keeper)
+	input.schedule(t, "next", 30)
+
+	genesis := ExportGenesis(input.ctx, input.keeper)
+	require.NoError(t, ValidateGenesis(genesis))
+	require.Equal(t, []DoneUpgrade{NewDoneUpgrade("done", 15)}, genesis.DoneUpgrades)
+	require.Equal(t, "next", genesis.Plan.Name)
+
+	// the applied upgrade cannot be scheduled again after the import
+	newInput := newTestInput(t)
+	InitGenesis(newInput.ctx, newInput.keeper, genesis)
+	require.Equal(t, genesis, ExportGenesis(newInput.ctx, newInput.keeper))
+	require.Equal(t, int64(15), newInput.keeper.GetDoneHeight(newInput.ctx, "done"))
+
+	p := NewSoftwareUpgradeProposal("prop", "prop", NewPlan("done", 40, ""))
+	require.Error(t, newInput.handler(newInput.ctx, p))
+
+	// a plan of an applied upgrade is rejected
+	genesis.Plan.Name = "done"
+	require.Error(t, ValidateGenesis(genesis))
+
+	require.NoError(t, ValidateGenesis(DefaultGenesisState()))
+	require.Error(t, ValidateGenesis(NewGenesisState(nil, []DoneUpgrade{NewDoneUpgrade("a", 1), NewDoneUpgrade("a", 2)})))
+	require.Error(t, ValidateGenesis(NewGenesisState(nil, []DoneUpgrade{NewDoneUpgrade("a", 0)})))
+}
+
+func TestQuerier(t *testing.T) {
+	input := newTestInput(t)
+	querier := NewQuerier(input.keeper)
+
+	res, err := querier(input.ctx, []string{QueryCurrent}, abci.RequestQuery{})
+	require.NoError(t, err)
+	require.Nil(t, res)
+
+	input.schedule(t, "test", 15)
+
+	res, err = querier(input.ctx, []string{QueryCurrent}, abci.RequestQuery{})
+	require.NoError(t, err)
+
+	var plan Plan
+	require.NoError(t, input.cdc.UnmarshalJSON(res, &plan))
+	require.Equal(t, NewPlan("test", 15, ""), plan)
+
+	input.keeper.SetUpgradeHandler("test", func(_ sdk.Context, _ Plan) {})
+	BeginBlocker(input.ctx.WithBlockHeight(15), input.keeper)
+
+	bz := input.cdc.MustMarshalJSON(NewQueryAppliedParams("test"))
+	res, err = querier(input.ctx, []string{QueryApplied}, abci.RequestQuery{Data: bz})
+	require.NoError(t, err)
+
+	var height int64
+	require.NoError(t, input.cdc.UnmarshalJSON(res, &height))
+	require.Equal(t, int64(15), height)
+
+	_, err = querier(input.ctx, []string{"foo"}, abci.RequestQuery{})
+	require.Error(t, err)
+}
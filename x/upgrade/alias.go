@@ -0,0 +1,53 @@
+// nolint
+// autogenerated code using github.com/rigelrozanski/multitool
+// aliases generated for the following subdirectories:
+// ALIASGEN: github.com/cosmos/cosmos-sdk/x/upgrade/types
+package upgrade
+
+import (
+	"github.com/cosmos/cosmos-sdk/x/upgrade/types"
+)
+
+const (
+	ModuleName                        = types.ModuleName
+	RouterKey                         = types.RouterKey
+	StoreKey                          = types.StoreKey
+	QuerierRoute                      = types.QuerierRoute
+	QueryCurrent                      = types.QueryCurrent
+	QueryApplied                      = types.QueryApplied
+	DefaultCodespace                  = types.DefaultCodespace
+	CodeInvalidPlan                   = types.CodeInvalidPlan
+	CodeNoUpgradePlan                 = types.CodeNoUpgradePlan
+	ProposalTypeSoftwareUpgrade       = types.ProposalTypeSoftwareUpgrade
+	ProposalTypeCancelSoftwareUpgrade = types.ProposalTypeCancelSoftwareUpgrade
+)
+
+var (
+	// functions aliases
+	GetDoneKey                       = types.GetDoneKey
+	NewPlan                          = types.NewPlan
+	ErrInvalidPlan                   = types.ErrInvalidPlan
+	ErrNoUpgradePlan                 = types.ErrNoUpgradePlan
+	NewSoftwareUpgradeProposal       = types.NewSoftwareUpgradeProposal
+	NewCancelSoftwareUpgradeProposal = types.NewCancelSoftwareUpgradeProposal
+	RegisterCodec                    = types.RegisterCodec
+	NewQueryAppliedParams            = types.NewQueryAppliedParams
+	NewDoneUpgrade                   = types.NewDoneUpgrade
+	NewGenesisState                  = types.NewGenesisState
+	DefaultGenesisState              = types.DefaultGenesisState
+
+	// variable aliases
+	ModuleCdc     = types.ModuleCdc
+	PlanKey       = types.PlanKey
+	DoneKeyPrefix = types.DoneKeyPrefix
+)
+
+type (
+	Plan                          = types.Plan
+	SoftwareUpgradeProposal       = types.SoftwareUpgradeProposal
+	CancelSoftwareUpgradeProposal = types.CancelSoftwareUpgradeProposal
+	UpgradeHandler                = types.UpgradeHandler
+	QueryAppliedParams            = types.QueryAppliedParams
+	DoneUpgrade                   = types.DoneUpgrade
+	GenesisState                  = types.GenesisState
+)
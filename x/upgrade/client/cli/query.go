@@ -0,0 +1,99 @@
+package cli
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/context"
+	"github.com/cosmos/cosmos-sdk/client/utils"
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/x/upgrade/types"
+)
+
+// GetQueryCmd returns the cli query commands for the upgrade module.
+func GetQueryCmd(cdc *codec.Codec) *cobra.Command {
+	upgradeQueryCmd := &cobra.Command{
+		Use:                        types.ModuleName,
+		Short:                      "Querying commands for the upgrade module",
+		DisableFlagParsing:         true,
+		SuggestionsMinimumDistance: 2,
+		RunE:                       utils.ValidateCmd,
+	}
+
+	upgradeQueryCmd.AddCommand(
+		client.GetCommands(
+			GetCmdQueryPlan(cdc),
+			GetCmdQueryApplied(cdc),
+		)...,
+	)
+
+	return upgradeQueryCmd
+}
+
+// GetCmdQueryPlan implements a command to return the currently scheduled
+// upgrade plan, if any.
+func GetCmdQueryPlan(cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "plan",
+		Short: "Query the upgrade plan (if one exists)",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			route := fmt.Sprintf("custom/%s/%s", types.QuerierRoute, types.QueryCurrent)
+			res, err := cliCtx.QueryWithData(route, nil)
+			if err != nil {
+				return err
+			}
+
+			if len(res) == 0 {
+				return fmt.Errorf("no upgrade scheduled")
+			}
+
+			var plan types.Plan
+			if err := cdc.UnmarshalJSON(res, &plan); err != nil {
+				return err
+			}
+
+			return cliCtx.PrintOutput(plan)
+		},
+	}
+}
+
+// GetCmdQueryApplied implements a command to return the height at which a
+// named upgrade was applied.
+func GetCmdQueryApplied(cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "applied [upgrade-name]",
+		Short: "Query the height at which a completed upgrade was applied",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			bz, err := cdc.MarshalJSON(types.NewQueryAppliedParams(args[0]))
+			if err != nil {
+				return err
+			}
+
+			route := fmt.Sprintf("custom/%s/%s", types.QuerierRoute, types.QueryApplied)
+			res, err := cliCtx.QueryWithData(route, bz)
+			if err != nil {
+				return err
+			}
+
+			if len(res) == 0 {
+				return fmt.Errorf("no upgrade found with name %s", args[0])
+			}
+
+			var height int64
+			if err := cdc.UnmarshalJSON(res, &height); err != nil {
+				return err
+			}
+
+			fmt.Println(height)
+			return nil
+		},
+	}
+}
@@ -0,0 +1,131 @@
+package cli
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+
+	"github.com/cosmos/cosmos-sdk/client/context"
+	"github.com/cosmos/cosmos-sdk/client/utils"
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/version"
+	"github.com/cosmos/cosmos-sdk/x/auth"
+	"github.com/cosmos/cosmos-sdk/x/gov"
+	govcli "github.com/cosmos/cosmos-sdk/x/gov/client/cli"
+	"github.com/cosmos/cosmos-sdk/x/upgrade/types"
+)
+
+// Upgrade proposal flags
+const (
+	FlagUpgradeHeight = "upgrade-height"
+	FlagUpgradeInfo   = "info"
+)
+
+// GetCmdSubmitUpgradeProposal implements a command handler for submitting a
+// software upgrade proposal transaction.
+func GetCmdSubmitUpgradeProposal(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "software-upgrade [name]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Submit a software upgrade proposal",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Submit a software upgrade proposal along with an initial deposit.
+Once the proposal passes, every node will halt at the given upgrade height
+unless its binary registers an upgrade handler with the given name.
+
+Example:
+$ %s tx gov submit-proposal software-upgrade v2 --upgrade-height=200000 --info="git commit 1a2b3c" \
+--title="Upgrade to v2" --description="Switch to the v2 binary" --deposit=10000stake --from=<key_or_address>
+`,
+				version.ClientName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			txBldr := auth.NewTxBuilderFromCLI().WithTxEncoder(utils.GetTxEncoder(cdc))
+			cliCtx := context.NewCLIContext().
+				WithCodec(cdc).
+				WithAccountDecoder(cdc)
+
+			deposit, err := sdk.ParseCoins(viper.GetString(govcli.FlagDeposit))
+			if err != nil {
+				return err
+			}
+
+			plan := types.NewPlan(args[0], viper.GetInt64(FlagUpgradeHeight), viper.GetString(FlagUpgradeInfo))
+			content := types.NewSoftwareUpgradeProposal(
+				viper.GetString(govcli.FlagTitle), viper.GetString(govcli.FlagDescription), plan,
+			)
+
+			from := cliCtx.GetFromAddress()
+			msg := gov.NewMsgSubmitProposal(content, deposit, from, viper.GetBool(govcli.FlagExpedited))
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return utils.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
+		},
+	}
+
+	cmd.Flags().String(govcli.FlagTitle, "", "title of proposal")
+	cmd.Flags().String(govcli.FlagDescription, "", "description of proposal")
+	cmd.Flags().String(govcli.FlagDeposit, "", "deposit of proposal")
+	cmd.Flags().Bool(govcli.FlagExpedited, false, "submit an expedited proposal")
+	cmd.Flags().Int64(FlagUpgradeHeight, 0, "the height at which the upgrade must happen")
+	cmd.Flags().String(FlagUpgradeInfo, "", "optional info for the planned upgrade such as commit hash, etc.")
+
+	return cmd
+}
+
+// GetCmdSubmitCancelUpgradeProposal implements a command handler for
+// submitting a software upgrade cancel proposal transaction.
+func GetCmdSubmitCancelUpgradeProposal(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "cancel-software-upgrade",
+		Args:  cobra.NoArgs,
+		Short: "Submit a proposal to cancel the currently scheduled software upgrade",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Submit a proposal to cancel the currently scheduled software upgrade
+along with an initial deposit.
+
+Example:
+$ %s tx gov submit-proposal cancel-software-upgrade --title="Cancel v2" \
+--description="v2 has a bug" --deposit=10000stake --from=<key_or_address>
+`,
+				version.ClientName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			txBldr := auth.NewTxBuilderFromCLI().WithTxEncoder(utils.GetTxEncoder(cdc))
+			cliCtx := context.NewCLIContext().
+				WithCodec(cdc).
+				WithAccountDecoder(cdc)
+
+			deposit, err := sdk.ParseCoins(viper.GetString(govcli.FlagDeposit))
+			if err != nil {
+				return err
+			}
+
+			content := types.NewCancelSoftwareUpgradeProposal(
+				viper.GetString(govcli.FlagTitle), viper.GetString(govcli.FlagDescription),
+			)
+
+			from := cliCtx.GetFromAddress()
+			msg := gov.NewMsgSubmitProposal(content, deposit, from, viper.GetBool(govcli.FlagExpedited))
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return utils.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
+		},
+	}
+
+	cmd.Flags().String(govcli.FlagTitle, "", "title of proposal")
+	cmd.Flags().String(govcli.FlagDescription, "", "description of proposal")
+	cmd.Flags().String(govcli.FlagDeposit, "", "deposit of proposal")
+	cmd.Flags().Bool(govcli.FlagExpedited, false, "submit an expedited proposal")
+
+	return cmd
+}
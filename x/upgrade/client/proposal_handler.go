@@ -0,0 +1,13 @@
+package client
+
+import (
+	govclient "github.com/cosmos/cosmos-sdk/x/gov/client"
+	"github.com/cosmos/cosmos-sdk/x/upgrade/client/cli"
+	"github.com/cosmos/cosmos-sdk/x/upgrade/client/rest"
+)
+
+// software upgrade proposal handlers
+var (
+	ProposalHandler       = govclient.NewProposalHandler(cli.GetCmdSubmitUpgradeProposal, rest.ProposalRESTHandler)
+	CancelProposalHandler = govclient.NewProposalHandler(cli.GetCmdSubmitCancelUpgradeProposal, rest.ProposalCancelRESTHandler)
+)
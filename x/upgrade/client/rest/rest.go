@@ -0,0 +1,171 @@
+package rest
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/gorilla/mux"
+
+	"github.com/cosmos/cosmos-sdk/client/context"
+	clientrest "github.com/cosmos/cosmos-sdk/client/rest"
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/rest"
+	"github.com/cosmos/cosmos-sdk/x/gov"
+	govrest "github.com/cosmos/cosmos-sdk/x/gov/client/rest"
+	"github.com/cosmos/cosmos-sdk/x/upgrade/types"
+)
+
+type (
+	// PlanRequest defines a software upgrade proposal request body.
+	PlanRequest struct {
+		BaseReq rest.BaseReq `json:"base_req"`
+
+		Title         string         `json:"title"`
+		Description   string         `json:"description"`
+		Deposit       sdk.Coins      `json:"deposit"`
+		Proposer      sdk.AccAddress `json:"proposer"`
+		UpgradeName   string         `json:"name"`
+		UpgradeHeight int64          `json:"upgrade_height"`
+		UpgradeInfo   string         `json:"info"`
+	}
+
+	// CancelRequest defines a cancel software upgrade proposal request body.
+	CancelRequest struct {
+		BaseReq rest.BaseReq `json:"base_req"`
+
+		Title       string         `json:"title"`
+		Description string         `json:"description"`
+		Deposit     sdk.Coins      `json:"deposit"`
+		Proposer    sdk.AccAddress `json:"proposer"`
+	}
+)
+
+// RegisterRoutes registers upgrade REST routes.
+func RegisterRoutes(cliCtx context.CLIContext, r *mux.Router, cdc *codec.Codec) {
+	registerQueryRoutes(cliCtx, r, cdc)
+}
+
+// ProposalRESTHandler returns a ProposalRESTHandler that exposes the software
+// upgrade REST handler with a given sub-route.
+func ProposalRESTHandler(cliCtx context.CLIContext, cdc *codec.Codec) govrest.ProposalRESTHandler {
+	return govrest.ProposalRESTHandler{
+		SubRoute: "upgrade",
+		Handler:  postPlanHandlerFn(cdc, cliCtx),
+	}
+}
+
+// ProposalCancelRESTHandler returns a ProposalRESTHandler that exposes the
+// cancel software upgrade REST handler with a given sub-route.
+func ProposalCancelRESTHandler(cliCtx context.CLIContext, cdc *codec.Codec) govrest.ProposalRESTHandler {
+	return govrest.ProposalRESTHandler{
+		SubRoute: "upgrade_cancel",
+		Handler:  postCancelPlanHandlerFn(cdc, cliCtx),
+	}
+}
+
+func registerQueryRoutes(cliCtx context.CLIContext, r *mux.Router, cdc *codec.Codec) {
+	r.HandleFunc(
+		"/upgrade/current",
+		getCurrentPlanHandlerFn(cdc, cliCtx),
+	).Methods("GET")
+
+	r.HandleFunc(
+		"/upgrade/applied/{name}",
+		getDonePlanHandlerFn(cdc, cliCtx),
+	).Methods("GET")
+}
+
+func postPlanHandlerFn(cdc *codec.Codec, cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		var req PlanRequest
+		if !rest.ReadRESTReq(w, r, cdc, &req) {
+			return
+		}
+
+		req.BaseReq = req.BaseReq.Sanitize()
+		if !req.BaseReq.ValidateBasic(w) {
+			return
+		}
+
+		plan := types.NewPlan(req.UpgradeName, req.UpgradeHeight, req.UpgradeInfo)
+		content := types.NewSoftwareUpgradeProposal(req.Title, req.Description, plan)
+
+		msg := gov.NewMsgSubmitProposal(content, req.Deposit, req.Proposer, false)
+		if err := msg.ValidateBasic(); err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		clientrest.WriteGenerateStdTxResponse(w, cdc, cliCtx, req.BaseReq, []sdk.Msg{msg})
+	}
+}
+
+func postCancelPlanHandlerFn(cdc *codec.Codec, cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		var req CancelRequest
+		if !rest.ReadRESTReq(w, r, cdc, &req) {
+			return
+		}
+
+		req.BaseReq = req.BaseReq.Sanitize()
+		if !req.BaseReq.ValidateBasic(w) {
+			return
+		}
+
+		content := types.NewCancelSoftwareUpgradeProposal(req.Title, req.Description)
+
+		msg := gov.NewMsgSubmitProposal(content, req.Deposit, req.Proposer, false)
+		if err := msg.ValidateBasic(); err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		clientrest.WriteGenerateStdTxResponse(w, cdc, cliCtx, req.BaseReq, []sdk.Msg{msg})
+	}
+}
+
+func getCurrentPlanHandlerFn(cdc *codec.Codec, cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		route := fmt.Sprintf("custom/%s/%s", types.QuerierRoute, types.QueryCurrent)
+
+		res, err := cliCtx.QueryWithData(route, nil)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusInternalServerError, err.Error())
+			return
+		}
+
+		if len(res) == 0 {
+			rest.WriteErrorResponse(w, http.StatusNotFound, "no upgrade scheduled")
+			return
+		}
+
+		rest.PostProcessResponse(w, cdc, res, cliCtx.Indent)
+	}
+}
+
+func getDonePlanHandlerFn(cdc *codec.Codec, cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		name := mux.Vars(r)["name"]
+
+		bz, err := cdc.MarshalJSON(types.NewQueryAppliedParams(name))
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		route := fmt.Sprintf("custom/%s/%s", types.QuerierRoute, types.QueryApplied)
+		res, err := cliCtx.QueryWithData(route, bz)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusInternalServerError, err.Error())
+			return
+		}
+
+		if len(res) == 0 {
+			rest.WriteErrorResponse(w, http.StatusNotFound, fmt.Sprintf("no upgrade found with name %s", name))
+			return
+		}
+
+		rest.PostProcessResponse(w, cdc, res, cliCtx.Indent)
+	}
+}
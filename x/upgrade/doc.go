@@ -0,0 +1,87 @@
+/*
+Package upgrade provides a Cosmos SDK module that can be used for smoothly
+upgrading a live Cosmos chain to a new software version. It accomplishes this
+by providing a BeginBlocker hook that prevents the blockchain state machine
+from proceeding once a pre-defined upgrade block height has been reached.
+
+Without software support for upgrades, upgrading a live chain is risky because
+all of the validators need to pause their state machines at exactly the same
+point in the process. If this is not done correctly, there can be state
+inconsistencies which are hard to recover from.
+
+General Workflow
+
+Let's assume we are running v0.34.0 of our software in our testnet and want to
+upgrade to v0.36.0. How would this look in practice? First of all, we want to
+finalize the v0.36.0 release candidate and install a specially named upgrade
+handler (eg. "testnet-v2" or even "v0.36.0"). An upgrade handler should be
+defined in a new version of the software to define what migrations to run to
+migrate from the older version of the software. Naturally, this is app-specific
+rather than module specific, and must be defined in app.go, even if it imports
+logic from various modules to perform the actions. You can register them with
+upgradeKeeper.SetUpgradeHandler during the app initialization (before starting
+the abci server), and they serve not only to perform a migration, but also to
+identify if this is the old or new version (eg. presence of a handler
+registered for the named upgrade).
+
+Once the release candidate along with an appropriate upgrade handler is frozen,
+we can have a governance vote to approve this upgrade at some future block
+height (e.g. 200000). This is known as an upgrade.Plan. The v0.34.0 code will
+not know of this handler, but will continue to run until block 200000, when the
+plan kicks in at BeginBlock. It will check for the existence of the handler,
+and finding it missing, know that it is running the obsolete software, and
+gracefully exit.
+
+Generally the application binary will restart on exit, but then will execute
+this BeginBlocker again and exit, causing a restart loop. Either the node
+operator can manually install the new software, or you can make use of an
+external watcher daemon to possibly download and then switch binaries, also
+potentially doing a backup.
+
+When the binary restarts with the upgraded version (here v0.36.0), it will
+detect we have registered the "testnet-v2" upgrade handler in the code, and
+realize it is the new version. It then will run the upgrade handler and
+*migrate the database in-place*. Once finished, it marks the upgrade as done,
+and continues processing the rest of the block as normal. Once 2/3 of the
+voting power has upgraded, the blockchain will immediately resume the
+consensus mechanism.
+
+Store Migrations
+
+If the new version of the software adds, renames or removes module stores, the
+upgrade handler alone is not enough, since the multistore is loaded before any
+block is processed. Instead, the new binary should load the multistore with the
+corresponding store upgrades once the upgrade height has been reached:
+
+	err := app.LoadLatestVersionAndUpgrade(keyMain, &sdk.StoreUpgrades{
+		Added:   []string{"newmodule"},
+		Renamed: []sdk.StoreRename{{OldKey: "foo", NewKey: "bar"}},
+		Deleted: []string{"oldmodule"},
+	})
+
+The store changes are then committed along with the first block processed by
+the new binary.
+
+Halting Behavior
+
+Before halting the ABCI state machine in the BeginBlocker method, the upgrade
+module will log an error that looks like:
+
+	UPGRADE "<Name>" NEEDED at height <Height>: <Info>
+
+where Name and Info are the values of the respective fields on the upgrade
+Plan.
+
+Cancelling Upgrades
+
+There are two ways to cancel a planned upgrade - with on-chain governance or
+off-chain social consensus. For the first one, there is a
+CancelSoftwareUpgradeProposal which can be voted on and will remove the
+scheduled upgrade plan. Of course this requires that the upgrade was known to
+be a bad idea well before the upgrade itself, to allow time for a vote.
+
+If the scheduled upgrade is instead bypassed off-chain, node operators may set
+the halt-height of their nodes and coordinate a new binary that registers a
+handler for the plan which performs no migrations.
+*/
+package upgrade
@@ -0,0 +1,39 @@
+package upgrade
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// InitGenesis stores the scheduled upgrade plan and the heights of the
+// upgrades already applied, so that an upgrade cannot be applied twice.
+func InitGenesis(ctx sdk.Context, k Keeper, data GenesisState) {
+	for _, upgrade := range data.DoneUpgrades {
+		k.setDoneHeight(ctx, upgrade.Name, upgrade.Height)
+	}
+
+	if data.Plan != nil {
+		k.setUpgradePlan(ctx, *data.Plan)
+	}
+}
+
+// ExportGenesis returns a GenesisState for a given context and keeper.
+func ExportGenesis(ctx sdk.Context, k Keeper) GenesisState {
+	var plan *Plan
+	if p, found := k.GetUpgradePlan(ctx); found {
+		plan = &p
+	}
+
+	doneUpgrades := []DoneUpgrade{}
+	k.IterateDoneUpgrades(ctx, func(upgrade DoneUpgrade) (stop bool) {
+		doneUpgrades = append(doneUpgrades, upgrade)
+		return false
+	})
+
+	return NewGenesisState(plan, doneUpgrades)
+}
+
+// ValidateGenesis performs basic validation of upgrade genesis data returning
+// an error for any failed validation criteria.
+func ValidateGenesis(data GenesisState) error {
+	return data.ValidateBasic()
+}
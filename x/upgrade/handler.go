@@ -0,0 +1,43 @@
+package upgrade
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+)
+
+// NewSoftwareUpgradeProposalHandler creates a governance handler to manage
+// new proposal types. It enables SoftwareUpgradeProposal to propose an
+// upgrade, and CancelSoftwareUpgradeProposal to abort a previously voted
+// upgrade.
+func NewSoftwareUpgradeProposalHandler(k Keeper) govtypes.Handler {
+	return func(ctx sdk.Context, content govtypes.Content) sdk.Error {
+		switch c := content.(type) {
+		case SoftwareUpgradeProposal:
+			return handleSoftwareUpgradeProposal(ctx, k, c)
+
+		case CancelSoftwareUpgradeProposal:
+			return handleCancelSoftwareUpgradeProposal(ctx, k, c)
+
+		default:
+			errMsg := fmt.Sprintf("unrecognized upgrade proposal content type: %T", c)
+			return sdk.ErrUnknownRequest(errMsg)
+		}
+	}
+}
+
+func handleSoftwareUpgradeProposal(ctx sdk.Context, k Keeper, p SoftwareUpgradeProposal) sdk.Error {
+	k.Logger(ctx).Info(fmt.Sprintf("scheduling upgrade %q at height %d", p.Plan.Name, p.Plan.Height))
+	return k.ScheduleUpgrade(ctx, p.Plan)
+}
+
+func handleCancelSoftwareUpgradeProposal(ctx sdk.Context, k Keeper, _ CancelSoftwareUpgradeProposal) sdk.Error {
+	if _, found := k.GetUpgradePlan(ctx); !found {
+		return ErrNoUpgradePlan(k.codespace)
+	}
+
+	k.Logger(ctx).Info("cancelling scheduled upgrade")
+	k.ClearUpgradePlan(ctx)
+	return nil
+}
@@ -0,0 +1,148 @@
+package upgrade
+
+import (
+	"encoding/binary"
+	"fmt"
+
+	"github.com/tendermint/tendermint/libs/log"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// Keeper of the upgrade store
+type Keeper struct {
+	storeKey        sdk.StoreKey
+	cdc             *codec.Codec
+	upgradeHandlers map[string]UpgradeHandler
+
+	// codespace
+	codespace sdk.CodespaceType
+}
+
+// NewKeeper creates a new upgrade Keeper instance
+func NewKeeper(cdc *codec.Codec, key sdk.StoreKey, codespace sdk.CodespaceType) Keeper {
+	return Keeper{
+		storeKey:        key,
+		cdc:             cdc,
+		upgradeHandlers: map[string]UpgradeHandler{},
+		codespace:       codespace,
+	}
+}
+
+// Logger returns a module-specific logger.
+func (k Keeper) Logger(ctx sdk.Context) log.Logger { return ctx.Logger().With("module", "x/upgrade") }
+
+// SetUpgradeHandler sets an UpgradeHandler for the upgrade specified by name.
+// This handler will be called when the upgrade with this name is applied. In
+// order for an upgrade with the given name to proceed, a handler for this
+// upgrade must be set even if it is a no-op function.
+func (k Keeper) SetUpgradeHandler(name string, upgradeHandler UpgradeHandler) {
+	k.upgradeHandlers[name] = upgradeHandler
+}
+
+// HasHandler returns true if an UpgradeHandler has been registered for the
+// given upgrade name.
+func (k Keeper) HasHandler(name string) bool {
+	_, ok := k.upgradeHandlers[name]
+	return ok
+}
+
+// ScheduleUpgrade schedules an upgrade based on the specified plan. If there
+// is another Plan already scheduled, it will overwrite it.
+func (k Keeper) ScheduleUpgrade(ctx sdk.Context, plan Plan) sdk.Error {
+	if err := plan.ValidateBasic(); err != nil {
+		return err
+	}
+
+	if plan.Height <= ctx.BlockHeight() {
+		return ErrInvalidPlan(k.codespace, "upgrade cannot be scheduled in the past")
+	}
+
+	if k.GetDoneHeight(ctx, plan.Name) != 0 {
+		return ErrInvalidPlan(k.codespace, fmt.Sprintf("upgrade with name %s has already been completed", plan.Name))
+	}
+
+	k.setUpgradePlan(ctx, plan)
+	return nil
+}
+
+// setUpgradePlan stores the plan as the scheduled upgrade.
+func (k Keeper) setUpgradePlan(ctx sdk.Context, plan Plan) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(PlanKey, k.cdc.MustMarshalBinaryLengthPrefixed(plan))
+}
+
+// GetUpgradePlan returns the currently scheduled Plan if any, setting found to
+// true if there is a scheduled upgrade or false if there is none.
+func (k Keeper) GetUpgradePlan(ctx sdk.Context) (plan Plan, found bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(PlanKey)
+	if bz == nil {
+		return plan, false
+	}
+
+	k.cdc.MustUnmarshalBinaryLengthPrefixed(bz, &plan)
+	return plan, true
+}
+
+// ClearUpgradePlan clears any scheduled upgrade.
+func (k Keeper) ClearUpgradePlan(ctx sdk.Context) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(PlanKey)
+}
+
+// GetDoneHeight returns the height at which the given upgrade was executed, or
+// zero if it has not been applied yet.
+func (k Keeper) GetDoneHeight(ctx sdk.Context, name string) int64 {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(GetDoneKey(name))
+	if len(bz) == 0 {
+		return 0
+	}
+
+	return int64(binary.BigEndian.Uint64(bz))
+}
+
+// IterateDoneUpgrades iterates through the applied upgrades, ordered by name.
+func (k Keeper) IterateDoneUpgrades(ctx sdk.Context, fn func(upgrade DoneUpgrade) (stop bool)) {
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, DoneKeyPrefix)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		name := string(iterator.Key()[len(DoneKeyPrefix):])
+		height := int64(binary.BigEndian.Uint64(iterator.Value()))
+		if fn(NewDoneUpgrade(name, height)) {
+			break
+		}
+	}
+}
+
+// setDone marks this upgrade name as being done so the name can't be reused
+// accidentally.
+func (k Keeper) setDone(ctx sdk.Context, name string) {
+	k.setDoneHeight(ctx, name, ctx.BlockHeight())
+}
+
+// setDoneHeight marks this upgrade name as being done at the given height.
+func (k Keeper) setDoneHeight(ctx sdk.Context, name string, height int64) {
+	store := ctx.KVStore(k.storeKey)
+	bz := make([]byte, 8)
+	binary.BigEndian.PutUint64(bz, uint64(height))
+	store.Set(GetDoneKey(name), bz)
+}
+
+// ApplyUpgrade will execute the handler associated with the Plan and mark the
+// plan as done.
+func (k Keeper) ApplyUpgrade(ctx sdk.Context, plan Plan) {
+	handler := k.upgradeHandlers[plan.Name]
+	if handler == nil {
+		panic(fmt.Sprintf("ApplyUpgrade should never be called without first checking HasHandler; name: %s", plan.Name))
+	}
+
+	handler(ctx, plan)
+
+	k.ClearUpgradePlan(ctx)
+	k.setDone(ctx, plan.Name)
+}
@@ -0,0 +1,61 @@
+package upgrade
+
+import (
+	"fmt"
+
+	abci "github.com/tendermint/tendermint/abci/types"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// NewQuerier creates a querier for the upgrade module
+func NewQuerier(k Keeper) sdk.Querier {
+	return func(ctx sdk.Context, path []string, req abci.RequestQuery) ([]byte, sdk.Error) {
+		switch path[0] {
+		case QueryCurrent:
+			return queryCurrent(ctx, k)
+
+		case QueryApplied:
+			return queryApplied(ctx, req, k)
+
+		default:
+			return nil, sdk.ErrUnknownRequest(fmt.Sprintf("unknown upgrade query endpoint: %s", path[0]))
+		}
+	}
+}
+
+func queryCurrent(ctx sdk.Context, k Keeper) ([]byte, sdk.Error) {
+	plan, found := k.GetUpgradePlan(ctx)
+	if !found {
+		return nil, nil
+	}
+
+	res, err := codec.MarshalJSONIndent(k.cdc, plan)
+	if err != nil {
+		return nil, sdk.ErrInternal(sdk.AppendMsgToErr("failed to marshal JSON", err.Error()))
+	}
+
+	return res, nil
+}
+
+func queryApplied(ctx sdk.Context, req abci.RequestQuery, k Keeper) ([]byte, sdk.Error) {
+	var params QueryAppliedParams
+
+	err := k.cdc.UnmarshalJSON(req.Data, &params)
+	if err != nil {
+		return nil, sdk.ErrUnknownRequest(sdk.AppendMsgToErr("incorrectly formatted request data", err.Error()))
+	}
+
+	height := k.GetDoneHeight(ctx, params.Name)
+	if height == 0 {
+		return nil, nil
+	}
+
+	res, err := codec.MarshalJSONIndent(k.cdc, height)
+	if err != nil {
+		return nil, sdk.ErrInternal(sdk.AppendMsgToErr("failed to marshal JSON", err.Error()))
+	}
+
+	return res, nil
+}
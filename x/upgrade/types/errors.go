@@ -0,0 +1,23 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// Upgrade module codespace constants
+const (
+	DefaultCodespace sdk.CodespaceType = ModuleName
+
+	CodeInvalidPlan   sdk.CodeType = 1
+	CodeNoUpgradePlan sdk.CodeType = 2
+)
+
+// ErrInvalidPlan returns an error for an invalid upgrade plan.
+func ErrInvalidPlan(codespace sdk.CodespaceType, msg string) sdk.Error {
+	return sdk.NewError(codespace, CodeInvalidPlan, "invalid upgrade plan: "+msg)
+}
+
+// ErrNoUpgradePlan returns an error for when no upgrade plan is scheduled.
+func ErrNoUpgradePlan(codespace sdk.CodespaceType) sdk.Error {
+	return sdk.NewError(codespace, CodeNoUpgradePlan, "no upgrade plan is currently scheduled")
+}
@@ -0,0 +1,69 @@
+package types
+
+import (
+	"fmt"
+)
+
+// DoneUpgrade is an upgrade which has been applied, along with the height it
+// was applied at.
+type DoneUpgrade struct {
+	Name   string `json:"name"`
+	Height int64  `json:"height"`
+}
+
+// NewDoneUpgrade creates a new DoneUpgrade instance
+func NewDoneUpgrade(name string, height int64) DoneUpgrade {
+	return DoneUpgrade{
+		Name:   name,
+		Height: height,
+	}
+}
+
+// GenesisState contains the scheduled upgrade plan, if any, and the upgrades
+// which have already been applied, so that they cannot be applied again.
+type GenesisState struct {
+	Plan         *Plan         `json:"plan"`
+	DoneUpgrades []DoneUpgrade `json:"done_upgrades"`
+}
+
+// NewGenesisState creates a new genesis state.
+func NewGenesisState(plan *Plan, doneUpgrades []DoneUpgrade) GenesisState {
+	return GenesisState{
+		Plan:         plan,
+		DoneUpgrades: doneUpgrades,
+	}
+}
+
+// DefaultGenesisState returns a default genesis state
+func DefaultGenesisState() GenesisState {
+	return NewGenesisState(nil, []DoneUpgrade{})
+}
+
+// ValidateBasic ensures the plan is valid and that the done upgrades are
+// unique and do not include the plan.
+func (data GenesisState) ValidateBasic() error {
+	done := make(map[string]bool)
+	for _, upgrade := range data.DoneUpgrades {
+		if upgrade.Name == "" {
+			return fmt.Errorf("invalid done upgrade: name cannot be empty")
+		}
+		if upgrade.Height <= 0 {
+			return fmt.Errorf("invalid done upgrade %s: height must be greater than 0", upgrade.Name)
+		}
+		if done[upgrade.Name] {
+			return fmt.Errorf("duplicate done upgrade %s", upgrade.Name)
+		}
+		done[upgrade.Name] = true
+	}
+
+	if data.Plan != nil {
+		if err := data.Plan.ValidateBasic(); err != nil {
+			return err
+		}
+		if done[data.Plan.Name] {
+			return fmt.Errorf("upgrade plan %s has already been completed", data.Plan.Name)
+		}
+	}
+
+	return nil
+}
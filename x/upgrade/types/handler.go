@@ -0,0 +1,10 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// UpgradeHandler specifies the type of function that is called when an upgrade
+// is applied. It is registered with the upgrade keeper under the name of the
+// Plan it handles and runs in the BeginBlock of the upgrade height.
+type UpgradeHandler func(ctx sdk.Context, plan Plan)
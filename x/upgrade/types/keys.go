@@ -0,0 +1,36 @@
+package types
+
+const (
+	// ModuleName is the name of this module
+	ModuleName = "upgrade"
+
+	// RouterKey is used to route governance proposals
+	RouterKey = ModuleName
+
+	// StoreKey is the prefix under which we store this module's data
+	StoreKey = ModuleName
+
+	// QuerierRoute is the querier route for the upgrade store
+	QuerierRoute = StoreKey
+
+	// Query endpoints supported by the upgrade querier
+	QueryCurrent = "current"
+	QueryApplied = "applied"
+)
+
+// Keys for upgrade store
+// Items are stored with the following key: values
+//
+// - 0x00: Plan
+//
+// - 0x01<name_Bytes>: Height (int64, big endian)
+var (
+	PlanKey       = []byte{0x00}
+	DoneKeyPrefix = []byte{0x01}
+)
+
+// GetDoneKey returns the key under which the height an upgrade was applied at
+// is stored.
+func GetDoneKey(name string) []byte {
+	return append(DoneKeyPrefix, []byte(name)...)
+}
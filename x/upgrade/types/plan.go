@@ -0,0 +1,62 @@
+package types
+
+import (
+	"fmt"
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// Plan specifies information about a planned upgrade and when it should occur
+type Plan struct {
+	// Sets the name for the upgrade. This name will be used by the upgraded
+	// version of the software to apply any special "on-upgrade" commands during
+	// the first BeginBlock method after the upgrade is applied. It is also used
+	// to detect whether a software version can handle a given upgrade. If no
+	// upgrade handler with this name has been set in the software, it will be
+	// assumed that the software is out-of-date when the upgrade height is
+	// reached and the node will halt.
+	Name string `json:"name"`
+
+	// The height at which the upgrade must be performed.
+	Height int64 `json:"height"`
+
+	// Any application specific upgrade info to be included on-chain
+	// such as a git commit that validators could automatically upgrade to
+	Info string `json:"info"`
+}
+
+// NewPlan creates a new Plan instance
+func NewPlan(name string, height int64, info string) Plan {
+	return Plan{
+		Name:   name,
+		Height: height,
+		Info:   info,
+	}
+}
+
+// String implements the Stringer interface.
+func (p Plan) String() string {
+	return fmt.Sprintf(`Upgrade Plan
+  Name:   %s
+  Height: %d
+  Info:   %s`, p.Name, p.Height, p.Info)
+}
+
+// ValidateBasic does basic validation of a Plan
+func (p Plan) ValidateBasic() sdk.Error {
+	if len(strings.TrimSpace(p.Name)) == 0 {
+		return ErrInvalidPlan(DefaultCodespace, "name cannot be empty")
+	}
+	if p.Height <= 0 {
+		return ErrInvalidPlan(DefaultCodespace, "height must be greater than 0")
+	}
+
+	return nil
+}
+
+// ShouldExecute returns true if the Plan is ready to execute given the current
+// context.
+func (p Plan) ShouldExecute(ctx sdk.Context) bool {
+	return p.Height > 0 && p.Height <= ctx.BlockHeight()
+}
@@ -0,0 +1,115 @@
+package types
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+)
+
+const (
+	// ProposalTypeSoftwareUpgrade defines the type for a SoftwareUpgradeProposal
+	ProposalTypeSoftwareUpgrade = "SoftwareUpgrade"
+
+	// ProposalTypeCancelSoftwareUpgrade defines the type for a CancelSoftwareUpgradeProposal
+	ProposalTypeCancelSoftwareUpgrade = "CancelSoftwareUpgrade"
+)
+
+// Assert proposals implement govtypes.Content at compile-time
+var (
+	_ govtypes.Content = SoftwareUpgradeProposal{}
+	_ govtypes.Content = CancelSoftwareUpgradeProposal{}
+)
+
+func init() {
+	govtypes.RegisterProposalType(ProposalTypeSoftwareUpgrade)
+	govtypes.RegisterProposalTypeCodec(SoftwareUpgradeProposal{}, "cosmos-sdk/SoftwareUpgradeProposal")
+	govtypes.RegisterProposalType(ProposalTypeCancelSoftwareUpgrade)
+	govtypes.RegisterProposalTypeCodec(CancelSoftwareUpgradeProposal{}, "cosmos-sdk/CancelSoftwareUpgradeProposal")
+}
+
+// SoftwareUpgradeProposal defines a proposal which schedules an upgrade Plan
+// once it passes.
+type SoftwareUpgradeProposal struct {
+	Title       string `json:"title"`
+	Description string `json:"description"`
+	Plan        Plan   `json:"plan"`
+}
+
+// NewSoftwareUpgradeProposal creates a new software upgrade proposal.
+func NewSoftwareUpgradeProposal(title, description string, plan Plan) SoftwareUpgradeProposal {
+	return SoftwareUpgradeProposal{title, description, plan}
+}
+
+// GetTitle returns the title of a software upgrade proposal.
+func (sup SoftwareUpgradeProposal) GetTitle() string { return sup.Title }
+
+// GetDescription returns the description of a software upgrade proposal.
+func (sup SoftwareUpgradeProposal) GetDescription() string { return sup.Description }
+
+// ProposalRoute returns the routing key of a software upgrade proposal.
+func (sup SoftwareUpgradeProposal) ProposalRoute() string { return RouterKey }
+
+// ProposalType returns the type of a software upgrade proposal.
+func (sup SoftwareUpgradeProposal) ProposalType() string { return ProposalTypeSoftwareUpgrade }
+
+// ValidateBasic validates the software upgrade proposal
+func (sup SoftwareUpgradeProposal) ValidateBasic() sdk.Error {
+	if err := sup.Plan.ValidateBasic(); err != nil {
+		return err
+	}
+
+	return govtypes.ValidateAbstract(DefaultCodespace, sup)
+}
+
+// String implements the Stringer interface.
+func (sup SoftwareUpgradeProposal) String() string {
+	return fmt.Sprintf(`Software Upgrade Proposal:
+  Title:       %s
+  Description: %s
+  Plan:
+    Name:   %s
+    Height: %d
+    Info:   %s
+`, sup.Title, sup.Description, sup.Plan.Name, sup.Plan.Height, sup.Plan.Info)
+}
+
+// CancelSoftwareUpgradeProposal defines a proposal which clears the currently
+// scheduled upgrade Plan once it passes.
+type CancelSoftwareUpgradeProposal struct {
+	Title       string `json:"title"`
+	Description string `json:"description"`
+}
+
+// NewCancelSoftwareUpgradeProposal creates a new cancel software upgrade
+// proposal.
+func NewCancelSoftwareUpgradeProposal(title, description string) CancelSoftwareUpgradeProposal {
+	return CancelSoftwareUpgradeProposal{title, description}
+}
+
+// GetTitle returns the title of a cancel software upgrade proposal.
+func (csup CancelSoftwareUpgradeProposal) GetTitle() string { return csup.Title }
+
+// GetDescription returns the description of a cancel software upgrade proposal.
+func (csup CancelSoftwareUpgradeProposal) GetDescription() string { return csup.Description }
+
+// ProposalRoute returns the routing key of a cancel software upgrade proposal.
+func (csup CancelSoftwareUpgradeProposal) ProposalRoute() string { return RouterKey }
+
+// ProposalType returns the type of a cancel software upgrade proposal.
+func (csup CancelSoftwareUpgradeProposal) ProposalType() string {
+	return ProposalTypeCancelSoftwareUpgrade
+}
+
+// ValidateBasic validates the cancel software upgrade proposal
+func (csup CancelSoftwareUpgradeProposal) ValidateBasic() sdk.Error {
+	return govtypes.ValidateAbstract(DefaultCodespace, csup)
+}
+
+// String implements the Stringer interface.
+func (csup CancelSoftwareUpgradeProposal) String() string {
+	return fmt.Sprintf(`Cancel Software Upgrade Proposal:
+  Title:       %s
+  Description: %s
+`, csup.Title, csup.Description)
+}
@@ -0,0 +1,12 @@
+package types
+
+// QueryAppliedParams is passed as data with QueryApplied
+type QueryAppliedParams struct {
+	Name string `json:"name"`
+}
+
+// NewQueryAppliedParams creates a new instance to query if a named upgrade
+// has been applied
+func NewQueryAppliedParams(name string) QueryAppliedParams {
+	return QueryAppliedParams{Name: name}
+}